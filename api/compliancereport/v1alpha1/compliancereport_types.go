@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComplianceRule is the name of a benchmark rule checked by the compliance
+// analyzer.
+// +kubebuilder:validation:Enum=NoUnconfinedPods;NoAllowAllProfiles;NoDanglingBindings
+type ComplianceRule string
+
+const (
+	// ComplianceRuleNoUnconfinedPods reports containers which run without a
+	// seccomp profile or with the Unconfined one.
+	ComplianceRuleNoUnconfinedPods ComplianceRule = "NoUnconfinedPods"
+	// ComplianceRuleNoAllowAllProfiles reports profiles which do not
+	// restrict anything, like seccomp profiles allowing all syscalls or
+	// permissive SELinux profiles, as well as bindings to them.
+	ComplianceRuleNoAllowAllProfiles ComplianceRule = "NoAllowAllProfiles"
+	// ComplianceRuleNoDanglingBindings reports profile bindings which
+	// reference profiles that do not exist.
+	ComplianceRuleNoDanglingBindings ComplianceRule = "NoDanglingBindings"
+)
+
+// AllComplianceRules are all rules known by the compliance analyzer.
+var AllComplianceRules = []ComplianceRule{
+	ComplianceRuleNoUnconfinedPods,
+	ComplianceRuleNoAllowAllProfiles,
+	ComplianceRuleNoDanglingBindings,
+}
+
+// ComplianceFinding is a single violation of a benchmark rule.
+type ComplianceFinding struct {
+	// Rule is the violated benchmark rule.
+	Rule ComplianceRule `json:"rule"`
+	// Kind is the kind of the violating object.
+	Kind string `json:"kind"`
+	// Name is the name of the violating object.
+	Name string `json:"name"`
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ComplianceReport contains the result of checking the profiles, bindings
+// and workloads of a namespace against benchmark rules.
+// +kubebuilder:resource:shortName=spcr
+// +kubebuilder:printcolumn:name="Compliant",type=boolean,JSONPath=`.compliant`
+// +kubebuilder:printcolumn:name="Findings",type=integer,JSONPath=`.findingsCount`
+// +kubebuilder:printcolumn:name="Checked",type=date,JSONPath=`.lastChecked`
+type ComplianceReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Rules are the benchmark rules which have been checked.
+	Rules []ComplianceRule `json:"rules,omitempty"`
+	// Compliant is true if no rule has been violated.
+	Compliant bool `json:"compliant"`
+	// FindingsCount is the number of findings.
+	FindingsCount int `json:"findingsCount"`
+	// Findings are the violations of the rules.
+	Findings []ComplianceFinding `json:"findings,omitempty"`
+	// LastChecked is the time of the last check.
+	LastChecked metav1.Time `json:"lastChecked,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ComplianceReportList contains a list of ComplianceReport.
+type ComplianceReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComplianceReport `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to init the scheme
+	SchemeBuilder.Register(&ComplianceReport{}, &ComplianceReportList{})
+}
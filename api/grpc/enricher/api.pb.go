@@ -24,6 +24,7 @@ package api_enricher
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -40,7 +41,8 @@ type SyscallsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Profile    string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Generation uint64 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
 }
 
 func (x *SyscallsRequest) Reset() {
@@ -82,13 +84,22 @@ func (x *SyscallsRequest) GetProfile() string {
 	return ""
 }
 
+func (x *SyscallsRequest) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
 type SyscallsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Syscalls []string `protobuf:"bytes,1,rep,name=syscalls,proto3" json:"syscalls,omitempty"`
-	GoArch   string   `protobuf:"bytes,2,opt,name=go_arch,json=goArch,proto3" json:"go_arch,omitempty"`
+	Syscalls    []string                                 `protobuf:"bytes,1,rep,name=syscalls,proto3" json:"syscalls,omitempty"`
+	GoArch      string                                   `protobuf:"bytes,2,opt,name=go_arch,json=goArch,proto3" json:"go_arch,omitempty"`
+	Executables map[string]*SyscallsResponse_Executables `protobuf:"bytes,3,rep,name=executables,proto3" json:"executables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Generation  uint64                                   `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
 }
 
 func (x *SyscallsResponse) Reset() {
@@ -137,18 +148,96 @@ func (x *SyscallsResponse) GetGoArch() string {
 	return ""
 }
 
+func (x *SyscallsResponse) GetExecutables() map[string]*SyscallsResponse_Executables {
+	if x != nil {
+		return x.Executables
+	}
+	return nil
+}
+
+func (x *SyscallsResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+type SyscallEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Syscall    string                 `protobuf:"bytes,1,opt,name=syscall,proto3" json:"syscall,omitempty"`
+	Executable string                 `protobuf:"bytes,2,opt,name=executable,proto3" json:"executable,omitempty"`
+	EventTime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+}
+
+func (x *SyscallEvent) Reset() {
+	*x = SyscallEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyscallEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyscallEvent) ProtoMessage() {}
+
+func (x *SyscallEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyscallEvent.ProtoReflect.Descriptor instead.
+func (*SyscallEvent) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SyscallEvent) GetSyscall() string {
+	if x != nil {
+		return x.Syscall
+	}
+	return ""
+}
+
+func (x *SyscallEvent) GetExecutable() string {
+	if x != nil {
+		return x.Executable
+	}
+	return ""
+}
+
+func (x *SyscallEvent) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
 type AvcRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Profile    string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Generation uint64 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
 }
 
 func (x *AvcRequest) Reset() {
 	*x = AvcRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_enricher_api_proto_msgTypes[2]
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -161,7 +250,7 @@ func (x *AvcRequest) String() string {
 func (*AvcRequest) ProtoMessage() {}
 
 func (x *AvcRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[2]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,7 +263,7 @@ func (x *AvcRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AvcRequest.ProtoReflect.Descriptor instead.
 func (*AvcRequest) Descriptor() ([]byte, []int) {
-	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{2}
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AvcRequest) GetProfile() string {
@@ -184,18 +273,27 @@ func (x *AvcRequest) GetProfile() string {
 	return ""
 }
 
+func (x *AvcRequest) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
 type AvcResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Avc []*AvcResponse_SelinuxAvc `protobuf:"bytes,1,rep,name=avc,proto3" json:"avc,omitempty"`
+	Avc        []*AvcResponse_SelinuxAvc `protobuf:"bytes,1,rep,name=avc,proto3" json:"avc,omitempty"`
+	Scontext   string                    `protobuf:"bytes,2,opt,name=scontext,proto3" json:"scontext,omitempty"`
+	Generation uint64                    `protobuf:"varint,3,opt,name=generation,proto3" json:"generation,omitempty"`
 }
 
 func (x *AvcResponse) Reset() {
 	*x = AvcResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_enricher_api_proto_msgTypes[3]
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -208,7 +306,7 @@ func (x *AvcResponse) String() string {
 func (*AvcResponse) ProtoMessage() {}
 
 func (x *AvcResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[3]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +319,7 @@ func (x *AvcResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AvcResponse.ProtoReflect.Descriptor instead.
 func (*AvcResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{3}
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AvcResponse) GetAvc() []*AvcResponse_SelinuxAvc {
@@ -231,6 +329,114 @@ func (x *AvcResponse) GetAvc() []*AvcResponse_SelinuxAvc {
 	return nil
 }
 
+func (x *AvcResponse) GetScontext() string {
+	if x != nil {
+		return x.Scontext
+	}
+	return ""
+}
+
+func (x *AvcResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+type ApparmorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *ApparmorRequest) Reset() {
+	*x = ApparmorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApparmorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApparmorRequest) ProtoMessage() {}
+
+func (x *ApparmorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApparmorRequest.ProtoReflect.Descriptor instead.
+func (*ApparmorRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApparmorRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type ApparmorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*ApparmorResponse_ApparmorEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ApparmorResponse) Reset() {
+	*x = ApparmorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApparmorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApparmorResponse) ProtoMessage() {}
+
+func (x *ApparmorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApparmorResponse.ProtoReflect.Descriptor instead.
+func (*ApparmorResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApparmorResponse) GetEvents() []*ApparmorResponse_ApparmorEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -240,7 +446,7 @@ type EmptyResponse struct {
 func (x *EmptyResponse) Reset() {
 	*x = EmptyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -253,7 +459,7 @@ func (x *EmptyResponse) String() string {
 func (*EmptyResponse) ProtoMessage() {}
 
 func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +472,54 @@ func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{4}
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{7}
+}
+
+type SyscallsResponse_Executables struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *SyscallsResponse_Executables) Reset() {
+	*x = SyscallsResponse_Executables{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyscallsResponse_Executables) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyscallsResponse_Executables) ProtoMessage() {}
+
+func (x *SyscallsResponse_Executables) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyscallsResponse_Executables.ProtoReflect.Descriptor instead.
+func (*SyscallsResponse_Executables) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SyscallsResponse_Executables) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
 }
 
 type AvcResponse_SelinuxAvc struct {
@@ -283,7 +536,7 @@ type AvcResponse_SelinuxAvc struct {
 func (x *AvcResponse_SelinuxAvc) Reset() {
 	*x = AvcResponse_SelinuxAvc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -296,7 +549,7 @@ func (x *AvcResponse_SelinuxAvc) String() string {
 func (*AvcResponse_SelinuxAvc) ProtoMessage() {}
 
 func (x *AvcResponse_SelinuxAvc) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +562,7 @@ func (x *AvcResponse_SelinuxAvc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AvcResponse_SelinuxAvc.ProtoReflect.Descriptor instead.
 func (*AvcResponse_SelinuxAvc) Descriptor() ([]byte, []int) {
-	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{3, 0}
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *AvcResponse_SelinuxAvc) GetPerm() string {
@@ -340,55 +593,212 @@ func (x *AvcResponse_SelinuxAvc) GetTclass() string {
 	return ""
 }
 
+type ApparmorResponse_ApparmorEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Apparmor      string `protobuf:"bytes,1,opt,name=apparmor,proto3" json:"apparmor,omitempty"`
+	Operation     string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RequestedMask string `protobuf:"bytes,4,opt,name=requested_mask,json=requestedMask,proto3" json:"requested_mask,omitempty"`
+	Executable    string `protobuf:"bytes,5,opt,name=executable,proto3" json:"executable,omitempty"`
+}
+
+func (x *ApparmorResponse_ApparmorEvent) Reset() {
+	*x = ApparmorResponse_ApparmorEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApparmorResponse_ApparmorEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApparmorResponse_ApparmorEvent) ProtoMessage() {}
+
+func (x *ApparmorResponse_ApparmorEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApparmorResponse_ApparmorEvent.ProtoReflect.Descriptor instead.
+func (*ApparmorResponse_ApparmorEvent) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *ApparmorResponse_ApparmorEvent) GetApparmor() string {
+	if x != nil {
+		return x.Apparmor
+	}
+	return ""
+}
+
+func (x *ApparmorResponse_ApparmorEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ApparmorResponse_ApparmorEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApparmorResponse_ApparmorEvent) GetRequestedMask() string {
+	if x != nil {
+		return x.RequestedMask
+	}
+	return ""
+}
+
+func (x *ApparmorResponse_ApparmorEvent) GetExecutable() string {
+	if x != nil {
+		return x.Executable
+	}
+	return ""
+}
+
 var File_api_grpc_enricher_api_proto protoreflect.FileDescriptor
 
 var file_api_grpc_enricher_api_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x65, 0x6e, 0x72, 0x69, 0x63,
 	0x68, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x61,
-	0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x22, 0x2b, 0x0a, 0x0f, 0x53,
-	0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x63,
-	0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x5f, 0x61,
-	0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x41, 0x72, 0x63,
-	0x68, 0x22, 0x26, 0x0a, 0x0a, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x0f,
+	0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x0b, 0x41, 0x76,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x03, 0x61, 0x76, 0x63,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72,
-	0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41, 0x76, 0x63, 0x52, 0x03, 0x61, 0x76,
-	0x63, 0x1a, 0x70, 0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41, 0x76, 0x63, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x65, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xab, 0x02, 0x0a, 0x08, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65,
-	0x72, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73,
-	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
-	0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63,
-	0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d,
-	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53,
-	0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x04, 0x41, 0x76, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcb, 0x02, 0x0a, 0x10, 0x53, 0x79,
+	0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f,
+	0x5f, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x41,
+	0x72, 0x63, 0x68, 0x12, 0x51, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x23, 0x0a, 0x0b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x6a, 0x0a, 0x10, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x63,
+	0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x63,
+	0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61,
+	0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x46, 0x0a,
+	0x0a, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x41, 0x76, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x03, 0x61, 0x76, 0x63, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41, 0x76, 0x63, 0x52, 0x03, 0x61, 0x76, 0x63, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x70, 0x0a, 0x0a, 0x53, 0x65, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x41, 0x76, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x72, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x41,
+	0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x70,
+	0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x1a, 0xa4, 0x01, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb2, 0x05, 0x0a, 0x08,
+	0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x79, 0x73, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72,
+	0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53,
+	0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e,
+	0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72,
+	0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x04, 0x41, 0x76, 0x63,
+	0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x41, 0x76, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69,
 	0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41,
-	0x76, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x76, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x5f,
-	0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68,
-	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63,
-	0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x76, 0x63, 0x73, 0x12, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e,
+	0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41,
+	0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -403,30 +813,51 @@ func file_api_grpc_enricher_api_proto_rawDescGZIP() []byte {
 	return file_api_grpc_enricher_api_proto_rawDescData
 }
 
-var file_api_grpc_enricher_api_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_grpc_enricher_api_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_api_grpc_enricher_api_proto_goTypes = []interface{}{
-	(*SyscallsRequest)(nil),        // 0: api_enricher.SyscallsRequest
-	(*SyscallsResponse)(nil),       // 1: api_enricher.SyscallsResponse
-	(*AvcRequest)(nil),             // 2: api_enricher.AvcRequest
-	(*AvcResponse)(nil),            // 3: api_enricher.AvcResponse
-	(*EmptyResponse)(nil),          // 4: api_enricher.EmptyResponse
-	(*AvcResponse_SelinuxAvc)(nil), // 5: api_enricher.AvcResponse.SelinuxAvc
+	(*SyscallsRequest)(nil),                // 0: api_enricher.SyscallsRequest
+	(*SyscallsResponse)(nil),               // 1: api_enricher.SyscallsResponse
+	(*SyscallEvent)(nil),                   // 2: api_enricher.SyscallEvent
+	(*AvcRequest)(nil),                     // 3: api_enricher.AvcRequest
+	(*AvcResponse)(nil),                    // 4: api_enricher.AvcResponse
+	(*ApparmorRequest)(nil),                // 5: api_enricher.ApparmorRequest
+	(*ApparmorResponse)(nil),               // 6: api_enricher.ApparmorResponse
+	(*EmptyResponse)(nil),                  // 7: api_enricher.EmptyResponse
+	(*SyscallsResponse_Executables)(nil),   // 8: api_enricher.SyscallsResponse.Executables
+	nil,                                    // 9: api_enricher.SyscallsResponse.ExecutablesEntry
+	(*AvcResponse_SelinuxAvc)(nil),         // 10: api_enricher.AvcResponse.SelinuxAvc
+	(*ApparmorResponse_ApparmorEvent)(nil), // 11: api_enricher.ApparmorResponse.ApparmorEvent
+	(*timestamppb.Timestamp)(nil),          // 12: google.protobuf.Timestamp
 }
 var file_api_grpc_enricher_api_proto_depIdxs = []int32{
-	5, // 0: api_enricher.AvcResponse.avc:type_name -> api_enricher.AvcResponse.SelinuxAvc
-	0, // 1: api_enricher.Enricher.Syscalls:input_type -> api_enricher.SyscallsRequest
-	0, // 2: api_enricher.Enricher.ResetSyscalls:input_type -> api_enricher.SyscallsRequest
-	2, // 3: api_enricher.Enricher.Avcs:input_type -> api_enricher.AvcRequest
-	2, // 4: api_enricher.Enricher.ResetAvcs:input_type -> api_enricher.AvcRequest
-	1, // 5: api_enricher.Enricher.Syscalls:output_type -> api_enricher.SyscallsResponse
-	4, // 6: api_enricher.Enricher.ResetSyscalls:output_type -> api_enricher.EmptyResponse
-	3, // 7: api_enricher.Enricher.Avcs:output_type -> api_enricher.AvcResponse
-	4, // 8: api_enricher.Enricher.ResetAvcs:output_type -> api_enricher.EmptyResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: api_enricher.SyscallsResponse.executables:type_name -> api_enricher.SyscallsResponse.ExecutablesEntry
+	12, // 1: api_enricher.SyscallEvent.event_time:type_name -> google.protobuf.Timestamp
+	10, // 2: api_enricher.AvcResponse.avc:type_name -> api_enricher.AvcResponse.SelinuxAvc
+	11, // 3: api_enricher.ApparmorResponse.events:type_name -> api_enricher.ApparmorResponse.ApparmorEvent
+	8,  // 4: api_enricher.SyscallsResponse.ExecutablesEntry.value:type_name -> api_enricher.SyscallsResponse.Executables
+	0,  // 5: api_enricher.Enricher.Syscalls:input_type -> api_enricher.SyscallsRequest
+	0,  // 6: api_enricher.Enricher.ResetSyscalls:input_type -> api_enricher.SyscallsRequest
+	0,  // 7: api_enricher.Enricher.CollectSyscalls:input_type -> api_enricher.SyscallsRequest
+	0,  // 8: api_enricher.Enricher.SyscallsStream:input_type -> api_enricher.SyscallsRequest
+	3,  // 9: api_enricher.Enricher.Avcs:input_type -> api_enricher.AvcRequest
+	3,  // 10: api_enricher.Enricher.ResetAvcs:input_type -> api_enricher.AvcRequest
+	3,  // 11: api_enricher.Enricher.CollectAvcs:input_type -> api_enricher.AvcRequest
+	5,  // 12: api_enricher.Enricher.Apparmor:input_type -> api_enricher.ApparmorRequest
+	5,  // 13: api_enricher.Enricher.ResetApparmor:input_type -> api_enricher.ApparmorRequest
+	1,  // 14: api_enricher.Enricher.Syscalls:output_type -> api_enricher.SyscallsResponse
+	7,  // 15: api_enricher.Enricher.ResetSyscalls:output_type -> api_enricher.EmptyResponse
+	1,  // 16: api_enricher.Enricher.CollectSyscalls:output_type -> api_enricher.SyscallsResponse
+	2,  // 17: api_enricher.Enricher.SyscallsStream:output_type -> api_enricher.SyscallEvent
+	4,  // 18: api_enricher.Enricher.Avcs:output_type -> api_enricher.AvcResponse
+	7,  // 19: api_enricher.Enricher.ResetAvcs:output_type -> api_enricher.EmptyResponse
+	4,  // 20: api_enricher.Enricher.CollectAvcs:output_type -> api_enricher.AvcResponse
+	6,  // 21: api_enricher.Enricher.Apparmor:output_type -> api_enricher.ApparmorResponse
+	7,  // 22: api_enricher.Enricher.ResetApparmor:output_type -> api_enricher.EmptyResponse
+	14, // [14:23] is the sub-list for method output_type
+	5,  // [5:14] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_grpc_enricher_api_proto_init() }
@@ -460,7 +891,7 @@ func file_api_grpc_enricher_api_proto_init() {
 			}
 		}
 		file_api_grpc_enricher_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AvcRequest); i {
+			switch v := v.(*SyscallEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -472,7 +903,7 @@ func file_api_grpc_enricher_api_proto_init() {
 			}
 		}
 		file_api_grpc_enricher_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AvcResponse); i {
+			switch v := v.(*AvcRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -484,7 +915,7 @@ func file_api_grpc_enricher_api_proto_init() {
 			}
 		}
 		file_api_grpc_enricher_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyResponse); i {
+			switch v := v.(*AvcResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -496,6 +927,54 @@ func file_api_grpc_enricher_api_proto_init() {
 			}
 		}
 		file_api_grpc_enricher_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApparmorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_enricher_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApparmorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_enricher_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_enricher_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyscallsResponse_Executables); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_enricher_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AvcResponse_SelinuxAvc); i {
 			case 0:
 				return &v.state
@@ -507,6 +986,18 @@ func file_api_grpc_enricher_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_grpc_enricher_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApparmorResponse_ApparmorEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -514,7 +1005,7 @@ func file_api_grpc_enricher_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_enricher_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
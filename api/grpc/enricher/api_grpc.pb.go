@@ -34,10 +34,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Enricher_Syscalls_FullMethodName      = "/api_enricher.Enricher/Syscalls"
-	Enricher_ResetSyscalls_FullMethodName = "/api_enricher.Enricher/ResetSyscalls"
-	Enricher_Avcs_FullMethodName          = "/api_enricher.Enricher/Avcs"
-	Enricher_ResetAvcs_FullMethodName     = "/api_enricher.Enricher/ResetAvcs"
+	Enricher_Syscalls_FullMethodName        = "/api_enricher.Enricher/Syscalls"
+	Enricher_ResetSyscalls_FullMethodName   = "/api_enricher.Enricher/ResetSyscalls"
+	Enricher_CollectSyscalls_FullMethodName = "/api_enricher.Enricher/CollectSyscalls"
+	Enricher_SyscallsStream_FullMethodName  = "/api_enricher.Enricher/SyscallsStream"
+	Enricher_Avcs_FullMethodName            = "/api_enricher.Enricher/Avcs"
+	Enricher_ResetAvcs_FullMethodName       = "/api_enricher.Enricher/ResetAvcs"
+	Enricher_CollectAvcs_FullMethodName     = "/api_enricher.Enricher/CollectAvcs"
+	Enricher_Apparmor_FullMethodName        = "/api_enricher.Enricher/Apparmor"
+	Enricher_ResetApparmor_FullMethodName   = "/api_enricher.Enricher/ResetApparmor"
 )
 
 // EnricherClient is the client API for Enricher service.
@@ -46,8 +51,13 @@ const (
 type EnricherClient interface {
 	Syscalls(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (*SyscallsResponse, error)
 	ResetSyscalls(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	CollectSyscalls(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (*SyscallsResponse, error)
+	SyscallsStream(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (Enricher_SyscallsStreamClient, error)
 	Avcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*AvcResponse, error)
 	ResetAvcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	CollectAvcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*AvcResponse, error)
+	Apparmor(ctx context.Context, in *ApparmorRequest, opts ...grpc.CallOption) (*ApparmorResponse, error)
+	ResetApparmor(ctx context.Context, in *ApparmorRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type enricherClient struct {
@@ -76,6 +86,47 @@ func (c *enricherClient) ResetSyscalls(ctx context.Context, in *SyscallsRequest,
 	return out, nil
 }
 
+func (c *enricherClient) CollectSyscalls(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (*SyscallsResponse, error) {
+	out := new(SyscallsResponse)
+	err := c.cc.Invoke(ctx, Enricher_CollectSyscalls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) SyscallsStream(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (Enricher_SyscallsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Enricher_ServiceDesc.Streams[0], Enricher_SyscallsStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enricherSyscallsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Enricher_SyscallsStreamClient interface {
+	Recv() (*SyscallEvent, error)
+	grpc.ClientStream
+}
+
+type enricherSyscallsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *enricherSyscallsStreamClient) Recv() (*SyscallEvent, error) {
+	m := new(SyscallEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *enricherClient) Avcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*AvcResponse, error) {
 	out := new(AvcResponse)
 	err := c.cc.Invoke(ctx, Enricher_Avcs_FullMethodName, in, out, opts...)
@@ -94,14 +145,46 @@ func (c *enricherClient) ResetAvcs(ctx context.Context, in *AvcRequest, opts ...
 	return out, nil
 }
 
+func (c *enricherClient) CollectAvcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*AvcResponse, error) {
+	out := new(AvcResponse)
+	err := c.cc.Invoke(ctx, Enricher_CollectAvcs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) Apparmor(ctx context.Context, in *ApparmorRequest, opts ...grpc.CallOption) (*ApparmorResponse, error) {
+	out := new(ApparmorResponse)
+	err := c.cc.Invoke(ctx, Enricher_Apparmor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) ResetApparmor(ctx context.Context, in *ApparmorRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, Enricher_ResetApparmor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnricherServer is the server API for Enricher service.
 // All implementations must embed UnimplementedEnricherServer
 // for forward compatibility
 type EnricherServer interface {
 	Syscalls(context.Context, *SyscallsRequest) (*SyscallsResponse, error)
 	ResetSyscalls(context.Context, *SyscallsRequest) (*EmptyResponse, error)
+	CollectSyscalls(context.Context, *SyscallsRequest) (*SyscallsResponse, error)
+	SyscallsStream(*SyscallsRequest, Enricher_SyscallsStreamServer) error
 	Avcs(context.Context, *AvcRequest) (*AvcResponse, error)
 	ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error)
+	CollectAvcs(context.Context, *AvcRequest) (*AvcResponse, error)
+	Apparmor(context.Context, *ApparmorRequest) (*ApparmorResponse, error)
+	ResetApparmor(context.Context, *ApparmorRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedEnricherServer()
 }
 
@@ -115,12 +198,27 @@ func (UnimplementedEnricherServer) Syscalls(context.Context, *SyscallsRequest) (
 func (UnimplementedEnricherServer) ResetSyscalls(context.Context, *SyscallsRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetSyscalls not implemented")
 }
+func (UnimplementedEnricherServer) CollectSyscalls(context.Context, *SyscallsRequest) (*SyscallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectSyscalls not implemented")
+}
+func (UnimplementedEnricherServer) SyscallsStream(*SyscallsRequest, Enricher_SyscallsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyscallsStream not implemented")
+}
 func (UnimplementedEnricherServer) Avcs(context.Context, *AvcRequest) (*AvcResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Avcs not implemented")
 }
 func (UnimplementedEnricherServer) ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetAvcs not implemented")
 }
+func (UnimplementedEnricherServer) CollectAvcs(context.Context, *AvcRequest) (*AvcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectAvcs not implemented")
+}
+func (UnimplementedEnricherServer) Apparmor(context.Context, *ApparmorRequest) (*ApparmorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apparmor not implemented")
+}
+func (UnimplementedEnricherServer) ResetApparmor(context.Context, *ApparmorRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetApparmor not implemented")
+}
 func (UnimplementedEnricherServer) mustEmbedUnimplementedEnricherServer() {}
 
 // UnsafeEnricherServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +268,45 @@ func _Enricher_ResetSyscalls_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Enricher_CollectSyscalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyscallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).CollectSyscalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_CollectSyscalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).CollectSyscalls(ctx, req.(*SyscallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_SyscallsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyscallsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnricherServer).SyscallsStream(m, &enricherSyscallsStreamServer{stream})
+}
+
+type Enricher_SyscallsStreamServer interface {
+	Send(*SyscallEvent) error
+	grpc.ServerStream
+}
+
+type enricherSyscallsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *enricherSyscallsStreamServer) Send(m *SyscallEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Enricher_Avcs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AvcRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +343,60 @@ func _Enricher_ResetAvcs_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Enricher_CollectAvcs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).CollectAvcs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_CollectAvcs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).CollectAvcs(ctx, req.(*AvcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_Apparmor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApparmorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).Apparmor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_Apparmor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).Apparmor(ctx, req.(*ApparmorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_ResetApparmor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApparmorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).ResetApparmor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_ResetApparmor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).ResetApparmor(ctx, req.(*ApparmorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Enricher_ServiceDesc is the grpc.ServiceDesc for Enricher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +412,10 @@ var Enricher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetSyscalls",
 			Handler:    _Enricher_ResetSyscalls_Handler,
 		},
+		{
+			MethodName: "CollectSyscalls",
+			Handler:    _Enricher_CollectSyscalls_Handler,
+		},
 		{
 			MethodName: "Avcs",
 			Handler:    _Enricher_Avcs_Handler,
@@ -229,7 +424,25 @@ var Enricher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetAvcs",
 			Handler:    _Enricher_ResetAvcs_Handler,
 		},
+		{
+			MethodName: "CollectAvcs",
+			Handler:    _Enricher_CollectAvcs_Handler,
+		},
+		{
+			MethodName: "Apparmor",
+			Handler:    _Enricher_Apparmor_Handler,
+		},
+		{
+			MethodName: "ResetApparmor",
+			Handler:    _Enricher_ResetApparmor_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SyscallsStream",
+			Handler:       _Enricher_SyscallsStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/enricher/api.proto",
 }
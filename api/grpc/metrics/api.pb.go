@@ -24,6 +24,7 @@ package api_metrics
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -47,6 +48,8 @@ type AuditRequest struct {
 	Executable string                        `protobuf:"bytes,5,opt,name=executable,proto3" json:"executable,omitempty"`
 	SeccompReq *AuditRequest_SeccompAuditReq `protobuf:"bytes,6,opt,name=seccompReq,proto3" json:"seccompReq,omitempty"`
 	SelinuxReq *AuditRequest_SelinuxAuditReq `protobuf:"bytes,7,opt,name=selinuxReq,proto3" json:"selinuxReq,omitempty"`
+	LagSeconds float64                       `protobuf:"fixed64,8,opt,name=lag_seconds,json=lagSeconds,proto3" json:"lag_seconds,omitempty"`
+	EventTime  *timestamppb.Timestamp        `protobuf:"bytes,9,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
 }
 
 func (x *AuditRequest) Reset() {
@@ -130,6 +133,67 @@ func (x *AuditRequest) GetSelinuxReq() *AuditRequest_SelinuxAuditReq {
 	return nil
 }
 
+func (x *AuditRequest) GetLagSeconds() float64 {
+	if x != nil {
+		return x.LagSeconds
+	}
+	return 0
+}
+
+func (x *AuditRequest) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+type AuditBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*AuditRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *AuditBatchRequest) Reset() {
+	*x = AuditBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditBatchRequest) ProtoMessage() {}
+
+func (x *AuditBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditBatchRequest.ProtoReflect.Descriptor instead.
+func (*AuditBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditBatchRequest) GetRequests() []*AuditRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
 type BpfRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -143,7 +207,7 @@ type BpfRequest struct {
 func (x *BpfRequest) Reset() {
 	*x = BpfRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_metrics_api_proto_msgTypes[1]
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -156,7 +220,7 @@ func (x *BpfRequest) String() string {
 func (*BpfRequest) ProtoMessage() {}
 
 func (x *BpfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[1]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +233,7 @@ func (x *BpfRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BpfRequest.ProtoReflect.Descriptor instead.
 func (*BpfRequest) Descriptor() ([]byte, []int) {
-	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{1}
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *BpfRequest) GetNode() string {
@@ -193,6 +257,171 @@ func (x *BpfRequest) GetProfile() string {
 	return ""
 }
 
+type ContainerIDResolutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node     string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Resolver string `protobuf:"bytes,2,opt,name=resolver,proto3" json:"resolver,omitempty"`
+}
+
+func (x *ContainerIDResolutionRequest) Reset() {
+	*x = ContainerIDResolutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerIDResolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerIDResolutionRequest) ProtoMessage() {}
+
+func (x *ContainerIDResolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerIDResolutionRequest.ProtoReflect.Descriptor instead.
+func (*ContainerIDResolutionRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ContainerIDResolutionRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ContainerIDResolutionRequest) GetResolver() string {
+	if x != nil {
+		return x.Resolver
+	}
+	return ""
+}
+
+type EnricherEvictionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *EnricherEvictionRequest) Reset() {
+	*x = EnricherEvictionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnricherEvictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnricherEvictionRequest) ProtoMessage() {}
+
+func (x *EnricherEvictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnricherEvictionRequest.ProtoReflect.Descriptor instead.
+func (*EnricherEvictionRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnricherEvictionRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *EnricherEvictionRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type SyscallStreamDropRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node  string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SyscallStreamDropRequest) Reset() {
+	*x = SyscallStreamDropRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyscallStreamDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyscallStreamDropRequest) ProtoMessage() {}
+
+func (x *SyscallStreamDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyscallStreamDropRequest.ProtoReflect.Descriptor instead.
+func (*SyscallStreamDropRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SyscallStreamDropRequest) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *SyscallStreamDropRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -202,7 +431,7 @@ type EmptyResponse struct {
 func (x *EmptyResponse) Reset() {
 	*x = EmptyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_metrics_api_proto_msgTypes[2]
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -215,7 +444,7 @@ func (x *EmptyResponse) String() string {
 func (*EmptyResponse) ProtoMessage() {}
 
 func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[2]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +457,7 @@ func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{2}
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{6}
 }
 
 type AuditRequest_SeccompAuditReq struct {
@@ -242,7 +471,7 @@ type AuditRequest_SeccompAuditReq struct {
 func (x *AuditRequest_SeccompAuditReq) Reset() {
 	*x = AuditRequest_SeccompAuditReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_metrics_api_proto_msgTypes[3]
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -255,7 +484,7 @@ func (x *AuditRequest_SeccompAuditReq) String() string {
 func (*AuditRequest_SeccompAuditReq) ProtoMessage() {}
 
 func (x *AuditRequest_SeccompAuditReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[3]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,12 +514,14 @@ type AuditRequest_SelinuxAuditReq struct {
 
 	Scontext string `protobuf:"bytes,1,opt,name=scontext,proto3" json:"scontext,omitempty"`
 	Tcontext string `protobuf:"bytes,2,opt,name=tcontext,proto3" json:"tcontext,omitempty"`
+	Tclass   string `protobuf:"bytes,3,opt,name=tclass,proto3" json:"tclass,omitempty"`
+	Perm     string `protobuf:"bytes,4,opt,name=perm,proto3" json:"perm,omitempty"`
 }
 
 func (x *AuditRequest_SelinuxAuditReq) Reset() {
 	*x = AuditRequest_SelinuxAuditReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_metrics_api_proto_msgTypes[4]
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -303,7 +534,7 @@ func (x *AuditRequest_SelinuxAuditReq) String() string {
 func (*AuditRequest_SelinuxAuditReq) ProtoMessage() {}
 
 func (x *AuditRequest_SelinuxAuditReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_metrics_api_proto_msgTypes[4]
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,56 +564,122 @@ func (x *AuditRequest_SelinuxAuditReq) GetTcontext() string {
 	return ""
 }
 
+func (x *AuditRequest_SelinuxAuditReq) GetTclass() string {
+	if x != nil {
+		return x.Tclass
+	}
+	return ""
+}
+
+func (x *AuditRequest_SelinuxAuditReq) GetPerm() string {
+	if x != nil {
+		return x.Perm
+	}
+	return ""
+}
+
 var File_api_grpc_metrics_api_proto protoreflect.FileDescriptor
 
 var file_api_grpc_metrics_api_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69,
 	0x63, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70,
-	0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x0c, 0x41, 0x75,
-	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x70, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x0a,
-	0x73, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41,
-	0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x63,
-	0x6f, 0x6d, 0x70, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x52, 0x0a, 0x73, 0x65, 0x63,
-	0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x12, 0x49, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x69, 0x6e,
-	0x75, 0x78, 0x52, 0x65, 0x71, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70,
-	0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41, 0x75,
-	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x52,
-	0x65, 0x71, 0x1a, 0x2b, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x41, 0x75, 0x64,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x1a,
-	0x49, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x63, 0x0a, 0x0a, 0x42, 0x70,
-	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22,
-	0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x93, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x45, 0x0a, 0x08,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x28, 0x01, 0x12, 0x41, 0x0a, 0x06, 0x42, 0x70, 0x66, 0x49, 0x6e, 0x63, 0x12, 0x17, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x70, 0x66, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x42, 0x0e, 0x5a, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa6, 0x04, 0x0a, 0x0c, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x49, 0x0a,
+	0x0a, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x63,
+	0x63, 0x6f, 0x6d, 0x70, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x52, 0x0a, 0x73, 0x65,
+	0x63, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x12, 0x49, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x52, 0x65, 0x71, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x67, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x1a,
+	0x2b, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x1a, 0x75, 0x0a, 0x0f,
+	0x53, 0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x65, 0x72, 0x6d, 0x22, 0x4a, 0x0a, 0x11, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22,
+	0x63, 0x0a, 0x0a, 0x42, 0x70, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0x4e, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x17, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72,
+	0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x44, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x63, 0x61,
+	0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0f, 0x0a,
+	0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x87,
+	0x04, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x12, 0x4f, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x63, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x12, 0x41, 0x0a, 0x06, 0x42, 0x70, 0x66, 0x49, 0x6e, 0x63, 0x12, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x70, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x65, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x63, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5b, 0x0a, 0x13,
+	0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x63, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x45, 0x76, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5d, 0x0a, 0x14, 0x53, 0x79, 0x73,
+	0x63, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e,
+	0x63, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x72, 0x6f,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x42, 0x0e, 0x5a, 0x0c, 0x2f, 0x61, 0x70, 0x69,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -397,26 +694,41 @@ func file_api_grpc_metrics_api_proto_rawDescGZIP() []byte {
 	return file_api_grpc_metrics_api_proto_rawDescData
 }
 
-var file_api_grpc_metrics_api_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_grpc_metrics_api_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_api_grpc_metrics_api_proto_goTypes = []interface{}{
 	(*AuditRequest)(nil),                 // 0: api_metrics.AuditRequest
-	(*BpfRequest)(nil),                   // 1: api_metrics.BpfRequest
-	(*EmptyResponse)(nil),                // 2: api_metrics.EmptyResponse
-	(*AuditRequest_SeccompAuditReq)(nil), // 3: api_metrics.AuditRequest.SeccompAuditReq
-	(*AuditRequest_SelinuxAuditReq)(nil), // 4: api_metrics.AuditRequest.SelinuxAuditReq
+	(*AuditBatchRequest)(nil),            // 1: api_metrics.AuditBatchRequest
+	(*BpfRequest)(nil),                   // 2: api_metrics.BpfRequest
+	(*ContainerIDResolutionRequest)(nil), // 3: api_metrics.ContainerIDResolutionRequest
+	(*EnricherEvictionRequest)(nil),      // 4: api_metrics.EnricherEvictionRequest
+	(*SyscallStreamDropRequest)(nil),     // 5: api_metrics.SyscallStreamDropRequest
+	(*EmptyResponse)(nil),                // 6: api_metrics.EmptyResponse
+	(*AuditRequest_SeccompAuditReq)(nil), // 7: api_metrics.AuditRequest.SeccompAuditReq
+	(*AuditRequest_SelinuxAuditReq)(nil), // 8: api_metrics.AuditRequest.SelinuxAuditReq
+	(*timestamppb.Timestamp)(nil),        // 9: google.protobuf.Timestamp
 }
 var file_api_grpc_metrics_api_proto_depIdxs = []int32{
-	3, // 0: api_metrics.AuditRequest.seccompReq:type_name -> api_metrics.AuditRequest.SeccompAuditReq
-	4, // 1: api_metrics.AuditRequest.selinuxReq:type_name -> api_metrics.AuditRequest.SelinuxAuditReq
-	0, // 2: api_metrics.Metrics.AuditInc:input_type -> api_metrics.AuditRequest
-	1, // 3: api_metrics.Metrics.BpfInc:input_type -> api_metrics.BpfRequest
-	2, // 4: api_metrics.Metrics.AuditInc:output_type -> api_metrics.EmptyResponse
-	2, // 5: api_metrics.Metrics.BpfInc:output_type -> api_metrics.EmptyResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	7,  // 0: api_metrics.AuditRequest.seccompReq:type_name -> api_metrics.AuditRequest.SeccompAuditReq
+	8,  // 1: api_metrics.AuditRequest.selinuxReq:type_name -> api_metrics.AuditRequest.SelinuxAuditReq
+	9,  // 2: api_metrics.AuditRequest.event_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: api_metrics.AuditBatchRequest.requests:type_name -> api_metrics.AuditRequest
+	0,  // 4: api_metrics.Metrics.AuditInc:input_type -> api_metrics.AuditRequest
+	1,  // 5: api_metrics.Metrics.AuditBatchInc:input_type -> api_metrics.AuditBatchRequest
+	2,  // 6: api_metrics.Metrics.BpfInc:input_type -> api_metrics.BpfRequest
+	3,  // 7: api_metrics.Metrics.ContainerIDResolutionInc:input_type -> api_metrics.ContainerIDResolutionRequest
+	4,  // 8: api_metrics.Metrics.EnricherEvictionInc:input_type -> api_metrics.EnricherEvictionRequest
+	5,  // 9: api_metrics.Metrics.SyscallStreamDropInc:input_type -> api_metrics.SyscallStreamDropRequest
+	6,  // 10: api_metrics.Metrics.AuditInc:output_type -> api_metrics.EmptyResponse
+	6,  // 11: api_metrics.Metrics.AuditBatchInc:output_type -> api_metrics.EmptyResponse
+	6,  // 12: api_metrics.Metrics.BpfInc:output_type -> api_metrics.EmptyResponse
+	6,  // 13: api_metrics.Metrics.ContainerIDResolutionInc:output_type -> api_metrics.EmptyResponse
+	6,  // 14: api_metrics.Metrics.EnricherEvictionInc:output_type -> api_metrics.EmptyResponse
+	6,  // 15: api_metrics.Metrics.SyscallStreamDropInc:output_type -> api_metrics.EmptyResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_api_grpc_metrics_api_proto_init() }
@@ -438,7 +750,7 @@ func file_api_grpc_metrics_api_proto_init() {
 			}
 		}
 		file_api_grpc_metrics_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BpfRequest); i {
+			switch v := v.(*AuditBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -450,7 +762,7 @@ func file_api_grpc_metrics_api_proto_init() {
 			}
 		}
 		file_api_grpc_metrics_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyResponse); i {
+			switch v := v.(*BpfRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -462,7 +774,7 @@ func file_api_grpc_metrics_api_proto_init() {
 			}
 		}
 		file_api_grpc_metrics_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuditRequest_SeccompAuditReq); i {
+			switch v := v.(*ContainerIDResolutionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -474,6 +786,54 @@ func file_api_grpc_metrics_api_proto_init() {
 			}
 		}
 		file_api_grpc_metrics_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnricherEvictionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_metrics_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyscallStreamDropRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_metrics_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_metrics_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditRequest_SeccompAuditReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_metrics_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuditRequest_SelinuxAuditReq); i {
 			case 0:
 				return &v.state
@@ -492,7 +852,7 @@ func file_api_grpc_metrics_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_metrics_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
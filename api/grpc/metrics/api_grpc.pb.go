@@ -34,8 +34,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Metrics_AuditInc_FullMethodName = "/api_metrics.Metrics/AuditInc"
-	Metrics_BpfInc_FullMethodName   = "/api_metrics.Metrics/BpfInc"
+	Metrics_AuditInc_FullMethodName                 = "/api_metrics.Metrics/AuditInc"
+	Metrics_AuditBatchInc_FullMethodName            = "/api_metrics.Metrics/AuditBatchInc"
+	Metrics_BpfInc_FullMethodName                   = "/api_metrics.Metrics/BpfInc"
+	Metrics_ContainerIDResolutionInc_FullMethodName = "/api_metrics.Metrics/ContainerIDResolutionInc"
+	Metrics_EnricherEvictionInc_FullMethodName      = "/api_metrics.Metrics/EnricherEvictionInc"
+	Metrics_SyscallStreamDropInc_FullMethodName     = "/api_metrics.Metrics/SyscallStreamDropInc"
 )
 
 // MetricsClient is the client API for Metrics service.
@@ -43,7 +47,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MetricsClient interface {
 	AuditInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_AuditIncClient, error)
+	AuditBatchInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_AuditBatchIncClient, error)
 	BpfInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_BpfIncClient, error)
+	ContainerIDResolutionInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_ContainerIDResolutionIncClient, error)
+	EnricherEvictionInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_EnricherEvictionIncClient, error)
+	SyscallStreamDropInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_SyscallStreamDropIncClient, error)
 }
 
 type metricsClient struct {
@@ -88,8 +96,42 @@ func (x *metricsAuditIncClient) CloseAndRecv() (*EmptyResponse, error) {
 	return m, nil
 }
 
+func (c *metricsClient) AuditBatchInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_AuditBatchIncClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Metrics_ServiceDesc.Streams[1], Metrics_AuditBatchInc_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metricsAuditBatchIncClient{stream}
+	return x, nil
+}
+
+type Metrics_AuditBatchIncClient interface {
+	Send(*AuditBatchRequest) error
+	CloseAndRecv() (*EmptyResponse, error)
+	grpc.ClientStream
+}
+
+type metricsAuditBatchIncClient struct {
+	grpc.ClientStream
+}
+
+func (x *metricsAuditBatchIncClient) Send(m *AuditBatchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *metricsAuditBatchIncClient) CloseAndRecv() (*EmptyResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(EmptyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *metricsClient) BpfInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_BpfIncClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Metrics_ServiceDesc.Streams[1], Metrics_BpfInc_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Metrics_ServiceDesc.Streams[2], Metrics_BpfInc_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -122,12 +164,118 @@ func (x *metricsBpfIncClient) CloseAndRecv() (*EmptyResponse, error) {
 	return m, nil
 }
 
+func (c *metricsClient) ContainerIDResolutionInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_ContainerIDResolutionIncClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Metrics_ServiceDesc.Streams[3], Metrics_ContainerIDResolutionInc_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metricsContainerIDResolutionIncClient{stream}
+	return x, nil
+}
+
+type Metrics_ContainerIDResolutionIncClient interface {
+	Send(*ContainerIDResolutionRequest) error
+	CloseAndRecv() (*EmptyResponse, error)
+	grpc.ClientStream
+}
+
+type metricsContainerIDResolutionIncClient struct {
+	grpc.ClientStream
+}
+
+func (x *metricsContainerIDResolutionIncClient) Send(m *ContainerIDResolutionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *metricsContainerIDResolutionIncClient) CloseAndRecv() (*EmptyResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(EmptyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metricsClient) EnricherEvictionInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_EnricherEvictionIncClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Metrics_ServiceDesc.Streams[4], Metrics_EnricherEvictionInc_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metricsEnricherEvictionIncClient{stream}
+	return x, nil
+}
+
+type Metrics_EnricherEvictionIncClient interface {
+	Send(*EnricherEvictionRequest) error
+	CloseAndRecv() (*EmptyResponse, error)
+	grpc.ClientStream
+}
+
+type metricsEnricherEvictionIncClient struct {
+	grpc.ClientStream
+}
+
+func (x *metricsEnricherEvictionIncClient) Send(m *EnricherEvictionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *metricsEnricherEvictionIncClient) CloseAndRecv() (*EmptyResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(EmptyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metricsClient) SyscallStreamDropInc(ctx context.Context, opts ...grpc.CallOption) (Metrics_SyscallStreamDropIncClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Metrics_ServiceDesc.Streams[5], Metrics_SyscallStreamDropInc_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metricsSyscallStreamDropIncClient{stream}
+	return x, nil
+}
+
+type Metrics_SyscallStreamDropIncClient interface {
+	Send(*SyscallStreamDropRequest) error
+	CloseAndRecv() (*EmptyResponse, error)
+	grpc.ClientStream
+}
+
+type metricsSyscallStreamDropIncClient struct {
+	grpc.ClientStream
+}
+
+func (x *metricsSyscallStreamDropIncClient) Send(m *SyscallStreamDropRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *metricsSyscallStreamDropIncClient) CloseAndRecv() (*EmptyResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(EmptyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // MetricsServer is the server API for Metrics service.
 // All implementations must embed UnimplementedMetricsServer
 // for forward compatibility
 type MetricsServer interface {
 	AuditInc(Metrics_AuditIncServer) error
+	AuditBatchInc(Metrics_AuditBatchIncServer) error
 	BpfInc(Metrics_BpfIncServer) error
+	ContainerIDResolutionInc(Metrics_ContainerIDResolutionIncServer) error
+	EnricherEvictionInc(Metrics_EnricherEvictionIncServer) error
+	SyscallStreamDropInc(Metrics_SyscallStreamDropIncServer) error
 	mustEmbedUnimplementedMetricsServer()
 }
 
@@ -138,9 +286,21 @@ type UnimplementedMetricsServer struct {
 func (UnimplementedMetricsServer) AuditInc(Metrics_AuditIncServer) error {
 	return status.Errorf(codes.Unimplemented, "method AuditInc not implemented")
 }
+func (UnimplementedMetricsServer) AuditBatchInc(Metrics_AuditBatchIncServer) error {
+	return status.Errorf(codes.Unimplemented, "method AuditBatchInc not implemented")
+}
 func (UnimplementedMetricsServer) BpfInc(Metrics_BpfIncServer) error {
 	return status.Errorf(codes.Unimplemented, "method BpfInc not implemented")
 }
+func (UnimplementedMetricsServer) ContainerIDResolutionInc(Metrics_ContainerIDResolutionIncServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerIDResolutionInc not implemented")
+}
+func (UnimplementedMetricsServer) EnricherEvictionInc(Metrics_EnricherEvictionIncServer) error {
+	return status.Errorf(codes.Unimplemented, "method EnricherEvictionInc not implemented")
+}
+func (UnimplementedMetricsServer) SyscallStreamDropInc(Metrics_SyscallStreamDropIncServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyscallStreamDropInc not implemented")
+}
 func (UnimplementedMetricsServer) mustEmbedUnimplementedMetricsServer() {}
 
 // UnsafeMetricsServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +340,32 @@ func (x *metricsAuditIncServer) Recv() (*AuditRequest, error) {
 	return m, nil
 }
 
+func _Metrics_AuditBatchInc_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MetricsServer).AuditBatchInc(&metricsAuditBatchIncServer{stream})
+}
+
+type Metrics_AuditBatchIncServer interface {
+	SendAndClose(*EmptyResponse) error
+	Recv() (*AuditBatchRequest, error)
+	grpc.ServerStream
+}
+
+type metricsAuditBatchIncServer struct {
+	grpc.ServerStream
+}
+
+func (x *metricsAuditBatchIncServer) SendAndClose(m *EmptyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *metricsAuditBatchIncServer) Recv() (*AuditBatchRequest, error) {
+	m := new(AuditBatchRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Metrics_BpfInc_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(MetricsServer).BpfInc(&metricsBpfIncServer{stream})
 }
@@ -206,6 +392,84 @@ func (x *metricsBpfIncServer) Recv() (*BpfRequest, error) {
 	return m, nil
 }
 
+func _Metrics_ContainerIDResolutionInc_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MetricsServer).ContainerIDResolutionInc(&metricsContainerIDResolutionIncServer{stream})
+}
+
+type Metrics_ContainerIDResolutionIncServer interface {
+	SendAndClose(*EmptyResponse) error
+	Recv() (*ContainerIDResolutionRequest, error)
+	grpc.ServerStream
+}
+
+type metricsContainerIDResolutionIncServer struct {
+	grpc.ServerStream
+}
+
+func (x *metricsContainerIDResolutionIncServer) SendAndClose(m *EmptyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *metricsContainerIDResolutionIncServer) Recv() (*ContainerIDResolutionRequest, error) {
+	m := new(ContainerIDResolutionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Metrics_EnricherEvictionInc_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MetricsServer).EnricherEvictionInc(&metricsEnricherEvictionIncServer{stream})
+}
+
+type Metrics_EnricherEvictionIncServer interface {
+	SendAndClose(*EmptyResponse) error
+	Recv() (*EnricherEvictionRequest, error)
+	grpc.ServerStream
+}
+
+type metricsEnricherEvictionIncServer struct {
+	grpc.ServerStream
+}
+
+func (x *metricsEnricherEvictionIncServer) SendAndClose(m *EmptyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *metricsEnricherEvictionIncServer) Recv() (*EnricherEvictionRequest, error) {
+	m := new(EnricherEvictionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Metrics_SyscallStreamDropInc_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MetricsServer).SyscallStreamDropInc(&metricsSyscallStreamDropIncServer{stream})
+}
+
+type Metrics_SyscallStreamDropIncServer interface {
+	SendAndClose(*EmptyResponse) error
+	Recv() (*SyscallStreamDropRequest, error)
+	grpc.ServerStream
+}
+
+type metricsSyscallStreamDropIncServer struct {
+	grpc.ServerStream
+}
+
+func (x *metricsSyscallStreamDropIncServer) SendAndClose(m *EmptyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *metricsSyscallStreamDropIncServer) Recv() (*SyscallStreamDropRequest, error) {
+	m := new(SyscallStreamDropRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Metrics_ServiceDesc is the grpc.ServiceDesc for Metrics service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -219,11 +483,31 @@ var Metrics_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Metrics_AuditInc_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "AuditBatchInc",
+			Handler:       _Metrics_AuditBatchInc_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "BpfInc",
 			Handler:       _Metrics_BpfInc_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ContainerIDResolutionInc",
+			Handler:       _Metrics_ContainerIDResolutionInc_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "EnricherEvictionInc",
+			Handler:       _Metrics_EnricherEvictionInc_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SyscallStreamDropInc",
+			Handler:       _Metrics_SyscallStreamDropInc_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "api/grpc/metrics/api.proto",
 }
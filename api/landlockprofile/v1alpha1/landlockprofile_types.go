@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+)
+
+var (
+	// Ensure LandlockProfile implements the StatusBaseUser and SecurityProfileBase interfaces.
+	_ profilebasev1alpha1.StatusBaseUser      = &LandlockProfile{}
+	_ profilebasev1alpha1.SecurityProfileBase = &LandlockProfile{}
+)
+
+// LandlockAccessFS is a filesystem access right of Landlock.
+// +kubebuilder:validation:Enum=execute;write_file;read_file;read_dir;remove_dir;remove_file;make_char;make_dir;make_reg;make_sock;make_fifo;make_block;make_sym;refer;truncate;ioctl_dev
+//
+//nolint:lll // required for kubebuilder
+type LandlockAccessFS string
+
+const (
+	LandlockAccessFSExecute    LandlockAccessFS = "execute"
+	LandlockAccessFSWriteFile  LandlockAccessFS = "write_file"
+	LandlockAccessFSReadFile   LandlockAccessFS = "read_file"
+	LandlockAccessFSReadDir    LandlockAccessFS = "read_dir"
+	LandlockAccessFSRemoveDir  LandlockAccessFS = "remove_dir"
+	LandlockAccessFSRemoveFile LandlockAccessFS = "remove_file"
+	LandlockAccessFSMakeChar   LandlockAccessFS = "make_char"
+	LandlockAccessFSMakeDir    LandlockAccessFS = "make_dir"
+	LandlockAccessFSMakeReg    LandlockAccessFS = "make_reg"
+	LandlockAccessFSMakeSock   LandlockAccessFS = "make_sock"
+	LandlockAccessFSMakeFifo   LandlockAccessFS = "make_fifo"
+	LandlockAccessFSMakeBlock  LandlockAccessFS = "make_block"
+	LandlockAccessFSMakeSym    LandlockAccessFS = "make_sym"
+	// LandlockAccessFSRefer requires Landlock ABI version 2 (Linux 5.19).
+	LandlockAccessFSRefer LandlockAccessFS = "refer"
+	// LandlockAccessFSTruncate requires Landlock ABI version 3 (Linux 6.2).
+	LandlockAccessFSTruncate LandlockAccessFS = "truncate"
+	// LandlockAccessFSIoctlDev requires Landlock ABI version 5 (Linux 6.10).
+	LandlockAccessFSIoctlDev LandlockAccessFS = "ioctl_dev"
+)
+
+// MinimumABI returns the Landlock ABI version which introduced the access
+// right. All access rights not listed explicitly are part of version 1.
+func (a LandlockAccessFS) MinimumABI() int {
+	switch a {
+	case LandlockAccessFSRefer:
+		return 2
+	case LandlockAccessFSTruncate:
+		return 3
+	case LandlockAccessFSIoctlDev:
+		return 5
+	default:
+		return 1
+	}
+}
+
+// LandlockPathRule grants filesystem access rights beneath a path.
+type LandlockPathRule struct {
+	// Path is the absolute path of the file or directory hierarchy the
+	// access rights are granted for.
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path"`
+
+	// AllowedAccess are the granted access rights, which have to be handled
+	// by the profile.
+	// +kubebuilder:validation:MinItems=1
+	AllowedAccess []LandlockAccessFS `json:"allowedAccess"`
+}
+
+// LandlockProfileSpec defines the desired state of LandlockProfile.
+type LandlockProfileSpec struct {
+	// Common spec fields for all profiles.
+	profilebasev1alpha1.SpecBase `json:",inline"`
+
+	// HandledAccessFS are the filesystem access rights restricted by the
+	// profile. Access rights which are not handled are always allowed.
+	// +kubebuilder:validation:MinItems=1
+	HandledAccessFS []LandlockAccessFS `json:"handledAccessFS"`
+
+	// Rules are the paths beneath which handled access rights are granted.
+	// Everything else is denied for the handled access rights.
+	// +optional
+	Rules []LandlockPathRule `json:"rules,omitempty"`
+}
+
+// LandlockProfileStatus defines the observed state of LandlockProfile.
+type LandlockProfileStatus struct {
+	profilebasev1alpha1.StatusBase `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// LandlockProfile is a specification for a Landlock filesystem ruleset, which
+// gets rendered on all nodes supporting Landlock (Linux 5.13 or later).
+// +kubebuilder:resource:shortName=ll
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type LandlockProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LandlockProfileSpec   `json:"spec,omitempty"`
+	Status LandlockProfileStatus `json:"status,omitempty"`
+}
+
+// MinimumABI returns the Landlock ABI version required by the access rights
+// of the profile.
+func (sp *LandlockProfile) MinimumABI() int {
+	abi := 1
+	for _, access := range sp.Spec.HandledAccessFS {
+		if v := access.MinimumABI(); v > abi {
+			abi = v
+		}
+	}
+	return abi
+}
+
+func (sp *LandlockProfile) GetStatusBase() *profilebasev1alpha1.StatusBase {
+	return &sp.Status.StatusBase
+}
+
+func (sp *LandlockProfile) DeepCopyToStatusBaseIf() profilebasev1alpha1.StatusBaseUser {
+	return sp.DeepCopy()
+}
+
+func (sp *LandlockProfile) SetImplementationStatus() {
+}
+
+func (sp *LandlockProfile) ListProfilesByRecording(
+	ctx context.Context,
+	cli client.Client,
+	recording string,
+) ([]metav1.Object, error) {
+	return profilebasev1alpha1.ListProfilesByRecording(ctx, cli, recording, sp.Namespace, &LandlockProfileList{})
+}
+
+func (sp *LandlockProfile) IsPartial() bool {
+	return profilebasev1alpha1.IsPartial(sp)
+}
+
+func (sp *LandlockProfile) IsDisabled() bool {
+	return profilebasev1alpha1.IsDisabled(&sp.Spec.SpecBase)
+}
+
+func (sp *LandlockProfile) IsReconcilable() bool {
+	return profilebasev1alpha1.IsReconcilable(sp)
+}
+
+// +kubebuilder:object:root=true
+
+// LandlockProfileList contains a list of LandlockProfile.
+type LandlockProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LandlockProfile `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to init the scheme
+	SchemeBuilder.Register(&LandlockProfile{}, &LandlockProfileList{})
+}
+
+func (sp *LandlockProfile) GetProfileName() string {
+	return sp.GetName()
+}
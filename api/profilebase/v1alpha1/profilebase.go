@@ -87,6 +87,24 @@ func ListProfilesByRecording(
 type StatusBase struct {
 	spodv1alpha1.ConditionedStatus `json:",inline"`
 	Status                         secprofnodestatusv1alpha1.ProfileState `json:"status,omitempty"`
+	// InstalledNodes is the number of nodes on which the profile is
+	// installed.
+	// +optional
+	InstalledNodes int32 `json:"installedNodes,omitempty"`
+	// FailedNodes lists the nodes on which the profile could not be
+	// installed.
+	// +optional
+	FailedNodes []string `json:"failedNodes,omitempty"`
+}
+
+// FailedNodesRatio returns the percentage of the nodes on which the profile
+// could not be installed.
+func (s *StatusBase) FailedNodesRatio() int32 {
+	failed := int32(len(s.FailedNodes))
+	if total := failed + s.InstalledNodes; total > 0 {
+		return failed * 100 / total
+	}
+	return 0
 }
 
 type StatusBaseUser interface {
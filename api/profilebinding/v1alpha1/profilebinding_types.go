@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 )
 
 type ProfileBindingKind string
@@ -27,12 +29,51 @@ const (
 	ProfileBindingKindSelinuxProfile ProfileBindingKind = "SelinuxProfile"
 )
 
+type ImageMatchType string
+
+const (
+	// ImageMatchTypeExact matches the image name exactly.
+	ImageMatchTypeExact ImageMatchType = "Exact"
+	// ImageMatchTypeGlob matches the image name against a shell pattern,
+	// like "registry.example.com/app:*".
+	ImageMatchTypeGlob ImageMatchType = "Glob"
+	// ImageMatchTypeRegex matches the whole image name against a regular
+	// expression, like "registry.example.com/app:v[0-9]+".
+	ImageMatchTypeRegex ImageMatchType = "Regex"
+)
+
 // ProfileBindingSpec defines the desired state of ProfileBinding.
 type ProfileBindingSpec struct {
 	// ProfileRef references a SeccompProfile or other profile type in the current namespace.
 	ProfileRef ProfileRef `json:"profileRef"`
 	// Image name within pod containers to match to the profile.
-	Image string `json:"image"`
+	// +optional
+	Image string `json:"image,omitempty"`
+	// MatchType defines how the image of the containers is matched against
+	// the image of the binding. Defaults to Exact.
+	// +kubebuilder:validation:Enum=Exact;Glob;Regex
+	// +optional
+	MatchType ImageMatchType `json:"matchType,omitempty"`
+	// Digest pins the binding to the images with the digest, for example
+	// "sha256:9a7e...". The image of the containers has to reference the
+	// digest, like "nginx:1.25@sha256:9a7e...". The image is matched
+	// without the digest in this case.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+	// EphemeralContainers indicates whether the profile should be bound to
+	// all ephemeral containers of the pods, for example debug containers
+	// injected by "kubectl debug", regardless of their image. The image is
+	// not matched in this case.
+	// +optional
+	EphemeralContainers bool `json:"ephemeralContainers,omitempty"`
+	// PodSelector restricts the binding to the pods matching the label
+	// selector. All pods of the namespace are selected if unset.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// OwnerSelector restricts the binding to the pods controlled by the
+	// selected owner, for example an Argo Workflow or a Tekton TaskRun.
+	// +optional
+	OwnerSelector *profilerecordingv1alpha1.OwnerSelector `json:"ownerSelector,omitempty"`
 }
 
 // ProfileRef contains information that points to the profile being used.
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ProfileBindingPolicySpec defines the profiles which are allowed to be bound
+// in the selected namespaces.
+type ProfileBindingPolicySpec struct {
+	// NamespaceSelector selects the namespaces to which the policy applies.
+	// This field follows standard label selector semantics. An empty
+	// namespaceSelector matches all namespaces.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// AllowedProfileNames are the names of the profiles which are allowed to
+	// be bound in the selected namespaces.
+	// +optional
+	AllowedProfileNames []string `json:"allowedProfileNames,omitempty"`
+
+	// AllowedProfileSelector selects the profiles which are allowed to be
+	// bound in the selected namespaces by their labels.
+	// +optional
+	AllowedProfileSelector *metav1.LabelSelector `json:"allowedProfileSelector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// ProfileBindingPolicy restricts the profiles which are allowed to be bound in
+// the selected namespaces. Namespaces which are selected by multiple policies
+// are allowed to bind the profiles allowed by any of them, while namespaces
+// without a policy are not restricted.
+type ProfileBindingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProfileBindingPolicySpec `json:"spec,omitempty"`
+}
+
+// SelectsNamespace returns true if the policy applies to the namespace with
+// the provided labels.
+func (p *ProfileBindingPolicy) SelectsNamespace(namespaceLabels map[string]string) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&p.Spec.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("parse namespace selector of policy %s: %w", p.Name, err)
+	}
+	return selector.Matches(labels.Set(namespaceLabels)), nil
+}
+
+// AllowsProfile returns true if the policy allows to bind the provided
+// profile.
+func (p *ProfileBindingPolicy) AllowsProfile(profile metav1.Object) (bool, error) {
+	for _, name := range p.Spec.AllowedProfileNames {
+		if name == profile.GetName() {
+			return true, nil
+		}
+	}
+
+	if p.Spec.AllowedProfileSelector == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(p.Spec.AllowedProfileSelector)
+	if err != nil {
+		return false, fmt.Errorf("parse profile selector of policy %s: %w", p.Name, err)
+	}
+	return selector.Matches(labels.Set(profile.GetLabels())), nil
+}
+
+// +kubebuilder:object:root=true
+
+// ProfileBindingPolicyList contains a list of ProfileBindingPolicy.
+type ProfileBindingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProfileBindingPolicy `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to register the scheme
+	SchemeBuilder.Register(&ProfileBindingPolicy{}, &ProfileBindingPolicyList{})
+}
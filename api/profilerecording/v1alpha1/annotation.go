@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CtrAnnotationVersion is the version of the payload written to the
+// annotations which request the recording of a container.
+const CtrAnnotationVersion = 2
+
+// CtrAnnotationPayload is the value of an annotation requesting the recording
+// of a container. Version 2 of the annotation carries the payload as JSON
+// document, while version 1 uses the format
+// "<recording>_<container>_<session>_<timestamp>", which is still parsed to
+// keep pods annotated by older operator versions working.
+// +kubebuilder:object:generate=false
+type CtrAnnotationPayload struct {
+	// Version of the annotation payload.
+	Version int `json:"version"`
+	// Recording is the name of the ProfileRecording.
+	Recording string `json:"recording"`
+	// Kinds are the profile kinds recorded for the container. Empty for
+	// version 1 annotations.
+	Kinds []ProfileRecordingKind `json:"kinds,omitempty"`
+	// Container is the name of the recorded container.
+	Container string `json:"container"`
+	// Session is the recording session of the pod.
+	Session string `json:"session,omitempty"`
+	// Timestamp is the unix time at which the pod got annotated.
+	Timestamp int64 `json:"timestamp"`
+	// MergeStrategy of the recording at the time the pod got annotated.
+	// Empty for version 1 annotations.
+	MergeStrategy ProfileMergeStrategy `json:"mergeStrategy,omitempty"`
+	// RuntimeBaseline of the recording at the time the pod got annotated.
+	// Nil for version 1 annotations.
+	RuntimeBaseline *bool `json:"runtimeBaseline,omitempty"`
+}
+
+// ParseCtrAnnotation parses the value of an annotation requesting the
+// recording of a container.
+func ParseCtrAnnotation(value string) (*CtrAnnotationPayload, error) {
+	if strings.HasPrefix(value, "{") {
+		payload := &CtrAnnotationPayload{}
+		if err := json.Unmarshal([]byte(value), payload); err != nil {
+			return nil, fmt.Errorf("invalid annotation: %s: %w", value, err)
+		}
+		if payload.Version != CtrAnnotationVersion {
+			return nil, fmt.Errorf("invalid annotation: %s, unsupported version %d", value, payload.Version)
+		}
+		if payload.Recording == "" || payload.Container == "" {
+			return nil, fmt.Errorf("invalid annotation: %s, recording and container are mandatory", value)
+		}
+		return payload, nil
+	}
+
+	const expectedParts = 4
+
+	parts := strings.Split(value, "_")
+	if len(parts) != expectedParts {
+		return nil,
+			fmt.Errorf("invalid annotation: %s, expected %d parts got %d", value, expectedParts, len(parts))
+	}
+
+	payload := &CtrAnnotationPayload{
+		Version:   1,
+		Recording: parts[0],
+		Container: parts[1],
+		Session:   parts[2],
+	}
+	// The timestamp is informational, which is why invalid ones are ignored
+	if timestamp, err := strconv.ParseInt(parts[3], 10, 64); err == nil {
+		payload.Timestamp = timestamp
+	}
+	return payload, nil
+}
+
+// String returns the annotation value of the payload in the format of its
+// version.
+func (p *CtrAnnotationPayload) String() string {
+	if p.Version < CtrAnnotationVersion {
+		return fmt.Sprintf("%s_%s_%s_%d", p.Recording, p.Container, p.Session, p.Timestamp)
+	}
+
+	// Marshalling cannot fail for the field types of the payload
+	value, err := json.Marshal(p)
+	if err != nil {
+		return ""
+	}
+	return string(value)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ProfileNameData contains the details of a recorded container which are
+// available to the profile name template of a recording.
+// +kubebuilder:object:generate=false
+type ProfileNameData struct {
+	// Container is the name of the recorded container.
+	Container string
+	// Owner is the name of the workload owning the recorded pod, for
+	// example the deployment, or the name of the pod itself if it has no
+	// controller.
+	Owner string
+	// Image is the image of the recorded container.
+	Image string
+	// Time is the time at which the profile gets collected.
+	Time time.Time
+}
+
+// profileNameFuncs are the functions available to profile name templates.
+var profileNameFuncs = map[string]func(pr *ProfileRecording, data *ProfileNameData) string{
+	"recording": func(pr *ProfileRecording, _ *ProfileNameData) string { return pr.GetName() },
+	"container": func(_ *ProfileRecording, data *ProfileNameData) string { return data.Container },
+	"owner":     func(_ *ProfileRecording, data *ProfileNameData) string { return data.Owner },
+	"imageTag":  func(_ *ProfileRecording, data *ProfileNameData) string { return imageTag(data.Image) },
+	"date":      func(_ *ProfileRecording, data *ProfileNameData) string { return data.Time.UTC().Format("20060102") },
+}
+
+// ProfileName returns the name of the profile recorded for a container,
+// which is rendered from the ProfileNameTemplate if set and
+// "<recording>-<container>" otherwise.
+func (pr *ProfileRecording) ProfileName(data *ProfileNameData) (string, error) {
+	if pr.Spec.ProfileNameTemplate == "" {
+		return fmt.Sprintf("%s-%s", pr.GetName(), data.Container), nil
+	}
+
+	funcs := template.FuncMap{}
+	for name, fn := range profileNameFuncs {
+		fn := fn
+		funcs[name] = func() string { return fn(pr, data) }
+	}
+	tmpl, err := template.New("profileName").Funcs(funcs).Parse(pr.Spec.ProfileNameTemplate)
+	if err != nil {
+		return "", fmt.Errorf("parse profile name template: %w", err)
+	}
+
+	name := &strings.Builder{}
+	if err := tmpl.Execute(name, data); err != nil {
+		return "", fmt.Errorf("render profile name template: %w", err)
+	}
+
+	if errs := validation.IsDNS1123Subdomain(name.String()); len(errs) > 0 {
+		return "", fmt.Errorf(
+			"profile name %q is not a DNS-1123 subdomain: %s", name, strings.Join(errs, ", "),
+		)
+	}
+	return name.String(), nil
+}
+
+// ValidateProfileNameTemplate returns an error if the ProfileNameTemplate
+// cannot be rendered into a valid profile name.
+func (pr *ProfileRecording) ValidateProfileNameTemplate() error {
+	_, err := pr.ProfileName(&ProfileNameData{
+		Container: "container",
+		Owner:     "owner",
+		Image:     "image:tag",
+		Time:      time.Now(),
+	})
+	return err
+}
+
+// imageTag returns the tag of a container image reference, "latest" if the
+// reference has no tag. Underscores are replaced and upper case letters are
+// lowered, because they are not allowed in profile names.
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	tag := "latest"
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		tag = image[i+1:]
+	}
+	return strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+}
@@ -18,14 +18,17 @@ package v1alpha1
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/containers/common/pkg/seccomp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
+// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile
 type ProfileRecordingKind string
 
 const (
@@ -47,26 +50,78 @@ const (
 	ProfileMergeContainers ProfileMergeStrategy = "containers"
 )
 
+type ProfileSyscallGrouping string
+
+const (
+	ProfileSyscallGroupingNone     ProfileSyscallGrouping = "none"
+	ProfileSyscallGroupingCategory ProfileSyscallGrouping = "category"
+)
+
+// ProfileRecordingPhase is the phase of recording the profiles of a pod.
+type ProfileRecordingPhase string
+
+const (
+	// ProfileRecordingPhaseRecording means that the pod is being recorded.
+	ProfileRecordingPhaseRecording ProfileRecordingPhase = "Recording"
+	// ProfileRecordingPhaseCollected means that the recorded profiles of the
+	// pod got collected.
+	ProfileRecordingPhaseCollected ProfileRecordingPhase = "Collected"
+	// ProfileRecordingPhaseFailed means that the recorded profiles of the pod
+	// could not be collected.
+	ProfileRecordingPhaseFailed ProfileRecordingPhase = "Failed"
+)
+
 const (
 	// ProfileToRecordingLabel is the name of the ProfileRecording CR that produced this profile.
 	ProfileToRecordingLabel = "spo.x-k8s.io/recording-id"
 	// ProfileToContainerLabel is the name of the container that produced this profile.
 	ProfileToContainerLabel = "spo.x-k8s.io/container-id"
+	// ProfileToRecordingSessionLabel is the ID of the recording session that produced this profile. It is
+	// not set on merged profiles which got recorded in more than one session.
+	ProfileToRecordingSessionLabel = "spo.x-k8s.io/recording-session"
+	// ProfileRecordingSourcesAnnotation is a JSON list on recorded profiles of the recording sessions with the
+	// node, pod and time window which contributed to the profile.
+	ProfileRecordingSourcesAnnotation = "spo.x-k8s.io/recording-sources"
 	// RecordingHasUnmergedProfiles is a finalizer that indicates that the recording has partial policies. Its
 	// main use is to hold off the deletion of the recording until all partial profiles are merged.
 	RecordingHasUnmergedProfiles = "spo.x-k8s.io/has-unmerged-profiles"
+	// ProfileSyscallExecutablesAnnotation is a JSON object on recorded seccomp profiles which maps the recorded
+	// syscalls to the executables that triggered them.
+	ProfileSyscallExecutablesAnnotation = "spo.x-k8s.io/syscall-executables"
+	// ProfileNameAnnotation is the name rendered from the profile name template of the recording. It is set on
+	// partial profiles and used as name of the merged profile.
+	ProfileNameAnnotation = "spo.x-k8s.io/profile-name"
+	// RecordedProfilesAnnotation is a comma separated list of the profiles recorded for a pod, for example
+	// "seccompprofile/my-recording-nginx". It is set on the pod if it still exists once the profiles got
+	// collected, otherwise on the controller of the pod.
+	RecordedProfilesAnnotation = "spo.x-k8s.io/recorded-profiles"
 )
 
 // ProfileRecordingSpec defines the desired state of ProfileRecording.
 type ProfileRecordingSpec struct {
 	// Kind of object to be recorded.
-	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile
 	Kind ProfileRecordingKind `json:"kind"`
 
+	// AdditionalKinds are further kinds of objects to be recorded from the
+	// same run of the workload, for example SelinuxProfile in addition to a
+	// SeccompProfile kind. All kinds are recorded by the same log enricher
+	// session, which is why additional kinds require the logs recorder.
+	// +optional
+	AdditionalKinds []ProfileRecordingKind `json:"additionalKinds,omitempty"`
+
 	// Recorder to be used.
 	// +kubebuilder:validation:Enum=bpf;logs
 	Recorder ProfileRecorder `json:"recorder"`
 
+	// RecorderFallback indicates whether the recording should fall back to
+	// the logs recorder on nodes where the bpf recorder is not available, for
+	// example because of an unsupported kernel. The recorded containers run
+	// with the logging seccomp profile of the logs recorder on all nodes in
+	// this case. Only applies to the bpf recorder and the SeccompProfile kind.
+	// +optional
+	// +kubebuilder:default=false
+	RecorderFallback bool `json:"recorderFallback,omitempty"`
+
 	// Whether or how to merge recorded profiles. Can be one of "none" or "containers".
 	// Default is "none".
 	// +optional
@@ -74,28 +129,185 @@ type ProfileRecordingSpec struct {
 	// +kubebuilder:validation:Enum=none;containers
 	MergeStrategy ProfileMergeStrategy `json:"mergeStrategy"`
 
+	// ProfileNameTemplate is a Go template which renders the names of the
+	// recorded profiles, for example "{{ owner }}-{{ container }}-{{ imageTag }}".
+	// The available functions are recording, container, owner (the workload
+	// controlling the pod), imageTag and date (YYYYMMDD). Profiles of
+	// replicas which are not merged keep the suffix of the pod name. The
+	// rendered names have to be DNS-1123 subdomains. Defaults to
+	// "{{ recording }}-{{ container }}".
+	// +optional
+	ProfileNameTemplate string `json:"profileNameTemplate,omitempty"`
+
 	// PodSelector selects the pods to record. This field follows standard
 	// label selector semantics. An empty podSelector matches all pods in this
 	// namespace.
 	PodSelector metav1.LabelSelector `json:"podSelector"`
 
+	// OwnerSelector additionally restricts the recorded pods to the ones
+	// controlled by the selected owner, for example an Argo Workflow or a
+	// Tekton TaskRun.
+	// +optional
+	OwnerSelector *OwnerSelector `json:"ownerSelector,omitempty"`
+
 	// Containers is a set of containers to record. This allows to select
 	// only specific containers to record instead of all containers present
 	// in the pod.
 	// +optional
 	Containers []string `json:"containers,omitempty"`
 
+	// ExcludeInitContainers excludes the init containers of the pods from
+	// the recording. Otherwise, every init container is recorded into a
+	// separate profile named "<recording>-init-<container>", because init
+	// containers usually require more syscalls than the other containers.
+	// +optional
+	ExcludeInitContainers bool `json:"excludeInitContainers,omitempty"`
+
 	// DisableProfileAfterRecording indicates whether the profile should be disabled
 	// after recording and thus skipped during reconcile. In case of SELinux profiles,
 	// reconcile can take a significant amount of time and for all profiles might not be needed.
 	// This Defaults to false.
 	// +kubebuilder:default=false
 	DisableProfileAfterRecording bool `json:"disableProfileAfterRecording"`
+
+	// SelinuxPermissive indicates whether the recorded SELinux profiles
+	// should be installed in permissive mode. This causes the recorded type
+	// to only log denials instead of enforcing them, which allows a gradual
+	// rollout of SELinux policies. Only applies to the SelinuxProfile kind.
+	// +optional
+	// +kubebuilder:default=false
+	SelinuxPermissive bool `json:"selinuxPermissive,omitempty"`
+
+	// SelinuxKeepContext indicates whether the recorded containers should
+	// keep running with their own SELinux context instead of the permissive
+	// recording type of the operator. The type of the workload has to be
+	// permissive on the nodes to record all denials, for example by using
+	// "semanage permissive -a <type>". Only applies to the SelinuxProfile kind.
+	// +optional
+	// +kubebuilder:default=false
+	SelinuxKeepContext bool `json:"selinuxKeepContext,omitempty"`
+
+	// RuntimeBaseline indicates whether the recorded seccomp profiles should
+	// be seeded with a baseline of syscalls for the language runtime of the
+	// container (JVM, Go, Node.js or Python). The runtime is detected from
+	// the "runtime.spo.x-k8s.io/<container>" pod annotation or, if not set,
+	// from the command, environment and image of the container. Only applies
+	// to the SeccompProfile kind.
+	// +optional
+	// +kubebuilder:default=false
+	RuntimeBaseline bool `json:"runtimeBaseline,omitempty"`
+
+	// SeccompDefaultAction is the default action of the recorded seccomp
+	// profiles, which applies to all syscalls that did not get recorded.
+	// Only applies to the SeccompProfile kind.
+	// +optional
+	// +kubebuilder:default="SCMP_ACT_ERRNO"
+	//nolint:lll // required for kubebuilder
+	// +kubebuilder:validation:Enum=SCMP_ACT_KILL;SCMP_ACT_KILL_PROCESS;SCMP_ACT_KILL_THREAD;SCMP_ACT_TRAP;SCMP_ACT_ERRNO;SCMP_ACT_LOG
+	SeccompDefaultAction seccomp.Action `json:"seccompDefaultAction,omitempty"`
+
+	// SeccompDefaultErrnoRet is the errno return code of the default action
+	// of the recorded seccomp profiles, for example 38 (ENOSYS). It is only
+	// used if the default action is SCMP_ACT_ERRNO, where the container
+	// runtime returns EPERM if not set. Only applies to the SeccompProfile
+	// kind.
+	// +optional
+	SeccompDefaultErrnoRet *uint `json:"seccompDefaultErrnoRet,omitempty"`
+
+	// SeccompSyscallAction is the action for the recorded syscalls in the
+	// recorded seccomp profiles. SCMP_ACT_LOG allows the syscalls as well,
+	// but logs every call of them. Only applies to the SeccompProfile kind.
+	// +optional
+	// +kubebuilder:default="SCMP_ACT_ALLOW"
+	// +kubebuilder:validation:Enum=SCMP_ACT_ALLOW;SCMP_ACT_LOG
+	SeccompSyscallAction seccomp.Action `json:"seccompSyscallAction,omitempty"`
+
+	// SeccompSyscallGrouping indicates whether or how the recorded syscalls
+	// should be grouped into multiple syscall entries of the recorded seccomp
+	// profiles. Can be one of "none", which results in a single entry, or
+	// "category", which results in one entry per syscall category like file,
+	// network or process. Only applies to the SeccompProfile kind.
+	// +optional
+	// +kubebuilder:default="none"
+	// +kubebuilder:validation:Enum=none;category
+	SeccompSyscallGrouping ProfileSyscallGrouping `json:"seccompSyscallGrouping,omitempty"`
+
+	// SeccompBaseProfileName is the name of a SeccompProfile in the namespace
+	// of the recording, for example the default profile of the container
+	// runtime, which gets referenced as base profile by the recorded seccomp
+	// profiles. All syscalls already allowed by the base profile or its own
+	// base profiles are removed from the recorded profiles to keep them
+	// minimal. Only applies to the SeccompProfile kind.
+	// +optional
+	SeccompBaseProfileName string `json:"seccompBaseProfileName,omitempty"`
+
+	// EphemeralContainers indicates whether ephemeral containers of the
+	// selected pods should be recorded as well, for example debug containers
+	// injected by "kubectl debug". Every ephemeral container results in a
+	// separate profile. The Containers filter does not apply to them.
+	// +optional
+	// +kubebuilder:default=false
+	EphemeralContainers bool `json:"ephemeralContainers,omitempty"`
+
+	// SnapshotInterval is the interval at which the profiles recorded so
+	// far get collected from running pods, for example "1h". This allows to
+	// record long running workloads which never terminate. The profiles are
+	// collected a last time when the pod terminates. Intervals shorter than
+	// one minute are raised to one minute. Only applies to the logs recorder.
+	// +optional
+	SnapshotInterval *metav1.Duration `json:"snapshotInterval,omitempty"`
+
+	// Approved indicates whether the recording got approved by a user which
+	// is allowed to approve profile recordings, which requires the "approve"
+	// verb on the profilerecordings resource. Recordings using the logs
+	// recorder run the workloads with permissive profiles and are therefore
+	// only activated in namespaces labeled with
+	// "spo.x-k8s.io/require-recording-approval" once they got approved.
+	// +optional
+	// +kubebuilder:default=false
+	Approved bool `json:"approved,omitempty"`
 }
 
 // ProfileRecordingStatus contains status of the ProfileRecording.
 type ProfileRecordingStatus struct {
 	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
+	// LastSeen contains the time of the last heartbeat per node name,
+	// reported periodically by the daemon while it records workloads of the
+	// recording on its node.
+	// +optional
+	LastSeen map[string]metav1.Time `json:"lastSeen,omitempty"`
+	// Recorders contains the recorder used per node name, which is the logs
+	// recorder on nodes where a recording using RecorderFallback could not
+	// use the bpf recorder.
+	// +optional
+	Recorders map[string]ProfileRecorder `json:"recorders,omitempty"`
+	// Pods contains the recording phase per recorded pod name, reported by
+	// the daemon on the node of the pod. The phase of a pod is Collected or
+	// Failed once it succeeded or got removed.
+	// +optional
+	Pods map[string]ProfileRecordingPhase `json:"pods,omitempty"`
+	// IgnoredPods contains the reason per pod name for the pods whose
+	// recording annotations got ignored by the daemon on the node of the
+	// pod, for example RecorderNotAllowed or PodAlreadyRunning. It is
+	// reported periodically until the pod gets removed.
+	// +optional
+	IgnoredPods map[string]string `json:"ignoredPods,omitempty"`
+}
+
+// OwnerSelector selects pods by the workload controller owning them. This
+// allows to select the pods of any controller, including custom resources
+// like Argo Workflows or Tekton TaskRuns, whose pods may not carry
+// predictable labels.
+type OwnerSelector struct {
+	// APIGroup of the owner, for example "argoproj.io" or "tekton.dev".
+	// Empty for the core API group.
+	// +optional
+	APIGroup string `json:"apiGroup,omitempty"`
+	// Kind of the owner, for example "Workflow" or "TaskRun".
+	Kind string `json:"kind"`
+	// Name of the owner. All owners of the kind are selected if empty.
+	// +optional
+	Name string `json:"name,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -111,40 +323,121 @@ type ProfileRecording struct {
 	Status ProfileRecordingStatus `json:"status,omitempty"`
 }
 
-func (pr *ProfileRecording) CtrAnnotation(ctrName string) (key, value string, err error) {
-	switch pr.Spec.Kind {
-	case ProfileRecordingKindSeccompProfile:
-		return pr.ctrAnnotationSeccomp(ctrName)
-	case ProfileRecordingKindSelinuxProfile:
-		return pr.ctrAnnotationSelinux(ctrName)
+// CtrAnnotation returns the annotation for recording the container within
+// the provided recording session.
+func (pr *ProfileRecording) CtrAnnotation(ctrName, session string) (key, value string, err error) {
+	key, err = pr.ctrAnnotationKey(pr.Spec.Kind, ctrName)
+	if err != nil {
+		return "", "", err
 	}
+	return key, pr.ctrAnnotationValue(ctrName, session), nil
+}
 
-	return "", "", fmt.Errorf(
-		"invalid kind: %s", pr.Spec.Kind,
-	)
+// NewRecordingSession returns a new unique ID for the recording session of a
+// pod.
+func NewRecordingSession() string {
+	const sessionSize = 10
+
+	return utilrand.String(sessionSize)
+}
+
+// AdditionalCtrAnnotationKeys returns the annotation keys for recording the
+// additional kinds of a container. They use the same value as the annotation
+// returned by CtrAnnotation.
+func (pr *ProfileRecording) AdditionalCtrAnnotationKeys(ctrName string) ([]string, error) {
+	keys := []string{}
+	for _, kind := range pr.Kinds()[1:] {
+		key, err := pr.ctrAnnotationKey(kind, ctrName)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Kinds returns all kinds recorded by the recording, starting with the
+// primary kind.
+func (pr *ProfileRecording) Kinds() []ProfileRecordingKind {
+	kinds := []ProfileRecordingKind{pr.Spec.Kind}
+	for _, kind := range pr.Spec.AdditionalKinds {
+		if !slices.Contains(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+// ValidateKinds returns an error if the additional kinds cannot be recorded
+// together with the primary kind.
+func (pr *ProfileRecording) ValidateKinds() error {
+	if len(pr.Kinds()) > 1 && pr.Spec.Recorder != ProfileRecorderLogs {
+		return fmt.Errorf(
+			"recording additional kinds requires the %s recorder", ProfileRecorderLogs,
+		)
+	}
+	return nil
+}
+
+// NeedsApproval returns true if the recording has to be approved before it
+// gets activated in namespaces which require an approval.
+func (pr *ProfileRecording) NeedsApproval() bool {
+	return pr.UsesLogsRecorder() && !pr.Spec.Approved
+}
+
+// UsesLogsRecorder returns true if the recorded workloads have to be prepared
+// for the logs recorder, either because it is the configured recorder or
+// because the recording may fall back to it.
+func (pr *ProfileRecording) UsesLogsRecorder() bool {
+	return pr.Spec.Recorder == ProfileRecorderLogs || pr.FallsBackToLogs()
+}
+
+// FallsBackToLogs returns true if the recording falls back to the logs
+// recorder on nodes where the bpf recorder is not available.
+func (pr *ProfileRecording) FallsBackToLogs() bool {
+	return pr.Spec.Recorder == ProfileRecorderBpf &&
+		pr.Spec.Kind == ProfileRecordingKindSeccompProfile &&
+		pr.Spec.RecorderFallback
 }
 
 func (pr *ProfileRecording) IsKindSupported() bool {
-	switch pr.Spec.Kind {
-	case ProfileRecordingKindSelinuxProfile, ProfileRecordingKindSeccompProfile:
-		return true
+	for _, kind := range pr.Kinds() {
+		if kind != ProfileRecordingKindSelinuxProfile && kind != ProfileRecordingKindSeccompProfile {
+			return false
+		}
 	}
-	return false
+	return true
 }
 
-func (pr *ProfileRecording) ctrAnnotationValue(ctrName string) string {
-	const nonceSize = 5
+func (pr *ProfileRecording) ctrAnnotationKey(kind ProfileRecordingKind, ctrName string) (string, error) {
+	switch kind {
+	case ProfileRecordingKindSeccompProfile:
+		return pr.ctrAnnotationSeccomp(ctrName)
+	case ProfileRecordingKindSelinuxProfile:
+		return pr.ctrAnnotationSelinux(ctrName)
+	}
 
-	return fmt.Sprintf(
-		"%s_%s_%s_%d",
-		pr.GetName(),
-		ctrName,
-		utilrand.String(nonceSize),
-		time.Now().Unix(),
+	return "", fmt.Errorf(
+		"invalid kind: %s", kind,
 	)
 }
 
-func (pr *ProfileRecording) ctrAnnotationSeccomp(ctrName string) (key, value string, err error) {
+func (pr *ProfileRecording) ctrAnnotationValue(ctrName, session string) string {
+	runtimeBaseline := pr.Spec.RuntimeBaseline
+	payload := &CtrAnnotationPayload{
+		Version:         CtrAnnotationVersion,
+		Recording:       pr.GetName(),
+		Kinds:           pr.Kinds(),
+		Container:       ctrName,
+		Session:         session,
+		Timestamp:       time.Now().Unix(),
+		MergeStrategy:   pr.Spec.MergeStrategy,
+		RuntimeBaseline: &runtimeBaseline,
+	}
+	return payload.String()
+}
+
+func (pr *ProfileRecording) ctrAnnotationSeccomp(ctrName string) (string, error) {
 	var annotationPrefix string
 
 	switch pr.Spec.Recorder {
@@ -153,17 +446,15 @@ func (pr *ProfileRecording) ctrAnnotationSeccomp(ctrName string) (key, value str
 	case ProfileRecorderBpf:
 		annotationPrefix = config.SeccompProfileRecordBpfAnnotationKey
 	default:
-		return "", "", fmt.Errorf(
+		return "", fmt.Errorf(
 			"invalid recorder: %s", pr.Spec.Recorder,
 		)
 	}
 
-	key = annotationPrefix + ctrName
-	value = pr.ctrAnnotationValue(ctrName)
-	return key, value, err
+	return annotationPrefix + ctrName, nil
 }
 
-func (pr *ProfileRecording) ctrAnnotationSelinux(ctrName string) (key, value string, err error) {
+func (pr *ProfileRecording) ctrAnnotationSelinux(ctrName string) (string, error) {
 	var annotationPrefix string
 
 	switch pr.Spec.Recorder {
@@ -171,14 +462,12 @@ func (pr *ProfileRecording) ctrAnnotationSelinux(ctrName string) (key, value str
 		annotationPrefix = config.SelinuxProfileRecordLogsAnnotationKey
 	case ProfileRecorderBpf:
 	default:
-		return "", "", fmt.Errorf(
+		return "", fmt.Errorf(
 			"invalid recorder: %s, only %s is supported", pr.Spec.Recorder, ProfileRecorderLogs,
 		)
 	}
 
-	value = pr.ctrAnnotationValue(ctrName)
-	key = annotationPrefix + ctrName
-	return
+	return annotationPrefix + ctrName, nil
 }
 
 // +kubebuilder:object:root=true
@@ -21,9 +21,25 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnerSelector) DeepCopyInto(out *OwnerSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnerSelector.
+func (in *OwnerSelector) DeepCopy() *OwnerSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnerSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProfileRecording) DeepCopyInto(out *ProfileRecording) {
 	*out = *in
@@ -86,12 +102,32 @@ func (in *ProfileRecordingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProfileRecordingSpec) DeepCopyInto(out *ProfileRecordingSpec) {
 	*out = *in
+	if in.AdditionalKinds != nil {
+		in, out := &in.AdditionalKinds, &out.AdditionalKinds
+		*out = make([]ProfileRecordingKind, len(*in))
+		copy(*out, *in)
+	}
 	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.OwnerSelector != nil {
+		in, out := &in.OwnerSelector, &out.OwnerSelector
+		*out = new(OwnerSelector)
+		**out = **in
+	}
 	if in.Containers != nil {
 		in, out := &in.Containers, &out.Containers
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SeccompDefaultErrnoRet != nil {
+		in, out := &in.SeccompDefaultErrnoRet, &out.SeccompDefaultErrnoRet
+		*out = new(uint)
+		**out = **in
+	}
+	if in.SnapshotInterval != nil {
+		in, out := &in.SnapshotInterval, &out.SnapshotInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRecordingSpec.
@@ -112,6 +148,34 @@ func (in *ProfileRecordingStatus) DeepCopyInto(out *ProfileRecordingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastSeen != nil {
+		in, out := &in.LastSeen, &out.LastSeen
+		*out = make(map[string]v1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Recorders != nil {
+		in, out := &in.Recorders, &out.Recorders
+		*out = make(map[string]ProfileRecorder, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make(map[string]ProfileRecordingPhase, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.IgnoredPods != nil {
+		in, out := &in.IgnoredPods, &out.IgnoredPods
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRecordingStatus.
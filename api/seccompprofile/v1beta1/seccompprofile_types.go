@@ -21,6 +21,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/containers/common/pkg/seccomp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,6 +39,11 @@ var (
 
 const ExtJSON = ".json"
 
+// SyscallsAnnotation is the annotation on a SeccompProfile which contains
+// the JSON encoded syscalls of the profile including the ones of all of its
+// base profiles, as they got resolved by the daemon.
+const SyscallsAnnotation = "syscalls"
+
 // SeccompProfileSpec defines the desired state of SeccompProfile.
 type SeccompProfileSpec struct {
 	// Common spec fields for all profiles.
@@ -48,12 +54,29 @@ type SeccompProfileSpec struct {
 	// remote OCI artifacts as well when prefixed with `oci://`.
 	BaseProfileName string `json:"baseProfileName,omitempty"`
 
+	// ExpiresAt is the time after which the profile expires, for example
+	// for temporary profiles used while debugging a workload. Expired
+	// profiles are not updated on the nodes anymore and have the Expired
+	// status.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// RemoveOnExpiry indicates whether the profile should be removed from
+	// the nodes once it expired. Workloads using the profile cannot be
+	// started anymore in this case.
+	// +optional
+	RemoveOnExpiry bool `json:"removeOnExpiry,omitempty"`
+
 	// Properties from containers/common/pkg/seccomp.Seccomp type
 
 	// the default action for seccomp
 	//nolint:lll // required for kubebuilder
 	// +kubebuilder:validation:Enum=SCMP_ACT_KILL;SCMP_ACT_KILL_PROCESS;SCMP_ACT_KILL_THREAD;SCMP_ACT_TRAP;SCMP_ACT_ERRNO;SCMP_ACT_TRACE;SCMP_ACT_ALLOW;SCMP_ACT_LOG;SCMP_ACT_NOTIFY
 	DefaultAction seccomp.Action `json:"defaultAction"`
+	// the errno return code of the default action. Some actions like
+	// SCMP_ACT_ERRNO and SCMP_ACT_TRACE allow to specify the errno code to
+	// return
+	DefaultErrnoRet *uint `json:"defaultErrnoRet,omitempty"`
 	// the architecture used for system calls
 	Architectures []Arch `json:"architectures,omitempty"`
 	// path of UNIX domain socket to contact a seccomp agent for SCMP_ACT_NOTIFY
@@ -120,6 +143,12 @@ type SeccompProfileStatus struct {
 	profilebase.StatusBase `json:",inline"`
 	Path                   string   `json:"path,omitempty"`
 	ActiveWorkloads        []string `json:"activeWorkloads,omitempty"`
+	// The workloads owning the pods in activeWorkloads, in the form
+	// kind/namespace/name. Pods without a controller are listed as Pod.
+	ActiveWorkloadOwners []string `json:"activeWorkloadOwners,omitempty"`
+	// The time since when no pod uses the profile any more. Not set while
+	// the profile is used or if it has never been used.
+	UnusedSince *metav1.Time `json:"unusedSince,omitempty"`
 	// The path that should be provided to the `securityContext.seccompProfile.localhostProfile`
 	// field of a Pod or container spec
 	LocalhostProfile string `json:"localhostProfile,omitempty"`
@@ -201,6 +230,12 @@ func (sp *SeccompProfile) IsReconcilable() bool {
 	return profilebase.IsReconcilable(sp)
 }
 
+// IsExpired returns true if the profile has an expiry time which is not after
+// the provided time.
+func (sp *SeccompProfile) IsExpired(now time.Time) bool {
+	return sp.Spec.ExpiresAt != nil && !sp.Spec.ExpiresAt.After(now)
+}
+
 // +kubebuilder:object:root=true
 
 // SeccompProfileList contains a list of SeccompProfile.
@@ -102,6 +102,15 @@ func (in *SeccompProfileList) DeepCopyObject() runtime.Object {
 func (in *SeccompProfileSpec) DeepCopyInto(out *SeccompProfileSpec) {
 	*out = *in
 	out.SpecBase = in.SpecBase
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DefaultErrnoRet != nil {
+		in, out := &in.DefaultErrnoRet, &out.DefaultErrnoRet
+		*out = new(uint)
+		**out = **in
+	}
 	if in.Architectures != nil {
 		in, out := &in.Architectures, &out.Architectures
 		*out = make([]Arch, len(*in))
@@ -150,6 +159,15 @@ func (in *SeccompProfileStatus) DeepCopyInto(out *SeccompProfileStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ActiveWorkloadOwners != nil {
+		in, out := &in.ActiveWorkloadOwners, &out.ActiveWorkloadOwners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnusedSince != nil {
+		in, out := &in.UnusedSince, &out.UnusedSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeccompProfileStatus.
@@ -40,6 +40,14 @@ const (
 	ProfileStateTerminating ProfileState = "Terminating"
 	// The profile couldn't be installed.
 	ProfileStateError ProfileState = "Error"
+	// The profile was installed on some nodes but couldn't be installed on
+	// the others. This state is only used for the overall status of a profile.
+	ProfileStatePartiallyInstalled ProfileState = "PartiallyInstalled"
+	// The profile expired and is not updated anymore.
+	ProfileStateExpired ProfileState = "Expired"
+	// The profile was rendered but not installed, because the daemon runs in
+	// dry-run mode.
+	ProfileStateDryRun ProfileState = "DryRun"
 	// When adding new statuses, remember to also adjust the LowerOfTwoStates function.
 )
 
@@ -67,11 +75,14 @@ func LowerOfTwoStates(currentLowest, candidate ProfileState) ProfileState {
 	orderedStates := make(map[ProfileState]int)
 	orderedStates[ProfileStateError] = 0       // error must always have the lowest index
 	orderedStates[ProfileStateTerminating] = 1 // If one is set as terminating; all the statuses will end here too
-	orderedStates[ProfileStatePartial] = 2
-	orderedStates[ProfileStateDisabled] = 3
-	orderedStates[ProfileStatePending] = 4
-	orderedStates[ProfileStateInProgress] = 5
-	orderedStates[ProfileStateInstalled] = 6
+	orderedStates[ProfileStateExpired] = 2
+	orderedStates[ProfileStatePartiallyInstalled] = 3
+	orderedStates[ProfileStatePartial] = 4
+	orderedStates[ProfileStateDisabled] = 5
+	orderedStates[ProfileStateDryRun] = 6
+	orderedStates[ProfileStatePending] = 7
+	orderedStates[ProfileStateInProgress] = 8
+	orderedStates[ProfileStateInstalled] = 9
 
 	if orderedStates[currentLowest] > orderedStates[candidate] {
 		return candidate
@@ -94,10 +105,30 @@ type SecurityProfileNodeStatus struct {
 
 	NodeName string       `json:"nodeName"`
 	Status   ProfileState `json:"status,omitempty"`
+	// SelinuxBooleans is the state of the SELinux booleans required by
+	// the profile on the node.
+	// +optional
+	SelinuxBooleans []SelinuxBooleanStatus `json:"selinuxBooleans,omitempty"`
 }
 
 type SecurityProfileNodeStatusSpec struct{}
 
+// SelinuxBooleanStatus is the per-node state of a SELinux boolean.
+type SelinuxBooleanStatus struct {
+	// Name of the SELinux boolean.
+	Name string `json:"name"`
+	// Value is the value required by the profile.
+	Value bool `json:"value"`
+	// Original is the value of the boolean before it was set, which
+	// gets restored when the profile is removed.
+	Original bool `json:"original"`
+	// Applied indicates if the boolean has been set on the node.
+	Applied bool `json:"applied"`
+	// Message contains the reason if the boolean could not be set.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SecurityProfileNodeStatusList contains a list of SecurityProfileNodeStatus.
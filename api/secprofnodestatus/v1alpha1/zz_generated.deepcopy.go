@@ -30,6 +30,11 @@ func (in *SecurityProfileNodeStatus) DeepCopyInto(out *SecurityProfileNodeStatus
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
+	if in.SelinuxBooleans != nil {
+		in, out := &in.SelinuxBooleans, &out.SelinuxBooleans
+		*out = make([]SelinuxBooleanStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityProfileNodeStatus.
@@ -96,3 +101,18 @@ func (in *SecurityProfileNodeStatusSpec) DeepCopy() *SecurityProfileNodeStatusSp
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelinuxBooleanStatus) DeepCopyInto(out *SelinuxBooleanStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelinuxBooleanStatus.
+func (in *SelinuxBooleanStatus) DeepCopy() *SelinuxBooleanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SelinuxBooleanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
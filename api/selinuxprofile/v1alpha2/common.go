@@ -25,6 +25,9 @@ import (
 
 const (
 	SystemPolicyKind = "System"
+	// TemplatePolicyKind refers to one of the SELinux templates which are
+	// managed by the operator in its own namespace.
+	TemplatePolicyKind = "Template"
 )
 
 // +k8s:deepcopy-gen=false
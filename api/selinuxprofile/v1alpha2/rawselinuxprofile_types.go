@@ -64,6 +64,7 @@ func (sp *RawSelinuxProfile) DeepCopyToStatusBaseIf() profilebasev1alpha1.Status
 
 func (sp *RawSelinuxProfile) SetImplementationStatus() {
 	sp.Status.Usage = sp.GetPolicyUsage()
+	sp.Status.Context = sp.GetPolicyUsage()
 }
 
 // GetPolicyName gets the policy module name in the format that
@@ -104,7 +105,7 @@ func (sp *RawSelinuxProfile) IsReconcilable() bool {
 type RawSelinuxProfileList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []SelinuxProfile `json:"items"`
+	Items           []RawSelinuxProfile `json:"items"`
 }
 
 func init() { //nolint:gochecknoinits // required to init the scheme
@@ -19,6 +19,7 @@ package v1alpha2
 import (
 	"context"
 	"sort"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +32,10 @@ const (
 	// AllowSelf describes an "allow" entry meant to give
 	// the same process.
 	AllowSelf = "@self"
+
+	// DefaultSensitivity is the MLS sensitivity used when building the
+	// SELinux level out of the configured MCS categories.
+	DefaultSensitivity = "s0"
 )
 
 // Ensure SelinuxProfile implements the StatusBaseUser and SecurityProfileBase interfaces.
@@ -45,8 +50,10 @@ type PolicyRef struct {
 	// installed policy will be used.
 	// The allowed "System" policies are available in the
 	// SecurityProfilesOperatorDaemon instance.
+	// "Template" refers to one of the reusable templates shipped by
+	// the operator, for example log-writer, net-client or home-reader.
 	// +kubebuilder:default="System"
-	// +kubebuilder:validation:Enum=System;SelinuxProfile;
+	// +kubebuilder:validation:Enum=System;SelinuxProfile;Template;
 	Kind string `json:"kind,omitempty"`
 	// The name of the policy that this inherits from.
 	Name string `json:"name"`
@@ -67,10 +74,27 @@ type SelinuxProfileSpec struct {
 	// +optional
 	// +kubebuilder:default=false
 	Permissive bool `json:"permissive,omitempty"`
+	// Categories are the MCS categories which should be assigned to
+	// workloads using this profile, for example ["c123", "c456"]. If
+	// unset, the workloads inherit the categories assigned to the pod,
+	// e.g. by the namespace or the container runtime.
+	// +optional
+	Categories []MCSCategory `json:"categories,omitempty"`
+	// Booleans are the SELinux booleans which are required by the
+	// profile, for example {"container_use_devices": true}. They are set
+	// on the nodes when the profile gets installed and reverted to their
+	// previous value when the profile gets removed. The booleans have to
+	// be allowed in the SecurityProfilesOperatorDaemon instance.
+	// +optional
+	Booleans map[string]bool `json:"booleans,omitempty"`
 	// Defines the allow policy for the profile
 	Allow Allow `json:"allow,omitempty"`
 }
 
+// MCSCategory is a single SELinux MCS category, like "c123".
+// +kubebuilder:validation:Pattern=`^c[0-9]{1,4}$`
+type MCSCategory string
+
 type LabelKey string
 
 func (lk LabelKey) String() string {
@@ -111,8 +135,23 @@ type SelinuxProfileStatus struct {
 
 	// Represents the string that the SelinuxProfile object can be
 	// referenced as in a pod seLinuxOptions section.
-	Usage           string   `json:"usage,omitempty"`
+	Usage string `json:"usage,omitempty"`
+	// Represents the SELinux level including the MCS categories which
+	// workloads using this profile are assigned to. Empty if the
+	// categories are inherited from the pod.
+	Level string `json:"level,omitempty"`
+	// Represents the SELinux type and level in the format type:level which
+	// workloads using this profile run with, or only the type if the level
+	// is inherited from the pod. Templating tools can reference it instead
+	// of computing the naming convention of the operator.
+	Context         string   `json:"context,omitempty"`
 	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
+	// The workloads owning the pods in activeWorkloads, in the form
+	// kind/namespace/name. Pods without a controller are listed as Pod.
+	ActiveWorkloadOwners []string `json:"activeWorkloadOwners,omitempty"`
+	// The time since when no pod uses the profile any more. Not set while
+	// the profile is used or if it has never been used.
+	UnusedSince *metav1.Time `json:"unusedSince,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -141,6 +180,8 @@ func (sp *SelinuxProfile) DeepCopyToStatusBaseIf() profilebasev1alpha1.StatusBas
 
 func (sp *SelinuxProfile) SetImplementationStatus() {
 	sp.Status.Usage = sp.GetPolicyUsage()
+	sp.Status.Level = sp.GetPolicyLevel()
+	sp.Status.Context = sp.GetPolicyContext()
 }
 
 // GetPolicyName gets the policy module name in the format that
@@ -155,6 +196,30 @@ func (sp *SelinuxProfile) GetPolicyUsage() string {
 	return sp.GetPolicyName() + ".process"
 }
 
+// GetPolicyLevel is the SELinux level, including the configured MCS
+// categories, which a pod will use together with this SELinux module.
+// It returns an empty string if no categories are configured.
+func (sp *SelinuxProfile) GetPolicyLevel() string {
+	if len(sp.Spec.Categories) == 0 {
+		return ""
+	}
+	categories := make([]string, len(sp.Spec.Categories))
+	for i, c := range sp.Spec.Categories {
+		categories[i] = string(c)
+	}
+	return DefaultSensitivity + ":" + strings.Join(categories, ",")
+}
+
+// GetPolicyContext is the SELinux type and level in the format type:level
+// which a pod will use together with this SELinux module. It only contains
+// the type if no categories are configured.
+func (sp *SelinuxProfile) GetPolicyContext() string {
+	if level := sp.GetPolicyLevel(); level != "" {
+		return sp.GetPolicyUsage() + ":" + level
+	}
+	return sp.GetPolicyUsage()
+}
+
 func (sp *SelinuxProfile) ListProfilesByRecording(
 	ctx context.Context,
 	cli client.Client,
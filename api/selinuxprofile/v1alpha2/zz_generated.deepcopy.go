@@ -133,7 +133,7 @@ func (in *RawSelinuxProfileList) DeepCopyInto(out *RawSelinuxProfileList) {
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SelinuxProfile, len(*in))
+		*out = make([]RawSelinuxProfile, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -242,6 +242,18 @@ func (in *SelinuxProfileSpec) DeepCopyInto(out *SelinuxProfileSpec) {
 		*out = make([]PolicyRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]MCSCategory, len(*in))
+		copy(*out, *in)
+	}
+	if in.Booleans != nil {
+		in, out := &in.Booleans, &out.Booleans
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Allow != nil {
 		in, out := &in.Allow, &out.Allow
 		*out = make(Allow, len(*in))
@@ -290,6 +302,15 @@ func (in *SelinuxProfileStatus) DeepCopyInto(out *SelinuxProfileStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ActiveWorkloadOwners != nil {
+		in, out := &in.ActiveWorkloadOwners, &out.ActiveWorkloadOwners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnusedSince != nil {
+		in, out := &in.UnusedSince, &out.UnusedSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelinuxProfileStatus.
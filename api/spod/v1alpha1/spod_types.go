@@ -17,18 +17,25 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
 	"sort"
 
 	"github.com/containers/common/pkg/seccomp"
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	compliancev1alpha1 "sigs.k8s.io/security-profiles-operator/api/compliancereport/v1alpha1"
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 )
 
 // Condition types.
 const (
 	// TypeReady resources are believed to be ready to handle work.
 	TypeReady = "Ready"
+	// TypeVersionSkew resources run daemons with a different version than
+	// the operator.
+	TypeVersionSkew = "VersionSkew"
 )
 
 // Reasons a resource is or is not ready.
@@ -39,6 +46,13 @@ const (
 	ReasonDeleting    = "Deleting"
 	ReasonPending     = "Pending"
 	ReasonUpdating    = "Updating"
+	ReasonExpired     = "Expired"
+)
+
+// Reasons the daemons do or do not run the version of the operator.
+const (
+	ReasonVersionMatch    = "VersionMatch"
+	ReasonVersionMismatch = "VersionMismatch"
 )
 
 // Equal returns true if the condition is identical to the supplied condition,
@@ -176,6 +190,17 @@ func Unavailable() metav1.Condition {
 	}
 }
 
+// Expired returns a condition that indicates the resource expired and is
+// therefore not available for use anymore.
+func Expired() metav1.Condition {
+	return metav1.Condition{
+		Type:               TypeReady,
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExpired,
+	}
+}
+
 // Pending returns a condition that indicates the resource is currently
 // observed to be waiting for creating.
 func Pending() metav1.Condition {
@@ -198,6 +223,29 @@ func Updating() metav1.Condition {
 	}
 }
 
+// VersionSkew returns a condition that indicates that some daemons run a
+// different version than the operator.
+func VersionSkew(message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               TypeVersionSkew,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonVersionMismatch,
+		Message:            message,
+	}
+}
+
+// NoVersionSkew returns a condition that indicates that all daemons run the
+// same version as the operator.
+func NoVersionSkew() metav1.Condition {
+	return metav1.Condition{
+		Type:               TypeVersionSkew,
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonVersionMatch,
+	}
+}
+
 // SelinuxOptions defines options specific to the SELinux
 // functionality of the SecurityProfilesOperator.
 type SelinuxOptions struct {
@@ -207,8 +255,157 @@ type SelinuxOptions struct {
 	// policy.
 	// +kubebuilder:default={"container"}
 	AllowedSystemProfiles []string `json:"allowedSystemProfiles,omitempty"`
+	// Lists the SELinux booleans which are allowed to be set by
+	// SelinuxProfiles. Setting booleans requires the daemon to run
+	// as root, which is only done if this list is not empty.
+	// +optional
+	AllowedBooleans []string `json:"allowedBooleans,omitempty"`
+}
+
+// ComplianceOptions configures the generation of ComplianceReports.
+type ComplianceOptions struct {
+	// NamespaceSelector selects the namespaces for which a ComplianceReport
+	// is generated. All namespaces are selected if not set.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Rules are the benchmark rules to be checked. All known rules are
+	// checked if empty.
+	// +optional
+	Rules []compliancev1alpha1.ComplianceRule `json:"rules,omitempty"`
+}
+
+// RecordedProfileGCOptions configures the garbage collection of recorded
+// profiles.
+type RecordedProfileGCOptions struct {
+	// Retention is the period for which a recorded profile has to be unused
+	// before it gets collected, for example "720h". Profiles which have never
+	// been used are collected after the retention period since their creation.
+	Retention metav1.Duration `json:"retention"`
+	// Action is applied to the collected profiles. "Delete" removes them,
+	// while "Mark" only labels them with spo.x-k8s.io/unused to allow a
+	// review before removing them manually.
+	// +kubebuilder:default=Delete
+	// +optional
+	Action RecordedProfileGCAction `json:"action,omitempty"`
+}
+
+// RecordedProfileGCAction is the action applied to unused recorded profiles.
+// +kubebuilder:validation:Enum=Delete;Mark
+type RecordedProfileGCAction string
+
+const (
+	// RecordedProfileGCActionDelete deletes unused recorded profiles.
+	RecordedProfileGCActionDelete RecordedProfileGCAction = "Delete"
+	// RecordedProfileGCActionMark labels unused recorded profiles.
+	RecordedProfileGCActionMark RecordedProfileGCAction = "Mark"
+)
+
+// OCIArtifactSignaturePolicy configures which signatures are accepted for
+// profiles pulled from OCI artifacts.
+type OCIArtifactSignaturePolicy struct {
+	// PublicKey is a PEM encoded cosign public key. If set, the artifacts
+	// have to be signed by the corresponding private key instead of using
+	// keyless signing, and the Identities are ignored.
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+	// Identities are the identities of which one has to be the signer of
+	// the artifact when using keyless signing. Any identity is accepted if
+	// empty.
+	// +optional
+	Identities []OCIArtifactSignatureIdentity `json:"identities,omitempty"`
+}
+
+// OCIArtifactSignatureIdentity is an identity used for keyless signing of OCI
+// artifacts. Unset fields match any value.
+type OCIArtifactSignatureIdentity struct {
+	// Subject is the identity of the signing certificate, for example an
+	// email address or the URI of a CI workflow.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+	// SubjectRegexp is a regular expression matching the identity of the
+	// signing certificate. Ignored if Subject is set.
+	// +optional
+	SubjectRegexp string `json:"subjectRegexp,omitempty"`
+	// Issuer is the OIDC issuer of the signing certificate, for example
+	// https://token.actions.githubusercontent.com.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+	// IssuerRegexp is a regular expression matching the OIDC issuer of the
+	// signing certificate. Ignored if Issuer is set.
+	// +optional
+	IssuerRegexp string `json:"issuerRegexp,omitempty"`
+}
+
+// LogEnricherSinkOptions configures the outputs of the enriched audit events
+// of the log enricher.
+type LogEnricherSinkOptions struct {
+	// StdoutFormat is the format of the audit events logged to stdout. "Text"
+	// keeps the format of the operator logs, while "JSON" writes one JSON
+	// object per line.
+	// +kubebuilder:default=Text
+	// +optional
+	StdoutFormat LogEnricherStdoutFormat `json:"stdoutFormat,omitempty"`
+	// File is the absolute path of a file on the node, to which the audit
+	// events get appended in the JSON lines format. The directory of the file
+	// gets created if it does not exist.
+	// +kubebuilder:validation:Pattern=`^/.+`
+	// +optional
+	File string `json:"file,omitempty"`
+	// WebhookURL is an HTTP endpoint which receives the audit events in
+	// batches as JSON lines by POST requests.
+	// +kubebuilder:validation:Pattern=`^https?://.+`
+	// +optional
+	WebhookURL string `json:"webhookURL,omitempty"`
+}
+
+// LogEnricherCacheOptions configures the caches of the log enricher.
+type LogEnricherCacheOptions struct {
+	// TTL is the time after which the cached container IDs and container
+	// infos expire if they have not been used, for example "30m". Defaults to
+	// one hour.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+	// MaxEntries is the maximum amount of entries per cache. The least
+	// recently used entries get evicted if a cache is full, which bounds the
+	// memory of the log enricher on nodes with a high pod churn. Defaults to
+	// 1000.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxEntries int64 `json:"maxEntries,omitempty"`
 }
 
+// LogEnricherStdoutFormat is the format of the audit events logged to stdout.
+// +kubebuilder:validation:Enum=Text;JSON
+type LogEnricherStdoutFormat string
+
+const (
+	// LogEnricherStdoutFormatText logs the audit events in the format of the
+	// operator logs.
+	LogEnricherStdoutFormatText LogEnricherStdoutFormat = "Text"
+	// LogEnricherStdoutFormatJSON writes the audit events as JSON lines.
+	LogEnricherStdoutFormatJSON LogEnricherStdoutFormat = "JSON"
+)
+
+// ContainerIDResolver is the name of a container ID resolver of the log
+// enricher.
+// +kubebuilder:validation:Enum=crio;containerd;docker;cgroupfs
+type ContainerIDResolver string
+
+// SeccompLintSeverity is the severity of a seccomp profile lint rule.
+// +kubebuilder:validation:Enum=Off;Warn;Deny
+type SeccompLintSeverity string
+
+const (
+	// SeccompLintSeverityOff disables the lint rule.
+	SeccompLintSeverityOff SeccompLintSeverity = "Off"
+	// SeccompLintSeverityWarn returns a warning for seccomp profiles
+	// violating the lint rule.
+	SeccompLintSeverityWarn SeccompLintSeverity = "Warn"
+	// SeccompLintSeverityDeny rejects seccomp profiles violating the lint
+	// rule.
+	SeccompLintSeverityDeny SeccompLintSeverity = "Deny"
+)
+
 type WebhookOptions struct {
 	// Name specifies which webhook do we configure
 	Name string `json:"name,omitempty"`
@@ -238,6 +435,12 @@ type SPODSpec struct {
 	// tells the operator whether or not to enable SELinux support for this
 	// SPOD instance.
 	EnableSelinux *bool `json:"enableSelinux,omitempty"`
+	// If specified, SELinux support is only enabled on the nodes matching
+	// all of the labels. The daemon runs without SELinux support on all the
+	// other nodes, which allows to run SPOD in clusters with mixed operating
+	// systems.
+	// +optional
+	SelinuxNodeSelector map[string]string `json:"selinuxNodeSelector,omitempty"`
 	// If specified, the SELinux type tag applied to the security context of SPOD.
 	// +optional
 	// +kubebuilder:default="spc_t"
@@ -245,12 +448,103 @@ type SPODSpec struct {
 	// tells the operator whether or not to enable log enrichment support for this
 	// SPOD instance.
 	EnableLogEnricher bool `json:"enableLogEnricher,omitempty"`
+	// tells the log enricher to emit rate-limited Kubernetes Warning events
+	// on pods which cause denials but are not being recorded. Requires the
+	// log enricher to be enabled.
+	// +optional
+	EnableDenialEvents bool `json:"enableDenialEvents,omitempty"`
+	// tells the log enricher to subscribe to the kernel audit netlink socket
+	// instead of tailing the audit log or syslog files on the node. This
+	// removes the dependency on auditd writing to a file. Requires the log
+	// enricher to be enabled.
+	// +optional
+	EnableAuditNetlink bool `json:"enableAuditNetlink,omitempty"`
+	// LogEnricherSinks if defined, configures additional outputs for the
+	// enriched audit events of the log enricher, for example to be consumed
+	// by SIEM pipelines. Requires the log enricher to be enabled.
+	// +optional
+	LogEnricherSinks *LogEnricherSinkOptions `json:"logEnricherSinks,omitempty"`
+	// LogEnricherRetention if specified, is the duration after which the log
+	// enricher evicts the recorded syscalls, AVCs and AppArmor events of a
+	// profile which have been neither updated nor read, for example "24h".
+	// This frees the data of recordings which never complete. The retention
+	// has to be longer than the time between two audit events of the longest
+	// running recording. Requires the log enricher to be enabled.
+	// +optional
+	LogEnricherRetention *metav1.Duration `json:"logEnricherRetention,omitempty"`
+	// LogEnricherCache if specified, configures the caches of the log enricher
+	// which map the processes of audit events to their containers. Requires
+	// the log enricher to be enabled.
+	// +optional
+	LogEnricherCache *LogEnricherCacheOptions `json:"logEnricherCache,omitempty"`
+	// LogEnricherCRISocket if specified, is the path of the CRI runtime socket
+	// on the nodes, for example /run/containerd/containerd.sock. The log
+	// enricher then looks up the containers of audit events via the runtime
+	// instead of listing the pods via the API server, which is faster and
+	// also works for short lived containers. Requires the log enricher to be
+	// enabled.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/`
+	LogEnricherCRISocket string `json:"logEnricherCRISocket,omitempty"`
+	// LogEnricherContainerIDResolvers if specified, are the container ID
+	// resolvers used by the log enricher to map the processes of audit events
+	// to their containers, in the order of their precedence. Resolvers which
+	// are not listed are disabled. Defaults to crio, containerd, docker and
+	// cgroupfs. Requires the log enricher to be enabled.
+	// +optional
+	// +listType=set
+	LogEnricherContainerIDResolvers []ContainerIDResolver `json:"logEnricherContainerIDResolvers,omitempty"`
+	// LogEnricherBackfill if enabled, replays the audit log file from the
+	// start of a recorded container once the log enricher notices it. This
+	// captures the syscalls issued during the container startup before the
+	// first audit line got processed. Only supported if the log enricher
+	// reads the audit log from a file. Requires the log enricher to be
+	// enabled.
+	// +optional
+	LogEnricherBackfill bool `json:"logEnricherBackfill,omitempty"`
+	// LogEnricherTLSSecret if specified, is the name of a secret of type
+	// kubernetes.io/tls in the operator namespace, which is used for mutual
+	// TLS between the GRPC server of the log enricher and its clients. The
+	// secret has to contain the tls.crt, tls.key and ca.crt keys, where the
+	// certificate has to be valid for localhost as well as for server and
+	// client authentication. Requires the log enricher to be enabled.
+	// +optional
+	LogEnricherTLSSecret string `json:"logEnricherTLSSecret,omitempty"`
 	// tells the operator whether or not to enable bpf recorder support for this
 	// SPOD instance.
 	EnableBpfRecorder bool `json:"enableBpfRecorder,omitempty"`
 	// tells the operator whether or not to enable AppArmor support for this
 	// SPOD instance.
 	EnableAppArmor bool `json:"enableAppArmor,omitempty"`
+	// If specified, AppArmor support is only enabled on the nodes matching
+	// all of the labels. The daemon runs without AppArmor support on all the
+	// other nodes.
+	// +optional
+	AppArmorNodeSelector map[string]string `json:"appArmorNodeSelector,omitempty"`
+	// tells the operator whether or not to enable Landlock support for this
+	// SPOD instance. Requires the Landlock feature gate to be enabled.
+	// +optional
+	EnableLandlock bool `json:"enableLandlock,omitempty"`
+	// tells the operator whether or not to deploy default Prometheus alerting
+	// rules for this SPOD instance. Requires the prometheus operator.
+	// +optional
+	EnableAlertingRules bool `json:"enableAlertingRules,omitempty"`
+	// tells the operator whether or not to additionally export the metrics
+	// of this SPOD instance with their legacy security_profiles_operator_
+	// prefixed names, to ease the migration of dashboards and alerts.
+	// +optional
+	EnableLegacyMetricNames bool `json:"enableLegacyMetricNames,omitempty"`
+	// tells the daemon to taint its node with spo.x-k8s.io/profiles-not-synced
+	// until all profiles have been installed after it started, to keep
+	// workloads using localhost profiles from being scheduled on the node too
+	// early. The daemon tolerates the taint.
+	// +optional
+	EnableStartupTaint bool `json:"enableStartupTaint,omitempty"`
+	// tells the daemons to only render and report the profiles, without
+	// writing them to the node filesystem or selinuxd. The node statuses of
+	// the profiles are set to DryRun and the profiles are not bound to pods.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 	// If specified, the SPOD's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
@@ -271,6 +565,28 @@ type SPODSpec struct {
 	// SPO's webhooks
 	// +optional
 	WebhookOpts []WebhookOptions `json:"webhookOptions,omitempty"`
+	// BindingAuditMode indicates whether the binding webhook evaluates the
+	// ProfileBindings of new pods without applying them. The bindings which
+	// would have been applied are logged and counted by the
+	// "spo_binding_audit_total" metric of the webhook instead, which allows
+	// assessing the impact of enforcing profiles in existing clusters.
+	// +optional
+	BindingAuditMode bool `json:"bindingAuditMode,omitempty"`
+	// BindingPartialInstallTolerance is the percentage of nodes on which a
+	// profile may have failed to install while still being bound to pods.
+	// Pods are rejected by the binding webhook if their profile is
+	// PartiallyInstalled on more failed nodes than tolerated, otherwise
+	// they are admitted with a warning.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BindingPartialInstallTolerance int32 `json:"bindingPartialInstallTolerance,omitempty"`
+	// AllowedRecorders if specified, a list of recorders which can be used
+	// by ProfileRecordings within the cluster. ProfileRecordings using any
+	// other recorder are rejected and their workloads are not recorded.
+	// Supported recorders are "logs" and "bpf".
+	// +optional
+	AllowedRecorders []profilerecordingv1alpha1.ProfileRecorder `json:"allowedRecorders,omitempty"`
 	// AllowedSyscalls if specified, a list of system calls which are allowed
 	// in seccomp profiles.
 	// +optional
@@ -291,6 +607,16 @@ type SPODSpec struct {
 	// +optional
 	DaemonResourceRequirements *corev1.ResourceRequirements `json:"daemonResourceRequirements,omitempty"`
 
+	// SelinuxdResourceRequirements if defined, overwrites the default resource
+	// requirements of the selinuxd container.
+	// +optional
+	SelinuxdResourceRequirements *corev1.ResourceRequirements `json:"selinuxdResourceRequirements,omitempty"`
+
+	// LogEnricherResourceRequirements if defined, overwrites the default
+	// resource requirements of the log-enricher container.
+	// +optional
+	LogEnricherResourceRequirements *corev1.ResourceRequirements `json:"logEnricherResourceRequirements,omitempty"`
+
 	// PriorityClassName if defined, indicates the spod pod priority class.
 	// +optional
 	// +kubebuilder:default="system-node-critical"
@@ -300,6 +626,39 @@ type SPODSpec struct {
 	// artifact signature verification.
 	// +optional
 	DisableOCIArtifactSignatureVerification bool `json:"disableOciArtifactSignatureVerification"`
+
+	// OCIArtifactSignaturePolicy if defined, restricts the signatures which
+	// are accepted for profiles pulled from OCI artifacts, for example to a
+	// public key or to a set of signing identities. Has no effect if the
+	// signature verification is disabled.
+	// +optional
+	OCIArtifactSignaturePolicy *OCIArtifactSignaturePolicy `json:"ociArtifactSignaturePolicy,omitempty"`
+
+	// Compliance if defined, enables the generation of ComplianceReports
+	// which check profiles, bindings and workloads against benchmark rules.
+	// +optional
+	Compliance *ComplianceOptions `json:"compliance,omitempty"`
+
+	// RecordedProfileGC if defined, enables the garbage collection of
+	// recorded SeccompProfiles and SelinuxProfiles which have not been used
+	// by any pod for the configured retention period.
+	// +optional
+	RecordedProfileGC *RecordedProfileGCOptions `json:"recordedProfileGC,omitempty"`
+
+	// FeatureGates enables or disables features by their name, for
+	// example {"BpfRecorder": false}. Features which are disabled by their
+	// gate are not available, even if they are enabled by another option.
+	// The SPO_FEATURE_GATES environment variable of the operator takes
+	// precedence.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// SeccompLintRules configures the severity of the lint rules checked
+	// for new and updated seccomp profiles by their name, for example
+	// {"dangerous-syscalls": "Deny"}. Rules which are not configured
+	// return warnings.
+	// +optional
+	SeccompLintRules map[string]SeccompLintSeverity `json:"seccompLintRules,omitempty"`
 }
 
 // SPODState defines the state that the spod is in.
@@ -318,12 +677,36 @@ const (
 	SPODStateError SPODState = "ERROR"
 )
 
+// SelinuxMode is the SELinux mode of a node.
+type SelinuxMode string
+
+const (
+	// SelinuxModeEnforcing indicates that SELinux is enforcing its policy.
+	SelinuxModeEnforcing SelinuxMode = "Enforcing"
+	// SelinuxModePermissive indicates that SELinux only logs denials.
+	SelinuxModePermissive SelinuxMode = "Permissive"
+	// SelinuxModeDisabled indicates that SELinux is not enabled.
+	SelinuxModeDisabled SelinuxMode = "Disabled"
+)
+
 // SPODStatus defines the observed state of SPOD.
 type SPODStatus struct {
 	ConditionedStatus `json:",inline"`
 	// Represents the state that the policy is in. Can be:
 	// PENDING, IN-PROGRESS, RUNNING or ERROR
 	State SPODState `json:"state,omitempty"`
+	// SelinuxModes contains the SELinux mode per node name, reported by
+	// the daemon if SELinux support is enabled.
+	// +optional
+	SelinuxModes map[string]SelinuxMode `json:"selinuxModes,omitempty"`
+	// DaemonVersions contains the build version of the daemon per node
+	// name, reported by the daemon on startup.
+	// +optional
+	DaemonVersions map[string]string `json:"daemonVersions,omitempty"`
+	// FeatureGates contains the effective state of all known feature
+	// gates.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -369,6 +752,12 @@ func (s *SPODStatus) StateRunning() {
 	s.ConditionedStatus.SetConditions(Available())
 }
 
+// RecorderAllowed returns true if ProfileRecordings can use the provided
+// recorder, which is the case for all recorders if none got configured.
+func (s *SPODSpec) RecorderAllowed(recorder profilerecordingv1alpha1.ProfileRecorder) bool {
+	return len(s.AllowedRecorders) == 0 || slices.Contains(s.AllowedRecorders, recorder)
+}
+
 func init() { //nolint:gochecknoinits // required to init the scheme
 	SchemeBuilder.Register(&SecurityProfilesOperatorDaemon{}, &SecurityProfilesOperatorDaemonList{})
 }
@@ -26,8 +26,35 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	compliancereportv1alpha1 "sigs.k8s.io/security-profiles-operator/api/compliancereport/v1alpha1"
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceOptions) DeepCopyInto(out *ComplianceOptions) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]compliancereportv1alpha1.ComplianceRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceOptions.
+func (in *ComplianceOptions) DeepCopy() *ComplianceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionedStatus) DeepCopyInto(out *ConditionedStatus) {
 	*out = *in
@@ -50,6 +77,92 @@ func (in *ConditionedStatus) DeepCopy() *ConditionedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEnricherCacheOptions) DeepCopyInto(out *LogEnricherCacheOptions) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEnricherCacheOptions.
+func (in *LogEnricherCacheOptions) DeepCopy() *LogEnricherCacheOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEnricherCacheOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEnricherSinkOptions) DeepCopyInto(out *LogEnricherSinkOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEnricherSinkOptions.
+func (in *LogEnricherSinkOptions) DeepCopy() *LogEnricherSinkOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEnricherSinkOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactSignatureIdentity) DeepCopyInto(out *OCIArtifactSignatureIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactSignatureIdentity.
+func (in *OCIArtifactSignatureIdentity) DeepCopy() *OCIArtifactSignatureIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactSignatureIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactSignaturePolicy) DeepCopyInto(out *OCIArtifactSignaturePolicy) {
+	*out = *in
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make([]OCIArtifactSignatureIdentity, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactSignaturePolicy.
+func (in *OCIArtifactSignaturePolicy) DeepCopy() *OCIArtifactSignaturePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactSignaturePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordedProfileGCOptions) DeepCopyInto(out *RecordedProfileGCOptions) {
+	*out = *in
+	out.Retention = in.Retention
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordedProfileGCOptions.
+func (in *RecordedProfileGCOptions) DeepCopy() *RecordedProfileGCOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordedProfileGCOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 	*out = *in
@@ -58,6 +171,40 @@ func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SelinuxNodeSelector != nil {
+		in, out := &in.SelinuxNodeSelector, &out.SelinuxNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LogEnricherSinks != nil {
+		in, out := &in.LogEnricherSinks, &out.LogEnricherSinks
+		*out = new(LogEnricherSinkOptions)
+		**out = **in
+	}
+	if in.LogEnricherRetention != nil {
+		in, out := &in.LogEnricherRetention, &out.LogEnricherRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LogEnricherCache != nil {
+		in, out := &in.LogEnricherCache, &out.LogEnricherCache
+		*out = new(LogEnricherCacheOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogEnricherContainerIDResolvers != nil {
+		in, out := &in.LogEnricherContainerIDResolvers, &out.LogEnricherContainerIDResolvers
+		*out = make([]ContainerIDResolver, len(*in))
+		copy(*out, *in)
+	}
+	if in.AppArmorNodeSelector != nil {
+		in, out := &in.AppArmorNodeSelector, &out.AppArmorNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]corev1.Toleration, len(*in))
@@ -73,6 +220,11 @@ func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AllowedRecorders != nil {
+		in, out := &in.AllowedRecorders, &out.AllowedRecorders
+		*out = make([]profilerecordingv1alpha1.ProfileRecorder, len(*in))
+		copy(*out, *in)
+	}
 	if in.AllowedSyscalls != nil {
 		in, out := &in.AllowedSyscalls, &out.AllowedSyscalls
 		*out = make([]string, len(*in))
@@ -98,6 +250,45 @@ func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SelinuxdResourceRequirements != nil {
+		in, out := &in.SelinuxdResourceRequirements, &out.SelinuxdResourceRequirements
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogEnricherResourceRequirements != nil {
+		in, out := &in.LogEnricherResourceRequirements, &out.LogEnricherResourceRequirements
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCIArtifactSignaturePolicy != nil {
+		in, out := &in.OCIArtifactSignaturePolicy, &out.OCIArtifactSignaturePolicy
+		*out = new(OCIArtifactSignaturePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compliance != nil {
+		in, out := &in.Compliance, &out.Compliance
+		*out = new(ComplianceOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecordedProfileGC != nil {
+		in, out := &in.RecordedProfileGC, &out.RecordedProfileGC
+		*out = new(RecordedProfileGCOptions)
+		**out = **in
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SeccompLintRules != nil {
+		in, out := &in.SeccompLintRules, &out.SeccompLintRules
+		*out = make(map[string]SeccompLintSeverity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPODSpec.
@@ -114,6 +305,27 @@ func (in *SPODSpec) DeepCopy() *SPODSpec {
 func (in *SPODStatus) DeepCopyInto(out *SPODStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.SelinuxModes != nil {
+		in, out := &in.SelinuxModes, &out.SelinuxModes
+		*out = make(map[string]SelinuxMode, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DaemonVersions != nil {
+		in, out := &in.DaemonVersions, &out.DaemonVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPODStatus.
@@ -193,6 +405,11 @@ func (in *SelinuxOptions) DeepCopyInto(out *SelinuxOptions) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedBooleans != nil {
+		in, out := &in.AllowedBooleans, &out.AllowedBooleans
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelinuxOptions.
@@ -45,6 +45,8 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	landlockprofileapi "sigs.k8s.io/security-profiles-operator/api/landlockprofile/v1alpha1"
 	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
@@ -57,12 +59,22 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/initialsync"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/landlockprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profileserver"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/selinuxprofile"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/syscallstream"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/versionreporter"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/compliance"
 	nodestatus "sigs.k8s.io/security-profiles-operator/internal/pkg/manager/nodestatus"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/notification"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/profilegc"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/profilemirror"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/recordingmerger"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/selinuxusage"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/workloadannotator"
@@ -71,6 +83,7 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/version"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/recording"
+	seccompprofilewebhook "sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/seccompprofile"
 )
 
 const (
@@ -79,6 +92,7 @@ const (
 	recordingFlag      string = "with-recording"
 	selinuxFlag        string = "with-selinux"
 	apparmorFlag       string = "with-apparmor"
+	landlockFlag       string = "with-landlock"
 	webhookFlag        string = "webhook"
 	memOptimFlag       string = "with-mem-optim"
 	defaultWebhookPort int    = 9443
@@ -133,6 +147,11 @@ func main() {
 					Usage: "Listen for AppArmor API resources",
 					Value: false,
 				},
+				&cli.BoolFlag{
+					Name:  landlockFlag,
+					Usage: "Listen for Landlock API resources",
+					Value: false,
+				},
 				&cli.BoolFlag{
 					Name:    recordingFlag,
 					Usage:   "Listen for ProfileRecording API resources",
@@ -333,6 +352,12 @@ func runManager(ctx *cli.Context, info *version.Info) error {
 	if err := selxv1alpha2.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add selinuxprofile API to scheme: %w", err)
 	}
+	if err := apparmorprofileapi.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add apparmorprofile API to scheme: %w", err)
+	}
+	if err := landlockprofileapi.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add landlockprofile API to scheme: %w", err)
+	}
 	if err := monitoringv1.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add ServiceMonitor API to scheme: %w", err)
 	}
@@ -344,6 +369,11 @@ func runManager(ctx *cli.Context, info *version.Info) error {
 			spod.NewController(),
 			workloadannotator.NewController(),
 			recordingmerger.NewController(),
+			notification.NewController(),
+			profilemirror.NewController(),
+			selinuxusage.NewController(),
+			compliance.NewController(),
+			profilegc.NewController(),
 		}, mgr, nil); err != nil {
 		return fmt.Errorf("enable controllers: %w", err)
 	}
@@ -396,6 +426,10 @@ func setControllerOptionsForNamespaces(opts *ctrl.Options) {
 func getEnabledControllers(ctx *cli.Context) []controller.Controller {
 	controllers := []controller.Controller{
 		seccompprofile.NewController(),
+		versionreporter.NewController(),
+	}
+	profileLists := []func() client.ObjectList{
+		func() client.ObjectList { return &seccompprofileapi.SeccompProfileList{} },
 	}
 
 	if ctx.Bool(recordingFlag) {
@@ -405,14 +439,26 @@ func getEnabledControllers(ctx *cli.Context) []controller.Controller {
 	if ctx.Bool(selinuxFlag) {
 		controllers = append(controllers,
 			selinuxprofile.NewController(),
-			selinuxprofile.NewRawController())
+			selinuxprofile.NewRawController(),
+			selinuxprofile.NewModeController())
+		profileLists = append(profileLists,
+			func() client.ObjectList { return &selxv1alpha2.SelinuxProfileList{} },
+			func() client.ObjectList { return &selxv1alpha2.RawSelinuxProfileList{} })
 	}
 
 	if ctx.Bool(apparmorFlag) {
 		controllers = append(controllers, apparmorprofile.NewController())
+		profileLists = append(profileLists,
+			func() client.ObjectList { return &apparmorprofileapi.AppArmorProfileList{} })
 	}
 
-	return controllers
+	if ctx.Bool(landlockFlag) {
+		controllers = append(controllers, landlockprofile.NewController())
+		profileLists = append(profileLists,
+			func() client.ObjectList { return &landlockprofileapi.LandlockProfileList{} })
+	}
+
+	return append(controllers, initialsync.NewController(profileLists...))
 }
 
 // newMemoryOptimizedCache creates a memory optimized cache for daemon controller.
@@ -460,6 +506,12 @@ func runDaemon(ctx *cli.Context, info *version.Info) error {
 		return fmt.Errorf("start metrics grpc server: %w", err)
 	}
 
+	// Serve the installed profiles for debugging the node state
+	profiles := profileserver.New(ctrl.Log.WithName("profile-server")).Handler()
+
+	// Bridge the syscalls stream of the log enricher to Server-Sent Events
+	syscalls := syscallstream.New(ctrl.Log.WithName("syscall-stream")).Handler()
+
 	disableHTTP2 := func(c *tls.Config) {
 		c.NextProtos = []string{"http/1.1"}
 	}
@@ -468,8 +520,12 @@ func runDaemon(ctx *cli.Context, info *version.Info) error {
 		HealthProbeBindAddress: fmt.Sprintf(":%d", config.HealthProbePort),
 		NewCache:               newMemoryOptimizedCache(ctx),
 		Metrics: metricsserver.Options{
+			BindAddress: config.DaemonMetricsBindAddress,
 			ExtraHandlers: map[string]http.Handler{
-				metrics.HandlerPath: met.Handler(),
+				metrics.HandlerPath:             met.Handler(),
+				profileserver.HandlerPath:       profiles,
+				profileserver.HandlerPath + "/": profiles,
+				syscallstream.HandlerPath:       syscalls,
 			},
 			TLSOpts: []func(*tls.Config){disableHTTP2},
 		},
@@ -529,11 +585,13 @@ func runNonRootEnabler(ctx *cli.Context, info *version.Info) error {
 	if err != nil {
 		return fmt.Errorf("creating manager: %w", err)
 	}
+	logger := ctrl.Log.WithName(component)
+	enabler := nonrootenabler.New()
 	kubeletDir, err := util.GetKubeletDirFromNodeLabel(ctx.Context, mgr.GetAPIReader())
 	if err != nil {
-		kubeletDir = config.KubeletDir()
+		kubeletDir = enabler.DiscoverKubeletDir(logger)
 	}
-	return nonrootenabler.New().Run(ctrl.Log.WithName(component), runtime, kubeletDir)
+	return enabler.Run(logger, runtime, kubeletDir)
 }
 
 func runWebhook(ctx *cli.Context, info *version.Info) error {
@@ -578,11 +636,15 @@ func runWebhook(ctx *cli.Context, info *version.Info) error {
 	if err := profilerecording1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add profilerecording API to scheme: %w", err)
 	}
+	if err := spodv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add SPOD config API to scheme: %w", err)
+	}
 
 	setupLog.Info("registering webhooks")
 	hookserver := mgr.GetWebhookServer()
 	binding.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetClient())
 	recording.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetEventRecorderFor("recording-webhook"), mgr.GetClient())
+	seccompprofilewebhook.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetClient())
 
 	sigHandler := ctrl.SetupSignalHandler()
 	setupLog.Info("starting webhook")
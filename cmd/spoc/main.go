@@ -26,10 +26,21 @@ import (
 
 	"sigs.k8s.io/security-profiles-operator/cmd"
 	spocli "sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/checker"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/converter"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/differ"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/exporter"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/importer"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/linter"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/merger"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/puller"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/pusher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/recorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/restorer"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/runner"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/snapshotter"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/subtractor"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/seccomplint"
 )
 
 func main() {
@@ -74,6 +85,15 @@ func main() {
 					Aliases: []string{"n"},
 					Usage:   "do not add any base syscalls at all",
 				},
+				&cli.StringFlag{
+					Name:        recorder.FlagName,
+					Usage:       "the name of the recorded seccomp CRD profile",
+					DefaultText: "base name of the command",
+				},
+				&cli.StringFlag{
+					Name:  recorder.FlagNamespace,
+					Usage: "the namespace of the recorded seccomp CRD profile",
+				},
 			},
 		},
 		&cli.Command{
@@ -169,6 +189,218 @@ func main() {
 				},
 			},
 		},
+		&cli.Command{
+			Name:      "subtract",
+			Aliases:   []string{"s"},
+			Usage:     "subtract a base profile from a seccomp profile",
+			Action:    subtract,
+			ArgsUsage: "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:      subtractor.FlagBaseProfile,
+					Aliases:   []string{"b"},
+					Usage:     "the base profile to be subtracted, for example the runtime default",
+					TakesFile: true,
+				},
+				&cli.StringFlag{
+					Name:    subtractor.FlagBaseProfileName,
+					Aliases: []string{"n"},
+					Usage:   "the base profile name to be referenced by the resulting profile",
+					DefaultText: fmt.Sprintf(
+						"the name of the %s",
+						subtractor.FlagBaseProfile,
+					),
+				},
+				&cli.StringFlag{
+					Name:        subtractor.FlagOutputFile,
+					Aliases:     []string{"o"},
+					Usage:       "the output file to store the profile",
+					DefaultText: subtractor.DefaultOutputFile,
+					TakesFile:   true,
+				},
+			},
+		},
+		&cli.Command{
+			Name:      "diff",
+			Aliases:   []string{"d"},
+			Usage:     "show the differences between two seccomp profiles",
+			Action:    diff,
+			ArgsUsage: "OLD NEW",
+		},
+		&cli.Command{
+			Name:      "merge",
+			Aliases:   []string{"m"},
+			Usage:     "merge multiple seccomp profiles into a single one",
+			Action:    merge,
+			ArgsUsage: "FILE FILE...",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:    merger.FlagIntersect,
+					Aliases: []string{"i"},
+					Usage:   "build the intersection instead of the union of the profiles",
+				},
+				&cli.StringFlag{
+					Name:        merger.FlagOutputFile,
+					Aliases:     []string{"o"},
+					Usage:       "the output file path for the merged profile",
+					DefaultText: merger.DefaultOutputFile,
+					TakesFile:   true,
+				},
+			},
+		},
+		&cli.Command{
+			Name:      "convert",
+			Aliases:   []string{"v"},
+			Usage:     "convert a seccomp profile between different formats",
+			Action:    convert,
+			ArgsUsage: "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    converter.FlagFrom,
+					Aliases: []string{"f"},
+					Usage:   "the format of the input profile",
+					DefaultText: fmt.Sprintf(
+						"%s for JSON files, otherwise %s [alternative: %s]",
+						converter.FormatRaw,
+						converter.FormatCRD,
+						converter.FormatDocker,
+					),
+				},
+				&cli.StringFlag{
+					Name:    converter.FlagTo,
+					Aliases: []string{"t"},
+					Usage:   "the format of the output profile",
+					DefaultText: fmt.Sprintf(
+						"%s [alternatives: %s, %s]",
+						converter.FormatCRD,
+						converter.FormatRaw,
+						converter.FormatDocker,
+					),
+				},
+				&cli.StringFlag{
+					Name:        converter.FlagOutputFile,
+					Aliases:     []string{"o"},
+					Usage:       "the output file path for the converted profile",
+					DefaultText: converter.DefaultOutputFile,
+					TakesFile:   true,
+				},
+			},
+		},
+		&cli.Command{
+			Name:      "lint",
+			Aliases:   []string{"c"},
+			Usage:     "check seccomp profiles against lint rules",
+			Action:    lint,
+			ArgsUsage: "FILE...",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:    linter.FlagRules,
+					Aliases: []string{"r"},
+					Usage: fmt.Sprintf(
+						"the severities of the rules %v in `RULE:SEVERITY` format, where SEVERITY is off, warn or deny",
+						seccomplint.Rules,
+					),
+				},
+			},
+		},
+		&cli.Command{
+			Name:    "export-kyverno",
+			Aliases: []string{"k"},
+			Usage:   "export Kyverno policies requiring the profiles of the profile bindings in the cluster",
+			Action:  exportKyverno,
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:        exporter.FlagNamespaces,
+					Aliases:     []string{"n"},
+					Usage:       "the namespaces to export the policies for",
+					DefaultText: "all namespaces",
+				},
+				&cli.BoolFlag{
+					Name:    exporter.FlagEnforce,
+					Aliases: []string{"e"},
+					Usage:   "reject violating pods instead of only reporting them",
+				},
+				&cli.StringFlag{
+					Name:        exporter.FlagOutputFile,
+					Aliases:     []string{"o"},
+					Usage:       "the output file to store the policies",
+					DefaultText: exporter.DefaultOutputFile,
+					TakesFile:   true,
+				},
+			},
+		},
+		&cli.Command{
+			Name:      "import",
+			Aliases:   []string{"i"},
+			Usage:     "import existing seccomp JSON profiles into the cluster as SeccompProfiles",
+			Action:    importProfiles,
+			ArgsUsage: "DIRECTORY|ARCHIVE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        importer.FlagNamespace,
+					Aliases:     []string{"n"},
+					Usage:       "the namespace of the imported profiles",
+					DefaultText: importer.DefaultNamespace,
+				},
+			},
+		},
+		&cli.Command{
+			Name:    "check-recording",
+			Aliases: []string{"v"},
+			Usage:   "check on every node if the prerequisites for recording profiles are met",
+			Action:  checkRecording,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        checker.FlagNamespace,
+					Aliases:     []string{"n"},
+					Usage:       "the namespace of the operator",
+					DefaultText: checker.DefaultNamespace,
+				},
+				&cli.Int64Flag{
+					Name:        checker.FlagLogLines,
+					Usage:       "the amount of latest log lines of the log enricher to be inspected",
+					DefaultText: fmt.Sprint(checker.DefaultLogLines),
+				},
+			},
+		},
+		&cli.Command{
+			Name:    "snapshot",
+			Aliases: []string{"b"},
+			Usage:   "save all resources managed by the operator into a bundle for restoring them on another cluster",
+			Action:  snapshot,
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:        snapshotter.FlagNamespaces,
+					Aliases:     []string{"n"},
+					Usage:       "the namespaces of the resources to be saved",
+					DefaultText: "all namespaces",
+				},
+				&cli.StringFlag{
+					Name:        snapshotter.FlagOutputFile,
+					Aliases:     []string{"o"},
+					Usage:       "the output file to store the bundle",
+					DefaultText: snapshotter.DefaultOutputFile,
+					TakesFile:   true,
+				},
+			},
+		},
+		&cli.Command{
+			Name:      "restore",
+			Aliases:   []string{"e"},
+			Usage:     "restore the resources of a bundle created by the snapshot command",
+			Action:    restore,
+			ArgsUsage: "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name: restorer.FlagOnConflict,
+					Usage: fmt.Sprintf(
+						"how to handle already existing resources, one of: %s, %s, %s",
+						restorer.OnConflictSkip, restorer.OnConflictOverwrite, restorer.OnConflictFail,
+					),
+					DefaultText: restorer.DefaultOnConflict,
+				},
+			},
+		},
 	)
 
 	if err := app.Run(os.Args); err != nil {
@@ -231,3 +463,143 @@ func pull(ctx *cli.Context) error {
 
 	return nil
 }
+
+// subtract runs the `spoc subtract` subcommand.
+func subtract(ctx *cli.Context) error {
+	options, err := subtractor.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := subtractor.New(options).Run(); err != nil {
+		return fmt.Errorf("run subtractor: %w", err)
+	}
+
+	return nil
+}
+
+// diff runs the `spoc diff` subcommand.
+func diff(ctx *cli.Context) error {
+	options, err := differ.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := differ.New(options).Run(); err != nil {
+		return fmt.Errorf("run differ: %w", err)
+	}
+
+	return nil
+}
+
+// merge runs the `spoc merge` subcommand.
+func merge(ctx *cli.Context) error {
+	options, err := merger.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := merger.New(options).Run(); err != nil {
+		return fmt.Errorf("run merger: %w", err)
+	}
+
+	return nil
+}
+
+// convert runs the `spoc convert` subcommand.
+func convert(ctx *cli.Context) error {
+	options, err := converter.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := converter.New(options).Run(); err != nil {
+		return fmt.Errorf("run converter: %w", err)
+	}
+
+	return nil
+}
+
+// lint runs the `spoc lint` subcommand.
+func lint(ctx *cli.Context) error {
+	options, err := linter.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := linter.New(options).Run(); err != nil {
+		return fmt.Errorf("run linter: %w", err)
+	}
+
+	return nil
+}
+
+// exportKyverno runs the `spoc export-kyverno` subcommand.
+func exportKyverno(ctx *cli.Context) error {
+	options, err := exporter.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := exporter.New(options).Run(); err != nil {
+		return fmt.Errorf("run exporter: %w", err)
+	}
+
+	return nil
+}
+
+// importProfiles runs the `spoc import` subcommand.
+func importProfiles(ctx *cli.Context) error {
+	options, err := importer.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := importer.New(options).Run(); err != nil {
+		return fmt.Errorf("run importer: %w", err)
+	}
+
+	return nil
+}
+
+// checkRecording runs the `spoc check-recording` subcommand.
+func checkRecording(ctx *cli.Context) error {
+	options, err := checker.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := checker.New(options).Run(); err != nil {
+		return fmt.Errorf("run checker: %w", err)
+	}
+
+	return nil
+}
+
+// snapshot runs the `spoc snapshot` subcommand.
+func snapshot(ctx *cli.Context) error {
+	options, err := snapshotter.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := snapshotter.New(options).Run(); err != nil {
+		return fmt.Errorf("run snapshotter: %w", err)
+	}
+
+	return nil
+}
+
+// restore runs the `spoc restore` subcommand.
+func restore(ctx *cli.Context) error {
+	options, err := restorer.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := restorer.New(options).Run(); err != nil {
+		return fmt.Errorf("run restorer: %w", err)
+	}
+
+	return nil
+}
@@ -18,7 +18,9 @@ package artifact
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -34,6 +36,7 @@ import (
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 )
 
 // PullResult is the type returned by Pull.
@@ -247,20 +250,13 @@ func (a *Artifact) Pull(
 	from, username, password string,
 	platform *v1.Platform,
 	disableSignatureVerification bool,
+	signaturePolicy *spodv1alpha1.OCIArtifactSignaturePolicy,
 ) (*PullResult, error) {
 	ctx, cancel := context.WithTimeout(c, defaultTimeout)
 	defer cancel()
 
 	if !disableSignatureVerification {
-		a.logger.Info("Verifying signature")
-		const all = ".*"
-		v := verify.VerifyCommand{
-			CertVerifyOptions: options.CertVerifyOptions{
-				CertIdentityRegexp:   all,
-				CertOidcIssuerRegexp: all,
-			},
-		}
-		if err := a.VerifyCmd(ctx, v, from); err != nil {
+		if err := a.verifySignature(ctx, from, signaturePolicy); err != nil {
 			return nil, fmt.Errorf("verify signature: %w", err)
 		}
 	}
@@ -374,6 +370,87 @@ func (a *Artifact) Pull(
 	return nil, fmt.Errorf("%w: last err: %w", ErrDecodeYAML, err)
 }
 
+// verifySignature verifies the signature of the artifact against the
+// provided policy. Keyless signatures of any identity are accepted if no
+// policy is provided.
+func (a *Artifact) verifySignature(
+	ctx context.Context, from string, policy *spodv1alpha1.OCIArtifactSignaturePolicy,
+) error {
+	if policy != nil && policy.PublicKey != "" {
+		a.logger.Info("Verifying signature using public key")
+		dir, err := a.MkdirTemp("", "verify-")
+		if err != nil {
+			return fmt.Errorf("create temp dir: %w", err)
+		}
+		defer func() {
+			if err := a.RemoveAll(dir); err != nil {
+				a.logger.Info("Unable to remove temp dir: " + err.Error())
+			}
+		}()
+
+		keyRef := filepath.Join(dir, publicKeyFile)
+		const keyFileMode = os.FileMode(0o600)
+		if err := a.WriteFile(keyRef, []byte(policy.PublicKey), keyFileMode); err != nil {
+			return fmt.Errorf("write public key: %w", err)
+		}
+
+		if err := a.VerifyCmd(ctx, verify.VerifyCommand{KeyRef: keyRef}, from); err != nil {
+			return fmt.Errorf("verify using public key: %w", err)
+		}
+		return nil
+	}
+
+	identities := []spodv1alpha1.OCIArtifactSignatureIdentity{{}}
+	if policy != nil && len(policy.Identities) > 0 {
+		identities = policy.Identities
+	}
+
+	// The signature is valid if any of the identities signed the artifact.
+	errs := []error{}
+	for i := range identities {
+		identity := &identities[i]
+		a.logger.Info(
+			"Verifying signature",
+			"subject", identity.Subject, "subjectRegexp", identity.SubjectRegexp,
+			"issuer", identity.Issuer, "issuerRegexp", identity.IssuerRegexp,
+		)
+		v := verify.VerifyCommand{CertVerifyOptions: certVerifyOptions(identity)}
+		err := a.VerifyCmd(ctx, v, from)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	return fmt.Errorf("%w: %w", ErrNoAllowedSigner, errors.Join(errs...))
+}
+
+// certVerifyOptions returns the certificate options for verifying a keyless
+// signature of the identity. Unset fields of the identity match any value.
+func certVerifyOptions(identity *spodv1alpha1.OCIArtifactSignatureIdentity) options.CertVerifyOptions {
+	const all = ".*"
+	o := options.CertVerifyOptions{
+		CertIdentity:         identity.Subject,
+		CertIdentityRegexp:   identity.SubjectRegexp,
+		CertOidcIssuer:       identity.Issuer,
+		CertOidcIssuerRegexp: identity.IssuerRegexp,
+	}
+
+	if o.CertIdentity != "" {
+		o.CertIdentityRegexp = ""
+	} else if o.CertIdentityRegexp == "" {
+		o.CertIdentityRegexp = all
+	}
+
+	if o.CertOidcIssuer != "" {
+		o.CertOidcIssuerRegexp = ""
+	} else if o.CertOidcIssuerRegexp == "" {
+		o.CertOidcIssuerRegexp = all
+	}
+
+	return o
+}
+
 // profileName returns the name for the profile based on the platform.
 func profileName(platform *v1.Platform) string {
 	name := strings.Builder{}
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"oras.land/oras-go/v2/registry/remote"
 
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact/artifactfakes"
 )
 
@@ -394,8 +395,146 @@ func TestPull(t *testing.T) {
 			sut := New(logr.Discard())
 			sut.impl = mock
 
-			res, err := sut.Pull(context.Background(), "", "foo", "bar", nil, false)
+			res, err := sut.Pull(context.Background(), "", "foo", "bar", nil, false, nil)
 			assert(res, err)
 		})
 	}
 }
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		policy  *spodv1alpha1.OCIArtifactSignaturePolicy
+		prepare func(mock *artifactfakes.FakeImpl)
+		assert  func(*artifactfakes.FakeImpl, error)
+	}{
+		{
+			name:    "success without policy",
+			prepare: func(*artifactfakes.FakeImpl) {},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.VerifyCmdCallCount())
+				_, v, _ := mock.VerifyCmdArgsForCall(0)
+				require.Equal(t, ".*", v.CertIdentityRegexp)
+				require.Equal(t, ".*", v.CertOidcIssuerRegexp)
+			},
+		},
+		{
+			name: "success with public key",
+			policy: &spodv1alpha1.OCIArtifactSignaturePolicy{
+				PublicKey:  "key",
+				Identities: []spodv1alpha1.OCIArtifactSignatureIdentity{{Subject: "ignored"}},
+			},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.MkdirTempReturns("/tmp/verify", nil)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+				file, data, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, "/tmp/verify/cosign.pub", file)
+				require.Equal(t, []byte("key"), data)
+				require.Equal(t, 1, mock.VerifyCmdCallCount())
+				_, v, _ := mock.VerifyCmdArgsForCall(0)
+				require.Equal(t, file, v.KeyRef)
+				require.Empty(t, v.CertIdentity)
+				require.Equal(t, 1, mock.RemoveAllCallCount())
+			},
+		},
+		{
+			name: "success with second identity",
+			policy: &spodv1alpha1.OCIArtifactSignaturePolicy{
+				Identities: []spodv1alpha1.OCIArtifactSignatureIdentity{
+					{Subject: "foo@example.com", Issuer: "https://accounts.google.com"},
+					{SubjectRegexp: "^https://github.com/org/", IssuerRegexp: "ignored", Issuer: "https://github.com"},
+				},
+			},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.VerifyCmdReturnsOnCall(0, errTest)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.VerifyCmdCallCount())
+
+				_, v, _ := mock.VerifyCmdArgsForCall(0)
+				require.Equal(t, "foo@example.com", v.CertIdentity)
+				require.Empty(t, v.CertIdentityRegexp)
+				require.Equal(t, "https://accounts.google.com", v.CertOidcIssuer)
+				require.Empty(t, v.CertOidcIssuerRegexp)
+
+				_, v, _ = mock.VerifyCmdArgsForCall(1)
+				require.Empty(t, v.CertIdentity)
+				require.Equal(t, "^https://github.com/org/", v.CertIdentityRegexp)
+				require.Equal(t, "https://github.com", v.CertOidcIssuer)
+				require.Empty(t, v.CertOidcIssuerRegexp)
+			},
+		},
+		{
+			name: "failure no identity matches",
+			policy: &spodv1alpha1.OCIArtifactSignaturePolicy{
+				Identities: []spodv1alpha1.OCIArtifactSignatureIdentity{
+					{Subject: "foo@example.com"},
+					{Subject: "bar@example.com"},
+				},
+			},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.VerifyCmdReturns(errTest)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, ErrNoAllowedSigner)
+				require.ErrorIs(t, err, errTest)
+				require.Equal(t, 2, mock.VerifyCmdCallCount())
+			},
+		},
+		{
+			name:   "failure on WriteFile",
+			policy: &spodv1alpha1.OCIArtifactSignaturePolicy{PublicKey: "key"},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.VerifyCmdCallCount())
+			},
+		},
+		{
+			name:   "failure on MkdirTemp",
+			policy: &spodv1alpha1.OCIArtifactSignaturePolicy{PublicKey: "key"},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.MkdirTempReturns("", errTest)
+			},
+			assert: func(mock *artifactfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.VerifyCmdCallCount())
+			},
+		},
+		{
+			name:   "failure on VerifyCmd with public key",
+			policy: &spodv1alpha1.OCIArtifactSignaturePolicy{PublicKey: "key"},
+			prepare: func(mock *artifactfakes.FakeImpl) {
+				mock.VerifyCmdReturns(errTest)
+			},
+			assert: func(_ *artifactfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		policy := tc.policy
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &artifactfakes.FakeImpl{}
+			prepare(mock)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+
+			err := sut.verifySignature(context.Background(), "foo", policy)
+			assert(mock, err)
+		})
+	}
+}
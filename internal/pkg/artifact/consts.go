@@ -27,12 +27,20 @@ const (
 
 	// defaultTimeout is the default timeout for push and pull operations.
 	defaultTimeout = 5 * time.Minute
+
+	// publicKeyFile is the file name of the public key used for verifying
+	// signatures.
+	publicKeyFile = "cosign.pub"
 )
 
 // ErrDecodeYAML is the error returned if no matching type could be decoded on
 // artifact pull.
 var ErrDecodeYAML = errors.New("unable to decode YAML into seccomp, selinux or apparmor profile")
 
+// ErrNoAllowedSigner is the error returned if the signature of a pulled
+// artifact cannot be verified for any of the allowed identities.
+var ErrNoAllowedSigner = errors.New("artifact is not signed by any allowed identity")
+
 // PullResultType are the different types returned for a PullResult.
 type PullResultType string
 
@@ -47,6 +47,7 @@ type impl interface {
 	NewRepository(string) (*remote.Repository, error)
 	Copy(context.Context, oras.ReadOnlyTarget, string, oras.Target, string, oras.CopyOptions) (ocispec.Descriptor, error)
 	ReadFile(string) ([]byte, error)
+	WriteFile(string, []byte, os.FileMode) error
 	YamlUnmarshal([]byte, interface{}) error
 	StoreAdd(context.Context, *file.Store, string, string, string) (ocispec.Descriptor, error)
 	StoreTag(context.Context, *file.Store, ocispec.Descriptor, string) error
@@ -97,6 +98,10 @@ func (*defaultImpl) ReadFile(name string) ([]byte, error) {
 	return os.ReadFile(name)
 }
 
+func (*defaultImpl) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+
 func (*defaultImpl) YamlUnmarshal(y []byte, o interface{}) error {
 	return yaml.Unmarshal(y, o)
 }
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bundle contains the format of the snapshots of all resources
+// managed by the operator, which can be restored on another cluster.
+package bundle
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	landlockprofileapi "sigs.k8s.io/security-profiles-operator/api/landlockprofile/v1alpha1"
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+// Version is the version of the bundle format.
+const Version = "v1"
+
+// ErrUnsupportedVersion is returned when reading a bundle of an unknown
+// version.
+var ErrUnsupportedVersion = errors.New("unsupported bundle version")
+
+// Kind is a kind of resource contained in a bundle.
+type Kind struct {
+	schema.GroupVersionKind
+	// Namespaced is true if the resources of the kind are namespaced.
+	Namespaced bool
+}
+
+// Kinds are the kinds of resources contained in a bundle. They are restored
+// in this order, so that the profiles exist before being referenced by
+// bindings and recordings.
+var Kinds = []Kind{
+	{spodv1alpha1.GroupVersion.WithKind("SecurityProfilesOperatorDaemon"), true},
+	{seccompprofileapi.GroupVersion.WithKind("SeccompProfile"), true},
+	{selinuxprofileapi.GroupVersion.WithKind("SelinuxProfile"), true},
+	{selinuxprofileapi.GroupVersion.WithKind("RawSelinuxProfile"), true},
+	{apparmorprofileapi.GroupVersion.WithKind("AppArmorProfile"), true},
+	{landlockprofileapi.GroupVersion.WithKind("LandlockProfile"), true},
+	{profilebindingv1alpha1.GroupVersion.WithKind("ProfileBindingPolicy"), false},
+	{profilebindingv1alpha1.GroupVersion.WithKind("ProfileBinding"), true},
+	{profilerecordingv1alpha1.GroupVersion.WithKind("ProfileRecording"), true},
+}
+
+// Bundle is a snapshot of the resources managed by the operator.
+type Bundle struct {
+	// Version is the version of the bundle format.
+	Version string `json:"version"`
+	// Created is the time when the snapshot has been taken.
+	Created metav1.Time `json:"created"`
+	// Items are the resources of the snapshot.
+	Items []*unstructured.Unstructured `json:"items"`
+}
+
+// Order returns the position of the kind of the object in Kinds, or the
+// amount of kinds if it is unknown.
+func Order(obj *unstructured.Unstructured) int {
+	gvk := obj.GroupVersionKind()
+	for i := range Kinds {
+		if Kinds[i].GroupVersionKind == gvk {
+			return i
+		}
+	}
+	return len(Kinds)
+}
+
+// Sanitize removes all fields of the object which are specific to the
+// cluster it has been read from, like its status and the metadata set by the
+// API server or the operator.
+func Sanitize(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "status")
+	for _, field := range []string{
+		"uid",
+		"resourceVersion",
+		"generation",
+		"creationTimestamp",
+		"deletionTimestamp",
+		"deletionGracePeriodSeconds",
+		"managedFields",
+		"ownerReferences",
+		"finalizers",
+		"selfLink",
+	} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+}
+
+// Marshal returns the YAML representation of the bundle.
+func Marshal(b *Bundle) ([]byte, error) {
+	data, err := yaml.Marshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("marshal bundle: %w", err)
+	}
+	return data, nil
+}
+
+// Unmarshal parses a bundle from its YAML or JSON representation and
+// verifies its version.
+func Unmarshal(data []byte) (*Bundle, error) {
+	b := &Bundle{}
+	if err := yaml.Unmarshal(data, b); err != nil {
+		return nil, fmt.Errorf("unmarshal bundle: %w", err)
+	}
+
+	if b.Version != Version {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedVersion, b.Version)
+	}
+
+	for i, item := range b.Items {
+		if item == nil || item.GetKind() == "" || item.GetName() == "" {
+			return nil, fmt.Errorf("item %d: missing kind or name", i)
+		}
+		if Order(item) == len(Kinds) {
+			return nil, fmt.Errorf("item %d: unsupported kind %s", i, item.GroupVersionKind())
+		}
+	}
+
+	return b, nil
+}
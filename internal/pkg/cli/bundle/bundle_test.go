@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func testProfile() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "security-profiles-operator.x-k8s.io/v1beta1",
+		"kind":       "SeccompProfile",
+		"metadata": map[string]interface{}{
+			"name":              "profile",
+			"namespace":         "default",
+			"uid":               "2c1b3a6e",
+			"resourceVersion":   "42",
+			"creationTimestamp": "2023-10-20T10:20:00Z",
+			"finalizers":        []interface{}{"node-1-deleted"},
+			"labels":            map[string]interface{}{"app": "nginx"},
+		},
+		"spec":   map[string]interface{}{"defaultAction": "SCMP_ACT_ERRNO"},
+		"status": map[string]interface{}{"status": "Installed"},
+	}}
+}
+
+func TestSanitize(t *testing.T) {
+	t.Parallel()
+
+	obj := testProfile()
+	Sanitize(obj)
+
+	require.Equal(t, map[string]interface{}{
+		"apiVersion": "security-profiles-operator.x-k8s.io/v1beta1",
+		"kind":       "SeccompProfile",
+		"metadata": map[string]interface{}{
+			"name":      "profile",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "nginx"},
+		},
+		"spec": map[string]interface{}{"defaultAction": "SCMP_ACT_ERRNO"},
+	}, obj.Object)
+}
+
+func TestOrder(t *testing.T) {
+	t.Parallel()
+
+	spod := &unstructured.Unstructured{}
+	spod.SetGroupVersionKind(Kinds[0].GroupVersionKind)
+	binding := &unstructured.Unstructured{}
+	binding.SetAPIVersion("security-profiles-operator.x-k8s.io/v1alpha1")
+	binding.SetKind("ProfileBinding")
+	unknown := &unstructured.Unstructured{}
+	unknown.SetAPIVersion("v1")
+	unknown.SetKind("Pod")
+
+	require.Zero(t, Order(spod))
+	require.Less(t, Order(testProfile()), Order(binding))
+	require.Equal(t, len(Kinds), Order(unknown))
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	created := metav1.NewTime(time.Date(2023, 10, 20, 10, 20, 0, 0, time.UTC))
+	data, err := Marshal(&Bundle{
+		Version: Version,
+		Created: created,
+		Items:   []*unstructured.Unstructured{testProfile()},
+	})
+	require.NoError(t, err)
+
+	b, err := Unmarshal(data)
+	require.NoError(t, err)
+	require.True(t, created.Equal(&b.Created))
+	require.Len(t, b.Items, 1)
+	require.Equal(t, "profile", b.Items[0].GetName())
+	require.Equal(t, "SeccompProfile", b.Items[0].GetKind())
+}
+
+func TestUnmarshalFailure(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		data string
+	}{
+		{name: "invalid YAML", data: "version: [v1"},
+		{name: "unsupported version", data: "version: v2\nitems: []"},
+		{name: "missing name", data: "version: v1\nitems:\n- kind: SeccompProfile"},
+		{
+			name: "unsupported kind",
+			data: "version: v1\nitems:\n- apiVersion: v1\n  kind: Pod\n  metadata:\n    name: pod",
+		},
+	} {
+		data := tc.data
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Unmarshal([]byte(data))
+			require.Error(t, err)
+		})
+	}
+}
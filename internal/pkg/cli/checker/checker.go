@@ -0,0 +1,469 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+const (
+	webhookDeploymentName = config.OperatorName + "-webhook"
+	webhookConfigName     = "spo-mutating-webhook-configuration"
+	webhookServiceAccount = "spo-webhook"
+	recordingWebhookName  = "recording.spo.io"
+
+	// clusterScope is the node of all results which are not specific to a
+	// single node.
+	clusterScope = "<cluster>"
+
+	// auditSourcePrefix is the prefix of the log enricher message telling
+	// which audit source it reads from.
+	auditSourcePrefix = "Reading from "
+)
+
+var errNotReady = errors.New("recording is not ready")
+
+type status string
+
+const (
+	statusOK      status = "OK"
+	statusWarning status = "WARN"
+	statusFailed  status = "FAIL"
+)
+
+type result struct {
+	node    string
+	check   string
+	status  status
+	message string
+}
+
+// permission is a permission required by a service account of the operator
+// for recording profiles.
+type permission struct {
+	serviceAccount string
+	attributes     authorizationv1.ResourceAttributes
+}
+
+var requiredPermissions = []permission{
+	{config.SPOdServiceAccount, authorizationv1.ResourceAttributes{Verb: "get", Resource: "pods"}},
+	{config.SPOdServiceAccount, authorizationv1.ResourceAttributes{Verb: "list", Resource: "pods"}},
+	{config.SPOdServiceAccount, authorizationv1.ResourceAttributes{
+		Verb: "list", Group: profilerecordingv1alpha1.GroupVersion.Group, Resource: "profilerecordings",
+	}},
+	{config.SPOdServiceAccount, authorizationv1.ResourceAttributes{
+		Verb:        "patch",
+		Group:       profilerecordingv1alpha1.GroupVersion.Group,
+		Resource:    "profilerecordings",
+		Subresource: "status",
+	}},
+	{webhookServiceAccount, authorizationv1.ResourceAttributes{Verb: "list", Resource: "pods"}},
+	{webhookServiceAccount, authorizationv1.ResourceAttributes{
+		Verb: "list", Group: profilerecordingv1alpha1.GroupVersion.Group, Resource: "profilerecordings",
+	}},
+	{webhookServiceAccount, authorizationv1.ResourceAttributes{
+		Verb:        "update",
+		Group:       profilerecordingv1alpha1.GroupVersion.Group,
+		Resource:    "profilerecordings",
+		Subresource: "status",
+	}},
+}
+
+// Checker is the main structure of this package.
+type Checker struct {
+	impl
+	options *Options
+	out     io.Writer
+}
+
+// New returns a new Checker instance.
+func New(options *Options) *Checker {
+	return &Checker{
+		impl:    &defaultImpl{},
+		options: options,
+		out:     os.Stdout,
+	}
+}
+
+// Run the Checker.
+func (c *Checker) Run() error {
+	cfg, err := c.GetConfig()
+	if err != nil {
+		return fmt.Errorf("get kubeconfig: %w", err)
+	}
+
+	cl, err := c.NewClient(cfg)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	clientset, err := c.NewClientset(cfg)
+	if err != nil {
+		return fmt.Errorf("create clientset: %w", err)
+	}
+
+	ctx := context.Background()
+	spod, err := c.GetSPOd(ctx, cl, types.NamespacedName{Namespace: c.options.namespace, Name: config.SPOdName})
+	if err != nil {
+		return fmt.Errorf("get SPOD: %w", err)
+	}
+
+	results := []result{checkRecorder(spod)}
+	results = append(results, c.checkWebhook(ctx, cl)...)
+	results = append(results, c.checkPermissions(ctx, cl)...)
+
+	nodeResults, err := c.checkNodes(ctx, cl, clientset, spod)
+	if err != nil {
+		return fmt.Errorf("check nodes: %w", err)
+	}
+	results = append(results, nodeResults...)
+
+	if err := c.printReport(results); err != nil {
+		return fmt.Errorf("print report: %w", err)
+	}
+
+	failed := 0
+	for i := range results {
+		if results[i].status == statusFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%w: %d of %d checks failed", errNotReady, failed, len(results))
+	}
+
+	return nil
+}
+
+// checkRecorder verifies that at least one recorder is enabled in the SPOD.
+func checkRecorder(spod *spodv1alpha1.SecurityProfilesOperatorDaemon) result {
+	res := result{node: clusterScope, check: "recorder", status: statusOK}
+
+	switch {
+	case spod.Spec.EnableLogEnricher && spod.Spec.EnableBpfRecorder:
+		res.message = "log enricher and bpf recorder enabled"
+	case spod.Spec.EnableLogEnricher:
+		res.message = "log enricher enabled"
+	case spod.Spec.EnableBpfRecorder:
+		res.message = "bpf recorder enabled"
+	default:
+		res.status = statusFailed
+		res.message = "neither the log enricher nor the bpf recorder is enabled in the SPOD"
+	}
+
+	return res
+}
+
+// checkWebhook verifies that the recording webhook is registered and served.
+func (c *Checker) checkWebhook(ctx context.Context, cl client.Client) []result {
+	deploymentResult := result{node: clusterScope, check: "webhook deployment", status: statusOK}
+	deployment, err := c.GetDeployment(
+		ctx, cl, types.NamespacedName{Namespace: c.options.namespace, Name: webhookDeploymentName},
+	)
+	switch {
+	case err != nil:
+		deploymentResult.status = statusFailed
+		deploymentResult.message = err.Error()
+	case deployment.Status.AvailableReplicas == 0:
+		deploymentResult.status = statusFailed
+		deploymentResult.message = "no available replicas"
+	default:
+		deploymentResult.message = fmt.Sprintf(
+			"%d of %d replicas available", deployment.Status.AvailableReplicas, deployment.Status.Replicas,
+		)
+	}
+
+	configResult := result{
+		node:    clusterScope,
+		check:   "webhook configuration",
+		status:  statusFailed,
+		message: recordingWebhookName + " not registered",
+	}
+	webhookConfig, err := c.GetMutatingWebhookConfiguration(ctx, cl, webhookConfigName)
+	if err != nil {
+		configResult.message = err.Error()
+	} else {
+		for i := range webhookConfig.Webhooks {
+			webhook := &webhookConfig.Webhooks[i]
+			if webhook.Name != recordingWebhookName {
+				continue
+			}
+			configResult.status = statusOK
+			configResult.message = recordingWebhookName + " registered"
+			if webhook.FailurePolicy != nil {
+				configResult.message += fmt.Sprintf(" with failure policy %s", *webhook.FailurePolicy)
+			}
+		}
+	}
+
+	return []result{deploymentResult, configResult}
+}
+
+// checkPermissions verifies that the service accounts of the operator are
+// allowed to record profiles.
+func (c *Checker) checkPermissions(ctx context.Context, cl client.Client) []result {
+	missing := map[string][]string{}
+	failed := map[string]error{}
+	serviceAccounts := []string{}
+
+	for i := range requiredPermissions {
+		perm := &requiredPermissions[i]
+		if _, ok := missing[perm.serviceAccount]; !ok {
+			missing[perm.serviceAccount] = nil
+			serviceAccounts = append(serviceAccounts, perm.serviceAccount)
+		}
+		if failed[perm.serviceAccount] != nil {
+			continue
+		}
+
+		attributes := perm.attributes
+		allowed, err := c.SubjectAccessReview(ctx, cl, &authorizationv1.SubjectAccessReviewSpec{
+			User: fmt.Sprintf("system:serviceaccount:%s:%s", c.options.namespace, perm.serviceAccount),
+			Groups: []string{
+				"system:serviceaccounts",
+				"system:serviceaccounts:" + c.options.namespace,
+				"system:authenticated",
+			},
+			ResourceAttributes: &attributes,
+		})
+		if err != nil {
+			failed[perm.serviceAccount] = err
+			continue
+		}
+		if !allowed {
+			missing[perm.serviceAccount] = append(missing[perm.serviceAccount], describePermission(&attributes))
+		}
+	}
+
+	results := make([]result, 0, len(serviceAccounts))
+	for _, serviceAccount := range serviceAccounts {
+		res := result{node: clusterScope, check: "rbac " + serviceAccount, status: statusOK}
+		switch {
+		case failed[serviceAccount] != nil:
+			res.status = statusWarning
+			res.message = fmt.Sprintf("unable to review permissions: %v", failed[serviceAccount])
+		case len(missing[serviceAccount]) > 0:
+			res.status = statusFailed
+			res.message = "missing permissions: " + strings.Join(missing[serviceAccount], ", ")
+		default:
+			res.message = "all required permissions granted"
+		}
+		results = append(results, res)
+	}
+
+	return results
+}
+
+func describePermission(attributes *authorizationv1.ResourceAttributes) string {
+	resource := attributes.Resource
+	if attributes.Subresource != "" {
+		resource += "/" + attributes.Subresource
+	}
+	if attributes.Group != "" {
+		resource += "." + attributes.Group
+	}
+	return attributes.Verb + " " + resource
+}
+
+// checkNodes verifies the SPOD instances on all nodes.
+func (c *Checker) checkNodes(
+	ctx context.Context,
+	cl client.Client,
+	clientset kubernetes.Interface,
+	spod *spodv1alpha1.SecurityProfilesOperatorDaemon,
+) ([]result, error) {
+	pods, err := c.ListPods(ctx, cl,
+		client.InNamespace(c.options.namespace),
+		client.MatchingLabels{"app": config.OperatorName, "name": config.SPOdName},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list SPOD pods: %w", err)
+	}
+
+	if len(pods.Items) == 0 {
+		return []result{{
+			node:    clusterScope,
+			check:   "daemon",
+			status:  statusFailed,
+			message: "no SPOD pods found",
+		}}, nil
+	}
+
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Spec.NodeName < pods.Items[j].Spec.NodeName
+	})
+
+	results := []result{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		results = append(results, checkDaemon(pod))
+
+		if spod.Spec.EnableLogEnricher {
+			enricherResult := checkContainer(pod, "log enricher", bindata.LogEnricherContainerName)
+			results = append(results, enricherResult)
+			if enricherResult.status == statusOK {
+				results = append(results, c.checkAuditSource(ctx, clientset, pod))
+			}
+		}
+
+		if spod.Spec.EnableBpfRecorder {
+			results = append(results, checkContainer(pod, "bpf recorder", bindata.BpfRecorderContainerName))
+		}
+	}
+
+	return results, nil
+}
+
+// checkDaemon verifies that the SPOD pod is ready.
+func checkDaemon(pod *corev1.Pod) result {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+			return result{
+				node:    pod.Spec.NodeName,
+				check:   "daemon",
+				status:  statusOK,
+				message: fmt.Sprintf("pod %s ready", pod.Name),
+			}
+		}
+	}
+
+	return result{
+		node:    pod.Spec.NodeName,
+		check:   "daemon",
+		status:  statusFailed,
+		message: fmt.Sprintf("pod %s not ready (phase %s)", pod.Name, pod.Status.Phase),
+	}
+}
+
+// checkContainer verifies that a container of the SPOD pod is running and
+// ready.
+func checkContainer(pod *corev1.Pod, check, name string) result {
+	res := result{node: pod.Spec.NodeName, check: check, status: statusFailed}
+
+	for i := range pod.Status.ContainerStatuses {
+		containerStatus := &pod.Status.ContainerStatuses[i]
+		if containerStatus.Name != name {
+			continue
+		}
+
+		switch {
+		case containerStatus.State.Running == nil && containerStatus.State.Waiting != nil:
+			res.message = fmt.Sprintf("container %s waiting: %s", name, containerStatus.State.Waiting.Reason)
+		case containerStatus.State.Running == nil:
+			res.message = fmt.Sprintf("container %s not running", name)
+		case !containerStatus.Ready:
+			res.message = fmt.Sprintf("container %s not ready", name)
+		default:
+			res.status = statusOK
+			res.message = fmt.Sprintf(
+				"container %s running with %d restarts", name, containerStatus.RestartCount,
+			)
+		}
+		return res
+	}
+
+	res.message = fmt.Sprintf("container %s not found in pod %s", name, pod.Name)
+	return res
+}
+
+// checkAuditSource verifies that the log enricher reads from an audit source
+// and receives audit events by inspecting its latest log lines.
+func (c *Checker) checkAuditSource(
+	ctx context.Context, clientset kubernetes.Interface, pod *corev1.Pod,
+) result {
+	res := result{node: pod.Spec.NodeName, check: "audit source"}
+
+	logs, err := c.ContainerLogs(
+		ctx, clientset, client.ObjectKeyFromObject(pod), bindata.LogEnricherContainerName, c.options.logLines,
+	)
+	if err != nil {
+		res.status = statusWarning
+		res.message = fmt.Sprintf("unable to inspect logs: %v", err)
+		return res
+	}
+
+	source, events := parseEnricherLogs(logs)
+	switch {
+	case source != "" && events > 0:
+		res.status = statusOK
+		res.message = fmt.Sprintf(
+			"reading from %s, %d audit events in the last %d log lines", source, events, c.options.logLines,
+		)
+	case source != "":
+		res.status = statusWarning
+		res.message = fmt.Sprintf(
+			"reading from %s, but no audit events in the last %d log lines", source, c.options.logLines,
+		)
+	case events > 0:
+		res.status = statusOK
+		res.message = fmt.Sprintf("%d audit events in the last %d log lines", events, c.options.logLines)
+	default:
+		res.status = statusFailed
+		res.message = fmt.Sprintf("no audit source or audit events in the last %d log lines", c.options.logLines)
+	}
+
+	return res
+}
+
+// parseEnricherLogs returns the latest audit source and the amount of audit
+// events logged by the log enricher, either as text or as JSON.
+func parseEnricherLogs(logs []byte) (source string, events int) {
+	for _, line := range bytes.Split(logs, []byte("\n")) {
+		text := string(line)
+		if idx := strings.Index(text, auditSourcePrefix); idx >= 0 {
+			source = strings.TrimSuffix(strings.TrimSpace(text[idx+len(auditSourcePrefix):]), `"`)
+			continue
+		}
+		if strings.Contains(text, `"msg"="audit"`) || strings.HasPrefix(text, `{"timestamp":`) {
+			events++
+		}
+	}
+	return source, events
+}
+
+// printReport writes the results as table.
+func (c *Checker) printReport(results []result) error {
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tCHECK\tSTATUS\tMESSAGE")
+	for i := range results {
+		res := &results[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", res.node, res.check, res.status, res.message)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("flush output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checker
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/checker/checkerfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+var errTest = errors.New("test")
+
+//nolint:lll // no need to wrap
+const testEnricherLogs = `I1015 12:51:04.258061 1 enricher.go:371] log-enricher "msg"="Reading from file /var/log/audit/audit.log"
+I1015 12:51:05.258061 1 enricher.go:588] log-enricher "msg"="audit" "timestamp"="1697374265.258:1" "type"="seccomp"
+I1015 12:51:06.258061 1 enricher.go:588] log-enricher "msg"="audit" "timestamp"="1697374266.258:2" "type"="seccomp"
+`
+
+func testPod(node string, ready bool) corev1.Pod {
+	conditionStatus := corev1.ConditionFalse
+	if ready {
+		conditionStatus = corev1.ConditionTrue
+	}
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: DefaultNamespace, Name: "spod-" + node},
+		Spec:       corev1.PodSpec{NodeName: node},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: conditionStatus}},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  bindata.LogEnricherContainerName,
+				Ready: ready,
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}
+}
+
+func prepareReady(mock *checkerfakes.FakeImpl) {
+	mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{
+		Spec: spodv1alpha1.SPODSpec{EnableLogEnricher: true},
+	}, nil)
+	mock.GetDeploymentReturns(&appsv1.Deployment{
+		Status: appsv1.DeploymentStatus{Replicas: 3, AvailableReplicas: 3},
+	}, nil)
+	mock.GetMutatingWebhookConfigurationReturns(&admissionregv1.MutatingWebhookConfiguration{
+		Webhooks: []admissionregv1.MutatingWebhook{{Name: "binding.spo.io"}, {Name: recordingWebhookName}},
+	}, nil)
+	mock.SubjectAccessReviewReturns(true, nil)
+	mock.ListPodsReturns(&corev1.PodList{
+		Items: []corev1.Pod{testPod("node-b", true), testPod("node-a", true)},
+	}, nil)
+	mock.ContainerLogsReturns([]byte(testEnricherLogs), nil)
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		prepare func(mock *checkerfakes.FakeImpl)
+		assert  func(mock *checkerfakes.FakeImpl, out string, err error)
+	}{
+		{
+			name:    "success",
+			prepare: prepareReady,
+			assert: func(mock *checkerfakes.FakeImpl, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, len(requiredPermissions), mock.SubjectAccessReviewCallCount())
+				require.Equal(t, 2, mock.ContainerLogsCallCount())
+				require.NotContains(t, out, statusFailed)
+				require.Contains(t, out, "log enricher enabled")
+				require.Contains(t, out, "3 of 3 replicas available")
+				require.Contains(t, out, "recording.spo.io registered")
+				require.Contains(t, out, "all required permissions granted")
+				require.Contains(t, out, "reading from file /var/log/audit/audit.log, 2 audit events")
+				require.Less(t, bytes.Index([]byte(out), []byte("node-a")), bytes.Index([]byte(out), []byte("node-b")))
+			},
+		},
+		{
+			name: "success without audit events",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				prepareReady(mock)
+				mock.ContainerLogsReturns([]byte(
+					`I1015 12:51:04.258061 1 enricher.go:335] log-enricher "msg"="Reading from audit netlink socket"`,
+				), nil)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, out string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, out, statusWarning)
+				require.Contains(t, out, "reading from audit netlink socket, but no audit events")
+			},
+		},
+		{
+			name: "failure recorders disabled",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				prepareReady(mock)
+				mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{}, nil)
+			},
+			assert: func(mock *checkerfakes.FakeImpl, out string, err error) {
+				require.ErrorIs(t, err, errNotReady)
+				require.Contains(t, out, "neither the log enricher nor the bpf recorder is enabled")
+				require.Zero(t, mock.ContainerLogsCallCount())
+			},
+		},
+		{
+			name: "failure webhook unavailable",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				prepareReady(mock)
+				mock.GetDeploymentReturns(&appsv1.Deployment{}, nil)
+				mock.GetMutatingWebhookConfigurationReturns(nil, errTest)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, out string, err error) {
+				require.ErrorIs(t, err, errNotReady)
+				require.Contains(t, err.Error(), "2 of")
+				require.Contains(t, out, "no available replicas")
+			},
+		},
+		{
+			name: "failure missing permissions",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				prepareReady(mock)
+				mock.SubjectAccessReviewReturnsOnCall(1, false, nil)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, out string, err error) {
+				require.ErrorIs(t, err, errNotReady)
+				require.Contains(t, out, "missing permissions: list pods")
+			},
+		},
+		{
+			name: "failure daemon and log enricher not ready",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				prepareReady(mock)
+				mock.ListPodsReturns(&corev1.PodList{Items: []corev1.Pod{testPod("node-a", false)}}, nil)
+			},
+			assert: func(mock *checkerfakes.FakeImpl, out string, err error) {
+				require.ErrorIs(t, err, errNotReady)
+				require.Contains(t, err.Error(), "2 of")
+				require.Contains(t, out, "container log-enricher not ready")
+				require.Zero(t, mock.ContainerLogsCallCount())
+			},
+		},
+		{
+			name: "failure no audit source",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				prepareReady(mock)
+				mock.ContainerLogsReturns(nil, nil)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, out string, err error) {
+				require.ErrorIs(t, err, errNotReady)
+				require.Contains(t, out, "no audit source or audit events")
+			},
+		},
+		{
+			name: "failure no SPOD pods",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				prepareReady(mock)
+				mock.ListPodsReturns(&corev1.PodList{}, nil)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, out string, err error) {
+				require.ErrorIs(t, err, errNotReady)
+				require.Contains(t, out, "no SPOD pods found")
+			},
+		},
+		{
+			name: "failure on GetConfig",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				mock.GetConfigReturns(nil, errTest)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, _ string, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on NewClient",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				mock.NewClientReturns(nil, errTest)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, _ string, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on NewClientset",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				mock.NewClientsetReturns(nil, errTest)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, _ string, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on GetSPOd",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				mock.GetSPOdReturns(nil, errTest)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, _ string, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on ListPods",
+			prepare: func(mock *checkerfakes.FakeImpl) {
+				prepareReady(mock)
+				mock.ListPodsReturns(nil, errTest)
+			},
+			assert: func(_ *checkerfakes.FakeImpl, _ string, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &checkerfakes.FakeImpl{}
+			prepare(mock)
+
+			out := &bytes.Buffer{}
+			sut := New(Default())
+			sut.impl = mock
+			sut.out = out
+
+			err := sut.Run()
+			assert(mock, out.String(), err)
+		})
+	}
+}
@@ -0,0 +1,790 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by counterfeiter. DO NOT EDIT.
+package checkerfakes
+
+import (
+	"context"
+	"sync"
+
+	v1a "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/apps/v1"
+	v1c "k8s.io/api/authorization/v1"
+	v1b "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+type FakeImpl struct {
+	ContainerLogsStub        func(context.Context, kubernetes.Interface, types.NamespacedName, string, int64) ([]byte, error)
+	containerLogsMutex       sync.RWMutex
+	containerLogsArgsForCall []struct {
+		arg1 context.Context
+		arg2 kubernetes.Interface
+		arg3 types.NamespacedName
+		arg4 string
+		arg5 int64
+	}
+	containerLogsReturns struct {
+		result1 []byte
+		result2 error
+	}
+	containerLogsReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	GetConfigStub        func() (*rest.Config, error)
+	getConfigMutex       sync.RWMutex
+	getConfigArgsForCall []struct {
+	}
+	getConfigReturns struct {
+		result1 *rest.Config
+		result2 error
+	}
+	getConfigReturnsOnCall map[int]struct {
+		result1 *rest.Config
+		result2 error
+	}
+	GetDeploymentStub        func(context.Context, client.Client, types.NamespacedName) (*v1.Deployment, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+	}
+	getDeploymentReturns struct {
+		result1 *v1.Deployment
+		result2 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 *v1.Deployment
+		result2 error
+	}
+	GetMutatingWebhookConfigurationStub        func(context.Context, client.Client, string) (*v1a.MutatingWebhookConfiguration, error)
+	getMutatingWebhookConfigurationMutex       sync.RWMutex
+	getMutatingWebhookConfigurationArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 string
+	}
+	getMutatingWebhookConfigurationReturns struct {
+		result1 *v1a.MutatingWebhookConfiguration
+		result2 error
+	}
+	getMutatingWebhookConfigurationReturnsOnCall map[int]struct {
+		result1 *v1a.MutatingWebhookConfiguration
+		result2 error
+	}
+	GetSPOdStub        func(context.Context, client.Client, types.NamespacedName) (*v1alpha1.SecurityProfilesOperatorDaemon, error)
+	getSPOdMutex       sync.RWMutex
+	getSPOdArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+	}
+	getSPOdReturns struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	getSPOdReturnsOnCall map[int]struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	ListPodsStub        func(context.Context, client.Client, ...client.ListOption) (*v1b.PodList, error)
+	listPodsMutex       sync.RWMutex
+	listPodsArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 []client.ListOption
+	}
+	listPodsReturns struct {
+		result1 *v1b.PodList
+		result2 error
+	}
+	listPodsReturnsOnCall map[int]struct {
+		result1 *v1b.PodList
+		result2 error
+	}
+	NewClientStub        func(*rest.Config) (client.Client, error)
+	newClientMutex       sync.RWMutex
+	newClientArgsForCall []struct {
+		arg1 *rest.Config
+	}
+	newClientReturns struct {
+		result1 client.Client
+		result2 error
+	}
+	newClientReturnsOnCall map[int]struct {
+		result1 client.Client
+		result2 error
+	}
+	NewClientsetStub        func(*rest.Config) (kubernetes.Interface, error)
+	newClientsetMutex       sync.RWMutex
+	newClientsetArgsForCall []struct {
+		arg1 *rest.Config
+	}
+	newClientsetReturns struct {
+		result1 kubernetes.Interface
+		result2 error
+	}
+	newClientsetReturnsOnCall map[int]struct {
+		result1 kubernetes.Interface
+		result2 error
+	}
+	SubjectAccessReviewStub        func(context.Context, client.Client, *v1c.SubjectAccessReviewSpec) (bool, error)
+	subjectAccessReviewMutex       sync.RWMutex
+	subjectAccessReviewArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1c.SubjectAccessReviewSpec
+	}
+	subjectAccessReviewReturns struct {
+		result1 bool
+		result2 error
+	}
+	subjectAccessReviewReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImpl) ContainerLogs(arg1 context.Context, arg2 kubernetes.Interface, arg3 types.NamespacedName, arg4 string, arg5 int64) ([]byte, error) {
+	fake.containerLogsMutex.Lock()
+	ret, specificReturn := fake.containerLogsReturnsOnCall[len(fake.containerLogsArgsForCall)]
+	fake.containerLogsArgsForCall = append(fake.containerLogsArgsForCall, struct {
+		arg1 context.Context
+		arg2 kubernetes.Interface
+		arg3 types.NamespacedName
+		arg4 string
+		arg5 int64
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ContainerLogsStub
+	fakeReturns := fake.containerLogsReturns
+	fake.recordInvocation("ContainerLogs", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.containerLogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ContainerLogsCallCount() int {
+	fake.containerLogsMutex.RLock()
+	defer fake.containerLogsMutex.RUnlock()
+	return len(fake.containerLogsArgsForCall)
+}
+
+func (fake *FakeImpl) ContainerLogsCalls(stub func(context.Context, kubernetes.Interface, types.NamespacedName, string, int64) ([]byte, error)) {
+	fake.containerLogsMutex.Lock()
+	defer fake.containerLogsMutex.Unlock()
+	fake.ContainerLogsStub = stub
+}
+
+func (fake *FakeImpl) ContainerLogsArgsForCall(i int) (context.Context, kubernetes.Interface, types.NamespacedName, string, int64) {
+	fake.containerLogsMutex.RLock()
+	defer fake.containerLogsMutex.RUnlock()
+	argsForCall := fake.containerLogsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeImpl) ContainerLogsReturns(result1 []byte, result2 error) {
+	fake.containerLogsMutex.Lock()
+	defer fake.containerLogsMutex.Unlock()
+	fake.ContainerLogsStub = nil
+	fake.containerLogsReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ContainerLogsReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.containerLogsMutex.Lock()
+	defer fake.containerLogsMutex.Unlock()
+	fake.ContainerLogsStub = nil
+	if fake.containerLogsReturnsOnCall == nil {
+		fake.containerLogsReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.containerLogsReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetConfig() (*rest.Config, error) {
+	fake.getConfigMutex.Lock()
+	ret, specificReturn := fake.getConfigReturnsOnCall[len(fake.getConfigArgsForCall)]
+	fake.getConfigArgsForCall = append(fake.getConfigArgsForCall, struct {
+	}{})
+	stub := fake.GetConfigStub
+	fakeReturns := fake.getConfigReturns
+	fake.recordInvocation("GetConfig", []interface{}{})
+	fake.getConfigMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetConfigCallCount() int {
+	fake.getConfigMutex.RLock()
+	defer fake.getConfigMutex.RUnlock()
+	return len(fake.getConfigArgsForCall)
+}
+
+func (fake *FakeImpl) GetConfigCalls(stub func() (*rest.Config, error)) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = stub
+}
+
+func (fake *FakeImpl) GetConfigReturns(result1 *rest.Config, result2 error) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = nil
+	fake.getConfigReturns = struct {
+		result1 *rest.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetConfigReturnsOnCall(i int, result1 *rest.Config, result2 error) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = nil
+	if fake.getConfigReturnsOnCall == nil {
+		fake.getConfigReturnsOnCall = make(map[int]struct {
+			result1 *rest.Config
+			result2 error
+		})
+	}
+	fake.getConfigReturnsOnCall[i] = struct {
+		result1 *rest.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetDeployment(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName) (*v1.Deployment, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+	}{arg1, arg2, arg3})
+	stub := fake.GetDeploymentStub
+	fakeReturns := fake.getDeploymentReturns
+	fake.recordInvocation("GetDeployment", []interface{}{arg1, arg2, arg3})
+	fake.getDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeImpl) GetDeploymentCalls(stub func(context.Context, client.Client, types.NamespacedName) (*v1.Deployment, error)) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = stub
+}
+
+func (fake *FakeImpl) GetDeploymentArgsForCall(i int) (context.Context, client.Client, types.NamespacedName) {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	argsForCall := fake.getDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) GetDeploymentReturns(result1 *v1.Deployment, result2 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 *v1.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetDeploymentReturnsOnCall(i int, result1 *v1.Deployment, result2 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *v1.Deployment
+			result2 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 *v1.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetMutatingWebhookConfiguration(arg1 context.Context, arg2 client.Client, arg3 string) (*v1a.MutatingWebhookConfiguration, error) {
+	fake.getMutatingWebhookConfigurationMutex.Lock()
+	ret, specificReturn := fake.getMutatingWebhookConfigurationReturnsOnCall[len(fake.getMutatingWebhookConfigurationArgsForCall)]
+	fake.getMutatingWebhookConfigurationArgsForCall = append(fake.getMutatingWebhookConfigurationArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetMutatingWebhookConfigurationStub
+	fakeReturns := fake.getMutatingWebhookConfigurationReturns
+	fake.recordInvocation("GetMutatingWebhookConfiguration", []interface{}{arg1, arg2, arg3})
+	fake.getMutatingWebhookConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetMutatingWebhookConfigurationCallCount() int {
+	fake.getMutatingWebhookConfigurationMutex.RLock()
+	defer fake.getMutatingWebhookConfigurationMutex.RUnlock()
+	return len(fake.getMutatingWebhookConfigurationArgsForCall)
+}
+
+func (fake *FakeImpl) GetMutatingWebhookConfigurationCalls(stub func(context.Context, client.Client, string) (*v1a.MutatingWebhookConfiguration, error)) {
+	fake.getMutatingWebhookConfigurationMutex.Lock()
+	defer fake.getMutatingWebhookConfigurationMutex.Unlock()
+	fake.GetMutatingWebhookConfigurationStub = stub
+}
+
+func (fake *FakeImpl) GetMutatingWebhookConfigurationArgsForCall(i int) (context.Context, client.Client, string) {
+	fake.getMutatingWebhookConfigurationMutex.RLock()
+	defer fake.getMutatingWebhookConfigurationMutex.RUnlock()
+	argsForCall := fake.getMutatingWebhookConfigurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) GetMutatingWebhookConfigurationReturns(result1 *v1a.MutatingWebhookConfiguration, result2 error) {
+	fake.getMutatingWebhookConfigurationMutex.Lock()
+	defer fake.getMutatingWebhookConfigurationMutex.Unlock()
+	fake.GetMutatingWebhookConfigurationStub = nil
+	fake.getMutatingWebhookConfigurationReturns = struct {
+		result1 *v1a.MutatingWebhookConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetMutatingWebhookConfigurationReturnsOnCall(i int, result1 *v1a.MutatingWebhookConfiguration, result2 error) {
+	fake.getMutatingWebhookConfigurationMutex.Lock()
+	defer fake.getMutatingWebhookConfigurationMutex.Unlock()
+	fake.GetMutatingWebhookConfigurationStub = nil
+	if fake.getMutatingWebhookConfigurationReturnsOnCall == nil {
+		fake.getMutatingWebhookConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *v1a.MutatingWebhookConfiguration
+			result2 error
+		})
+	}
+	fake.getMutatingWebhookConfigurationReturnsOnCall[i] = struct {
+		result1 *v1a.MutatingWebhookConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOd(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName) (*v1alpha1.SecurityProfilesOperatorDaemon, error) {
+	fake.getSPOdMutex.Lock()
+	ret, specificReturn := fake.getSPOdReturnsOnCall[len(fake.getSPOdArgsForCall)]
+	fake.getSPOdArgsForCall = append(fake.getSPOdArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+	}{arg1, arg2, arg3})
+	stub := fake.GetSPOdStub
+	fakeReturns := fake.getSPOdReturns
+	fake.recordInvocation("GetSPOd", []interface{}{arg1, arg2, arg3})
+	fake.getSPOdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSPOdCallCount() int {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	return len(fake.getSPOdArgsForCall)
+}
+
+func (fake *FakeImpl) GetSPOdCalls(stub func(context.Context, client.Client, types.NamespacedName) (*v1alpha1.SecurityProfilesOperatorDaemon, error)) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = stub
+}
+
+func (fake *FakeImpl) GetSPOdArgsForCall(i int) (context.Context, client.Client, types.NamespacedName) {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	argsForCall := fake.getSPOdArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) GetSPOdReturns(result1 *v1alpha1.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	fake.getSPOdReturns = struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOdReturnsOnCall(i int, result1 *v1alpha1.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	if fake.getSPOdReturnsOnCall == nil {
+		fake.getSPOdReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.SecurityProfilesOperatorDaemon
+			result2 error
+		})
+	}
+	fake.getSPOdReturnsOnCall[i] = struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListPods(arg1 context.Context, arg2 client.Client, arg3 ...client.ListOption) (*v1b.PodList, error) {
+	fake.listPodsMutex.Lock()
+	ret, specificReturn := fake.listPodsReturnsOnCall[len(fake.listPodsArgsForCall)]
+	fake.listPodsArgsForCall = append(fake.listPodsArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 []client.ListOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListPodsStub
+	fakeReturns := fake.listPodsReturns
+	fake.recordInvocation("ListPods", []interface{}{arg1, arg2, arg3})
+	fake.listPodsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListPodsCallCount() int {
+	fake.listPodsMutex.RLock()
+	defer fake.listPodsMutex.RUnlock()
+	return len(fake.listPodsArgsForCall)
+}
+
+func (fake *FakeImpl) ListPodsCalls(stub func(context.Context, client.Client, ...client.ListOption) (*v1b.PodList, error)) {
+	fake.listPodsMutex.Lock()
+	defer fake.listPodsMutex.Unlock()
+	fake.ListPodsStub = stub
+}
+
+func (fake *FakeImpl) ListPodsArgsForCall(i int) (context.Context, client.Client, []client.ListOption) {
+	fake.listPodsMutex.RLock()
+	defer fake.listPodsMutex.RUnlock()
+	argsForCall := fake.listPodsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ListPodsReturns(result1 *v1b.PodList, result2 error) {
+	fake.listPodsMutex.Lock()
+	defer fake.listPodsMutex.Unlock()
+	fake.ListPodsStub = nil
+	fake.listPodsReturns = struct {
+		result1 *v1b.PodList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListPodsReturnsOnCall(i int, result1 *v1b.PodList, result2 error) {
+	fake.listPodsMutex.Lock()
+	defer fake.listPodsMutex.Unlock()
+	fake.ListPodsStub = nil
+	if fake.listPodsReturnsOnCall == nil {
+		fake.listPodsReturnsOnCall = make(map[int]struct {
+			result1 *v1b.PodList
+			result2 error
+		})
+	}
+	fake.listPodsReturnsOnCall[i] = struct {
+		result1 *v1b.PodList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClient(arg1 *rest.Config) (client.Client, error) {
+	fake.newClientMutex.Lock()
+	ret, specificReturn := fake.newClientReturnsOnCall[len(fake.newClientArgsForCall)]
+	fake.newClientArgsForCall = append(fake.newClientArgsForCall, struct {
+		arg1 *rest.Config
+	}{arg1})
+	stub := fake.NewClientStub
+	fakeReturns := fake.newClientReturns
+	fake.recordInvocation("NewClient", []interface{}{arg1})
+	fake.newClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) NewClientCallCount() int {
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	return len(fake.newClientArgsForCall)
+}
+
+func (fake *FakeImpl) NewClientCalls(stub func(*rest.Config) (client.Client, error)) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = stub
+}
+
+func (fake *FakeImpl) NewClientArgsForCall(i int) *rest.Config {
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	argsForCall := fake.newClientArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) NewClientReturns(result1 client.Client, result2 error) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = nil
+	fake.newClientReturns = struct {
+		result1 client.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClientReturnsOnCall(i int, result1 client.Client, result2 error) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = nil
+	if fake.newClientReturnsOnCall == nil {
+		fake.newClientReturnsOnCall = make(map[int]struct {
+			result1 client.Client
+			result2 error
+		})
+	}
+	fake.newClientReturnsOnCall[i] = struct {
+		result1 client.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClientset(arg1 *rest.Config) (kubernetes.Interface, error) {
+	fake.newClientsetMutex.Lock()
+	ret, specificReturn := fake.newClientsetReturnsOnCall[len(fake.newClientsetArgsForCall)]
+	fake.newClientsetArgsForCall = append(fake.newClientsetArgsForCall, struct {
+		arg1 *rest.Config
+	}{arg1})
+	stub := fake.NewClientsetStub
+	fakeReturns := fake.newClientsetReturns
+	fake.recordInvocation("NewClientset", []interface{}{arg1})
+	fake.newClientsetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) NewClientsetCallCount() int {
+	fake.newClientsetMutex.RLock()
+	defer fake.newClientsetMutex.RUnlock()
+	return len(fake.newClientsetArgsForCall)
+}
+
+func (fake *FakeImpl) NewClientsetCalls(stub func(*rest.Config) (kubernetes.Interface, error)) {
+	fake.newClientsetMutex.Lock()
+	defer fake.newClientsetMutex.Unlock()
+	fake.NewClientsetStub = stub
+}
+
+func (fake *FakeImpl) NewClientsetArgsForCall(i int) *rest.Config {
+	fake.newClientsetMutex.RLock()
+	defer fake.newClientsetMutex.RUnlock()
+	argsForCall := fake.newClientsetArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) NewClientsetReturns(result1 kubernetes.Interface, result2 error) {
+	fake.newClientsetMutex.Lock()
+	defer fake.newClientsetMutex.Unlock()
+	fake.NewClientsetStub = nil
+	fake.newClientsetReturns = struct {
+		result1 kubernetes.Interface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClientsetReturnsOnCall(i int, result1 kubernetes.Interface, result2 error) {
+	fake.newClientsetMutex.Lock()
+	defer fake.newClientsetMutex.Unlock()
+	fake.NewClientsetStub = nil
+	if fake.newClientsetReturnsOnCall == nil {
+		fake.newClientsetReturnsOnCall = make(map[int]struct {
+			result1 kubernetes.Interface
+			result2 error
+		})
+	}
+	fake.newClientsetReturnsOnCall[i] = struct {
+		result1 kubernetes.Interface
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) SubjectAccessReview(arg1 context.Context, arg2 client.Client, arg3 *v1c.SubjectAccessReviewSpec) (bool, error) {
+	fake.subjectAccessReviewMutex.Lock()
+	ret, specificReturn := fake.subjectAccessReviewReturnsOnCall[len(fake.subjectAccessReviewArgsForCall)]
+	fake.subjectAccessReviewArgsForCall = append(fake.subjectAccessReviewArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1c.SubjectAccessReviewSpec
+	}{arg1, arg2, arg3})
+	stub := fake.SubjectAccessReviewStub
+	fakeReturns := fake.subjectAccessReviewReturns
+	fake.recordInvocation("SubjectAccessReview", []interface{}{arg1, arg2, arg3})
+	fake.subjectAccessReviewMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) SubjectAccessReviewCallCount() int {
+	fake.subjectAccessReviewMutex.RLock()
+	defer fake.subjectAccessReviewMutex.RUnlock()
+	return len(fake.subjectAccessReviewArgsForCall)
+}
+
+func (fake *FakeImpl) SubjectAccessReviewCalls(stub func(context.Context, client.Client, *v1c.SubjectAccessReviewSpec) (bool, error)) {
+	fake.subjectAccessReviewMutex.Lock()
+	defer fake.subjectAccessReviewMutex.Unlock()
+	fake.SubjectAccessReviewStub = stub
+}
+
+func (fake *FakeImpl) SubjectAccessReviewArgsForCall(i int) (context.Context, client.Client, *v1c.SubjectAccessReviewSpec) {
+	fake.subjectAccessReviewMutex.RLock()
+	defer fake.subjectAccessReviewMutex.RUnlock()
+	argsForCall := fake.subjectAccessReviewArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) SubjectAccessReviewReturns(result1 bool, result2 error) {
+	fake.subjectAccessReviewMutex.Lock()
+	defer fake.subjectAccessReviewMutex.Unlock()
+	fake.SubjectAccessReviewStub = nil
+	fake.subjectAccessReviewReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) SubjectAccessReviewReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.subjectAccessReviewMutex.Lock()
+	defer fake.subjectAccessReviewMutex.Unlock()
+	fake.SubjectAccessReviewStub = nil
+	if fake.subjectAccessReviewReturnsOnCall == nil {
+		fake.subjectAccessReviewReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.subjectAccessReviewReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.containerLogsMutex.RLock()
+	defer fake.containerLogsMutex.RUnlock()
+	fake.getConfigMutex.RLock()
+	defer fake.getConfigMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	fake.getMutatingWebhookConfigurationMutex.RLock()
+	defer fake.getMutatingWebhookConfigurationMutex.RUnlock()
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	fake.listPodsMutex.RLock()
+	defer fake.listPodsMutex.RUnlock()
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	fake.newClientsetMutex.RLock()
+	defer fake.newClientsetMutex.RUnlock()
+	fake.subjectAccessReviewMutex.RLock()
+	defer fake.subjectAccessReviewMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImpl) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
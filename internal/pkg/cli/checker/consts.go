@@ -0,0 +1,36 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checker
+
+import (
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	// FlagNamespace is the flag for defining the namespace of the operator.
+	FlagNamespace string = "namespace"
+
+	// DefaultNamespace is the default namespace of the operator.
+	DefaultNamespace string = config.OperatorName
+
+	// FlagLogLines is the flag for defining the amount of log lines of the
+	// log enricher to be inspected.
+	FlagLogLines string = "log-lines"
+
+	// DefaultLogLines is the default amount of inspected log lines.
+	DefaultLogLines int64 = 1000
+)
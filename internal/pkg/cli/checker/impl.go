@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checker
+
+import (
+	"context"
+	"fmt"
+
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	GetConfig() (*rest.Config, error)
+	NewClient(*rest.Config) (client.Client, error)
+	NewClientset(*rest.Config) (kubernetes.Interface, error)
+	GetSPOd(
+		context.Context, client.Client, types.NamespacedName,
+	) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error)
+	ListPods(context.Context, client.Client, ...client.ListOption) (*corev1.PodList, error)
+	GetDeployment(context.Context, client.Client, types.NamespacedName) (*appsv1.Deployment, error)
+	GetMutatingWebhookConfiguration(
+		context.Context, client.Client, string,
+	) (*admissionregv1.MutatingWebhookConfiguration, error)
+	SubjectAccessReview(context.Context, client.Client, *authorizationv1.SubjectAccessReviewSpec) (bool, error)
+	ContainerLogs(
+		context.Context, kubernetes.Interface, types.NamespacedName, string, int64,
+	) ([]byte, error)
+}
+
+func (*defaultImpl) GetConfig() (*rest.Config, error) {
+	return config.GetConfig()
+}
+
+func (*defaultImpl) NewClient(cfg *rest.Config) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("add core APIs to scheme: %w", err)
+	}
+	if err := spodv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("add spod API to scheme: %w", err)
+	}
+	return client.New(cfg, client.Options{Scheme: scheme})
+}
+
+func (*defaultImpl) NewClientset(cfg *rest.Config) (kubernetes.Interface, error) {
+	return kubernetes.NewForConfig(cfg)
+}
+
+func (*defaultImpl) GetSPOd(
+	ctx context.Context, c client.Client, key types.NamespacedName,
+) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	if err := c.Get(ctx, key, spod); err != nil {
+		return nil, fmt.Errorf("get spod: %w", err)
+	}
+	return spod, nil
+}
+
+func (*defaultImpl) ListPods(
+	ctx context.Context, c client.Client, opts ...client.ListOption,
+) (*corev1.PodList, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, opts...); err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+	return pods, nil
+}
+
+func (*defaultImpl) GetDeployment(
+	ctx context.Context, c client.Client, key types.NamespacedName,
+) (*appsv1.Deployment, error) {
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, key, deployment); err != nil {
+		return nil, fmt.Errorf("get deployment: %w", err)
+	}
+	return deployment, nil
+}
+
+func (*defaultImpl) GetMutatingWebhookConfiguration(
+	ctx context.Context, c client.Client, name string,
+) (*admissionregv1.MutatingWebhookConfiguration, error) {
+	webhookConfig := &admissionregv1.MutatingWebhookConfiguration{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, webhookConfig); err != nil {
+		return nil, fmt.Errorf("get mutating webhook configuration: %w", err)
+	}
+	return webhookConfig, nil
+}
+
+func (*defaultImpl) SubjectAccessReview(
+	ctx context.Context, c client.Client, spec *authorizationv1.SubjectAccessReviewSpec,
+) (bool, error) {
+	review := &authorizationv1.SubjectAccessReview{Spec: *spec}
+	if err := c.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("create subject access review: %w", err)
+	}
+	return review.Status.Allowed, nil
+}
+
+func (*defaultImpl) ContainerLogs(
+	ctx context.Context, clientset kubernetes.Interface, pod types.NamespacedName, container string, lines int64,
+) ([]byte, error) {
+	logs, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &lines,
+	}).DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get container logs: %w", err)
+	}
+	return logs, nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checker
+
+import (
+	"errors"
+
+	ucli "github.com/urfave/cli/v2"
+)
+
+// Options define all possible options for the checker.
+type Options struct {
+	namespace string
+	logLines  int64
+}
+
+// Default returns a default options instance.
+func Default() *Options {
+	return &Options{
+		namespace: DefaultNamespace,
+		logLines:  DefaultLogLines,
+	}
+}
+
+// FromContext can be used to create Options from an CLI context.
+func FromContext(ctx *ucli.Context) (*Options, error) {
+	options := Default()
+
+	if ctx.IsSet(FlagNamespace) {
+		options.namespace = ctx.String(FlagNamespace)
+	}
+	if options.namespace == "" {
+		return nil, errors.New("no namespace provided")
+	}
+
+	if ctx.IsSet(FlagLogLines) {
+		options.logLines = ctx.Int64(FlagLogLines)
+	}
+	if options.logLines <= 0 {
+		return nil, errors.New("amount of log lines has to be positive")
+	}
+
+	return options, nil
+}
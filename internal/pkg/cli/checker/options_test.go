@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checker
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		prepare func(*flag.FlagSet)
+		assert  func(*Options, error)
+	}{
+		{
+			name:    "success defaults",
+			prepare: func(*flag.FlagSet) {},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, DefaultNamespace, opts.namespace)
+				require.Equal(t, DefaultLogLines, opts.logLines)
+			},
+		},
+		{
+			name: "success with namespace and log lines",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagNamespace, "", "")
+				set.Int64(FlagLogLines, 0, "")
+				require.Nil(t, set.Set(FlagNamespace, "spo"))
+				require.Nil(t, set.Set(FlagLogLines, "50"))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "spo", opts.namespace)
+				require.EqualValues(t, 50, opts.logLines)
+			},
+		},
+		{
+			name: "failure no namespace provided",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagNamespace, "", "")
+				require.Nil(t, set.Set(FlagNamespace, ""))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure invalid log lines",
+			prepare: func(set *flag.FlagSet) {
+				set.Int64(FlagLogLines, 0, "")
+				require.Nil(t, set.Set(FlagLogLines, "0"))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			set := flag.NewFlagSet("", flag.ExitOnError)
+			prepare(set)
+
+			app := cli.NewApp()
+			ctx := cli.NewContext(app, set, nil)
+
+			opts, err := FromContext(ctx)
+			assert(opts, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import "sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+
+// DefaultOutputFile defines the default output location for the converter.
+var DefaultOutputFile = cli.DefaultFile
+
+const (
+	// FlagOutputFile is the flag for defining the output file location.
+	FlagOutputFile string = cli.FlagOutputFile
+
+	// FlagFrom is the flag for defining the format of the input profile.
+	FlagFrom string = "from"
+
+	// FlagTo is the flag for defining the format of the output profile.
+	FlagTo string = "to"
+)
+
+// Format is the enum for all available profile formats.
+type Format string
+
+const (
+	// FormatCRD is the format of a SeccompProfile CRD YAML.
+	FormatCRD Format = "crd"
+
+	// FormatRaw is the format of a raw OCI seccomp JSON profile.
+	FormatRaw Format = "raw"
+
+	// FormatDocker is the format of the Docker and containerd default seccomp
+	// profiles, which supports conditional syscall rules.
+	FormatDocker Format = "docker"
+)
+
+// Formats are all supported profile formats.
+var Formats = []Format{FormatCRD, FormatRaw, FormatDocker}
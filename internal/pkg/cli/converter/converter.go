@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/containers/common/pkg/seccomp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+)
+
+// ErrBaseProfile is returned if a profile referencing a base profile should
+// be converted into a format which does not support base profiles.
+var ErrBaseProfile = errors.New("profiles referencing a base profile can only be converted into CRDs")
+
+// Converter is the main structure of this package.
+type Converter struct {
+	impl
+	options *Options
+}
+
+// New returns a new Converter instance.
+func New(options *Options) *Converter {
+	return &Converter{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Converter.
+func (c *Converter) Run() error {
+	profile, err := c.readProfile()
+	if err != nil {
+		return fmt.Errorf("read profile: %w", err)
+	}
+
+	data, err := c.marshalProfile(profile)
+	if err != nil {
+		return fmt.Errorf("marshal profile: %w", err)
+	}
+
+	outputFile := c.options.outputFile
+	if c.options.to != FormatCRD && outputFile == DefaultOutputFile {
+		outputFile = strings.TrimSuffix(outputFile, filepath.Ext(outputFile)) + seccompprofileapi.ExtJSON
+	}
+
+	log.Printf("Saving %s profile in: %s", c.options.to, outputFile)
+	const defaultFileMode = os.FileMode(0o644)
+	if err := c.WriteFile(outputFile, data, defaultFileMode); err != nil {
+		return fmt.Errorf("save profile: %w", err)
+	}
+
+	return nil
+}
+
+// readProfile reads the input profile in the configured format.
+func (c *Converter) readProfile() (*seccompprofileapi.SeccompProfile, error) {
+	name := c.options.profile
+	log.Printf("Reading %s profile %s", c.options.from, name)
+	content, err := c.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("open profile: %w", err)
+	}
+
+	if c.options.from != FormatDocker {
+		profile, err := cli.UnmarshalSeccompProfile(c, name, content, c.options.from == FormatRaw)
+		if err != nil {
+			return nil, fmt.Errorf("read %s profile: %w", c.options.from, err)
+		}
+		if profile.Name == "" {
+			profile.Name = cli.ProfileName(name)
+		}
+		return profile, nil
+	}
+
+	dockerProfile := &seccomp.Seccomp{}
+	if err := c.JSONUnmarshal(content, dockerProfile); err != nil {
+		return nil, fmt.Errorf("unmarshal JSON profile: %w", err)
+	}
+	spec, err := specFromDocker(dockerProfile)
+	if err != nil {
+		return nil, fmt.Errorf("convert docker profile: %w", err)
+	}
+
+	profile := &seccompprofileapi.SeccompProfile{Spec: *spec}
+	profile.Name = cli.ProfileName(name)
+	return profile, nil
+}
+
+// marshalProfile marshals the profile in the configured output format.
+func (c *Converter) marshalProfile(profile *seccompprofileapi.SeccompProfile) ([]byte, error) {
+	if c.options.to == FormatCRD {
+		profile.TypeMeta = metav1.TypeMeta{
+			Kind:       "SeccompProfile",
+			APIVersion: seccompprofileapi.GroupVersion.String(),
+		}
+		data, err := c.YamlMarshal(profile)
+		if err != nil {
+			return nil, fmt.Errorf("marshal YAML profile: %w", err)
+		}
+		return data, nil
+	}
+
+	if profile.Spec.BaseProfileName != "" {
+		return nil, fmt.Errorf("%w: %s", ErrBaseProfile, profile.Spec.BaseProfileName)
+	}
+
+	var v any = &profile.Spec
+	if c.options.to == FormatDocker {
+		v = dockerFromSpec(&profile.Spec)
+	}
+
+	data, err := c.JSONMarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal JSON profile: %w", err)
+	}
+	return data, nil
+}
+
+// specFromDocker converts a Docker or containerd seccomp profile into the
+// operator representation. Rules which are only included for certain
+// capabilities get dropped, because workloads run without additional
+// capabilities by default. Architecture specific rules are kept for all
+// architectures, since runtimes ignore syscalls unknown to an architecture.
+func specFromDocker(profile *seccomp.Seccomp) (*seccompprofileapi.SeccompProfileSpec, error) {
+	if profile.DefaultErrno != "" && profile.DefaultErrnoRet == nil {
+		return nil, fmt.Errorf("unsupported default errno name: %s", profile.DefaultErrno)
+	}
+
+	spec := &seccompprofileapi.SeccompProfileSpec{
+		DefaultAction:    profile.DefaultAction,
+		DefaultErrnoRet:  profile.DefaultErrnoRet,
+		ListenerPath:     profile.ListenerPath,
+		ListenerMetadata: profile.ListenerMetadata,
+	}
+
+	arches := slices.Clone(profile.Architectures)
+	for _, a := range profile.ArchMap {
+		arches = append(arches, a.Arch)
+		arches = append(arches, a.SubArches...)
+	}
+	seen := map[seccomp.Arch]bool{}
+	for _, arch := range arches {
+		if !seen[arch] {
+			seen[arch] = true
+			spec.Architectures = append(spec.Architectures, seccompprofileapi.Arch(arch))
+		}
+	}
+
+	for _, flag := range profile.Flags {
+		f := seccompprofileapi.Flag(flag)
+		spec.Flags = append(spec.Flags, &f)
+	}
+
+	for _, call := range profile.Syscalls {
+		names := call.Names
+		if call.Name != "" {
+			names = append([]string{call.Name}, names...)
+		}
+
+		if len(call.Includes.Caps) > 0 {
+			log.Printf("Skipping syscalls %v requiring capabilities %v", names, call.Includes.Caps)
+			continue
+		}
+
+		syscall := &seccompprofileapi.Syscall{
+			Names:  names,
+			Action: call.Action,
+		}
+		if call.ErrnoRet != nil {
+			syscall.ErrnoRet = *call.ErrnoRet
+		} else if call.Errno != "" {
+			return nil, fmt.Errorf("unsupported errno name for syscalls %v: %s", names, call.Errno)
+		}
+		for _, arg := range call.Args {
+			syscall.Args = append(syscall.Args, &seccompprofileapi.Arg{
+				Index:    arg.Index,
+				Value:    arg.Value,
+				ValueTwo: arg.ValueTwo,
+				Op:       arg.Op,
+			})
+		}
+		spec.Syscalls = append(spec.Syscalls, syscall)
+	}
+
+	return spec, nil
+}
+
+// dockerFromSpec converts the operator representation of a seccomp profile
+// into a Docker or containerd seccomp profile.
+func dockerFromSpec(spec *seccompprofileapi.SeccompProfileSpec) *seccomp.Seccomp {
+	profile := &seccomp.Seccomp{
+		DefaultAction:    spec.DefaultAction,
+		DefaultErrnoRet:  spec.DefaultErrnoRet,
+		ListenerPath:     spec.ListenerPath,
+		ListenerMetadata: spec.ListenerMetadata,
+		Syscalls:         []*seccomp.Syscall{},
+	}
+
+	for _, arch := range spec.Architectures {
+		profile.Architectures = append(profile.Architectures, seccomp.Arch(arch))
+	}
+
+	for _, flag := range spec.Flags {
+		profile.Flags = append(profile.Flags, string(*flag))
+	}
+
+	for _, syscall := range spec.Syscalls {
+		call := &seccomp.Syscall{
+			Names:  syscall.Names,
+			Action: syscall.Action,
+		}
+		if syscall.ErrnoRet != 0 {
+			errnoRet := syscall.ErrnoRet
+			call.ErrnoRet = &errnoRet
+		}
+		for _, arg := range syscall.Args {
+			call.Args = append(call.Args, &seccomp.Arg{
+				Index:    arg.Index,
+				Value:    arg.Value,
+				ValueTwo: arg.ValueTwo,
+				Op:       arg.Op,
+			})
+		}
+		profile.Syscalls = append(profile.Syscalls, call)
+	}
+
+	return profile
+}
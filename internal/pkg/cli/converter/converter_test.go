@@ -0,0 +1,280 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/converter/converterfakes"
+)
+
+var errTest = errors.New("test")
+
+const (
+	testProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+metadata:
+  name: my-app
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  architectures:
+  - SCMP_ARCH_X86_64
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names:
+    - read
+    - write
+  - action: SCMP_ACT_ERRNO
+    errnoRet: 1
+    names:
+    - ptrace
+`
+	testRawProfile = `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "syscalls": [{"action": "SCMP_ACT_ALLOW", "names": ["read", "write"]}]
+}`
+	testDockerProfile = `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "defaultErrnoRet": 1,
+  "archMap": [
+    {"architecture": "SCMP_ARCH_X86_64", "subArchitectures": ["SCMP_ARCH_X86"]},
+    {"architecture": "SCMP_ARCH_AARCH64", "subArchitectures": ["SCMP_ARCH_ARM"]}
+  ],
+  "syscalls": [
+    {"action": "SCMP_ACT_ALLOW", "names": ["read", "write"]},
+    {"action": "SCMP_ACT_ALLOW", "name": "arch_prctl", "includes": {"arches": ["amd64"]}},
+    {"action": "SCMP_ACT_ALLOW", "names": ["ptrace"], "includes": {"caps": ["CAP_SYS_PTRACE"]}},
+    {
+      "action": "SCMP_ACT_ALLOW",
+      "names": ["personality"],
+      "args": [{"index": 0, "value": 8, "op": "SCMP_CMP_EQ"}]
+    }
+  ]
+}`
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		options *Options
+		prepare func(mock *converterfakes.FakeImpl)
+		assert  func(mock *converterfakes.FakeImpl, err error)
+	}{
+		{
+			name:    "success CRD to raw",
+			options: &Options{profile: "my-app.yaml", from: FormatCRD, to: FormatRaw},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testProfile), nil)
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				file, data, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, strings.TrimSuffix(DefaultOutputFile, ".yaml")+".json", file)
+				spec := &seccompprofileapi.SeccompProfileSpec{}
+				require.NoError(t, json.Unmarshal(data, spec))
+				require.Equal(t, seccomp.ActErrno, spec.DefaultAction)
+				require.Len(t, spec.Syscalls, 2)
+			},
+		},
+		{
+			name:    "success CRD to docker",
+			options: &Options{profile: "my-app.yaml", from: FormatCRD, to: FormatDocker},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testProfile), nil)
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				profile := &seccomp.Seccomp{}
+				require.NoError(t, json.Unmarshal(data, profile))
+				require.Equal(t, []seccomp.Arch{seccomp.ArchX86_64}, profile.Architectures)
+				require.Len(t, profile.Syscalls, 2)
+				require.Nil(t, profile.Syscalls[0].ErrnoRet)
+				require.NotNil(t, profile.Syscalls[1].ErrnoRet)
+				require.EqualValues(t, 1, *profile.Syscalls[1].ErrnoRet)
+			},
+		},
+		{
+			name:    "success raw to CRD",
+			options: &Options{profile: "/path/my-app.json", from: FormatRaw, to: FormatCRD},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testRawProfile), nil)
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				file, data, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, DefaultOutputFile, file)
+				profile := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, yaml.Unmarshal(data, profile))
+				require.Equal(t, "my-app", profile.Name)
+				require.Equal(t, "SeccompProfile", profile.Kind)
+				require.Len(t, profile.Spec.Syscalls, 1)
+			},
+		},
+		{
+			name:    "success docker to CRD",
+			options: &Options{profile: "default.json", from: FormatDocker, to: FormatCRD},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testDockerProfile), nil)
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				profile := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, yaml.Unmarshal(data, profile))
+				require.Equal(t, "default", profile.Name)
+				require.EqualValues(t, 1, *profile.Spec.DefaultErrnoRet)
+				require.Equal(t, []seccompprofileapi.Arch{
+					"SCMP_ARCH_X86_64", "SCMP_ARCH_X86", "SCMP_ARCH_AARCH64", "SCMP_ARCH_ARM",
+				}, profile.Spec.Architectures)
+				require.Len(t, profile.Spec.Syscalls, 3)
+				require.Equal(t, []string{"read", "write"}, profile.Spec.Syscalls[0].Names)
+				require.Equal(t, []string{"arch_prctl"}, profile.Spec.Syscalls[1].Names)
+				require.Equal(t, []string{"personality"}, profile.Spec.Syscalls[2].Names)
+				require.Len(t, profile.Spec.Syscalls[2].Args, 1)
+				require.EqualValues(t, 8, profile.Spec.Syscalls[2].Args[0].Value)
+			},
+		},
+		{
+			name:    "failure base profile to raw",
+			options: &Options{profile: "my-app.yaml", from: FormatCRD, to: FormatRaw},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testProfile+"  baseProfileName: runtime-default\n"), nil)
+			},
+			assert: func(_ *converterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, ErrBaseProfile)
+			},
+		},
+		{
+			name:    "failure docker profile with errno name",
+			options: &Options{profile: "default.json", from: FormatDocker, to: FormatCRD},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(`{"defaultAction": "SCMP_ACT_ERRNO", "defaultErrno": "EPERM"}`), nil)
+			},
+			assert: func(_ *converterfakes.FakeImpl, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:    "failure on ReadFile",
+			options: &Options{profile: "my-app.yaml", from: FormatCRD, to: FormatRaw},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns(nil, errTest)
+			},
+			assert: func(_ *converterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name:    "failure on YamlUnmarshal",
+			options: &Options{profile: "my-app.yaml", from: FormatCRD, to: FormatRaw},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.YamlUnmarshalReturns(errTest)
+			},
+			assert: func(_ *converterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name:    "failure on JSONUnmarshal",
+			options: &Options{profile: "default.json", from: FormatDocker, to: FormatCRD},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.JSONUnmarshalReturns(errTest)
+			},
+			assert: func(_ *converterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name:    "failure on YamlMarshal",
+			options: &Options{profile: "my-app.json", from: FormatRaw, to: FormatCRD},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testRawProfile), nil)
+				mock.YamlMarshalReturns(nil, errTest)
+			},
+			assert: func(_ *converterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name:    "failure on JSONMarshalIndent",
+			options: &Options{profile: "my-app.yaml", from: FormatCRD, to: FormatDocker},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testProfile), nil)
+				mock.JSONMarshalIndentReturns(nil, errTest)
+			},
+			assert: func(_ *converterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name:    "failure on WriteFile",
+			options: &Options{profile: "my-app.yaml", from: FormatCRD, to: FormatCRD},
+			prepare: func(mock *converterfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testProfile), nil)
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(_ *converterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		options := tc.options
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &converterfakes.FakeImpl{}
+			mock.ReadFileReturns([]byte(testProfile), nil)
+			mock.YamlUnmarshalStub = func(y []byte, o interface{}) error {
+				return yaml.Unmarshal(y, o)
+			}
+			mock.YamlMarshalStub = yaml.Marshal
+			mock.JSONUnmarshalStub = json.Unmarshal
+			mock.JSONMarshalIndentStub = json.MarshalIndent
+			prepare(mock)
+
+			options.outputFile = DefaultOutputFile
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"encoding/json"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	ReadFile(string) ([]byte, error)
+	YamlUnmarshal([]byte, interface{}) error
+	YamlMarshal(interface{}) ([]byte, error)
+	JSONUnmarshal([]byte, any) error
+	JSONMarshalIndent(any, string, string) ([]byte, error)
+	WriteFile(string, []byte, os.FileMode) error
+}
+
+func (*defaultImpl) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
+func (*defaultImpl) YamlUnmarshal(y []byte, o interface{}) error {
+	return yaml.Unmarshal(y, o)
+}
+
+func (*defaultImpl) YamlMarshal(o interface{}) ([]byte, error) {
+	return yaml.Marshal(o)
+}
+
+func (*defaultImpl) JSONUnmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (*defaultImpl) JSONMarshalIndent(v any, prefix, indent string) ([]byte, error) {
+	return json.MarshalIndent(v, prefix, indent)
+}
+
+func (*defaultImpl) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	ucli "github.com/urfave/cli/v2"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+// Options define all possible options for the converter.
+type Options struct {
+	profile    string
+	from       Format
+	to         Format
+	outputFile string
+}
+
+// Default returns a default options instance.
+func Default() *Options {
+	return &Options{
+		to:         FormatCRD,
+		outputFile: DefaultOutputFile,
+	}
+}
+
+// FromContext can be used to create Options from an CLI context.
+func FromContext(ctx *ucli.Context) (*Options, error) {
+	options := Default()
+
+	args := ctx.Args().Slice()
+	if len(args) == 0 {
+		return nil, errors.New("no profile provided")
+	}
+	options.profile = args[0]
+
+	// Guess the input format from the file extension if not specified.
+	options.from = FormatCRD
+	if filepath.Ext(options.profile) == seccompprofileapi.ExtJSON {
+		options.from = FormatRaw
+	}
+	if ctx.IsSet(FlagFrom) {
+		options.from = Format(ctx.String(FlagFrom))
+	}
+	if !slices.Contains(Formats, options.from) {
+		return nil, fmt.Errorf("unsupported %s format: %s", FlagFrom, options.from)
+	}
+
+	if ctx.IsSet(FlagTo) {
+		options.to = Format(ctx.String(FlagTo))
+	}
+	if !slices.Contains(Formats, options.to) {
+		return nil, fmt.Errorf("unsupported %s format: %s", FlagTo, options.to)
+	}
+
+	if ctx.IsSet(FlagOutputFile) {
+		options.outputFile = ctx.String(FlagOutputFile)
+	}
+	if options.outputFile == "" {
+		return nil, errors.New("no filename provided")
+	}
+
+	return options, nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		prepare func(*flag.FlagSet)
+		assert  func(*Options, error)
+	}{
+		{
+			name: "success YAML defaults",
+			prepare: func(set *flag.FlagSet) {
+				require.Nil(t, set.Parse([]string{"profile.yaml"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "profile.yaml", opts.profile)
+				require.Equal(t, FormatCRD, opts.from)
+				require.Equal(t, FormatCRD, opts.to)
+				require.Equal(t, DefaultOutputFile, opts.outputFile)
+			},
+		},
+		{
+			name: "success JSON defaults",
+			prepare: func(set *flag.FlagSet) {
+				require.Nil(t, set.Parse([]string{"profile.json"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, FormatRaw, opts.from)
+				require.Equal(t, FormatCRD, opts.to)
+			},
+		},
+		{
+			name: "success with formats",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagFrom, "", "")
+				set.String(FlagTo, "", "")
+				require.Nil(t, set.Set(FlagFrom, string(FormatDocker)))
+				require.Nil(t, set.Set(FlagTo, string(FormatRaw)))
+				require.Nil(t, set.Parse([]string{"profile.json"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, FormatDocker, opts.from)
+				require.Equal(t, FormatRaw, opts.to)
+			},
+		},
+		{
+			name:    "failure no profile provided",
+			prepare: func(set *flag.FlagSet) {},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure unsupported input format",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagFrom, "", "")
+				require.Nil(t, set.Set(FlagFrom, "wrong"))
+				require.Nil(t, set.Parse([]string{"profile.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure unsupported output format",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagTo, "", "")
+				require.Nil(t, set.Set(FlagTo, "wrong"))
+				require.Nil(t, set.Parse([]string{"profile.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure no output file provided",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagOutputFile, "", "")
+				require.Nil(t, set.Set(FlagOutputFile, ""))
+				require.Nil(t, set.Parse([]string{"profile.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			set := flag.NewFlagSet("", flag.ExitOnError)
+			prepare(set)
+
+			app := cli.NewApp()
+			ctx := cli.NewContext(app, set, nil)
+
+			opts, err := FromContext(ctx)
+			assert(opts, err)
+		})
+	}
+}
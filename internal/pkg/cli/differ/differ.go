@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// ErrDiffer is returned if the profiles are not equal.
+var ErrDiffer = errors.New("profiles differ")
+
+// Differ is the main structure of this package.
+type Differ struct {
+	impl
+	options *Options
+}
+
+// New returns a new Differ instance.
+func New(options *Options) *Differ {
+	return &Differ{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Differ.
+func (d *Differ) Run() error {
+	oldProfile, err := cli.ReadSeccompProfile(d, d.options.oldProfile)
+	if err != nil {
+		return fmt.Errorf("read profile %s: %w", d.options.oldProfile, err)
+	}
+
+	newProfile, err := cli.ReadSeccompProfile(d, d.options.newProfile)
+	if err != nil {
+		return fmt.Errorf("read profile %s: %w", d.options.newProfile, err)
+	}
+
+	lines, err := diffProfiles(&oldProfile.Spec, &newProfile.Spec)
+	if err != nil {
+		return fmt.Errorf("diff profiles: %w", err)
+	}
+	if len(lines) == 0 {
+		log.Printf("Profiles are equal")
+		return nil
+	}
+
+	d.Printf("--- %s\n", d.options.oldProfile)
+	d.Printf("+++ %s\n", d.options.newProfile)
+	for _, line := range lines {
+		d.Printf("%s\n", line)
+	}
+
+	return fmt.Errorf("%w: %d changes", ErrDiffer, len(lines))
+}
+
+// diffProfiles returns the removed lines prefixed with `-` followed by the
+// added lines prefixed with `+`. Syscalls are compared by using their action,
+// errno return code and arguments.
+func diffProfiles(oldSpec, newSpec *seccompprofileapi.SeccompProfileSpec) ([]string, error) {
+	removed, added := []string{}, []string{}
+
+	if oldSpec.DefaultAction != newSpec.DefaultAction {
+		removed = append(removed, fmt.Sprintf("defaultAction: %s", oldSpec.DefaultAction))
+		added = append(added, fmt.Sprintf("defaultAction: %s", newSpec.DefaultAction))
+	}
+
+	if oldSpec.BaseProfileName != newSpec.BaseProfileName {
+		if oldSpec.BaseProfileName != "" {
+			removed = append(removed, fmt.Sprintf("baseProfileName: %s", oldSpec.BaseProfileName))
+		}
+		if newSpec.BaseProfileName != "" {
+			added = append(added, fmt.Sprintf("baseProfileName: %s", newSpec.BaseProfileName))
+		}
+	}
+
+	for _, arch := range oldSpec.Architectures {
+		if !slices.Contains(newSpec.Architectures, arch) {
+			removed = append(removed, fmt.Sprintf("architecture: %s", arch))
+		}
+	}
+	for _, arch := range newSpec.Architectures {
+		if !slices.Contains(oldSpec.Architectures, arch) {
+			added = append(added, fmt.Sprintf("architecture: %s", arch))
+		}
+	}
+
+	removedSyscalls, err := syscallLines(util.SubtractSyscalls(oldSpec.Syscalls, newSpec.Syscalls))
+	if err != nil {
+		return nil, err
+	}
+	addedSyscalls, err := syscallLines(util.SubtractSyscalls(newSpec.Syscalls, oldSpec.Syscalls))
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []string{}
+	for _, line := range append(removed, removedSyscalls...) {
+		lines = append(lines, "-"+line)
+	}
+	for _, line := range append(added, addedSyscalls...) {
+		lines = append(lines, "+"+line)
+	}
+	return lines, nil
+}
+
+// syscallLines returns a sorted line for every syscall name.
+func syscallLines(syscalls []*seccompprofileapi.Syscall) ([]string, error) {
+	lines := []string{}
+	for _, syscall := range syscalls {
+		rule := []string{string(syscall.Action)}
+		if syscall.ErrnoRet != 0 {
+			rule = append(rule, fmt.Sprintf("errnoRet=%d", syscall.ErrnoRet))
+		}
+		if len(syscall.Args) > 0 {
+			args, err := json.Marshal(syscall.Args)
+			if err != nil {
+				return nil, fmt.Errorf("marshal syscall args: %w", err)
+			}
+			rule = append(rule, "args="+string(args))
+		}
+
+		for _, name := range syscall.Names {
+			lines = append(lines, fmt.Sprintf("syscall: %s (%s)", name, strings.Join(rule, " ")))
+		}
+	}
+	sort.Strings(lines)
+	return lines, nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/differ/differfakes"
+)
+
+var errTest = errors.New("test")
+
+const (
+	testProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+metadata:
+  name: my-app
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  architectures:
+  - SCMP_ARCH_X86_64
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names:
+    - ptrace
+    - read
+    - write
+`
+	testEqualJSONProfile = `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "architectures": ["SCMP_ARCH_X86_64"],
+  "syscalls": [{"action": "SCMP_ACT_ALLOW", "names": ["read", "write", "ptrace"]}]
+}`
+	testJSONProfile = `{
+  "defaultAction": "SCMP_ACT_LOG",
+  "architectures": ["SCMP_ARCH_X86_64", "SCMP_ARCH_X86"],
+  "syscalls": [
+    {"action": "SCMP_ACT_ALLOW", "names": ["read", "write", "close"]},
+    {"action": "SCMP_ACT_ERRNO", "errnoRet": 1, "names": ["ptrace"]}
+  ]
+}`
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		prepare func(mock *differfakes.FakeImpl)
+		assert  func(mock *differfakes.FakeImpl, err error)
+	}{
+		{
+			name: "success equal",
+			prepare: func(mock *differfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testEqualJSONProfile), nil)
+			},
+			assert: func(mock *differfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Zero(t, mock.PrintfCallCount())
+			},
+		},
+		{
+			name: "success different",
+			prepare: func(mock *differfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testJSONProfile), nil)
+			},
+			assert: func(mock *differfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, ErrDiffer)
+
+				lines := []string{}
+				for i := 0; i < mock.PrintfCallCount(); i++ {
+					format, args := mock.PrintfArgsForCall(i)
+					lines = append(lines, fmt.Sprintf(format, args...))
+				}
+				require.Equal(t, []string{
+					"--- profile.yaml\n",
+					"+++ profile.json\n",
+					"-defaultAction: SCMP_ACT_ERRNO\n",
+					"-syscall: ptrace (SCMP_ACT_ALLOW)\n",
+					"+defaultAction: SCMP_ACT_LOG\n",
+					"+architecture: SCMP_ARCH_X86\n",
+					"+syscall: close (SCMP_ACT_ALLOW)\n",
+					"+syscall: ptrace (SCMP_ACT_ERRNO errnoRet=1)\n",
+				}, lines)
+			},
+		},
+		{
+			name: "failure on ReadFile",
+			prepare: func(mock *differfakes.FakeImpl) {
+				mock.ReadFileReturns(nil, errTest)
+			},
+			assert: func(_ *differfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on YamlUnmarshal",
+			prepare: func(mock *differfakes.FakeImpl) {
+				mock.YamlUnmarshalReturns(errTest)
+			},
+			assert: func(_ *differfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on JSONUnmarshal",
+			prepare: func(mock *differfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.JSONUnmarshalReturns(errTest)
+			},
+			assert: func(_ *differfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &differfakes.FakeImpl{}
+			mock.YamlUnmarshalStub = func(y []byte, o interface{}) error {
+				return yaml.Unmarshal(y, o)
+			}
+			mock.JSONUnmarshalStub = json.Unmarshal
+			prepare(mock)
+
+			sut := New(&Options{
+				oldProfile: "profile.yaml",
+				newProfile: "profile.json",
+			})
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const policyNamePrefix = "spo-require-profiles-"
+
+var (
+	errUnsupportedKind      = errors.New("profile kind not supported")
+	errUnsupportedMatchType = errors.New("image match type not supported")
+)
+
+// Exporter is the main structure of this package.
+type Exporter struct {
+	impl
+	options *Options
+}
+
+// New returns a new Exporter instance.
+func New(options *Options) *Exporter {
+	return &Exporter{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Exporter.
+func (e *Exporter) Run() error {
+	cfg, err := e.GetConfig()
+	if err != nil {
+		return fmt.Errorf("get kubeconfig: %w", err)
+	}
+
+	c, err := e.NewClient(cfg)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	ctx := context.Background()
+	bindings, err := e.listBindings(ctx, c)
+	if err != nil {
+		return fmt.Errorf("list bindings: %w", err)
+	}
+	if len(bindings) == 0 {
+		return errors.New("no profile bindings found")
+	}
+
+	policies, err := e.policies(ctx, c, bindings)
+	if err != nil {
+		return fmt.Errorf("build policies: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for i := range policies {
+		data, err := e.YamlMarshal(policies[i])
+		if err != nil {
+			return fmt.Errorf("marshal YAML policy: %w", err)
+		}
+		buf.WriteString("---\n")
+		buf.Write(data)
+	}
+
+	log.Printf("Saving %d policies in: %s", len(policies), e.options.outputFile)
+	const defaultFileMode = os.FileMode(0o644)
+	if err := e.WriteFile(e.options.outputFile, buf.Bytes(), defaultFileMode); err != nil {
+		return fmt.Errorf("save policies: %w", err)
+	}
+
+	return nil
+}
+
+func (e *Exporter) listBindings(
+	ctx context.Context, c client.Client,
+) ([]profilebindingv1alpha1.ProfileBinding, error) {
+	if len(e.options.namespaces) == 0 {
+		log.Print("Listing profile bindings in all namespaces")
+		list, err := e.ListProfileBindings(ctx, c)
+		if err != nil {
+			return nil, fmt.Errorf("in all namespaces: %w", err)
+		}
+		return list.Items, nil
+	}
+
+	res := []profilebindingv1alpha1.ProfileBinding{}
+	for _, namespace := range e.options.namespaces {
+		log.Printf("Listing profile bindings in namespace %s", namespace)
+		list, err := e.ListProfileBindings(ctx, c, client.InNamespace(namespace))
+		if err != nil {
+			return nil, fmt.Errorf("in namespace %s: %w", namespace, err)
+		}
+		res = append(res, list.Items...)
+	}
+	return res, nil
+}
+
+// policies returns a policy for every namespace containing profile bindings,
+// where every binding results in a rule of the policy.
+func (e *Exporter) policies(
+	ctx context.Context, c client.Client, bindings []profilebindingv1alpha1.ProfileBinding,
+) ([]*clusterPolicy, error) {
+	sort.Slice(bindings, func(i, j int) bool {
+		if bindings[i].Namespace != bindings[j].Namespace {
+			return bindings[i].Namespace < bindings[j].Namespace
+		}
+		return bindings[i].Name < bindings[j].Name
+	})
+
+	action := validationFailureActionAudit
+	if e.options.enforce {
+		action = validationFailureActionEnforce
+	}
+
+	var (
+		res    []*clusterPolicy
+		policy *clusterPolicy
+	)
+	for i := range bindings {
+		binding := &bindings[i]
+		r, err := e.rule(ctx, c, binding)
+		if errors.Is(err, errUnsupportedKind) || errors.Is(err, errUnsupportedMatchType) {
+			log.Printf("Skipping binding %s/%s: %v", binding.Namespace, binding.Name, err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("build rule for binding %s/%s: %w", binding.Namespace, binding.Name, err)
+		}
+
+		if policy == nil || policy.Name != policyNamePrefix+binding.Namespace {
+			policy = newPolicy(binding.Namespace, action)
+			res = append(res, policy)
+		}
+		policy.Spec.Rules = append(policy.Spec.Rules, *r)
+	}
+
+	return res, nil
+}
+
+func newPolicy(namespace, action string) *clusterPolicy {
+	return &clusterPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kyvernoAPIVersion,
+			Kind:       kyvernoKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyNamePrefix + namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": config.OperatorName,
+			},
+			Annotations: map[string]string{
+				"policies.kyverno.io/description": fmt.Sprintf(
+					"Requires the pods in namespace %s to use the profiles of their profile bindings.",
+					namespace,
+				),
+			},
+		},
+		Spec: policySpec{
+			ValidationFailureAction: action,
+			Background:              true,
+		},
+	}
+}
+
+// imagePattern returns the Kyverno pattern matching the images selected by
+// the binding. Kyverno patterns support the wildcards of globs, but not
+// regular expressions.
+func imagePattern(spec *profilebindingv1alpha1.ProfileBindingSpec) (string, error) {
+	switch spec.MatchType {
+	case profilebindingv1alpha1.ImageMatchTypeExact, profilebindingv1alpha1.ImageMatchTypeGlob, "":
+	default:
+		return "", fmt.Errorf("%w: %s", errUnsupportedMatchType, spec.MatchType)
+	}
+
+	if spec.Digest != "" {
+		return spec.Image + "@" + spec.Digest, nil
+	}
+	return spec.Image, nil
+}
+
+// rule returns the rule requiring the containers selected by the binding to
+// use the bound profile.
+func (e *Exporter) rule(
+	ctx context.Context, c client.Client, binding *profilebindingv1alpha1.ProfileBinding,
+) (*rule, error) {
+	securityContext, err := e.securityContext(ctx, c, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := binding.Spec.ProfileRef
+	var (
+		message string
+		spec    map[string]interface{}
+	)
+	if binding.Spec.EphemeralContainers {
+		message = fmt.Sprintf("Ephemeral containers have to use the %s %s.", ref.Kind, ref.Name)
+		spec = map[string]interface{}{
+			"=(ephemeralContainers)": []interface{}{
+				map[string]interface{}{"securityContext": securityContext},
+			},
+		}
+	} else {
+		image, err := imagePattern(&binding.Spec)
+		if err != nil {
+			return nil, err
+		}
+		message = fmt.Sprintf(
+			"Containers with image %s have to use the %s %s.", image, ref.Kind, ref.Name,
+		)
+		// The conditional anchor restricts the pattern to the containers
+		// which match the image of the binding.
+		containers := []interface{}{
+			map[string]interface{}{
+				"(image)":         image,
+				"securityContext": securityContext,
+			},
+		}
+		spec = map[string]interface{}{
+			"containers":             containers,
+			"=(initContainers)":      containers,
+			"=(ephemeralContainers)": containers,
+		}
+	}
+
+	return &rule{
+		Name: binding.Name,
+		Match: matchResources{
+			Any: []resourceFilter{{
+				Resources: resourceDescription{
+					Kinds:      []string{"Pod"},
+					Namespaces: []string{binding.Namespace},
+				},
+			}},
+		},
+		Validate: validation{
+			Message: message,
+			Pattern: map[string]interface{}{"spec": spec},
+		},
+	}, nil
+}
+
+// securityContext returns the part of the container security context which
+// references the bound profile.
+func (e *Exporter) securityContext(
+	ctx context.Context, c client.Client, binding *profilebindingv1alpha1.ProfileBinding,
+) (map[string]interface{}, error) {
+	key := types.NamespacedName{
+		Namespace: binding.Namespace,
+		Name:      binding.Spec.ProfileRef.Name,
+	}
+
+	switch binding.Spec.ProfileRef.Kind {
+	case profilebindingv1alpha1.ProfileBindingKindSeccompProfile:
+		profile, err := e.GetSeccompProfile(ctx, c, key)
+		if err != nil {
+			return nil, fmt.Errorf("get profile: %w", err)
+		}
+		if profile.Status.LocalhostProfile == "" {
+			return nil, fmt.Errorf("seccomp profile %s has not been installed yet", key)
+		}
+		return map[string]interface{}{
+			"seccompProfile": map[string]interface{}{
+				"type":             string(corev1.SeccompProfileTypeLocalhost),
+				"localhostProfile": profile.Status.LocalhostProfile,
+			},
+		}, nil
+
+	case profilebindingv1alpha1.ProfileBindingKindSelinuxProfile:
+		profile, err := e.GetSelinuxProfile(ctx, c, key)
+		if err != nil {
+			return nil, fmt.Errorf("get profile: %w", err)
+		}
+		if profile.Status.Usage == "" {
+			return nil, fmt.Errorf("selinux profile %s has not been installed yet", key)
+		}
+		return map[string]interface{}{
+			"seLinuxOptions": map[string]interface{}{
+				"type": profile.Status.Usage,
+			},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", errUnsupportedKind, binding.Spec.ProfileRef.Kind)
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/exporter/exporterfakes"
+)
+
+var errTest = errors.New("test")
+
+func testBinding(
+	namespace, name string, kind profilebindingv1alpha1.ProfileBindingKind, image string,
+) profilebindingv1alpha1.ProfileBinding {
+	return profilebindingv1alpha1.ProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: profilebindingv1alpha1.ProfileBindingSpec{
+			ProfileRef: profilebindingv1alpha1.ProfileRef{Kind: kind, Name: "profile"},
+			Image:      image,
+		},
+	}
+}
+
+func testSeccompProfile() *seccompprofileapi.SeccompProfile {
+	profile := &seccompprofileapi.SeccompProfile{}
+	profile.Status.LocalhostProfile = "operator/default/profile.json"
+	return profile
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		options *Options
+		prepare func(mock *exporterfakes.FakeImpl)
+		assert  func(mock *exporterfakes.FakeImpl, err error)
+	}{
+		{
+			name: "success",
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&profilebindingv1alpha1.ProfileBindingList{
+					Items: []profilebindingv1alpha1.ProfileBinding{
+						testBinding("default", "nginx", profilebindingv1alpha1.ProfileBindingKindSeccompProfile, "nginx"),
+						testBinding("app", "app", profilebindingv1alpha1.ProfileBindingKindSelinuxProfile, "app"),
+						testBinding("default", "debug", profilebindingv1alpha1.ProfileBindingKindSeccompProfile, ""),
+						testBinding("default", "unsupported", "Unsupported", "nginx"),
+					},
+				}, nil)
+				mock.GetSeccompProfileReturns(testSeccompProfile(), nil)
+				selinuxProfile := &selinuxprofileapi.SelinuxProfile{}
+				selinuxProfile.Status.Usage = "profile_app.process"
+				mock.GetSelinuxProfileReturns(selinuxProfile, nil)
+			},
+			assert: func(mock *exporterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.ListProfileBindingsCallCount())
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				policies := []clusterPolicy{}
+				for _, doc := range splitDocuments(data) {
+					policy := clusterPolicy{}
+					require.NoError(t, yaml.Unmarshal(doc, &policy))
+					policies = append(policies, policy)
+				}
+				require.Len(t, policies, 2)
+
+				require.Equal(t, "spo-require-profiles-app", policies[0].Name)
+				require.Equal(t, kyvernoKind, policies[0].Kind)
+				require.Equal(t, validationFailureActionAudit, policies[0].Spec.ValidationFailureAction)
+				require.Len(t, policies[0].Spec.Rules, 1)
+				require.Equal(t, []string{"app"}, policies[0].Spec.Rules[0].Match.Any[0].Resources.Namespaces)
+				require.Contains(t, string(data), "type: profile_app.process")
+
+				require.Equal(t, "spo-require-profiles-default", policies[1].Name)
+				require.Len(t, policies[1].Spec.Rules, 2)
+				require.Equal(t, "debug", policies[1].Spec.Rules[0].Name)
+				require.Equal(t, "nginx", policies[1].Spec.Rules[1].Name)
+				require.Contains(t, string(data), "localhostProfile: operator/default/profile.json")
+				require.Contains(t, string(data), "(image): nginx")
+			},
+		},
+		{
+			name:    "success ephemeral containers enforced",
+			options: &Options{namespaces: []string{"default"}, enforce: true},
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				binding := testBinding("default", "debug", profilebindingv1alpha1.ProfileBindingKindSeccompProfile, "")
+				binding.Spec.EphemeralContainers = true
+				mock.ListProfileBindingsReturns(&profilebindingv1alpha1.ProfileBindingList{
+					Items: []profilebindingv1alpha1.ProfileBinding{binding},
+				}, nil)
+				mock.GetSeccompProfileReturns(testSeccompProfile(), nil)
+			},
+			assert: func(mock *exporterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(data), "validationFailureAction: Enforce")
+				require.Contains(t, string(data), "=(ephemeralContainers)")
+				require.NotContains(t, string(data), "(image)")
+			},
+		},
+		{
+			name: "success image patterns",
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				kind := profilebindingv1alpha1.ProfileBindingKindSeccompProfile
+				glob := testBinding("default", "glob", kind, "nginx:*")
+				glob.Spec.MatchType = profilebindingv1alpha1.ImageMatchTypeGlob
+				glob.Spec.Digest = "sha256:9a7e"
+				regex := testBinding("default", "regex", kind, "nginx:.*")
+				regex.Spec.MatchType = profilebindingv1alpha1.ImageMatchTypeRegex
+				mock.ListProfileBindingsReturns(&profilebindingv1alpha1.ProfileBindingList{
+					Items: []profilebindingv1alpha1.ProfileBinding{glob, regex},
+				}, nil)
+				mock.GetSeccompProfileReturns(testSeccompProfile(), nil)
+			},
+			assert: func(mock *exporterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(data), "(image): nginx:*@sha256:9a7e")
+				require.NotContains(t, string(data), "name: regex")
+			},
+		},
+		{
+			name: "failure no bindings",
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&profilebindingv1alpha1.ProfileBindingList{}, nil)
+			},
+			assert: func(mock *exporterfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name: "failure profile not installed",
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&profilebindingv1alpha1.ProfileBindingList{
+					Items: []profilebindingv1alpha1.ProfileBinding{
+						testBinding("default", "nginx", profilebindingv1alpha1.ProfileBindingKindSeccompProfile, "nginx"),
+					},
+				}, nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{}, nil)
+			},
+			assert: func(mock *exporterfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name: "failure on GetConfig",
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				mock.GetConfigReturns(nil, errTest)
+			},
+			assert: func(_ *exporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on NewClient",
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				mock.NewClientReturns(nil, errTest)
+			},
+			assert: func(_ *exporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name:    "failure on ListProfileBindings",
+			options: &Options{namespaces: []string{"default"}},
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(nil, errTest)
+			},
+			assert: func(_ *exporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on GetSelinuxProfile",
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&profilebindingv1alpha1.ProfileBindingList{
+					Items: []profilebindingv1alpha1.ProfileBinding{
+						testBinding("app", "app", profilebindingv1alpha1.ProfileBindingKindSelinuxProfile, "app"),
+					},
+				}, nil)
+				mock.GetSelinuxProfileReturns(nil, errTest)
+			},
+			assert: func(_ *exporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on WriteFile",
+			prepare: func(mock *exporterfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&profilebindingv1alpha1.ProfileBindingList{
+					Items: []profilebindingv1alpha1.ProfileBinding{
+						testBinding("default", "nginx", profilebindingv1alpha1.ProfileBindingKindSeccompProfile, "nginx"),
+					},
+				}, nil)
+				mock.GetSeccompProfileReturns(testSeccompProfile(), nil)
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(_ *exporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		options := tc.options
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &exporterfakes.FakeImpl{}
+			mock.YamlMarshalStub = yaml.Marshal
+			prepare(mock)
+
+			if options == nil {
+				options = &Options{}
+			}
+			options.outputFile = DefaultOutputFile
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
+
+func splitDocuments(data []byte) (docs [][]byte) {
+	for _, doc := range strings.Split(string(data), "---\n") {
+		if doc != "" {
+			docs = append(docs, []byte(doc))
+		}
+	}
+	return docs
+}
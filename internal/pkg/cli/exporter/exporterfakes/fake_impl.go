@@ -0,0 +1,618 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by counterfeiter. DO NOT EDIT.
+package exporterfakes
+
+import (
+	"context"
+	"io/fs"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+type FakeImpl struct {
+	GetConfigStub        func() (*rest.Config, error)
+	getConfigMutex       sync.RWMutex
+	getConfigArgsForCall []struct {
+	}
+	getConfigReturns struct {
+		result1 *rest.Config
+		result2 error
+	}
+	getConfigReturnsOnCall map[int]struct {
+		result1 *rest.Config
+		result2 error
+	}
+	GetSeccompProfileStub        func(context.Context, client.Client, types.NamespacedName) (*v1beta1.SeccompProfile, error)
+	getSeccompProfileMutex       sync.RWMutex
+	getSeccompProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+	}
+	getSeccompProfileReturns struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}
+	getSeccompProfileReturnsOnCall map[int]struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}
+	GetSelinuxProfileStub        func(context.Context, client.Client, types.NamespacedName) (*v1alpha2.SelinuxProfile, error)
+	getSelinuxProfileMutex       sync.RWMutex
+	getSelinuxProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+	}
+	getSelinuxProfileReturns struct {
+		result1 *v1alpha2.SelinuxProfile
+		result2 error
+	}
+	getSelinuxProfileReturnsOnCall map[int]struct {
+		result1 *v1alpha2.SelinuxProfile
+		result2 error
+	}
+	ListProfileBindingsStub        func(context.Context, client.Client, ...client.ListOption) (*v1alpha1.ProfileBindingList, error)
+	listProfileBindingsMutex       sync.RWMutex
+	listProfileBindingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 []client.ListOption
+	}
+	listProfileBindingsReturns struct {
+		result1 *v1alpha1.ProfileBindingList
+		result2 error
+	}
+	listProfileBindingsReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ProfileBindingList
+		result2 error
+	}
+	NewClientStub        func(*rest.Config) (client.Client, error)
+	newClientMutex       sync.RWMutex
+	newClientArgsForCall []struct {
+		arg1 *rest.Config
+	}
+	newClientReturns struct {
+		result1 client.Client
+		result2 error
+	}
+	newClientReturnsOnCall map[int]struct {
+		result1 client.Client
+		result2 error
+	}
+	WriteFileStub        func(string, []byte, fs.FileMode) error
+	writeFileMutex       sync.RWMutex
+	writeFileArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 fs.FileMode
+	}
+	writeFileReturns struct {
+		result1 error
+	}
+	writeFileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	YamlMarshalStub        func(interface{}) ([]byte, error)
+	yamlMarshalMutex       sync.RWMutex
+	yamlMarshalArgsForCall []struct {
+		arg1 interface{}
+	}
+	yamlMarshalReturns struct {
+		result1 []byte
+		result2 error
+	}
+	yamlMarshalReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImpl) GetConfig() (*rest.Config, error) {
+	fake.getConfigMutex.Lock()
+	ret, specificReturn := fake.getConfigReturnsOnCall[len(fake.getConfigArgsForCall)]
+	fake.getConfigArgsForCall = append(fake.getConfigArgsForCall, struct {
+	}{})
+	stub := fake.GetConfigStub
+	fakeReturns := fake.getConfigReturns
+	fake.recordInvocation("GetConfig", []interface{}{})
+	fake.getConfigMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetConfigCallCount() int {
+	fake.getConfigMutex.RLock()
+	defer fake.getConfigMutex.RUnlock()
+	return len(fake.getConfigArgsForCall)
+}
+
+func (fake *FakeImpl) GetConfigCalls(stub func() (*rest.Config, error)) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = stub
+}
+
+func (fake *FakeImpl) GetConfigReturns(result1 *rest.Config, result2 error) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = nil
+	fake.getConfigReturns = struct {
+		result1 *rest.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetConfigReturnsOnCall(i int, result1 *rest.Config, result2 error) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = nil
+	if fake.getConfigReturnsOnCall == nil {
+		fake.getConfigReturnsOnCall = make(map[int]struct {
+			result1 *rest.Config
+			result2 error
+		})
+	}
+	fake.getConfigReturnsOnCall[i] = struct {
+		result1 *rest.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSeccompProfile(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName) (*v1beta1.SeccompProfile, error) {
+	fake.getSeccompProfileMutex.Lock()
+	ret, specificReturn := fake.getSeccompProfileReturnsOnCall[len(fake.getSeccompProfileArgsForCall)]
+	fake.getSeccompProfileArgsForCall = append(fake.getSeccompProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+	}{arg1, arg2, arg3})
+	stub := fake.GetSeccompProfileStub
+	fakeReturns := fake.getSeccompProfileReturns
+	fake.recordInvocation("GetSeccompProfile", []interface{}{arg1, arg2, arg3})
+	fake.getSeccompProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSeccompProfileCallCount() int {
+	fake.getSeccompProfileMutex.RLock()
+	defer fake.getSeccompProfileMutex.RUnlock()
+	return len(fake.getSeccompProfileArgsForCall)
+}
+
+func (fake *FakeImpl) GetSeccompProfileCalls(stub func(context.Context, client.Client, types.NamespacedName) (*v1beta1.SeccompProfile, error)) {
+	fake.getSeccompProfileMutex.Lock()
+	defer fake.getSeccompProfileMutex.Unlock()
+	fake.GetSeccompProfileStub = stub
+}
+
+func (fake *FakeImpl) GetSeccompProfileArgsForCall(i int) (context.Context, client.Client, types.NamespacedName) {
+	fake.getSeccompProfileMutex.RLock()
+	defer fake.getSeccompProfileMutex.RUnlock()
+	argsForCall := fake.getSeccompProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) GetSeccompProfileReturns(result1 *v1beta1.SeccompProfile, result2 error) {
+	fake.getSeccompProfileMutex.Lock()
+	defer fake.getSeccompProfileMutex.Unlock()
+	fake.GetSeccompProfileStub = nil
+	fake.getSeccompProfileReturns = struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSeccompProfileReturnsOnCall(i int, result1 *v1beta1.SeccompProfile, result2 error) {
+	fake.getSeccompProfileMutex.Lock()
+	defer fake.getSeccompProfileMutex.Unlock()
+	fake.GetSeccompProfileStub = nil
+	if fake.getSeccompProfileReturnsOnCall == nil {
+		fake.getSeccompProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.SeccompProfile
+			result2 error
+		})
+	}
+	fake.getSeccompProfileReturnsOnCall[i] = struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSelinuxProfile(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName) (*v1alpha2.SelinuxProfile, error) {
+	fake.getSelinuxProfileMutex.Lock()
+	ret, specificReturn := fake.getSelinuxProfileReturnsOnCall[len(fake.getSelinuxProfileArgsForCall)]
+	fake.getSelinuxProfileArgsForCall = append(fake.getSelinuxProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+	}{arg1, arg2, arg3})
+	stub := fake.GetSelinuxProfileStub
+	fakeReturns := fake.getSelinuxProfileReturns
+	fake.recordInvocation("GetSelinuxProfile", []interface{}{arg1, arg2, arg3})
+	fake.getSelinuxProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSelinuxProfileCallCount() int {
+	fake.getSelinuxProfileMutex.RLock()
+	defer fake.getSelinuxProfileMutex.RUnlock()
+	return len(fake.getSelinuxProfileArgsForCall)
+}
+
+func (fake *FakeImpl) GetSelinuxProfileCalls(stub func(context.Context, client.Client, types.NamespacedName) (*v1alpha2.SelinuxProfile, error)) {
+	fake.getSelinuxProfileMutex.Lock()
+	defer fake.getSelinuxProfileMutex.Unlock()
+	fake.GetSelinuxProfileStub = stub
+}
+
+func (fake *FakeImpl) GetSelinuxProfileArgsForCall(i int) (context.Context, client.Client, types.NamespacedName) {
+	fake.getSelinuxProfileMutex.RLock()
+	defer fake.getSelinuxProfileMutex.RUnlock()
+	argsForCall := fake.getSelinuxProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) GetSelinuxProfileReturns(result1 *v1alpha2.SelinuxProfile, result2 error) {
+	fake.getSelinuxProfileMutex.Lock()
+	defer fake.getSelinuxProfileMutex.Unlock()
+	fake.GetSelinuxProfileStub = nil
+	fake.getSelinuxProfileReturns = struct {
+		result1 *v1alpha2.SelinuxProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSelinuxProfileReturnsOnCall(i int, result1 *v1alpha2.SelinuxProfile, result2 error) {
+	fake.getSelinuxProfileMutex.Lock()
+	defer fake.getSelinuxProfileMutex.Unlock()
+	fake.GetSelinuxProfileStub = nil
+	if fake.getSelinuxProfileReturnsOnCall == nil {
+		fake.getSelinuxProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha2.SelinuxProfile
+			result2 error
+		})
+	}
+	fake.getSelinuxProfileReturnsOnCall[i] = struct {
+		result1 *v1alpha2.SelinuxProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListProfileBindings(arg1 context.Context, arg2 client.Client, arg3 ...client.ListOption) (*v1alpha1.ProfileBindingList, error) {
+	fake.listProfileBindingsMutex.Lock()
+	ret, specificReturn := fake.listProfileBindingsReturnsOnCall[len(fake.listProfileBindingsArgsForCall)]
+	fake.listProfileBindingsArgsForCall = append(fake.listProfileBindingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 []client.ListOption
+	}{arg1, arg2, arg3})
+	stub := fake.ListProfileBindingsStub
+	fakeReturns := fake.listProfileBindingsReturns
+	fake.recordInvocation("ListProfileBindings", []interface{}{arg1, arg2, arg3})
+	fake.listProfileBindingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListProfileBindingsCallCount() int {
+	fake.listProfileBindingsMutex.RLock()
+	defer fake.listProfileBindingsMutex.RUnlock()
+	return len(fake.listProfileBindingsArgsForCall)
+}
+
+func (fake *FakeImpl) ListProfileBindingsCalls(stub func(context.Context, client.Client, ...client.ListOption) (*v1alpha1.ProfileBindingList, error)) {
+	fake.listProfileBindingsMutex.Lock()
+	defer fake.listProfileBindingsMutex.Unlock()
+	fake.ListProfileBindingsStub = stub
+}
+
+func (fake *FakeImpl) ListProfileBindingsArgsForCall(i int) (context.Context, client.Client, []client.ListOption) {
+	fake.listProfileBindingsMutex.RLock()
+	defer fake.listProfileBindingsMutex.RUnlock()
+	argsForCall := fake.listProfileBindingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ListProfileBindingsReturns(result1 *v1alpha1.ProfileBindingList, result2 error) {
+	fake.listProfileBindingsMutex.Lock()
+	defer fake.listProfileBindingsMutex.Unlock()
+	fake.ListProfileBindingsStub = nil
+	fake.listProfileBindingsReturns = struct {
+		result1 *v1alpha1.ProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListProfileBindingsReturnsOnCall(i int, result1 *v1alpha1.ProfileBindingList, result2 error) {
+	fake.listProfileBindingsMutex.Lock()
+	defer fake.listProfileBindingsMutex.Unlock()
+	fake.ListProfileBindingsStub = nil
+	if fake.listProfileBindingsReturnsOnCall == nil {
+		fake.listProfileBindingsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ProfileBindingList
+			result2 error
+		})
+	}
+	fake.listProfileBindingsReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClient(arg1 *rest.Config) (client.Client, error) {
+	fake.newClientMutex.Lock()
+	ret, specificReturn := fake.newClientReturnsOnCall[len(fake.newClientArgsForCall)]
+	fake.newClientArgsForCall = append(fake.newClientArgsForCall, struct {
+		arg1 *rest.Config
+	}{arg1})
+	stub := fake.NewClientStub
+	fakeReturns := fake.newClientReturns
+	fake.recordInvocation("NewClient", []interface{}{arg1})
+	fake.newClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) NewClientCallCount() int {
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	return len(fake.newClientArgsForCall)
+}
+
+func (fake *FakeImpl) NewClientCalls(stub func(*rest.Config) (client.Client, error)) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = stub
+}
+
+func (fake *FakeImpl) NewClientArgsForCall(i int) *rest.Config {
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	argsForCall := fake.newClientArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) NewClientReturns(result1 client.Client, result2 error) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = nil
+	fake.newClientReturns = struct {
+		result1 client.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClientReturnsOnCall(i int, result1 client.Client, result2 error) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = nil
+	if fake.newClientReturnsOnCall == nil {
+		fake.newClientReturnsOnCall = make(map[int]struct {
+			result1 client.Client
+			result2 error
+		})
+	}
+	fake.newClientReturnsOnCall[i] = struct {
+		result1 client.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) WriteFile(arg1 string, arg2 []byte, arg3 fs.FileMode) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeFileMutex.Lock()
+	ret, specificReturn := fake.writeFileReturnsOnCall[len(fake.writeFileArgsForCall)]
+	fake.writeFileArgsForCall = append(fake.writeFileArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 fs.FileMode
+	}{arg1, arg2Copy, arg3})
+	stub := fake.WriteFileStub
+	fakeReturns := fake.writeFileReturns
+	fake.recordInvocation("WriteFile", []interface{}{arg1, arg2Copy, arg3})
+	fake.writeFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) WriteFileCallCount() int {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	return len(fake.writeFileArgsForCall)
+}
+
+func (fake *FakeImpl) WriteFileCalls(stub func(string, []byte, fs.FileMode) error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = stub
+}
+
+func (fake *FakeImpl) WriteFileArgsForCall(i int) (string, []byte, fs.FileMode) {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	argsForCall := fake.writeFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) WriteFileReturns(result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	fake.writeFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) WriteFileReturnsOnCall(i int, result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	if fake.writeFileReturnsOnCall == nil {
+		fake.writeFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) YamlMarshal(arg1 interface{}) ([]byte, error) {
+	fake.yamlMarshalMutex.Lock()
+	ret, specificReturn := fake.yamlMarshalReturnsOnCall[len(fake.yamlMarshalArgsForCall)]
+	fake.yamlMarshalArgsForCall = append(fake.yamlMarshalArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.YamlMarshalStub
+	fakeReturns := fake.yamlMarshalReturns
+	fake.recordInvocation("YamlMarshal", []interface{}{arg1})
+	fake.yamlMarshalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) YamlMarshalCallCount() int {
+	fake.yamlMarshalMutex.RLock()
+	defer fake.yamlMarshalMutex.RUnlock()
+	return len(fake.yamlMarshalArgsForCall)
+}
+
+func (fake *FakeImpl) YamlMarshalCalls(stub func(interface{}) ([]byte, error)) {
+	fake.yamlMarshalMutex.Lock()
+	defer fake.yamlMarshalMutex.Unlock()
+	fake.YamlMarshalStub = stub
+}
+
+func (fake *FakeImpl) YamlMarshalArgsForCall(i int) interface{} {
+	fake.yamlMarshalMutex.RLock()
+	defer fake.yamlMarshalMutex.RUnlock()
+	argsForCall := fake.yamlMarshalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) YamlMarshalReturns(result1 []byte, result2 error) {
+	fake.yamlMarshalMutex.Lock()
+	defer fake.yamlMarshalMutex.Unlock()
+	fake.YamlMarshalStub = nil
+	fake.yamlMarshalReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) YamlMarshalReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.yamlMarshalMutex.Lock()
+	defer fake.yamlMarshalMutex.Unlock()
+	fake.YamlMarshalStub = nil
+	if fake.yamlMarshalReturnsOnCall == nil {
+		fake.yamlMarshalReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.yamlMarshalReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getConfigMutex.RLock()
+	defer fake.getConfigMutex.RUnlock()
+	fake.getSeccompProfileMutex.RLock()
+	defer fake.getSeccompProfileMutex.RUnlock()
+	fake.getSelinuxProfileMutex.RLock()
+	defer fake.getSelinuxProfileMutex.RUnlock()
+	fake.listProfileBindingsMutex.RLock()
+	defer fake.listProfileBindingsMutex.RUnlock()
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	fake.yamlMarshalMutex.RLock()
+	defer fake.yamlMarshalMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImpl) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	GetConfig() (*rest.Config, error)
+	NewClient(*rest.Config) (client.Client, error)
+	ListProfileBindings(
+		context.Context, client.Client, ...client.ListOption,
+	) (*profilebindingv1alpha1.ProfileBindingList, error)
+	GetSeccompProfile(
+		context.Context, client.Client, types.NamespacedName,
+	) (*seccompprofileapi.SeccompProfile, error)
+	GetSelinuxProfile(
+		context.Context, client.Client, types.NamespacedName,
+	) (*selinuxprofileapi.SelinuxProfile, error)
+	YamlMarshal(interface{}) ([]byte, error)
+	WriteFile(string, []byte, os.FileMode) error
+}
+
+func (*defaultImpl) GetConfig() (*rest.Config, error) {
+	return config.GetConfig()
+}
+
+func (*defaultImpl) NewClient(cfg *rest.Config) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	if err := profilebindingv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("add profile binding API to scheme: %w", err)
+	}
+	if err := seccompprofileapi.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("add seccomp profile API to scheme: %w", err)
+	}
+	if err := selinuxprofileapi.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("add selinux profile API to scheme: %w", err)
+	}
+	return client.New(cfg, client.Options{Scheme: scheme})
+}
+
+func (*defaultImpl) ListProfileBindings(
+	ctx context.Context, c client.Client, opts ...client.ListOption,
+) (*profilebindingv1alpha1.ProfileBindingList, error) {
+	profileBindings := &profilebindingv1alpha1.ProfileBindingList{}
+	if err := c.List(ctx, profileBindings, opts...); err != nil {
+		return nil, fmt.Errorf("list profile bindings: %w", err)
+	}
+	return profileBindings, nil
+}
+
+func (*defaultImpl) GetSeccompProfile(
+	ctx context.Context, c client.Client, key types.NamespacedName,
+) (*seccompprofileapi.SeccompProfile, error) {
+	seccompProfile := &seccompprofileapi.SeccompProfile{}
+	if err := c.Get(ctx, key, seccompProfile); err != nil {
+		return nil, fmt.Errorf("get seccomp profile: %w", err)
+	}
+	return seccompProfile, nil
+}
+
+func (*defaultImpl) GetSelinuxProfile(
+	ctx context.Context, c client.Client, key types.NamespacedName,
+) (*selinuxprofileapi.SelinuxProfile, error) {
+	selinuxProfile := &selinuxprofileapi.SelinuxProfile{}
+	if err := c.Get(ctx, key, selinuxProfile); err != nil {
+		return nil, fmt.Errorf("get selinux profile: %w", err)
+	}
+	return selinuxProfile, nil
+}
+
+func (*defaultImpl) YamlMarshal(o interface{}) ([]byte, error) {
+	return yaml.Marshal(o)
+}
+
+func (*defaultImpl) WriteFile(name string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
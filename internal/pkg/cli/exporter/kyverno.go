@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The types below are the subset of the Kyverno ClusterPolicy API which is
+// required for the exported policies.
+// See: https://kyverno.io/docs/kyverno-policies
+
+const (
+	kyvernoAPIVersion = "kyverno.io/v1"
+	kyvernoKind       = "ClusterPolicy"
+
+	validationFailureActionAudit   = "Audit"
+	validationFailureActionEnforce = "Enforce"
+)
+
+type clusterPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec policySpec `json:"spec"`
+}
+
+type policySpec struct {
+	ValidationFailureAction string `json:"validationFailureAction"`
+	Background              bool   `json:"background"`
+	Rules                   []rule `json:"rules"`
+}
+
+type rule struct {
+	Name     string         `json:"name"`
+	Match    matchResources `json:"match"`
+	Validate validation     `json:"validate"`
+}
+
+type matchResources struct {
+	Any []resourceFilter `json:"any"`
+}
+
+type resourceFilter struct {
+	Resources resourceDescription `json:"resources"`
+}
+
+type resourceDescription struct {
+	Kinds      []string `json:"kinds"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+type validation struct {
+	Message string                 `json:"message"`
+	Pattern map[string]interface{} `json:"pattern"`
+}
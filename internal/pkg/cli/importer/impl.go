@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	Stat(string) (os.FileInfo, error)
+	WalkDir(string, fs.WalkDirFunc) error
+	ReadFile(string) ([]byte, error)
+	Open(string) (io.ReadCloser, error)
+	GetConfig() (*rest.Config, error)
+	NewClient(*rest.Config) (client.Client, error)
+	CreateSeccompProfile(context.Context, client.Client, *seccompprofileapi.SeccompProfile) error
+}
+
+func (*defaultImpl) Stat(name string) (os.FileInfo, error) {
+	return os.Stat(name)
+}
+
+func (*defaultImpl) WalkDir(root string, fn fs.WalkDirFunc) error {
+	return filepath.WalkDir(root, fn)
+}
+
+func (*defaultImpl) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
+func (*defaultImpl) Open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+func (*defaultImpl) GetConfig() (*rest.Config, error) {
+	return config.GetConfig()
+}
+
+func (*defaultImpl) NewClient(cfg *rest.Config) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	if err := seccompprofileapi.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("add seccomp profile API to scheme: %w", err)
+	}
+	return client.New(cfg, client.Options{Scheme: scheme})
+}
+
+func (*defaultImpl) CreateSeccompProfile(
+	ctx context.Context, c client.Client, profile *seccompprofileapi.SeccompProfile,
+) error {
+	return c.Create(ctx, profile)
+}
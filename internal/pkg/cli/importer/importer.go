@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+var (
+	gzipMagic        = []byte{0x1f, 0x8b}
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+)
+
+// Importer is the main structure of this package.
+type Importer struct {
+	impl
+	options *Options
+}
+
+// profileFile is a seccomp JSON profile found in the import path.
+type profileFile struct {
+	// path is the relative path of the profile within the import path.
+	path    string
+	content []byte
+}
+
+// New returns a new Importer instance.
+func New(options *Options) *Importer {
+	return &Importer{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Importer.
+func (i *Importer) Run() error {
+	files, err := i.readFiles()
+	if err != nil {
+		return fmt.Errorf("read profiles: %w", err)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no seccomp profiles found in %s", i.options.path)
+	}
+
+	// Convert all profiles upfront to not partially import an invalid path
+	profiles := make([]*seccompprofileapi.SeccompProfile, 0, len(files))
+	sources := map[string]string{}
+	for _, file := range files {
+		profile, err := i.profile(file)
+		if err != nil {
+			return fmt.Errorf("convert profile %s: %w", file.path, err)
+		}
+		if source, ok := sources[profile.Name]; ok {
+			return fmt.Errorf(
+				"profiles %s and %s result in the same name %s", source, file.path, profile.Name,
+			)
+		}
+		sources[profile.Name] = file.path
+		profiles = append(profiles, profile)
+	}
+
+	cfg, err := i.GetConfig()
+	if err != nil {
+		return fmt.Errorf("get kubeconfig: %w", err)
+	}
+
+	c, err := i.NewClient(cfg)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	ctx := context.Background()
+	created := 0
+	for _, profile := range profiles {
+		err := i.CreateSeccompProfile(ctx, c, profile)
+		if kerrors.IsAlreadyExists(err) {
+			log.Printf("Skipping profile %s/%s: already exists", profile.Namespace, profile.Name)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("create profile %s/%s: %w", profile.Namespace, profile.Name, err)
+		}
+		log.Printf(
+			"Created seccomp profile %s/%s from %s",
+			profile.Namespace, profile.Name, sources[profile.Name],
+		)
+		created++
+	}
+
+	log.Printf("Imported %d of %d profiles", created, len(profiles))
+	return nil
+}
+
+// readFiles returns the JSON profiles of either a directory or a tar
+// archive, which may be compressed using gzip.
+func (i *Importer) readFiles() ([]profileFile, error) {
+	info, err := i.Stat(i.options.path)
+	if err != nil {
+		return nil, fmt.Errorf("stat path: %w", err)
+	}
+
+	if info.IsDir() {
+		return i.readDir()
+	}
+	return i.readArchive()
+}
+
+func (i *Importer) readDir() ([]profileFile, error) {
+	root := i.options.path
+	log.Printf("Reading profiles from directory %s", root)
+
+	files := []profileFile{}
+	if err := i.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return fmt.Errorf("get relative path: %w", err)
+		}
+
+		if d.IsDir() {
+			if managedByOperator(rel) {
+				log.Printf("Skipping directory %s managed by the operator", path)
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if filepath.Ext(rel) != seccompprofileapi.ExtJSON {
+			return nil
+		}
+
+		content, err := i.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read file: %w", err)
+		}
+		files = append(files, profileFile{path: rel, content: content})
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("walk directory: %w", err)
+	}
+
+	return files, nil
+}
+
+func (i *Importer) readArchive() ([]profileFile, error) {
+	log.Printf("Reading profiles from archive %s", i.options.path)
+
+	f, err := i.Open(i.options.path)
+	if err != nil {
+		return nil, fmt.Errorf("open archive: %w", err)
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	var r io.Reader = br
+	if magic, err := br.Peek(len(gzipMagic)); err == nil && bytes.Equal(magic, gzipMagic) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("create gzip reader: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	files := []profileFile{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read archive: %w", err)
+		}
+
+		name := filepath.Clean(hdr.Name)
+		if hdr.Typeflag != tar.TypeReg ||
+			filepath.Ext(name) != seccompprofileapi.ExtJSON ||
+			managedByOperator(name) {
+			continue
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("read archive file %s: %w", name, err)
+		}
+		files = append(files, profileFile{path: name, content: content})
+	}
+
+	return files, nil
+}
+
+// profile converts a seccomp JSON profile into a SeccompProfile.
+func (i *Importer) profile(file profileFile) (*seccompprofileapi.SeccompProfile, error) {
+	spec := seccompprofileapi.SeccompProfileSpec{}
+	if err := json.Unmarshal(file.content, &spec); err != nil {
+		return nil, fmt.Errorf("unmarshal JSON profile: %w", err)
+	}
+	if spec.DefaultAction == "" {
+		return nil, errors.New("no default action set")
+	}
+
+	name, err := profileName(file.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: i.options.namespace,
+		},
+		Spec: spec,
+	}, nil
+}
+
+// profileName returns the name of the SeccompProfile for the relative path
+// of a JSON profile, for example "my-app-v1" for "my-app/v1.json".
+func profileName(path string) (string, error) {
+	name := strings.TrimSuffix(filepath.ToSlash(path), seccompprofileapi.ExtJSON)
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(name, "-.")
+
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", fmt.Errorf("invalid profile name %q: %s", name, strings.Join(errs, ", "))
+	}
+	return name, nil
+}
+
+// managedByOperator returns true if the relative path points into the folder
+// of the profiles installed by the operator, which must not be imported again.
+func managedByOperator(path string) bool {
+	first, _, _ := strings.Cut(filepath.ToSlash(path), "/")
+	return first == config.OperatorProfilesFolder
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/importer/importerfakes"
+)
+
+var errTest = errors.New("test")
+
+const (
+	testRoot    = "/var/lib/kubelet/seccomp"
+	testProfile = `{"defaultAction":"SCMP_ACT_ERRNO","architectures":["SCMP_ARCH_X86_64"],` +
+		`"syscalls":[{"names":["read","write"],"action":"SCMP_ACT_ALLOW"}]}`
+)
+
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"nginx.json":              {Data: []byte(testProfile)},
+		"apps/My_App.json":        {Data: []byte(testProfile)},
+		"README.md":               {Data: []byte("not a profile")},
+		"operator/ns/nginx.json":  {Data: []byte(testProfile)},
+		"operator/ns/other.json":  {Data: []byte(testProfile)},
+		"apps/nested/empty.jsonc": {Data: []byte("{}")},
+	}
+}
+
+func prepareDir(mock *importerfakes.FakeImpl, fsys fstest.MapFS) {
+	mock.StatStub = func(name string) (os.FileInfo, error) {
+		return fs.Stat(fsys, ".")
+	}
+	mock.WalkDirStub = func(root string, fn fs.WalkDirFunc) error {
+		return fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+			return fn(filepath.Join(root, path), d, err)
+		})
+	}
+	mock.ReadFileStub = func(name string) ([]byte, error) {
+		rel, err := filepath.Rel(testRoot, name)
+		if err != nil {
+			return nil, err
+		}
+		return fsys.ReadFile(rel)
+	}
+}
+
+func testArchive(t *testing.T, compress bool, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	var w io.Writer = &buf
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(&buf)
+		w = gz
+	}
+
+	tw := tar.NewWriter(w)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./apps/", Typeflag: tar.TypeDir, Mode: 0o755}))
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(content)),
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	if gz != nil {
+		require.NoError(t, gz.Close())
+	}
+	return buf.Bytes()
+}
+
+func prepareArchive(mock *importerfakes.FakeImpl, archive []byte) {
+	mock.StatStub = func(name string) (os.FileInfo, error) {
+		return fstest.MapFS{"profiles.tar": {}}.Stat("profiles.tar")
+	}
+	mock.OpenReturns(io.NopCloser(bytes.NewReader(archive)), nil)
+}
+
+func createdProfiles(mock *importerfakes.FakeImpl) map[string]*seccompprofileapi.SeccompProfile {
+	res := map[string]*seccompprofileapi.SeccompProfile{}
+	for i := 0; i < mock.CreateSeccompProfileCallCount(); i++ {
+		_, _, profile := mock.CreateSeccompProfileArgsForCall(i)
+		res[profile.Namespace+"/"+profile.Name] = profile
+	}
+	return res
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		prepare func(*testing.T, *importerfakes.FakeImpl)
+		assert  func(*importerfakes.FakeImpl, error)
+	}{
+		{
+			name: "success directory",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				prepareDir(mock, testFS())
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				profiles := createdProfiles(mock)
+				require.Len(t, profiles, 2)
+				require.Contains(t, profiles, "default/nginx")
+				require.Contains(t, profiles, "default/apps-my-app")
+
+				spec := profiles["default/nginx"].Spec
+				require.EqualValues(t, "SCMP_ACT_ERRNO", spec.DefaultAction)
+				require.Equal(t, []seccompprofileapi.Arch{"SCMP_ARCH_X86_64"}, spec.Architectures)
+				require.Len(t, spec.Syscalls, 1)
+				require.Equal(t, []string{"read", "write"}, spec.Syscalls[0].Names)
+			},
+		},
+		{
+			name: "success archive",
+			prepare: func(t *testing.T, mock *importerfakes.FakeImpl) {
+				prepareArchive(mock, testArchive(t, false, map[string]string{
+					"./apps/nginx.json":       testProfile,
+					"operator/ns/nginx.json":  testProfile,
+					"./apps/nginx.json.bak":   testProfile,
+					"./apps/runtime/go.json":  testProfile,
+					"./apps/runtime/go.yaml":  testProfile,
+					"./operator/ns/app2.json": testProfile,
+				}))
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				profiles := createdProfiles(mock)
+				require.Len(t, profiles, 2)
+				require.Contains(t, profiles, "default/apps-nginx")
+				require.Contains(t, profiles, "default/apps-runtime-go")
+			},
+		},
+		{
+			name: "success gzip compressed archive",
+			prepare: func(t *testing.T, mock *importerfakes.FakeImpl) {
+				prepareArchive(mock, testArchive(t, true, map[string]string{
+					"nginx.json": testProfile,
+				}))
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Contains(t, createdProfiles(mock), "default/nginx")
+			},
+		},
+		{
+			name: "success skip existing profiles",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				prepareDir(mock, testFS())
+				mock.CreateSeccompProfileReturnsOnCall(0, kerrors.NewAlreadyExists(
+					schema.GroupResource{Resource: "seccompprofiles"}, "apps-my-app",
+				))
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.CreateSeccompProfileCallCount())
+			},
+		},
+		{
+			name: "failure no profiles found",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				prepareDir(mock, fstest.MapFS{"README.md": {}})
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.GetConfigCallCount())
+			},
+		},
+		{
+			name: "failure invalid profile",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				fsys := testFS()
+				fsys["invalid.json"] = &fstest.MapFile{Data: []byte(`{"syscalls":[]}`)}
+				prepareDir(mock, fsys)
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.CreateSeccompProfileCallCount())
+			},
+		},
+		{
+			name: "failure duplicate profile names",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				fsys := testFS()
+				fsys["apps/my-app.json"] = &fstest.MapFile{Data: []byte(testProfile)}
+				prepareDir(mock, fsys)
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.CreateSeccompProfileCallCount())
+			},
+		},
+		{
+			name: "failure on Stat",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				mock.StatReturns(nil, errTest)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on ReadFile",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				prepareDir(mock, testFS())
+				mock.ReadFileStub = nil
+				mock.ReadFileReturns(nil, errTest)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on Open",
+			prepare: func(t *testing.T, mock *importerfakes.FakeImpl) {
+				prepareArchive(mock, nil)
+				mock.OpenReturns(nil, errTest)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on GetConfig",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				prepareDir(mock, testFS())
+				mock.GetConfigReturns(nil, errTest)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on NewClient",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				prepareDir(mock, testFS())
+				mock.NewClientReturns(nil, errTest)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on CreateSeccompProfile",
+			prepare: func(_ *testing.T, mock *importerfakes.FakeImpl) {
+				prepareDir(mock, testFS())
+				mock.CreateSeccompProfileReturns(errTest)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &importerfakes.FakeImpl{}
+			prepare(t, mock)
+
+			options := Default()
+			options.path = testRoot
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
+
+func TestProfileName(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		path, expected string
+		shouldErr      bool
+	}{
+		{path: "nginx.json", expected: "nginx"},
+		{path: "apps/My_App.v1.json", expected: "apps-my-app.v1"},
+		{path: "_leading.json", expected: "leading"},
+		{path: "___.json", shouldErr: true},
+	} {
+		path := tc.path
+		expected := tc.expected
+		shouldErr := tc.shouldErr
+
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+
+			name, err := profileName(path)
+			if shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expected, name)
+		})
+	}
+}
@@ -0,0 +1,596 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by counterfeiter. DO NOT EDIT.
+package importerfakes
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"sync"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+type FakeImpl struct {
+	CreateSeccompProfileStub        func(context.Context, client.Client, *v1beta1.SeccompProfile) error
+	createSeccompProfileMutex       sync.RWMutex
+	createSeccompProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1beta1.SeccompProfile
+	}
+	createSeccompProfileReturns struct {
+		result1 error
+	}
+	createSeccompProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetConfigStub        func() (*rest.Config, error)
+	getConfigMutex       sync.RWMutex
+	getConfigArgsForCall []struct {
+	}
+	getConfigReturns struct {
+		result1 *rest.Config
+		result2 error
+	}
+	getConfigReturnsOnCall map[int]struct {
+		result1 *rest.Config
+		result2 error
+	}
+	NewClientStub        func(*rest.Config) (client.Client, error)
+	newClientMutex       sync.RWMutex
+	newClientArgsForCall []struct {
+		arg1 *rest.Config
+	}
+	newClientReturns struct {
+		result1 client.Client
+		result2 error
+	}
+	newClientReturnsOnCall map[int]struct {
+		result1 client.Client
+		result2 error
+	}
+	OpenStub        func(string) (io.ReadCloser, error)
+	openMutex       sync.RWMutex
+	openArgsForCall []struct {
+		arg1 string
+	}
+	openReturns struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	openReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	ReadFileStub        func(string) ([]byte, error)
+	readFileMutex       sync.RWMutex
+	readFileArgsForCall []struct {
+		arg1 string
+	}
+	readFileReturns struct {
+		result1 []byte
+		result2 error
+	}
+	readFileReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	StatStub        func(string) (fs.FileInfo, error)
+	statMutex       sync.RWMutex
+	statArgsForCall []struct {
+		arg1 string
+	}
+	statReturns struct {
+		result1 fs.FileInfo
+		result2 error
+	}
+	statReturnsOnCall map[int]struct {
+		result1 fs.FileInfo
+		result2 error
+	}
+	WalkDirStub        func(string, fs.WalkDirFunc) error
+	walkDirMutex       sync.RWMutex
+	walkDirArgsForCall []struct {
+		arg1 string
+		arg2 fs.WalkDirFunc
+	}
+	walkDirReturns struct {
+		result1 error
+	}
+	walkDirReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImpl) CreateSeccompProfile(arg1 context.Context, arg2 client.Client, arg3 *v1beta1.SeccompProfile) error {
+	fake.createSeccompProfileMutex.Lock()
+	ret, specificReturn := fake.createSeccompProfileReturnsOnCall[len(fake.createSeccompProfileArgsForCall)]
+	fake.createSeccompProfileArgsForCall = append(fake.createSeccompProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1beta1.SeccompProfile
+	}{arg1, arg2, arg3})
+	stub := fake.CreateSeccompProfileStub
+	fakeReturns := fake.createSeccompProfileReturns
+	fake.recordInvocation("CreateSeccompProfile", []interface{}{arg1, arg2, arg3})
+	fake.createSeccompProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) CreateSeccompProfileCallCount() int {
+	fake.createSeccompProfileMutex.RLock()
+	defer fake.createSeccompProfileMutex.RUnlock()
+	return len(fake.createSeccompProfileArgsForCall)
+}
+
+func (fake *FakeImpl) CreateSeccompProfileCalls(stub func(context.Context, client.Client, *v1beta1.SeccompProfile) error) {
+	fake.createSeccompProfileMutex.Lock()
+	defer fake.createSeccompProfileMutex.Unlock()
+	fake.CreateSeccompProfileStub = stub
+}
+
+func (fake *FakeImpl) CreateSeccompProfileArgsForCall(i int) (context.Context, client.Client, *v1beta1.SeccompProfile) {
+	fake.createSeccompProfileMutex.RLock()
+	defer fake.createSeccompProfileMutex.RUnlock()
+	argsForCall := fake.createSeccompProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) CreateSeccompProfileReturns(result1 error) {
+	fake.createSeccompProfileMutex.Lock()
+	defer fake.createSeccompProfileMutex.Unlock()
+	fake.CreateSeccompProfileStub = nil
+	fake.createSeccompProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) CreateSeccompProfileReturnsOnCall(i int, result1 error) {
+	fake.createSeccompProfileMutex.Lock()
+	defer fake.createSeccompProfileMutex.Unlock()
+	fake.CreateSeccompProfileStub = nil
+	if fake.createSeccompProfileReturnsOnCall == nil {
+		fake.createSeccompProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createSeccompProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) GetConfig() (*rest.Config, error) {
+	fake.getConfigMutex.Lock()
+	ret, specificReturn := fake.getConfigReturnsOnCall[len(fake.getConfigArgsForCall)]
+	fake.getConfigArgsForCall = append(fake.getConfigArgsForCall, struct {
+	}{})
+	stub := fake.GetConfigStub
+	fakeReturns := fake.getConfigReturns
+	fake.recordInvocation("GetConfig", []interface{}{})
+	fake.getConfigMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetConfigCallCount() int {
+	fake.getConfigMutex.RLock()
+	defer fake.getConfigMutex.RUnlock()
+	return len(fake.getConfigArgsForCall)
+}
+
+func (fake *FakeImpl) GetConfigCalls(stub func() (*rest.Config, error)) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = stub
+}
+
+func (fake *FakeImpl) GetConfigReturns(result1 *rest.Config, result2 error) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = nil
+	fake.getConfigReturns = struct {
+		result1 *rest.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetConfigReturnsOnCall(i int, result1 *rest.Config, result2 error) {
+	fake.getConfigMutex.Lock()
+	defer fake.getConfigMutex.Unlock()
+	fake.GetConfigStub = nil
+	if fake.getConfigReturnsOnCall == nil {
+		fake.getConfigReturnsOnCall = make(map[int]struct {
+			result1 *rest.Config
+			result2 error
+		})
+	}
+	fake.getConfigReturnsOnCall[i] = struct {
+		result1 *rest.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClient(arg1 *rest.Config) (client.Client, error) {
+	fake.newClientMutex.Lock()
+	ret, specificReturn := fake.newClientReturnsOnCall[len(fake.newClientArgsForCall)]
+	fake.newClientArgsForCall = append(fake.newClientArgsForCall, struct {
+		arg1 *rest.Config
+	}{arg1})
+	stub := fake.NewClientStub
+	fakeReturns := fake.newClientReturns
+	fake.recordInvocation("NewClient", []interface{}{arg1})
+	fake.newClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) NewClientCallCount() int {
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	return len(fake.newClientArgsForCall)
+}
+
+func (fake *FakeImpl) NewClientCalls(stub func(*rest.Config) (client.Client, error)) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = stub
+}
+
+func (fake *FakeImpl) NewClientArgsForCall(i int) *rest.Config {
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	argsForCall := fake.newClientArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) NewClientReturns(result1 client.Client, result2 error) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = nil
+	fake.newClientReturns = struct {
+		result1 client.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewClientReturnsOnCall(i int, result1 client.Client, result2 error) {
+	fake.newClientMutex.Lock()
+	defer fake.newClientMutex.Unlock()
+	fake.NewClientStub = nil
+	if fake.newClientReturnsOnCall == nil {
+		fake.newClientReturnsOnCall = make(map[int]struct {
+			result1 client.Client
+			result2 error
+		})
+	}
+	fake.newClientReturnsOnCall[i] = struct {
+		result1 client.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) Open(arg1 string) (io.ReadCloser, error) {
+	fake.openMutex.Lock()
+	ret, specificReturn := fake.openReturnsOnCall[len(fake.openArgsForCall)]
+	fake.openArgsForCall = append(fake.openArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.OpenStub
+	fakeReturns := fake.openReturns
+	fake.recordInvocation("Open", []interface{}{arg1})
+	fake.openMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) OpenCallCount() int {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return len(fake.openArgsForCall)
+}
+
+func (fake *FakeImpl) OpenCalls(stub func(string) (io.ReadCloser, error)) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = stub
+}
+
+func (fake *FakeImpl) OpenArgsForCall(i int) string {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	argsForCall := fake.openArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) OpenReturns(result1 io.ReadCloser, result2 error) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = nil
+	fake.openReturns = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) OpenReturnsOnCall(i int, result1 io.ReadCloser, result2 error) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = nil
+	if fake.openReturnsOnCall == nil {
+		fake.openReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 error
+		})
+	}
+	fake.openReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ReadFile(arg1 string) ([]byte, error) {
+	fake.readFileMutex.Lock()
+	ret, specificReturn := fake.readFileReturnsOnCall[len(fake.readFileArgsForCall)]
+	fake.readFileArgsForCall = append(fake.readFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadFileStub
+	fakeReturns := fake.readFileReturns
+	fake.recordInvocation("ReadFile", []interface{}{arg1})
+	fake.readFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ReadFileCallCount() int {
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
+	return len(fake.readFileArgsForCall)
+}
+
+func (fake *FakeImpl) ReadFileCalls(stub func(string) ([]byte, error)) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = stub
+}
+
+func (fake *FakeImpl) ReadFileArgsForCall(i int) string {
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
+	argsForCall := fake.readFileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ReadFileReturns(result1 []byte, result2 error) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = nil
+	fake.readFileReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ReadFileReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = nil
+	if fake.readFileReturnsOnCall == nil {
+		fake.readFileReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.readFileReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) Stat(arg1 string) (fs.FileInfo, error) {
+	fake.statMutex.Lock()
+	ret, specificReturn := fake.statReturnsOnCall[len(fake.statArgsForCall)]
+	fake.statArgsForCall = append(fake.statArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.StatStub
+	fakeReturns := fake.statReturns
+	fake.recordInvocation("Stat", []interface{}{arg1})
+	fake.statMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) StatCallCount() int {
+	fake.statMutex.RLock()
+	defer fake.statMutex.RUnlock()
+	return len(fake.statArgsForCall)
+}
+
+func (fake *FakeImpl) StatCalls(stub func(string) (fs.FileInfo, error)) {
+	fake.statMutex.Lock()
+	defer fake.statMutex.Unlock()
+	fake.StatStub = stub
+}
+
+func (fake *FakeImpl) StatArgsForCall(i int) string {
+	fake.statMutex.RLock()
+	defer fake.statMutex.RUnlock()
+	argsForCall := fake.statArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) StatReturns(result1 fs.FileInfo, result2 error) {
+	fake.statMutex.Lock()
+	defer fake.statMutex.Unlock()
+	fake.StatStub = nil
+	fake.statReturns = struct {
+		result1 fs.FileInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) StatReturnsOnCall(i int, result1 fs.FileInfo, result2 error) {
+	fake.statMutex.Lock()
+	defer fake.statMutex.Unlock()
+	fake.StatStub = nil
+	if fake.statReturnsOnCall == nil {
+		fake.statReturnsOnCall = make(map[int]struct {
+			result1 fs.FileInfo
+			result2 error
+		})
+	}
+	fake.statReturnsOnCall[i] = struct {
+		result1 fs.FileInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) WalkDir(arg1 string, arg2 fs.WalkDirFunc) error {
+	fake.walkDirMutex.Lock()
+	ret, specificReturn := fake.walkDirReturnsOnCall[len(fake.walkDirArgsForCall)]
+	fake.walkDirArgsForCall = append(fake.walkDirArgsForCall, struct {
+		arg1 string
+		arg2 fs.WalkDirFunc
+	}{arg1, arg2})
+	stub := fake.WalkDirStub
+	fakeReturns := fake.walkDirReturns
+	fake.recordInvocation("WalkDir", []interface{}{arg1, arg2})
+	fake.walkDirMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) WalkDirCallCount() int {
+	fake.walkDirMutex.RLock()
+	defer fake.walkDirMutex.RUnlock()
+	return len(fake.walkDirArgsForCall)
+}
+
+func (fake *FakeImpl) WalkDirCalls(stub func(string, fs.WalkDirFunc) error) {
+	fake.walkDirMutex.Lock()
+	defer fake.walkDirMutex.Unlock()
+	fake.WalkDirStub = stub
+}
+
+func (fake *FakeImpl) WalkDirArgsForCall(i int) (string, fs.WalkDirFunc) {
+	fake.walkDirMutex.RLock()
+	defer fake.walkDirMutex.RUnlock()
+	argsForCall := fake.walkDirArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) WalkDirReturns(result1 error) {
+	fake.walkDirMutex.Lock()
+	defer fake.walkDirMutex.Unlock()
+	fake.WalkDirStub = nil
+	fake.walkDirReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) WalkDirReturnsOnCall(i int, result1 error) {
+	fake.walkDirMutex.Lock()
+	defer fake.walkDirMutex.Unlock()
+	fake.WalkDirStub = nil
+	if fake.walkDirReturnsOnCall == nil {
+		fake.walkDirReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.walkDirReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createSeccompProfileMutex.RLock()
+	defer fake.createSeccompProfileMutex.RUnlock()
+	fake.getConfigMutex.RLock()
+	defer fake.getConfigMutex.RUnlock()
+	fake.newClientMutex.RLock()
+	defer fake.newClientMutex.RUnlock()
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
+	fake.statMutex.RLock()
+	defer fake.statMutex.RUnlock()
+	fake.walkDirMutex.RLock()
+	defer fake.walkDirMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImpl) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/seccomplint"
+)
+
+// ErrDenied is returned if any profile violates a lint rule with the deny
+// severity.
+var ErrDenied = errors.New("profiles violate lint rules")
+
+// Linter is the main structure of this package.
+type Linter struct {
+	impl
+	options *Options
+}
+
+// New returns a new Linter instance.
+func New(options *Options) *Linter {
+	return &Linter{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Linter.
+func (l *Linter) Run() error {
+	denied := 0
+	for _, name := range l.options.profiles {
+		profile, err := cli.ReadSeccompProfile(l, name)
+		if err != nil {
+			return fmt.Errorf("read profile %s: %w", name, err)
+		}
+
+		findings := seccomplint.Lint(&profile.Spec, l.options.severities)
+		for i := range findings {
+			log.Printf("%s: %s %s", name, findings[i].Severity, findings[i].String())
+		}
+		if seccomplint.Denied(findings) {
+			denied++
+		}
+	}
+
+	if denied > 0 {
+		return fmt.Errorf("%w: %d of %d", ErrDenied, denied, len(l.options.profiles))
+	}
+	return nil
+}
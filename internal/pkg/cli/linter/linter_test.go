@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/linter/linterfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/seccomplint"
+)
+
+var errTest = errors.New("test")
+
+const (
+	testProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+metadata:
+  name: my-app
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  architectures:
+  - SCMP_ARCH_X86_64
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names:
+    - ptrace
+    - read
+    - write
+`
+	testJSONProfile = `{
+  "defaultAction": "SCMP_ACT_ALLOW",
+  "architectures": ["SCMP_ARCH_X86_64"]
+}`
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name       string
+		configured map[string]spodv1alpha1.SeccompLintSeverity
+		prepare    func(mock *linterfakes.FakeImpl)
+		assert     func(err error)
+	}{
+		{
+			name: "success with warnings",
+			prepare: func(mock *linterfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testJSONProfile), nil)
+			},
+			assert: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "failure denied",
+			configured: map[string]spodv1alpha1.SeccompLintSeverity{
+				"default-allow": spodv1alpha1.SeccompLintSeverityDeny,
+			},
+			prepare: func(mock *linterfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testJSONProfile), nil)
+			},
+			assert: func(err error) {
+				require.ErrorIs(t, err, ErrDenied)
+			},
+		},
+		{
+			name: "failure on ReadFile",
+			prepare: func(mock *linterfakes.FakeImpl) {
+				mock.ReadFileReturns(nil, errTest)
+			},
+			assert: func(err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on YamlUnmarshal",
+			prepare: func(mock *linterfakes.FakeImpl) {
+				mock.YamlUnmarshalReturns(errTest)
+			},
+			assert: func(err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on JSONUnmarshal",
+			prepare: func(mock *linterfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.JSONUnmarshalReturns(errTest)
+			},
+			assert: func(err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		configured := tc.configured
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &linterfakes.FakeImpl{}
+			mock.YamlUnmarshalStub = func(y []byte, o interface{}) error {
+				return yaml.Unmarshal(y, o)
+			}
+			mock.JSONUnmarshalStub = json.Unmarshal
+			prepare(mock)
+
+			severities, _ := seccomplint.NewSeverities(configured)
+			sut := New(&Options{
+				profiles:   []string{"profile.yaml", "profile.json"},
+				severities: severities,
+			})
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(err)
+		})
+	}
+}
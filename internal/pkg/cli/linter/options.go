@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	ucli "github.com/urfave/cli/v2"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/seccomplint"
+)
+
+// Options define all possible options for the linter.
+type Options struct {
+	profiles   []string
+	severities seccomplint.Severities
+}
+
+// Default returns a default options instance.
+func Default() *Options {
+	severities, _ := seccomplint.NewSeverities(nil)
+	return &Options{
+		severities: severities,
+	}
+}
+
+// FromContext can be used to create Options from an CLI context.
+func FromContext(ctx *ucli.Context) (*Options, error) {
+	options := Default()
+
+	options.profiles = ctx.Args().Slice()
+	if len(options.profiles) == 0 {
+		return nil, errors.New("no profile provided")
+	}
+
+	configured := map[string]spodv1alpha1.SeccompLintSeverity{}
+	for _, r := range ctx.StringSlice(FlagRules) {
+		rule, severity, ok := strings.Cut(r, ":")
+		if !ok {
+			return nil, fmt.Errorf("wrong rule format: %s", r)
+		}
+		parsed, err := parseSeverity(severity)
+		if err != nil {
+			return nil, err
+		}
+		configured[rule] = parsed
+	}
+
+	severities, unknown := seccomplint.NewSeverities(configured)
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown rules %v, supported rules are %v", unknown, seccomplint.Rules)
+	}
+	options.severities = severities
+
+	return options, nil
+}
+
+func parseSeverity(severity string) (spodv1alpha1.SeccompLintSeverity, error) {
+	for _, s := range []spodv1alpha1.SeccompLintSeverity{
+		spodv1alpha1.SeccompLintSeverityOff,
+		spodv1alpha1.SeccompLintSeverityWarn,
+		spodv1alpha1.SeccompLintSeverityDeny,
+	} {
+		if strings.EqualFold(severity, string(s)) {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported severity: %s", severity)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/seccomplint"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		prepare func(*flag.FlagSet)
+		assert  func(*Options, error)
+	}{
+		{
+			name: "success",
+			prepare: func(set *flag.FlagSet) {
+				set.Var(cli.NewStringSlice(), FlagRules, "")
+				require.Nil(t, set.Set(FlagRules, "dangerous-syscalls:deny"))
+				require.Nil(t, set.Set(FlagRules, "architectures:Off"))
+				require.Nil(t, set.Parse([]string{"profile.yaml", "profile.json"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"profile.yaml", "profile.json"}, opts.profiles)
+				require.Equal(t, seccomplint.Severities{
+					seccomplint.RuleArchitectures:     spodv1alpha1.SeccompLintSeverityOff,
+					seccomplint.RuleDangerousSyscalls: spodv1alpha1.SeccompLintSeverityDeny,
+					seccomplint.RuleDefaultAllow:      spodv1alpha1.SeccompLintSeverityWarn,
+				}, opts.severities)
+			},
+		},
+		{
+			name: "failure no profile provided",
+			prepare: func(set *flag.FlagSet) {
+				require.Nil(t, set.Parse([]string{}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure wrong rule format",
+			prepare: func(set *flag.FlagSet) {
+				set.Var(cli.NewStringSlice(), FlagRules, "")
+				require.Nil(t, set.Set(FlagRules, "dangerous-syscalls"))
+				require.Nil(t, set.Parse([]string{"profile.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure unsupported severity",
+			prepare: func(set *flag.FlagSet) {
+				set.Var(cli.NewStringSlice(), FlagRules, "")
+				require.Nil(t, set.Set(FlagRules, "dangerous-syscalls:error"))
+				require.Nil(t, set.Parse([]string{"profile.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure unknown rule",
+			prepare: func(set *flag.FlagSet) {
+				set.Var(cli.NewStringSlice(), FlagRules, "")
+				require.Nil(t, set.Set(FlagRules, "unknown:deny"))
+				require.Nil(t, set.Parse([]string{"profile.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			set := flag.NewFlagSet("", flag.ExitOnError)
+			prepare(set)
+
+			app := cli.NewApp()
+			ctx := cli.NewContext(app, set, nil)
+
+			opts, err := FromContext(ctx)
+			assert(opts, err)
+		})
+	}
+}
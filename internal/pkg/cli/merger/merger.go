@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package merger
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/recordingmerger"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// Merger is the main structure of this package.
+type Merger struct {
+	impl
+	options *Options
+}
+
+// New returns a new Merger instance.
+func New(options *Options) *Merger {
+	return &Merger{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Merger.
+func (m *Merger) Run() error {
+	profiles := make([]*seccompprofileapi.SeccompProfile, 0, len(m.options.profiles))
+	for _, name := range m.options.profiles {
+		log.Printf("Reading file %s", name)
+		profile, err := cli.ReadSeccompProfile(m, name)
+		if err != nil {
+			return fmt.Errorf("read profile: %w", err)
+		}
+		profiles = append(profiles, profile)
+	}
+
+	if err := checkConflicts(profiles); err != nil {
+		return err
+	}
+
+	profile := profiles[0]
+	if m.options.intersect {
+		for _, other := range profiles[1:] {
+			profile.Spec.Syscalls = util.IntersectSyscalls(profile.Spec.Syscalls, other.Spec.Syscalls)
+			profile.Spec.Architectures = intersectArchitectures(profile.Spec.Architectures, other.Spec.Architectures)
+		}
+	} else {
+		merged, err := recordingmerger.MergeSeccompProfiles(profiles)
+		if err != nil {
+			return fmt.Errorf("merge profiles: %w", err)
+		}
+		profile = merged
+	}
+
+	log.Printf(
+		"Merged %d profiles into %d syscalls", len(m.options.profiles), cli.CountSyscalls(profile.Spec.Syscalls),
+	)
+
+	profile.TypeMeta = metav1.TypeMeta{
+		Kind:       "SeccompProfile",
+		APIVersion: seccompprofileapi.GroupVersion.String(),
+	}
+
+	data, err := m.YamlMarshal(profile)
+	if err != nil {
+		return fmt.Errorf("marshal YAML profile: %w", err)
+	}
+
+	log.Printf("Saving profile in: %s", m.options.outputFile)
+	const defaultFileMode = os.FileMode(0o644)
+	if err := m.WriteFile(m.options.outputFile, data, defaultFileMode); err != nil {
+		return fmt.Errorf("save profile: %w", err)
+	}
+
+	return nil
+}
+
+// checkConflicts fails if the profiles use different default actions, because
+// merging their rules would change the meaning of them. Different
+// architectures are only reported, since they get combined.
+func checkConflicts(profiles []*seccompprofileapi.SeccompProfile) error {
+	base := profiles[0]
+	for _, other := range profiles[1:] {
+		if other.Spec.DefaultAction != base.Spec.DefaultAction {
+			return fmt.Errorf(
+				"profile %s uses default action %s instead of %s: %w",
+				other.Name, other.Spec.DefaultAction, base.Spec.DefaultAction,
+				recordingmerger.ErrConflictingDefaultAction,
+			)
+		}
+		if !slices.Equal(other.Spec.Architectures, base.Spec.Architectures) {
+			log.Printf(
+				"Warning: profile %s uses architectures %v instead of %v",
+				other.Name, other.Spec.Architectures, base.Spec.Architectures,
+			)
+		}
+	}
+	return nil
+}
+
+func intersectArchitectures(arches, otherArches []seccompprofileapi.Arch) []seccompprofileapi.Arch {
+	res := []seccompprofileapi.Arch{}
+	for _, arch := range arches {
+		if slices.Contains(otherArches, arch) {
+			res = append(res, arch)
+		}
+	}
+	return res
+}
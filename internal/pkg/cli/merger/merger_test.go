@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package merger
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/merger/mergerfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/recordingmerger"
+)
+
+var errTest = errors.New("test")
+
+const (
+	testProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+metadata:
+  name: my-app
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names:
+    - ptrace
+    - read
+    - write
+`
+	testOtherProfile = `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "syscalls": [{"action": "SCMP_ACT_ALLOW", "names": ["read", "write", "close"]}]
+}`
+	testOtherArchProfile = `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "architectures": ["SCMP_ARCH_AARCH64"],
+  "syscalls": [{"action": "SCMP_ACT_ALLOW", "names": ["read"]}]
+}`
+	testOtherActionProfile = `{
+  "defaultAction": "SCMP_ACT_LOG",
+  "syscalls": [{"action": "SCMP_ACT_ALLOW", "names": ["read"]}]
+}`
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name      string
+		intersect bool
+		prepare   func(mock *mergerfakes.FakeImpl)
+		assert    func(mock *mergerfakes.FakeImpl, err error)
+	}{
+		{
+			name: "success union",
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testOtherProfile), nil)
+			},
+			assert: func(mock *mergerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				profile := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, yaml.Unmarshal(data, profile))
+				require.Equal(t, "my-app", profile.Name)
+				require.Equal(t, "SeccompProfile", profile.Kind)
+				require.Len(t, profile.Spec.Syscalls, 2)
+				require.Equal(t, []string{"ptrace", "read", "write"}, profile.Spec.Syscalls[0].Names)
+				require.Equal(t, []string{"close", "read", "write"}, profile.Spec.Syscalls[1].Names)
+			},
+		},
+		{
+			name: "success union of architectures",
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testOtherArchProfile), nil)
+			},
+			assert: func(mock *mergerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				profile := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, yaml.Unmarshal(data, profile))
+				require.Equal(t, []seccompprofileapi.Arch{"SCMP_ARCH_AARCH64"}, profile.Spec.Architectures)
+			},
+		},
+		{
+			name:      "success intersection of architectures",
+			intersect: true,
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testOtherArchProfile), nil)
+			},
+			assert: func(mock *mergerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				profile := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, yaml.Unmarshal(data, profile))
+				require.Empty(t, profile.Spec.Architectures)
+			},
+		},
+		{
+			name: "failure on conflicting default action",
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testOtherActionProfile), nil)
+			},
+			assert: func(mock *mergerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, recordingmerger.ErrConflictingDefaultAction)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name:      "success intersection",
+			intersect: true,
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testOtherProfile), nil)
+			},
+			assert: func(mock *mergerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				profile := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, yaml.Unmarshal(data, profile))
+				require.Len(t, profile.Spec.Syscalls, 1)
+				require.Equal(t, []string{"read", "write"}, profile.Spec.Syscalls[0].Names)
+			},
+		},
+		{
+			name: "failure on ReadFile",
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, nil, errTest)
+			},
+			assert: func(_ *mergerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on YamlUnmarshal",
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.YamlUnmarshalReturns(errTest)
+			},
+			assert: func(_ *mergerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on JSONUnmarshal",
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.JSONUnmarshalReturns(errTest)
+			},
+			assert: func(_ *mergerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on YamlMarshal",
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testOtherProfile), nil)
+				mock.YamlMarshalReturns(nil, errTest)
+			},
+			assert: func(_ *mergerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on WriteFile",
+			prepare: func(mock *mergerfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testOtherProfile), nil)
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(_ *mergerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		intersect := tc.intersect
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &mergerfakes.FakeImpl{}
+			mock.YamlUnmarshalStub = func(y []byte, o interface{}) error {
+				return yaml.Unmarshal(y, o)
+			}
+			mock.YamlMarshalStub = yaml.Marshal
+			mock.JSONUnmarshalStub = json.Unmarshal
+			prepare(mock)
+
+			sut := New(&Options{
+				profiles:   []string{"profile.yaml", "other.json"},
+				intersect:  intersect,
+				outputFile: DefaultOutputFile,
+			})
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
@@ -0,0 +1,453 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by counterfeiter. DO NOT EDIT.
+package mergerfakes
+
+import (
+	"io/fs"
+	"sync"
+)
+
+type FakeImpl struct {
+	JSONUnmarshalStub        func([]byte, any) error
+	jSONUnmarshalMutex       sync.RWMutex
+	jSONUnmarshalArgsForCall []struct {
+		arg1 []byte
+		arg2 any
+	}
+	jSONUnmarshalReturns struct {
+		result1 error
+	}
+	jSONUnmarshalReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReadFileStub        func(string) ([]byte, error)
+	readFileMutex       sync.RWMutex
+	readFileArgsForCall []struct {
+		arg1 string
+	}
+	readFileReturns struct {
+		result1 []byte
+		result2 error
+	}
+	readFileReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	WriteFileStub        func(string, []byte, fs.FileMode) error
+	writeFileMutex       sync.RWMutex
+	writeFileArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 fs.FileMode
+	}
+	writeFileReturns struct {
+		result1 error
+	}
+	writeFileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	YamlMarshalStub        func(interface{}) ([]byte, error)
+	yamlMarshalMutex       sync.RWMutex
+	yamlMarshalArgsForCall []struct {
+		arg1 interface{}
+	}
+	yamlMarshalReturns struct {
+		result1 []byte
+		result2 error
+	}
+	yamlMarshalReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	YamlUnmarshalStub        func([]byte, interface{}) error
+	yamlUnmarshalMutex       sync.RWMutex
+	yamlUnmarshalArgsForCall []struct {
+		arg1 []byte
+		arg2 interface{}
+	}
+	yamlUnmarshalReturns struct {
+		result1 error
+	}
+	yamlUnmarshalReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImpl) JSONUnmarshal(arg1 []byte, arg2 any) error {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.jSONUnmarshalMutex.Lock()
+	ret, specificReturn := fake.jSONUnmarshalReturnsOnCall[len(fake.jSONUnmarshalArgsForCall)]
+	fake.jSONUnmarshalArgsForCall = append(fake.jSONUnmarshalArgsForCall, struct {
+		arg1 []byte
+		arg2 any
+	}{arg1Copy, arg2})
+	stub := fake.JSONUnmarshalStub
+	fakeReturns := fake.jSONUnmarshalReturns
+	fake.recordInvocation("JSONUnmarshal", []interface{}{arg1Copy, arg2})
+	fake.jSONUnmarshalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) JSONUnmarshalCallCount() int {
+	fake.jSONUnmarshalMutex.RLock()
+	defer fake.jSONUnmarshalMutex.RUnlock()
+	return len(fake.jSONUnmarshalArgsForCall)
+}
+
+func (fake *FakeImpl) JSONUnmarshalCalls(stub func([]byte, any) error) {
+	fake.jSONUnmarshalMutex.Lock()
+	defer fake.jSONUnmarshalMutex.Unlock()
+	fake.JSONUnmarshalStub = stub
+}
+
+func (fake *FakeImpl) JSONUnmarshalArgsForCall(i int) ([]byte, any) {
+	fake.jSONUnmarshalMutex.RLock()
+	defer fake.jSONUnmarshalMutex.RUnlock()
+	argsForCall := fake.jSONUnmarshalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) JSONUnmarshalReturns(result1 error) {
+	fake.jSONUnmarshalMutex.Lock()
+	defer fake.jSONUnmarshalMutex.Unlock()
+	fake.JSONUnmarshalStub = nil
+	fake.jSONUnmarshalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) JSONUnmarshalReturnsOnCall(i int, result1 error) {
+	fake.jSONUnmarshalMutex.Lock()
+	defer fake.jSONUnmarshalMutex.Unlock()
+	fake.JSONUnmarshalStub = nil
+	if fake.jSONUnmarshalReturnsOnCall == nil {
+		fake.jSONUnmarshalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.jSONUnmarshalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ReadFile(arg1 string) ([]byte, error) {
+	fake.readFileMutex.Lock()
+	ret, specificReturn := fake.readFileReturnsOnCall[len(fake.readFileArgsForCall)]
+	fake.readFileArgsForCall = append(fake.readFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadFileStub
+	fakeReturns := fake.readFileReturns
+	fake.recordInvocation("ReadFile", []interface{}{arg1})
+	fake.readFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ReadFileCallCount() int {
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
+	return len(fake.readFileArgsForCall)
+}
+
+func (fake *FakeImpl) ReadFileCalls(stub func(string) ([]byte, error)) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = stub
+}
+
+func (fake *FakeImpl) ReadFileArgsForCall(i int) string {
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
+	argsForCall := fake.readFileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ReadFileReturns(result1 []byte, result2 error) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = nil
+	fake.readFileReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ReadFileReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = nil
+	if fake.readFileReturnsOnCall == nil {
+		fake.readFileReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.readFileReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) WriteFile(arg1 string, arg2 []byte, arg3 fs.FileMode) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeFileMutex.Lock()
+	ret, specificReturn := fake.writeFileReturnsOnCall[len(fake.writeFileArgsForCall)]
+	fake.writeFileArgsForCall = append(fake.writeFileArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 fs.FileMode
+	}{arg1, arg2Copy, arg3})
+	stub := fake.WriteFileStub
+	fakeReturns := fake.writeFileReturns
+	fake.recordInvocation("WriteFile", []interface{}{arg1, arg2Copy, arg3})
+	fake.writeFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) WriteFileCallCount() int {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	return len(fake.writeFileArgsForCall)
+}
+
+func (fake *FakeImpl) WriteFileCalls(stub func(string, []byte, fs.FileMode) error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = stub
+}
+
+func (fake *FakeImpl) WriteFileArgsForCall(i int) (string, []byte, fs.FileMode) {
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	argsForCall := fake.writeFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) WriteFileReturns(result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	fake.writeFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) WriteFileReturnsOnCall(i int, result1 error) {
+	fake.writeFileMutex.Lock()
+	defer fake.writeFileMutex.Unlock()
+	fake.WriteFileStub = nil
+	if fake.writeFileReturnsOnCall == nil {
+		fake.writeFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) YamlMarshal(arg1 interface{}) ([]byte, error) {
+	fake.yamlMarshalMutex.Lock()
+	ret, specificReturn := fake.yamlMarshalReturnsOnCall[len(fake.yamlMarshalArgsForCall)]
+	fake.yamlMarshalArgsForCall = append(fake.yamlMarshalArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.YamlMarshalStub
+	fakeReturns := fake.yamlMarshalReturns
+	fake.recordInvocation("YamlMarshal", []interface{}{arg1})
+	fake.yamlMarshalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) YamlMarshalCallCount() int {
+	fake.yamlMarshalMutex.RLock()
+	defer fake.yamlMarshalMutex.RUnlock()
+	return len(fake.yamlMarshalArgsForCall)
+}
+
+func (fake *FakeImpl) YamlMarshalCalls(stub func(interface{}) ([]byte, error)) {
+	fake.yamlMarshalMutex.Lock()
+	defer fake.yamlMarshalMutex.Unlock()
+	fake.YamlMarshalStub = stub
+}
+
+func (fake *FakeImpl) YamlMarshalArgsForCall(i int) interface{} {
+	fake.yamlMarshalMutex.RLock()
+	defer fake.yamlMarshalMutex.RUnlock()
+	argsForCall := fake.yamlMarshalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) YamlMarshalReturns(result1 []byte, result2 error) {
+	fake.yamlMarshalMutex.Lock()
+	defer fake.yamlMarshalMutex.Unlock()
+	fake.YamlMarshalStub = nil
+	fake.yamlMarshalReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) YamlMarshalReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.yamlMarshalMutex.Lock()
+	defer fake.yamlMarshalMutex.Unlock()
+	fake.YamlMarshalStub = nil
+	if fake.yamlMarshalReturnsOnCall == nil {
+		fake.yamlMarshalReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.yamlMarshalReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) YamlUnmarshal(arg1 []byte, arg2 interface{}) error {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.yamlUnmarshalMutex.Lock()
+	ret, specificReturn := fake.yamlUnmarshalReturnsOnCall[len(fake.yamlUnmarshalArgsForCall)]
+	fake.yamlUnmarshalArgsForCall = append(fake.yamlUnmarshalArgsForCall, struct {
+		arg1 []byte
+		arg2 interface{}
+	}{arg1Copy, arg2})
+	stub := fake.YamlUnmarshalStub
+	fakeReturns := fake.yamlUnmarshalReturns
+	fake.recordInvocation("YamlUnmarshal", []interface{}{arg1Copy, arg2})
+	fake.yamlUnmarshalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) YamlUnmarshalCallCount() int {
+	fake.yamlUnmarshalMutex.RLock()
+	defer fake.yamlUnmarshalMutex.RUnlock()
+	return len(fake.yamlUnmarshalArgsForCall)
+}
+
+func (fake *FakeImpl) YamlUnmarshalCalls(stub func([]byte, interface{}) error) {
+	fake.yamlUnmarshalMutex.Lock()
+	defer fake.yamlUnmarshalMutex.Unlock()
+	fake.YamlUnmarshalStub = stub
+}
+
+func (fake *FakeImpl) YamlUnmarshalArgsForCall(i int) ([]byte, interface{}) {
+	fake.yamlUnmarshalMutex.RLock()
+	defer fake.yamlUnmarshalMutex.RUnlock()
+	argsForCall := fake.yamlUnmarshalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) YamlUnmarshalReturns(result1 error) {
+	fake.yamlUnmarshalMutex.Lock()
+	defer fake.yamlUnmarshalMutex.Unlock()
+	fake.YamlUnmarshalStub = nil
+	fake.yamlUnmarshalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) YamlUnmarshalReturnsOnCall(i int, result1 error) {
+	fake.yamlUnmarshalMutex.Lock()
+	defer fake.yamlUnmarshalMutex.Unlock()
+	fake.YamlUnmarshalStub = nil
+	if fake.yamlUnmarshalReturnsOnCall == nil {
+		fake.yamlUnmarshalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.yamlUnmarshalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.jSONUnmarshalMutex.RLock()
+	defer fake.jSONUnmarshalMutex.RUnlock()
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
+	fake.writeFileMutex.RLock()
+	defer fake.writeFileMutex.RUnlock()
+	fake.yamlMarshalMutex.RLock()
+	defer fake.yamlMarshalMutex.RUnlock()
+	fake.yamlUnmarshalMutex.RLock()
+	defer fake.yamlUnmarshalMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImpl) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package merger
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		prepare func(*flag.FlagSet)
+		assert  func(*Options, error)
+	}{
+		{
+			name: "success",
+			prepare: func(set *flag.FlagSet) {
+				require.Nil(t, set.Parse([]string{"a.yaml", "b.json"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"a.yaml", "b.json"}, opts.profiles)
+				require.False(t, opts.intersect)
+				require.Equal(t, DefaultOutputFile, opts.outputFile)
+			},
+		},
+		{
+			name: "success intersect",
+			prepare: func(set *flag.FlagSet) {
+				set.Bool(FlagIntersect, false, "")
+				require.Nil(t, set.Set(FlagIntersect, "true"))
+				require.Nil(t, set.Parse([]string{"a.yaml", "b.yaml", "c.yaml"}))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Len(t, opts.profiles, 3)
+				require.True(t, opts.intersect)
+			},
+		},
+		{
+			name: "failure single profile provided",
+			prepare: func(set *flag.FlagSet) {
+				require.Nil(t, set.Parse([]string{"a.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure no output file provided",
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagOutputFile, "", "")
+				require.Nil(t, set.Set(FlagOutputFile, ""))
+				require.Nil(t, set.Parse([]string{"a.yaml", "b.yaml"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			set := flag.NewFlagSet("", flag.ExitOnError)
+			prepare(set)
+
+			app := cli.NewApp()
+			ctx := cli.NewContext(app, set, nil)
+
+			opts, err := FromContext(ctx)
+			assert(opts, err)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+// ProfileReader is the file access required to read profiles.
+type ProfileReader interface {
+	ReadFile(string) ([]byte, error)
+	YamlUnmarshal([]byte, interface{}) error
+	JSONUnmarshal([]byte, any) error
+}
+
+// ReadSeccompProfile reads either a raw seccomp JSON profile or a
+// SeccompProfile YAML from the provided file, depending on its extension.
+func ReadSeccompProfile(r ProfileReader, name string) (*seccompprofileapi.SeccompProfile, error) {
+	content, err := r.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("open profile: %w", err)
+	}
+
+	return UnmarshalSeccompProfile(r, name, content, filepath.Ext(name) == seccompprofileapi.ExtJSON)
+}
+
+// UnmarshalSeccompProfile unmarshals the content of the provided file either
+// as raw seccomp JSON profile or as SeccompProfile YAML. Raw profiles are
+// named after the file.
+func UnmarshalSeccompProfile(
+	r ProfileReader, name string, content []byte, raw bool,
+) (*seccompprofileapi.SeccompProfile, error) {
+	profile := &seccompprofileapi.SeccompProfile{}
+	if raw {
+		if err := r.JSONUnmarshal(content, &profile.Spec); err != nil {
+			return nil, fmt.Errorf("unmarshal JSON profile: %w", err)
+		}
+		profile.Name = ProfileName(name)
+		return profile, nil
+	}
+
+	if err := r.YamlUnmarshal(content, profile); err != nil {
+		return nil, fmt.Errorf("unmarshal YAML profile: %w", err)
+	}
+	return profile, nil
+}
+
+// ProfileName returns the name of a profile stored in the provided file,
+// which is the file name without its extension.
+func ProfileName(name string) string {
+	return strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))
+}
+
+// CountSyscalls returns the number of syscall names of all rules.
+func CountSyscalls(syscalls []*seccompprofileapi.Syscall) (count int) {
+	for _, syscall := range syscalls {
+		count += len(syscall.Names)
+	}
+	return count
+}
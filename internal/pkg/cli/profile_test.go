@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+var errTest = errors.New("test")
+
+type fakeProfileReader struct {
+	content []byte
+	err     error
+}
+
+func (f *fakeProfileReader) ReadFile(string) ([]byte, error) {
+	return f.content, f.err
+}
+
+func (*fakeProfileReader) YamlUnmarshal(y []byte, o interface{}) error {
+	return yaml.Unmarshal(y, o)
+}
+
+func (*fakeProfileReader) JSONUnmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func TestReadSeccompProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name, file string
+		reader     *fakeProfileReader
+		wantName   string
+		wantErr    error
+	}{
+		{
+			name: "success raw JSON profile",
+			file: "/path/my-app.json",
+			reader: &fakeProfileReader{
+				content: []byte(`{"defaultAction":"SCMP_ACT_ERRNO"}`),
+			},
+			wantName: "my-app",
+		},
+		{
+			name: "success SeccompProfile YAML",
+			file: "profile.yaml",
+			reader: &fakeProfileReader{
+				content: []byte("metadata:\n  name: other\nspec:\n  defaultAction: SCMP_ACT_ERRNO\n"),
+			},
+			wantName: "other",
+		},
+		{
+			name:    "failure on ReadFile",
+			file:    "profile.yaml",
+			reader:  &fakeProfileReader{err: errTest},
+			wantErr: errTest,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile, err := ReadSeccompProfile(tc.reader, tc.file)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantName, profile.Name)
+			assert.Equal(t, "SCMP_ACT_ERRNO", string(profile.Spec.DefaultAction))
+		})
+	}
+}
@@ -40,7 +40,7 @@ func (*defaultImpl) Pull(
 	from, username, password string, platform *v1.Platform, disableSignatureVerification bool,
 ) (*artifact.PullResult, error) {
 	return artifact.New(logr.New(&cli.LogSink{})).Pull(
-		context.Background(), from, username, password, platform, disableSignatureVerification,
+		context.Background(), from, username, password, platform, disableSignatureVerification, nil,
 	)
 }
 
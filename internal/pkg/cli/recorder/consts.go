@@ -32,6 +32,14 @@ const (
 	// FlagNoBaseSyscalls can be used to indicate that no base syscalls should
 	// be added at all.
 	FlagNoBaseSyscalls string = "no-base-syscalls"
+
+	// FlagName is the flag for defining the name of the recorded CRD profile.
+	// Defaults to the base name of the recorded command.
+	FlagName string = "name"
+
+	// FlagNamespace is the flag for defining the namespace of the recorded
+	// CRD profile.
+	FlagNamespace string = "namespace"
 )
 
 // Type is the enum for all available recorder types.
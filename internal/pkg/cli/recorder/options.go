@@ -31,6 +31,8 @@ type Options struct {
 	typ            Type
 	outputFile     string
 	baseSyscalls   []string
+	name           string
+	namespace      string
 }
 
 // Default returns a default options instance.
@@ -68,6 +70,16 @@ func FromContext(ctx *cli.Context) (*Options, error) {
 		options.baseSyscalls = nil
 	}
 
+	if ctx.IsSet(FlagName) {
+		options.name = ctx.String(FlagName)
+		if options.name == "" {
+			return nil, errors.New("no profile name provided")
+		}
+	}
+	if ctx.IsSet(FlagNamespace) {
+		options.namespace = ctx.String(FlagNamespace)
+	}
+
 	commandOptions, err := command.FromContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("get command options: %w", err)
@@ -53,6 +53,28 @@ func TestFromContext(t *testing.T) {
 				require.NotNil(t, err)
 			},
 		},
+		{ // success with name and namespace
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagName, "", "")
+				set.String(FlagNamespace, "", "")
+				require.Nil(t, set.Set(FlagName, "my-profile"))
+				require.Nil(t, set.Set(FlagNamespace, "my-namespace"))
+				require.Nil(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(err error) {
+				require.Nil(t, err)
+			},
+		},
+		{ // failure: empty name provided
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagName, "", "")
+				require.Nil(t, set.Set(FlagName, ""))
+				require.Nil(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(err error) {
+				require.NotNil(t, err)
+			},
+		},
 		{ // failure: no filename provided
 			prepare: func(set *flag.FlagSet) {
 				set.String(FlagOutputFile, "", "")
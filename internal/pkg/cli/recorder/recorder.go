@@ -184,13 +184,19 @@ func (r *Recorder) buildProfileRaw(spec *seccompprofileapi.SeccompProfileSpec) e
 }
 
 func (r *Recorder) buildProfileCRD(spec *seccompprofileapi.SeccompProfileSpec) error {
+	name := r.options.name
+	if name == "" {
+		name = filepath.Base(r.options.commandOptions.Command())
+	}
+
 	profile := &seccompprofileapi.SeccompProfile{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "SeccompProfile",
 			APIVersion: seccompprofileapi.GroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: filepath.Base(r.options.commandOptions.Command()),
+			Name:      name,
+			Namespace: r.options.namespace,
 		},
 		Spec: *spec,
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/containers/common/pkg/seccomp"
 	"github.com/stretchr/testify/require"
 
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/recorder/recorderfakes"
 )
 
@@ -62,6 +63,25 @@ func TestRun(t *testing.T) {
 				require.Equal(t, 1, mock.PrintObjCallCount())
 			},
 		},
+		{
+			name: "success seccomp CRD with name and namespace",
+			prepare: func(mock *recorderfakes.FakeImpl) *Options {
+				defaultMock(mock)
+				options := Default()
+				options.name = "my-profile"
+				options.namespace = "my-namespace"
+				return options
+			},
+			assert: func(mock *recorderfakes.FakeImpl, err error) {
+				require.Nil(t, err)
+				require.Equal(t, 1, mock.PrintObjCallCount())
+				_, obj, _ := mock.PrintObjArgsForCall(0)
+				profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+				require.True(t, ok)
+				require.Equal(t, "my-profile", profile.Name)
+				require.Equal(t, "my-namespace", profile.Namespace)
+			},
+		},
 		{
 			name: "success seccomp CRD with error on CmdWait",
 			prepare: func(mock *recorderfakes.FakeImpl) *Options {
@@ -0,0 +1,37 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorer
+
+const (
+	// FlagOnConflict is the flag for defining how to handle resources which
+	// already exist in the cluster.
+	FlagOnConflict string = "on-conflict"
+
+	// OnConflictSkip keeps existing resources untouched.
+	OnConflictSkip string = "skip"
+
+	// OnConflictOverwrite replaces existing resources with the ones from the
+	// bundle.
+	OnConflictOverwrite string = "overwrite"
+
+	// OnConflictFail aborts the restore before creating any resource if at
+	// least one of them already exists.
+	OnConflictFail string = "fail"
+
+	// DefaultOnConflict is the default conflict handling.
+	DefaultOnConflict = OnConflictSkip
+)
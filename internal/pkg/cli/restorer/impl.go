@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorer
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	ReadFile(string) ([]byte, error)
+	GetConfig() (*rest.Config, error)
+	NewClient(*rest.Config) (client.Client, error)
+	Get(
+		context.Context, client.Client, schema.GroupVersionKind, types.NamespacedName,
+	) (*unstructured.Unstructured, error)
+	Create(context.Context, client.Client, *unstructured.Unstructured) error
+	Update(context.Context, client.Client, *unstructured.Unstructured) error
+}
+
+func (*defaultImpl) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
+func (*defaultImpl) GetConfig() (*rest.Config, error) {
+	return config.GetConfig()
+}
+
+func (*defaultImpl) NewClient(cfg *rest.Config) (client.Client, error) {
+	return client.New(cfg, client.Options{})
+}
+
+func (*defaultImpl) Get(
+	ctx context.Context, c client.Client, gvk schema.GroupVersionKind, key types.NamespacedName,
+) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := c.Get(ctx, key, obj); err != nil {
+		return nil, fmt.Errorf("get %s: %w", gvk.Kind, err)
+	}
+	return obj, nil
+}
+
+func (*defaultImpl) Create(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+	return c.Create(ctx, obj)
+}
+
+func (*defaultImpl) Update(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+	return c.Update(ctx, obj)
+}
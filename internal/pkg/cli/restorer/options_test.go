@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorer
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		args    []string
+		prepare func(*flag.FlagSet)
+		assert  func(*Options, error)
+	}{
+		{
+			name:    "success defaults",
+			args:    []string{"bundle.yaml"},
+			prepare: func(*flag.FlagSet) {},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "bundle.yaml", opts.path)
+				require.Equal(t, DefaultOnConflict, opts.onConflict)
+			},
+		},
+		{
+			name: "success with conflict handling",
+			args: []string{"bundle.yaml"},
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagOnConflict, "", "")
+				require.Nil(t, set.Set(FlagOnConflict, OnConflictOverwrite))
+			},
+			assert: func(opts *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, OnConflictOverwrite, opts.onConflict)
+			},
+		},
+		{
+			name:    "failure no bundle file provided",
+			prepare: func(*flag.FlagSet) {},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure unsupported conflict handling",
+			args: []string{"bundle.yaml"},
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagOnConflict, "", "")
+				require.Nil(t, set.Set(FlagOnConflict, "merge"))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		args := tc.args
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			set := flag.NewFlagSet("", flag.ExitOnError)
+			prepare(set)
+			require.Nil(t, set.Parse(args))
+
+			app := cli.NewApp()
+			ctx := cli.NewContext(app, set, nil)
+
+			opts, err := FromContext(ctx)
+			assert(opts, err)
+		})
+	}
+}
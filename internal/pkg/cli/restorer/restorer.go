@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/bundle"
+)
+
+var errConflict = errors.New("resources already exist in the cluster")
+
+// Restorer is the main structure of this package.
+type Restorer struct {
+	impl
+	options *Options
+}
+
+// New returns a new Restorer instance.
+func New(options *Options) *Restorer {
+	return &Restorer{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Restorer.
+func (r *Restorer) Run() error {
+	log.Printf("Reading bundle from %s", r.options.path)
+	data, err := r.ReadFile(r.options.path)
+	if err != nil {
+		return fmt.Errorf("read bundle: %w", err)
+	}
+
+	b, err := bundle.Unmarshal(data)
+	if err != nil {
+		return fmt.Errorf("parse bundle: %w", err)
+	}
+	log.Printf(
+		"Restoring %d resources of bundle created at %s",
+		len(b.Items), b.Created.UTC().Format("2006-01-02T15:04:05Z"),
+	)
+
+	cfg, err := r.GetConfig()
+	if err != nil {
+		return fmt.Errorf("get kubeconfig: %w", err)
+	}
+
+	c, err := r.NewClient(cfg)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	ctx := context.Background()
+	if r.options.onConflict == OnConflictFail {
+		if err := r.checkConflicts(ctx, c, b.Items); err != nil {
+			return err
+		}
+	}
+
+	created, updated, skipped := 0, 0, 0
+	for _, item := range b.Items {
+		bundle.Sanitize(item)
+		err := r.Create(ctx, c, item)
+		if err == nil {
+			log.Printf("Created %s", describe(item))
+			created++
+			continue
+		}
+		if !kerrors.IsAlreadyExists(err) || r.options.onConflict == OnConflictFail {
+			return fmt.Errorf("create %s: %w", describe(item), err)
+		}
+
+		if r.options.onConflict == OnConflictSkip {
+			log.Printf("Skipping %s: already exists", describe(item))
+			skipped++
+			continue
+		}
+
+		if err := r.overwrite(ctx, c, item); err != nil {
+			return fmt.Errorf("overwrite %s: %w", describe(item), err)
+		}
+		log.Printf("Overwrote %s", describe(item))
+		updated++
+	}
+
+	log.Printf(
+		"Restored %d of %d resources (%d created, %d overwritten, %d skipped)",
+		created+updated, len(b.Items), created, updated, skipped,
+	)
+	return nil
+}
+
+// checkConflicts verifies that none of the items exists in the cluster.
+func (r *Restorer) checkConflicts(
+	ctx context.Context, c client.Client, items []*unstructured.Unstructured,
+) error {
+	conflicts := 0
+	for _, item := range items {
+		_, err := r.Get(ctx, c, item.GroupVersionKind(), client.ObjectKeyFromObject(item))
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("check %s: %w", describe(item), err)
+		}
+		log.Printf("Conflict: %s already exists", describe(item))
+		conflicts++
+	}
+
+	if conflicts > 0 {
+		return fmt.Errorf("%w: %d conflicts", errConflict, conflicts)
+	}
+	return nil
+}
+
+// overwrite replaces the existing resource with the item of the bundle.
+func (r *Restorer) overwrite(ctx context.Context, c client.Client, item *unstructured.Unstructured) error {
+	existing, err := r.Get(ctx, c, item.GroupVersionKind(), client.ObjectKeyFromObject(item))
+	if err != nil {
+		return err
+	}
+
+	item.SetResourceVersion(existing.GetResourceVersion())
+	item.SetFinalizers(existing.GetFinalizers())
+	if err := r.Update(ctx, c, item); err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+	return nil
+}
+
+// describe returns a human readable identifier of the resource.
+func describe(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", obj.GetKind(), obj.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
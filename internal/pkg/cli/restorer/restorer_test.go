@@ -0,0 +1,244 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/restorer/restorerfakes"
+)
+
+var (
+	errTest   = errors.New("test")
+	errExists = kerrors.NewAlreadyExists(schema.GroupResource{Resource: "seccompprofiles"}, "profile")
+	errAbsent = kerrors.NewNotFound(schema.GroupResource{Resource: "seccompprofiles"}, "profile")
+)
+
+const testBundle = `version: v1
+created: "2023-10-20T10:20:00Z"
+items:
+- apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+  kind: SeccompProfile
+  metadata:
+    name: profile
+    namespace: default
+    resourceVersion: "42"
+  spec:
+    defaultAction: SCMP_ACT_ERRNO
+- apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+  kind: ProfileBinding
+  metadata:
+    name: binding
+    namespace: default
+  spec:
+    image: nginx:1.19.1
+    profileRef:
+      kind: SeccompProfile
+      name: profile
+`
+
+func existing() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetResourceVersion("1337")
+	obj.SetFinalizers([]string{"in-use"})
+	return obj
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name       string
+		onConflict string
+		prepare    func(*restorerfakes.FakeImpl)
+		assert     func(*restorerfakes.FakeImpl, error)
+	}{
+		{
+			name: "success create all",
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+			},
+			assert: func(mock *restorerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.CreateCallCount())
+				_, _, profile := mock.CreateArgsForCall(0)
+				require.Equal(t, "profile", profile.GetName())
+				require.Empty(t, profile.GetResourceVersion())
+				_, _, binding := mock.CreateArgsForCall(1)
+				require.Equal(t, "binding", binding.GetName())
+				require.Zero(t, mock.GetCallCount())
+			},
+		},
+		{
+			name: "success skip existing",
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.CreateReturnsOnCall(0, errExists)
+			},
+			assert: func(mock *restorerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.CreateCallCount())
+				require.Zero(t, mock.UpdateCallCount())
+			},
+		},
+		{
+			name:       "success overwrite existing",
+			onConflict: OnConflictOverwrite,
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.CreateReturnsOnCall(0, errExists)
+				mock.GetReturns(existing(), nil)
+			},
+			assert: func(mock *restorerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.UpdateCallCount())
+				_, _, obj := mock.UpdateArgsForCall(0)
+				require.Equal(t, "profile", obj.GetName())
+				require.Equal(t, "1337", obj.GetResourceVersion())
+				require.Equal(t, []string{"in-use"}, obj.GetFinalizers())
+			},
+		},
+		{
+			name:       "success fail without conflicts",
+			onConflict: OnConflictFail,
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.GetReturns(nil, errAbsent)
+			},
+			assert: func(mock *restorerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.GetCallCount())
+				require.Equal(t, 2, mock.CreateCallCount())
+			},
+		},
+		{
+			name:       "failure fail on conflicts",
+			onConflict: OnConflictFail,
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.GetReturns(nil, errAbsent)
+				mock.GetReturnsOnCall(1, existing(), nil)
+			},
+			assert: func(mock *restorerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errConflict)
+				require.Zero(t, mock.CreateCallCount())
+			},
+		},
+		{
+			name: "failure invalid bundle",
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte("version: v0"), nil)
+			},
+			assert: func(mock *restorerfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.GetConfigCallCount())
+			},
+		},
+		{
+			name: "failure on ReadFile",
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns(nil, errTest)
+			},
+			assert: func(_ *restorerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on GetConfig",
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.GetConfigReturns(nil, errTest)
+			},
+			assert: func(_ *restorerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on NewClient",
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.NewClientReturns(nil, errTest)
+			},
+			assert: func(_ *restorerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name:       "failure on Get",
+			onConflict: OnConflictFail,
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.GetReturns(nil, errTest)
+			},
+			assert: func(mock *restorerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.CreateCallCount())
+			},
+		},
+		{
+			name: "failure on Create",
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.CreateReturns(errTest)
+			},
+			assert: func(_ *restorerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name:       "failure on Update",
+			onConflict: OnConflictOverwrite,
+			prepare: func(mock *restorerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testBundle), nil)
+				mock.CreateReturns(errExists)
+				mock.GetReturns(existing(), nil)
+				mock.UpdateReturns(errTest)
+			},
+			assert: func(_ *restorerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		onConflict := tc.onConflict
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &restorerfakes.FakeImpl{}
+			prepare(mock)
+
+			options := Default()
+			options.path = "bundle.yaml"
+			if onConflict != "" {
+				options.onConflict = onConflict
+			}
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshotter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/bundle"
+)
+
+// Snapshotter is the main structure of this package.
+type Snapshotter struct {
+	impl
+	options *Options
+}
+
+// New returns a new Snapshotter instance.
+func New(options *Options) *Snapshotter {
+	return &Snapshotter{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Snapshotter.
+func (s *Snapshotter) Run() error {
+	cfg, err := s.GetConfig()
+	if err != nil {
+		return fmt.Errorf("get kubeconfig: %w", err)
+	}
+
+	c, err := s.NewClient(cfg)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	ctx := context.Background()
+	items := []*unstructured.Unstructured{}
+	for i := range bundle.Kinds {
+		kind := &bundle.Kinds[i]
+		res, err := s.list(ctx, c, kind)
+		if meta.IsNoMatchError(err) {
+			log.Printf("Skipping %s: resource not available in the cluster", kind.Kind)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("list %s: %w", kind.Kind, err)
+		}
+		items = append(items, res...)
+	}
+	if len(items) == 0 {
+		return errors.New("no resources found")
+	}
+
+	// Sort the items to restore them in a well defined order
+	sort.SliceStable(items, func(i, j int) bool {
+		if oi, oj := bundle.Order(items[i]), bundle.Order(items[j]); oi != oj {
+			return oi < oj
+		}
+		if items[i].GetNamespace() != items[j].GetNamespace() {
+			return items[i].GetNamespace() < items[j].GetNamespace()
+		}
+		return items[i].GetName() < items[j].GetName()
+	})
+
+	data, err := bundle.Marshal(&bundle.Bundle{
+		Version: bundle.Version,
+		Created: metav1.Now(),
+		Items:   items,
+	})
+	if err != nil {
+		return fmt.Errorf("build bundle: %w", err)
+	}
+
+	log.Printf("Saving %d resources in: %s", len(items), s.options.outputFile)
+	const defaultFileMode = os.FileMode(0o600)
+	if err := s.WriteFile(s.options.outputFile, data, defaultFileMode); err != nil {
+		return fmt.Errorf("save bundle: %w", err)
+	}
+
+	return nil
+}
+
+// list returns the sanitized resources of the kind. Cluster scoped resources
+// are always listed, independently of the selected namespaces.
+func (s *Snapshotter) list(
+	ctx context.Context, c client.Client, kind *bundle.Kind,
+) ([]*unstructured.Unstructured, error) {
+	namespaces := s.options.namespaces
+	if len(namespaces) == 0 || !kind.Namespaced {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+
+	res := []*unstructured.Unstructured{}
+	for _, namespace := range namespaces {
+		list, err := s.List(ctx, c, kind.GroupVersionKind, client.InNamespace(namespace))
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			item := &list.Items[i]
+			item.SetGroupVersionKind(kind.GroupVersionKind)
+			bundle.Sanitize(item)
+			res = append(res, item)
+		}
+	}
+
+	log.Printf("Found %d resources of kind %s", len(res), kind.Kind)
+	return res, nil
+}
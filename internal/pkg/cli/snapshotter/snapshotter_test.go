@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshotter
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/bundle"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/snapshotter/snapshotterfakes"
+)
+
+var errTest = errors.New("test")
+
+func testList(
+	_ context.Context, _ client.Client, gvk schema.GroupVersionKind, opts ...client.ListOption,
+) (*unstructured.UnstructuredList, error) {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	item := unstructured.Unstructured{}
+	item.SetName("test")
+	item.SetResourceVersion("42")
+	item.SetFinalizers([]string{"in-use"})
+	if gvk.Kind != "ProfileBindingPolicy" {
+		item.SetNamespace("default")
+		if listOpts.Namespace != "" {
+			item.SetNamespace(listOpts.Namespace)
+		}
+	}
+	return &unstructured.UnstructuredList{Items: []unstructured.Unstructured{item}}, nil
+}
+
+func writtenBundle(t *testing.T, mock *snapshotterfakes.FakeImpl) *bundle.Bundle {
+	t.Helper()
+	require.Equal(t, 1, mock.WriteFileCallCount())
+	_, data, _ := mock.WriteFileArgsForCall(0)
+	b, err := bundle.Unmarshal(data)
+	require.NoError(t, err)
+	return b
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name       string
+		namespaces []string
+		prepare    func(*snapshotterfakes.FakeImpl)
+		assert     func(*testing.T, *snapshotterfakes.FakeImpl, error)
+	}{
+		{
+			name: "success all namespaces",
+			prepare: func(mock *snapshotterfakes.FakeImpl) {
+				mock.ListStub = testList
+			},
+			assert: func(t *testing.T, mock *snapshotterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, len(bundle.Kinds), mock.ListCallCount())
+
+				b := writtenBundle(t, mock)
+				require.Equal(t, bundle.Version, b.Version)
+				require.Len(t, b.Items, len(bundle.Kinds))
+				for i, item := range b.Items {
+					require.Equal(t, bundle.Kinds[i].GroupVersionKind, item.GroupVersionKind())
+					require.Empty(t, item.GetResourceVersion())
+					require.Empty(t, item.GetFinalizers())
+				}
+			},
+		},
+		{
+			name:       "success selected namespaces",
+			namespaces: []string{"ns1", "ns2"},
+			prepare: func(mock *snapshotterfakes.FakeImpl) {
+				mock.ListStub = testList
+			},
+			assert: func(t *testing.T, mock *snapshotterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2*len(bundle.Kinds)-1, mock.ListCallCount())
+
+				b := writtenBundle(t, mock)
+				require.Len(t, b.Items, 2*len(bundle.Kinds)-1)
+				require.Equal(t, "ns1", b.Items[0].GetNamespace())
+				require.Equal(t, "ns2", b.Items[1].GetNamespace())
+			},
+		},
+		{
+			name: "success skip unavailable kinds",
+			prepare: func(mock *snapshotterfakes.FakeImpl) {
+				mock.ListStub = func(
+					ctx context.Context, c client.Client, gvk schema.GroupVersionKind, opts ...client.ListOption,
+				) (*unstructured.UnstructuredList, error) {
+					if gvk.Kind == "AppArmorProfile" {
+						return nil, &meta.NoKindMatchError{GroupKind: gvk.GroupKind()}
+					}
+					return testList(ctx, c, gvk, opts...)
+				}
+			},
+			assert: func(t *testing.T, mock *snapshotterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Len(t, writtenBundle(t, mock).Items, len(bundle.Kinds)-1)
+			},
+		},
+		{
+			name: "failure no resources found",
+			prepare: func(mock *snapshotterfakes.FakeImpl) {
+				mock.ListReturns(&unstructured.UnstructuredList{}, nil)
+			},
+			assert: func(t *testing.T, mock *snapshotterfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name: "failure on GetConfig",
+			prepare: func(mock *snapshotterfakes.FakeImpl) {
+				mock.GetConfigReturns(nil, errTest)
+			},
+			assert: func(t *testing.T, _ *snapshotterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on NewClient",
+			prepare: func(mock *snapshotterfakes.FakeImpl) {
+				mock.NewClientReturns(nil, errTest)
+			},
+			assert: func(t *testing.T, _ *snapshotterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on List",
+			prepare: func(mock *snapshotterfakes.FakeImpl) {
+				mock.ListReturns(nil, errTest)
+			},
+			assert: func(t *testing.T, _ *snapshotterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on WriteFile",
+			prepare: func(mock *snapshotterfakes.FakeImpl) {
+				mock.ListStub = testList
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(t *testing.T, _ *snapshotterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		namespaces := tc.namespaces
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &snapshotterfakes.FakeImpl{}
+			prepare(mock)
+
+			options := Default()
+			options.namespaces = namespaces
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(t, mock, err)
+		})
+	}
+}
+
+func TestWriteFileMode(t *testing.T) {
+	t.Parallel()
+
+	mock := &snapshotterfakes.FakeImpl{}
+	mock.ListStub = testList
+
+	sut := New(Default())
+	sut.impl = mock
+	require.NoError(t, sut.Run())
+
+	name, _, mode := mock.WriteFileArgsForCall(0)
+	require.Equal(t, DefaultOutputFile, name)
+	require.Equal(t, os.FileMode(0o600), mode)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subtractor
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// Subtractor is the main structure of this package.
+type Subtractor struct {
+	impl
+	options *Options
+}
+
+// New returns a new Subtractor instance.
+func New(options *Options) *Subtractor {
+	return &Subtractor{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Subtractor.
+func (s *Subtractor) Run() error {
+	log.Printf("Reading file %s", s.options.profile)
+	profile, err := cli.ReadSeccompProfile(s, s.options.profile)
+	if err != nil {
+		return fmt.Errorf("read profile: %w", err)
+	}
+
+	log.Printf("Reading file %s", s.options.baseProfile)
+	baseProfile, err := cli.ReadSeccompProfile(s, s.options.baseProfile)
+	if err != nil {
+		return fmt.Errorf("read base profile: %w", err)
+	}
+
+	baseProfileName := s.options.baseProfileName
+	if baseProfileName == "" {
+		baseProfileName = baseProfile.GetName()
+	}
+	if baseProfileName == "" {
+		return errors.New("no base profile name provided")
+	}
+
+	syscalls := util.SubtractSyscalls(profile.Spec.Syscalls, baseProfile.Spec.Syscalls)
+	log.Printf(
+		"Reduced profile from %d to %d syscalls by subtracting base profile %s",
+		cli.CountSyscalls(profile.Spec.Syscalls), cli.CountSyscalls(syscalls), baseProfileName,
+	)
+
+	profile.TypeMeta = metav1.TypeMeta{
+		Kind:       "SeccompProfile",
+		APIVersion: seccompprofileapi.GroupVersion.String(),
+	}
+	profile.Spec.BaseProfileName = baseProfileName
+	profile.Spec.Syscalls = syscalls
+
+	data, err := s.YamlMarshal(profile)
+	if err != nil {
+		return fmt.Errorf("marshal YAML profile: %w", err)
+	}
+
+	log.Printf("Saving profile in: %s", s.options.outputFile)
+	const defaultFileMode = os.FileMode(0o644)
+	if err := s.WriteFile(s.options.outputFile, data, defaultFileMode); err != nil {
+		return fmt.Errorf("save profile: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subtractor
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/subtractor/subtractorfakes"
+)
+
+var errTest = errors.New("test")
+
+const (
+	testProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+metadata:
+  name: my-app
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names:
+    - ptrace
+    - read
+    - write
+`
+	testBaseProfile = `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "syscalls": [{"action": "SCMP_ACT_ALLOW", "names": ["read", "write", "close"]}]
+}`
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		options *Options
+		prepare func(mock *subtractorfakes.FakeImpl)
+		assert  func(mock *subtractorfakes.FakeImpl, err error)
+	}{
+		{
+			name: "success",
+			prepare: func(mock *subtractorfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testBaseProfile), nil)
+			},
+			assert: func(mock *subtractorfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				profile := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, yaml.Unmarshal(data, profile))
+				require.Equal(t, "my-app", profile.Name)
+				require.Equal(t, "SeccompProfile", profile.Kind)
+				require.Equal(t, "runtime-default", profile.Spec.BaseProfileName)
+				require.Len(t, profile.Spec.Syscalls, 1)
+				require.Equal(t, []string{"ptrace"}, profile.Spec.Syscalls[0].Names)
+			},
+		},
+		{
+			name:    "success with base profile name",
+			options: &Options{baseProfile: "base.json", baseProfileName: "my-base"},
+			prepare: func(mock *subtractorfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testBaseProfile), nil)
+			},
+			assert: func(mock *subtractorfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				profile := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, yaml.Unmarshal(data, profile))
+				require.Equal(t, "my-base", profile.Spec.BaseProfileName)
+			},
+		},
+		{
+			name:    "failure no base profile name",
+			options: &Options{baseProfile: "base.yaml"},
+			prepare: func(mock *subtractorfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte("spec: {}"), nil)
+			},
+			assert: func(mock *subtractorfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name: "failure on ReadFile",
+			prepare: func(mock *subtractorfakes.FakeImpl) {
+				mock.ReadFileReturns(nil, errTest)
+			},
+			assert: func(_ *subtractorfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on YamlUnmarshal",
+			prepare: func(mock *subtractorfakes.FakeImpl) {
+				mock.YamlUnmarshalReturns(errTest)
+			},
+			assert: func(_ *subtractorfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on JSONUnmarshal",
+			prepare: func(mock *subtractorfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.JSONUnmarshalReturns(errTest)
+			},
+			assert: func(_ *subtractorfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on YamlMarshal",
+			prepare: func(mock *subtractorfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testBaseProfile), nil)
+				mock.YamlMarshalReturns(nil, errTest)
+			},
+			assert: func(_ *subtractorfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on WriteFile",
+			prepare: func(mock *subtractorfakes.FakeImpl) {
+				mock.ReadFileReturnsOnCall(0, []byte(testProfile), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(testBaseProfile), nil)
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(_ *subtractorfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		options := tc.options
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &subtractorfakes.FakeImpl{}
+			mock.YamlUnmarshalStub = func(y []byte, o interface{}) error {
+				return yaml.Unmarshal(y, o)
+			}
+			mock.YamlMarshalStub = yaml.Marshal
+			mock.JSONUnmarshalStub = json.Unmarshal
+			prepare(mock)
+
+			if options == nil {
+				options = &Options{baseProfile: "runtime-default.json"}
+			}
+			options.profile = "profile.yaml"
+			options.outputFile = DefaultOutputFile
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
@@ -41,6 +41,20 @@ const (
 	// from within the daemon.
 	SPOdNameEnvKey = "SPOD_NAME"
 
+	// SPOdVariantLabel is the label of the SPOd DaemonSets which run on the
+	// nodes not matching the node selector of a feature.
+	SPOdVariantLabel = "spo.x-k8s.io/spod-variant"
+
+	// SPOdDisabledFeaturesAnnotation is the annotation of a SPOd DaemonSet
+	// variant which lists the comma separated features disabled by it.
+	SPOdDisabledFeaturesAnnotation = "spo.x-k8s.io/disabled-features"
+
+	// SPOdFeatureSelinux is the name of the SELinux feature of SPOd.
+	SPOdFeatureSelinux = "selinux"
+
+	// SPOdFeatureAppArmor is the name of the AppArmor feature of SPOd.
+	SPOdFeatureAppArmor = "apparmor"
+
 	// HostRoot define the host files root mount path.
 	HostRoot = "/host"
 
@@ -52,6 +66,11 @@ const (
 	// profiles are stored.
 	OperatorProfilesFolder = "operator"
 
+	// LandlockProfilesFolder defines the folder name below the operator
+	// profiles where the Landlock rulesets are stored. It is not a valid
+	// namespace name to not clash with the seccomp profiles.
+	LandlockProfilesFolder = "landlock.d"
+
 	// OperatorRoot is the root directory of the operator.
 	OperatorRoot = "/var/lib/security-profiles-operator"
 
@@ -61,6 +80,10 @@ const (
 	// DefaultKubeletPath specifies the default kubelet path.
 	DefaultKubeletPath = "/var/lib/kubelet"
 
+	// KubeletPodsFolder defines the folder name inside the kubelet root
+	// directory where the kubelet stores the pod data.
+	KubeletPodsFolder = "pods"
+
 	// KubeletConfigFile specifies the name of the kubelet config file
 	// which contains various configuration parameters of the kubelet.
 	// This configuration file is created by the non-root enabler container
@@ -88,9 +111,78 @@ const (
 	// EnableBpfRecorderEnvKey is the environment variable key for enabling the BPF recorder.
 	EnableBpfRecorderEnvKey = "ENABLE_BPF_RECORDER"
 
+	// EnableDenialEventsEnvKey is the environment variable key for enabling
+	// Kubernetes events about denials of pods which are not being recorded.
+	EnableDenialEventsEnvKey = "ENABLE_DENIAL_EVENTS"
+
+	// EnableAuditNetlinkEnvKey is the environment variable key for reading
+	// audit records from the kernel audit netlink socket instead of log files.
+	EnableAuditNetlinkEnvKey = "ENABLE_AUDIT_NETLINK"
+
+	// EnricherSinkStdoutFormatEnvKey is the environment variable key for the
+	// format of the audit events logged to stdout by the log enricher.
+	EnricherSinkStdoutFormatEnvKey = "ENRICHER_SINK_STDOUT_FORMAT"
+
+	// EnricherSinkFileEnvKey is the environment variable key for the file to
+	// which the log enricher appends the audit events as JSON lines.
+	EnricherSinkFileEnvKey = "ENRICHER_SINK_FILE"
+
+	// EnricherSinkWebhookURLEnvKey is the environment variable key for the
+	// HTTP endpoint which receives the audit events of the log enricher.
+	EnricherSinkWebhookURLEnvKey = "ENRICHER_SINK_WEBHOOK_URL"
+
+	// EnricherRetentionEnvKey is the environment variable key for the duration
+	// after which the log enricher evicts the recorded data of profiles which
+	// have not been accessed.
+	EnricherRetentionEnvKey = "ENRICHER_RETENTION"
+
+	// EnricherCacheTTLEnvKey is the environment variable key for the time to
+	// live of the container ID and info caches of the log enricher.
+	EnricherCacheTTLEnvKey = "ENRICHER_CACHE_TTL"
+
+	// EnricherCacheMaxEntriesEnvKey is the environment variable key for the
+	// maximum amount of entries per cache of the log enricher.
+	EnricherCacheMaxEntriesEnvKey = "ENRICHER_CACHE_MAX_ENTRIES"
+
+	// EnricherCRIEndpointEnvKey is the environment variable key for the CRI
+	// runtime endpoint used by the log enricher to look up containers
+	// locally, for example unix:///run/containerd/containerd.sock.
+	EnricherCRIEndpointEnvKey = "ENRICHER_CRI_ENDPOINT"
+
+	// EnricherContainerIDResolversEnvKey is the environment variable key for
+	// the comma separated, ordered list of container ID resolvers used by the
+	// log enricher.
+	EnricherContainerIDResolversEnvKey = "ENRICHER_CONTAINER_ID_RESOLVERS"
+
+	// EnricherBackfillEnvKey is the environment variable key for replaying
+	// the audit log from the start of a recorded container in the log
+	// enricher.
+	EnricherBackfillEnvKey = "ENRICHER_BACKFILL"
+
+	// EnricherTLSDirEnvKey is the environment variable key for the directory
+	// containing the certificates for mutual TLS between the GRPC server of
+	// the log enricher and its clients. TLS is disabled if it is not set.
+	EnricherTLSDirEnvKey = "ENRICHER_TLS_DIR"
+
 	// EnableRecordingEnvKey is the environment variable key to enabling profile recording.
 	EnableRecordingEnvKey = "ENABLE_RECORDING"
 
+	// LegacyMetricNamesEnvKey is the environment variable key for additionally
+	// exporting the metrics of the daemon with their legacy names.
+	LegacyMetricNamesEnvKey = "SPO_LEGACY_METRIC_NAMES"
+
+	// StartupTaintEnvKey is the environment variable key for tainting the
+	// node until the initial profile sync of the daemon finished.
+	StartupTaintEnvKey = "SPO_STARTUP_TAINT"
+
+	// DryRunEnvKey is the environment variable key for rendering the profiles
+	// without installing them on the node.
+	DryRunEnvKey = "SPO_DRY_RUN"
+
+	// FeatureGatesEnvKey is the environment variable key for configuring the
+	// feature gates of the operator, for example "BpfRecorder=false".
+	FeatureGatesEnvKey = "SPO_FEATURE_GATES"
+
 	// VerboseLevel is the increased verbosity log level.
 	VerboseLevel = 1
 
@@ -123,6 +215,75 @@ const (
 	// created a selinux profile.
 	SelinuxProfileRecordLogsAnnotationKey = "io.containers.trace-avcs/"
 
+	// RecordingSessionAnnotationKey is the annotation on a recorded Pod that
+	// contains the unique ID of its recording session. The ID is part of the
+	// recording annotations of all containers and of the audit events of the
+	// log enricher, and it is set as label on the recorded profiles.
+	RecordingSessionAnnotationKey = "spo.x-k8s.io/recording-session"
+
+	// EphemeralContainersRecordName is the container name used in the
+	// recording annotations to record all ephemeral containers of a Pod, for
+	// example debug containers injected by "kubectl debug". It cannot clash
+	// with a regular container name, because those must not contain dots.
+	EphemeralContainersRecordName = "ephemeral.containers"
+
+	// RuntimeAnnotationKey is the annotation on a Pod that specifies the
+	// language runtime of a container, for example set by SBOM tooling. It
+	// is used to seed recorded seccomp profiles with a baseline of syscalls
+	// for the runtime. Supported values are jvm, go, nodejs and python.
+	RuntimeAnnotationKey = "runtime.spo.x-k8s.io/"
+
+	// NodeResyncAnnotationKey is the annotation on a Node that forces the
+	// daemon running on this node to resync all profiles and to report their
+	// status again. Every change of the value, for example to the current
+	// timestamp, triggers another resync.
+	NodeResyncAnnotationKey = "spo.x-k8s.io/resync"
+
+	// ProfilesSyncedLabelKey is the label on a Node that indicates whether
+	// the daemon running on this node finished installing all profiles after
+	// it started. Workloads using localhost profiles can require the value
+	// "true" via their node affinity.
+	ProfilesSyncedLabelKey = "spo.x-k8s.io/profiles-synced"
+
+	// ProfilesSyncProgressAnnotationKey is the annotation on a Node that
+	// contains the number of synced and total profiles, like "12/15".
+	ProfilesSyncProgressAnnotationKey = "spo.x-k8s.io/profiles-sync-progress"
+
+	// ProfilesNotSyncedTaintKey is the NoSchedule taint on a Node which is
+	// removed once the daemon running on this node finished installing all
+	// profiles after it started. Nodes can be registered with the taint to
+	// keep workloads from being scheduled before the daemon started.
+	ProfilesNotSyncedTaintKey = "spo.x-k8s.io/profiles-not-synced"
+
+	// RequireRecordingApprovalLabelKey is the label on a Namespace that
+	// requires profile recordings using the log based recorder to be approved
+	// before their permissive profiles get applied to the workloads.
+	RequireRecordingApprovalLabelKey = "spo.x-k8s.io/require-recording-approval"
+
+	// ProfileMetadataAnnotationPrefix is the prefix of annotations on security
+	// profiles carrying metadata about the profile, for example the owning
+	// team in "metadata.spo.x-k8s.io/owner". The daemon copies the metadata
+	// into a companion file next to the profile on the node.
+	ProfileMetadataAnnotationPrefix = "metadata.spo.x-k8s.io/"
+
+	// ProfileMirrorLabelKey is the label on a ConfigMap mirroring the content
+	// of the SeccompProfile with the name of the label value.
+	ProfileMirrorLabelKey = "spo.x-k8s.io/mirrored-profile"
+
+	// SelinuxUsageConfigMapName is the name of the ConfigMap in every
+	// namespace which publishes the SELinux type and level of the SELinux
+	// profiles of the namespace.
+	SelinuxUsageConfigMapName = "spo-selinux-usage"
+
+	// SelinuxUsageLabelKey is the label on the ConfigMaps publishing the
+	// SELinux usage, which marks them as managed by the operator.
+	SelinuxUsageLabelKey = "spo.x-k8s.io/selinux-usage"
+
+	// UnusedProfileLabelKey is the label on recorded profiles which have
+	// not been used for the retention period of the recorded profile garbage
+	// collection, if it is configured to only mark them.
+	UnusedProfileLabelKey = "spo.x-k8s.io/unused"
+
 	// KubeletDirNodeLabelKey is the label on a Node that specifies
 	// a custom kubelet root directory configured for this node. The directory
 	// path is provided in the following format folder-subfolder-subfolder
@@ -132,12 +293,21 @@ const (
 	// HealthProbePort is the port where the liveness probe will be served.
 	HealthProbePort = 8085
 
+	// DaemonMetricsBindAddress is the address of the metrics server of the
+	// daemon. It is bound to localhost because the served profiles and
+	// syscalls must only be reachable via the authenticating kube-rbac-proxy
+	// sidecar.
+	DaemonMetricsBindAddress = "127.0.0.1:8080"
+
 	// AuditLogPath is the path to the auditd log file.
 	AuditLogPath = "/var/log/audit/audit.log"
 
 	// SyslogLogPath is the path to the syslog log file.
 	SyslogLogPath = "/var/log/syslog"
 
+	// JournalLogPath is the path to the persistent systemd journal.
+	JournalLogPath = "/var/log/journal"
+
 	// LogEnricherProfile is the seccomp profile name for tracing syscalls from
 	// the log enricher.
 	LogEnricherProfile = "log-enricher-trace"
@@ -169,6 +339,14 @@ const (
 // therefore have a limited lifetime.
 var ProfileRecordingOutputPath = filepath.Join(os.TempDir(), "security-profiles-operator-recordings")
 
+// KnownKubeletPaths are well-known kubelet root directories used by
+// Kubernetes distributions which do not follow the default path.
+var KnownKubeletPaths = []string{
+	DefaultKubeletPath,
+	"/var/lib/k0s/kubelet",
+	"/var/snap/microk8s/common/var/lib/kubelet",
+}
+
 var ErrPodNamespaceEnvNotFound = errors.New("the env variable OPERATOR_NAMESPACE hasn't been set")
 
 // KubeletConfig stores various configuration parameters of the kubelet.
@@ -223,6 +401,12 @@ func ProfilesRootPath() string {
 	return path.Join(KubeletSeccompRootPath(), OperatorProfilesFolder)
 }
 
+// LandlockProfilesRootPath specifies the path where the operator stores the
+// rendered Landlock rulesets.
+func LandlockProfilesRootPath() string {
+	return path.Join(ProfilesRootPath(), LandlockProfilesFolder)
+}
+
 // KubeletConfigFilePath returns the kubelet config file path.
 func KubeletConfigFilePath() string {
 	return path.Join(OperatorRoot, KubeletConfigFile)
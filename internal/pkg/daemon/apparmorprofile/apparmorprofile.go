@@ -38,6 +38,7 @@ import (
 	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
@@ -72,6 +73,7 @@ type Reconciler struct {
 	record  record.EventRecorder
 	metrics *metrics.Metrics
 	manager ProfileManager
+	dryRun  bool
 }
 
 // Name returns the name of the controller.
@@ -173,6 +175,17 @@ func (r *Reconciler) reconcileAppArmorProfile(
 		return reconcile.Result{RequeueAfter: wait}, nil
 	}
 
+	if r.dryRun {
+		err := common.ReportDryRun(ctx, nodeStatus, r.record, l, sp, sp.GetProfileName(), []byte(sp.Spec.Policy))
+		if err != nil {
+			l.Error(err, "cannot report dry run")
+			r.metrics.IncAppArmorProfileError(reasonCannotUpdateStatus)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+			return reconcile.Result{}, fmt.Errorf("reporting dry run of AppArmorProfile: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
 	// TODO: backoff policy
 	updated, err := r.manager.InstallProfile(sp)
 	if err != nil {
@@ -248,7 +261,9 @@ func (r *Reconciler) reconcileDeletion(
 		return reconcile.Result{RequeueAfter: wait}, nil
 	}
 
-	if err := r.handleDeletion(sp); err != nil {
+	if r.dryRun {
+		r.log.Info("Dry run, not unloading profile")
+	} else if err := r.handleDeletion(sp); err != nil {
 		r.log.Error(err, "cannot delete profile")
 		r.metrics.IncAppArmorProfileError(reasonCannotUnloadProfile)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotUnloadProfile, err.Error())
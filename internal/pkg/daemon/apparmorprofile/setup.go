@@ -20,8 +20,10 @@ import (
 	"context"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 )
 
@@ -36,12 +38,16 @@ func (r *Reconciler) Setup(
 	r.record = mgr.GetEventRecorderFor("apparmorprofile")
 	r.metrics = met
 	r.manager = NewAppArmorProfileManager(r.log)
+	r.dryRun = common.DryRun()
 
 	r.logNodeInfo()
 
 	// Register the regular reconciler to manage AppArmorProfiles
-	return ctrl.NewControllerManagedBy(mgr).
-		Named("apparmorprofile").
+	b := common.WatchNodeResync(
+		ctrl.NewControllerManagedBy(mgr), r.client, r.record, r.log, "AppArmorProfile",
+		func() client.ObjectList { return &v1alpha1.AppArmorProfileList{} },
+	)
+	return b.Named("apparmorprofile").
 		For(&v1alpha1.AppArmorProfile{}).
 		Complete(r)
 }
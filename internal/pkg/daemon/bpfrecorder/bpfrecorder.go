@@ -707,6 +707,8 @@ func (b *BpfRecorder) findProfileForContainerID(id string) (string, error) {
 				pod := &pods.Items[p]
 				//nolint:gocritic // We explicitly do not want to append to the same slice
 				statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+				ephemeralStart := len(statuses)
+				statuses = append(statuses, pod.Status.EphemeralContainerStatuses...)
 				for c := range statuses {
 					containerStatus := statuses[c]
 					fullContainerID := containerStatus.ContainerID
@@ -742,9 +744,10 @@ func (b *BpfRecorder) findProfileForContainerID(id string) (string, error) {
 						"containerName", containerName,
 					)
 
-					key := config.SeccompProfileRecordBpfAnnotationKey + containerName
-					profile, ok := pod.Annotations[key]
-					if ok && profile != "" {
+					profile := util.RecordProfileForContainer(
+						pod.Annotations, config.SeccompProfileRecordBpfAnnotationKey, containerName, c >= ephemeralStart,
+					)
+					if profile != "" {
 						b.logger.Info(
 							"Cache this profile found in cluster",
 							"profile", profile,
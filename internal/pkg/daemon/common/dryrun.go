@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const reasonRenderedProfile = "RenderedProfile"
+
+// DryRun returns true if the daemon must not install profiles on the node.
+func DryRun() bool {
+	dryRun, err := strconv.ParseBool(os.Getenv(config.DryRunEnvKey))
+	return err == nil && dryRun
+}
+
+// ReportDryRun reports the rendered artifact of a profile instead of
+// installing it on the node. The artifact gets logged and the node status
+// set to DryRun, which emits an event including the digest of the artifact.
+func ReportDryRun(
+	ctx context.Context,
+	nsc *nodestatus.StatusClient,
+	rec record.EventRecorder,
+	l logr.Logger,
+	obj client.Object,
+	artifact string,
+	content []byte,
+) error {
+	l.Info("Rendered profile in dry-run mode", "artifact", artifact, "content", string(content))
+
+	isDryRun, err := nsc.Matches(ctx, statusv1alpha1.ProfileStateDryRun)
+	if err != nil {
+		return fmt.Errorf("getting node status: %w", err)
+	}
+	if isDryRun {
+		return nil
+	}
+
+	if err := nsc.SetNodeStatus(ctx, statusv1alpha1.ProfileStateDryRun); err != nil {
+		return fmt.Errorf("setting node status to dry run: %w", err)
+	}
+	rec.Event(obj, util.EventTypeNormal, reasonRenderedProfile, fmt.Sprintf(
+		"Rendered %s (sha256:%x) on %s without installing it",
+		artifact, sha256.Sum256(content), os.Getenv(config.NodeNameEnvKey),
+	))
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
+)
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestDryRun(t *testing.T) {
+	for _, tc := range []struct {
+		value    string
+		expected bool
+	}{
+		{value: "", expected: false},
+		{value: "false", expected: false},
+		{value: "invalid", expected: false},
+		{value: "true", expected: true},
+	} {
+		t.Setenv(config.DryRunEnvKey, tc.value)
+		require.Equal(t, tc.expected, DryRun(), tc.value)
+	}
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestReportDryRun(t *testing.T) {
+	t.Setenv(config.NodeNameEnvKey, "node")
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+	require.NoError(t, statusv1alpha1.AddToScheme(scheme))
+
+	profile := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "default", UID: "uid"},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(profile).Build()
+
+	ctx := context.Background()
+	nsc, err := nodestatus.NewForProfile(profile, c)
+	require.NoError(t, err)
+	require.NoError(t, nsc.Create(ctx))
+
+	recorder := record.NewFakeRecorder(10)
+	report := func() {
+		require.NoError(t, ReportDryRun(
+			ctx, nsc, recorder, logr.Discard(), profile, "/profile.json", []byte("{}"),
+		))
+	}
+
+	report()
+	isDryRun, err := nsc.Matches(ctx, statusv1alpha1.ProfileStateDryRun)
+	require.NoError(t, err)
+	require.True(t, isDryRun)
+	require.Len(t, recorder.Events, 1)
+	require.Equal(t,
+		"Normal RenderedProfile Rendered /profile.json "+
+			"(sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a) on node without installing it",
+		<-recorder.Events,
+	)
+
+	// The event is only emitted once the state changes
+	report()
+	require.Empty(t, recorder.Events)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const reasonResyncProfiles = "ResyncingProfiles"
+
+// NodeResyncPredicate implements an update predicate function on changes of
+// the resync annotation of the node the daemon is running on.
+type NodeResyncPredicate struct {
+	predicate.Funcs
+}
+
+// Create ignores new nodes, because all profiles get reconciled on startup.
+func (NodeResyncPredicate) Create(event.CreateEvent) bool {
+	return false
+}
+
+// Delete ignores deleted nodes.
+func (NodeResyncPredicate) Delete(event.DeleteEvent) bool {
+	return false
+}
+
+// Generic ignores generic events.
+func (NodeResyncPredicate) Generic(event.GenericEvent) bool {
+	return false
+}
+
+// Update implements the update event filter for checking if a resync got
+// requested for the current node.
+func (NodeResyncPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+	if e.ObjectNew.GetName() != os.Getenv(config.NodeNameEnvKey) {
+		return false
+	}
+	newValue, ok := e.ObjectNew.GetAnnotations()[config.NodeResyncAnnotationKey]
+	return ok && newValue != e.ObjectOld.GetAnnotations()[config.NodeResyncAnnotationKey]
+}
+
+// WatchNodeResync adds a watch for resync requests of the current node to
+// the controller builder, which enqueues all profiles of the provided kind
+// listed by newList.
+func WatchNodeResync(
+	b *ctrl.Builder,
+	cli client.Client,
+	rec record.EventRecorder,
+	l logr.Logger,
+	kind string,
+	newList func() client.ObjectList,
+) *ctrl.Builder {
+	return b.Watches(
+		&corev1.Node{},
+		handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			requests, err := ResyncRequests(ctx, cli, newList())
+			if err != nil {
+				l.Error(err, "cannot list profiles for node resync")
+				return []reconcile.Request{}
+			}
+
+			l.Info("Resyncing profiles", "kind", kind, "count", len(requests))
+			rec.Event(obj, util.EventTypeNormal, reasonResyncProfiles,
+				fmt.Sprintf("Resyncing %d %s objects on %s", len(requests), kind, obj.GetName()))
+			return requests
+		}),
+		builder.WithPredicates(NodeResyncPredicate{}),
+	)
+}
+
+// ResyncRequests lists all profiles into the provided list and returns a
+// reconcile request for each of them.
+func ResyncRequests(
+	ctx context.Context, cli client.Client, list client.ObjectList,
+) ([]reconcile.Request, error) {
+	if err := cli.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("list profiles: %w", err)
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, fmt.Errorf("extract profiles from list: %w", err)
+	}
+
+	requests := make([]reconcile.Request, 0, len(items))
+	for _, item := range items {
+		obj, err := meta.Accessor(item)
+		if err != nil {
+			return nil, fmt.Errorf("access profile metadata: %w", err)
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      obj.GetName(),
+				Namespace: obj.GetNamespace(),
+			},
+		})
+	}
+	return requests, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func node(name, resync string) *corev1.Node {
+	n := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if resync != "" {
+		n.Annotations = map[string]string{config.NodeResyncAnnotationKey: resync}
+	}
+	return n
+}
+
+func TestNodeResyncPredicate(t *testing.T) {
+	t.Setenv(config.NodeNameEnvKey, "node")
+
+	cases := []struct {
+		name  string
+		event event.UpdateEvent
+		want  bool
+	}{
+		{
+			name:  "NilObjects",
+			event: event.UpdateEvent{},
+			want:  false,
+		},
+		{
+			name: "OtherNode",
+			event: event.UpdateEvent{
+				ObjectOld: node("other", ""),
+				ObjectNew: node("other", "1"),
+			},
+			want: false,
+		},
+		{
+			name: "NoAnnotation",
+			event: event.UpdateEvent{
+				ObjectOld: node("node", ""),
+				ObjectNew: node("node", ""),
+			},
+			want: false,
+		},
+		{
+			name: "SameAnnotation",
+			event: event.UpdateEvent{
+				ObjectOld: node("node", "1"),
+				ObjectNew: node("node", "1"),
+			},
+			want: false,
+		},
+		{
+			name: "NewAnnotation",
+			event: event.UpdateEvent{
+				ObjectOld: node("node", ""),
+				ObjectNew: node("node", "1"),
+			},
+			want: true,
+		},
+		{
+			name: "ChangedAnnotation",
+			event: event.UpdateEvent{
+				ObjectOld: node("node", "1"),
+				ObjectNew: node("node", "2"),
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			predicate := NodeResyncPredicate{}
+			require.Equal(t, tc.want, predicate.Update(tc.event))
+		})
+	}
+
+	require.False(t, NodeResyncPredicate{}.Create(event.CreateEvent{Object: node("node", "1")}))
+}
+
+func TestResyncRequests(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&seccompprofileapi.SeccompProfile{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns1"}},
+		&seccompprofileapi.SeccompProfile{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns2"}},
+	).Build()
+
+	requests, err := ResyncRequests(context.Background(), cli, &seccompprofileapi.SeccompProfileList{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: "a", Namespace: "ns1"}},
+		{NamespacedName: types.NamespacedName{Name: "b", Namespace: "ns2"}},
+	}, requests)
+}
@@ -17,28 +17,43 @@ limitations under the License.
 package enricher
 
 import (
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 )
 
 // type IDs are defined at https://elixir.bootlin.com/linux/latest/source/include/uapi/linux/audit.h
+//
+// The records are matched independently of any prefix, which allows parsing
+// lines written by auditd, the kernel via printk (dmesg, /dev/kmsg or syslog,
+// which use the numeric type and may be prefixed by kernel timestamps) as well
+// as journald (which omits the audit timestamp).
 var (
 	seccompLineRegex = regexp.MustCompile(
-		`(type=SECCOMP|audit:.+type=1326).+audit\((.+)\).+pid=(\b\d+\b).+exe="(.+)".+syscall=(\b\d+\b).*`,
+		`(?:type=(?:SECCOMP|1326)|audit\[\d+\]: SECCOMP)` + auditTimestampRegex +
+			`.*\bpid=(\d+)\b.+\bexe=("[^"]*"|[0-9A-F]+|\(null\)).+\bsyscall=(\d+)\b`,
 	)
 	selinuxLineRegex = regexp.MustCompile(
-		`type=AVC.+audit\((.+)\).+{ (.+) }.+pid=(\b\d+\b).*scontext=(.+) tcontext=(.+) tclass=(\b\w+\b).*`,
+		`(?:type=(?:AVC|1400)|audit\[\d+\]: AVC)` + auditTimestampRegex +
+			`.*{ (.+) }.+\bpid=(\d+)\b.*scontext=(\S+) tcontext=(\S+) tclass=(\w+)`,
 	)
 	apparmorLineRegex = regexp.MustCompile(
-		//nolint:lll // no need to wrap regex
-		`(type=APPARMOR|audit:.+type=1400).+audit\((.+)\).+apparmor="(.+)".+operation="([a-zA-Z0-9\/\-\_]+)"\s(?:info.+)?profile="(.+)".+name="(.+)".+pid=(\b\d+\b).+comm="([a-zA-Z0-9\/\-\_]+)"\s?(.*)?`,
+		`(type=(?:APPARMOR\w*|AVC|1400)|audit\[\d+\]: (?:APPARMOR\w*|AVC))` + auditTimestampRegex +
+			//nolint:lll // no need to wrap regex
+			`.*apparmor="(.+)".+operation="([a-zA-Z0-9\/\-\_]+)"\s(?:info.+)?profile="(.+)".+name="(.+)".+pid=(\b\d+\b).+comm="([a-zA-Z0-9\/\-\_]+)"\s?(.*)?`,
 	)
+	apparmorRequestedMaskRegex = regexp.MustCompile(`\brequested_mask="([^"]*)"`)
 )
 
+// auditTimestampRegex matches the optional audit timestamp and serial of a
+// record, for example: msg=audit(1613173578.156:2945):
+const auditTimestampRegex = `(?:\s+(?:msg=)?audit\(([^)]+)\):)?`
+
 var (
 	minSeccompCapturesExpected  = 5
 	minSelinuxCapturesExpected  = 7
@@ -58,7 +73,11 @@ func IsAuditLine(logLine string) bool {
 	}
 
 	captures = apparmorLineRegex.FindStringSubmatch(logLine)
-	return len(captures) >= minAppArmorCapturesExpected
+	if len(captures) >= minAppArmorCapturesExpected {
+		return true
+	}
+
+	return parsers.parse(logLine) != nil
 }
 
 // ExtractAuditLine extracts an auditline from logLine.
@@ -75,6 +94,10 @@ func ExtractAuditLine(logLine string) (*types.AuditLine, error) {
 		return apparmor, nil
 	}
 
+	if line := parsers.parse(logLine); line != nil {
+		return line, nil
+	}
+
 	return nil, fmt.Errorf("unsupported log line: %s", logLine)
 }
 
@@ -86,9 +109,10 @@ func extractSeccompLine(logLine string) *types.AuditLine {
 
 	line := types.AuditLine{}
 	line.AuditType = types.AuditTypeSeccomp
-	line.TimestampID = captures[2]
-	line.Executable = captures[4]
-	if v, err := strconv.Atoi(captures[3]); err == nil {
+	line.TimestampID = captures[1]
+	line.Timestamp = auditTimestamp(line.TimestampID)
+	line.Executable = auditString(captures[3])
+	if v, err := strconv.Atoi(captures[2]); err == nil {
 		line.ProcessID = v
 	}
 
@@ -96,7 +120,7 @@ func extractSeccompLine(logLine string) *types.AuditLine {
 		base    = 10
 		bitSize = 32
 	)
-	if v, err := strconv.ParseInt(captures[5], base, bitSize); err == nil {
+	if v, err := strconv.ParseInt(captures[4], base, bitSize); err == nil {
 		line.SystemCallID = int32(v)
 	}
 
@@ -112,6 +136,7 @@ func extractSelinuxLine(logLine string) *types.AuditLine {
 	line := types.AuditLine{}
 	line.AuditType = types.AuditTypeSelinux
 	line.TimestampID = captures[1]
+	line.Timestamp = auditTimestamp(line.TimestampID)
 	line.Perm = captures[2]
 	if v, err := strconv.Atoi(captures[3]); err == nil {
 		line.ProcessID = v
@@ -132,6 +157,7 @@ func extractApparmorLine(logLine string) *types.AuditLine {
 	line := types.AuditLine{}
 	line.AuditType = types.AuditTypeApparmor
 	line.TimestampID = captures[2]
+	line.Timestamp = auditTimestamp(line.TimestampID)
 	line.Apparmor = captures[3]
 	line.Operation = captures[4]
 	line.Profile = captures[5]
@@ -142,7 +168,70 @@ func extractApparmorLine(logLine string) *types.AuditLine {
 	}
 
 	if len(captures) > minAppArmorCapturesExpected {
+		if mask := apparmorRequestedMaskRegex.FindStringSubmatch(captures[9]); mask != nil {
+			line.RequestedMask = mask[1]
+		}
 		line.ExtraInfo = strings.ReplaceAll(captures[9], "\"", "'")
 	}
 	return &line
 }
+
+// auditString decodes an untrusted string field of an audit record, which is
+// either quoted, hex encoded if it contains special characters, or (null).
+func auditString(value string) string {
+	if unquoted, ok := strings.CutPrefix(value, `"`); ok {
+		return strings.TrimSuffix(unquoted, `"`)
+	}
+	if value == "(null)" {
+		return ""
+	}
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		return value
+	}
+	return string(decoded)
+}
+
+// auditTimestamp parses the time of an audit record from its timestamp ID,
+// which has the format <seconds>.<milliseconds>:<serial>. The seconds are
+// relative to the Unix epoch, which makes the result independent of the time
+// zone of the node. A zero time is returned if the ID cannot be parsed.
+func auditTimestamp(timestampID string) time.Time {
+	ts, _, _ := strings.Cut(timestampID, ":")
+	secs, millis, _ := strings.Cut(ts, ".")
+
+	sec, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil || sec <= 0 {
+		return time.Time{}
+	}
+
+	var ms int64
+	if millis != "" {
+		// The kernel always uses three digits, but be tolerant
+		const digits = 3
+		millis = (millis + "000")[:digits]
+		if ms, err = strconv.ParseInt(millis, 10, 64); err != nil {
+			return time.Time{}
+		}
+	}
+
+	return time.Unix(sec, ms*int64(time.Millisecond)).UTC()
+}
+
+// eventTime returns the time of the audit line, or now if the record did not
+// contain a timestamp.
+func eventTime(line *types.AuditLine, now time.Time) time.Time {
+	if line.Timestamp.IsZero() {
+		return now.UTC()
+	}
+	return line.Timestamp
+}
+
+// auditLag returns the time in seconds since the audit line has been
+// written, or zero if the line has no timestamp.
+func auditLag(line *types.AuditLine, now time.Time) float64 {
+	if line.Timestamp.IsZero() {
+		return 0
+	}
+	return now.Sub(line.Timestamp).Seconds()
+}
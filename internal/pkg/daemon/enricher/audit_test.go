@@ -19,6 +19,7 @@ package enricher
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -111,6 +112,7 @@ func Test_extractAuditLine(t *testing.T) {
 			&types.AuditLine{
 				AuditType:    "seccomp",
 				TimestampID:  "1612299677.115:549067",
+				Timestamp:    time.UnixMilli(1612299677115).UTC(),
 				SystemCallID: 0,
 				ProcessID:    3109464,
 				Executable:   "/bin/busybox",
@@ -124,6 +126,7 @@ func Test_extractAuditLine(t *testing.T) {
 			&types.AuditLine{
 				AuditType:    "seccomp",
 				TimestampID:  "1613596317.899:6461",
+				Timestamp:    time.UnixMilli(1613596317899).UTC(),
 				SystemCallID: 3,
 				ProcessID:    2039886,
 				Executable:   "/bin/ls",
@@ -137,6 +140,7 @@ func Test_extractAuditLine(t *testing.T) {
 			&types.AuditLine{
 				AuditType:    "selinux",
 				TimestampID:  "1613173578.156:2945",
+				Timestamp:    time.UnixMilli(1613173578156).UTC(),
 				SystemCallID: 0,
 				ProcessID:    75593,
 				Executable:   "",
@@ -154,6 +158,7 @@ func Test_extractAuditLine(t *testing.T) {
 			&types.AuditLine{
 				AuditType:    "selinux",
 				TimestampID:  "1666691794.882:1434",
+				Timestamp:    time.UnixMilli(1666691794882).UTC(),
 				SystemCallID: 0,
 				ProcessID:    94509,
 				Executable:   "",
@@ -171,6 +176,7 @@ func Test_extractAuditLine(t *testing.T) {
 			&types.AuditLine{
 				AuditType:   "apparmor",
 				TimestampID: "1668191154.949:64",
+				Timestamp:   time.UnixMilli(1668191154949).UTC(),
 				ProcessID:   4166,
 				Apparmor:    "DENIED",
 				Operation:   "exec",
@@ -184,16 +190,105 @@ func Test_extractAuditLine(t *testing.T) {
 			"Should extract apparmor long log lines",
 			//nolint:lll // no need to wrap
 			`audit: type=1400 audit(1668191154.949:64): apparmor="DENIED" operation="exec" profile="profile-name" name="/usr/local/bin/sample-app" pid=4166 comm="tini" requested_mask="x" denied_mask="x" fsuid=65534 ouid=0`,
+			&types.AuditLine{
+				AuditType:     "apparmor",
+				TimestampID:   "1668191154.949:64",
+				Timestamp:     time.UnixMilli(1668191154949).UTC(),
+				ProcessID:     4166,
+				Apparmor:      "DENIED",
+				Operation:     "exec",
+				Profile:       "profile-name",
+				Name:          "/usr/local/bin/sample-app",
+				Executable:    "tini",
+				ExtraInfo:     "requested_mask='x' denied_mask='x' fsuid=65534 ouid=0",
+				RequestedMask: "x",
+			},
+			nil,
+		},
+		{
+			"Should extract seccomp log lines from /dev/kmsg",
+			//nolint:lll // no need to wrap
+			`5,2331,1240432112,-;audit: type=1326 audit(1625740283.502:574): auid=4294967295 uid=0 gid=0 ses=4294967295 pid=4709 comm="sh" exe="/bin/busybox" sig=0 arch=c000003e syscall=13 compat=0 ip=0x7f3c012e467b code=0x7ffc0000`,
+			&types.AuditLine{
+				AuditType:    "seccomp",
+				TimestampID:  "1625740283.502:574",
+				Timestamp:    time.UnixMilli(1625740283502).UTC(),
+				SystemCallID: 13,
+				ProcessID:    4709,
+				Executable:   "/bin/busybox",
+			},
+			nil,
+		},
+		{
+			"Should extract seccomp log lines from syslog without audit prefix",
+			//nolint:lll // no need to wrap
+			`Oct 19 10:20:30 rhel7 kernel: type=1326 audit(1625740283.502:574): auid=4294967295 uid=0 gid=0 ses=4294967295 subj=system_u:system_r:spc_t:s0 pid=4709 comm="sh" exe="/bin/busybox" sig=0 arch=c000003e syscall=13 compat=0 ip=0x7f3c012e467b code=0x7ffc0000`,
+			&types.AuditLine{
+				AuditType:    "seccomp",
+				TimestampID:  "1625740283.502:574",
+				Timestamp:    time.UnixMilli(1625740283502).UTC(),
+				SystemCallID: 13,
+				ProcessID:    4709,
+				Executable:   "/bin/busybox",
+			},
+			nil,
+		},
+		{
+			"Should extract seccomp log lines from journald",
+			//nolint:lll // no need to wrap
+			`Oct 19 10:20:30 fedora audit[4709]: SECCOMP auid=4294967295 uid=0 gid=0 ses=4294967295 subj=system_u:system_r:spc_t:s0 pid=4709 comm="sh" exe="/bin/busybox" sig=0 arch=c000003e syscall=13 compat=0 ip=0x7f3c012e467b code=0x7ffc0000`,
+			&types.AuditLine{
+				AuditType:    "seccomp",
+				SystemCallID: 13,
+				ProcessID:    4709,
+				Executable:   "/bin/busybox",
+			},
+			nil,
+		},
+		{
+			"Should extract seccomp log lines with hex encoded executable",
+			//nolint:lll // no need to wrap
+			`[  270.853767] audit: type=1326 audit(1625740283.502:574): auid=4294967295 uid=0 gid=0 ses=4294967295 ppid=1 pid=4709 comm="my app" exe=2F7573722F62696E2F6D7920617070 sig=0 arch=c000003e syscall=13 compat=0 ip=0x7f3c012e467b code=0x7ffc0000`,
+			&types.AuditLine{
+				AuditType:    "seccomp",
+				TimestampID:  "1625740283.502:574",
+				Timestamp:    time.UnixMilli(1625740283502).UTC(),
+				SystemCallID: 13,
+				ProcessID:    4709,
+				Executable:   "/usr/bin/my app",
+			},
+			nil,
+		},
+		{
+			"Should extract selinux log lines from the kernel ring buffer",
+			//nolint:lll // no need to wrap
+			`[ 1234.567890] audit: type=1400 audit(1613173578.156:2945): avc:  denied  { read } for  pid=75593 comm="security-profil" name="token" dev="tmpfs" ino=612459 scontext=system_u:system_r:container_t:s0:c4,c808 tcontext=system_u:object_r:var_lib_t:s0 tclass=lnk_file permissive=0`,
+			&types.AuditLine{
+				AuditType:   "selinux",
+				TimestampID: "1613173578.156:2945",
+				Timestamp:   time.UnixMilli(1613173578156).UTC(),
+				ProcessID:   75593,
+				Perm:        "read",
+				Scontext:    "system_u:system_r:container_t:s0:c4,c808",
+				Tcontext:    "system_u:object_r:var_lib_t:s0",
+				Tclass:      "lnk_file",
+			},
+			nil,
+		},
+		{
+			"Should extract apparmor log lines from auditd",
+			//nolint:lll // no need to wrap
+			`type=AVC msg=audit(1668191154.949:64): apparmor="DENIED" operation="exec" profile="profile-name" name="/usr/local/bin/sample-app" pid=4166 comm="tini"`,
 			&types.AuditLine{
 				AuditType:   "apparmor",
 				TimestampID: "1668191154.949:64",
+				Timestamp:   time.UnixMilli(1668191154949).UTC(),
 				ProcessID:   4166,
 				Apparmor:    "DENIED",
 				Operation:   "exec",
 				Profile:     "profile-name",
 				Name:        "/usr/local/bin/sample-app",
 				Executable:  "tini",
-				ExtraInfo:   "requested_mask='x' denied_mask='x' fsuid=65534 ouid=0",
 			},
 			nil,
 		},
@@ -215,3 +310,65 @@ func Test_extractAuditLine(t *testing.T) {
 		})
 	}
 }
+
+func Test_auditLag(t *testing.T) {
+	t.Parallel()
+
+	now := time.UnixMilli(1668191160949)
+	tests := []struct {
+		name        string
+		timestampID string
+		want        float64
+	}{
+		{"Should calculate the lag", "1668191154.949:64", 6},
+		{"Should ignore an invalid timestamp", "invalid:64", 0},
+		{"Should ignore an empty timestamp", "", 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := auditLag(&types.AuditLine{Timestamp: auditTimestamp(tt.timestampID)}, now)
+			require.InDelta(t, tt.want, got, 0.001)
+		})
+	}
+}
+
+func Test_auditTimestamp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		timestampID string
+		want        time.Time
+	}{
+		{"Should parse seconds and milliseconds", "1668191154.949:64", time.UnixMilli(1668191154949)},
+		{"Should parse a timestamp without serial", "1668191154.949", time.UnixMilli(1668191154949)},
+		{"Should parse a timestamp without milliseconds", "1668191154:64", time.Unix(1668191154, 0)},
+		{"Should ignore an invalid timestamp", "invalid:64", time.Time{}},
+		{"Should ignore invalid milliseconds", "1668191154.abc:64", time.Time{}},
+		{"Should ignore a zero timestamp", "0.000:0", time.Time{}},
+		{"Should ignore an empty timestamp", "", time.Time{}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := auditTimestamp(tt.timestampID)
+			require.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+			if !got.IsZero() {
+				require.Equal(t, time.UTC, got.Location())
+			}
+		})
+	}
+}
+
+func Test_eventTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.UnixMilli(1668191160949)
+	ts := time.UnixMilli(1668191154949).UTC()
+
+	require.Equal(t, ts, eventTime(&types.AuditLine{Timestamp: ts}, now))
+	require.Equal(t, now.UTC(), eventTime(&types.AuditLine{}, now))
+}
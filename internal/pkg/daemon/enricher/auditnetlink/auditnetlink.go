@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package auditnetlink receives audit records directly from the kernel audit
+// netlink socket, which does not require auditd to write them to a file.
+package auditnetlink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nxadm/tail"
+)
+
+const (
+	// netlinkHeaderLen is the size of the netlink message header (nlmsghdr).
+	netlinkHeaderLen = 16
+
+	// auditBufferSize is the receive buffer size for audit netlink messages,
+	// which is MAX_AUDIT_MESSAGE_LENGTH of the kernel.
+	auditBufferSize = 8970
+)
+
+// auditRecordTypes are the audit record types the log enricher is interested in,
+// mapped to the names auditd would use when writing them to the log file.
+var auditRecordTypes = map[uint16]string{
+	1326: "SECCOMP",
+	1400: "AVC",
+	1500: "APPARMOR",
+	1501: "APPARMOR_AUDIT",
+	1502: "APPARMOR_ALLOWED",
+	1503: "APPARMOR_DENIED",
+	1504: "APPARMOR_HINT",
+	1505: "APPARMOR_STATUS",
+	1506: "APPARMOR_ERROR",
+}
+
+// errOverrun is returned by the receive function if the kernel dropped
+// audit messages because the socket buffer was full.
+var errOverrun = errors.New("audit netlink receive buffer overrun")
+
+// Subscription provides the audit records received from the kernel audit
+// netlink socket in the same form as tailing the audit log.
+type Subscription struct {
+	recv  func([]byte) (int, error)
+	close func() error
+	lines chan *tail.Line
+	err   error
+}
+
+func newSubscription(recv func([]byte) (int, error), closeFn func() error) *Subscription {
+	s := &Subscription{
+		recv:  recv,
+		close: closeFn,
+		lines: make(chan *tail.Line),
+	}
+	go s.receive()
+	return s
+}
+
+// receive reads audit messages until the socket fails and converts them into
+// log lines. The lines channel gets closed on failure.
+func (s *Subscription) receive() {
+	defer close(s.lines)
+
+	buf := make([]byte, auditBufferSize)
+	for {
+		n, err := s.recv(buf)
+		if errors.Is(err, errOverrun) {
+			s.lines <- &tail.Line{Err: err, Time: time.Now()}
+			continue
+		}
+		if err != nil {
+			s.err = fmt.Errorf("receive audit message: %w", err)
+			if closeErr := s.close(); closeErr != nil {
+				s.err = errors.Join(s.err, fmt.Errorf("close audit netlink socket: %w", closeErr))
+			}
+			return
+		}
+
+		for _, line := range parseMessages(buf[:n]) {
+			s.lines <- &tail.Line{Text: line, Time: time.Now()}
+		}
+	}
+}
+
+// Lines returns the channel of received audit lines, which gets closed if
+// the subscription failed.
+func (s *Subscription) Lines() chan *tail.Line {
+	return s.lines
+}
+
+// Err returns the reason why receiving audit messages stopped.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// parseMessages extracts the relevant audit records of a netlink
+// datagram and formats them like auditd does, for example:
+// type=SECCOMP msg=audit(1624537480.360:8477): pid=2060394 ...
+func parseMessages(b []byte) []string {
+	lines := []string{}
+	for len(b) >= netlinkHeaderLen {
+		msgLen := int(binary.NativeEndian.Uint32(b[0:4]))
+		msgType := binary.NativeEndian.Uint16(b[4:6])
+
+		// The kernel does not always set the message length correctly, so
+		// treat the rest of the datagram as payload in that case.
+		if msgLen < netlinkHeaderLen || msgLen > len(b) {
+			msgLen = len(b)
+		}
+
+		if name, ok := auditRecordTypes[msgType]; ok {
+			data := bytes.TrimRight(b[netlinkHeaderLen:msgLen], "\x00\n")
+			lines = append(lines, fmt.Sprintf("type=%s msg=%s", name, data))
+		}
+
+		// Messages are aligned to 4 bytes (NLMSG_ALIGN)
+		aligned := (msgLen + 3) &^ 3
+		if aligned >= len(b) {
+			break
+		}
+		b = b[aligned:]
+	}
+	return lines
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditnetlink
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errTest = errors.New("test")
+
+const (
+	seccompRecord = `audit(1624537480.360:8477): auid=1000 uid=0 gid=0 ses=1 ` +
+		`subj=kernel pid=2060394 comm="sleep" exe="/bin/busybox" sig=0 ` +
+		`arch=c000003e syscall=10 compat=0 ip=0x7f4ce626349b code=0x7ffc0000`
+	syscallRecord = `audit(1624537480.360:8478): arch=c000003e syscall=59 success=yes`
+)
+
+func message(msgType uint16, data string, msgLen int) []byte {
+	payload := append([]byte(data), 0)
+	if msgLen == 0 {
+		msgLen = netlinkHeaderLen + len(payload)
+	}
+
+	msg := make([]byte, netlinkHeaderLen, netlinkHeaderLen+len(payload)+3)
+	binary.NativeEndian.PutUint32(msg[0:4], uint32(msgLen))
+	binary.NativeEndian.PutUint16(msg[4:6], msgType)
+	msg = append(msg, payload...)
+	for len(msg)%4 != 0 {
+		msg = append(msg, 0)
+	}
+	return msg
+}
+
+func TestParseMessages(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		datagram []byte
+		expected []string
+	}{
+		{
+			name:     "seccomp",
+			datagram: message(1326, seccompRecord, 0),
+			expected: []string{"type=SECCOMP msg=" + seccompRecord},
+		},
+		{
+			name:     "unrelated record type",
+			datagram: message(1300, syscallRecord, 0),
+			expected: []string{},
+		},
+		{
+			name: "multiple messages",
+			datagram: append(
+				message(1300, syscallRecord, 0),
+				message(1400, "audit(1613173578.156:2945): avc:  denied", 0)...,
+			),
+			expected: []string{"type=AVC msg=audit(1613173578.156:2945): avc:  denied"},
+		},
+		{
+			name:     "wrong message length",
+			datagram: message(1503, `audit(1.2:3): apparmor="DENIED"`, 4),
+			expected: []string{`type=APPARMOR_DENIED msg=audit(1.2:3): apparmor="DENIED"`},
+		},
+		{
+			name:     "truncated header",
+			datagram: []byte{1, 2, 3},
+			expected: []string{},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, parseMessages(tc.datagram))
+		})
+	}
+}
+
+func TestSubscription(t *testing.T) {
+	t.Parallel()
+
+	// A nil datagram simulates a receive buffer overrun
+	datagrams := [][]byte{message(1326, seccompRecord, 0), nil}
+	closed := false
+	sub := newSubscription(func(buf []byte) (int, error) {
+		if len(datagrams) == 0 {
+			return 0, errTest
+		}
+		if datagrams[0] == nil {
+			datagrams = datagrams[1:]
+			return 0, errOverrun
+		}
+		n := copy(buf, datagrams[0])
+		datagrams = datagrams[1:]
+		return n, nil
+	}, func() error {
+		closed = true
+		return nil
+	})
+
+	line := <-sub.Lines()
+	require.NoError(t, line.Err)
+	require.Equal(t, "type=SECCOMP msg="+seccompRecord, line.Text)
+
+	line = <-sub.Lines()
+	require.ErrorIs(t, line.Err, errOverrun)
+
+	_, ok := <-sub.Lines()
+	require.False(t, ok)
+	require.ErrorIs(t, sub.Err(), errTest)
+	require.True(t, closed)
+}
@@ -0,0 +1,97 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditnetlink
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+
+	"golang.org/x/sys/unix"
+)
+
+// hostNetNamespace is the network namespace of the host init process. The
+// kernel only multicasts audit records to netlink sockets of the initial
+// network namespace, which is reachable because the log enricher uses the host
+// PID namespace.
+const hostNetNamespace = "/proc/1/ns/net"
+
+// Subscribe opens an audit netlink socket in the host network namespace
+// and joins the read-only audit multicast group.
+func Subscribe() (*Subscription, error) {
+	fd, err := hostAuditSocket()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{
+		Family: unix.AF_NETLINK,
+		Groups: unix.AUDIT_NLGRP_READLOG,
+	}); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("join audit multicast group: %w", err)
+	}
+
+	recv := func(buf []byte) (int, error) {
+		for {
+			n, _, err := unix.Recvfrom(fd, buf, 0)
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+			if errors.Is(err, unix.ENOBUFS) {
+				return 0, errOverrun
+			}
+			return n, err
+		}
+	}
+
+	return newSubscription(recv, func() error { return unix.Close(fd) }), nil
+}
+
+// hostAuditSocket creates the audit netlink socket from a dedicated OS thread
+// which switches into the host network namespace. The socket stays bound to
+// that namespace, while the thread is never unlocked and therefore discarded
+// by the runtime once the goroutine exits.
+func hostAuditSocket() (fd int, err error) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runtime.LockOSThread()
+
+		nsFd, openErr := unix.Open(hostNetNamespace, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+		if openErr != nil {
+			err = fmt.Errorf("open host network namespace: %w", openErr)
+			return
+		}
+		defer unix.Close(nsFd)
+
+		if setnsErr := unix.Setns(nsFd, unix.CLONE_NEWNET); setnsErr != nil {
+			err = fmt.Errorf("enter host network namespace: %w", setnsErr)
+			return
+		}
+
+		fd, err = unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_AUDIT)
+		if err != nil {
+			err = fmt.Errorf("create audit netlink socket: %w", err)
+		}
+	}()
+	<-done
+	return fd, err
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+// backfillMaxLineSize is the maximum size of a single line when replaying the
+// audit log file.
+const backfillMaxLineSize = 1024 * 1024
+
+// backfill replays the audit log file from the start of the container up to
+// the provided time to record the syscalls the enricher missed before it
+// noticed the recording, for example the ones issued during the container
+// startup. It runs only once per container and only if the enricher reads
+// from a file, whereas only the current file is replayed and not the rotated
+// ones.
+func (e *Enricher) backfill(info *types.ContainerInfo, until time.Time) {
+	if e.backfillFile == "" || info.RecordProfile == "" {
+		return
+	}
+	if e.backfilled.Has(info.ContainerID) {
+		return
+	}
+	e.backfilled.Set(info.ContainerID, struct{}{}, ttlcache.DefaultTTL)
+
+	if info.StartTime.IsZero() {
+		e.logger.Info(
+			"Skipping backfill because the container start time is unknown",
+			"profile", info.RecordProfile,
+			"containerID", info.ContainerID,
+		)
+		return
+	}
+
+	recorded, err := e.replayAuditLog(info, info.StartTime.Add(-clockSkewTolerance), until)
+	if err != nil {
+		e.logger.Error(err, "unable to backfill recorded profile", "profile", info.RecordProfile)
+		return
+	}
+
+	e.logger.Info(
+		"Backfilled recorded profile",
+		"profile", info.RecordProfile,
+		"containerID", info.ContainerID,
+		"lines", recorded,
+	)
+}
+
+// replayAuditLog records all audit lines of the container between from and
+// until and returns the amount of recorded lines.
+func (e *Enricher) replayAuditLog(info *types.ContainerInfo, from, until time.Time) (recorded int, err error) {
+	file, err := e.Open(e.backfillFile)
+	if err != nil {
+		return 0, fmt.Errorf("open audit log: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), backfillMaxLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !IsAuditLine(line) {
+			continue
+		}
+
+		auditLine, err := ExtractAuditLine(line)
+		if err != nil || auditLine.Timestamp.IsZero() || auditLine.Timestamp.Before(from) {
+			continue
+		}
+		if auditLine.Timestamp.After(until) {
+			break
+		}
+
+		// Processes which already exited cannot be resolved anymore
+		cID, _, err := e.ContainerIDForPID(e.containerIDCache, e.resolvers, auditLine.ProcessID)
+		if err != nil || cID != info.ContainerID {
+			continue
+		}
+
+		if err := e.recordAuditLine(auditLine, info); err != nil {
+			e.logger.V(config.VerboseLevel).Info("Not backfilling audit line", "reason", err.Error())
+			continue
+		}
+		recorded++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return recorded, fmt.Errorf("read audit log: %w", err)
+	}
+
+	return recorded, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+func TestBackfill(t *testing.T) {
+	t.Parallel()
+
+	const (
+		testProfile = "test-profile"
+		backfillPID = 2060394
+		otherPID    = 4242
+	)
+	start := time.Unix(1624537480, 0)
+	until := start.Add(time.Minute)
+
+	auditLog := strings.Join([]string{
+		backfillLine(start.Add(-time.Hour), backfillPID, 1),
+		"not an audit line",
+		backfillLine(start.Add(time.Second), backfillPID, 10),
+		backfillLine(start.Add(2*time.Second), otherPID, 60),
+		backfillLine(start.Add(3*time.Second), backfillPID, 59),
+		backfillLine(until.Add(time.Second), backfillPID, 3),
+	}, "\n")
+
+	for _, tc := range []struct {
+		name             string
+		backfillFile     string
+		startTime        time.Time
+		runs             int
+		prepare          func(*enricherfakes.FakeImpl)
+		expectedOpens    int
+		expectedSyscalls []string
+	}{
+		{
+			name:             "replays lines of the container since its start",
+			backfillFile:     "/var/log/audit/audit.log",
+			startTime:        start,
+			runs:             1,
+			expectedOpens:    1,
+			expectedSyscalls: []string{"mprotect", "execve"},
+		},
+		{
+			name:             "replays only once per container",
+			backfillFile:     "/var/log/audit/audit.log",
+			startTime:        start,
+			runs:             2,
+			expectedOpens:    1,
+			expectedSyscalls: []string{"mprotect", "execve"},
+		},
+		{
+			name:      "disabled",
+			startTime: start,
+			runs:      1,
+		},
+		{
+			name:         "unknown container start time",
+			backfillFile: "/var/log/audit/audit.log",
+			runs:         1,
+		},
+		{
+			name:         "failure on open",
+			backfillFile: "/var/log/audit/audit.log",
+			startTime:    start,
+			runs:         1,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.OpenReturns(nil, errTest)
+			},
+			expectedOpens: 1,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &enricherfakes.FakeImpl{}
+			mock.OpenStub = func(string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(auditLog)), nil
+			}
+			mock.ContainerIDForPIDStub = func(
+				_ *ttlcache.Cache[string, string], _ []util.ContainerIDResolver, pid int,
+			) (string, string, error) {
+				if pid == backfillPID {
+					return containerID, "", nil
+				}
+				return "other", "", nil
+			}
+			if tc.prepare != nil {
+				tc.prepare(mock)
+			}
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+			sut.backfillFile = tc.backfillFile
+			info := &types.ContainerInfo{
+				ContainerID:   containerID,
+				RecordProfile: testProfile,
+				StartTime:     tc.startTime,
+			}
+
+			for i := 0; i < tc.runs; i++ {
+				sut.backfill(info, until)
+			}
+
+			require.Equal(t, tc.expectedOpens, mock.OpenCallCount())
+			s, ok := sut.syscalls.Load(testProfile)
+			if len(tc.expectedSyscalls) == 0 {
+				require.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			syscalls, ok := s.(sets.Set[string])
+			require.True(t, ok)
+			require.ElementsMatch(t, tc.expectedSyscalls, syscalls.UnsortedList())
+		})
+	}
+}
+
+func backfillLine(timestamp time.Time, pid, syscallID int) string {
+	return fmt.Sprintf(
+		`type=SECCOMP msg=audit(%d.000:1): pid=%d comm="sleep" exe="%s" sig=0 arch=c000003e syscall=%d`,
+		timestamp.Unix(), pid, executable, syscallID,
+	)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"fmt"
+	"time"
+
+	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+)
+
+const (
+	// auditBatchSize is the maximum amount of audit requests sent to the
+	// metrics server within a single message.
+	auditBatchSize = 100
+
+	// auditBatchInterval is the maximum time an audit request gets buffered
+	// before being sent to the metrics server.
+	auditBatchInterval time.Duration = time.Second
+)
+
+// queueAuditRequest adds the request to the current batch, which gets sent
+// to the metrics server as soon as it reaches auditBatchSize.
+func (e *Enricher) queueAuditRequest(
+	client apimetrics.Metrics_AuditBatchIncClient, req *apimetrics.AuditRequest,
+) error {
+	e.auditBatchMu.Lock()
+	defer e.auditBatchMu.Unlock()
+
+	e.auditBatch = append(e.auditBatch, req)
+	if len(e.auditBatch) < auditBatchSize {
+		return nil
+	}
+
+	return e.sendAuditBatch(client)
+}
+
+// flushAuditBatch sends all buffered audit requests to the metrics server.
+func (e *Enricher) flushAuditBatch(client apimetrics.Metrics_AuditBatchIncClient) {
+	e.auditBatchMu.Lock()
+	defer e.auditBatchMu.Unlock()
+
+	if err := e.sendAuditBatch(client); err != nil {
+		e.logger.Error(err, "unable to update metrics")
+	}
+}
+
+// sendAuditBatch requires the auditBatchMu to be locked.
+func (e *Enricher) sendAuditBatch(client apimetrics.Metrics_AuditBatchIncClient) error {
+	if len(e.auditBatch) == 0 {
+		return nil
+	}
+
+	// A failed batch is dropped to not let the buffer grow unbounded.
+	batch := &apimetrics.AuditBatchRequest{Requests: e.auditBatch}
+	e.auditBatch = nil
+
+	if err := e.SendMetric(client, batch); err != nil {
+		return fmt.Errorf("send batch of %d audit requests: %w", len(batch.GetRequests()), err)
+	}
+
+	return nil
+}
+
+// flushSyscallStreamDrops sends the amount of syscall events dropped for
+// slow streams since the last flush to the metrics server.
+func (e *Enricher) flushSyscallStreamDrops(nodeName string, client apimetrics.Metrics_SyscallStreamDropIncClient) {
+	count := e.syscallStreamDrops.Swap(0)
+	if count == 0 {
+		return
+	}
+
+	if err := e.SendSyscallStreamDropMetric(client, &apimetrics.SyscallStreamDropRequest{
+		Node:  nodeName,
+		Count: count,
+	}); err != nil {
+		e.logger.Error(err, "unable to update metrics")
+	}
+}
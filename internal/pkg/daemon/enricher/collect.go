@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// pendingCollection is the recorded data of a profile which got collected,
+// but not yet acknowledged by resetting it with the generation of the
+// collection.
+type pendingCollection struct {
+	generation  uint64
+	values      sets.Set[string]
+	executables util.SyscallExecutables
+	scontext    string
+}
+
+// collections tracks the pending collections per recorded profile. Collecting
+// moves the recorded data atomically into the pending collection, so nothing
+// recorded in between collecting and resetting gets lost. Collecting again
+// before acknowledging returns the pending data together with everything
+// recorded since then, which makes failed collections safe to retry.
+type collections struct {
+	sync.Mutex
+	generation uint64
+	pending    map[string]*pendingCollection
+}
+
+// collect moves the recorded data of the profile into its pending collection
+// by calling take and returns a copy of the result with a new generation. It
+// returns false if there is no data to collect.
+func (c *collections) collect(profile string, take func(*pendingCollection)) (*pendingCollection, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.pending == nil {
+		c.pending = map[string]*pendingCollection{}
+	}
+
+	p, ok := c.pending[profile]
+	if !ok {
+		p = &pendingCollection{
+			values:      sets.New[string](),
+			executables: util.SyscallExecutables{},
+		}
+	}
+	take(p)
+
+	if p.values.Len() == 0 {
+		delete(c.pending, profile)
+		return nil, false
+	}
+
+	c.generation++
+	p.generation = c.generation
+	c.pending[profile] = p
+
+	executables := util.SyscallExecutables{}
+	executables.Merge(p.executables)
+	return &pendingCollection{
+		generation:  p.generation,
+		values:      p.values.Clone(),
+		executables: executables,
+		scontext:    p.scontext,
+	}, true
+}
+
+// acknowledge removes the pending collection of the profile if it matches the
+// generation. A generation of zero removes the pending collection
+// unconditionally.
+func (c *collections) acknowledge(profile string, generation uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if p, ok := c.pending[profile]; ok && (generation == 0 || p.generation == generation) {
+		delete(c.pending, profile)
+	}
+}
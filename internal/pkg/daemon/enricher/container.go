@@ -38,9 +38,23 @@ const (
 	backoffDuration  = 500 * time.Millisecond
 	backoffFactor    = 1.5
 	backoffSteps     = 10
+
+	// infoLookupSteps is the amount of pod listings when looking up the info
+	// of a container which is not yet cached, for example because the
+	// enricher got restarted or the container status did not propagate yet.
+	infoLookupSteps  = 3
+	infoLookupFactor = 2
+
+	// criTimeout is the timeout for looking up a container via the local
+	// CRI runtime.
+	criTimeout = 2 * time.Second
 )
 
-var errContainerIDEmpty = errors.New("container ID is empty")
+var (
+	errContainerIDEmpty    = errors.New("container ID is empty")
+	errContainerInfoAbsent = errors.New("no container info for container ID")
+	errCRIInfoIncomplete   = errors.New("container info of CRI runtime is incomplete")
+)
 
 // NOTE(jaosorior): Should this actually be namespace-scoped?
 //
@@ -56,16 +70,107 @@ func (e *Enricher) getContainerInfo(
 		return item.Value(), nil
 	}
 
-	if err := e.populateContainerPodCache(nodeName); err != nil {
-		return nil, fmt.Errorf("get container info for pods: %w", err)
+	// Prefer the local CRI runtime if available, which also knows short
+	// lived containers whose status did not reach the API server yet.
+	if e.criClient != nil {
+		info, err := e.criContainerInfo(targetContainerID)
+		if err == nil {
+			e.infoCache.Set(targetContainerID, info, ttlcache.DefaultTTL)
+			return info, nil
+		}
+		e.logger.V(config.VerboseLevel).Info(
+			"Falling back to API server for container info",
+			"containerID", targetContainerID, "reason", err.Error(),
+		)
 	}
 
-	item = e.infoCache.Get(targetContainerID)
-	if item != nil {
-		return item.Value(), nil
+	// Do not list the pods again if the container was not found recently
+	if e.missingInfoCache.Has(targetContainerID) {
+		return nil, errContainerInfoAbsent
+	}
+
+	lookupBackoff := wait.Backoff{
+		Duration: backoffDuration,
+		Factor:   infoLookupFactor,
+		Steps:    infoLookupSteps,
+	}
+
+	var lookupErr error
+	if err := util.RetryEx(
+		&lookupBackoff,
+		func() error {
+			if lookupErr = e.populateContainerPodCache(nodeName); lookupErr != nil {
+				return fmt.Errorf("get container info for pods: %w", lookupErr)
+			}
+
+			if item = e.infoCache.Get(targetContainerID); item == nil {
+				lookupErr = errContainerInfoAbsent
+				return lookupErr
+			}
+			return nil
+		},
+		func(err error) bool {
+			return errors.Is(err, errContainerInfoAbsent)
+		},
+	); err != nil {
+		if errors.Is(lookupErr, errContainerInfoAbsent) {
+			// The pods got listed successfully, but none of them contains
+			// the container.
+			e.missingInfoCache.Set(targetContainerID, struct{}{}, ttlcache.DefaultTTL)
+			return nil, errContainerInfoAbsent
+		}
+		return nil, err
+	}
+
+	return item.Value(), nil
+}
+
+// criContainerInfo returns the info of the container as known by the CRI
+// runtime. The runtime neither knows the security context of the containers
+// nor whether they are ephemeral, so errCRIInfoIncomplete is returned if
+// the info would depend on them.
+func (e *Enricher) criContainerInfo(containerID string) (*types.ContainerInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), criTimeout)
+	defer cancel()
+
+	ctr, err := e.CRIContainer(ctx, e.criClient, containerID)
+	if err != nil {
+		return nil, fmt.Errorf("look up container via CRI: %w", err)
+	}
+
+	annotations := ctr.PodAnnotations
+	if util.RecordProfileForContainer(
+		annotations, config.SelinuxProfileRecordLogsAnnotationKey, ctr.Name, false,
+	) != "" {
+		// The SELinux type is only part of the pod spec
+		return nil, errCRIInfoIncomplete
+	}
+
+	recordProfile := util.RecordProfileForContainer(
+		annotations, config.SeccompProfileRecordLogsAnnotationKey, ctr.Name, false,
+	)
+	if recordProfile == "" {
+		for _, prefix := range []string{
+			config.SeccompProfileRecordLogsAnnotationKey,
+			config.SelinuxProfileRecordLogsAnnotationKey,
+		} {
+			if _, ok := annotations[prefix+config.EphemeralContainersRecordName]; ok {
+				// The container may be an ephemeral one
+				return nil, errCRIInfoIncomplete
+			}
+		}
 	}
 
-	return nil, errors.New("no container info for container ID")
+	return &types.ContainerInfo{
+		PodName:          ctr.PodName,
+		PodUID:           ctr.PodUID,
+		ContainerName:    ctr.Name,
+		Namespace:        ctr.PodNamespace,
+		ContainerID:      containerID,
+		RecordProfile:    recordProfile,
+		RecordingSession: annotations[config.RecordingSessionAnnotationKey],
+		StartTime:        ctr.CreatedAt,
+	}, nil
 }
 
 func (e *Enricher) populateContainerPodCache(
@@ -109,6 +214,8 @@ func (e *Enricher) populateCacheEntryForContainer(
 	eg.Go(func() (errorToRetry error) {
 		//nolint:gocritic // This is what we expect and want
 		statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+		ephemeralStart := len(statuses)
+		statuses = append(statuses, pod.Status.EphemeralContainerStatuses...)
 
 		for c := range statuses {
 			containerStatus := statuses[c]
@@ -136,16 +243,25 @@ func (e *Enricher) populateCacheEntryForContainer(
 				continue
 			}
 
-			recordProfile, ok := pod.Annotations[config.SeccompProfileRecordLogsAnnotationKey+containerName]
-			if !ok {
-				recordProfile = pod.Annotations[config.SelinuxProfileRecordLogsAnnotationKey+containerName]
+			ephemeral := c >= ephemeralStart
+			recordProfile := util.RecordProfileForContainer(
+				pod.Annotations, config.SeccompProfileRecordLogsAnnotationKey, containerName, ephemeral,
+			)
+			if recordProfile == "" {
+				recordProfile = util.RecordProfileForContainer(
+					pod.Annotations, config.SelinuxProfileRecordLogsAnnotationKey, containerName, ephemeral,
+				)
 			}
 			info := &types.ContainerInfo{
-				PodName:       pod.Name,
-				ContainerName: containerStatus.Name,
-				Namespace:     pod.Namespace,
-				ContainerID:   rawContainerID,
-				RecordProfile: recordProfile,
+				PodName:          pod.Name,
+				PodUID:           string(pod.UID),
+				ContainerName:    containerStatus.Name,
+				Namespace:        pod.Namespace,
+				ContainerID:      rawContainerID,
+				RecordProfile:    recordProfile,
+				RecordingSession: pod.Annotations[config.RecordingSessionAnnotationKey],
+				SelinuxType:      containerSelinuxType(pod, containerName),
+				StartTime:        containerStartTime(&containerStatus),
 			}
 
 			// Update the cache
@@ -156,6 +272,36 @@ func (e *Enricher) populateCacheEntryForContainer(
 	})
 }
 
+// containerSelinuxType returns the SELinux type of the container with the
+// provided name, whereas the container security context takes precedence over
+// the one of the pod.
+func containerSelinuxType(pod *v1.Pod, containerName string) string {
+	var sc *v1.SecurityContext
+	for i := range pod.Spec.InitContainers {
+		if pod.Spec.InitContainers[i].Name == containerName {
+			sc = pod.Spec.InitContainers[i].SecurityContext
+		}
+	}
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == containerName {
+			sc = pod.Spec.Containers[i].SecurityContext
+		}
+	}
+	for i := range pod.Spec.EphemeralContainers {
+		if pod.Spec.EphemeralContainers[i].Name == containerName {
+			sc = pod.Spec.EphemeralContainers[i].SecurityContext
+		}
+	}
+
+	if sc != nil && sc.SELinuxOptions != nil && sc.SELinuxOptions.Type != "" {
+		return sc.SELinuxOptions.Type
+	}
+	if psc := pod.Spec.SecurityContext; psc != nil && psc.SELinuxOptions != nil {
+		return psc.SELinuxOptions.Type
+	}
+	return ""
+}
+
 func (e *Enricher) handleContainerIDEmpty(podName, containerName string, containerStatus *v1.ContainerStatus) error {
 	if containerStatus.State.Waiting != nil &&
 		(containerStatus.State.Waiting.Reason == "ContainerCreating" ||
@@ -173,3 +319,16 @@ func (e *Enricher) handleContainerIDEmpty(podName, containerName string, contain
 		containerStatus.State,
 	)
 }
+
+// containerStartTime returns the time the container was started, or the zero
+// time if the status does not record it yet.
+func containerStartTime(status *v1.ContainerStatus) time.Time {
+	switch {
+	case status.State.Running != nil:
+		return status.State.Running.StartedAt.Time
+	case status.State.Terminated != nil:
+		return status.State.Terminated.StartedAt.Time
+	default:
+		return time.Time{}
+	}
+}
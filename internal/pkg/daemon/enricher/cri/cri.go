@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cri looks up Kubernetes containers via the CRI runtime service of
+// the node, like containerd or CRI-O.
+package cri
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// ErrNotFound is returned if the runtime does not know the container.
+var ErrNotFound = errors.New("container not found in CRI runtime")
+
+// Container is a Kubernetes container as reported by the CRI runtime.
+type Container struct {
+	// ID is the full ID of the container.
+	ID string
+	// Name is the name of the container within its pod.
+	Name string
+	// PodName is the name of the pod of the container.
+	PodName string
+	// PodNamespace is the namespace of the pod of the container.
+	PodNamespace string
+	// PodUID is the UID of the pod of the container.
+	PodUID string
+	// PodAnnotations are the annotations of the pod, as passed by the kubelet
+	// to the pod sandbox.
+	PodAnnotations map[string]string
+	// CreatedAt is the time the container was created.
+	CreatedAt time.Time
+}
+
+// Client is a client for the CRI runtime service.
+type Client struct {
+	conn    *grpc.ClientConn
+	runtime runtimeapi.RuntimeServiceClient
+}
+
+// NewClient returns a new Client for the endpoint, for example
+// unix:///run/containerd/containerd.sock. The connection is established
+// lazily on the first lookup.
+func NewClient(endpoint string) (*Client, error) {
+	if !strings.HasPrefix(endpoint, "unix://") {
+		return nil, fmt.Errorf("unsupported CRI endpoint %q, must be a unix socket", endpoint)
+	}
+
+	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("GRPC dial: %w", err)
+	}
+
+	return &Client{
+		conn:    conn,
+		runtime: runtimeapi.NewRuntimeServiceClient(conn),
+	}, nil
+}
+
+// Close closes the connection to the runtime.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Container looks up the container with the provided ID together with its
+// pod sandbox.
+func (c *Client) Container(ctx context.Context, id string) (*Container, error) {
+	containers, err := c.runtime.ListContainers(ctx, &runtimeapi.ListContainersRequest{
+		Filter: &runtimeapi.ContainerFilter{Id: id},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+	if len(containers.Containers) == 0 {
+		return nil, ErrNotFound
+	}
+
+	ctr := containers.Containers[0]
+	if ctr.Metadata == nil {
+		return nil, fmt.Errorf("no metadata for container %s", ctr.Id)
+	}
+
+	sandbox, err := c.runtime.PodSandboxStatus(ctx, &runtimeapi.PodSandboxStatusRequest{
+		PodSandboxId: ctr.PodSandboxId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pod sandbox status: %w", err)
+	}
+	if sandbox.Status == nil || sandbox.Status.Metadata == nil {
+		return nil, fmt.Errorf("no metadata for pod sandbox %s", ctr.PodSandboxId)
+	}
+
+	return &Container{
+		ID:             ctr.Id,
+		Name:           ctr.Metadata.Name,
+		PodName:        sandbox.Status.Metadata.Name,
+		PodNamespace:   sandbox.Status.Metadata.Namespace,
+		PodUID:         sandbox.Status.Metadata.Uid,
+		PodAnnotations: sandbox.Status.Annotations,
+		CreatedAt:      time.Unix(0, ctr.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cri
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+var errTest = errors.New("test")
+
+const (
+	testContainerID = "76e1aa2b2f8ac52e4af0bcc6c5ab5a5f0db3dc0d6a3d2c4b6a1f9c8f2d3e4f5a"
+	testSandboxID   = "a3c1b2d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+	testCreatedAt   = 1624537480000000000
+)
+
+type fakeRuntime struct {
+	runtimeapi.RuntimeServiceClient
+	containers    []*runtimeapi.Container
+	containersErr error
+	sandbox       *runtimeapi.PodSandboxStatus
+	sandboxErr    error
+}
+
+func (f *fakeRuntime) ListContainers(
+	_ context.Context, in *runtimeapi.ListContainersRequest, _ ...grpc.CallOption,
+) (*runtimeapi.ListContainersResponse, error) {
+	if f.containersErr != nil {
+		return nil, f.containersErr
+	}
+	res := []*runtimeapi.Container{}
+	for _, c := range f.containers {
+		if c.Id == in.Filter.Id {
+			res = append(res, c)
+		}
+	}
+	return &runtimeapi.ListContainersResponse{Containers: res}, nil
+}
+
+func (f *fakeRuntime) PodSandboxStatus(
+	_ context.Context, in *runtimeapi.PodSandboxStatusRequest, _ ...grpc.CallOption,
+) (*runtimeapi.PodSandboxStatusResponse, error) {
+	if f.sandboxErr != nil {
+		return nil, f.sandboxErr
+	}
+	if in.PodSandboxId != testSandboxID {
+		return nil, errTest
+	}
+	return &runtimeapi.PodSandboxStatusResponse{Status: f.sandbox}, nil
+}
+
+func testRuntime() *fakeRuntime {
+	return &fakeRuntime{
+		containers: []*runtimeapi.Container{{
+			Id:           testContainerID,
+			PodSandboxId: testSandboxID,
+			Metadata:     &runtimeapi.ContainerMetadata{Name: "nginx"},
+			CreatedAt:    testCreatedAt,
+		}},
+		sandbox: &runtimeapi.PodSandboxStatus{
+			Id: testSandboxID,
+			Metadata: &runtimeapi.PodSandboxMetadata{
+				Name:      "nginx-7d4b9c",
+				Namespace: "default",
+				Uid:       "4f3c2b1a",
+			},
+			Annotations: map[string]string{"key": "value"},
+		},
+	}
+}
+
+func TestContainer(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		id      string
+		prepare func(*fakeRuntime)
+		assert  func(*Container, error)
+	}{
+		{
+			name:    "success",
+			id:      testContainerID,
+			prepare: func(*fakeRuntime) {},
+			assert: func(c *Container, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &Container{
+					ID:             testContainerID,
+					Name:           "nginx",
+					PodName:        "nginx-7d4b9c",
+					PodNamespace:   "default",
+					PodUID:         "4f3c2b1a",
+					PodAnnotations: map[string]string{"key": "value"},
+					CreatedAt:      time.Unix(0, testCreatedAt),
+				}, c)
+			},
+		},
+		{
+			name:    "failure container not found",
+			id:      "unknown",
+			prepare: func(*fakeRuntime) {},
+			assert: func(_ *Container, err error) {
+				require.ErrorIs(t, err, ErrNotFound)
+			},
+		},
+		{
+			name: "failure no container metadata",
+			id:   testContainerID,
+			prepare: func(f *fakeRuntime) {
+				f.containers[0].Metadata = nil
+			},
+			assert: func(_ *Container, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure no sandbox metadata",
+			id:   testContainerID,
+			prepare: func(f *fakeRuntime) {
+				f.sandbox.Metadata = nil
+			},
+			assert: func(_ *Container, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure on ListContainers",
+			id:   testContainerID,
+			prepare: func(f *fakeRuntime) {
+				f.containersErr = errTest
+			},
+			assert: func(_ *Container, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on PodSandboxStatus",
+			id:   testContainerID,
+			prepare: func(f *fakeRuntime) {
+				f.sandboxErr = errTest
+			},
+			assert: func(_ *Container, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		id := tc.id
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			runtime := testRuntime()
+			prepare(runtime)
+			sut := &Client{runtime: runtime}
+
+			c, err := sut.Container(context.Background(), id)
+			assert(c, err)
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient("unix:///run/containerd/containerd.sock")
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+
+	_, err = NewClient("tcp://localhost:1234")
+	require.Error(t, err)
+}
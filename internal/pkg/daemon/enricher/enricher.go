@@ -25,73 +25,201 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/nxadm/tail"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	rutil "sigs.k8s.io/release-utils/util"
 
 	apienricher "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
 	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/cri"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/journal"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
 const (
-	// defaultCacheTimeout is the timeout for the container ID and info cache being
-	// used. The chosen value is nothing more than a rough guess.
+	// defaultCacheTimeout is the default timeout for the container ID and info
+	// cache being used. The chosen value is nothing more than a rough guess.
 	defaultCacheTimeout time.Duration = time.Hour
 	auditBacklogMax                   = 128
 
+	// missingInfoCacheTimeout is the time a container ID is not being looked
+	// up again after it could not be found in the cluster. It is kept short to
+	// not lose the audit lines of transient misses for too long.
+	missingInfoCacheTimeout time.Duration = 30 * time.Second
+
 	defaultTimeout time.Duration = time.Minute
 	maxMsgSize     int           = 16 * 1024 * 1024
-	maxCacheItems  uint64        = 1000
+	// maxCacheItems is the default maximum amount of entries per cache. The
+	// least recently used entries get evicted if a cache is full.
+	maxCacheItems uint64 = 1000
+
+	// denialEventInterval is the minimum interval between two denial events
+	// for the same container and denial.
+	denialEventInterval time.Duration = 5 * time.Minute
+
+	// crashLoopInterval is the interval for correlating crash looping
+	// containers with recent denials.
+	crashLoopInterval time.Duration = time.Minute
+
+	// recentDenialTimeout is the time a denial is considered as a possible
+	// cause of a container crash.
+	recentDenialTimeout time.Duration = 10 * time.Minute
+
+	// clockSkewTolerance is the tolerated difference between the audit
+	// timestamps of the node and the container status times reported via the
+	// API server, which are also only accurate to the second.
+	clockSkewTolerance time.Duration = 5 * time.Second
 )
 
 // Enricher is the main structure of this package.
 type Enricher struct {
 	apienricher.UnimplementedEnricherServer
 	impl
-	logger           logr.Logger
-	containerIDCache *ttlcache.Cache[string, string]
-	infoCache        *ttlcache.Cache[string, *types.ContainerInfo]
-	syscalls         sync.Map
-	avcs             sync.Map
-	auditLineCache   *ttlcache.Cache[string, []*types.AuditLine]
-	clientset        kubernetes.Interface
+	logger             logr.Logger
+	containerIDCache   *ttlcache.Cache[string, string]
+	resolvers          []util.ContainerIDResolver
+	infoCache          *ttlcache.Cache[string, *types.ContainerInfo]
+	missingInfoCache   *ttlcache.Cache[string, struct{}]
+	syscalls           sync.Map
+	executables        sync.Map
+	syscallStreams     syscallStreams
+	syscallStreamDrops atomic.Uint64
+	syscallCollections collections
+	auditBatch         []*apimetrics.AuditRequest
+	auditBatchMu       sync.Mutex
+	avcs               sync.Map
+	avcScontexts       sync.Map
+	avcCollections     collections
+	apparmor           sync.Map
+	records            sync.Map
+	auditLineCache     *ttlcache.Cache[string, []*types.AuditLine]
+	clientset          kubernetes.Interface
+	recorder           record.EventRecorder
+	denialEventCache   *ttlcache.Cache[string, struct{}]
+	recentDenials      *ttlcache.Cache[string, recentDenial]
+	sinks              *auditSinks
+	retention          retention
+	cacheTimeout       time.Duration
+	criClient          *cri.Client
+	backfillFile       string
+	backfilled         *ttlcache.Cache[string, struct{}]
+	tlsDir             string
 }
 
 // New returns a new Enricher instance.
 func New(logger logr.Logger) *Enricher {
+	cacheTimeout, cacheItems := cacheOptions(logger, os.Getenv)
 	return &Enricher{
-		impl:   &defaultImpl{},
-		logger: logger,
+		impl:         &defaultImpl{},
+		logger:       logger,
+		cacheTimeout: cacheTimeout,
 		containerIDCache: ttlcache.New(
-			ttlcache.WithTTL[string, string](defaultCacheTimeout),
-			ttlcache.WithCapacity[string, string](maxCacheItems),
+			ttlcache.WithTTL[string, string](cacheTimeout),
+			ttlcache.WithCapacity[string, string](cacheItems),
 		),
+		resolvers: containerIDResolvers(logger, os.Getenv),
 		infoCache: ttlcache.New(
-			ttlcache.WithTTL[string, *types.ContainerInfo](defaultCacheTimeout),
-			ttlcache.WithCapacity[string, *types.ContainerInfo](maxCacheItems),
+			ttlcache.WithTTL[string, *types.ContainerInfo](cacheTimeout),
+			ttlcache.WithCapacity[string, *types.ContainerInfo](cacheItems),
+		),
+		missingInfoCache: ttlcache.New(
+			ttlcache.WithTTL[string, struct{}](missingInfoCacheTimeout),
+			ttlcache.WithCapacity[string, struct{}](cacheItems),
+			ttlcache.WithDisableTouchOnHit[string, struct{}](),
 		),
-		syscalls: sync.Map{},
-		avcs:     sync.Map{},
+		syscalls:     sync.Map{},
+		executables:  sync.Map{},
+		avcs:         sync.Map{},
+		avcScontexts: sync.Map{},
+		apparmor:     sync.Map{},
 		auditLineCache: ttlcache.New(
-			ttlcache.WithTTL[string, []*types.AuditLine](defaultCacheTimeout),
-			ttlcache.WithCapacity[string, []*types.AuditLine](maxCacheItems),
+			ttlcache.WithTTL[string, []*types.AuditLine](cacheTimeout),
+			ttlcache.WithCapacity[string, []*types.AuditLine](cacheItems),
 			// For the audit line cache we don't want to increase the TTL on
 			// Get calls because we want the TTLs just to quietly expire
 			// if/when the cache is full.
 			ttlcache.WithDisableTouchOnHit[string, []*types.AuditLine](),
 		),
+		denialEventCache: ttlcache.New(
+			ttlcache.WithTTL[string, struct{}](denialEventInterval),
+			ttlcache.WithCapacity[string, struct{}](cacheItems),
+			ttlcache.WithDisableTouchOnHit[string, struct{}](),
+		),
+		recentDenials: ttlcache.New(
+			ttlcache.WithTTL[string, recentDenial](recentDenialTimeout),
+			ttlcache.WithCapacity[string, recentDenial](cacheItems),
+			ttlcache.WithDisableTouchOnHit[string, recentDenial](),
+		),
+		backfilled: ttlcache.New(
+			ttlcache.WithTTL[string, struct{}](cacheTimeout),
+			ttlcache.WithCapacity[string, struct{}](cacheItems),
+		),
+		sinks:  newAuditSinks(logger),
+		tlsDir: os.Getenv(config.EnricherTLSDirEnvKey),
+	}
+}
+
+// cacheOptions returns the timeout and the maximum amount of entries of the
+// caches, which can be overridden via the environment.
+func cacheOptions(logger logr.Logger, getenv func(string) string) (timeout time.Duration, items uint64) {
+	timeout, items = defaultCacheTimeout, maxCacheItems
+
+	if value := getenv(config.EnricherCacheTTLEnvKey); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			logger.Info("Invalid cache TTL, using the default", "ttl", value, "default", defaultCacheTimeout)
+		} else {
+			timeout = parsed
+		}
+	}
+
+	if value := getenv(config.EnricherCacheMaxEntriesEnvKey); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || parsed == 0 {
+			logger.Info("Invalid cache size, using the default", "maxEntries", value, "default", maxCacheItems)
+		} else {
+			items = parsed
+		}
+	}
+
+	return timeout, items
+}
+
+// containerIDResolvers returns the container ID resolvers in the order
+// configured via the environment, or the default ones.
+func containerIDResolvers(logger logr.Logger, getenv func(string) string) []util.ContainerIDResolver {
+	value := getenv(config.EnricherContainerIDResolversEnvKey)
+	if value == "" {
+		return util.DefaultContainerIDResolvers
 	}
+
+	names := strings.Split(value, ",")
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+	}
+
+	resolvers, err := util.ContainerIDResolversByName(names)
+	if err != nil {
+		logger.Info("Invalid container ID resolvers, using the default", "resolvers", value, "error", err.Error())
+		return util.DefaultContainerIDResolvers
+	}
+
+	return resolvers
 }
 
 // Run the log-enricher to scrap audit logs and enrich them with
@@ -107,10 +235,22 @@ func (e *Enricher) Run() error {
 		return fmt.Errorf("load in-cluster config: %w", err)
 	}
 
-	e.logger.Info(fmt.Sprintf("Setting up caches with expiry of %v", defaultCacheTimeout))
+	if endpoint := e.Getenv(config.EnricherCRIEndpointEnvKey); endpoint != "" {
+		criClient, err := e.NewCRIClient(endpoint)
+		if err != nil {
+			e.logger.Error(err, "unable to create CRI client, looking up containers via the API server only")
+		} else {
+			e.logger.Info("Looking up containers via CRI runtime: " + endpoint)
+			e.criClient = criClient
+		}
+	}
+
+	e.logger.Info(fmt.Sprintf("Setting up caches with expiry of %v", e.cacheTimeout))
 	go e.containerIDCache.Start()
 	go e.infoCache.Start()
+	go e.missingInfoCache.Start()
 	go e.auditLineCache.Start()
+	go e.backfilled.Start()
 
 	nodeName := e.Getenv(config.NodeNameEnvKey)
 	if nodeName == "" {
@@ -121,11 +261,22 @@ func (e *Enricher) Run() error {
 
 	e.logger.Info("Starting log-enricher on node: " + nodeName)
 
+	if enabled, err := strconv.ParseBool(e.Getenv(config.EnableDenialEventsEnvKey)); err == nil && enabled {
+		e.logger.Info("Emitting denial events for pods which are not recorded")
+		e.recorder = e.NewEventRecorder(e.clientset, nodeName)
+		go e.denialEventCache.Start()
+		go e.recentDenials.Start()
+		go wait.Forever(func() { e.correlateCrashLoops(nodeName) }, crashLoopInterval)
+	}
+
 	e.logger.Info("Connecting to local GRPC server")
 	var (
-		conn          *grpc.ClientConn
-		cancel        context.CancelFunc
-		metricsClient apimetrics.Metrics_AuditIncClient
+		conn             *grpc.ClientConn
+		cancel           context.CancelFunc
+		metricsClient    apimetrics.Metrics_AuditBatchIncClient
+		resolutionClient apimetrics.Metrics_ContainerIDResolutionIncClient
+		evictionClient   apimetrics.Metrics_EnricherEvictionIncClient
+		dropClient       apimetrics.Metrics_SyscallStreamDropIncClient
 	)
 
 	if err := util.Retry(func() (err error) {
@@ -135,45 +286,69 @@ func (e *Enricher) Run() error {
 		}
 		client := apimetrics.NewMetricsClient(conn)
 
-		metricsClient, err = e.AuditInc(client)
+		metricsClient, err = e.AuditBatchInc(client)
 		if err != nil {
 			cancel()
 			e.Close(conn)
 			return fmt.Errorf("create metrics audit client: %w", err)
 		}
 
+		resolutionClient, err = e.ContainerIDResolutionInc(client)
+		if err != nil {
+			cancel()
+			e.Close(conn)
+			return fmt.Errorf("create metrics container ID resolution client: %w", err)
+		}
+
+		evictionClient, err = e.EnricherEvictionInc(client)
+		if err != nil {
+			cancel()
+			e.Close(conn)
+			return fmt.Errorf("create metrics enricher eviction client: %w", err)
+		}
+
+		dropClient, err = e.SyscallStreamDropInc(client)
+		if err != nil {
+			cancel()
+			e.Close(conn)
+			return fmt.Errorf("create metrics syscall stream drop client: %w", err)
+		}
+
 		return nil
 	}, func(err error) bool { return true }); err != nil {
 		return fmt.Errorf("connect to local GRPC server: %w", err)
 	}
 	defer cancel()
 	defer e.Close(conn)
+	defer e.flushAuditBatch(metricsClient)
+
+	go wait.Forever(func() { e.flushAuditBatch(metricsClient) }, auditBatchInterval)
+	go wait.Forever(func() { e.flushSyscallStreamDrops(nodeName, dropClient) }, auditBatchInterval)
+	if len(e.sinks.outputs) > 0 {
+		go wait.Forever(e.flushAuditEvents, auditBatchInterval)
+	}
+
+	if retention := e.Getenv(config.EnricherRetentionEnvKey); retention != "" {
+		window, err := time.ParseDuration(retention)
+		if err != nil {
+			e.logger.Error(err, "unable to parse retention, keeping recorded data forever")
+		} else if window > 0 {
+			e.logger.Info("Evicting recorded data of profiles not accessed within " + window.String())
+			e.retention.window = window
+			go wait.Forever(func() { e.evictOrphanedData(nodeName, evictionClient) }, retentionInterval)
+		}
+	}
 
 	if err := e.startGrpcServer(); err != nil {
 		return fmt.Errorf("start GRPC server: %w", err)
 	}
 
-	// Use auditd logs as main source or syslog as fallback.
-	filePath := LogFilePath()
-
-	// If the file does not exist, then tail will wait for it to appear
-	tailFile, err := e.TailFile(
-		filePath,
-		tail.Config{
-			ReOpen: true,
-			Follow: true,
-			Location: &tail.SeekInfo{
-				Offset: 0,
-				Whence: io.SeekEnd,
-			},
-		},
-	)
+	lines, reason, err := e.auditLines()
 	if err != nil {
-		return fmt.Errorf("tailing file: %w", err)
+		return err
 	}
 
-	e.logger.Info("Reading from file " + filePath)
-	for l := range e.Lines(tailFile) {
+	for l := range lines {
 		if l.Err != nil {
 			e.logger.Error(l.Err, "failed to tail")
 			continue
@@ -193,7 +368,7 @@ func (e *Enricher) Run() error {
 		}
 
 		e.logger.V(config.VerboseLevel).Info(fmt.Sprintf("Get container ID for PID: %d", auditLine.ProcessID))
-		cID, err := e.ContainerIDForPID(e.containerIDCache, auditLine.ProcessID)
+		cID, resolver, err := e.ContainerIDForPID(e.containerIDCache, e.resolvers, auditLine.ProcessID)
 		if errors.Is(err, os.ErrNotExist) {
 			// We're probably in container creation or removal
 			if backlogErr := e.addToBacklog(auditLine); backlogErr != nil {
@@ -212,6 +387,19 @@ func (e *Enricher) Run() error {
 			continue
 		}
 
+		if resolver != "" {
+			e.logger.V(config.VerboseLevel).Info("Resolved container ID", "containerID", cID, "resolver", resolver)
+			if err := e.SendContainerIDResolutionMetric(
+				resolutionClient,
+				&apimetrics.ContainerIDResolutionRequest{
+					Node:     nodeName,
+					Resolver: resolver,
+				},
+			); err != nil {
+				e.logger.Error(err, "unable to update container ID resolution metrics")
+			}
+		}
+
 		e.logger.V(config.VerboseLevel).Info("Get container info for: " + cID)
 		info, err := e.getContainerInfo(nodeName, cID)
 		if err != nil {
@@ -226,6 +414,8 @@ func (e *Enricher) Run() error {
 			continue
 		}
 
+		e.backfill(info, eventTime(auditLine, time.Now()))
+
 		err = e.dispatchAuditLine(metricsClient, nodeName, auditLine, info)
 		if err != nil {
 			e.logger.Error(
@@ -237,12 +427,80 @@ func (e *Enricher) Run() error {
 		e.dispatchBacklog(metricsClient, nodeName, info, auditLine.ProcessID)
 	}
 
-	return fmt.Errorf("enricher failed: %w", e.Reason(tailFile))
+	return fmt.Errorf("enricher failed: %w", reason())
+}
+
+// auditLines returns the source of audit lines, which is either the kernel
+// audit netlink socket if enabled, the journal if auditd does not log into a
+// file or the log file otherwise. The returned function provides the reason
+// once the lines channel got closed.
+func (e *Enricher) auditLines() (lines chan *tail.Line, reason func() error, err error) {
+	if enabled, err := strconv.ParseBool(e.Getenv(config.EnableAuditNetlinkEnvKey)); err == nil && enabled {
+		sub, err := e.SubscribeAudit()
+		if err != nil {
+			return nil, nil, fmt.Errorf("subscribing to audit netlink: %w", err)
+		}
+
+		e.logger.Info("Reading from audit netlink socket")
+		return e.AuditLines(sub), func() error { return e.AuditReason(sub) }, nil
+	}
+
+	// Prefer the journal if auditd does not log into a file
+	if e.useJournal() {
+		reader, err := e.FollowJournal(config.JournalLogPath)
+		if err == nil {
+			e.logger.Info("Reading from journal " + config.JournalLogPath)
+			return e.JournalLines(reader), func() error { return e.JournalReason(reader) }, nil
+		}
+		if !errors.Is(err, journal.ErrUnsupported) {
+			return nil, nil, fmt.Errorf("following journal: %w", err)
+		}
+		e.logger.Info("Not reading from journal", "reason", err)
+	}
+
+	// Use auditd logs as main source or syslog as fallback.
+	filePath := LogFilePath()
+
+	// If the file does not exist, then tail will wait for it to appear
+	tailFile, err := e.TailFile(
+		filePath,
+		tail.Config{
+			ReOpen: true,
+			Follow: true,
+			Location: &tail.SeekInfo{
+				Offset: 0,
+				Whence: io.SeekEnd,
+			},
+		},
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tailing file: %w", err)
+	}
+
+	e.logger.Info("Reading from file " + filePath)
+	if enabled, err := strconv.ParseBool(e.Getenv(config.EnricherBackfillEnvKey)); err == nil && enabled {
+		e.logger.Info("Backfilling recorded profiles from " + filePath)
+		e.backfillFile = filePath
+	}
+	return e.Lines(tailFile), func() error { return e.Reason(tailFile) }, nil
 }
 
 func (e *Enricher) startGrpcServer() error {
 	e.logger.Info("Starting GRPC server API")
 
+	opts := []grpc.ServerOption{
+		grpc.MaxSendMsgSize(maxMsgSize),
+		grpc.MaxRecvMsgSize(maxMsgSize),
+	}
+	if e.tlsDir != "" {
+		tlsConfig, err := serverTLSConfig(e.tlsDir)
+		if err != nil {
+			return fmt.Errorf("load GRPC server TLS config: %w", err)
+		}
+		e.logger.Info("Requiring mutual TLS for the GRPC server API")
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
 	if _, err := e.Stat(config.GRPCServerSocketEnricher); err == nil {
 		if err := e.RemoveAll(config.GRPCServerSocketEnricher); err != nil {
 			return fmt.Errorf("remove GRPC socket file: %w", err)
@@ -262,10 +520,7 @@ func (e *Enricher) startGrpcServer() error {
 		return fmt.Errorf("change GRPC socket owner to rootless: %w", err)
 	}
 
-	grpcServer := grpc.NewServer(
-		grpc.MaxSendMsgSize(maxMsgSize),
-		grpc.MaxRecvMsgSize(maxMsgSize),
-	)
+	grpcServer := grpc.NewServer(opts...)
 	apienricher.RegisterEnricherServer(grpcServer, e)
 
 	go func() {
@@ -280,11 +535,20 @@ func (e *Enricher) startGrpcServer() error {
 // Dial can be used to connect to the default GRPC server by creating a new
 // client.
 func Dial() (*grpc.ClientConn, context.CancelFunc, error) {
+	creds := insecure.NewCredentials()
+	if dir := os.Getenv(config.EnricherTLSDirEnvKey); dir != "" {
+		tlsConfig, err := clientTLSConfig(dir)
+		if err != nil {
+			return nil, nil, fmt.Errorf("load GRPC client TLS config: %w", err)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 	conn, err := grpc.DialContext(
 		ctx,
 		"unix://"+config.GRPCServerSocketEnricher,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 	)
 	if err != nil {
 		cancel()
@@ -322,7 +586,7 @@ func (e *Enricher) addToBacklog(line *types.AuditLine) error {
 }
 
 func (e *Enricher) dispatchBacklog(
-	metricsClient apimetrics.Metrics_AuditIncClient,
+	metricsClient apimetrics.Metrics_AuditBatchIncClient,
 	nodeName string,
 	info *types.ContainerInfo,
 	processID int,
@@ -348,7 +612,7 @@ func (e *Enricher) dispatchBacklog(
 }
 
 func (e *Enricher) dispatchAuditLine(
-	metricsClient apimetrics.Metrics_AuditIncClient,
+	metricsClient apimetrics.Metrics_AuditBatchIncClient,
 	nodeName string,
 	auditLine *types.AuditLine,
 	info *types.ContainerInfo,
@@ -361,6 +625,35 @@ func (e *Enricher) dispatchAuditLine(
 	case types.AuditTypeApparmor:
 		e.dispatchApparmorLine(nodeName, auditLine, info)
 	default:
+		if parser, ok := parsers.get(auditLine.AuditType); ok {
+			e.dispatchParserLine(parser, nodeName, auditLine, info)
+			return nil
+		}
+		return fmt.Errorf("unknown audit line type %s", auditLine.AuditType)
+	}
+
+	return nil
+}
+
+// recordAuditLine adds the audit line to the recorded profile of the
+// container without emitting metrics, events or sink output.
+func (e *Enricher) recordAuditLine(auditLine *types.AuditLine, info *types.ContainerInfo) error {
+	switch auditLine.AuditType {
+	case types.AuditTypeSelinux:
+		e.recordSelinuxLine(auditLine, info)
+	case types.AuditTypeSeccomp:
+		syscallName, err := syscallName(auditLine.SystemCallID)
+		if err != nil {
+			return fmt.Errorf("no syscall name found for ID %d: %w", auditLine.SystemCallID, err)
+		}
+		e.recordSeccompLine(syscallName, auditLine, info)
+	case types.AuditTypeApparmor:
+		e.recordApparmorLine(auditLine, info)
+	default:
+		if parser, ok := parsers.get(auditLine.AuditType); ok {
+			e.recordParserLine(parser, auditLine, info)
+			return nil
+		}
 		return fmt.Errorf("unknown audit line type %s", auditLine.AuditType)
 	}
 
@@ -368,12 +661,12 @@ func (e *Enricher) dispatchAuditLine(
 }
 
 func (e *Enricher) dispatchSelinuxLine(
-	metricsClient apimetrics.Metrics_AuditIncClient,
+	metricsClient apimetrics.Metrics_AuditBatchIncClient,
 	nodeName string,
 	auditLine *types.AuditLine,
 	info *types.ContainerInfo,
 ) {
-	e.logger.Info("audit",
+	e.writeAuditEvent(newAuditEvent(nodeName, auditLine, info),
 		"timestamp", auditLine.TimestampID,
 		"type", auditLine.AuditType,
 		"profile", info.RecordProfile,
@@ -387,7 +680,8 @@ func (e *Enricher) dispatchSelinuxLine(
 		"tclass", auditLine.Tclass,
 	)
 
-	if err := e.SendMetric(
+	now := time.Now()
+	if err := e.queueAuditRequest(
 		metricsClient,
 		&apimetrics.AuditRequest{
 			Node:       nodeName,
@@ -395,39 +689,61 @@ func (e *Enricher) dispatchSelinuxLine(
 			Pod:        info.PodName,
 			Container:  info.ContainerName,
 			Executable: auditLine.Executable,
+			LagSeconds: auditLag(auditLine, now),
+			EventTime:  timestamppb.New(eventTime(auditLine, now)),
 			SelinuxReq: &apimetrics.AuditRequest_SelinuxAuditReq{
 				Scontext: auditLine.Scontext,
 				Tcontext: auditLine.Tcontext,
+				Tclass:   auditLine.Tclass,
+				Perm:     auditLine.Perm,
 			},
 		},
 	); err != nil {
 		e.logger.Error(err, "unable to update metrics")
 	}
 
+	e.emitDenialEvent(
+		info, eventTime(auditLine, now), reasonSelinuxDenial, auditLine.Perm+"/"+auditLine.Tclass,
+		fmt.Sprintf(
+			"SELinux denied { %s } for %s on %s (tclass=%s) in container %s",
+			auditLine.Perm, auditLine.Scontext, auditLine.Tcontext, auditLine.Tclass, info.ContainerName,
+		),
+	)
+
 	if info.RecordProfile != "" {
-		for _, perm := range strings.Split(auditLine.Perm, " ") {
-			avc := &apienricher.AvcResponse_SelinuxAvc{
-				Perm:     perm,
-				Scontext: auditLine.Scontext,
-				Tcontext: auditLine.Tcontext,
-				Tclass:   auditLine.Tclass,
-			}
-			jsonBytes, err := protojson.Marshal(avc)
-			if err != nil {
-				e.logger.Error(err, "marshall protobuf")
-			}
+		e.recordSelinuxLine(auditLine, info)
+	}
+}
 
-			a, _ := e.avcs.LoadOrStore(info.RecordProfile, sets.New[string]())
-			stringSet, ok := a.(sets.Set[string])
-			if ok {
-				stringSet.Insert(string(jsonBytes))
-			}
+// recordSelinuxLine adds the AVCs of the audit line to the recorded profile
+// of the container.
+func (e *Enricher) recordSelinuxLine(auditLine *types.AuditLine, info *types.ContainerInfo) {
+	e.retention.touch(info.RecordProfile, time.Now())
+	if info.SelinuxType != "" {
+		e.avcScontexts.Store(info.RecordProfile, info.SelinuxType)
+	}
+	for _, perm := range strings.Split(auditLine.Perm, " ") {
+		avc := &apienricher.AvcResponse_SelinuxAvc{
+			Perm:     perm,
+			Scontext: auditLine.Scontext,
+			Tcontext: auditLine.Tcontext,
+			Tclass:   auditLine.Tclass,
+		}
+		jsonBytes, err := protojson.Marshal(avc)
+		if err != nil {
+			e.logger.Error(err, "marshall protobuf")
+		}
+
+		a, _ := e.avcs.LoadOrStore(info.RecordProfile, sets.New[string]())
+		stringSet, ok := a.(sets.Set[string])
+		if ok {
+			stringSet.Insert(string(jsonBytes))
 		}
 	}
 }
 
 func (e *Enricher) dispatchSeccompLine(
-	metricsClient apimetrics.Metrics_AuditIncClient,
+	metricsClient apimetrics.Metrics_AuditBatchIncClient,
 	nodeName string,
 	auditLine *types.AuditLine,
 	info *types.ContainerInfo,
@@ -442,7 +758,9 @@ func (e *Enricher) dispatchSeccompLine(
 		return
 	}
 
-	e.logger.Info("audit",
+	event := newAuditEvent(nodeName, auditLine, info)
+	event.Syscall = syscallName
+	e.writeAuditEvent(event,
 		"timestamp", auditLine.TimestampID,
 		"type", auditLine.AuditType,
 		"node", nodeName,
@@ -455,7 +773,8 @@ func (e *Enricher) dispatchSeccompLine(
 		"syscallName", syscallName,
 	)
 
-	if err := e.SendMetric(
+	now := time.Now()
+	if err := e.queueAuditRequest(
 		metricsClient,
 		&apimetrics.AuditRequest{
 			Node:       nodeName,
@@ -463,6 +782,8 @@ func (e *Enricher) dispatchSeccompLine(
 			Pod:        info.PodName,
 			Container:  info.ContainerName,
 			Executable: auditLine.Executable,
+			LagSeconds: auditLag(auditLine, now),
+			EventTime:  timestamppb.New(eventTime(auditLine, now)),
 			SeccompReq: &apimetrics.AuditRequest_SeccompAuditReq{
 				Syscall: syscallName,
 			},
@@ -471,15 +792,47 @@ func (e *Enricher) dispatchSeccompLine(
 		e.logger.Error(err, "unable to update metrics")
 	}
 
+	e.emitDenialEvent(
+		info, eventTime(auditLine, now), reasonSeccompDenial, syscallName,
+		fmt.Sprintf(
+			"seccomp denied syscall %s for executable %s in container %s",
+			syscallName, auditLine.Executable, info.ContainerName,
+		),
+	)
+
 	if info.RecordProfile != "" {
-		s, _ := e.syscalls.LoadOrStore(info.RecordProfile, sets.New[string]())
-		stringSet, ok := s.(sets.Set[string])
-		if ok {
-			stringSet.Insert(syscallName)
+		e.recordSeccompLine(syscallName, auditLine, info)
+
+		if dropped := e.syscallStreams.publish(info.RecordProfile, &apienricher.SyscallEvent{
+			Syscall:    syscallName,
+			Executable: auditLine.Executable,
+			EventTime:  timestamppb.New(eventTime(auditLine, now)),
+		}); dropped > 0 {
+			e.syscallStreamDrops.Add(uint64(dropped))
+			e.logger.V(config.VerboseLevel).Info("Dropped syscall event for slow streams",
+				"profile", info.RecordProfile, "streams", dropped)
 		}
 	}
 }
 
+// recordSeccompLine adds the syscall and the executable of the audit line to
+// the recorded profile of the container.
+func (e *Enricher) recordSeccompLine(syscallName string, auditLine *types.AuditLine, info *types.ContainerInfo) {
+	e.retention.touch(info.RecordProfile, time.Now())
+	s, _ := e.syscalls.LoadOrStore(info.RecordProfile, sets.New[string]())
+	stringSet, ok := s.(sets.Set[string])
+	if ok {
+		stringSet.Insert(syscallName)
+	}
+
+	x, _ := e.executables.LoadOrStore(info.RecordProfile, &syscallExecutables{
+		SyscallExecutables: util.SyscallExecutables{},
+	})
+	if executables, ok := x.(*syscallExecutables); ok {
+		executables.insert(syscallName, auditLine.Executable)
+	}
+}
+
 func (e *Enricher) dispatchApparmorLine(
 	nodeName string,
 	auditLine *types.AuditLine,
@@ -504,7 +857,55 @@ func (e *Enricher) dispatchApparmorLine(
 		values = append(values, "extra", auditLine.ExtraInfo)
 	}
 
-	e.logger.Info("audit", values...)
+	e.writeAuditEvent(newAuditEvent(nodeName, auditLine, info), values...)
+
+	if auditLine.Apparmor == apparmorDenied {
+		e.emitDenialEvent(
+			info, eventTime(auditLine, time.Now()), reasonApparmorDenial, auditLine.Operation+"/"+auditLine.Name,
+			fmt.Sprintf(
+				"AppArmor profile %s denied operation %s on %s in container %s",
+				auditLine.Profile, auditLine.Operation, auditLine.Name, info.ContainerName,
+			),
+		)
+	}
+
+	if info.RecordProfile != "" {
+		e.recordApparmorLine(auditLine, info)
+	}
+}
+
+// recordApparmorLine adds the AppArmor event of the audit line to the
+// recorded profile of the container.
+func (e *Enricher) recordApparmorLine(auditLine *types.AuditLine, info *types.ContainerInfo) {
+	e.retention.touch(info.RecordProfile, time.Now())
+	event := &apienricher.ApparmorResponse_ApparmorEvent{
+		Apparmor:      auditLine.Apparmor,
+		Operation:     auditLine.Operation,
+		Name:          auditLine.Name,
+		RequestedMask: auditLine.RequestedMask,
+		Executable:    auditLine.Executable,
+	}
+	jsonBytes, err := protojson.Marshal(event)
+	if err != nil {
+		e.logger.Error(err, "marshall protobuf")
+		return
+	}
+
+	a, _ := e.apparmor.LoadOrStore(info.RecordProfile, sets.New[string]())
+	stringSet, ok := a.(sets.Set[string])
+	if ok {
+		stringSet.Insert(string(jsonBytes))
+	}
+}
+
+// useJournal returns true if the audit log file does not exist, but the
+// journal does.
+func (e *Enricher) useJournal() bool {
+	if _, err := e.Stat(config.AuditLogPath); !os.IsNotExist(err) {
+		return false
+	}
+	_, err := e.Stat(config.JournalLogPath)
+	return err == nil
 }
 
 // LogFilePath returns either the path to the audit logs or falls back to
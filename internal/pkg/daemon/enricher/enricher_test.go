@@ -20,17 +20,28 @@ limitations under the License.
 package enricher
 
 import (
+	"context"
 	"errors"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/nxadm/tail"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 
+	apienricher "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/cri"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/journal"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 )
 
@@ -69,7 +80,7 @@ func TestRun(t *testing.T) {
 			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
 				mock.GetenvReturns(node)
 				mock.LinesReturns(lineChan)
-				mock.ContainerIDForPIDReturns(containerID, nil)
+				mock.ContainerIDForPIDReturns(containerID, "crio", nil)
 				mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      pod,
@@ -98,7 +109,9 @@ func TestRun(t *testing.T) {
 					// Wait for MetricsAuditIncCallCount to be called
 				}
 
-				_, res := mock.SendMetricArgsForCall(0)
+				_, batch := mock.SendMetricArgsForCall(0)
+				require.Len(t, batch.GetRequests(), 1)
+				res := batch.GetRequests()[0]
 				require.Equal(t, node, res.Node)
 				require.Equal(t, namespace, res.Namespace)
 				require.Equal(t, pod, res.Pod)
@@ -106,6 +119,11 @@ func TestRun(t *testing.T) {
 				require.NotNil(t, res.SeccompReq)
 				require.Equal(t, syscall, res.SeccompReq.Syscall)
 
+				require.Equal(t, 1, mock.SendContainerIDResolutionMetricCallCount())
+				_, resolution := mock.SendContainerIDResolutionMetricArgsForCall(0)
+				require.Equal(t, node, resolution.Node)
+				require.Equal(t, "crio", resolution.Resolver)
+
 				require.Equal(t, 0, mock.AddToBacklogCallCount())
 
 				require.Nil(t, err)
@@ -130,12 +148,12 @@ func TestRun(t *testing.T) {
 				require.NotNil(t, err)
 			},
 		},
-		{ // failure on MetricsAuditInc
+		{ // failure on MetricsAuditBatchInc
 			runAsync: false,
 			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
 				mock.GetenvReturns(node)
 				mock.DialReturns(nil, func() {}, errTest)
-				mock.AuditIncReturns(nil, errTest)
+				mock.AuditBatchIncReturns(nil, errTest)
 			},
 			assert: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line, err error) {
 				require.NotNil(t, err)
@@ -152,6 +170,86 @@ func TestRun(t *testing.T) {
 				require.NotNil(t, err)
 			},
 		},
+		{ // failure on SubscribeAudit
+			runAsync: false,
+			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
+				mock.GetenvStub = func(key string) string {
+					if key == config.EnableAuditNetlinkEnvKey {
+						return "true"
+					}
+					return node
+				}
+				mock.DialReturns(nil, func() {}, nil)
+				mock.SubscribeAuditReturns(nil, errTest)
+			},
+			assert: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line, err error) {
+				require.NotNil(t, err)
+				require.Equal(t, 1, mock.SubscribeAuditCallCount())
+				require.Equal(t, 0, mock.TailFileCallCount())
+			},
+		},
+		{ // failure on AuditLines
+			runAsync: false,
+			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
+				mock.GetenvStub = func(key string) string {
+					if key == config.EnableAuditNetlinkEnvKey {
+						return "true"
+					}
+					return node
+				}
+				mock.DialReturns(nil, func() {}, nil)
+				close(lineChan)
+				mock.AuditLinesReturns(lineChan)
+				mock.AuditReasonReturns(errTest)
+			},
+			assert: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Equal(t, 1, mock.AuditReasonCallCount())
+				require.Equal(t, 0, mock.LinesCallCount())
+			},
+		},
+		{ // failure on JournalLines
+			runAsync: false,
+			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
+				mock.GetenvReturns(node)
+				mock.DialReturns(nil, func() {}, nil)
+				mock.StatStub = func(name string) (os.FileInfo, error) {
+					if name == config.AuditLogPath {
+						return nil, os.ErrNotExist
+					}
+					return nil, nil
+				}
+				close(lineChan)
+				mock.JournalLinesReturns(lineChan)
+				mock.JournalReasonReturns(errTest)
+			},
+			assert: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Equal(t, 1, mock.FollowJournalCallCount())
+				require.Equal(t, config.JournalLogPath, mock.FollowJournalArgsForCall(0))
+				require.Equal(t, 0, mock.TailFileCallCount())
+			},
+		},
+		{ // journal not supported falls back to tailing
+			runAsync: false,
+			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
+				mock.GetenvReturns(node)
+				mock.DialReturns(nil, func() {}, nil)
+				mock.StatStub = func(name string) (os.FileInfo, error) {
+					if name == config.AuditLogPath {
+						return nil, os.ErrNotExist
+					}
+					return nil, nil
+				}
+				mock.FollowJournalReturns(nil, journal.ErrUnsupported)
+				mock.TailFileReturns(nil, errTest)
+			},
+			assert: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Equal(t, 1, mock.FollowJournalCallCount())
+				require.Equal(t, 1, mock.TailFileCallCount())
+			},
+		},
 		{ // failure on Listen
 			runAsync: false,
 			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
@@ -192,7 +290,7 @@ func TestRun(t *testing.T) {
 			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
 				mock.GetenvReturns(node)
 				mock.LinesReturns(lineChan)
-				mock.ContainerIDForPIDReturns(containerID, nil)
+				mock.ContainerIDForPIDReturns(containerID, "crio", nil)
 				mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      pod,
@@ -230,7 +328,7 @@ func TestRun(t *testing.T) {
 			prepare: func(mock *enricherfakes.FakeImpl, lineChan chan *tail.Line) {
 				mock.GetenvReturns(node)
 				mock.LinesReturns(lineChan)
-				mock.ContainerIDForPIDReturns(containerID, nil)
+				mock.ContainerIDForPIDReturns(containerID, "crio", nil)
 
 				// container.go says that there are 10 retries to get the container
 				// ID. Simulate a failure by returning the container ID on the 11th
@@ -344,3 +442,968 @@ func TestRun(t *testing.T) {
 		tc.assert(mock, lineChan, err)
 	}
 }
+
+func TestGetContainerInfo(t *testing.T) {
+	t.Parallel()
+
+	podWithContainer := &v1.PodList{Items: []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod,
+			Namespace: namespace,
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:        "ctr",
+				ContainerID: crioPrefix + containerID,
+			}},
+		},
+	}}}
+
+	for _, tc := range []struct {
+		name             string
+		prepare          func(*enricherfakes.FakeImpl)
+		shouldErr        bool
+		expectedListPods int
+	}{
+		{
+			name: "container found on first lookup",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(podWithContainer, nil)
+			},
+			expectedListPods: 1,
+		},
+		{
+			name: "container found after retry",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturnsOnCall(0, &v1.PodList{}, nil)
+				mock.ListPodsReturnsOnCall(1, podWithContainer, nil)
+			},
+			expectedListPods: 2,
+		},
+		{
+			name: "container not found is cached",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(&v1.PodList{}, nil)
+			},
+			shouldErr:        true,
+			expectedListPods: infoLookupSteps,
+		},
+		{
+			name: "failure on ListPods is not cached",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(nil, errTest)
+			},
+			shouldErr:        true,
+			expectedListPods: 2,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &enricherfakes.FakeImpl{}
+			tc.prepare(mock)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+
+			// The second lookup is served from the (negative) cache
+			for i := 0; i < 2; i++ {
+				info, err := sut.getContainerInfo(node, containerID)
+				if tc.shouldErr {
+					require.Error(t, err)
+					require.Nil(t, info)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, pod, info.PodName)
+					require.Equal(t, "ctr", info.ContainerName)
+				}
+			}
+			require.Equal(t, tc.expectedListPods, mock.ListPodsCallCount())
+		})
+	}
+}
+
+func TestGetContainerInfoCRI(t *testing.T) {
+	t.Parallel()
+
+	const testProfile = "test-profile"
+	podWithContainer := &v1.PodList{Items: []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod,
+			Namespace: namespace,
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:        "ctr",
+				ContainerID: crioPrefix + containerID,
+			}},
+		},
+	}}}
+
+	criContainer := func(annotations map[string]string) *cri.Container {
+		return &cri.Container{
+			ID:             containerID,
+			Name:           "ctr",
+			PodName:        pod,
+			PodNamespace:   namespace,
+			PodUID:         "uid",
+			PodAnnotations: annotations,
+		}
+	}
+
+	for _, tc := range []struct {
+		name                  string
+		prepare               func(*enricherfakes.FakeImpl)
+		expectedRecordProfile string
+		expectedListPods      int
+	}{
+		{
+			name: "container found via CRI",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.CRIContainerReturns(criContainer(nil), nil)
+			},
+		},
+		{
+			name: "container with seccomp recording found via CRI",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.CRIContainerReturns(criContainer(map[string]string{
+					config.SeccompProfileRecordLogsAnnotationKey + "ctr": testProfile,
+				}), nil)
+			},
+			expectedRecordProfile: testProfile,
+		},
+		{
+			name: "fallback on container not found via CRI",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.CRIContainerReturns(nil, cri.ErrNotFound)
+				mock.ListPodsReturns(podWithContainer, nil)
+			},
+			expectedListPods: 1,
+		},
+		{
+			name: "fallback on container with SELinux recording",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.CRIContainerReturns(criContainer(map[string]string{
+					config.SelinuxProfileRecordLogsAnnotationKey + "ctr": testProfile,
+				}), nil)
+				mock.ListPodsReturns(podWithContainer, nil)
+			},
+			expectedListPods: 1,
+		},
+		{
+			name: "fallback on possibly ephemeral container",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.CRIContainerReturns(criContainer(map[string]string{
+					config.SeccompProfileRecordLogsAnnotationKey + config.EphemeralContainersRecordName: testProfile,
+				}), nil)
+				mock.ListPodsReturns(podWithContainer, nil)
+			},
+			expectedListPods: 1,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &enricherfakes.FakeImpl{}
+			tc.prepare(mock)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+			sut.criClient = &cri.Client{}
+
+			// The second lookup is served from the cache
+			for i := 0; i < 2; i++ {
+				info, err := sut.getContainerInfo(node, containerID)
+				require.NoError(t, err)
+				require.Equal(t, pod, info.PodName)
+				require.Equal(t, "ctr", info.ContainerName)
+				if tc.expectedListPods == 0 {
+					require.Equal(t, tc.expectedRecordProfile, info.RecordProfile)
+				}
+			}
+			require.Equal(t, 1, mock.CRIContainerCallCount())
+			require.Equal(t, tc.expectedListPods, mock.ListPodsCallCount())
+		})
+	}
+}
+
+func TestAuditBatch(t *testing.T) {
+	t.Parallel()
+
+	mock := &enricherfakes.FakeImpl{}
+	sut := New(logr.Discard())
+	sut.impl = mock
+
+	req := &apimetrics.AuditRequest{Node: node}
+	for i := 0; i < auditBatchSize-1; i++ {
+		require.Nil(t, sut.queueAuditRequest(nil, req))
+	}
+	require.Zero(t, mock.SendMetricCallCount())
+
+	// Full batch gets sent
+	require.Nil(t, sut.queueAuditRequest(nil, req))
+	require.Equal(t, 1, mock.SendMetricCallCount())
+	_, batch := mock.SendMetricArgsForCall(0)
+	require.Len(t, batch.GetRequests(), auditBatchSize)
+
+	// Partial batch gets flushed
+	require.Nil(t, sut.queueAuditRequest(nil, req))
+	sut.flushAuditBatch(nil)
+	require.Equal(t, 2, mock.SendMetricCallCount())
+	_, batch = mock.SendMetricArgsForCall(1)
+	require.Len(t, batch.GetRequests(), 1)
+
+	// Nothing to flush
+	sut.flushAuditBatch(nil)
+	require.Equal(t, 2, mock.SendMetricCallCount())
+
+	// Failed batch gets dropped
+	mock.SendMetricReturns(errTest)
+	require.Nil(t, sut.queueAuditRequest(nil, req))
+	sut.flushAuditBatch(nil)
+	sut.flushAuditBatch(nil)
+	require.Equal(t, 3, mock.SendMetricCallCount())
+}
+
+func TestSelinuxAuditRequest(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	info := &types.ContainerInfo{PodName: pod, Namespace: namespace, ContainerName: "ctr"}
+	line := &types.AuditLine{
+		AuditType: types.AuditTypeSelinux,
+		Perm:      "read",
+		Scontext:  "system_u:system_r:container_t:s0:c4,c808",
+		Tcontext:  "system_u:object_r:var_lib_t:s0",
+		Tclass:    "lnk_file",
+	}
+	require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+
+	require.Len(t, sut.auditBatch, 1)
+	req := sut.auditBatch[0]
+	require.Equal(t, namespace, req.GetNamespace())
+	require.Equal(t, pod, req.GetPod())
+	require.Equal(t, line.Scontext, req.GetSelinuxReq().GetScontext())
+	require.Equal(t, line.Tcontext, req.GetSelinuxReq().GetTcontext())
+	require.Equal(t, line.Tclass, req.GetSelinuxReq().GetTclass())
+	require.Equal(t, line.Perm, req.GetSelinuxReq().GetPerm())
+}
+
+func TestAvcsScontext(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	info := &types.ContainerInfo{
+		PodName: pod, Namespace: namespace, ContainerName: "ctr",
+		RecordProfile: "profile", SelinuxType: "selinuxrecording.process",
+	}
+	line := &types.AuditLine{
+		AuditType: types.AuditTypeSelinux,
+		Perm:      "read",
+		Scontext:  "system_u:system_r:selinuxrecording.process:s0:c4,c808",
+		Tcontext:  "system_u:object_r:var_lib_t:s0",
+		Tclass:    "file",
+	}
+	require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+
+	request := &apienricher.AvcRequest{Profile: "profile"}
+	res, err := sut.Avcs(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, res.GetAvc(), 1)
+	require.Equal(t, "selinuxrecording.process", res.GetScontext())
+
+	_, err = sut.ResetAvcs(context.Background(), request)
+	require.NoError(t, err)
+	_, ok := sut.avcScontexts.Load("profile")
+	require.False(t, ok)
+}
+
+func TestApparmorEvents(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	info := &types.ContainerInfo{
+		PodName: pod, Namespace: namespace, ContainerName: "ctr", RecordProfile: "profile",
+	}
+	for _, line := range []*types.AuditLine{
+		{
+			AuditType: types.AuditTypeApparmor, Apparmor: "ALLOWED", Operation: "open",
+			Name: "/etc/passwd", RequestedMask: "r", Executable: executable,
+		},
+		{
+			AuditType: types.AuditTypeApparmor, Apparmor: "ALLOWED", Operation: "open",
+			Name: "/etc/passwd", RequestedMask: "r", Executable: executable,
+		},
+		{
+			AuditType: types.AuditTypeApparmor, Apparmor: "DENIED", Operation: "exec",
+			Name: "/bin/sh", RequestedMask: "x", Executable: executable,
+		},
+	} {
+		require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+	}
+
+	request := &apienricher.ApparmorRequest{Profile: "profile"}
+	res, err := sut.Apparmor(context.Background(), request)
+	require.NoError(t, err)
+	require.Len(t, res.GetEvents(), 2)
+	names := []string{}
+	for _, event := range res.GetEvents() {
+		names = append(names, event.GetOperation()+"/"+event.GetName()+"/"+event.GetRequestedMask())
+	}
+	require.ElementsMatch(t, []string{"open//etc/passwd/r", "exec//bin/sh/x"}, names)
+
+	_, err = sut.ResetApparmor(context.Background(), request)
+	require.NoError(t, err)
+	_, err = sut.Apparmor(context.Background(), request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestContainerSelinuxType(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		pod      *v1.Pod
+		expected string
+	}{
+		{
+			name:     "no security context",
+			pod:      &v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "ctr"}}}},
+			expected: "",
+		},
+		{
+			name: "pod security context",
+			pod: &v1.Pod{Spec: v1.PodSpec{
+				SecurityContext: &v1.PodSecurityContext{SELinuxOptions: &v1.SELinuxOptions{Type: "pod_t"}},
+				Containers:      []v1.Container{{Name: "ctr"}},
+			}},
+			expected: "pod_t",
+		},
+		{
+			name: "container security context takes precedence",
+			pod: &v1.Pod{Spec: v1.PodSpec{
+				SecurityContext: &v1.PodSecurityContext{SELinuxOptions: &v1.SELinuxOptions{Type: "pod_t"}},
+				Containers: []v1.Container{
+					{Name: "other"},
+					{
+						Name:            "ctr",
+						SecurityContext: &v1.SecurityContext{SELinuxOptions: &v1.SELinuxOptions{Type: "ctr_t"}},
+					},
+				},
+			}},
+			expected: "ctr_t",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, containerSelinuxType(tc.pod, "ctr"))
+		})
+	}
+}
+
+func TestContainerStartTime(t *testing.T) {
+	t.Parallel()
+
+	started := metav1.NewTime(time.Unix(1624537480, 0))
+	for _, tc := range []struct {
+		name     string
+		state    v1.ContainerState
+		expected time.Time
+	}{
+		{
+			name:     "running",
+			state:    v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: started}},
+			expected: started.Time,
+		},
+		{
+			name:     "terminated",
+			state:    v1.ContainerState{Terminated: &v1.ContainerStateTerminated{StartedAt: started}},
+			expected: started.Time,
+		},
+		{
+			name:  "waiting",
+			state: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, containerStartTime(&v1.ContainerStatus{State: tc.state}))
+		})
+	}
+}
+
+func TestDenialEvents(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		enabled  bool
+		info     *types.ContainerInfo
+		lines    []*types.AuditLine
+		expected int
+	}{
+		{
+			name:    "seccomp denial emits a single event",
+			enabled: true,
+			info:    &types.ContainerInfo{PodName: pod, Namespace: namespace, ContainerName: "ctr"},
+			lines: []*types.AuditLine{
+				{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable},
+				{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable},
+			},
+			expected: 1,
+		},
+		{
+			name:    "different denials emit separate events",
+			enabled: true,
+			info:    &types.ContainerInfo{PodName: pod, Namespace: namespace, ContainerName: "ctr"},
+			lines: []*types.AuditLine{
+				{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable},
+				{AuditType: types.AuditTypeSelinux, Perm: "read", Tclass: "file"},
+				{AuditType: types.AuditTypeApparmor, Apparmor: "DENIED", Operation: "open", Name: "/etc/shadow"},
+			},
+			expected: 3,
+		},
+		{
+			name:    "allowed apparmor operation emits no event",
+			enabled: true,
+			info:    &types.ContainerInfo{PodName: pod, Namespace: namespace, ContainerName: "ctr"},
+			lines: []*types.AuditLine{
+				{AuditType: types.AuditTypeApparmor, Apparmor: "ALLOWED", Operation: "open", Name: "/etc/shadow"},
+			},
+			expected: 0,
+		},
+		{
+			name:    "recorded container emits no event",
+			enabled: true,
+			info: &types.ContainerInfo{
+				PodName: pod, Namespace: namespace, ContainerName: "ctr", RecordProfile: "profile",
+			},
+			lines: []*types.AuditLine{
+				{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable},
+			},
+			expected: 0,
+		},
+		{
+			name:    "disabled emits no event",
+			enabled: false,
+			info:    &types.ContainerInfo{PodName: pod, Namespace: namespace, ContainerName: "ctr"},
+			lines: []*types.AuditLine{
+				{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable},
+			},
+			expected: 0,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sut := New(logr.Discard())
+			sut.impl = &enricherfakes.FakeImpl{}
+			recorder := record.NewFakeRecorder(len(tc.lines))
+			if tc.enabled {
+				sut.recorder = recorder
+			}
+
+			for _, line := range tc.lines {
+				require.Nil(t, sut.dispatchAuditLine(nil, node, line, tc.info))
+			}
+			require.Len(t, recorder.Events, tc.expected)
+		})
+	}
+}
+
+func TestSyscallExecutables(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	info := &types.ContainerInfo{
+		PodName: pod, Namespace: namespace, ContainerName: "ctr", RecordProfile: "profile",
+	}
+
+	for _, line := range []*types.AuditLine{
+		{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable},
+		{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: "/bin/sh"},
+		{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable},
+	} {
+		require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+	}
+
+	request := &apienricher.SyscallsRequest{Profile: "profile"}
+	res, err := sut.Syscalls(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, []string{syscall}, res.GetSyscalls())
+	require.Len(t, res.GetExecutables(), 1)
+	require.ElementsMatch(t, []string{executable, "/bin/sh"}, res.GetExecutables()[syscall].GetNames())
+
+	_, err = sut.ResetSyscalls(context.Background(), request)
+	require.NoError(t, err)
+	_, ok := sut.executables.Load("profile")
+	require.False(t, ok)
+}
+
+func TestEvictOrphanedData(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mock := &enricherfakes.FakeImpl{}
+	sut := New(logr.Discard())
+	sut.impl = mock
+	sut.retention.window = time.Hour
+
+	for _, profile := range []string{"orphaned", "active"} {
+		info := &types.ContainerInfo{
+			PodName: pod, Namespace: namespace, ContainerName: "ctr", RecordProfile: profile,
+		}
+		require.Nil(t, sut.dispatchAuditLine(nil, node, &types.AuditLine{
+			AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable,
+		}, info))
+		require.Nil(t, sut.dispatchAuditLine(nil, node, &types.AuditLine{
+			AuditType: types.AuditTypeSelinux, Perm: "read", Tclass: "file",
+		}, info))
+	}
+
+	// A pending collection gets evicted as well
+	_, err := sut.CollectSyscalls(ctx, &apienricher.SyscallsRequest{Profile: "orphaned"})
+	require.NoError(t, err)
+
+	sut.retention.lastAccess["orphaned"] = time.Now().Add(-2 * time.Hour)
+	sut.evictOrphanedData(node, nil)
+
+	_, ok := sut.avcs.Load("orphaned")
+	require.False(t, ok)
+	require.False(t, sut.syscallCollections.evict("orphaned"))
+	_, ok = sut.syscalls.Load("active")
+	require.True(t, ok)
+	_, ok = sut.avcs.Load("active")
+	require.True(t, ok)
+
+	require.Equal(t, 2, mock.SendEnricherEvictionMetricCallCount())
+	_, first := mock.SendEnricherEvictionMetricArgsForCall(0)
+	require.Equal(t, evictionKindSyscalls, first.GetKind())
+	require.Equal(t, node, first.GetNode())
+	_, second := mock.SendEnricherEvictionMetricArgsForCall(1)
+	require.Equal(t, evictionKindAvcs, second.GetKind())
+
+	// Nothing else expired
+	sut.evictOrphanedData(node, nil)
+	require.Equal(t, 2, mock.SendEnricherEvictionMetricCallCount())
+}
+
+func TestCacheOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		env          map[string]string
+		wantTimeout  time.Duration
+		wantMaxItems uint64
+	}{
+		{
+			name:         "defaults",
+			wantTimeout:  defaultCacheTimeout,
+			wantMaxItems: maxCacheItems,
+		},
+		{
+			name: "configured",
+			env: map[string]string{
+				config.EnricherCacheTTLEnvKey:        "30m",
+				config.EnricherCacheMaxEntriesEnvKey: "5000",
+			},
+			wantTimeout:  30 * time.Minute,
+			wantMaxItems: 5000,
+		},
+		{
+			name: "invalid",
+			env: map[string]string{
+				config.EnricherCacheTTLEnvKey:        "-1m",
+				config.EnricherCacheMaxEntriesEnvKey: "0",
+			},
+			wantTimeout:  defaultCacheTimeout,
+			wantMaxItems: maxCacheItems,
+		},
+	} {
+		env := tc.env
+		wantTimeout := tc.wantTimeout
+		wantMaxItems := tc.wantMaxItems
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			timeout, maxItems := cacheOptions(logr.Discard(), func(key string) string { return env[key] })
+			require.Equal(t, wantTimeout, timeout)
+			require.Equal(t, wantMaxItems, maxItems)
+		})
+	}
+}
+
+func TestContainerIDResolvers(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		env       map[string]string
+		wantNames []string
+	}{
+		{
+			name:      "defaults",
+			wantNames: []string{"crio", "containerd", "docker", "cgroupfs"},
+		},
+		{
+			name: "configured",
+			env: map[string]string{
+				config.EnricherContainerIDResolversEnvKey: "containerd, cgroupfs",
+			},
+			wantNames: []string{"containerd", "cgroupfs"},
+		},
+		{
+			name: "invalid",
+			env: map[string]string{
+				config.EnricherContainerIDResolversEnvKey: "containerd,podman",
+			},
+			wantNames: []string{"crio", "containerd", "docker", "cgroupfs"},
+		},
+	} {
+		env := tc.env
+		wantNames := tc.wantNames
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			names := []string{}
+			for _, r := range containerIDResolvers(logr.Discard(), func(key string) string { return env[key] }) {
+				names = append(names, r.Name())
+			}
+			require.Equal(t, wantNames, names)
+		})
+	}
+}
+
+func TestRetentionDisabled(t *testing.T) {
+	t.Parallel()
+
+	sut := &retention{}
+	sut.touch("profile", time.Now().Add(-time.Hour))
+	require.Empty(t, sut.expired(time.Now()))
+}
+
+func TestCollectSyscalls(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	info := &types.ContainerInfo{
+		PodName: pod, Namespace: namespace, ContainerName: "ctr", RecordProfile: "profile",
+	}
+	dispatch := func(exe string) {
+		line := &types.AuditLine{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: exe}
+		require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+	}
+	request := &apienricher.SyscallsRequest{Profile: "profile"}
+
+	dispatch(executable)
+	first, err := sut.CollectSyscalls(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, []string{syscall}, first.GetSyscalls())
+	require.NotZero(t, first.GetGeneration())
+	_, ok := sut.syscalls.Load("profile")
+	require.False(t, ok)
+
+	// Retrying an unacknowledged collection includes the newly recorded data
+	dispatch("/bin/sh")
+	retry, err := sut.CollectSyscalls(ctx, request)
+	require.NoError(t, err)
+	require.Greater(t, retry.GetGeneration(), first.GetGeneration())
+	require.Equal(t, []string{syscall}, retry.GetSyscalls())
+	require.ElementsMatch(t, []string{executable, "/bin/sh"}, retry.GetExecutables()[syscall].GetNames())
+
+	// A stale generation does not acknowledge the retried collection
+	_, err = sut.ResetSyscalls(ctx, &apienricher.SyscallsRequest{Profile: "profile", Generation: first.GetGeneration()})
+	require.NoError(t, err)
+	retry, err = sut.CollectSyscalls(ctx, request)
+	require.NoError(t, err)
+
+	// Acknowledging keeps the data recorded after the collection
+	dispatch(executable)
+	_, err = sut.ResetSyscalls(ctx, &apienricher.SyscallsRequest{Profile: "profile", Generation: retry.GetGeneration()})
+	require.NoError(t, err)
+	res, err := sut.CollectSyscalls(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, []string{executable}, res.GetExecutables()[syscall].GetNames())
+
+	// Resetting without a generation removes everything
+	_, err = sut.ResetSyscalls(ctx, request)
+	require.NoError(t, err)
+	_, err = sut.CollectSyscalls(ctx, request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCollectAvcs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	info := &types.ContainerInfo{
+		PodName: pod, Namespace: namespace, ContainerName: "ctr",
+		RecordProfile: "profile", SelinuxType: "selinuxrecording.process",
+	}
+	line := &types.AuditLine{
+		AuditType: types.AuditTypeSelinux,
+		Perm:      "read",
+		Scontext:  "system_u:system_r:selinuxrecording.process:s0:c4,c808",
+		Tcontext:  "system_u:object_r:var_lib_t:s0",
+		Tclass:    "file",
+	}
+	require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+
+	request := &apienricher.AvcRequest{Profile: "profile"}
+	res, err := sut.CollectAvcs(ctx, request)
+	require.NoError(t, err)
+	require.Len(t, res.GetAvc(), 1)
+	require.Equal(t, "selinuxrecording.process", res.GetScontext())
+	_, ok := sut.avcs.Load("profile")
+	require.False(t, ok)
+
+	retry, err := sut.CollectAvcs(ctx, request)
+	require.NoError(t, err)
+	require.Len(t, retry.GetAvc(), 1)
+	require.Equal(t, "selinuxrecording.process", retry.GetScontext())
+
+	_, err = sut.ResetAvcs(ctx, &apienricher.AvcRequest{Profile: "profile", Generation: retry.GetGeneration()})
+	require.NoError(t, err)
+	_, err = sut.CollectAvcs(ctx, request)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+type fakeSyscallsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *apienricher.SyscallEvent
+}
+
+func (s *fakeSyscallsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSyscallsStream) Send(event *apienricher.SyscallEvent) error {
+	s.events <- event
+	return nil
+}
+
+func TestSyscallsStream(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	info := &types.ContainerInfo{
+		PodName: pod, Namespace: namespace, ContainerName: "ctr", RecordProfile: "profile",
+	}
+	line := &types.AuditLine{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable}
+	require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeSyscallsStream{ctx: ctx, events: make(chan *apienricher.SyscallEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- sut.SyscallsStream(&apienricher.SyscallsRequest{Profile: "profile"}, stream)
+	}()
+
+	// The already recorded syscalls are sent after subscribing
+	recorded := <-stream.events
+	require.Equal(t, syscall, recorded.GetSyscall())
+	require.Empty(t, recorded.GetExecutable())
+
+	require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+	observed := <-stream.events
+	require.Equal(t, syscall, observed.GetSyscall())
+	require.Equal(t, executable, observed.GetExecutable())
+	require.NotNil(t, observed.GetEventTime())
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Empty(t, sut.syscallStreams.subscribers)
+}
+
+func TestSyscallStreamDrops(t *testing.T) {
+	t.Parallel()
+
+	mock := &enricherfakes.FakeImpl{}
+	sut := New(logr.Discard())
+	sut.impl = mock
+	info := &types.ContainerInfo{
+		PodName: pod, Namespace: namespace, ContainerName: "ctr", RecordProfile: "profile",
+	}
+	line := &types.AuditLine{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable}
+
+	// A subscriber which never reads drops the events exceeding its buffer
+	sut.syscallStreams.subscribe("profile")
+	for i := 0; i < syscallStreamBuffer+2; i++ {
+		require.Nil(t, sut.dispatchAuditLine(nil, node, line, info))
+	}
+	require.EqualValues(t, 2, sut.syscallStreamDrops.Load())
+
+	sut.flushSyscallStreamDrops(node, nil)
+	require.Equal(t, 1, mock.SendSyscallStreamDropMetricCallCount())
+	_, req := mock.SendSyscallStreamDropMetricArgsForCall(0)
+	require.Equal(t, node, req.GetNode())
+	require.EqualValues(t, 2, req.GetCount())
+	require.Zero(t, sut.syscallStreamDrops.Load())
+
+	// Nothing to flush
+	sut.flushSyscallStreamDrops(node, nil)
+	require.Equal(t, 1, mock.SendSyscallStreamDropMetricCallCount())
+}
+
+func TestCorrelateCrashLoops(t *testing.T) {
+	t.Parallel()
+
+	crashingPod := func(reason string, restarts int32) *v1.PodList {
+		return &v1.PodList{Items: []v1.Pod{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod,
+				Namespace: namespace,
+			},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{{
+					Name:         "ctr",
+					RestartCount: restarts,
+					State: v1.ContainerState{
+						Waiting: &v1.ContainerStateWaiting{Reason: reason},
+					},
+				}},
+			},
+		}}}
+	}
+	terminatedPod := func(started, finished time.Time) *v1.PodList {
+		pods := crashingPod(crashLoopBackOff, 1)
+		pods.Items[0].Status.ContainerStatuses[0].LastTerminationState.Terminated = &v1.ContainerStateTerminated{
+			StartedAt:  metav1.NewTime(started),
+			FinishedAt: metav1.NewTime(finished),
+		}
+		return pods
+	}
+	denial := &types.AuditLine{AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable}
+	denialTime := time.Unix(1668191154, 0).UTC()
+	timedDenial := &types.AuditLine{
+		AuditType: types.AuditTypeSeccomp, SystemCallID: 10, Executable: executable, Timestamp: denialTime,
+	}
+
+	for _, tc := range []struct {
+		name     string
+		denial   *types.AuditLine
+		prepare  func(*enricherfakes.FakeImpl)
+		expected int
+	}{
+		{
+			name:   "crash loop with recent denial",
+			denial: denial,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(crashingPod(crashLoopBackOff, 1), nil)
+			},
+			expected: 1,
+		},
+		{
+			name:   "crash loop reported once per restart",
+			denial: denial,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturnsOnCall(0, crashingPod(crashLoopBackOff, 1), nil)
+				mock.ListPodsReturnsOnCall(1, crashingPod(crashLoopBackOff, 1), nil)
+				mock.ListPodsReturnsOnCall(2, crashingPod(crashLoopBackOff, 2), nil)
+			},
+			expected: 2,
+		},
+		{
+			name:   "crash loop with denial during the last run",
+			denial: timedDenial,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(terminatedPod(denialTime.Add(-time.Minute), denialTime.Add(time.Second)), nil)
+			},
+			expected: 1,
+		},
+		{
+			name:   "crash loop with denial within the clock skew tolerance",
+			denial: timedDenial,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(terminatedPod(denialTime.Add(time.Second), denialTime.Add(time.Minute)), nil)
+			},
+			expected: 1,
+		},
+		{
+			name:   "crash loop with denial before the last run",
+			denial: timedDenial,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(terminatedPod(denialTime.Add(time.Minute), denialTime.Add(2*time.Minute)), nil)
+			},
+			expected: 0,
+		},
+		{
+			name:   "crash loop with denial after the last run",
+			denial: timedDenial,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(terminatedPod(denialTime.Add(-2*time.Minute), denialTime.Add(-time.Minute)), nil)
+			},
+			expected: 0,
+		},
+		{
+			name: "crash loop without denial",
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(crashingPod(crashLoopBackOff, 1), nil)
+			},
+			expected: 0,
+		},
+		{
+			name:   "waiting container which is not crash looping",
+			denial: denial,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(crashingPod("ContainerCreating", 0), nil)
+			},
+			expected: 0,
+		},
+		{
+			name:   "failure on ListPods",
+			denial: denial,
+			prepare: func(mock *enricherfakes.FakeImpl) {
+				mock.ListPodsReturns(nil, errTest)
+			},
+			expected: 0,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &enricherfakes.FakeImpl{}
+			tc.prepare(mock)
+
+			sut := New(logr.Discard())
+			sut.impl = mock
+			recorder := record.NewFakeRecorder(10)
+			sut.recorder = recorder
+
+			if tc.denial != nil {
+				info := &types.ContainerInfo{PodName: pod, Namespace: namespace, ContainerName: "ctr"}
+				require.Nil(t, sut.dispatchAuditLine(nil, node, tc.denial, info))
+				// drain the denial event itself
+				<-recorder.Events
+			}
+
+			for i := 0; i < 3; i++ {
+				sut.correlateCrashLoops(node)
+			}
+
+			require.Len(t, recorder.Events, tc.expected)
+			if tc.expected > 0 {
+				require.Contains(t, <-recorder.Events, reasonDenialCrash)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package enricherfakes
 
 import (
 	"context"
+	"io"
 	"io/fs"
 	"net"
 	"sync"
@@ -29,8 +30,13 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	api_metrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/auditnetlink"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/cri"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/journal"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
 type FakeImpl struct {
@@ -41,17 +47,54 @@ type FakeImpl struct {
 		arg2 string
 		arg3 []*types.AuditLine
 	}
-	AuditIncStub        func(api_metrics.MetricsClient) (api_metrics.Metrics_AuditIncClient, error)
-	auditIncMutex       sync.RWMutex
-	auditIncArgsForCall []struct {
+	AuditBatchIncStub        func(api_metrics.MetricsClient) (api_metrics.Metrics_AuditBatchIncClient, error)
+	auditBatchIncMutex       sync.RWMutex
+	auditBatchIncArgsForCall []struct {
 		arg1 api_metrics.MetricsClient
 	}
-	auditIncReturns struct {
-		result1 api_metrics.Metrics_AuditIncClient
+	auditBatchIncReturns struct {
+		result1 api_metrics.Metrics_AuditBatchIncClient
 		result2 error
 	}
-	auditIncReturnsOnCall map[int]struct {
-		result1 api_metrics.Metrics_AuditIncClient
+	auditBatchIncReturnsOnCall map[int]struct {
+		result1 api_metrics.Metrics_AuditBatchIncClient
+		result2 error
+	}
+	AuditLinesStub        func(*auditnetlink.Subscription) chan *tail.Line
+	auditLinesMutex       sync.RWMutex
+	auditLinesArgsForCall []struct {
+		arg1 *auditnetlink.Subscription
+	}
+	auditLinesReturns struct {
+		result1 chan *tail.Line
+	}
+	auditLinesReturnsOnCall map[int]struct {
+		result1 chan *tail.Line
+	}
+	AuditReasonStub        func(*auditnetlink.Subscription) error
+	auditReasonMutex       sync.RWMutex
+	auditReasonArgsForCall []struct {
+		arg1 *auditnetlink.Subscription
+	}
+	auditReasonReturns struct {
+		result1 error
+	}
+	auditReasonReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CRIContainerStub        func(context.Context, *cri.Client, string) (*cri.Container, error)
+	cRIContainerMutex       sync.RWMutex
+	cRIContainerArgsForCall []struct {
+		arg1 context.Context
+		arg2 *cri.Client
+		arg3 string
+	}
+	cRIContainerReturns struct {
+		result1 *cri.Container
+		result2 error
+	}
+	cRIContainerReturnsOnCall map[int]struct {
+		result1 *cri.Container
 		result2 error
 	}
 	ChownStub        func(string, int, int) error
@@ -78,18 +121,34 @@ type FakeImpl struct {
 	closeReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ContainerIDForPIDStub        func(*ttlcache.Cache[string, string], int) (string, error)
+	ContainerIDForPIDStub        func(*ttlcache.Cache[string, string], []util.ContainerIDResolver, int) (string, string, error)
 	containerIDForPIDMutex       sync.RWMutex
 	containerIDForPIDArgsForCall []struct {
 		arg1 *ttlcache.Cache[string, string]
-		arg2 int
+		arg2 []util.ContainerIDResolver
+		arg3 int
 	}
 	containerIDForPIDReturns struct {
 		result1 string
-		result2 error
+		result2 string
+		result3 error
 	}
 	containerIDForPIDReturnsOnCall map[int]struct {
 		result1 string
+		result2 string
+		result3 error
+	}
+	ContainerIDResolutionIncStub        func(api_metrics.MetricsClient) (api_metrics.Metrics_ContainerIDResolutionIncClient, error)
+	containerIDResolutionIncMutex       sync.RWMutex
+	containerIDResolutionIncArgsForCall []struct {
+		arg1 api_metrics.MetricsClient
+	}
+	containerIDResolutionIncReturns struct {
+		result1 api_metrics.Metrics_ContainerIDResolutionIncClient
+		result2 error
+	}
+	containerIDResolutionIncReturnsOnCall map[int]struct {
+		result1 api_metrics.Metrics_ContainerIDResolutionIncClient
 		result2 error
 	}
 	DialStub        func() (*grpc.ClientConn, context.CancelFunc, error)
@@ -106,12 +165,38 @@ type FakeImpl struct {
 		result2 context.CancelFunc
 		result3 error
 	}
+	EnricherEvictionIncStub        func(api_metrics.MetricsClient) (api_metrics.Metrics_EnricherEvictionIncClient, error)
+	enricherEvictionIncMutex       sync.RWMutex
+	enricherEvictionIncArgsForCall []struct {
+		arg1 api_metrics.MetricsClient
+	}
+	enricherEvictionIncReturns struct {
+		result1 api_metrics.Metrics_EnricherEvictionIncClient
+		result2 error
+	}
+	enricherEvictionIncReturnsOnCall map[int]struct {
+		result1 api_metrics.Metrics_EnricherEvictionIncClient
+		result2 error
+	}
 	FlushBacklogStub        func(*ttlcache.Cache[string, []*types.AuditLine], string)
 	flushBacklogMutex       sync.RWMutex
 	flushBacklogArgsForCall []struct {
 		arg1 *ttlcache.Cache[string, []*types.AuditLine]
 		arg2 string
 	}
+	FollowJournalStub        func(string) (*journal.Reader, error)
+	followJournalMutex       sync.RWMutex
+	followJournalArgsForCall []struct {
+		arg1 string
+	}
+	followJournalReturns struct {
+		result1 *journal.Reader
+		result2 error
+	}
+	followJournalReturnsOnCall map[int]struct {
+		result1 *journal.Reader
+		result2 error
+	}
 	GetFromBacklogStub        func(*ttlcache.Cache[string, []*types.AuditLine], string) []*types.AuditLine
 	getFromBacklogMutex       sync.RWMutex
 	getFromBacklogArgsForCall []struct {
@@ -147,6 +232,28 @@ type FakeImpl struct {
 		result1 *rest.Config
 		result2 error
 	}
+	JournalLinesStub        func(*journal.Reader) chan *tail.Line
+	journalLinesMutex       sync.RWMutex
+	journalLinesArgsForCall []struct {
+		arg1 *journal.Reader
+	}
+	journalLinesReturns struct {
+		result1 chan *tail.Line
+	}
+	journalLinesReturnsOnCall map[int]struct {
+		result1 chan *tail.Line
+	}
+	JournalReasonStub        func(*journal.Reader) error
+	journalReasonMutex       sync.RWMutex
+	journalReasonArgsForCall []struct {
+		arg1 *journal.Reader
+	}
+	journalReasonReturns struct {
+		result1 error
+	}
+	journalReasonReturnsOnCall map[int]struct {
+		result1 error
+	}
 	LinesStub        func(*tail.Tail) chan *tail.Line
 	linesMutex       sync.RWMutex
 	linesArgsForCall []struct {
@@ -187,6 +294,31 @@ type FakeImpl struct {
 		result1 net.Listener
 		result2 error
 	}
+	NewCRIClientStub        func(string) (*cri.Client, error)
+	newCRIClientMutex       sync.RWMutex
+	newCRIClientArgsForCall []struct {
+		arg1 string
+	}
+	newCRIClientReturns struct {
+		result1 *cri.Client
+		result2 error
+	}
+	newCRIClientReturnsOnCall map[int]struct {
+		result1 *cri.Client
+		result2 error
+	}
+	NewEventRecorderStub        func(kubernetes.Interface, string) record.EventRecorder
+	newEventRecorderMutex       sync.RWMutex
+	newEventRecorderArgsForCall []struct {
+		arg1 kubernetes.Interface
+		arg2 string
+	}
+	newEventRecorderReturns struct {
+		result1 record.EventRecorder
+	}
+	newEventRecorderReturnsOnCall map[int]struct {
+		result1 record.EventRecorder
+	}
 	NewForConfigStub        func(*rest.Config) (*kubernetes.Clientset, error)
 	newForConfigMutex       sync.RWMutex
 	newForConfigArgsForCall []struct {
@@ -200,6 +332,19 @@ type FakeImpl struct {
 		result1 *kubernetes.Clientset
 		result2 error
 	}
+	OpenStub        func(string) (io.ReadCloser, error)
+	openMutex       sync.RWMutex
+	openArgsForCall []struct {
+		arg1 string
+	}
+	openReturns struct {
+		result1 io.ReadCloser
+		result2 error
+	}
+	openReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 error
+	}
 	ReasonStub        func(*tail.Tail) error
 	reasonMutex       sync.RWMutex
 	reasonArgsForCall []struct {
@@ -222,11 +367,35 @@ type FakeImpl struct {
 	removeAllReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SendMetricStub        func(api_metrics.Metrics_AuditIncClient, *api_metrics.AuditRequest) error
+	SendContainerIDResolutionMetricStub        func(api_metrics.Metrics_ContainerIDResolutionIncClient, *api_metrics.ContainerIDResolutionRequest) error
+	sendContainerIDResolutionMetricMutex       sync.RWMutex
+	sendContainerIDResolutionMetricArgsForCall []struct {
+		arg1 api_metrics.Metrics_ContainerIDResolutionIncClient
+		arg2 *api_metrics.ContainerIDResolutionRequest
+	}
+	sendContainerIDResolutionMetricReturns struct {
+		result1 error
+	}
+	sendContainerIDResolutionMetricReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendEnricherEvictionMetricStub        func(api_metrics.Metrics_EnricherEvictionIncClient, *api_metrics.EnricherEvictionRequest) error
+	sendEnricherEvictionMetricMutex       sync.RWMutex
+	sendEnricherEvictionMetricArgsForCall []struct {
+		arg1 api_metrics.Metrics_EnricherEvictionIncClient
+		arg2 *api_metrics.EnricherEvictionRequest
+	}
+	sendEnricherEvictionMetricReturns struct {
+		result1 error
+	}
+	sendEnricherEvictionMetricReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SendMetricStub        func(api_metrics.Metrics_AuditBatchIncClient, *api_metrics.AuditBatchRequest) error
 	sendMetricMutex       sync.RWMutex
 	sendMetricArgsForCall []struct {
-		arg1 api_metrics.Metrics_AuditIncClient
-		arg2 *api_metrics.AuditRequest
+		arg1 api_metrics.Metrics_AuditBatchIncClient
+		arg2 *api_metrics.AuditBatchRequest
 	}
 	sendMetricReturns struct {
 		result1 error
@@ -234,6 +403,18 @@ type FakeImpl struct {
 	sendMetricReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SendSyscallStreamDropMetricStub        func(api_metrics.Metrics_SyscallStreamDropIncClient, *api_metrics.SyscallStreamDropRequest) error
+	sendSyscallStreamDropMetricMutex       sync.RWMutex
+	sendSyscallStreamDropMetricArgsForCall []struct {
+		arg1 api_metrics.Metrics_SyscallStreamDropIncClient
+		arg2 *api_metrics.SyscallStreamDropRequest
+	}
+	sendSyscallStreamDropMetricReturns struct {
+		result1 error
+	}
+	sendSyscallStreamDropMetricReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ServeStub        func(*grpc.Server, net.Listener) error
 	serveMutex       sync.RWMutex
 	serveArgsForCall []struct {
@@ -259,6 +440,31 @@ type FakeImpl struct {
 		result1 fs.FileInfo
 		result2 error
 	}
+	SubscribeAuditStub        func() (*auditnetlink.Subscription, error)
+	subscribeAuditMutex       sync.RWMutex
+	subscribeAuditArgsForCall []struct {
+	}
+	subscribeAuditReturns struct {
+		result1 *auditnetlink.Subscription
+		result2 error
+	}
+	subscribeAuditReturnsOnCall map[int]struct {
+		result1 *auditnetlink.Subscription
+		result2 error
+	}
+	SyscallStreamDropIncStub        func(api_metrics.MetricsClient) (api_metrics.Metrics_SyscallStreamDropIncClient, error)
+	syscallStreamDropIncMutex       sync.RWMutex
+	syscallStreamDropIncArgsForCall []struct {
+		arg1 api_metrics.MetricsClient
+	}
+	syscallStreamDropIncReturns struct {
+		result1 api_metrics.Metrics_SyscallStreamDropIncClient
+		result2 error
+	}
+	syscallStreamDropIncReturnsOnCall map[int]struct {
+		result1 api_metrics.Metrics_SyscallStreamDropIncClient
+		result2 error
+	}
 	TailFileStub        func(string, tail.Config) (*tail.Tail, error)
 	tailFileMutex       sync.RWMutex
 	tailFileArgsForCall []struct {
@@ -316,16 +522,16 @@ func (fake *FakeImpl) AddToBacklogArgsForCall(i int) (*ttlcache.Cache[string, []
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeImpl) AuditInc(arg1 api_metrics.MetricsClient) (api_metrics.Metrics_AuditIncClient, error) {
-	fake.auditIncMutex.Lock()
-	ret, specificReturn := fake.auditIncReturnsOnCall[len(fake.auditIncArgsForCall)]
-	fake.auditIncArgsForCall = append(fake.auditIncArgsForCall, struct {
+func (fake *FakeImpl) AuditBatchInc(arg1 api_metrics.MetricsClient) (api_metrics.Metrics_AuditBatchIncClient, error) {
+	fake.auditBatchIncMutex.Lock()
+	ret, specificReturn := fake.auditBatchIncReturnsOnCall[len(fake.auditBatchIncArgsForCall)]
+	fake.auditBatchIncArgsForCall = append(fake.auditBatchIncArgsForCall, struct {
 		arg1 api_metrics.MetricsClient
 	}{arg1})
-	stub := fake.AuditIncStub
-	fakeReturns := fake.auditIncReturns
-	fake.recordInvocation("AuditInc", []interface{}{arg1})
-	fake.auditIncMutex.Unlock()
+	stub := fake.AuditBatchIncStub
+	fakeReturns := fake.auditBatchIncReturns
+	fake.recordInvocation("AuditBatchInc", []interface{}{arg1})
+	fake.auditBatchIncMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -335,47 +541,235 @@ func (fake *FakeImpl) AuditInc(arg1 api_metrics.MetricsClient) (api_metrics.Metr
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeImpl) AuditIncCallCount() int {
-	fake.auditIncMutex.RLock()
-	defer fake.auditIncMutex.RUnlock()
-	return len(fake.auditIncArgsForCall)
+func (fake *FakeImpl) AuditBatchIncCallCount() int {
+	fake.auditBatchIncMutex.RLock()
+	defer fake.auditBatchIncMutex.RUnlock()
+	return len(fake.auditBatchIncArgsForCall)
+}
+
+func (fake *FakeImpl) AuditBatchIncCalls(stub func(api_metrics.MetricsClient) (api_metrics.Metrics_AuditBatchIncClient, error)) {
+	fake.auditBatchIncMutex.Lock()
+	defer fake.auditBatchIncMutex.Unlock()
+	fake.AuditBatchIncStub = stub
+}
+
+func (fake *FakeImpl) AuditBatchIncArgsForCall(i int) api_metrics.MetricsClient {
+	fake.auditBatchIncMutex.RLock()
+	defer fake.auditBatchIncMutex.RUnlock()
+	argsForCall := fake.auditBatchIncArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) AuditBatchIncReturns(result1 api_metrics.Metrics_AuditBatchIncClient, result2 error) {
+	fake.auditBatchIncMutex.Lock()
+	defer fake.auditBatchIncMutex.Unlock()
+	fake.AuditBatchIncStub = nil
+	fake.auditBatchIncReturns = struct {
+		result1 api_metrics.Metrics_AuditBatchIncClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AuditBatchIncReturnsOnCall(i int, result1 api_metrics.Metrics_AuditBatchIncClient, result2 error) {
+	fake.auditBatchIncMutex.Lock()
+	defer fake.auditBatchIncMutex.Unlock()
+	fake.AuditBatchIncStub = nil
+	if fake.auditBatchIncReturnsOnCall == nil {
+		fake.auditBatchIncReturnsOnCall = make(map[int]struct {
+			result1 api_metrics.Metrics_AuditBatchIncClient
+			result2 error
+		})
+	}
+	fake.auditBatchIncReturnsOnCall[i] = struct {
+		result1 api_metrics.Metrics_AuditBatchIncClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) AuditLines(arg1 *auditnetlink.Subscription) chan *tail.Line {
+	fake.auditLinesMutex.Lock()
+	ret, specificReturn := fake.auditLinesReturnsOnCall[len(fake.auditLinesArgsForCall)]
+	fake.auditLinesArgsForCall = append(fake.auditLinesArgsForCall, struct {
+		arg1 *auditnetlink.Subscription
+	}{arg1})
+	stub := fake.AuditLinesStub
+	fakeReturns := fake.auditLinesReturns
+	fake.recordInvocation("AuditLines", []interface{}{arg1})
+	fake.auditLinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) AuditLinesCallCount() int {
+	fake.auditLinesMutex.RLock()
+	defer fake.auditLinesMutex.RUnlock()
+	return len(fake.auditLinesArgsForCall)
+}
+
+func (fake *FakeImpl) AuditLinesCalls(stub func(*auditnetlink.Subscription) chan *tail.Line) {
+	fake.auditLinesMutex.Lock()
+	defer fake.auditLinesMutex.Unlock()
+	fake.AuditLinesStub = stub
+}
+
+func (fake *FakeImpl) AuditLinesArgsForCall(i int) *auditnetlink.Subscription {
+	fake.auditLinesMutex.RLock()
+	defer fake.auditLinesMutex.RUnlock()
+	argsForCall := fake.auditLinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) AuditLinesReturns(result1 chan *tail.Line) {
+	fake.auditLinesMutex.Lock()
+	defer fake.auditLinesMutex.Unlock()
+	fake.AuditLinesStub = nil
+	fake.auditLinesReturns = struct {
+		result1 chan *tail.Line
+	}{result1}
+}
+
+func (fake *FakeImpl) AuditLinesReturnsOnCall(i int, result1 chan *tail.Line) {
+	fake.auditLinesMutex.Lock()
+	defer fake.auditLinesMutex.Unlock()
+	fake.AuditLinesStub = nil
+	if fake.auditLinesReturnsOnCall == nil {
+		fake.auditLinesReturnsOnCall = make(map[int]struct {
+			result1 chan *tail.Line
+		})
+	}
+	fake.auditLinesReturnsOnCall[i] = struct {
+		result1 chan *tail.Line
+	}{result1}
+}
+
+func (fake *FakeImpl) AuditReason(arg1 *auditnetlink.Subscription) error {
+	fake.auditReasonMutex.Lock()
+	ret, specificReturn := fake.auditReasonReturnsOnCall[len(fake.auditReasonArgsForCall)]
+	fake.auditReasonArgsForCall = append(fake.auditReasonArgsForCall, struct {
+		arg1 *auditnetlink.Subscription
+	}{arg1})
+	stub := fake.AuditReasonStub
+	fakeReturns := fake.auditReasonReturns
+	fake.recordInvocation("AuditReason", []interface{}{arg1})
+	fake.auditReasonMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) AuditReasonCallCount() int {
+	fake.auditReasonMutex.RLock()
+	defer fake.auditReasonMutex.RUnlock()
+	return len(fake.auditReasonArgsForCall)
 }
 
-func (fake *FakeImpl) AuditIncCalls(stub func(api_metrics.MetricsClient) (api_metrics.Metrics_AuditIncClient, error)) {
-	fake.auditIncMutex.Lock()
-	defer fake.auditIncMutex.Unlock()
-	fake.AuditIncStub = stub
+func (fake *FakeImpl) AuditReasonCalls(stub func(*auditnetlink.Subscription) error) {
+	fake.auditReasonMutex.Lock()
+	defer fake.auditReasonMutex.Unlock()
+	fake.AuditReasonStub = stub
 }
 
-func (fake *FakeImpl) AuditIncArgsForCall(i int) api_metrics.MetricsClient {
-	fake.auditIncMutex.RLock()
-	defer fake.auditIncMutex.RUnlock()
-	argsForCall := fake.auditIncArgsForCall[i]
+func (fake *FakeImpl) AuditReasonArgsForCall(i int) *auditnetlink.Subscription {
+	fake.auditReasonMutex.RLock()
+	defer fake.auditReasonMutex.RUnlock()
+	argsForCall := fake.auditReasonArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeImpl) AuditIncReturns(result1 api_metrics.Metrics_AuditIncClient, result2 error) {
-	fake.auditIncMutex.Lock()
-	defer fake.auditIncMutex.Unlock()
-	fake.AuditIncStub = nil
-	fake.auditIncReturns = struct {
-		result1 api_metrics.Metrics_AuditIncClient
+func (fake *FakeImpl) AuditReasonReturns(result1 error) {
+	fake.auditReasonMutex.Lock()
+	defer fake.auditReasonMutex.Unlock()
+	fake.AuditReasonStub = nil
+	fake.auditReasonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) AuditReasonReturnsOnCall(i int, result1 error) {
+	fake.auditReasonMutex.Lock()
+	defer fake.auditReasonMutex.Unlock()
+	fake.AuditReasonStub = nil
+	if fake.auditReasonReturnsOnCall == nil {
+		fake.auditReasonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.auditReasonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) CRIContainer(arg1 context.Context, arg2 *cri.Client, arg3 string) (*cri.Container, error) {
+	fake.cRIContainerMutex.Lock()
+	ret, specificReturn := fake.cRIContainerReturnsOnCall[len(fake.cRIContainerArgsForCall)]
+	fake.cRIContainerArgsForCall = append(fake.cRIContainerArgsForCall, struct {
+		arg1 context.Context
+		arg2 *cri.Client
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CRIContainerStub
+	fakeReturns := fake.cRIContainerReturns
+	fake.recordInvocation("CRIContainer", []interface{}{arg1, arg2, arg3})
+	fake.cRIContainerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) CRIContainerCallCount() int {
+	fake.cRIContainerMutex.RLock()
+	defer fake.cRIContainerMutex.RUnlock()
+	return len(fake.cRIContainerArgsForCall)
+}
+
+func (fake *FakeImpl) CRIContainerCalls(stub func(context.Context, *cri.Client, string) (*cri.Container, error)) {
+	fake.cRIContainerMutex.Lock()
+	defer fake.cRIContainerMutex.Unlock()
+	fake.CRIContainerStub = stub
+}
+
+func (fake *FakeImpl) CRIContainerArgsForCall(i int) (context.Context, *cri.Client, string) {
+	fake.cRIContainerMutex.RLock()
+	defer fake.cRIContainerMutex.RUnlock()
+	argsForCall := fake.cRIContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) CRIContainerReturns(result1 *cri.Container, result2 error) {
+	fake.cRIContainerMutex.Lock()
+	defer fake.cRIContainerMutex.Unlock()
+	fake.CRIContainerStub = nil
+	fake.cRIContainerReturns = struct {
+		result1 *cri.Container
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) AuditIncReturnsOnCall(i int, result1 api_metrics.Metrics_AuditIncClient, result2 error) {
-	fake.auditIncMutex.Lock()
-	defer fake.auditIncMutex.Unlock()
-	fake.AuditIncStub = nil
-	if fake.auditIncReturnsOnCall == nil {
-		fake.auditIncReturnsOnCall = make(map[int]struct {
-			result1 api_metrics.Metrics_AuditIncClient
+func (fake *FakeImpl) CRIContainerReturnsOnCall(i int, result1 *cri.Container, result2 error) {
+	fake.cRIContainerMutex.Lock()
+	defer fake.cRIContainerMutex.Unlock()
+	fake.CRIContainerStub = nil
+	if fake.cRIContainerReturnsOnCall == nil {
+		fake.cRIContainerReturnsOnCall = make(map[int]struct {
+			result1 *cri.Container
 			result2 error
 		})
 	}
-	fake.auditIncReturnsOnCall[i] = struct {
-		result1 api_metrics.Metrics_AuditIncClient
+	fake.cRIContainerReturnsOnCall[i] = struct {
+		result1 *cri.Container
 		result2 error
 	}{result1, result2}
 }
@@ -504,24 +898,30 @@ func (fake *FakeImpl) CloseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImpl) ContainerIDForPID(arg1 *ttlcache.Cache[string, string], arg2 int) (string, error) {
+func (fake *FakeImpl) ContainerIDForPID(arg1 *ttlcache.Cache[string, string], arg2 []util.ContainerIDResolver, arg3 int) (string, string, error) {
+	var arg2Copy []util.ContainerIDResolver
+	if arg2 != nil {
+		arg2Copy = make([]util.ContainerIDResolver, len(arg2))
+		copy(arg2Copy, arg2)
+	}
 	fake.containerIDForPIDMutex.Lock()
 	ret, specificReturn := fake.containerIDForPIDReturnsOnCall[len(fake.containerIDForPIDArgsForCall)]
 	fake.containerIDForPIDArgsForCall = append(fake.containerIDForPIDArgsForCall, struct {
 		arg1 *ttlcache.Cache[string, string]
-		arg2 int
-	}{arg1, arg2})
+		arg2 []util.ContainerIDResolver
+		arg3 int
+	}{arg1, arg2Copy, arg3})
 	stub := fake.ContainerIDForPIDStub
 	fakeReturns := fake.containerIDForPIDReturns
-	fake.recordInvocation("ContainerIDForPID", []interface{}{arg1, arg2})
+	fake.recordInvocation("ContainerIDForPID", []interface{}{arg1, arg2Copy, arg3})
 	fake.containerIDForPIDMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeImpl) ContainerIDForPIDCallCount() int {
@@ -530,41 +930,108 @@ func (fake *FakeImpl) ContainerIDForPIDCallCount() int {
 	return len(fake.containerIDForPIDArgsForCall)
 }
 
-func (fake *FakeImpl) ContainerIDForPIDCalls(stub func(*ttlcache.Cache[string, string], int) (string, error)) {
+func (fake *FakeImpl) ContainerIDForPIDCalls(stub func(*ttlcache.Cache[string, string], []util.ContainerIDResolver, int) (string, string, error)) {
 	fake.containerIDForPIDMutex.Lock()
 	defer fake.containerIDForPIDMutex.Unlock()
 	fake.ContainerIDForPIDStub = stub
 }
 
-func (fake *FakeImpl) ContainerIDForPIDArgsForCall(i int) (*ttlcache.Cache[string, string], int) {
+func (fake *FakeImpl) ContainerIDForPIDArgsForCall(i int) (*ttlcache.Cache[string, string], []util.ContainerIDResolver, int) {
 	fake.containerIDForPIDMutex.RLock()
 	defer fake.containerIDForPIDMutex.RUnlock()
 	argsForCall := fake.containerIDForPIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeImpl) ContainerIDForPIDReturns(result1 string, result2 error) {
+func (fake *FakeImpl) ContainerIDForPIDReturns(result1 string, result2 string, result3 error) {
 	fake.containerIDForPIDMutex.Lock()
 	defer fake.containerIDForPIDMutex.Unlock()
 	fake.ContainerIDForPIDStub = nil
 	fake.containerIDForPIDReturns = struct {
 		result1 string
-		result2 error
-	}{result1, result2}
+		result2 string
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeImpl) ContainerIDForPIDReturnsOnCall(i int, result1 string, result2 error) {
+func (fake *FakeImpl) ContainerIDForPIDReturnsOnCall(i int, result1 string, result2 string, result3 error) {
 	fake.containerIDForPIDMutex.Lock()
 	defer fake.containerIDForPIDMutex.Unlock()
 	fake.ContainerIDForPIDStub = nil
 	if fake.containerIDForPIDReturnsOnCall == nil {
 		fake.containerIDForPIDReturnsOnCall = make(map[int]struct {
 			result1 string
-			result2 error
+			result2 string
+			result3 error
 		})
 	}
 	fake.containerIDForPIDReturnsOnCall[i] = struct {
 		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImpl) ContainerIDResolutionInc(arg1 api_metrics.MetricsClient) (api_metrics.Metrics_ContainerIDResolutionIncClient, error) {
+	fake.containerIDResolutionIncMutex.Lock()
+	ret, specificReturn := fake.containerIDResolutionIncReturnsOnCall[len(fake.containerIDResolutionIncArgsForCall)]
+	fake.containerIDResolutionIncArgsForCall = append(fake.containerIDResolutionIncArgsForCall, struct {
+		arg1 api_metrics.MetricsClient
+	}{arg1})
+	stub := fake.ContainerIDResolutionIncStub
+	fakeReturns := fake.containerIDResolutionIncReturns
+	fake.recordInvocation("ContainerIDResolutionInc", []interface{}{arg1})
+	fake.containerIDResolutionIncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ContainerIDResolutionIncCallCount() int {
+	fake.containerIDResolutionIncMutex.RLock()
+	defer fake.containerIDResolutionIncMutex.RUnlock()
+	return len(fake.containerIDResolutionIncArgsForCall)
+}
+
+func (fake *FakeImpl) ContainerIDResolutionIncCalls(stub func(api_metrics.MetricsClient) (api_metrics.Metrics_ContainerIDResolutionIncClient, error)) {
+	fake.containerIDResolutionIncMutex.Lock()
+	defer fake.containerIDResolutionIncMutex.Unlock()
+	fake.ContainerIDResolutionIncStub = stub
+}
+
+func (fake *FakeImpl) ContainerIDResolutionIncArgsForCall(i int) api_metrics.MetricsClient {
+	fake.containerIDResolutionIncMutex.RLock()
+	defer fake.containerIDResolutionIncMutex.RUnlock()
+	argsForCall := fake.containerIDResolutionIncArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ContainerIDResolutionIncReturns(result1 api_metrics.Metrics_ContainerIDResolutionIncClient, result2 error) {
+	fake.containerIDResolutionIncMutex.Lock()
+	defer fake.containerIDResolutionIncMutex.Unlock()
+	fake.ContainerIDResolutionIncStub = nil
+	fake.containerIDResolutionIncReturns = struct {
+		result1 api_metrics.Metrics_ContainerIDResolutionIncClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ContainerIDResolutionIncReturnsOnCall(i int, result1 api_metrics.Metrics_ContainerIDResolutionIncClient, result2 error) {
+	fake.containerIDResolutionIncMutex.Lock()
+	defer fake.containerIDResolutionIncMutex.Unlock()
+	fake.ContainerIDResolutionIncStub = nil
+	if fake.containerIDResolutionIncReturnsOnCall == nil {
+		fake.containerIDResolutionIncReturnsOnCall = make(map[int]struct {
+			result1 api_metrics.Metrics_ContainerIDResolutionIncClient
+			result2 error
+		})
+	}
+	fake.containerIDResolutionIncReturnsOnCall[i] = struct {
+		result1 api_metrics.Metrics_ContainerIDResolutionIncClient
 		result2 error
 	}{result1, result2}
 }
@@ -628,6 +1095,70 @@ func (fake *FakeImpl) DialReturnsOnCall(i int, result1 *grpc.ClientConn, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeImpl) EnricherEvictionInc(arg1 api_metrics.MetricsClient) (api_metrics.Metrics_EnricherEvictionIncClient, error) {
+	fake.enricherEvictionIncMutex.Lock()
+	ret, specificReturn := fake.enricherEvictionIncReturnsOnCall[len(fake.enricherEvictionIncArgsForCall)]
+	fake.enricherEvictionIncArgsForCall = append(fake.enricherEvictionIncArgsForCall, struct {
+		arg1 api_metrics.MetricsClient
+	}{arg1})
+	stub := fake.EnricherEvictionIncStub
+	fakeReturns := fake.enricherEvictionIncReturns
+	fake.recordInvocation("EnricherEvictionInc", []interface{}{arg1})
+	fake.enricherEvictionIncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) EnricherEvictionIncCallCount() int {
+	fake.enricherEvictionIncMutex.RLock()
+	defer fake.enricherEvictionIncMutex.RUnlock()
+	return len(fake.enricherEvictionIncArgsForCall)
+}
+
+func (fake *FakeImpl) EnricherEvictionIncCalls(stub func(api_metrics.MetricsClient) (api_metrics.Metrics_EnricherEvictionIncClient, error)) {
+	fake.enricherEvictionIncMutex.Lock()
+	defer fake.enricherEvictionIncMutex.Unlock()
+	fake.EnricherEvictionIncStub = stub
+}
+
+func (fake *FakeImpl) EnricherEvictionIncArgsForCall(i int) api_metrics.MetricsClient {
+	fake.enricherEvictionIncMutex.RLock()
+	defer fake.enricherEvictionIncMutex.RUnlock()
+	argsForCall := fake.enricherEvictionIncArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) EnricherEvictionIncReturns(result1 api_metrics.Metrics_EnricherEvictionIncClient, result2 error) {
+	fake.enricherEvictionIncMutex.Lock()
+	defer fake.enricherEvictionIncMutex.Unlock()
+	fake.EnricherEvictionIncStub = nil
+	fake.enricherEvictionIncReturns = struct {
+		result1 api_metrics.Metrics_EnricherEvictionIncClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) EnricherEvictionIncReturnsOnCall(i int, result1 api_metrics.Metrics_EnricherEvictionIncClient, result2 error) {
+	fake.enricherEvictionIncMutex.Lock()
+	defer fake.enricherEvictionIncMutex.Unlock()
+	fake.EnricherEvictionIncStub = nil
+	if fake.enricherEvictionIncReturnsOnCall == nil {
+		fake.enricherEvictionIncReturnsOnCall = make(map[int]struct {
+			result1 api_metrics.Metrics_EnricherEvictionIncClient
+			result2 error
+		})
+	}
+	fake.enricherEvictionIncReturnsOnCall[i] = struct {
+		result1 api_metrics.Metrics_EnricherEvictionIncClient
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) FlushBacklog(arg1 *ttlcache.Cache[string, []*types.AuditLine], arg2 string) {
 	fake.flushBacklogMutex.Lock()
 	fake.flushBacklogArgsForCall = append(fake.flushBacklogArgsForCall, struct {
@@ -661,6 +1192,70 @@ func (fake *FakeImpl) FlushBacklogArgsForCall(i int) (*ttlcache.Cache[string, []
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeImpl) FollowJournal(arg1 string) (*journal.Reader, error) {
+	fake.followJournalMutex.Lock()
+	ret, specificReturn := fake.followJournalReturnsOnCall[len(fake.followJournalArgsForCall)]
+	fake.followJournalArgsForCall = append(fake.followJournalArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FollowJournalStub
+	fakeReturns := fake.followJournalReturns
+	fake.recordInvocation("FollowJournal", []interface{}{arg1})
+	fake.followJournalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) FollowJournalCallCount() int {
+	fake.followJournalMutex.RLock()
+	defer fake.followJournalMutex.RUnlock()
+	return len(fake.followJournalArgsForCall)
+}
+
+func (fake *FakeImpl) FollowJournalCalls(stub func(string) (*journal.Reader, error)) {
+	fake.followJournalMutex.Lock()
+	defer fake.followJournalMutex.Unlock()
+	fake.FollowJournalStub = stub
+}
+
+func (fake *FakeImpl) FollowJournalArgsForCall(i int) string {
+	fake.followJournalMutex.RLock()
+	defer fake.followJournalMutex.RUnlock()
+	argsForCall := fake.followJournalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) FollowJournalReturns(result1 *journal.Reader, result2 error) {
+	fake.followJournalMutex.Lock()
+	defer fake.followJournalMutex.Unlock()
+	fake.FollowJournalStub = nil
+	fake.followJournalReturns = struct {
+		result1 *journal.Reader
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) FollowJournalReturnsOnCall(i int, result1 *journal.Reader, result2 error) {
+	fake.followJournalMutex.Lock()
+	defer fake.followJournalMutex.Unlock()
+	fake.FollowJournalStub = nil
+	if fake.followJournalReturnsOnCall == nil {
+		fake.followJournalReturnsOnCall = make(map[int]struct {
+			result1 *journal.Reader
+			result2 error
+		})
+	}
+	fake.followJournalReturnsOnCall[i] = struct {
+		result1 *journal.Reader
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) GetFromBacklog(arg1 *ttlcache.Cache[string, []*types.AuditLine], arg2 string) []*types.AuditLine {
 	fake.getFromBacklogMutex.Lock()
 	ret, specificReturn := fake.getFromBacklogReturnsOnCall[len(fake.getFromBacklogArgsForCall)]
@@ -802,42 +1397,164 @@ func (fake *FakeImpl) InClusterConfig() (*rest.Config, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeImpl) InClusterConfigCallCount() int {
-	fake.inClusterConfigMutex.RLock()
-	defer fake.inClusterConfigMutex.RUnlock()
-	return len(fake.inClusterConfigArgsForCall)
+func (fake *FakeImpl) InClusterConfigCallCount() int {
+	fake.inClusterConfigMutex.RLock()
+	defer fake.inClusterConfigMutex.RUnlock()
+	return len(fake.inClusterConfigArgsForCall)
+}
+
+func (fake *FakeImpl) InClusterConfigCalls(stub func() (*rest.Config, error)) {
+	fake.inClusterConfigMutex.Lock()
+	defer fake.inClusterConfigMutex.Unlock()
+	fake.InClusterConfigStub = stub
+}
+
+func (fake *FakeImpl) InClusterConfigReturns(result1 *rest.Config, result2 error) {
+	fake.inClusterConfigMutex.Lock()
+	defer fake.inClusterConfigMutex.Unlock()
+	fake.InClusterConfigStub = nil
+	fake.inClusterConfigReturns = struct {
+		result1 *rest.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) InClusterConfigReturnsOnCall(i int, result1 *rest.Config, result2 error) {
+	fake.inClusterConfigMutex.Lock()
+	defer fake.inClusterConfigMutex.Unlock()
+	fake.InClusterConfigStub = nil
+	if fake.inClusterConfigReturnsOnCall == nil {
+		fake.inClusterConfigReturnsOnCall = make(map[int]struct {
+			result1 *rest.Config
+			result2 error
+		})
+	}
+	fake.inClusterConfigReturnsOnCall[i] = struct {
+		result1 *rest.Config
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) JournalLines(arg1 *journal.Reader) chan *tail.Line {
+	fake.journalLinesMutex.Lock()
+	ret, specificReturn := fake.journalLinesReturnsOnCall[len(fake.journalLinesArgsForCall)]
+	fake.journalLinesArgsForCall = append(fake.journalLinesArgsForCall, struct {
+		arg1 *journal.Reader
+	}{arg1})
+	stub := fake.JournalLinesStub
+	fakeReturns := fake.journalLinesReturns
+	fake.recordInvocation("JournalLines", []interface{}{arg1})
+	fake.journalLinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) JournalLinesCallCount() int {
+	fake.journalLinesMutex.RLock()
+	defer fake.journalLinesMutex.RUnlock()
+	return len(fake.journalLinesArgsForCall)
+}
+
+func (fake *FakeImpl) JournalLinesCalls(stub func(*journal.Reader) chan *tail.Line) {
+	fake.journalLinesMutex.Lock()
+	defer fake.journalLinesMutex.Unlock()
+	fake.JournalLinesStub = stub
+}
+
+func (fake *FakeImpl) JournalLinesArgsForCall(i int) *journal.Reader {
+	fake.journalLinesMutex.RLock()
+	defer fake.journalLinesMutex.RUnlock()
+	argsForCall := fake.journalLinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) JournalLinesReturns(result1 chan *tail.Line) {
+	fake.journalLinesMutex.Lock()
+	defer fake.journalLinesMutex.Unlock()
+	fake.JournalLinesStub = nil
+	fake.journalLinesReturns = struct {
+		result1 chan *tail.Line
+	}{result1}
+}
+
+func (fake *FakeImpl) JournalLinesReturnsOnCall(i int, result1 chan *tail.Line) {
+	fake.journalLinesMutex.Lock()
+	defer fake.journalLinesMutex.Unlock()
+	fake.JournalLinesStub = nil
+	if fake.journalLinesReturnsOnCall == nil {
+		fake.journalLinesReturnsOnCall = make(map[int]struct {
+			result1 chan *tail.Line
+		})
+	}
+	fake.journalLinesReturnsOnCall[i] = struct {
+		result1 chan *tail.Line
+	}{result1}
+}
+
+func (fake *FakeImpl) JournalReason(arg1 *journal.Reader) error {
+	fake.journalReasonMutex.Lock()
+	ret, specificReturn := fake.journalReasonReturnsOnCall[len(fake.journalReasonArgsForCall)]
+	fake.journalReasonArgsForCall = append(fake.journalReasonArgsForCall, struct {
+		arg1 *journal.Reader
+	}{arg1})
+	stub := fake.JournalReasonStub
+	fakeReturns := fake.journalReasonReturns
+	fake.recordInvocation("JournalReason", []interface{}{arg1})
+	fake.journalReasonMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) JournalReasonCallCount() int {
+	fake.journalReasonMutex.RLock()
+	defer fake.journalReasonMutex.RUnlock()
+	return len(fake.journalReasonArgsForCall)
+}
+
+func (fake *FakeImpl) JournalReasonCalls(stub func(*journal.Reader) error) {
+	fake.journalReasonMutex.Lock()
+	defer fake.journalReasonMutex.Unlock()
+	fake.JournalReasonStub = stub
 }
 
-func (fake *FakeImpl) InClusterConfigCalls(stub func() (*rest.Config, error)) {
-	fake.inClusterConfigMutex.Lock()
-	defer fake.inClusterConfigMutex.Unlock()
-	fake.InClusterConfigStub = stub
+func (fake *FakeImpl) JournalReasonArgsForCall(i int) *journal.Reader {
+	fake.journalReasonMutex.RLock()
+	defer fake.journalReasonMutex.RUnlock()
+	argsForCall := fake.journalReasonArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeImpl) InClusterConfigReturns(result1 *rest.Config, result2 error) {
-	fake.inClusterConfigMutex.Lock()
-	defer fake.inClusterConfigMutex.Unlock()
-	fake.InClusterConfigStub = nil
-	fake.inClusterConfigReturns = struct {
-		result1 *rest.Config
-		result2 error
-	}{result1, result2}
+func (fake *FakeImpl) JournalReasonReturns(result1 error) {
+	fake.journalReasonMutex.Lock()
+	defer fake.journalReasonMutex.Unlock()
+	fake.JournalReasonStub = nil
+	fake.journalReasonReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeImpl) InClusterConfigReturnsOnCall(i int, result1 *rest.Config, result2 error) {
-	fake.inClusterConfigMutex.Lock()
-	defer fake.inClusterConfigMutex.Unlock()
-	fake.InClusterConfigStub = nil
-	if fake.inClusterConfigReturnsOnCall == nil {
-		fake.inClusterConfigReturnsOnCall = make(map[int]struct {
-			result1 *rest.Config
-			result2 error
+func (fake *FakeImpl) JournalReasonReturnsOnCall(i int, result1 error) {
+	fake.journalReasonMutex.Lock()
+	defer fake.journalReasonMutex.Unlock()
+	fake.JournalReasonStub = nil
+	if fake.journalReasonReturnsOnCall == nil {
+		fake.journalReasonReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.inClusterConfigReturnsOnCall[i] = struct {
-		result1 *rest.Config
-		result2 error
-	}{result1, result2}
+	fake.journalReasonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
 func (fake *FakeImpl) Lines(arg1 *tail.Tail) chan *tail.Line {
@@ -1032,6 +1749,132 @@ func (fake *FakeImpl) ListenReturnsOnCall(i int, result1 net.Listener, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) NewCRIClient(arg1 string) (*cri.Client, error) {
+	fake.newCRIClientMutex.Lock()
+	ret, specificReturn := fake.newCRIClientReturnsOnCall[len(fake.newCRIClientArgsForCall)]
+	fake.newCRIClientArgsForCall = append(fake.newCRIClientArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.NewCRIClientStub
+	fakeReturns := fake.newCRIClientReturns
+	fake.recordInvocation("NewCRIClient", []interface{}{arg1})
+	fake.newCRIClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) NewCRIClientCallCount() int {
+	fake.newCRIClientMutex.RLock()
+	defer fake.newCRIClientMutex.RUnlock()
+	return len(fake.newCRIClientArgsForCall)
+}
+
+func (fake *FakeImpl) NewCRIClientCalls(stub func(string) (*cri.Client, error)) {
+	fake.newCRIClientMutex.Lock()
+	defer fake.newCRIClientMutex.Unlock()
+	fake.NewCRIClientStub = stub
+}
+
+func (fake *FakeImpl) NewCRIClientArgsForCall(i int) string {
+	fake.newCRIClientMutex.RLock()
+	defer fake.newCRIClientMutex.RUnlock()
+	argsForCall := fake.newCRIClientArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) NewCRIClientReturns(result1 *cri.Client, result2 error) {
+	fake.newCRIClientMutex.Lock()
+	defer fake.newCRIClientMutex.Unlock()
+	fake.NewCRIClientStub = nil
+	fake.newCRIClientReturns = struct {
+		result1 *cri.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewCRIClientReturnsOnCall(i int, result1 *cri.Client, result2 error) {
+	fake.newCRIClientMutex.Lock()
+	defer fake.newCRIClientMutex.Unlock()
+	fake.NewCRIClientStub = nil
+	if fake.newCRIClientReturnsOnCall == nil {
+		fake.newCRIClientReturnsOnCall = make(map[int]struct {
+			result1 *cri.Client
+			result2 error
+		})
+	}
+	fake.newCRIClientReturnsOnCall[i] = struct {
+		result1 *cri.Client
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) NewEventRecorder(arg1 kubernetes.Interface, arg2 string) record.EventRecorder {
+	fake.newEventRecorderMutex.Lock()
+	ret, specificReturn := fake.newEventRecorderReturnsOnCall[len(fake.newEventRecorderArgsForCall)]
+	fake.newEventRecorderArgsForCall = append(fake.newEventRecorderArgsForCall, struct {
+		arg1 kubernetes.Interface
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.NewEventRecorderStub
+	fakeReturns := fake.newEventRecorderReturns
+	fake.recordInvocation("NewEventRecorder", []interface{}{arg1, arg2})
+	fake.newEventRecorderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) NewEventRecorderCallCount() int {
+	fake.newEventRecorderMutex.RLock()
+	defer fake.newEventRecorderMutex.RUnlock()
+	return len(fake.newEventRecorderArgsForCall)
+}
+
+func (fake *FakeImpl) NewEventRecorderCalls(stub func(kubernetes.Interface, string) record.EventRecorder) {
+	fake.newEventRecorderMutex.Lock()
+	defer fake.newEventRecorderMutex.Unlock()
+	fake.NewEventRecorderStub = stub
+}
+
+func (fake *FakeImpl) NewEventRecorderArgsForCall(i int) (kubernetes.Interface, string) {
+	fake.newEventRecorderMutex.RLock()
+	defer fake.newEventRecorderMutex.RUnlock()
+	argsForCall := fake.newEventRecorderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) NewEventRecorderReturns(result1 record.EventRecorder) {
+	fake.newEventRecorderMutex.Lock()
+	defer fake.newEventRecorderMutex.Unlock()
+	fake.NewEventRecorderStub = nil
+	fake.newEventRecorderReturns = struct {
+		result1 record.EventRecorder
+	}{result1}
+}
+
+func (fake *FakeImpl) NewEventRecorderReturnsOnCall(i int, result1 record.EventRecorder) {
+	fake.newEventRecorderMutex.Lock()
+	defer fake.newEventRecorderMutex.Unlock()
+	fake.NewEventRecorderStub = nil
+	if fake.newEventRecorderReturnsOnCall == nil {
+		fake.newEventRecorderReturnsOnCall = make(map[int]struct {
+			result1 record.EventRecorder
+		})
+	}
+	fake.newEventRecorderReturnsOnCall[i] = struct {
+		result1 record.EventRecorder
+	}{result1}
+}
+
 func (fake *FakeImpl) NewForConfig(arg1 *rest.Config) (*kubernetes.Clientset, error) {
 	fake.newForConfigMutex.Lock()
 	ret, specificReturn := fake.newForConfigReturnsOnCall[len(fake.newForConfigArgsForCall)]
@@ -1096,6 +1939,70 @@ func (fake *FakeImpl) NewForConfigReturnsOnCall(i int, result1 *kubernetes.Clien
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) Open(arg1 string) (io.ReadCloser, error) {
+	fake.openMutex.Lock()
+	ret, specificReturn := fake.openReturnsOnCall[len(fake.openArgsForCall)]
+	fake.openArgsForCall = append(fake.openArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.OpenStub
+	fakeReturns := fake.openReturns
+	fake.recordInvocation("Open", []interface{}{arg1})
+	fake.openMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) OpenCallCount() int {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return len(fake.openArgsForCall)
+}
+
+func (fake *FakeImpl) OpenCalls(stub func(string) (io.ReadCloser, error)) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = stub
+}
+
+func (fake *FakeImpl) OpenArgsForCall(i int) string {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	argsForCall := fake.openArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) OpenReturns(result1 io.ReadCloser, result2 error) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = nil
+	fake.openReturns = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) OpenReturnsOnCall(i int, result1 io.ReadCloser, result2 error) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = nil
+	if fake.openReturnsOnCall == nil {
+		fake.openReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 error
+		})
+	}
+	fake.openReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) Reason(arg1 *tail.Tail) error {
 	fake.reasonMutex.Lock()
 	ret, specificReturn := fake.reasonReturnsOnCall[len(fake.reasonArgsForCall)]
@@ -1218,12 +2125,136 @@ func (fake *FakeImpl) RemoveAllReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImpl) SendMetric(arg1 api_metrics.Metrics_AuditIncClient, arg2 *api_metrics.AuditRequest) error {
+func (fake *FakeImpl) SendContainerIDResolutionMetric(arg1 api_metrics.Metrics_ContainerIDResolutionIncClient, arg2 *api_metrics.ContainerIDResolutionRequest) error {
+	fake.sendContainerIDResolutionMetricMutex.Lock()
+	ret, specificReturn := fake.sendContainerIDResolutionMetricReturnsOnCall[len(fake.sendContainerIDResolutionMetricArgsForCall)]
+	fake.sendContainerIDResolutionMetricArgsForCall = append(fake.sendContainerIDResolutionMetricArgsForCall, struct {
+		arg1 api_metrics.Metrics_ContainerIDResolutionIncClient
+		arg2 *api_metrics.ContainerIDResolutionRequest
+	}{arg1, arg2})
+	stub := fake.SendContainerIDResolutionMetricStub
+	fakeReturns := fake.sendContainerIDResolutionMetricReturns
+	fake.recordInvocation("SendContainerIDResolutionMetric", []interface{}{arg1, arg2})
+	fake.sendContainerIDResolutionMetricMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SendContainerIDResolutionMetricCallCount() int {
+	fake.sendContainerIDResolutionMetricMutex.RLock()
+	defer fake.sendContainerIDResolutionMetricMutex.RUnlock()
+	return len(fake.sendContainerIDResolutionMetricArgsForCall)
+}
+
+func (fake *FakeImpl) SendContainerIDResolutionMetricCalls(stub func(api_metrics.Metrics_ContainerIDResolutionIncClient, *api_metrics.ContainerIDResolutionRequest) error) {
+	fake.sendContainerIDResolutionMetricMutex.Lock()
+	defer fake.sendContainerIDResolutionMetricMutex.Unlock()
+	fake.SendContainerIDResolutionMetricStub = stub
+}
+
+func (fake *FakeImpl) SendContainerIDResolutionMetricArgsForCall(i int) (api_metrics.Metrics_ContainerIDResolutionIncClient, *api_metrics.ContainerIDResolutionRequest) {
+	fake.sendContainerIDResolutionMetricMutex.RLock()
+	defer fake.sendContainerIDResolutionMetricMutex.RUnlock()
+	argsForCall := fake.sendContainerIDResolutionMetricArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) SendContainerIDResolutionMetricReturns(result1 error) {
+	fake.sendContainerIDResolutionMetricMutex.Lock()
+	defer fake.sendContainerIDResolutionMetricMutex.Unlock()
+	fake.SendContainerIDResolutionMetricStub = nil
+	fake.sendContainerIDResolutionMetricReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SendContainerIDResolutionMetricReturnsOnCall(i int, result1 error) {
+	fake.sendContainerIDResolutionMetricMutex.Lock()
+	defer fake.sendContainerIDResolutionMetricMutex.Unlock()
+	fake.SendContainerIDResolutionMetricStub = nil
+	if fake.sendContainerIDResolutionMetricReturnsOnCall == nil {
+		fake.sendContainerIDResolutionMetricReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendContainerIDResolutionMetricReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SendEnricherEvictionMetric(arg1 api_metrics.Metrics_EnricherEvictionIncClient, arg2 *api_metrics.EnricherEvictionRequest) error {
+	fake.sendEnricherEvictionMetricMutex.Lock()
+	ret, specificReturn := fake.sendEnricherEvictionMetricReturnsOnCall[len(fake.sendEnricherEvictionMetricArgsForCall)]
+	fake.sendEnricherEvictionMetricArgsForCall = append(fake.sendEnricherEvictionMetricArgsForCall, struct {
+		arg1 api_metrics.Metrics_EnricherEvictionIncClient
+		arg2 *api_metrics.EnricherEvictionRequest
+	}{arg1, arg2})
+	stub := fake.SendEnricherEvictionMetricStub
+	fakeReturns := fake.sendEnricherEvictionMetricReturns
+	fake.recordInvocation("SendEnricherEvictionMetric", []interface{}{arg1, arg2})
+	fake.sendEnricherEvictionMetricMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SendEnricherEvictionMetricCallCount() int {
+	fake.sendEnricherEvictionMetricMutex.RLock()
+	defer fake.sendEnricherEvictionMetricMutex.RUnlock()
+	return len(fake.sendEnricherEvictionMetricArgsForCall)
+}
+
+func (fake *FakeImpl) SendEnricherEvictionMetricCalls(stub func(api_metrics.Metrics_EnricherEvictionIncClient, *api_metrics.EnricherEvictionRequest) error) {
+	fake.sendEnricherEvictionMetricMutex.Lock()
+	defer fake.sendEnricherEvictionMetricMutex.Unlock()
+	fake.SendEnricherEvictionMetricStub = stub
+}
+
+func (fake *FakeImpl) SendEnricherEvictionMetricArgsForCall(i int) (api_metrics.Metrics_EnricherEvictionIncClient, *api_metrics.EnricherEvictionRequest) {
+	fake.sendEnricherEvictionMetricMutex.RLock()
+	defer fake.sendEnricherEvictionMetricMutex.RUnlock()
+	argsForCall := fake.sendEnricherEvictionMetricArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) SendEnricherEvictionMetricReturns(result1 error) {
+	fake.sendEnricherEvictionMetricMutex.Lock()
+	defer fake.sendEnricherEvictionMetricMutex.Unlock()
+	fake.SendEnricherEvictionMetricStub = nil
+	fake.sendEnricherEvictionMetricReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SendEnricherEvictionMetricReturnsOnCall(i int, result1 error) {
+	fake.sendEnricherEvictionMetricMutex.Lock()
+	defer fake.sendEnricherEvictionMetricMutex.Unlock()
+	fake.SendEnricherEvictionMetricStub = nil
+	if fake.sendEnricherEvictionMetricReturnsOnCall == nil {
+		fake.sendEnricherEvictionMetricReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendEnricherEvictionMetricReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SendMetric(arg1 api_metrics.Metrics_AuditBatchIncClient, arg2 *api_metrics.AuditBatchRequest) error {
 	fake.sendMetricMutex.Lock()
 	ret, specificReturn := fake.sendMetricReturnsOnCall[len(fake.sendMetricArgsForCall)]
 	fake.sendMetricArgsForCall = append(fake.sendMetricArgsForCall, struct {
-		arg1 api_metrics.Metrics_AuditIncClient
-		arg2 *api_metrics.AuditRequest
+		arg1 api_metrics.Metrics_AuditBatchIncClient
+		arg2 *api_metrics.AuditBatchRequest
 	}{arg1, arg2})
 	stub := fake.SendMetricStub
 	fakeReturns := fake.sendMetricReturns
@@ -1244,13 +2275,13 @@ func (fake *FakeImpl) SendMetricCallCount() int {
 	return len(fake.sendMetricArgsForCall)
 }
 
-func (fake *FakeImpl) SendMetricCalls(stub func(api_metrics.Metrics_AuditIncClient, *api_metrics.AuditRequest) error) {
+func (fake *FakeImpl) SendMetricCalls(stub func(api_metrics.Metrics_AuditBatchIncClient, *api_metrics.AuditBatchRequest) error) {
 	fake.sendMetricMutex.Lock()
 	defer fake.sendMetricMutex.Unlock()
 	fake.SendMetricStub = stub
 }
 
-func (fake *FakeImpl) SendMetricArgsForCall(i int) (api_metrics.Metrics_AuditIncClient, *api_metrics.AuditRequest) {
+func (fake *FakeImpl) SendMetricArgsForCall(i int) (api_metrics.Metrics_AuditBatchIncClient, *api_metrics.AuditBatchRequest) {
 	fake.sendMetricMutex.RLock()
 	defer fake.sendMetricMutex.RUnlock()
 	argsForCall := fake.sendMetricArgsForCall[i]
@@ -1280,6 +2311,68 @@ func (fake *FakeImpl) SendMetricReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) SendSyscallStreamDropMetric(arg1 api_metrics.Metrics_SyscallStreamDropIncClient, arg2 *api_metrics.SyscallStreamDropRequest) error {
+	fake.sendSyscallStreamDropMetricMutex.Lock()
+	ret, specificReturn := fake.sendSyscallStreamDropMetricReturnsOnCall[len(fake.sendSyscallStreamDropMetricArgsForCall)]
+	fake.sendSyscallStreamDropMetricArgsForCall = append(fake.sendSyscallStreamDropMetricArgsForCall, struct {
+		arg1 api_metrics.Metrics_SyscallStreamDropIncClient
+		arg2 *api_metrics.SyscallStreamDropRequest
+	}{arg1, arg2})
+	stub := fake.SendSyscallStreamDropMetricStub
+	fakeReturns := fake.sendSyscallStreamDropMetricReturns
+	fake.recordInvocation("SendSyscallStreamDropMetric", []interface{}{arg1, arg2})
+	fake.sendSyscallStreamDropMetricMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SendSyscallStreamDropMetricCallCount() int {
+	fake.sendSyscallStreamDropMetricMutex.RLock()
+	defer fake.sendSyscallStreamDropMetricMutex.RUnlock()
+	return len(fake.sendSyscallStreamDropMetricArgsForCall)
+}
+
+func (fake *FakeImpl) SendSyscallStreamDropMetricCalls(stub func(api_metrics.Metrics_SyscallStreamDropIncClient, *api_metrics.SyscallStreamDropRequest) error) {
+	fake.sendSyscallStreamDropMetricMutex.Lock()
+	defer fake.sendSyscallStreamDropMetricMutex.Unlock()
+	fake.SendSyscallStreamDropMetricStub = stub
+}
+
+func (fake *FakeImpl) SendSyscallStreamDropMetricArgsForCall(i int) (api_metrics.Metrics_SyscallStreamDropIncClient, *api_metrics.SyscallStreamDropRequest) {
+	fake.sendSyscallStreamDropMetricMutex.RLock()
+	defer fake.sendSyscallStreamDropMetricMutex.RUnlock()
+	argsForCall := fake.sendSyscallStreamDropMetricArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) SendSyscallStreamDropMetricReturns(result1 error) {
+	fake.sendSyscallStreamDropMetricMutex.Lock()
+	defer fake.sendSyscallStreamDropMetricMutex.Unlock()
+	fake.SendSyscallStreamDropMetricStub = nil
+	fake.sendSyscallStreamDropMetricReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SendSyscallStreamDropMetricReturnsOnCall(i int, result1 error) {
+	fake.sendSyscallStreamDropMetricMutex.Lock()
+	defer fake.sendSyscallStreamDropMetricMutex.Unlock()
+	fake.SendSyscallStreamDropMetricStub = nil
+	if fake.sendSyscallStreamDropMetricReturnsOnCall == nil {
+		fake.sendSyscallStreamDropMetricReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendSyscallStreamDropMetricReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Serve(arg1 *grpc.Server, arg2 net.Listener) error {
 	fake.serveMutex.Lock()
 	ret, specificReturn := fake.serveReturnsOnCall[len(fake.serveArgsForCall)]
@@ -1406,6 +2499,126 @@ func (fake *FakeImpl) StatReturnsOnCall(i int, result1 fs.FileInfo, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) SubscribeAudit() (*auditnetlink.Subscription, error) {
+	fake.subscribeAuditMutex.Lock()
+	ret, specificReturn := fake.subscribeAuditReturnsOnCall[len(fake.subscribeAuditArgsForCall)]
+	fake.subscribeAuditArgsForCall = append(fake.subscribeAuditArgsForCall, struct {
+	}{})
+	stub := fake.SubscribeAuditStub
+	fakeReturns := fake.subscribeAuditReturns
+	fake.recordInvocation("SubscribeAudit", []interface{}{})
+	fake.subscribeAuditMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) SubscribeAuditCallCount() int {
+	fake.subscribeAuditMutex.RLock()
+	defer fake.subscribeAuditMutex.RUnlock()
+	return len(fake.subscribeAuditArgsForCall)
+}
+
+func (fake *FakeImpl) SubscribeAuditCalls(stub func() (*auditnetlink.Subscription, error)) {
+	fake.subscribeAuditMutex.Lock()
+	defer fake.subscribeAuditMutex.Unlock()
+	fake.SubscribeAuditStub = stub
+}
+
+func (fake *FakeImpl) SubscribeAuditReturns(result1 *auditnetlink.Subscription, result2 error) {
+	fake.subscribeAuditMutex.Lock()
+	defer fake.subscribeAuditMutex.Unlock()
+	fake.SubscribeAuditStub = nil
+	fake.subscribeAuditReturns = struct {
+		result1 *auditnetlink.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) SubscribeAuditReturnsOnCall(i int, result1 *auditnetlink.Subscription, result2 error) {
+	fake.subscribeAuditMutex.Lock()
+	defer fake.subscribeAuditMutex.Unlock()
+	fake.SubscribeAuditStub = nil
+	if fake.subscribeAuditReturnsOnCall == nil {
+		fake.subscribeAuditReturnsOnCall = make(map[int]struct {
+			result1 *auditnetlink.Subscription
+			result2 error
+		})
+	}
+	fake.subscribeAuditReturnsOnCall[i] = struct {
+		result1 *auditnetlink.Subscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) SyscallStreamDropInc(arg1 api_metrics.MetricsClient) (api_metrics.Metrics_SyscallStreamDropIncClient, error) {
+	fake.syscallStreamDropIncMutex.Lock()
+	ret, specificReturn := fake.syscallStreamDropIncReturnsOnCall[len(fake.syscallStreamDropIncArgsForCall)]
+	fake.syscallStreamDropIncArgsForCall = append(fake.syscallStreamDropIncArgsForCall, struct {
+		arg1 api_metrics.MetricsClient
+	}{arg1})
+	stub := fake.SyscallStreamDropIncStub
+	fakeReturns := fake.syscallStreamDropIncReturns
+	fake.recordInvocation("SyscallStreamDropInc", []interface{}{arg1})
+	fake.syscallStreamDropIncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) SyscallStreamDropIncCallCount() int {
+	fake.syscallStreamDropIncMutex.RLock()
+	defer fake.syscallStreamDropIncMutex.RUnlock()
+	return len(fake.syscallStreamDropIncArgsForCall)
+}
+
+func (fake *FakeImpl) SyscallStreamDropIncCalls(stub func(api_metrics.MetricsClient) (api_metrics.Metrics_SyscallStreamDropIncClient, error)) {
+	fake.syscallStreamDropIncMutex.Lock()
+	defer fake.syscallStreamDropIncMutex.Unlock()
+	fake.SyscallStreamDropIncStub = stub
+}
+
+func (fake *FakeImpl) SyscallStreamDropIncArgsForCall(i int) api_metrics.MetricsClient {
+	fake.syscallStreamDropIncMutex.RLock()
+	defer fake.syscallStreamDropIncMutex.RUnlock()
+	argsForCall := fake.syscallStreamDropIncArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) SyscallStreamDropIncReturns(result1 api_metrics.Metrics_SyscallStreamDropIncClient, result2 error) {
+	fake.syscallStreamDropIncMutex.Lock()
+	defer fake.syscallStreamDropIncMutex.Unlock()
+	fake.SyscallStreamDropIncStub = nil
+	fake.syscallStreamDropIncReturns = struct {
+		result1 api_metrics.Metrics_SyscallStreamDropIncClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) SyscallStreamDropIncReturnsOnCall(i int, result1 api_metrics.Metrics_SyscallStreamDropIncClient, result2 error) {
+	fake.syscallStreamDropIncMutex.Lock()
+	defer fake.syscallStreamDropIncMutex.Unlock()
+	fake.SyscallStreamDropIncStub = nil
+	if fake.syscallStreamDropIncReturnsOnCall == nil {
+		fake.syscallStreamDropIncReturnsOnCall = make(map[int]struct {
+			result1 api_metrics.Metrics_SyscallStreamDropIncClient
+			result2 error
+		})
+	}
+	fake.syscallStreamDropIncReturnsOnCall[i] = struct {
+		result1 api_metrics.Metrics_SyscallStreamDropIncClient
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) TailFile(arg1 string, arg2 tail.Config) (*tail.Tail, error) {
 	fake.tailFileMutex.Lock()
 	ret, specificReturn := fake.tailFileReturnsOnCall[len(fake.tailFileArgsForCall)]
@@ -1476,42 +2689,74 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addToBacklogMutex.RLock()
 	defer fake.addToBacklogMutex.RUnlock()
-	fake.auditIncMutex.RLock()
-	defer fake.auditIncMutex.RUnlock()
+	fake.auditBatchIncMutex.RLock()
+	defer fake.auditBatchIncMutex.RUnlock()
+	fake.auditLinesMutex.RLock()
+	defer fake.auditLinesMutex.RUnlock()
+	fake.auditReasonMutex.RLock()
+	defer fake.auditReasonMutex.RUnlock()
+	fake.cRIContainerMutex.RLock()
+	defer fake.cRIContainerMutex.RUnlock()
 	fake.chownMutex.RLock()
 	defer fake.chownMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
 	fake.containerIDForPIDMutex.RLock()
 	defer fake.containerIDForPIDMutex.RUnlock()
+	fake.containerIDResolutionIncMutex.RLock()
+	defer fake.containerIDResolutionIncMutex.RUnlock()
 	fake.dialMutex.RLock()
 	defer fake.dialMutex.RUnlock()
+	fake.enricherEvictionIncMutex.RLock()
+	defer fake.enricherEvictionIncMutex.RUnlock()
 	fake.flushBacklogMutex.RLock()
 	defer fake.flushBacklogMutex.RUnlock()
+	fake.followJournalMutex.RLock()
+	defer fake.followJournalMutex.RUnlock()
 	fake.getFromBacklogMutex.RLock()
 	defer fake.getFromBacklogMutex.RUnlock()
 	fake.getenvMutex.RLock()
 	defer fake.getenvMutex.RUnlock()
 	fake.inClusterConfigMutex.RLock()
 	defer fake.inClusterConfigMutex.RUnlock()
+	fake.journalLinesMutex.RLock()
+	defer fake.journalLinesMutex.RUnlock()
+	fake.journalReasonMutex.RLock()
+	defer fake.journalReasonMutex.RUnlock()
 	fake.linesMutex.RLock()
 	defer fake.linesMutex.RUnlock()
 	fake.listPodsMutex.RLock()
 	defer fake.listPodsMutex.RUnlock()
 	fake.listenMutex.RLock()
 	defer fake.listenMutex.RUnlock()
+	fake.newCRIClientMutex.RLock()
+	defer fake.newCRIClientMutex.RUnlock()
+	fake.newEventRecorderMutex.RLock()
+	defer fake.newEventRecorderMutex.RUnlock()
 	fake.newForConfigMutex.RLock()
 	defer fake.newForConfigMutex.RUnlock()
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
 	fake.reasonMutex.RLock()
 	defer fake.reasonMutex.RUnlock()
 	fake.removeAllMutex.RLock()
 	defer fake.removeAllMutex.RUnlock()
+	fake.sendContainerIDResolutionMetricMutex.RLock()
+	defer fake.sendContainerIDResolutionMetricMutex.RUnlock()
+	fake.sendEnricherEvictionMetricMutex.RLock()
+	defer fake.sendEnricherEvictionMetricMutex.RUnlock()
 	fake.sendMetricMutex.RLock()
 	defer fake.sendMetricMutex.RUnlock()
+	fake.sendSyscallStreamDropMetricMutex.RLock()
+	defer fake.sendSyscallStreamDropMetricMutex.RUnlock()
 	fake.serveMutex.RLock()
 	defer fake.serveMutex.RUnlock()
 	fake.statMutex.RLock()
 	defer fake.statMutex.RUnlock()
+	fake.subscribeAuditMutex.RLock()
+	defer fake.subscribeAuditMutex.RUnlock()
+	fake.syscallStreamDropIncMutex.RLock()
+	defer fake.syscallStreamDropIncMutex.RUnlock()
 	fake.tailFileMutex.RLock()
 	defer fake.tailFileMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jellydator/ttlcache/v3"
+	v1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reasonSeccompDenial  = "SeccompDenial"
+	reasonSelinuxDenial  = "SelinuxDenial"
+	reasonApparmorDenial = "AppArmorDenial"
+	reasonDenialCrash    = "DenialCrashLoop"
+
+	crashLoopBackOff = "CrashLoopBackOff"
+
+	apparmorDenied = "DENIED"
+)
+
+// recentDenial is the last denial seen for a container together with the
+// time it happened on the node.
+type recentDenial struct {
+	message string
+	time    time.Time
+}
+
+// emitDenialEvent records a Warning event on the pod of the provided
+// container. Events are only emitted if denial events are enabled and the
+// container is not being recorded. The same denial is reported at most once
+// per denialEventInterval to not flood the API server.
+func (e *Enricher) emitDenialEvent(
+	info *types.ContainerInfo, eventTime time.Time, reason, denial, message string,
+) {
+	if e.recorder == nil || info.RecordProfile != "" {
+		return
+	}
+
+	containerKey := containerKey(info.Namespace, info.PodName, info.ContainerName)
+	e.recentDenials.Set(containerKey, recentDenial{message, eventTime}, ttlcache.DefaultTTL)
+
+	key := fmt.Sprintf("%s/%s/%s", containerKey, reason, denial)
+	if e.denialEventCache.Has(key) {
+		return
+	}
+	e.denialEventCache.Set(key, struct{}{}, ttlcache.DefaultTTL)
+
+	e.recorder.Event(&v1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  info.Namespace,
+		Name:       info.PodName,
+		UID:        k8stypes.UID(info.PodUID),
+	}, util.EventTypeWarning, reason, message)
+}
+
+// correlateCrashLoops links crash looping containers on the node to denials
+// recently seen for the same container. A Warning event containing the
+// likely cause is recorded once per container restart.
+func (e *Enricher) correlateCrashLoops(nodeName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	pods, err := e.ListPods(ctx, e.clientset, nodeName)
+	if err != nil {
+		e.logger.Error(err, "unable to list pods for crash loop correlation")
+		return
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		//nolint:gocritic // This is what we expect and want
+		statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+		for j := range statuses {
+			status := &statuses[j]
+			if status.State.Waiting == nil || status.State.Waiting.Reason != crashLoopBackOff {
+				continue
+			}
+
+			containerKey := containerKey(pod.Namespace, pod.Name, status.Name)
+			item := e.recentDenials.Get(containerKey)
+			if item == nil {
+				continue
+			}
+			denial := item.Value()
+			if !denialMatchesTermination(denial.time, status.LastTerminationState.Terminated) {
+				continue
+			}
+
+			key := fmt.Sprintf("%s/%s/%d", containerKey, reasonDenialCrash, status.RestartCount)
+			if e.denialEventCache.Has(key) {
+				continue
+			}
+			e.denialEventCache.Set(key, struct{}{}, ttlcache.DefaultTTL)
+
+			e.logger.Info(
+				"correlated crash looping container with denial",
+				"namespace", pod.Namespace,
+				"pod", pod.Name,
+				"container", status.Name,
+				"denial", denial.message,
+			)
+			e.recorder.Eventf(
+				pod, util.EventTypeWarning, reasonDenialCrash,
+				"Container %s is in %s, likely caused by: %s",
+				status.Name, crashLoopBackOff, denial.message,
+			)
+		}
+	}
+}
+
+// denialMatchesTermination returns true if the denial happened during the
+// last run of the container. The audit time comes from the node clock and the
+// termination times from the kubelet, so clockSkewTolerance is applied on both
+// ends. Missing times are treated as a match to keep the previous behavior.
+func denialMatchesTermination(denialTime time.Time, terminated *v1.ContainerStateTerminated) bool {
+	if denialTime.IsZero() || terminated == nil {
+		return true
+	}
+
+	if started := terminated.StartedAt.Time; !started.IsZero() &&
+		denialTime.Before(started.Add(-clockSkewTolerance)) {
+		return false
+	}
+
+	if finished := terminated.FinishedAt.Time; !finished.IsZero() &&
+		denialTime.After(finished.Add(clockSkewTolerance)) {
+		return false
+	}
+
+	return true
+}
+
+func containerKey(namespace, podName, containerName string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, podName, containerName)
+}
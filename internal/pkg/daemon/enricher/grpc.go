@@ -21,6 +21,9 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
 const (
@@ -35,12 +39,20 @@ const (
 	ErrorNoSyscalls = "no syscalls recorded for profile"
 	// ErrorNoAvcs is returned when no AVCs are recorded for a profile.
 	ErrorNoAvcs = "no avcs recorded for profile"
+	// ErrorNoApparmor is returned when no AppArmor events are recorded for a
+	// profile.
+	ErrorNoApparmor = "no apparmor events recorded for profile"
+
+	// syscallStreamBuffer is the amount of syscall events which are buffered
+	// per stream before dropping them.
+	syscallStreamBuffer = 1000
 )
 
 // Syscalls returns the syscalls for a provided profile.
 func (e *Enricher) Syscalls(
 	_ context.Context, r *api.SyscallsRequest,
 ) (*api.SyscallsResponse, error) {
+	e.retention.touch(r.GetProfile(), time.Now())
 	syscalls, ok := e.syscalls.Load(r.GetProfile())
 	if !ok {
 		st := status.New(codes.NotFound, ErrorNoSyscalls)
@@ -50,36 +62,181 @@ func (e *Enricher) Syscalls(
 	if !ok {
 		return nil, errors.New("syscalls are no string set")
 	}
-	return &api.SyscallsResponse{
+	res := &api.SyscallsResponse{
 		Syscalls: stringSet.UnsortedList(),
 		GoArch:   runtime.GOARCH,
-	}, nil
+	}
+	if x, ok := e.executables.Load(r.GetProfile()); ok {
+		if executables, ok := x.(*syscallExecutables); ok {
+			res.Executables = executables.toAPI()
+		}
+	}
+	return res, nil
 }
 
-// ResetSyscalls removes the syscalls for a provided profile.
+// ResetSyscalls removes the syscalls for a provided profile. If the request
+// contains a generation, then only the matching collection gets acknowledged
+// and syscalls recorded after it are kept.
 func (e *Enricher) ResetSyscalls(
 	_ context.Context, r *api.SyscallsRequest,
 ) (*api.EmptyResponse, error) {
-	e.syscalls.Delete(r.GetProfile())
+	e.syscallCollections.acknowledge(r.GetProfile(), r.GetGeneration())
+	if r.GetGeneration() == 0 {
+		e.syscalls.Delete(r.GetProfile())
+		e.executables.Delete(r.GetProfile())
+	}
 	return &api.EmptyResponse{}, nil
 }
 
+// CollectSyscalls atomically collects the syscalls for a provided profile.
+// The collection has to be acknowledged by resetting the syscalls with the
+// returned generation, otherwise it gets returned again on the next call.
+func (e *Enricher) CollectSyscalls(
+	_ context.Context, r *api.SyscallsRequest,
+) (*api.SyscallsResponse, error) {
+	e.retention.touch(r.GetProfile(), time.Now())
+	collected, ok := e.syscallCollections.collect(r.GetProfile(), func(p *pendingCollection) {
+		if syscalls, ok := e.syscalls.LoadAndDelete(r.GetProfile()); ok {
+			if stringSet, ok := syscalls.(sets.Set[string]); ok {
+				p.values = p.values.Union(stringSet)
+			}
+		}
+		if x, ok := e.executables.LoadAndDelete(r.GetProfile()); ok {
+			if executables, ok := x.(*syscallExecutables); ok {
+				executables.Lock()
+				p.executables.Merge(executables.SyscallExecutables)
+				executables.Unlock()
+			}
+		}
+	})
+	if !ok {
+		st := status.New(codes.NotFound, ErrorNoSyscalls)
+		return nil, st.Err()
+	}
+
+	executables := &syscallExecutables{SyscallExecutables: collected.executables}
+	return &api.SyscallsResponse{
+		Syscalls:    collected.values.UnsortedList(),
+		GoArch:      runtime.GOARCH,
+		Executables: executables.toAPI(),
+		Generation:  collected.generation,
+	}, nil
+}
+
+// SyscallsStream streams the syscalls for a provided profile as they are
+// observed. It starts with the already recorded syscalls of the profile and
+// runs until the client cancels the stream.
+func (e *Enricher) SyscallsStream(
+	r *api.SyscallsRequest, stream api.Enricher_SyscallsStreamServer,
+) error {
+	events := e.syscallStreams.subscribe(r.GetProfile())
+	defer e.syscallStreams.unsubscribe(r.GetProfile(), events)
+
+	if syscalls, ok := e.syscalls.Load(r.GetProfile()); ok {
+		if stringSet, ok := syscalls.(sets.Set[string]); ok {
+			recorded := stringSet.UnsortedList()
+			sort.Strings(recorded)
+			for _, syscall := range recorded {
+				if err := stream.Send(&api.SyscallEvent{Syscall: syscall}); err != nil {
+					return fmt.Errorf("send recorded syscall: %w", err)
+				}
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return fmt.Errorf("send syscall event: %w", err)
+			}
+		}
+	}
+}
+
 // Avcs returns the AVC messages for a provided profile.
 func (e *Enricher) Avcs(
 	_ context.Context, r *api.AvcRequest,
 ) (*api.AvcResponse, error) {
+	e.retention.touch(r.GetProfile(), time.Now())
 	avcs, ok := e.avcs.Load(r.GetProfile())
 	if !ok {
 		st := status.New(codes.NotFound, ErrorNoAvcs)
 		return nil, st.Err()
 	}
 
-	avcList := make([]*api.AvcResponse_SelinuxAvc, 0)
 	stringSet, ok := avcs.(sets.Set[string])
 	if !ok {
 		return nil, errors.New("avcs are no string set")
 	}
-	jsonList := stringSet.UnsortedList()
+	avcList, err := unmarshalAvcs(stringSet.UnsortedList())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.AvcResponse{Avc: avcList}
+	if x, ok := e.avcScontexts.Load(r.GetProfile()); ok {
+		if scontext, ok := x.(string); ok {
+			res.Scontext = scontext
+		}
+	}
+	return res, nil
+}
+
+// ResetAvcs removes the avcs for a provided profile. If the request contains
+// a generation, then only the matching collection gets acknowledged and avcs
+// recorded after it are kept.
+func (e *Enricher) ResetAvcs(
+	_ context.Context, r *api.AvcRequest,
+) (*api.EmptyResponse, error) {
+	e.avcCollections.acknowledge(r.GetProfile(), r.GetGeneration())
+	if r.GetGeneration() == 0 {
+		e.avcs.Delete(r.GetProfile())
+		e.avcScontexts.Delete(r.GetProfile())
+	}
+	return &api.EmptyResponse{}, nil
+}
+
+// CollectAvcs atomically collects the avcs for a provided profile. The
+// collection has to be acknowledged by resetting the avcs with the returned
+// generation, otherwise it gets returned again on the next call.
+func (e *Enricher) CollectAvcs(
+	_ context.Context, r *api.AvcRequest,
+) (*api.AvcResponse, error) {
+	e.retention.touch(r.GetProfile(), time.Now())
+	collected, ok := e.avcCollections.collect(r.GetProfile(), func(p *pendingCollection) {
+		if avcs, ok := e.avcs.LoadAndDelete(r.GetProfile()); ok {
+			if stringSet, ok := avcs.(sets.Set[string]); ok {
+				p.values = p.values.Union(stringSet)
+			}
+		}
+		if x, ok := e.avcScontexts.LoadAndDelete(r.GetProfile()); ok {
+			if scontext, ok := x.(string); ok {
+				p.scontext = scontext
+			}
+		}
+	})
+	if !ok {
+		st := status.New(codes.NotFound, ErrorNoAvcs)
+		return nil, st.Err()
+	}
+
+	avcList, err := unmarshalAvcs(collected.values.UnsortedList())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.AvcResponse{
+		Avc:        avcList,
+		Scontext:   collected.scontext,
+		Generation: collected.generation,
+	}, nil
+}
+
+func unmarshalAvcs(jsonList []string) ([]*api.AvcResponse_SelinuxAvc, error) {
+	avcList := make([]*api.AvcResponse_SelinuxAvc, 0, len(jsonList))
 	for i := range jsonList {
 		avc := &api.AvcResponse_SelinuxAvc{}
 		err := protojson.Unmarshal([]byte(jsonList[i]), avc)
@@ -88,14 +245,111 @@ func (e *Enricher) Avcs(
 		}
 		avcList = append(avcList, avc)
 	}
+	return avcList, nil
+}
+
+// Apparmor returns the AppArmor events for a provided profile.
+func (e *Enricher) Apparmor(
+	_ context.Context, r *api.ApparmorRequest,
+) (*api.ApparmorResponse, error) {
+	e.retention.touch(r.GetProfile(), time.Now())
+	events, ok := e.apparmor.Load(r.GetProfile())
+	if !ok {
+		st := status.New(codes.NotFound, ErrorNoApparmor)
+		return nil, st.Err()
+	}
+
+	stringSet, ok := events.(sets.Set[string])
+	if !ok {
+		return nil, errors.New("apparmor events are no string set")
+	}
+	jsonList := stringSet.UnsortedList()
+	eventList := make([]*api.ApparmorResponse_ApparmorEvent, 0, len(jsonList))
+	for i := range jsonList {
+		event := &api.ApparmorResponse_ApparmorEvent{}
+		if err := protojson.Unmarshal([]byte(jsonList[i]), event); err != nil {
+			return nil, fmt.Errorf("unmarshall JSON: %w", err)
+		}
+		eventList = append(eventList, event)
+	}
 
-	return &api.AvcResponse{Avc: avcList}, nil
+	return &api.ApparmorResponse{Events: eventList}, nil
 }
 
-// ResetAvcs removes the avcs for a provided profile.
-func (e *Enricher) ResetAvcs(
-	_ context.Context, r *api.AvcRequest,
+// ResetApparmor removes the AppArmor events for a provided profile.
+func (e *Enricher) ResetApparmor(
+	_ context.Context, r *api.ApparmorRequest,
 ) (*api.EmptyResponse, error) {
-	e.avcs.Delete(r.GetProfile())
+	e.apparmor.Delete(r.GetProfile())
 	return &api.EmptyResponse{}, nil
 }
+
+// syscallExecutables tracks the executables which triggered the syscalls of a
+// recorded profile.
+type syscallExecutables struct {
+	sync.Mutex
+	util.SyscallExecutables
+}
+
+func (s *syscallExecutables) insert(syscall, executable string) {
+	s.Lock()
+	defer s.Unlock()
+	s.Insert(syscall, executable)
+}
+
+func (s *syscallExecutables) toAPI() map[string]*api.SyscallsResponse_Executables {
+	s.Lock()
+	defer s.Unlock()
+	res := make(map[string]*api.SyscallsResponse_Executables, len(s.SyscallExecutables))
+	for syscall, executables := range s.SyscallExecutables {
+		res[syscall] = &api.SyscallsResponse_Executables{
+			Names: append([]string{}, executables...),
+		}
+	}
+	return res
+}
+
+// syscallStreams tracks the subscribers of the syscall events per recorded
+// profile.
+type syscallStreams struct {
+	sync.Mutex
+	subscribers map[string]map[chan *api.SyscallEvent]struct{}
+}
+
+func (s *syscallStreams) subscribe(profile string) chan *api.SyscallEvent {
+	s.Lock()
+	defer s.Unlock()
+	if s.subscribers == nil {
+		s.subscribers = map[string]map[chan *api.SyscallEvent]struct{}{}
+	}
+	if s.subscribers[profile] == nil {
+		s.subscribers[profile] = map[chan *api.SyscallEvent]struct{}{}
+	}
+	events := make(chan *api.SyscallEvent, syscallStreamBuffer)
+	s.subscribers[profile][events] = struct{}{}
+	return events
+}
+
+func (s *syscallStreams) unsubscribe(profile string, events chan *api.SyscallEvent) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.subscribers[profile], events)
+	if len(s.subscribers[profile]) == 0 {
+		delete(s.subscribers, profile)
+	}
+}
+
+// publish sends the event to all subscribers of the profile and returns the
+// amount of subscribers which were too slow to receive it.
+func (s *syscallStreams) publish(profile string, event *api.SyscallEvent) (dropped int) {
+	s.Lock()
+	defer s.Unlock()
+	for events := range s.subscribers[profile] {
+		select {
+		case events <- event:
+		default:
+			dropped++
+		}
+	}
+	return dropped
+}
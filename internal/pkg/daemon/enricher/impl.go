@@ -18,6 +18,7 @@ package enricher
 
 import (
 	"context"
+	"io"
 	"net"
 	"os"
 
@@ -27,9 +28,15 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 
 	api "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/auditnetlink"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/cri"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/journal"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
@@ -46,12 +53,33 @@ type impl interface {
 	TailFile(filename string, config tail.Config) (*tail.Tail, error)
 	Lines(tailFile *tail.Tail) chan *tail.Line
 	Reason(tailFile *tail.Tail) error
-	ContainerIDForPID(cache *ttlcache.Cache[string, string], pid int) (string, error)
+	SubscribeAudit() (*auditnetlink.Subscription, error)
+	AuditLines(sub *auditnetlink.Subscription) chan *tail.Line
+	AuditReason(sub *auditnetlink.Subscription) error
+	FollowJournal(dir string) (*journal.Reader, error)
+	JournalLines(reader *journal.Reader) chan *tail.Line
+	JournalReason(reader *journal.Reader) error
+	ContainerIDForPID(
+		cache *ttlcache.Cache[string, string], resolvers []util.ContainerIDResolver, pid int,
+	) (containerID, resolver string, err error)
 	InClusterConfig() (*rest.Config, error)
 	NewForConfig(c *rest.Config) (*kubernetes.Clientset, error)
 	ListPods(ctx context.Context, c kubernetes.Interface, nodeName string) (*v1.PodList, error)
-	AuditInc(client api.MetricsClient) (api.Metrics_AuditIncClient, error)
-	SendMetric(client api.Metrics_AuditIncClient, in *api.AuditRequest) error
+	NewCRIClient(endpoint string) (*cri.Client, error)
+	CRIContainer(ctx context.Context, c *cri.Client, containerID string) (*cri.Container, error)
+	NewEventRecorder(c kubernetes.Interface, nodeName string) record.EventRecorder
+	AuditBatchInc(client api.MetricsClient) (api.Metrics_AuditBatchIncClient, error)
+	SendMetric(client api.Metrics_AuditBatchIncClient, in *api.AuditBatchRequest) error
+	ContainerIDResolutionInc(client api.MetricsClient) (api.Metrics_ContainerIDResolutionIncClient, error)
+	SendContainerIDResolutionMetric(
+		client api.Metrics_ContainerIDResolutionIncClient, in *api.ContainerIDResolutionRequest,
+	) error
+	EnricherEvictionInc(client api.MetricsClient) (api.Metrics_EnricherEvictionIncClient, error)
+	SendEnricherEvictionMetric(client api.Metrics_EnricherEvictionIncClient, in *api.EnricherEvictionRequest) error
+	SyscallStreamDropInc(client api.MetricsClient) (api.Metrics_SyscallStreamDropIncClient, error)
+	SendSyscallStreamDropMetric(
+		client api.Metrics_SyscallStreamDropIncClient, in *api.SyscallStreamDropRequest,
+	) error
 	Listen(string, string) (net.Listener, error)
 	Serve(*grpc.Server, net.Listener) error
 	AddToBacklog(cache *ttlcache.Cache[string, []*types.AuditLine], key string, value []*types.AuditLine)
@@ -60,6 +88,7 @@ type impl interface {
 	Chown(string, int, int) error
 	Stat(string) (os.FileInfo, error)
 	RemoveAll(string) error
+	Open(name string) (io.ReadCloser, error)
 }
 
 func (d *defaultImpl) Getenv(key string) string {
@@ -88,8 +117,34 @@ func (d *defaultImpl) Reason(tailFile *tail.Tail) error {
 	return tailFile.Err()
 }
 
-func (d *defaultImpl) ContainerIDForPID(cache *ttlcache.Cache[string, string], pid int) (string, error) {
-	return util.ContainerIDForPID(cache, pid)
+func (d *defaultImpl) SubscribeAudit() (*auditnetlink.Subscription, error) {
+	return auditnetlink.Subscribe()
+}
+
+func (d *defaultImpl) AuditLines(sub *auditnetlink.Subscription) chan *tail.Line {
+	return sub.Lines()
+}
+
+func (d *defaultImpl) AuditReason(sub *auditnetlink.Subscription) error {
+	return sub.Err()
+}
+
+func (d *defaultImpl) FollowJournal(dir string) (*journal.Reader, error) {
+	return journal.Follow(dir)
+}
+
+func (d *defaultImpl) JournalLines(reader *journal.Reader) chan *tail.Line {
+	return reader.Lines()
+}
+
+func (d *defaultImpl) JournalReason(reader *journal.Reader) error {
+	return reader.Err()
+}
+
+func (d *defaultImpl) ContainerIDForPID(
+	cache *ttlcache.Cache[string, string], resolvers []util.ContainerIDResolver, pid int,
+) (containerID, resolver string, err error) {
+	return util.ResolveContainerIDForPID(cache, resolvers, pid)
 }
 
 func (d *defaultImpl) InClusterConfig() (*rest.Config, error) {
@@ -110,10 +165,33 @@ func (d *defaultImpl) ListPods(
 	})
 }
 
-func (d *defaultImpl) AuditInc(
+func (d *defaultImpl) NewCRIClient(endpoint string) (*cri.Client, error) {
+	return cri.NewClient(endpoint)
+}
+
+func (d *defaultImpl) CRIContainer(
+	ctx context.Context, c *cri.Client, containerID string,
+) (*cri.Container, error) {
+	return c.Container(ctx, containerID)
+}
+
+func (d *defaultImpl) NewEventRecorder(
+	c kubernetes.Interface, nodeName string,
+) record.EventRecorder {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: c.CoreV1().Events(""),
+	})
+	return broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{
+		Component: "log-enricher",
+		Host:      nodeName,
+	})
+}
+
+func (d *defaultImpl) AuditBatchInc(
 	client api.MetricsClient,
-) (api.Metrics_AuditIncClient, error) {
-	return client.AuditInc(context.Background())
+) (api.Metrics_AuditBatchIncClient, error) {
+	return client.AuditBatchInc(context.Background())
 }
 
 func (d *defaultImpl) AddToBacklog(
@@ -139,8 +217,47 @@ func (d *defaultImpl) FlushBacklog(
 }
 
 func (d *defaultImpl) SendMetric(
-	client api.Metrics_AuditIncClient,
-	in *api.AuditRequest,
+	client api.Metrics_AuditBatchIncClient,
+	in *api.AuditBatchRequest,
+) error {
+	return client.Send(in)
+}
+
+func (d *defaultImpl) ContainerIDResolutionInc(
+	client api.MetricsClient,
+) (api.Metrics_ContainerIDResolutionIncClient, error) {
+	return client.ContainerIDResolutionInc(context.Background())
+}
+
+func (d *defaultImpl) SendContainerIDResolutionMetric(
+	client api.Metrics_ContainerIDResolutionIncClient,
+	in *api.ContainerIDResolutionRequest,
+) error {
+	return client.Send(in)
+}
+
+func (d *defaultImpl) EnricherEvictionInc(
+	client api.MetricsClient,
+) (api.Metrics_EnricherEvictionIncClient, error) {
+	return client.EnricherEvictionInc(context.Background())
+}
+
+func (d *defaultImpl) SendEnricherEvictionMetric(
+	client api.Metrics_EnricherEvictionIncClient,
+	in *api.EnricherEvictionRequest,
+) error {
+	return client.Send(in)
+}
+
+func (d *defaultImpl) SyscallStreamDropInc(
+	client api.MetricsClient,
+) (api.Metrics_SyscallStreamDropIncClient, error) {
+	return client.SyscallStreamDropInc(context.Background())
+}
+
+func (d *defaultImpl) SendSyscallStreamDropMetric(
+	client api.Metrics_SyscallStreamDropIncClient,
+	in *api.SyscallStreamDropRequest,
 ) error {
 	return client.Send(in)
 }
@@ -164,3 +281,7 @@ func (d *defaultImpl) Stat(name string) (os.FileInfo, error) {
 func (d *defaultImpl) RemoveAll(path string) error {
 	return os.RemoveAll(path)
 }
+
+func (d *defaultImpl) Open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package journal reads audit records from the systemd journal, which is
+// used by distributions routing audit messages to journald instead of the
+// audit log or syslog.
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nxadm/tail"
+)
+
+// ErrUnsupported is returned if the journal reader is not part of the build.
+var ErrUnsupported = errors.New("journald support not enabled at build time")
+
+// Reader provides the audit records of the journal in the same form as
+// tailing the audit log.
+type Reader struct {
+	lines chan *tail.Line
+	err   error
+}
+
+// Lines returns the channel of journal lines, which gets closed if reading
+// the journal failed.
+func (r *Reader) Lines() chan *tail.Line {
+	return r.lines
+}
+
+// Err returns the reason why reading the journal stopped.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// formatEntry formats a journal entry like auditd does, for example:
+// type=SECCOMP msg=audit(1624537480.360:8477): pid=2060394 ...
+//
+// Entries of the audit transport contain the record type name at the start
+// of the message, while the serial and timestamp are separate fields. Kernel
+// messages already contain the full record and are returned unchanged.
+func formatEntry(fields map[string]string, realtimeUsec uint64) string {
+	msg := fields["MESSAGE"]
+	if fields["_TRANSPORT"] != "audit" {
+		return msg
+	}
+
+	typeName, body, ok := strings.Cut(msg, " ")
+	if !ok {
+		return msg
+	}
+
+	id, ok := fields["_AUDIT_ID"]
+	if !ok {
+		return fmt.Sprintf("type=%s %s", typeName, body)
+	}
+
+	if ts, err := strconv.ParseUint(fields["_SOURCE_REALTIME_TIMESTAMP"], 10, 64); err == nil {
+		realtimeUsec = ts
+	}
+	return fmt.Sprintf(
+		"type=%s msg=audit(%d.%03d:%s): %s",
+		typeName, realtimeUsec/1e6, realtimeUsec%1e6/1e3, id, body,
+	)
+}
@@ -0,0 +1,101 @@
+//go:build journald
+// +build journald
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package journal
+
+import (
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/coreos/go-systemd/v22/sdjournal"
+	"github.com/nxadm/tail"
+)
+
+// matches filter the journal for entries of the audit subsystem as well as
+// kernel messages, which contain the audit records if no audit daemon is
+// running.
+var matches = []string{"_TRANSPORT=audit", "_TRANSPORT=kernel"}
+
+// Follow opens the journal in the provided directory and reads all audit
+// records which get appended to it from now on.
+func Follow(dir string) (*Reader, error) {
+	j, err := sdjournal.NewJournalFromDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+
+	if err := seekTail(j); err != nil {
+		j.Close()
+		return nil, err
+	}
+
+	r := &Reader{lines: make(chan *tail.Line)}
+	go r.follow(j)
+	return r, nil
+}
+
+func seekTail(j *sdjournal.Journal) error {
+	// Matches of the same field are combined by a logical OR
+	for _, match := range matches {
+		if err := j.AddMatch(match); err != nil {
+			return fmt.Errorf("add journal match %s: %w", match, err)
+		}
+	}
+
+	// Position on the last entry, so that only new ones get read
+	if err := j.SeekTail(); err != nil {
+		return fmt.Errorf("seek journal tail: %w", err)
+	}
+	if _, err := j.Previous(); err != nil {
+		return fmt.Errorf("seek last journal entry: %w", err)
+	}
+	return nil
+}
+
+// follow reads journal entries until the journal fails and converts them
+// into log lines. The lines channel gets closed on failure.
+func (r *Reader) follow(j *sdjournal.Journal) {
+	defer close(r.lines)
+	defer j.Close()
+
+	for {
+		n, err := j.Next()
+		if err != nil {
+			r.err = fmt.Errorf("read next journal entry: %w", err)
+			return
+		}
+
+		if n == 0 {
+			if res := j.Wait(sdjournal.IndefiniteWait); res < 0 {
+				r.err = fmt.Errorf("wait for journal entries: %w", syscall.Errno(-res))
+				return
+			}
+			continue
+		}
+
+		entry, err := j.GetEntry()
+		if err != nil {
+			r.lines <- &tail.Line{Err: fmt.Errorf("get journal entry: %w", err), Time: time.Now()}
+			continue
+		}
+
+		r.lines <- &tail.Line{Text: formatEntry(entry.Fields, entry.RealtimeTimestamp), Time: time.Now()}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package journal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatEntry(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		fields   map[string]string
+		realtime uint64
+		expected string
+	}{
+		{
+			name: "audit transport",
+			fields: map[string]string{
+				"_TRANSPORT":                 "audit",
+				"_AUDIT_ID":                  "8477",
+				"_SOURCE_REALTIME_TIMESTAMP": "1624537480360123",
+				"MESSAGE":                    `SECCOMP auid=1000 uid=0 pid=2060394 comm="sleep" syscall=10`,
+			},
+			realtime: 1624537481000000,
+			expected: `type=SECCOMP msg=audit(1624537480.360:8477): ` +
+				`auid=1000 uid=0 pid=2060394 comm="sleep" syscall=10`,
+		},
+		{
+			name: "audit transport without source timestamp",
+			fields: map[string]string{
+				"_TRANSPORT": "audit",
+				"_AUDIT_ID":  "2945",
+				"MESSAGE":    "AVC avc:  denied  { read } for  pid=75593",
+			},
+			realtime: 1613173578156000,
+			expected: "type=AVC msg=audit(1613173578.156:2945): avc:  denied  { read } for  pid=75593",
+		},
+		{
+			name: "audit transport without serial",
+			fields: map[string]string{
+				"_TRANSPORT": "audit",
+				"MESSAGE":    "SECCOMP pid=1 syscall=10",
+			},
+			expected: "type=SECCOMP pid=1 syscall=10",
+		},
+		{
+			name: "kernel transport",
+			fields: map[string]string{
+				"_TRANSPORT": "kernel",
+				"MESSAGE":    "audit: type=1326 audit(1624537480.360:8477): pid=2060394 syscall=10",
+			},
+			expected: "audit: type=1326 audit(1624537480.360:8477): pid=2060394 syscall=10",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, formatEntry(tc.fields, tc.realtime))
+		})
+	}
+}
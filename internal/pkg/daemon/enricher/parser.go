@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+// ErrParserExists is returned if a parser for the audit type is already
+// registered or the audit type is built into the enricher.
+var ErrParserExists = errors.New("parser for audit type already exists")
+
+// parsers are the audit line parsers registered via RegisterParser.
+var parsers = &parserRegistry{}
+
+// parserRegistry holds the parsers for audit types which are not built into
+// the enricher, in the order of their registration.
+type parserRegistry struct {
+	sync.RWMutex
+	parsers []types.Parser
+}
+
+// RegisterParser registers a parser plugin for an additional audit type. The
+// lines of the type get written to the audit sinks and are accumulated per
+// recorded profile, which can be retrieved via Enricher.Records. Parsers have
+// to be registered before the enricher runs.
+func RegisterParser(parser types.Parser) error {
+	return parsers.register(parser)
+}
+
+func (r *parserRegistry) register(parser types.Parser) error {
+	r.Lock()
+	defer r.Unlock()
+
+	auditType := parser.Type()
+	if auditType == "" {
+		return errors.New("parser audit type is empty")
+	}
+	switch auditType {
+	case types.AuditTypeSeccomp, types.AuditTypeSelinux, types.AuditTypeApparmor:
+		return fmt.Errorf("%w: %s", ErrParserExists, auditType)
+	}
+	for _, p := range r.parsers {
+		if p.Type() == auditType {
+			return fmt.Errorf("%w: %s", ErrParserExists, auditType)
+		}
+	}
+
+	r.parsers = append(r.parsers, parser)
+	return nil
+}
+
+// parse returns the audit line of the first parser matching the log line, or
+// nil if none matches.
+func (r *parserRegistry) parse(logLine string) *types.AuditLine {
+	r.RLock()
+	defer r.RUnlock()
+
+	for _, p := range r.parsers {
+		if line := p.Parse(logLine); line != nil {
+			line.AuditType = p.Type()
+			return line
+		}
+	}
+	return nil
+}
+
+// get returns the parser for the audit type.
+func (r *parserRegistry) get(auditType string) (types.Parser, bool) {
+	r.RLock()
+	defer r.RUnlock()
+
+	for _, p := range r.parsers {
+		if p.Type() == auditType {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// recordKey is the key of the accumulated entries of a parser per profile.
+type recordKey struct {
+	auditType string
+	profile   string
+}
+
+func (e *Enricher) dispatchParserLine(
+	parser types.Parser,
+	nodeName string,
+	auditLine *types.AuditLine,
+	info *types.ContainerInfo,
+) {
+	values := []interface{}{
+		"timestamp", auditLine.TimestampID,
+		"type", auditLine.AuditType,
+		"node", nodeName,
+		"namespace", info.Namespace,
+		"pod", info.PodName,
+		"container", info.ContainerName,
+		"executable", auditLine.Executable,
+		"pid", auditLine.ProcessID,
+	}
+	for _, key := range sets.List(sets.KeySet(auditLine.Fields)) {
+		values = append(values, key, auditLine.Fields[key])
+	}
+
+	e.writeAuditEvent(newAuditEvent(nodeName, auditLine, info), values...)
+
+	if info.RecordProfile != "" {
+		e.recordParserLine(parser, auditLine, info)
+	}
+}
+
+// recordParserLine adds the entry the parser returns for the audit line to
+// the recorded profile of the container.
+func (e *Enricher) recordParserLine(parser types.Parser, auditLine *types.AuditLine, info *types.ContainerInfo) {
+	entry := parser.Record(auditLine)
+	if entry == "" {
+		return
+	}
+
+	e.retention.touch(info.RecordProfile, time.Now())
+	r, _ := e.records.LoadOrStore(
+		recordKey{auditType: auditLine.AuditType, profile: info.RecordProfile}, sets.New[string](),
+	)
+	if stringSet, ok := r.(sets.Set[string]); ok {
+		stringSet.Insert(entry)
+	}
+}
+
+// Records returns the sorted entries accumulated for the profile by the
+// parser of the audit type.
+func (e *Enricher) Records(auditType, profile string) []string {
+	r, ok := e.records.Load(recordKey{auditType: auditType, profile: profile})
+	if !ok {
+		return nil
+	}
+	stringSet, ok := r.(sets.Set[string])
+	if !ok {
+		return nil
+	}
+	res := stringSet.UnsortedList()
+	sort.Strings(res)
+	return res
+}
+
+// ResetRecords removes the entries accumulated for the profile by the parser
+// of the audit type.
+func (e *Enricher) ResetRecords(auditType, profile string) {
+	e.records.Delete(recordKey{auditType: auditType, profile: profile})
+}
+
+// evictRecords removes the entries accumulated for the profile by all parsers
+// and returns the sorted audit types which had entries.
+func (e *Enricher) evictRecords(profile string) []string {
+	res := []string{}
+	e.records.Range(func(key, _ any) bool {
+		if k, ok := key.(recordKey); ok && k.profile == profile {
+			e.records.Delete(k)
+			res = append(res, k.auditType)
+		}
+		return true
+	})
+	sort.Strings(res)
+	return res
+}
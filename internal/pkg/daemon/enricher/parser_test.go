@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+const (
+	auditTypeLandlock = "landlock"
+	landlockLine      = `type=LANDLOCK_ACCESS msg=audit(1729738800.268:30): domain=195ba459b ` +
+		`blockers=fs.refer pid=2060394 path="/usr/bin"`
+)
+
+var landlockLineRegex = regexp.MustCompile(
+	`type=LANDLOCK_ACCESS` + auditTimestampRegex + `.*\bblockers=(\S+) pid=(\d+) path="([^"]*)"`,
+)
+
+type landlockParser struct{ auditType string }
+
+func (p *landlockParser) Type() string {
+	return p.auditType
+}
+
+func (p *landlockParser) Parse(logLine string) *types.AuditLine {
+	captures := landlockLineRegex.FindStringSubmatch(logLine)
+	if captures == nil {
+		return nil
+	}
+	pid, err := strconv.Atoi(captures[3])
+	if err != nil {
+		return nil
+	}
+	return &types.AuditLine{
+		TimestampID: captures[1],
+		Timestamp:   auditTimestamp(captures[1]),
+		ProcessID:   pid,
+		Fields:      map[string]string{"blockers": captures[2], "path": captures[4]},
+	}
+}
+
+func (p *landlockParser) Record(line *types.AuditLine) string {
+	return line.Fields["blockers"] + ":" + line.Fields["path"]
+}
+
+var registerLandlockParser = sync.OnceValue(func() error {
+	return RegisterParser(&landlockParser{auditType: auditTypeLandlock})
+})
+
+func TestParserRegistry(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		auditType string
+		shouldErr bool
+	}{
+		{name: "success", auditType: "custom"},
+		{name: "empty type", shouldErr: true},
+		{name: "built-in type", auditType: types.AuditTypeSeccomp, shouldErr: true},
+		{name: "already registered", auditType: auditTypeLandlock, shouldErr: true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sut := &parserRegistry{}
+			require.NoError(t, sut.register(&landlockParser{auditType: auditTypeLandlock}))
+
+			err := sut.register(&landlockParser{auditType: tc.auditType})
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, ok := sut.get(tc.auditType)
+			require.True(t, ok)
+		})
+	}
+}
+
+func TestParserLines(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, registerLandlockParser())
+
+	require.True(t, IsAuditLine(landlockLine))
+	line, err := ExtractAuditLine(landlockLine)
+	require.NoError(t, err)
+	require.Equal(t, auditTypeLandlock, line.AuditType)
+	require.Equal(t, 2060394, line.ProcessID)
+	require.Equal(t, "/usr/bin", line.Fields["path"])
+
+	const testProfile = "test-profile"
+	sut := New(logr.Discard())
+	sut.impl = &enricherfakes.FakeImpl{}
+	info := &types.ContainerInfo{
+		PodName: pod, Namespace: namespace, ContainerName: "ctr", RecordProfile: testProfile,
+	}
+
+	require.NoError(t, sut.dispatchAuditLine(nil, node, line, info))
+	require.NoError(t, sut.recordAuditLine(line, info))
+	require.Equal(t, []string{"fs.refer:/usr/bin"}, sut.Records(auditTypeLandlock, testProfile))
+	require.Nil(t, sut.Records(auditTypeLandlock, "other"))
+
+	sut.ResetRecords(auditTypeLandlock, testProfile)
+	require.Nil(t, sut.Records(auditTypeLandlock, testProfile))
+
+	require.NoError(t, sut.recordAuditLine(line, info))
+	require.Equal(t, []string{auditTypeLandlock}, sut.evictRecords(testProfile))
+	require.Nil(t, sut.Records(auditTypeLandlock, testProfile))
+}
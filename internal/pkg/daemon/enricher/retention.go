@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+)
+
+const (
+	// retentionInterval is the interval for evicting the recorded data of
+	// profiles which exceeded the retention window.
+	retentionInterval = time.Minute
+
+	evictionKindSyscalls = "syscalls"
+	evictionKindAvcs     = "avcs"
+	evictionKindApparmor = "apparmor"
+)
+
+// retention tracks when the recorded data of a profile has been accessed the
+// last time, to evict the data of profiles whose recordings never complete.
+// Nothing gets tracked as long as the window is zero.
+type retention struct {
+	sync.Mutex
+	window     time.Duration
+	lastAccess map[string]time.Time
+}
+
+// touch marks the recorded data of the profile as accessed.
+func (r *retention) touch(profile string, now time.Time) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.window <= 0 {
+		return
+	}
+	if r.lastAccess == nil {
+		r.lastAccess = map[string]time.Time{}
+	}
+	r.lastAccess[profile] = now
+}
+
+// expired returns the sorted profiles which have not been accessed within the
+// retention window and stops tracking them.
+func (r *retention) expired(now time.Time) []string {
+	r.Lock()
+	defer r.Unlock()
+
+	res := []string{}
+	for profile, lastAccess := range r.lastAccess {
+		if now.Sub(lastAccess) > r.window {
+			res = append(res, profile)
+			delete(r.lastAccess, profile)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// evict removes the pending collection of the profile and returns true if
+// there was one.
+func (c *collections) evict(profile string) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	_, ok := c.pending[profile]
+	delete(c.pending, profile)
+	return ok
+}
+
+// evictOrphanedData removes the recorded data of all profiles which have not
+// been accessed within the retention window and counts the evictions per
+// kind of data.
+func (e *Enricher) evictOrphanedData(
+	nodeName string, evictionClient apimetrics.Metrics_EnricherEvictionIncClient,
+) {
+	for _, profile := range e.retention.expired(time.Now()) {
+		kinds := []string{}
+
+		_, syscalls := e.syscalls.LoadAndDelete(profile)
+		e.executables.Delete(profile)
+		if e.syscallCollections.evict(profile) || syscalls {
+			kinds = append(kinds, evictionKindSyscalls)
+		}
+
+		_, avcs := e.avcs.LoadAndDelete(profile)
+		e.avcScontexts.Delete(profile)
+		if e.avcCollections.evict(profile) || avcs {
+			kinds = append(kinds, evictionKindAvcs)
+		}
+
+		if _, ok := e.apparmor.LoadAndDelete(profile); ok {
+			kinds = append(kinds, evictionKindApparmor)
+		}
+
+		kinds = append(kinds, e.evictRecords(profile)...)
+
+		for _, kind := range kinds {
+			e.logger.Info("Evicting recorded data of orphaned recording", "profile", profile, "kind", kind)
+			if err := e.SendEnricherEvictionMetric(
+				evictionClient,
+				&apimetrics.EnricherEvictionRequest{Node: nodeName, Kind: kind},
+			); err != nil {
+				e.logger.Error(err, "unable to update enricher eviction metrics")
+			}
+		}
+	}
+}
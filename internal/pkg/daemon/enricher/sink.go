@@ -0,0 +1,280 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+const (
+	// sinkBufferSize is the maximum amount of audit events buffered for the
+	// file and webhook sinks. Further events get dropped until the next flush.
+	sinkBufferSize = 10000
+
+	// sinkRequestTimeout is the timeout for sending audit events to the
+	// webhook sink.
+	sinkRequestTimeout = 10 * time.Second
+)
+
+// auditEvent is an enriched audit event as written to the sinks.
+type auditEvent struct {
+	Timestamp        time.Time         `json:"timestamp"`
+	Type             string            `json:"type"`
+	Node             string            `json:"node"`
+	Namespace        string            `json:"namespace"`
+	Pod              string            `json:"pod"`
+	Container        string            `json:"container"`
+	RecordingSession string            `json:"recordingSession,omitempty"`
+	Executable       string            `json:"executable,omitempty"`
+	PID              int               `json:"pid,omitempty"`
+	Syscall          string            `json:"syscall,omitempty"`
+	Perm             string            `json:"perm,omitempty"`
+	Scontext         string            `json:"scontext,omitempty"`
+	Tcontext         string            `json:"tcontext,omitempty"`
+	Tclass           string            `json:"tclass,omitempty"`
+	Apparmor         string            `json:"apparmor,omitempty"`
+	Operation        string            `json:"operation,omitempty"`
+	Profile          string            `json:"profile,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Extra            string            `json:"extra,omitempty"`
+	Fields           map[string]string `json:"fields,omitempty"`
+}
+
+// newAuditEvent creates a new audit event from the audit line and the
+// container info. Fields not set for the type of the audit line are omitted.
+func newAuditEvent(nodeName string, auditLine *types.AuditLine, info *types.ContainerInfo) *auditEvent {
+	return &auditEvent{
+		Timestamp:        eventTime(auditLine, time.Now()),
+		Type:             auditLine.AuditType,
+		Node:             nodeName,
+		Namespace:        info.Namespace,
+		Pod:              info.PodName,
+		Container:        info.ContainerName,
+		RecordingSession: info.RecordingSession,
+		Executable:       auditLine.Executable,
+		PID:              auditLine.ProcessID,
+		Perm:             auditLine.Perm,
+		Scontext:         auditLine.Scontext,
+		Tcontext:         auditLine.Tcontext,
+		Tclass:           auditLine.Tclass,
+		Apparmor:         auditLine.Apparmor,
+		Operation:        auditLine.Operation,
+		Profile:          auditLine.Profile,
+		Name:             auditLine.Name,
+		Extra:            auditLine.ExtraInfo,
+		Fields:           auditLine.Fields,
+	}
+}
+
+// sinkOutput receives batches of audit events as JSON lines.
+type sinkOutput interface {
+	write(ctx context.Context, data []byte) error
+}
+
+// auditSinks writes the enriched audit events to stdout and buffers them for
+// the configured outputs, which get flushed periodically.
+type auditSinks struct {
+	jsonStdout bool
+	stdout     io.Writer
+	stdoutMu   sync.Mutex
+	outputs    []sinkOutput
+	buffer     []*auditEvent
+	bufferMu   sync.Mutex
+	dropped    int
+}
+
+// newAuditSinks configures the sinks from the environment.
+func newAuditSinks(logger logr.Logger) *auditSinks {
+	s := &auditSinks{stdout: os.Stdout}
+
+	switch format := spodv1alpha1.LogEnricherStdoutFormat(os.Getenv(config.EnricherSinkStdoutFormatEnvKey)); format {
+	case "", spodv1alpha1.LogEnricherStdoutFormatText:
+	case spodv1alpha1.LogEnricherStdoutFormatJSON:
+		s.jsonStdout = true
+	default:
+		logger.Info("Unknown stdout format for audit events, using text", "format", format)
+	}
+
+	if path := os.Getenv(config.EnricherSinkFileEnvKey); path != "" {
+		s.outputs = append(s.outputs, &fileSink{path: path})
+	}
+
+	if url := os.Getenv(config.EnricherSinkWebhookURLEnvKey); url != "" {
+		s.outputs = append(s.outputs, &webhookSink{
+			url:    url,
+			client: &http.Client{Timeout: sinkRequestTimeout},
+		})
+	}
+
+	return s
+}
+
+// writeStdout writes the audit event as JSON line to stdout.
+func (s *auditSinks) writeStdout(event *auditEvent) error {
+	s.stdoutMu.Lock()
+	defer s.stdoutMu.Unlock()
+
+	if err := json.NewEncoder(s.stdout).Encode(event); err != nil {
+		return fmt.Errorf("write audit event to stdout: %w", err)
+	}
+	return nil
+}
+
+// queue buffers the audit event for the outputs.
+func (s *auditSinks) queue(event *auditEvent) {
+	if len(s.outputs) == 0 {
+		return
+	}
+
+	s.bufferMu.Lock()
+	defer s.bufferMu.Unlock()
+
+	if len(s.buffer) >= sinkBufferSize {
+		s.dropped++
+		return
+	}
+	s.buffer = append(s.buffer, event)
+}
+
+// flush writes all buffered audit events to the outputs. A failed batch is
+// dropped to not let the buffer grow unbounded.
+func (s *auditSinks) flush(ctx context.Context) (dropped int, err error) {
+	s.bufferMu.Lock()
+	events := s.buffer
+	dropped = s.dropped
+	s.buffer = nil
+	s.dropped = 0
+	s.bufferMu.Unlock()
+
+	if len(events) == 0 {
+		return dropped, nil
+	}
+
+	data := &bytes.Buffer{}
+	encoder := json.NewEncoder(data)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return dropped, fmt.Errorf("encode audit event: %w", err)
+		}
+	}
+
+	var errs []error
+	for _, output := range s.outputs {
+		if err := output.write(ctx, data.Bytes()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return dropped, fmt.Errorf("write batch of %d audit events: %w", len(events), errors.Join(errs...))
+	}
+
+	return dropped, nil
+}
+
+// fileSink appends the audit events to a file on the node. The file gets
+// reopened for every batch, which supports external log rotation.
+type fileSink struct {
+	path string
+}
+
+func (f *fileSink) write(_ context.Context, data []byte) error {
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open sink file: %w", err)
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("write sink file: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close sink file: %w", err)
+	}
+	return nil
+}
+
+// webhookSink sends the audit events to an HTTP endpoint.
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func (w *webhookSink) write(ctx context.Context, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create sink request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send sink request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("sink webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// writeAuditEvent logs the audit event either by using the logger or as JSON
+// to stdout, and queues it for the configured sink outputs.
+func (e *Enricher) writeAuditEvent(event *auditEvent, values ...any) {
+	if e.sinks.jsonStdout {
+		if err := e.sinks.writeStdout(event); err != nil {
+			e.logger.Error(err, "unable to write audit event")
+		}
+	} else {
+		if event.RecordingSession != "" {
+			values = append(values, "recordingSession", event.RecordingSession)
+		}
+		e.logger.Info("audit", values...)
+	}
+
+	e.sinks.queue(event)
+}
+
+// flushAuditEvents writes the buffered audit events to the sink outputs.
+func (e *Enricher) flushAuditEvents() {
+	ctx, cancel := context.WithTimeout(context.Background(), sinkRequestTimeout)
+	defer cancel()
+
+	dropped, err := e.sinks.flush(ctx)
+	if dropped > 0 {
+		e.logger.Info("dropped audit events for full sink buffer", "events", dropped)
+	}
+	if err != nil {
+		e.logger.Error(err, "unable to write audit events to sinks")
+	}
+}
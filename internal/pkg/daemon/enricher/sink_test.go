@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+func TestNewAuditEvent(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	event := newAuditEvent("node", &types.AuditLine{
+		AuditType:  types.AuditTypeApparmor,
+		Timestamp:  ts,
+		ProcessID:  42,
+		Executable: "/bin/ls",
+		Apparmor:   "DENIED",
+		Operation:  "open",
+		Profile:    "profile",
+		Name:       "/etc/shadow",
+	}, &types.ContainerInfo{
+		Namespace:        "ns",
+		PodName:          "pod",
+		ContainerName:    "container",
+		RecordingSession: "session",
+	})
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"timestamp": "2023-01-02T03:04:05Z",
+		"type": "apparmor",
+		"node": "node",
+		"namespace": "ns",
+		"pod": "pod",
+		"container": "container",
+		"recordingSession": "session",
+		"executable": "/bin/ls",
+		"pid": 42,
+		"apparmor": "DENIED",
+		"operation": "open",
+		"profile": "profile",
+		"name": "/etc/shadow"
+	}`, string(data))
+}
+
+func TestAuditSinksWriteStdout(t *testing.T) {
+	t.Parallel()
+
+	stdout := &bytes.Buffer{}
+	sut := &auditSinks{stdout: stdout}
+
+	require.NoError(t, sut.writeStdout(&auditEvent{Type: "seccomp", Syscall: "read"}))
+	require.NoError(t, sut.writeStdout(&auditEvent{Type: "seccomp", Syscall: "write"}))
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], `"syscall":"read"`)
+	require.Contains(t, lines[1], `"syscall":"write"`)
+}
+
+func TestAuditSinksFlush(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err == nil && r.Header.Get("Content-Type") == "application/x-ndjson" {
+			received <- body
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sut := &auditSinks{outputs: []sinkOutput{
+		&fileSink{path: path},
+		&webhookSink{url: server.URL, client: server.Client()},
+	}}
+
+	sut.queue(&auditEvent{Type: "seccomp", Syscall: "read"})
+	sut.queue(&auditEvent{Type: "seccomp", Syscall: "write"})
+
+	dropped, err := sut.flush(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, dropped)
+	require.Empty(t, sut.buffer)
+
+	fileContent, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(fileContent)), "\n"), 2)
+	require.Equal(t, fileContent, <-received)
+
+	// A second flush appends to the file
+	sut.queue(&auditEvent{Type: "selinux", Perm: "read"})
+	_, err = sut.flush(context.Background())
+	require.NoError(t, err)
+	<-received
+
+	fileContent, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(fileContent)), "\n"), 3)
+}
+
+func TestAuditSinksFlushFailure(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sut := &auditSinks{outputs: []sinkOutput{
+		&webhookSink{url: server.URL, client: server.Client()},
+	}}
+	sut.queue(&auditEvent{Type: "seccomp"})
+
+	_, err := sut.flush(context.Background())
+	require.Error(t, err)
+	require.Empty(t, sut.buffer)
+}
+
+func TestAuditSinksQueue(t *testing.T) {
+	t.Parallel()
+
+	// Without outputs nothing gets buffered
+	sut := &auditSinks{}
+	sut.queue(&auditEvent{})
+	require.Empty(t, sut.buffer)
+
+	sut = &auditSinks{outputs: []sinkOutput{&fileSink{}}}
+	for i := 0; i < sinkBufferSize+2; i++ {
+		sut.queue(&auditEvent{})
+	}
+	require.Len(t, sut.buffer, sinkBufferSize)
+
+	dropped, err := sut.flush(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 2, dropped)
+}
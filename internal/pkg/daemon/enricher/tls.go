@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// tlsServerName is the name the certificate of the GRPC server has to
+	// be valid for, because the clients connect to it via a unix socket.
+	tlsServerName = "localhost"
+
+	// caCertKey is the key of the CA certificate in the TLS secret, as
+	// populated by cert-manager.
+	caCertKey = "ca.crt"
+)
+
+var errNoCACertificates = errors.New("no CA certificates found")
+
+// serverTLSConfig returns the TLS configuration of the GRPC server, which
+// requires the clients to present a certificate signed by the CA. The
+// certificates are loaded again for every connection to support rotating
+// them without restarting the log enricher.
+func serverTLSConfig(dir string) (*tls.Config, error) {
+	load := func() (*tls.Config, error) {
+		cert, pool, err := loadTLSFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+		return &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			ClientCAs:    pool,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			MinVersion:   tls.VersionTLS12,
+			NextProtos:   []string{"h2"},
+		}, nil
+	}
+
+	// Fail early on invalid certificates
+	if _, err := load(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return load()
+		},
+	}, nil
+}
+
+// clientTLSConfig returns the TLS configuration of the GRPC clients, which
+// present their certificate to the server and verify the server certificate
+// against the CA.
+func clientTLSConfig(dir string) (*tls.Config, error) {
+	cert, pool, err := loadTLSFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   tlsServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// loadTLSFiles loads the key pair and the CA from a directory containing a
+// mounted secret of type kubernetes.io/tls.
+func loadTLSFiles(dir string) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(
+		filepath.Join(dir, corev1.TLSCertKey), filepath.Join(dir, corev1.TLSPrivateKeyKey),
+	)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("load key pair: %w", err)
+	}
+
+	ca, err := os.ReadFile(filepath.Join(dir, caCertKey))
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("read CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return tls.Certificate{}, nil, fmt.Errorf("%s: %w", caCertKey, errNoCACertificates)
+	}
+
+	return cert, pool, nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// writeTestTLSSecret writes a CA and a certificate signed by it into a
+// directory, like a mounted secret of type kubernetes.io/tls.
+func writeTestTLSSecret(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	certDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: tlsServerName},
+		DNSNames:     []string{tlsServerName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	for name, block := range map[string]*pem.Block{
+		caCertKey:               {Type: "CERTIFICATE", Bytes: caDER},
+		corev1.TLSCertKey:       {Type: "CERTIFICATE", Bytes: certDER},
+		corev1.TLSPrivateKeyKey: {Type: "EC PRIVATE KEY", Bytes: keyDER},
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), pem.EncodeToMemory(block), 0o600))
+	}
+
+	return dir
+}
+
+// handshake runs a TLS handshake between the provided configurations and
+// returns the error of the server.
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) error {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+		if err == nil {
+			conn.Close()
+		}
+	}()
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(time.Minute)))
+
+	return tls.Server(conn, serverConfig).Handshake()
+}
+
+func TestTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*testing.T, string) *tls.Config
+		assert  func(error)
+	}{
+		{
+			name: "mutual TLS",
+			prepare: func(t *testing.T, dir string) *tls.Config {
+				t.Helper()
+				clientConfig, err := clientTLSConfig(dir)
+				require.NoError(t, err)
+				return clientConfig
+			},
+			assert: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "client without certificate",
+			prepare: func(t *testing.T, dir string) *tls.Config {
+				t.Helper()
+				clientConfig, err := clientTLSConfig(dir)
+				require.NoError(t, err)
+				clientConfig.Certificates = nil
+				return clientConfig
+			},
+			assert: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "client with untrusted certificate",
+			prepare: func(t *testing.T, _ string) *tls.Config {
+				t.Helper()
+				clientConfig, err := clientTLSConfig(writeTestTLSSecret(t))
+				require.NoError(t, err)
+				clientConfig.InsecureSkipVerify = true
+				return clientConfig
+			},
+			assert: func(err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dir := writeTestTLSSecret(t)
+			serverConfig, err := serverTLSConfig(dir)
+			require.NoError(t, err)
+
+			tc.assert(handshake(t, serverConfig, tc.prepare(t, dir)))
+		})
+	}
+}
+
+func TestTLSConfigInvalidFiles(t *testing.T) {
+	t.Parallel()
+
+	_, err := serverTLSConfig(t.TempDir())
+	require.Error(t, err)
+
+	dir := writeTestTLSSecret(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, caCertKey), []byte("invalid"), 0o600))
+	_, err = clientTLSConfig(dir)
+	require.ErrorIs(t, err, errNoCACertificates)
+}
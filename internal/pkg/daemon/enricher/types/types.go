@@ -16,6 +16,8 @@ limitations under the License.
 
 package types
 
+import "time"
+
 const (
 	AuditTypeSeccomp  = "seccomp"
 	AuditTypeSelinux  = "selinux"
@@ -28,6 +30,9 @@ type AuditLine struct {
 	// common
 	ProcessID   int
 	TimestampID string
+	// Timestamp is the time of the event parsed from the TimestampID in UTC.
+	// It is zero if the record does not contain a timestamp.
+	Timestamp time.Time
 
 	// seccomp
 	SystemCallID int32
@@ -49,12 +54,41 @@ type AuditLine struct {
 	// ExtraInfo may contain addition information such as:
 	// requested_mask, denied_mask, fsuid=65534, ouid and target.
 	ExtraInfo string
+	// RequestedMask is the access requested by the operation, for example r
+	// or wc for file operations.
+	RequestedMask string
+
+	// Fields contains the values of audit types provided by a Parser.
+	Fields map[string]string
+}
+
+// Parser is a plugin for an additional type of audit lines, for example the
+// ones of an LSM which is not built into the enricher.
+type Parser interface {
+	// Type returns the audit type of the parsed lines, which has to be
+	// unique.
+	Type() string
+	// Parse returns the audit line for the log line, or nil if the log line
+	// is not of the type of the parser.
+	Parse(logLine string) *AuditLine
+	// Record returns the entry the audit line adds to the recorded profile
+	// of its container, or an empty string if it should not be recorded.
+	Record(line *AuditLine) string
 }
 
 type ContainerInfo struct {
 	PodName       string
+	PodUID        string
 	ContainerName string
 	Namespace     string
 	ContainerID   string
 	RecordProfile string
+	// RecordingSession is the ID of the recording session of the pod, empty
+	// if the pod is not recorded.
+	RecordingSession string
+	// SelinuxType is the SELinux type the container runs with according to
+	// its security context, empty if the runtime chooses the type.
+	SelinuxType string
+	// StartTime is the time the container was started, zero if unknown.
+	StartTime time.Time
 }
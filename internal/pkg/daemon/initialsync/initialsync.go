@@ -0,0 +1,254 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package initialsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// syncInterval is the interval for checking the progress of the initial sync.
+const syncInterval = 5 * time.Second
+
+var errNotSynced = errors.New("profiles not synced yet")
+
+// InitialSync tracks the installation of all profiles after the daemon
+// started, for example on a new or reimaged node. The daemon is not ready
+// until all profiles have been synced, and the progress is reported on the
+// node, which allows to keep workloads using localhost profiles from being
+// scheduled on the node too early. If enabled, the node is additionally
+// tainted until the sync finished.
+type InitialSync struct {
+	client   client.Client
+	log      logr.Logger
+	nodeName string
+	taint    bool
+	newLists []func() client.ObjectList
+	synced   atomic.Bool
+	progress string
+}
+
+// NewController returns a new empty controller instance, which waits for the
+// profiles listed by newLists to be synced.
+func NewController(newLists ...func() client.ObjectList) controller.Controller {
+	return &InitialSync{newLists: newLists}
+}
+
+// Name returns the name of the controller.
+func (r *InitialSync) Name() string {
+	return "initial-sync"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *InitialSync) SchemeBuilder() *scheme.Builder {
+	return statusv1alpha1.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *InitialSync) Healthz(*http.Request) error {
+	return nil
+}
+
+// Readyz is the readiness probe endpoint of the controller, which fails
+// until the initial sync finished.
+func (r *InitialSync) Readyz(*http.Request) error {
+	if !r.synced.Load() {
+		return errNotSynced
+	}
+	return nil
+}
+
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;patch
+
+// Setup adds a runnable which waits for the initial sync to finish.
+func (r *InitialSync) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.log = logf.Log.WithName(r.Name())
+	r.client = mgr.GetClient()
+	r.nodeName = os.Getenv(config.NodeNameEnvKey)
+	taint, err := strconv.ParseBool(os.Getenv(config.StartupTaintEnvKey))
+	r.taint = err == nil && taint
+
+	if err := mgr.AddReadyzCheck(r.Name(), r.Readyz); err != nil {
+		return fmt.Errorf("add readiness check: %w", err)
+	}
+
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		//nolint:errcheck // the error only indicates that the context is done
+		wait.PollUntilContextCancel(ctx, syncInterval, true, func(ctx context.Context) (bool, error) {
+			done, err := r.sync(ctx)
+			if err != nil {
+				r.log.Error(err, "cannot check initial profile sync")
+			}
+			return done, nil
+		})
+		return nil
+	}))
+}
+
+// sync checks whether all profiles have been synced on the node and reports
+// the progress.
+func (r *InitialSync) sync(ctx context.Context) (bool, error) {
+	total := 0
+	for _, newList := range r.newLists {
+		list := newList()
+		if err := r.client.List(ctx, list); err != nil {
+			return false, fmt.Errorf("list profiles: %w", err)
+		}
+		total += meta.LenList(list)
+	}
+
+	nodeStatuses := &statusv1alpha1.SecurityProfileNodeStatusList{}
+	if err := r.client.List(
+		ctx, nodeStatuses, client.MatchingLabels{statusv1alpha1.StatusToNodeLabel: r.nodeName},
+	); err != nil {
+		return false, fmt.Errorf("list node statuses: %w", err)
+	}
+
+	synced := 0
+	for i := range nodeStatuses.Items {
+		if isSynced(nodeStatuses.Items[i].Status) {
+			synced++
+		}
+	}
+	// The statuses of deleted profiles may still exist
+	synced = min(synced, total)
+	done := synced == total
+
+	if err := r.reportProgress(ctx, synced, total, done); err != nil {
+		return false, err
+	}
+	if done {
+		r.log.Info("Initial profile sync finished", "profiles", total)
+		r.synced.Store(true)
+	}
+	return done, nil
+}
+
+// reportProgress labels the node with whether all profiles have been synced
+// and annotates it with the number of synced profiles. A merge patch is used
+// to not conflict with other updates of the node.
+func (r *InitialSync) reportProgress(ctx context.Context, synced, total int, done bool) error {
+	progress := fmt.Sprintf("%d/%d", synced, total)
+	if progress == r.progress {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]string{
+				config.ProfilesSyncedLabelKey: strconv.FormatBool(done),
+			},
+			"annotations": map[string]string{
+				config.ProfilesSyncProgressAnnotationKey: progress,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal node patch: %w", err)
+	}
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: r.nodeName}}
+	if err := r.client.Patch(ctx, node, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return fmt.Errorf("patching node: %w", err)
+	}
+
+	if r.taint {
+		if err := r.updateTaint(ctx, done); err != nil {
+			return err
+		}
+	}
+
+	r.log.V(config.VerboseLevel).Info("Reported profile sync progress", "node", r.nodeName, "progress", progress)
+	r.progress = progress
+	return nil
+}
+
+// updateTaint taints the node until all profiles have been synced. The taints
+// of a node can only be replaced as a whole, which is why the patch uses an
+// optimistic lock.
+func (r *InitialSync) updateTaint(ctx context.Context, done bool) error {
+	isStartupTaint := func(taint corev1.Taint) bool {
+		return taint.Key == config.ProfilesNotSyncedTaintKey
+	}
+
+	if err := util.Retry(func() error {
+		node := &corev1.Node{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: r.nodeName}, node); err != nil {
+			return fmt.Errorf("getting node: %w", err)
+		}
+		if slices.ContainsFunc(node.Spec.Taints, isStartupTaint) != done {
+			return nil
+		}
+
+		patched := node.DeepCopy()
+		if done {
+			patched.Spec.Taints = slices.DeleteFunc(patched.Spec.Taints, isStartupTaint)
+		} else {
+			patched.Spec.Taints = append(patched.Spec.Taints, corev1.Taint{
+				Key:    config.ProfilesNotSyncedTaintKey,
+				Effect: corev1.TaintEffectNoSchedule,
+			})
+		}
+		return r.client.Patch(ctx, patched, client.MergeFromWithOptions(node, client.MergeFromWithOptimisticLock{}))
+	}, kerrors.IsConflict); err != nil {
+		return fmt.Errorf("updating node taint: %w", err)
+	}
+	return nil
+}
+
+// isSynced returns true if the daemon finished processing the profile on the
+// node, regardless of whether its installation succeeded.
+func isSynced(state statusv1alpha1.ProfileState) bool {
+	switch state {
+	case "", statusv1alpha1.ProfileStatePending, statusv1alpha1.ProfileStateInProgress:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+	require.NoError(t, statusv1alpha1.AddToScheme(scheme))
+
+	nodeStatus := func(name, node string, state statusv1alpha1.ProfileState) *statusv1alpha1.SecurityProfileNodeStatus {
+		return &statusv1alpha1.SecurityProfileNodeStatus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-" + node,
+				Namespace: "ns",
+				Labels:    map[string]string{statusv1alpha1.StatusToNodeLabel: node},
+			},
+			NodeName: node,
+			Status:   state,
+		}
+	}
+	pending := nodeStatus("b", "node", statusv1alpha1.ProfileStatePending)
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}},
+		&seccompprofileapi.SeccompProfile{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"}},
+		&seccompprofileapi.SeccompProfile{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns"}},
+		nodeStatus("a", "node", statusv1alpha1.ProfileStateInstalled),
+		nodeStatus("a", "other-node", statusv1alpha1.ProfileStateInstalled),
+		nodeStatus("b", "other-node", statusv1alpha1.ProfileStateError),
+		pending,
+	).Build()
+
+	sut := &InitialSync{
+		client:   cli,
+		log:      logr.Discard(),
+		nodeName: "node",
+		newLists: []func() client.ObjectList{
+			func() client.ObjectList { return &seccompprofileapi.SeccompProfileList{} },
+		},
+	}
+	ctx := context.Background()
+	node := &corev1.Node{}
+
+	// One profile is still pending
+	done, err := sut.sync(ctx)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Error(t, sut.Readyz(nil))
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: "node"}, node))
+	require.Equal(t, "false", node.Labels[config.ProfilesSyncedLabelKey])
+	require.Equal(t, "1/2", node.Annotations[config.ProfilesSyncProgressAnnotationKey])
+
+	// Failed profiles are synced as well
+	pending.Status = statusv1alpha1.ProfileStateError
+	require.NoError(t, cli.Update(ctx, pending))
+	done, err = sut.sync(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.NoError(t, sut.Readyz(nil))
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: "node"}, node))
+	require.Equal(t, "true", node.Labels[config.ProfilesSyncedLabelKey])
+	require.Equal(t, "2/2", node.Annotations[config.ProfilesSyncProgressAnnotationKey])
+}
+
+func TestSyncTaint(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+	require.NoError(t, statusv1alpha1.AddToScheme(scheme))
+
+	otherTaint := corev1.Taint{Key: "other", Effect: corev1.TaintEffectNoExecute}
+	nodeStatus := &statusv1alpha1.SecurityProfileNodeStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "a-node",
+			Namespace: "ns",
+			Labels:    map[string]string{statusv1alpha1.StatusToNodeLabel: "node"},
+		},
+		NodeName: "node",
+		Status:   statusv1alpha1.ProfileStatePending,
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node"},
+			Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+				otherTaint,
+				// Registered by the kubelet
+				{Key: config.ProfilesNotSyncedTaintKey, Effect: corev1.TaintEffectNoSchedule},
+			}},
+		},
+		&seccompprofileapi.SeccompProfile{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns"}},
+		nodeStatus,
+	).Build()
+
+	sut := &InitialSync{
+		client:   cli,
+		log:      logr.Discard(),
+		nodeName: "node",
+		taint:    true,
+		newLists: []func() client.ObjectList{
+			func() client.ObjectList { return &seccompprofileapi.SeccompProfileList{} },
+		},
+	}
+	ctx := context.Background()
+	node := &corev1.Node{}
+
+	// The taint is kept while the profile is pending
+	done, err := sut.sync(ctx)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: "node"}, node))
+	require.ElementsMatch(t, []corev1.Taint{
+		otherTaint,
+		{Key: config.ProfilesNotSyncedTaintKey, Effect: corev1.TaintEffectNoSchedule},
+	}, node.Spec.Taints)
+
+	// The taint is removed once the profile is installed
+	nodeStatus.Status = statusv1alpha1.ProfileStateInstalled
+	require.NoError(t, cli.Update(ctx, nodeStatus))
+	done, err = sut.sync(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: "node"}, node))
+	require.Equal(t, []corev1.Taint{otherTaint}, node.Spec.Taints)
+}
@@ -0,0 +1,37 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package landlockprofile
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// landlockABI returns the Landlock ABI version supported by the kernel.
+func landlockABI() (int, error) {
+	abi, _, errno := unix.Syscall(
+		unix.SYS_LANDLOCK_CREATE_RULESET, 0, 0, unix.LANDLOCK_CREATE_RULESET_VERSION,
+	)
+	if errno != 0 {
+		return 0, fmt.Errorf("landlock not available: %w", errno)
+	}
+	return int(abi), nil
+}
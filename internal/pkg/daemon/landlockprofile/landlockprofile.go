@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package landlockprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	"sigs.k8s.io/security-profiles-operator/api/landlockprofile/v1alpha1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	// default reconcile timeout.
+	reconcileTimeout = 1 * time.Minute
+
+	wait = 10 * time.Second
+
+	errGetProfile = "cannot get profile"
+
+	reasonLandlockNotSupported     string = "LandlockNotSupportedOnNode"
+	reasonCannotUpdateStatus       string = "CannotUpdateNodeStatus"
+	reasonCannotInstallProfile     string = "CannotInstallLandlockProfile"
+	reasonCannotRemoveProfile      string = "CannotRemoveLandlockProfile"
+	reasonInstalledLandlockProfile string = "InstalledLandlockProfile"
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &Reconciler{}
+}
+
+// A Reconciler reconciles Landlock profiles.
+type Reconciler struct {
+	client  client.Client
+	log     logr.Logger
+	record  record.EventRecorder
+	manager ProfileManager
+	dryRun  bool
+}
+
+// Name returns the name of the controller.
+func (r *Reconciler) Name() string {
+	return "landlock-spod"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *Reconciler) SchemeBuilder() *scheme.Builder {
+	return v1alpha1.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *Reconciler) Healthz(*http.Request) error {
+	if _, err := r.manager.ABI(); err != nil {
+		return fmt.Errorf("node %q does not support landlock: %w", os.Getenv(config.NodeNameEnvKey), err)
+	}
+	return nil
+}
+
+// Security Profiles Operator RBAC permissions to manage LandlockProfile
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=landlockprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=landlockprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=landlockprofiles/finalizers,verbs=delete;get;update;patch
+
+// Reconcile reconciles a LandlockProfile.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("landlockprofile", req.Name, "namespace", req.Namespace)
+	logger.Info("Reconciling LandlockProfile")
+
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	sp := &v1alpha1.LandlockProfile{}
+	if err := r.client.Get(ctx, req.NamespacedName, sp); err != nil {
+		// Expected to find a LandlockProfile, return an error and requeue
+		if util.IgnoreNotFound(err) == nil {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("%s: %w", errGetProfile, err)
+	}
+
+	// Pre-check if the node supports Landlock
+	if _, err := r.manager.ABI(); err != nil {
+		logger.Error(err, fmt.Sprintf("node %q does not support landlock", os.Getenv(config.NodeNameEnvKey)))
+		r.record.Event(sp, util.EventTypeWarning, reasonLandlockNotSupported, err.Error())
+
+		// Do not requeue (will be requeued if a change to the object is
+		// observed, or after the usually very long reconcile timeout
+		// configured for the controller manager)
+		return reconcile.Result{}, nil
+	}
+
+	return r.reconcileLandlockProfile(ctx, sp, logger)
+}
+
+func (r *Reconciler) reconcileLandlockProfile(
+	ctx context.Context, sp *v1alpha1.LandlockProfile, l logr.Logger,
+) (reconcile.Result, error) {
+	nodeStatus, err := nodestatus.NewForProfile(sp, r.client)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("cannot create nodeStatus: %w", err)
+	}
+
+	if !sp.GetDeletionTimestamp().IsZero() { // object is being deleted
+		return r.reconcileDeletion(ctx, sp, nodeStatus)
+	}
+
+	exists, err := nodeStatus.Exists(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking if node status exists: %w", err)
+	}
+
+	if !exists {
+		if err := nodeStatus.Create(ctx); err != nil {
+			return reconcile.Result{}, fmt.Errorf("cannot ensure node status: %w", err)
+		}
+		l.Info("Created an initial status for this node")
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	if r.dryRun {
+		return r.reportDryRun(ctx, sp, nodeStatus, l)
+	}
+
+	updated, err := r.manager.InstallProfile(sp)
+	if err != nil {
+		return r.installFailed(ctx, sp, nodeStatus, l, err)
+	}
+
+	isAlreadyInstalled, err := nodeStatus.Matches(ctx, statusv1alpha1.ProfileStateInstalled)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("getting status for installed LandlockProfile: %w", err)
+	}
+
+	if !isAlreadyInstalled {
+		if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateInstalled); err != nil {
+			l.Error(err, "cannot update node status")
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+			return reconcile.Result{}, fmt.Errorf("updating status in LandlockProfile reconciler: %w", err)
+		}
+	}
+
+	l.Info(
+		"Reconciled profile from LandlockProfile",
+		"resource version", sp.GetResourceVersion(),
+		"path", ProfilePath(config.LandlockProfilesRootPath(), sp),
+	)
+	if updated {
+		evstr := fmt.Sprintf("Successfully installed profile on node %s", os.Getenv(config.NodeNameEnvKey))
+		r.record.Event(sp, util.EventTypeNormal, reasonInstalledLandlockProfile, evstr)
+	}
+	return reconcile.Result{}, nil
+}
+
+// reportDryRun reports the rendered ruleset of the profile instead of
+// installing it on the node.
+func (r *Reconciler) reportDryRun(
+	ctx context.Context, sp *v1alpha1.LandlockProfile, nodeStatus *nodestatus.StatusClient, l logr.Logger,
+) (reconcile.Result, error) {
+	content, err := r.manager.RenderProfile(sp)
+	if err != nil {
+		return r.installFailed(ctx, sp, nodeStatus, l, err)
+	}
+
+	path := ProfilePath(config.LandlockProfilesRootPath(), sp)
+	if err := common.ReportDryRun(ctx, nodeStatus, r.record, l, sp, path, content); err != nil {
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+		return reconcile.Result{}, fmt.Errorf("reporting dry run of LandlockProfile: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// installFailed sets the node status of a profile which cannot be installed
+// to Error.
+func (r *Reconciler) installFailed(
+	ctx context.Context, sp *v1alpha1.LandlockProfile, nodeStatus *nodestatus.StatusClient, l logr.Logger, err error,
+) (reconcile.Result, error) {
+	l.Error(err, "cannot install profile on node")
+	r.record.Event(sp, util.EventTypeWarning, reasonCannotInstallProfile, err.Error())
+	if statusErr := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateError); statusErr != nil {
+		l.Error(statusErr, "cannot update node status")
+	}
+	if errors.Is(err, ErrUnsupportedABI) {
+		// Retrying does not help until the kernel of the node changes
+		return reconcile.Result{}, nil
+	}
+	return reconcile.Result{}, fmt.Errorf("cannot install profile on node: %w", err)
+}
+
+func (r *Reconciler) reconcileDeletion(
+	ctx context.Context,
+	sp *v1alpha1.LandlockProfile,
+	nsc *nodestatus.StatusClient,
+) (reconcile.Result, error) {
+	hasStatus, err := nsc.Exists(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("checking if node status exists: %w", err)
+	}
+
+	// Set the status if it hasn't been deleted already
+	if hasStatus {
+		isTerminating, err := nsc.Matches(ctx, statusv1alpha1.ProfileStateTerminating)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("getting status for deleted LandlockProfile: %w", err)
+		}
+
+		if !isTerminating {
+			r.log.Info("setting status to terminating")
+			if err := nsc.SetNodeStatus(ctx, statusv1alpha1.ProfileStateTerminating); err != nil {
+				r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+				return reconcile.Result{}, fmt.Errorf("updating status for deleted LandlockProfile: %w", err)
+			}
+			return reconcile.Result{Requeue: true, RequeueAfter: wait}, nil
+		}
+	}
+
+	if r.dryRun {
+		r.log.Info("Dry run, not removing profile from node")
+	} else {
+		if err := r.manager.RemoveProfile(sp); err != nil {
+			r.log.Error(err, "cannot remove profile")
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotRemoveProfile, err.Error())
+			return ctrl.Result{}, fmt.Errorf("removing profile from node: %w", err)
+		}
+		r.log.Info(fmt.Sprintf("removed profile %s", sp.GetProfileName()))
+	}
+
+	if err := nsc.Remove(ctx, r.client); err != nil {
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+		return ctrl.Result{}, fmt.Errorf("deleting node status/finalizer for deleted LandlockProfile: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package landlockprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	name := "cool-profile"
+	namespace := "cool-namespace"
+
+	for _, tc := range []struct {
+		name           string
+		getErr         error
+		abi            func() (int, error)
+		expectedEvents int
+		shouldErr      bool
+	}{
+		{
+			name:   "profile not found",
+			getErr: kerrors.NewNotFound(schema.GroupResource{}, name),
+			abi:    func() (int, error) { return 1, nil },
+		},
+		{
+			name:      "failure on get profile",
+			getErr:    errTest,
+			abi:       func() (int, error) { return 1, nil },
+			shouldErr: true,
+		},
+		{
+			name:           "landlock not supported",
+			abi:            func() (int, error) { return 0, errTest },
+			expectedEvents: 1,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := record.NewFakeRecorder(10)
+			sut := &Reconciler{
+				client: &util.MockClient{
+					MockGet: util.NewMockGetFn(tc.getErr),
+				},
+				log:     log.Log,
+				record:  recorder,
+				manager: &fileProfileManager{root: t.TempDir(), abi: tc.abi},
+			}
+
+			res, err := sut.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: namespace, Name: name},
+			})
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, reconcile.Result{}, res)
+			require.Len(t, recorder.Events, tc.expectedEvents)
+		})
+	}
+}
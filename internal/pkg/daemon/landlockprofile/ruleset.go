@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package landlockprofile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/security-profiles-operator/api/landlockprofile/v1alpha1"
+)
+
+const (
+	dirPermissionMode  os.FileMode = 0o744
+	filePermissionMode os.FileMode = 0o644
+)
+
+// ErrUnsupportedABI is returned if the kernel of the node does not support
+// all access rights of a profile.
+var ErrUnsupportedABI = errors.New("landlock ABI version of node not supported by profile")
+
+// Ruleset is the rendered Landlock filesystem ruleset of a profile, which is
+// meant to be applied via landlock_create_ruleset(2) and landlock_add_rule(2)
+// by the workload or a wrapper.
+type Ruleset struct {
+	// ABI is the minimum Landlock ABI version required by the ruleset.
+	ABI int `json:"abi"`
+	// HandledAccessFS are the filesystem access rights restricted by the
+	// ruleset.
+	HandledAccessFS []v1alpha1.LandlockAccessFS `json:"handledAccessFS"`
+	// Rules are the paths beneath which handled access rights are granted.
+	Rules []v1alpha1.LandlockPathRule `json:"rules"`
+}
+
+// NewRuleset renders the ruleset of the profile and validates that all
+// granted access rights are handled.
+func NewRuleset(sp *v1alpha1.LandlockProfile) (*Ruleset, error) {
+	handled := map[v1alpha1.LandlockAccessFS]bool{}
+	for _, access := range sp.Spec.HandledAccessFS {
+		handled[access] = true
+	}
+
+	rules := []v1alpha1.LandlockPathRule{}
+	for _, rule := range sp.Spec.Rules {
+		if !filepath.IsAbs(rule.Path) {
+			return nil, fmt.Errorf("rule path %q is not absolute", rule.Path)
+		}
+		for _, access := range rule.AllowedAccess {
+			if !handled[access] {
+				return nil, fmt.Errorf("access %q of rule path %q is not handled by the profile", access, rule.Path)
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	return &Ruleset{
+		ABI:             sp.MinimumABI(),
+		HandledAccessFS: sp.Spec.HandledAccessFS,
+		Rules:           rules,
+	}, nil
+}
+
+// ProfilePath returns the path of the rendered ruleset of the profile on the
+// node.
+func ProfilePath(root string, sp *v1alpha1.LandlockProfile) string {
+	return filepath.Join(root, sp.GetNamespace(), sp.GetName()+".json")
+}
+
+// ProfileManager manages the Landlock rulesets on the node.
+type ProfileManager interface {
+	// ABI returns the Landlock ABI version supported by the kernel of the
+	// node, or an error if Landlock is not available.
+	ABI() (int, error)
+
+	// RenderProfile renders the ruleset of the profile for the node without
+	// installing it.
+	RenderProfile(sp *v1alpha1.LandlockProfile) ([]byte, error)
+
+	// InstallProfile renders the ruleset of the profile onto the node and
+	// returns true if the file on disk changed.
+	InstallProfile(sp *v1alpha1.LandlockProfile) (bool, error)
+
+	// RemoveProfile removes the ruleset of the profile from the node.
+	RemoveProfile(sp *v1alpha1.LandlockProfile) error
+}
+
+type fileProfileManager struct {
+	root string
+	abi  func() (int, error)
+}
+
+// NewProfileManager returns a new ProfileManager, which stores the rulesets
+// below the provided root directory.
+func NewProfileManager(root string) ProfileManager {
+	return &fileProfileManager{root: root, abi: landlockABI}
+}
+
+func (m *fileProfileManager) ABI() (int, error) {
+	return m.abi()
+}
+
+func (m *fileProfileManager) RenderProfile(sp *v1alpha1.LandlockProfile) ([]byte, error) {
+	ruleset, err := NewRuleset(sp)
+	if err != nil {
+		return nil, fmt.Errorf("render ruleset: %w", err)
+	}
+
+	abi, err := m.ABI()
+	if err != nil {
+		return nil, fmt.Errorf("get landlock ABI version: %w", err)
+	}
+	if ruleset.ABI > abi {
+		return nil, fmt.Errorf("%w: requires %d, node supports %d", ErrUnsupportedABI, ruleset.ABI, abi)
+	}
+
+	content, err := json.MarshalIndent(ruleset, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal ruleset: %w", err)
+	}
+	return content, nil
+}
+
+func (m *fileProfileManager) InstallProfile(sp *v1alpha1.LandlockProfile) (bool, error) {
+	content, err := m.RenderProfile(sp)
+	if err != nil {
+		return false, err
+	}
+
+	path := ProfilePath(m.root, sp)
+	existing, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(existing, content) {
+		return false, nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return false, fmt.Errorf("read ruleset: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPermissionMode); err != nil {
+		return false, fmt.Errorf("create ruleset directory: %w", err)
+	}
+	if err := os.WriteFile(path, content, filePermissionMode); err != nil {
+		return false, fmt.Errorf("write ruleset: %w", err)
+	}
+
+	return true, nil
+}
+
+func (m *fileProfileManager) RemoveProfile(sp *v1alpha1.LandlockProfile) error {
+	if err := os.Remove(ProfilePath(m.root, sp)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove ruleset: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package landlockprofile
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/security-profiles-operator/api/landlockprofile/v1alpha1"
+)
+
+var errTest = errors.New("test")
+
+func testProfile() *v1alpha1.LandlockProfile {
+	return &v1alpha1.LandlockProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "namespace"},
+		Spec: v1alpha1.LandlockProfileSpec{
+			HandledAccessFS: []v1alpha1.LandlockAccessFS{
+				v1alpha1.LandlockAccessFSReadFile,
+				v1alpha1.LandlockAccessFSWriteFile,
+			},
+			Rules: []v1alpha1.LandlockPathRule{{
+				Path:          "/usr",
+				AllowedAccess: []v1alpha1.LandlockAccessFS{v1alpha1.LandlockAccessFSReadFile},
+			}},
+		},
+	}
+}
+
+func TestNewRuleset(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		prepare     func(*v1alpha1.LandlockProfile)
+		expectedABI int
+		shouldErr   bool
+	}{
+		{
+			name:        "success",
+			prepare:     func(*v1alpha1.LandlockProfile) {},
+			expectedABI: 1,
+		},
+		{
+			name: "success with newer access rights",
+			prepare: func(sp *v1alpha1.LandlockProfile) {
+				sp.Spec.HandledAccessFS = append(sp.Spec.HandledAccessFS,
+					v1alpha1.LandlockAccessFSRefer, v1alpha1.LandlockAccessFSTruncate)
+			},
+			expectedABI: 3,
+		},
+		{
+			name: "failure on unhandled access right",
+			prepare: func(sp *v1alpha1.LandlockProfile) {
+				sp.Spec.Rules[0].AllowedAccess = append(sp.Spec.Rules[0].AllowedAccess,
+					v1alpha1.LandlockAccessFSExecute)
+			},
+			shouldErr: true,
+		},
+		{
+			name: "failure on relative path",
+			prepare: func(sp *v1alpha1.LandlockProfile) {
+				sp.Spec.Rules[0].Path = "usr"
+			},
+			shouldErr: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := testProfile()
+			tc.prepare(sp)
+
+			ruleset, err := NewRuleset(sp)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedABI, ruleset.ABI)
+			require.Equal(t, sp.Spec.HandledAccessFS, ruleset.HandledAccessFS)
+			require.Equal(t, sp.Spec.Rules, ruleset.Rules)
+		})
+	}
+}
+
+func TestProfileManager(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		abi     func() (int, error)
+		prepare func(*v1alpha1.LandlockProfile)
+		assert  func(*fileProfileManager, *v1alpha1.LandlockProfile)
+	}{
+		{
+			name: "install and remove",
+			abi:  func() (int, error) { return 1, nil },
+			assert: func(sut *fileProfileManager, sp *v1alpha1.LandlockProfile) {
+				updated, err := sut.InstallProfile(sp)
+				require.NoError(t, err)
+				require.True(t, updated)
+
+				content, err := os.ReadFile(ProfilePath(sut.root, sp))
+				require.NoError(t, err)
+				ruleset := &Ruleset{}
+				require.NoError(t, json.Unmarshal(content, ruleset))
+				require.Equal(t, 1, ruleset.ABI)
+				require.Len(t, ruleset.Rules, 1)
+
+				updated, err = sut.InstallProfile(sp)
+				require.NoError(t, err)
+				require.False(t, updated)
+
+				require.NoError(t, sut.RemoveProfile(sp))
+				_, err = os.Stat(ProfilePath(sut.root, sp))
+				require.True(t, os.IsNotExist(err))
+				require.NoError(t, sut.RemoveProfile(sp))
+			},
+		},
+		{
+			name: "render without installing",
+			abi:  func() (int, error) { return 1, nil },
+			assert: func(sut *fileProfileManager, sp *v1alpha1.LandlockProfile) {
+				content, err := sut.RenderProfile(sp)
+				require.NoError(t, err)
+				ruleset := &Ruleset{}
+				require.NoError(t, json.Unmarshal(content, ruleset))
+				require.Len(t, ruleset.Rules, 1)
+
+				_, err = os.Stat(ProfilePath(sut.root, sp))
+				require.True(t, os.IsNotExist(err))
+			},
+		},
+		{
+			name: "update on changed profile",
+			abi:  func() (int, error) { return 1, nil },
+			assert: func(sut *fileProfileManager, sp *v1alpha1.LandlockProfile) {
+				_, err := sut.InstallProfile(sp)
+				require.NoError(t, err)
+
+				sp.Spec.Rules[0].Path = "/etc"
+				updated, err := sut.InstallProfile(sp)
+				require.NoError(t, err)
+				require.True(t, updated)
+			},
+		},
+		{
+			name: "failure on unsupported ABI",
+			abi:  func() (int, error) { return 1, nil },
+			prepare: func(sp *v1alpha1.LandlockProfile) {
+				sp.Spec.HandledAccessFS = append(sp.Spec.HandledAccessFS, v1alpha1.LandlockAccessFSTruncate)
+			},
+			assert: func(sut *fileProfileManager, sp *v1alpha1.LandlockProfile) {
+				_, err := sut.InstallProfile(sp)
+				require.ErrorIs(t, err, ErrUnsupportedABI)
+				_, err = os.Stat(ProfilePath(sut.root, sp))
+				require.True(t, os.IsNotExist(err))
+			},
+		},
+		{
+			name: "failure on landlock not available",
+			abi:  func() (int, error) { return 0, errTest },
+			assert: func(sut *fileProfileManager, sp *v1alpha1.LandlockProfile) {
+				_, err := sut.InstallProfile(sp)
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := testProfile()
+			if tc.prepare != nil {
+				tc.prepare(sp)
+			}
+			tc.assert(&fileProfileManager{root: t.TempDir(), abi: tc.abi}, sp)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package landlockprofile
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/security-profiles-operator/api/landlockprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+)
+
+// Setup adds a controller that reconciles Landlock profiles.
+func (r *Reconciler) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.client = mgr.GetClient()
+	r.log = ctrl.Log.WithName(r.Name())
+	r.record = mgr.GetEventRecorderFor("landlockprofile")
+	r.manager = NewProfileManager(config.LandlockProfilesRootPath())
+	r.dryRun = common.DryRun()
+
+	if abi, err := r.manager.ABI(); err != nil {
+		r.log.Error(err, "landlock not supported on node")
+	} else {
+		r.log.Info("Detected landlock", "abi", abi)
+	}
+
+	b := common.WatchNodeResync(
+		ctrl.NewControllerManagedBy(mgr), r.client, r.record, r.log, "LandlockProfile",
+		func() client.ObjectList { return &v1alpha1.LandlockProfileList{} },
+	)
+	return b.Named("landlockprofile").
+		For(&v1alpha1.LandlockProfile{}).
+		Complete(r)
+}
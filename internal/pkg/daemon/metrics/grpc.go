@@ -23,6 +23,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
@@ -95,27 +96,66 @@ func (m *Metrics) AuditInc(
 			return fmt.Errorf("record syscalls: %w", err)
 		}
 
-		if r.GetSeccompReq() != nil {
-			m.IncSeccompProfileAudit(
-				r.GetNode(),
-				r.GetNamespace(),
-				r.GetPod(),
-				r.GetContainer(),
-				r.GetExecutable(),
-				r.GetSeccompReq().GetSyscall(),
-			)
-		} else if r.GetSelinuxReq() != nil {
-			m.IncSelinuxProfileAudit(
+		m.audit(r)
+	}
+}
+
+// AuditBatchInc updates the metrics for the audit counter from batches of
+// audit requests.
+func (m *Metrics) AuditBatchInc(
+	stream api.Metrics_AuditBatchIncServer,
+) error {
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&api.EmptyResponse{})
+		}
+		if err != nil {
+			return fmt.Errorf("record syscall batch: %w", err)
+		}
+
+		for _, req := range r.GetRequests() {
+			m.audit(req)
+		}
+	}
+}
+
+func (m *Metrics) audit(r *api.AuditRequest) {
+	if r.GetSeccompReq() != nil {
+		m.IncSeccompProfileAudit(
+			r.GetNode(),
+			r.GetNamespace(),
+			r.GetPod(),
+			r.GetContainer(),
+			r.GetExecutable(),
+			r.GetSeccompReq().GetSyscall(),
+		)
+	} else if r.GetSelinuxReq() != nil {
+		m.IncSelinuxProfileAudit(
+			r.GetNode(),
+			r.GetNamespace(),
+			r.GetPod(),
+			r.GetContainer(),
+			r.GetExecutable(),
+			r.GetSelinuxReq().GetScontext(),
+			r.GetSelinuxReq().GetTcontext(),
+		)
+		// An AVC can deny multiple permissions at once, counting them
+		// separately keeps the perm label bounded to the single permissions.
+		for _, perm := range strings.Fields(r.GetSelinuxReq().GetPerm()) {
+			m.IncSelinuxAvcDenial(
 				r.GetNode(),
 				r.GetNamespace(),
 				r.GetPod(),
-				r.GetContainer(),
-				r.GetExecutable(),
-				r.GetSelinuxReq().GetScontext(),
-				r.GetSelinuxReq().GetTcontext(),
+				r.GetSelinuxReq().GetTclass(),
+				perm,
 			)
 		}
 	}
+
+	if r.GetLagSeconds() > 0 {
+		m.ObserveEnricherLag(r.GetNode(), r.GetLagSeconds())
+	}
 }
 
 // BpfInc updates the metrics for the bpf counter.
@@ -136,3 +176,50 @@ func (m *Metrics) BpfInc(stream api.Metrics_BpfIncServer) error {
 		)
 	}
 }
+
+// ContainerIDResolutionInc updates the metrics for the container ID
+// resolution counter.
+func (m *Metrics) ContainerIDResolutionInc(stream api.Metrics_ContainerIDResolutionIncServer) error {
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&api.EmptyResponse{})
+		}
+		if err != nil {
+			return fmt.Errorf("record container ID resolution metrics: %w", err)
+		}
+
+		m.IncContainerIDResolution(r.GetNode(), r.GetResolver())
+	}
+}
+
+// EnricherEvictionInc updates the metrics for the enricher eviction counter.
+func (m *Metrics) EnricherEvictionInc(stream api.Metrics_EnricherEvictionIncServer) error {
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&api.EmptyResponse{})
+		}
+		if err != nil {
+			return fmt.Errorf("record enricher eviction metrics: %w", err)
+		}
+
+		m.IncEnricherEviction(r.GetNode(), r.GetKind())
+	}
+}
+
+// SyscallStreamDropInc updates the metrics for the dropped syscall stream
+// events counter.
+func (m *Metrics) SyscallStreamDropInc(stream api.Metrics_SyscallStreamDropIncServer) error {
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&api.EmptyResponse{})
+		}
+		if err != nil {
+			return fmt.Errorf("record syscall stream drop metrics: %w", err)
+		}
+
+		m.AddSyscallStreamDrops(r.GetNode(), r.GetCount())
+	}
+}
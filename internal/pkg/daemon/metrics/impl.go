@@ -18,6 +18,7 @@ package metrics
 
 import (
 	"net/http"
+	"os"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -31,6 +32,7 @@ type defaultImpl struct{}
 type impl interface {
 	Register(c prometheus.Collector) error
 	ListenAndServe(addr string, handler http.Handler) error
+	Getenv(key string) string
 }
 
 func (d *defaultImpl) Register(c prometheus.Collector) error {
@@ -45,3 +47,7 @@ func (d *defaultImpl) ListenAndServe(addr string, handler http.Handler) error {
 	}
 	return server.ListenAndServe()
 }
+
+func (d *defaultImpl) Getenv(key string) string {
+	return os.Getenv(key)
+}
@@ -19,6 +19,7 @@ package metrics
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/go-logr/logr"
 	"github.com/prometheus/client_golang/prometheus"
@@ -26,6 +27,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	api "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
 // Metrics proxy required permissions
@@ -33,19 +35,41 @@ import (
 // +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 
 const (
-	metricNamespace = "security_profiles_operator"
+	metricNamespace       = "spo"
+	legacyMetricNamespace = "security_profiles_operator"
 
 	// Metrics names.
-	metricNameSeccompProfile       = "seccomp_profile_total"
-	metricNameSelinuxProfile       = "selinux_profile_total"
-	metricNameAppArmorProfile      = "apparmor_profile_total"
-	metricNameSeccompProfileAudit  = "seccomp_profile_audit_total"
-	metricNameSelinuxProfileAudit  = "selinux_profile_audit_total"
-	metricNameAppArmorProfileAudit = "apparmor_profile_audit_total"
-	metricNameSeccompProfileBpf    = "seccomp_profile_bpf_total"
-	metricNameSeccompProfileError  = "seccomp_profile_error_total"
-	metricNameSelinuxProfileError  = "selinux_profile_error_total"
-	metricNameAppArmorProfileError = "apparmor_profile_error_total"
+	metricNameSeccompProfile        = "seccomp_profile_operations_total"
+	metricNameSelinuxProfile        = "selinux_profile_operations_total"
+	metricNameAppArmorProfile       = "apparmor_profile_operations_total"
+	metricNameSeccompProfileAudit   = "seccomp_denials_total"
+	metricNameSelinuxProfileAudit   = "selinux_denials_total"
+	metricNameSelinuxAvcDenial      = "selinux_avc_denials_total"
+	metricNameAppArmorProfileAudit  = "apparmor_denials_total"
+	metricNameSeccompProfileBpf     = "seccomp_bpf_events_total"
+	metricNameSeccompProfileError   = "seccomp_profile_errors_total"
+	metricNameSelinuxProfileError   = "selinux_profile_errors_total"
+	metricNameAppArmorProfileError  = "apparmor_profile_errors_total"
+	metricNameContainerIDResolution = "container_id_resolutions_total"
+	metricNameEnricherLag           = "enricher_lag_seconds"
+	metricNameSeccompProfileInfo    = "seccomp_profile_info"
+	metricNameRecordingIgnored      = "recording_annotations_ignored_total"
+	metricNameEnricherEviction      = "enricher_evictions_total"
+	metricNameSyscallStreamDrop     = "enricher_syscall_stream_drops_total"
+
+	// Legacy metrics names, which are exported additionally if enabled.
+	// Metrics introduced after the rename use noLegacyMetricName.
+	noLegacyMetricName                   = ""
+	legacyMetricNameSeccompProfile       = "seccomp_profile_total"
+	legacyMetricNameSelinuxProfile       = "selinux_profile_total"
+	legacyMetricNameAppArmorProfile      = "apparmor_profile_total"
+	legacyMetricNameSeccompProfileAudit  = "seccomp_profile_audit_total"
+	legacyMetricNameSelinuxProfileAudit  = "selinux_profile_audit_total"
+	legacyMetricNameAppArmorProfileAudit = "apparmor_profile_audit_total"
+	legacyMetricNameSeccompProfileBpf    = "seccomp_profile_bpf_total"
+	legacyMetricNameSeccompProfileError  = "seccomp_profile_error_total"
+	legacyMetricNameSelinuxProfileError  = "selinux_profile_error_total"
+	legacyMetricNameAppArmorProfileError = "apparmor_profile_error_total"
 
 	// Metrics label values.
 	metricLabelValueProfileUpdate = "update"
@@ -63,7 +87,14 @@ const (
 	metricsLabelProfile        = "profile"
 	metricsLabelScontext       = "scontext"
 	metricsLabelTcontext       = "tcontext"
+	metricsLabelTclass         = "tclass"
+	metricsLabelPerm           = "perm"
 	metricsLabelMountNamespace = "mount_namespace"
+	metricsLabelResolver       = "resolver"
+	metricsLabelOwner          = "owner"
+	metricsLabelTicket         = "ticket"
+	metricsLabelExpiry         = "expiry"
+	metricsLabelKind           = "kind"
 
 	// HandlerPath is the default path for serving metrics.
 	HandlerPath = "/metrics-spod"
@@ -72,18 +103,25 @@ const (
 // Metrics is the main structure of this package.
 type Metrics struct {
 	api.UnimplementedMetricsServer
-	impl                       impl
-	log                        logr.Logger
-	metricSeccompProfile       *prometheus.CounterVec
-	metricSeccompProfileAudit  *prometheus.CounterVec
-	metricSeccompProfileBpf    *prometheus.CounterVec
-	metricSeccompProfileError  *prometheus.CounterVec
-	metricSelinuxProfile       *prometheus.CounterVec
-	metricSelinuxProfileAudit  *prometheus.CounterVec
-	metricSelinuxProfileError  *prometheus.CounterVec
-	metricAppArmorProfile      *prometheus.CounterVec
-	metricAppArmorProfileAudit *prometheus.CounterVec
-	metricAppArmorProfileError *prometheus.CounterVec
+	impl                        impl
+	log                         logr.Logger
+	metricSeccompProfile        *counterVec
+	metricSeccompProfileAudit   *counterVec
+	metricSeccompProfileBpf     *counterVec
+	metricSeccompProfileError   *counterVec
+	metricSelinuxProfile        *counterVec
+	metricSelinuxProfileAudit   *counterVec
+	metricSelinuxAvcDenial      *counterVec
+	metricSelinuxProfileError   *counterVec
+	metricAppArmorProfile       *counterVec
+	metricAppArmorProfileAudit  *counterVec
+	metricAppArmorProfileError  *counterVec
+	metricContainerIDResolution *counterVec
+	metricEnricherLag           *histogramVec
+	metricSeccompProfileInfo    *gaugeVec
+	metricRecordingIgnored      *counterVec
+	metricEnricherEviction      *counterVec
+	metricSyscallStreamDrop     *counterVec
 }
 
 // New returns a new Metrics instance.
@@ -91,20 +129,16 @@ func New() *Metrics {
 	return &Metrics{
 		impl: &defaultImpl{},
 		log:  ctrl.Log.WithName("metrics"),
-		metricSeccompProfile: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameSeccompProfile,
-				Namespace: metricNamespace,
-				Help:      "Counter about seccomp profile operations.",
-			},
+		metricSeccompProfile: newCounterVec(
+			metricNameSeccompProfile,
+			legacyMetricNameSeccompProfile,
+			"Counter about seccomp profile operations.",
 			[]string{metricLabelOperation},
 		),
-		metricSeccompProfileAudit: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameSeccompProfileAudit,
-				Namespace: metricNamespace,
-				Help:      "Counter about seccomp profile audits, requires the log enricher to be enabled.",
-			},
+		metricSeccompProfileAudit: newCounterVec(
+			metricNameSeccompProfileAudit,
+			legacyMetricNameSeccompProfileAudit,
+			"Counter about seccomp profile audits, requires the log enricher to be enabled.",
 			[]string{
 				metricsLabelNode,
 				metricsLabelNamespace,
@@ -114,40 +148,32 @@ func New() *Metrics {
 				metricsLabelSyscall,
 			},
 		),
-		metricSeccompProfileBpf: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameSeccompProfileBpf,
-				Namespace: metricNamespace,
-				Help:      "Counter about seccomp profile bpf events, requires the bpf recorder to be enabled.",
-			},
+		metricSeccompProfileBpf: newCounterVec(
+			metricNameSeccompProfileBpf,
+			legacyMetricNameSeccompProfileBpf,
+			"Counter about seccomp profile bpf events, requires the bpf recorder to be enabled.",
 			[]string{
 				metricsLabelNode,
 				metricsLabelMountNamespace,
 				metricsLabelProfile,
 			},
 		),
-		metricSeccompProfileError: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameSeccompProfileError,
-				Namespace: metricNamespace,
-				Help:      "Counter about seccomp profile errors.",
-			},
+		metricSeccompProfileError: newCounterVec(
+			metricNameSeccompProfileError,
+			legacyMetricNameSeccompProfileError,
+			"Counter about seccomp profile errors.",
 			[]string{metricsLabelReason},
 		),
-		metricSelinuxProfile: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameSelinuxProfile,
-				Namespace: metricNamespace,
-				Help:      "Counter about selinux profile operations.",
-			},
+		metricSelinuxProfile: newCounterVec(
+			metricNameSelinuxProfile,
+			legacyMetricNameSelinuxProfile,
+			"Counter about selinux profile operations.",
 			[]string{metricLabelOperation},
 		),
-		metricSelinuxProfileAudit: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameSelinuxProfileAudit,
-				Namespace: metricNamespace,
-				Help:      "Counter about selinux profile audits, requires the log enricher to be enabled.",
-			},
+		metricSelinuxProfileAudit: newCounterVec(
+			metricNameSelinuxProfileAudit,
+			legacyMetricNameSelinuxProfileAudit,
+			"Counter about selinux profile audits, requires the log enricher to be enabled.",
 			[]string{
 				metricsLabelNode,
 				metricsLabelNamespace,
@@ -158,28 +184,35 @@ func New() *Metrics {
 				metricsLabelTcontext,
 			},
 		),
-		metricSelinuxProfileError: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameSelinuxProfileError,
-				Namespace: metricNamespace,
-				Help:      "Counter about selinux profile errors.",
+		metricSelinuxAvcDenial: newCounterVec(
+			metricNameSelinuxAvcDenial,
+			noLegacyMetricName,
+			"Counter about selinux AVC denials per target class and permission, "+
+				"requires the log enricher to be enabled.",
+			[]string{
+				metricsLabelNode,
+				metricsLabelNamespace,
+				metricsLabelPod,
+				metricsLabelTclass,
+				metricsLabelPerm,
 			},
+		),
+		metricSelinuxProfileError: newCounterVec(
+			metricNameSelinuxProfileError,
+			legacyMetricNameSelinuxProfileError,
+			"Counter about selinux profile errors.",
 			[]string{metricsLabelReason},
 		),
-		metricAppArmorProfile: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameAppArmorProfile,
-				Namespace: metricNamespace,
-				Help:      "Counter about apparmor profile operations.",
-			},
+		metricAppArmorProfile: newCounterVec(
+			metricNameAppArmorProfile,
+			legacyMetricNameAppArmorProfile,
+			"Counter about apparmor profile operations.",
 			[]string{metricLabelOperation},
 		),
-		metricAppArmorProfileAudit: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameAppArmorProfileAudit,
-				Namespace: metricNamespace,
-				Help:      "Counter about apparmor profile audits, requires the log enricher to be enabled.",
-			},
+		metricAppArmorProfileAudit: newCounterVec(
+			metricNameAppArmorProfileAudit,
+			legacyMetricNameAppArmorProfileAudit,
+			"Counter about apparmor profile audits, requires the log enricher to be enabled.",
 			[]string{
 				metricsLabelNode,
 				metricsLabelNamespace,
@@ -189,35 +222,105 @@ func New() *Metrics {
 				metricsLabelSyscall,
 			},
 		),
-		metricAppArmorProfileError: prometheus.NewCounterVec(
-			prometheus.CounterOpts{
-				Name:      metricNameAppArmorProfileError,
-				Namespace: metricNamespace,
-				Help:      "Counter about apparmor profile errors.",
-			},
+		metricAppArmorProfileError: newCounterVec(
+			metricNameAppArmorProfileError,
+			legacyMetricNameAppArmorProfileError,
+			"Counter about apparmor profile errors.",
 			[]string{metricsLabelReason},
 		),
+		metricContainerIDResolution: newCounterVec(
+			metricNameContainerIDResolution,
+			noLegacyMetricName,
+			"Counter about container ID resolutions per resolver, requires the log enricher to be enabled.",
+			[]string{
+				metricsLabelNode,
+				metricsLabelResolver,
+			},
+		),
+		metricEnricherLag: newHistogramVec(
+			metricNameEnricherLag,
+			noLegacyMetricName,
+			"Histogram about the time between an audit event and its processing "+
+				"by the log enricher, requires the log enricher to be enabled.",
+			[]float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+			[]string{metricsLabelNode},
+		),
+		metricSeccompProfileInfo: newGaugeVec(
+			metricNameSeccompProfileInfo,
+			noLegacyMetricName,
+			"Information about the metadata of installed seccomp profiles, always 1.",
+			[]string{
+				metricsLabelNamespace,
+				metricsLabelProfile,
+				metricsLabelOwner,
+				metricsLabelTicket,
+				metricsLabelExpiry,
+			},
+		),
+		metricRecordingIgnored: newCounterVec(
+			metricNameRecordingIgnored,
+			noLegacyMetricName,
+			"Counter about pods whose recording annotations got ignored by the profile recorder.",
+			[]string{metricsLabelNamespace, metricsLabelReason},
+		),
+		metricEnricherEviction: newCounterVec(
+			metricNameEnricherEviction,
+			noLegacyMetricName,
+			"Counter about recorded data of orphaned recordings evicted by the log enricher "+
+				"after the retention window.",
+			[]string{metricsLabelNode, metricsLabelKind},
+		),
+		metricSyscallStreamDrop: newCounterVec(
+			metricNameSyscallStreamDrop,
+			noLegacyMetricName,
+			"Counter about syscall events dropped by the log enricher for streaming clients "+
+				"which are not able to keep up.",
+			[]string{metricsLabelNode},
+		),
 	}
 }
 
-// Register iterates over all available metrics and registers them.
+// Register iterates over all available metrics and registers them. The
+// metrics are additionally registered with their legacy names if enabled and
+// if they have one.
 func (m *Metrics) Register() error {
-	for name, collector := range map[string]prometheus.Collector{
-		metricNameSeccompProfile:       m.metricSeccompProfile,
-		metricNameSeccompProfileAudit:  m.metricSeccompProfileAudit,
-		metricNameSeccompProfileBpf:    m.metricSeccompProfileBpf,
-		metricNameSeccompProfileError:  m.metricSeccompProfileError,
-		metricNameSelinuxProfile:       m.metricSelinuxProfile,
-		metricNameSelinuxProfileAudit:  m.metricSelinuxProfileAudit,
-		metricNameSelinuxProfileError:  m.metricSelinuxProfileError,
-		metricNameAppArmorProfile:      m.metricAppArmorProfile,
-		metricNameAppArmorProfileAudit: m.metricAppArmorProfileAudit,
-		metricNameAppArmorProfileError: m.metricAppArmorProfileError,
+	legacy, err := strconv.ParseBool(m.impl.Getenv(config.LegacyMetricNamesEnvKey))
+	if err != nil {
+		legacy = false
+	}
+
+	for name, collector := range map[string]legacyCollector{
+		metricNameSeccompProfile:        m.metricSeccompProfile,
+		metricNameSeccompProfileAudit:   m.metricSeccompProfileAudit,
+		metricNameSeccompProfileBpf:     m.metricSeccompProfileBpf,
+		metricNameSeccompProfileError:   m.metricSeccompProfileError,
+		metricNameSelinuxProfile:        m.metricSelinuxProfile,
+		metricNameSelinuxProfileAudit:   m.metricSelinuxProfileAudit,
+		metricNameSelinuxAvcDenial:      m.metricSelinuxAvcDenial,
+		metricNameSelinuxProfileError:   m.metricSelinuxProfileError,
+		metricNameAppArmorProfile:       m.metricAppArmorProfile,
+		metricNameAppArmorProfileAudit:  m.metricAppArmorProfileAudit,
+		metricNameAppArmorProfileError:  m.metricAppArmorProfileError,
+		metricNameContainerIDResolution: m.metricContainerIDResolution,
+		metricNameEnricherLag:           m.metricEnricherLag,
+		metricNameSeccompProfileInfo:    m.metricSeccompProfileInfo,
+		metricNameRecordingIgnored:      m.metricRecordingIgnored,
+		metricNameEnricherEviction:      m.metricEnricherEviction,
+		metricNameSyscallStreamDrop:     m.metricSyscallStreamDrop,
 	} {
 		m.log.Info(fmt.Sprintf("Registering metric: %s", name))
-		if err := m.impl.Register(collector); err != nil {
+		if err := m.impl.Register(collector.collector()); err != nil {
 			return fmt.Errorf("register collector for %s metric: %w", name, err)
 		}
+
+		if !legacy || collector.legacyCollector() == nil {
+			continue
+		}
+		m.log.Info(fmt.Sprintf("Registering legacy metric for: %s", name))
+		if err := m.impl.Register(collector.legacyCollector()); err != nil {
+			return fmt.Errorf("register legacy collector for %s metric: %w", name, err)
+		}
+		collector.enableLegacy()
 	}
 	return nil
 }
@@ -231,14 +334,12 @@ func (m *Metrics) Handler() http.Handler {
 
 // IncSeccompProfileUpdate increments the seccomp profile update counter.
 func (m *Metrics) IncSeccompProfileUpdate() {
-	m.metricSeccompProfile.
-		WithLabelValues(metricLabelValueProfileUpdate).Inc()
+	m.metricSeccompProfile.inc(metricLabelValueProfileUpdate)
 }
 
 // IncSeccompProfileDelete increments the seccomp profile deletion counter.
 func (m *Metrics) IncSeccompProfileDelete() {
-	m.metricSeccompProfile.
-		WithLabelValues(metricLabelValueProfileDelete).Inc()
+	m.metricSeccompProfile.inc(metricLabelValueProfileDelete)
 }
 
 // IncSeccompProfileAudit increments the seccomp profile audit counter for the
@@ -246,9 +347,9 @@ func (m *Metrics) IncSeccompProfileDelete() {
 func (m *Metrics) IncSeccompProfileAudit(
 	node, namespace, pod, container, executable, syscall string,
 ) {
-	m.metricSeccompProfileAudit.WithLabelValues(
+	m.metricSeccompProfileAudit.inc(
 		node, namespace, pod, container, executable, syscall,
-	).Inc()
+	)
 }
 
 // IncSeccompProfileBpf increments the seccomp profile bpf counter for the
@@ -256,27 +357,71 @@ func (m *Metrics) IncSeccompProfileAudit(
 func (m *Metrics) IncSeccompProfileBpf(
 	node, profile string, mountNamespace uint32,
 ) {
-	m.metricSeccompProfileBpf.WithLabelValues(
+	m.metricSeccompProfileBpf.inc(
 		node, fmt.Sprint(mountNamespace), profile,
-	).Inc()
+	)
+}
+
+// IncContainerIDResolution increments the container ID resolution counter
+// for the provided node and resolver.
+func (m *Metrics) IncContainerIDResolution(node, resolver string) {
+	m.metricContainerIDResolution.inc(node, resolver)
+}
+
+// IncEnricherEviction increments the enricher eviction counter for the
+// provided node and kind of recorded data.
+func (m *Metrics) IncEnricherEviction(node, kind string) {
+	m.metricEnricherEviction.inc(node, kind)
+}
+
+// AddSyscallStreamDrops adds the amount of dropped syscall stream events to
+// the counter of the provided node.
+func (m *Metrics) AddSyscallStreamDrops(node string, count uint64) {
+	m.metricSyscallStreamDrop.add(float64(count), node)
+}
+
+// ObserveEnricherLag records the time in seconds between an audit event and
+// its processing by the log enricher for the provided node.
+func (m *Metrics) ObserveEnricherLag(node string, seconds float64) {
+	m.metricEnricherLag.observe(seconds, node)
 }
 
 // IncSeccompProfileError increments the seccomp profile error counter for the
 // provided reason.
 func (m *Metrics) IncSeccompProfileError(reason string) {
-	m.metricSeccompProfileError.WithLabelValues(reason).Inc()
+	m.metricSeccompProfileError.inc(reason)
+}
+
+// SetSeccompProfileInfo exposes the metadata of the seccomp profile with the
+// provided namespace and name, replacing its previously exposed metadata.
+func (m *Metrics) SetSeccompProfileInfo(namespace, profile, owner, ticket, expiry string) {
+	m.DeleteSeccompProfileInfo(namespace, profile)
+	m.metricSeccompProfileInfo.set(1, namespace, profile, owner, ticket, expiry)
+}
+
+// DeleteSeccompProfileInfo removes the metadata of the seccomp profile with
+// the provided namespace and name.
+func (m *Metrics) DeleteSeccompProfileInfo(namespace, profile string) {
+	m.metricSeccompProfileInfo.deletePartialMatch(prometheus.Labels{
+		metricsLabelNamespace: namespace,
+		metricsLabelProfile:   profile,
+	})
+}
+
+// IncRecordingIgnored increments the counter of pods whose recording
+// annotations got ignored for the provided namespace and reason.
+func (m *Metrics) IncRecordingIgnored(namespace, reason string) {
+	m.metricRecordingIgnored.inc(namespace, reason)
 }
 
 // IncSelinuxProfileUpdate increments the selinux profile update counter.
 func (m *Metrics) IncSelinuxProfileUpdate() {
-	m.metricSelinuxProfile.
-		WithLabelValues(metricLabelValueProfileUpdate).Inc()
+	m.metricSelinuxProfile.inc(metricLabelValueProfileUpdate)
 }
 
 // IncSelinuxProfileDelete increments the selinux profile deletion counter.
 func (m *Metrics) IncSelinuxProfileDelete() {
-	m.metricSelinuxProfile.
-		WithLabelValues(metricLabelValueProfileDelete).Inc()
+	m.metricSelinuxProfile.inc(metricLabelValueProfileDelete)
 }
 
 // IncSelinuxProfileAudit increments the selinux profile audit counter for the
@@ -284,27 +429,33 @@ func (m *Metrics) IncSelinuxProfileDelete() {
 func (m *Metrics) IncSelinuxProfileAudit(
 	node, namespace, pod, container, executable, scontext, tcontext string,
 ) {
-	m.metricSelinuxProfileAudit.WithLabelValues(
+	m.metricSelinuxProfileAudit.inc(
 		node, namespace, pod, container, executable, scontext, tcontext,
-	).Inc()
+	)
+}
+
+// IncSelinuxAvcDenial increments the selinux AVC denial counter for the
+// provided labels.
+func (m *Metrics) IncSelinuxAvcDenial(
+	node, namespace, pod, tclass, perm string,
+) {
+	m.metricSelinuxAvcDenial.inc(node, namespace, pod, tclass, perm)
 }
 
 // IncSelinuxProfileError increments the selinux profile error counter for the
 // provided reason.
 func (m *Metrics) IncSelinuxProfileError(reason string) {
-	m.metricSelinuxProfileError.WithLabelValues(reason).Inc()
+	m.metricSelinuxProfileError.inc(reason)
 }
 
 // IncAppArmorProfileUpdate increments the apparmor profile update counter.
 func (m *Metrics) IncAppArmorProfileUpdate() {
-	m.metricAppArmorProfile.
-		WithLabelValues(metricLabelValueProfileUpdate).Inc()
+	m.metricAppArmorProfile.inc(metricLabelValueProfileUpdate)
 }
 
 // IncAppArmorProfileDelete increments the apparmor profile deletion counter.
 func (m *Metrics) IncAppArmorProfileDelete() {
-	m.metricAppArmorProfile.
-		WithLabelValues(metricLabelValueProfileDelete).Inc()
+	m.metricAppArmorProfile.inc(metricLabelValueProfileDelete)
 }
 
 // IncAppArmorProfileAudit increments the apparmor profile audit counter for the
@@ -312,13 +463,13 @@ func (m *Metrics) IncAppArmorProfileDelete() {
 func (m *Metrics) IncAppArmorProfileAudit(
 	node, namespace, pod, container, executable, syscall string,
 ) {
-	m.metricAppArmorProfileAudit.WithLabelValues(
+	m.metricAppArmorProfileAudit.inc(
 		node, namespace, pod, container, executable, syscall,
-	).Inc()
+	)
 }
 
 // IncAppArmorProfileError increments the apparmor profile error counter for the
 // provided reason.
 func (m *Metrics) IncAppArmorProfileError(reason string) {
-	m.metricAppArmorProfileError.WithLabelValues(reason).Inc()
+	m.metricAppArmorProfileError.inc(reason)
 }
@@ -17,14 +17,19 @@ limitations under the License.
 package metrics
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics/metricsfakes"
 )
 
@@ -221,3 +226,314 @@ func TestSeccompProfileBpf(t *testing.T) {
 		tc.then(sut)
 	}
 }
+
+func TestContainerIDResolution(t *testing.T) {
+	t.Parallel()
+
+	const (
+		node     = "node"
+		resolver = "crio"
+	)
+
+	getMetricValue := func(col prometheus.Collector) int {
+		c := make(chan prometheus.Metric, 1)
+		col.Collect(c)
+		m := dto.Metric{}
+		err := (<-c).Write(&m)
+		require.Nil(t, err)
+		return int(*m.Counter.Value)
+	}
+
+	for _, tc := range []struct {
+		when func(m *Metrics)
+		then func(m *Metrics)
+	}{
+		{ // single update
+			when: func(m *Metrics) {
+				m.IncContainerIDResolution(node, resolver)
+			},
+			then: func(m *Metrics) {
+				ctr, err := m.metricContainerIDResolution.GetMetricWithLabelValues(node, resolver)
+				require.Nil(t, err)
+				require.Equal(t, 1, getMetricValue(ctr))
+			},
+		},
+		{ // multiple update
+			when: func(m *Metrics) {
+				m.IncContainerIDResolution(node, resolver)
+				m.IncContainerIDResolution(node, resolver)
+				m.IncContainerIDResolution(node, "cgroupfs")
+			},
+			then: func(m *Metrics) {
+				ctr, err := m.metricContainerIDResolution.GetMetricWithLabelValues(node, resolver)
+				require.Nil(t, err)
+				require.Equal(t, 2, getMetricValue(ctr))
+				ctr, err = m.metricContainerIDResolution.GetMetricWithLabelValues(node, "cgroupfs")
+				require.Nil(t, err)
+				require.Equal(t, 1, getMetricValue(ctr))
+			},
+		},
+	} {
+		mock := &metricsfakes.FakeImpl{}
+		sut := New()
+		sut.impl = mock
+
+		tc.when(sut)
+		tc.then(sut)
+	}
+}
+
+func TestEnricherEviction(t *testing.T) {
+	t.Parallel()
+
+	const node = "node"
+
+	getMetricValue := func(col prometheus.Collector) int {
+		c := make(chan prometheus.Metric, 1)
+		col.Collect(c)
+		m := dto.Metric{}
+		err := (<-c).Write(&m)
+		require.Nil(t, err)
+		return int(*m.Counter.Value)
+	}
+
+	sut := New()
+	sut.impl = &metricsfakes.FakeImpl{}
+
+	sut.IncEnricherEviction(node, "syscalls")
+	sut.IncEnricherEviction(node, "syscalls")
+	sut.IncEnricherEviction(node, "avcs")
+
+	ctr, err := sut.metricEnricherEviction.GetMetricWithLabelValues(node, "syscalls")
+	require.Nil(t, err)
+	require.Equal(t, 2, getMetricValue(ctr))
+	ctr, err = sut.metricEnricherEviction.GetMetricWithLabelValues(node, "avcs")
+	require.Nil(t, err)
+	require.Equal(t, 1, getMetricValue(ctr))
+}
+
+type fakeAuditBatchStream struct {
+	grpc.ServerStream
+	batches []*api.AuditBatchRequest
+}
+
+func (f *fakeAuditBatchStream) Recv() (*api.AuditBatchRequest, error) {
+	if len(f.batches) == 0 {
+		return nil, io.EOF
+	}
+	batch := f.batches[0]
+	f.batches = f.batches[1:]
+	return batch, nil
+}
+
+func (f *fakeAuditBatchStream) SendAndClose(*api.EmptyResponse) error {
+	return nil
+}
+
+func (f *fakeAuditBatchStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestAuditBatchInc(t *testing.T) {
+	t.Parallel()
+
+	const node = "node"
+
+	getMetricValue := func(col prometheus.Collector) int {
+		c := make(chan prometheus.Metric, 1)
+		col.Collect(c)
+		m := dto.Metric{}
+		err := (<-c).Write(&m)
+		require.Nil(t, err)
+		return int(*m.Counter.Value)
+	}
+
+	seccomp := &api.AuditRequest{
+		Node:       node,
+		Namespace:  "ns",
+		Pod:        "pod",
+		Container:  "ctr",
+		Executable: "/bin/ls",
+		SeccompReq: &api.AuditRequest_SeccompAuditReq{Syscall: "read"},
+	}
+	selinux := &api.AuditRequest{
+		Node:       node,
+		Namespace:  "ns",
+		Pod:        "pod",
+		Container:  "ctr",
+		Executable: "/bin/ls",
+		SelinuxReq: &api.AuditRequest_SelinuxAuditReq{
+			Scontext: "scontext", Tcontext: "tcontext", Tclass: "file", Perm: "read write",
+		},
+	}
+
+	sut := New()
+	sut.impl = &metricsfakes.FakeImpl{}
+
+	require.Nil(t, sut.AuditBatchInc(&fakeAuditBatchStream{
+		batches: []*api.AuditBatchRequest{
+			{Requests: []*api.AuditRequest{seccomp, seccomp, selinux}},
+			{Requests: []*api.AuditRequest{seccomp}},
+		},
+	}))
+
+	ctr, err := sut.metricSeccompProfileAudit.GetMetricWithLabelValues(node, "ns", "pod", "ctr", "/bin/ls", "read")
+	require.Nil(t, err)
+	require.Equal(t, 3, getMetricValue(ctr))
+
+	ctr, err = sut.metricSelinuxProfileAudit.GetMetricWithLabelValues(
+		node, "ns", "pod", "ctr", "/bin/ls", "scontext", "tcontext",
+	)
+	require.Nil(t, err)
+	require.Equal(t, 1, getMetricValue(ctr))
+
+	ctr, err = sut.metricSelinuxAvcDenial.GetMetricWithLabelValues(node, "ns", "pod", "file", "read")
+	require.Nil(t, err)
+	require.Equal(t, 1, getMetricValue(ctr))
+
+	ctr, err = sut.metricSelinuxAvcDenial.GetMetricWithLabelValues(node, "ns", "pod", "file", "write")
+	require.Nil(t, err)
+	require.Equal(t, 1, getMetricValue(ctr))
+}
+
+func TestEnricherLag(t *testing.T) {
+	t.Parallel()
+
+	const node = "node"
+
+	getSampleCount := func(col prometheus.Collector) uint64 {
+		c := make(chan prometheus.Metric, 1)
+		col.Collect(c)
+		m := dto.Metric{}
+		err := (<-c).Write(&m)
+		require.Nil(t, err)
+		return m.Histogram.GetSampleCount()
+	}
+
+	sut := New()
+	sut.impl = &metricsfakes.FakeImpl{}
+
+	sut.ObserveEnricherLag(node, 0.2)
+	sut.ObserveEnricherLag(node, 42)
+
+	obs, err := sut.metricEnricherLag.GetMetricWithLabelValues(node)
+	require.Nil(t, err)
+	col, ok := obs.(prometheus.Collector)
+	require.True(t, ok)
+	require.EqualValues(t, 2, getSampleCount(col))
+}
+
+func TestSeccompProfileInfo(t *testing.T) {
+	t.Parallel()
+
+	sut := New()
+	sut.impl = &metricsfakes.FakeImpl{}
+
+	sut.SetSeccompProfileInfo("ns", "profile", "team-a", "SEC-1", "")
+	sut.SetSeccompProfileInfo("ns", "profile", "team-b", "SEC-2", "2024-01-01")
+	sut.SetSeccompProfileInfo("ns", "other", "team-a", "", "")
+	require.Equal(t, 2, testutil.CollectAndCount(sut.metricSeccompProfileInfo))
+
+	gauge, err := sut.metricSeccompProfileInfo.GetMetricWithLabelValues(
+		"ns", "profile", "team-b", "SEC-2", "2024-01-01",
+	)
+	require.Nil(t, err)
+	m := dto.Metric{}
+	require.Nil(t, gauge.Write(&m))
+	require.EqualValues(t, 1, m.Gauge.GetValue())
+
+	sut.DeleteSeccompProfileInfo("ns", "profile")
+	require.Equal(t, 1, testutil.CollectAndCount(sut.metricSeccompProfileInfo))
+}
+
+func TestRecordingIgnored(t *testing.T) {
+	t.Parallel()
+
+	sut := New()
+	sut.impl = &metricsfakes.FakeImpl{}
+
+	sut.IncRecordingIgnored("ns", "MalformedAnnotation")
+	sut.IncRecordingIgnored("ns", "MalformedAnnotation")
+	sut.IncRecordingIgnored("other", "RecorderNotAllowed")
+	require.Equal(t, 2, testutil.CollectAndCount(sut.metricRecordingIgnored))
+	require.EqualValues(t, 2, testutil.ToFloat64(
+		sut.metricRecordingIgnored.WithLabelValues("ns", "MalformedAnnotation"),
+	))
+}
+
+type fakeSyscallStreamDropStream struct {
+	grpc.ServerStream
+	requests []*api.SyscallStreamDropRequest
+}
+
+func (f *fakeSyscallStreamDropStream) Recv() (*api.SyscallStreamDropRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	r := f.requests[0]
+	f.requests = f.requests[1:]
+	return r, nil
+}
+
+func (f *fakeSyscallStreamDropStream) SendAndClose(*api.EmptyResponse) error {
+	return nil
+}
+
+func TestSyscallStreamDropInc(t *testing.T) {
+	t.Parallel()
+
+	sut := New()
+	sut.impl = &metricsfakes.FakeImpl{}
+
+	require.Nil(t, sut.SyscallStreamDropInc(&fakeSyscallStreamDropStream{
+		requests: []*api.SyscallStreamDropRequest{
+			{Node: "node", Count: 3},
+			{Node: "node", Count: 2},
+			{Node: "other", Count: 1},
+		},
+	}))
+	require.EqualValues(t, 5, testutil.ToFloat64(sut.metricSyscallStreamDrop.WithLabelValues("node")))
+	require.EqualValues(t, 1, testutil.ToFloat64(sut.metricSyscallStreamDrop.WithLabelValues("other")))
+}
+
+func TestRegisterLegacy(t *testing.T) {
+	t.Parallel()
+
+	// All metrics except the ones without legacy name
+	const legacyMetrics = 10
+
+	mock := &metricsfakes.FakeImpl{}
+	sut := New()
+	sut.impl = mock
+
+	require.Nil(t, sut.Register())
+	current := mock.RegisterCallCount()
+
+	mock = &metricsfakes.FakeImpl{}
+	mock.GetenvReturns("true")
+	sut = New()
+	sut.impl = mock
+
+	require.Nil(t, sut.Register())
+	require.Equal(t, current+legacyMetrics, mock.RegisterCallCount())
+
+	require.Nil(t, sut.metricContainerIDResolution.legacyCollector())
+	require.Nil(t, sut.metricEnricherLag.legacyCollector())
+	require.Nil(t, sut.metricEnricherEviction.legacyCollector())
+	require.Nil(t, sut.metricSyscallStreamDrop.legacyCollector())
+	require.Nil(t, sut.metricRecordingIgnored.legacyCollector())
+	require.Nil(t, sut.metricSeccompProfileInfo.legacyCollector())
+	require.Nil(t, sut.metricSelinuxAvcDenial.legacyCollector())
+
+	sut.IncSeccompProfileError("reason")
+	for _, col := range []*prometheus.CounterVec{
+		sut.metricSeccompProfileError.CounterVec,
+		sut.metricSeccompProfileError.legacy,
+	} {
+		ctr, err := col.GetMetricWithLabelValues("reason")
+		require.Nil(t, err)
+		m := dto.Metric{}
+		require.Nil(t, ctr.Write(&m))
+		require.EqualValues(t, 1, m.Counter.GetValue())
+	}
+}
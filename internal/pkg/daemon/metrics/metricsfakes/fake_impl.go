@@ -25,6 +25,17 @@ import (
 )
 
 type FakeImpl struct {
+	GetenvStub        func(string) string
+	getenvMutex       sync.RWMutex
+	getenvArgsForCall []struct {
+		arg1 string
+	}
+	getenvReturns struct {
+		result1 string
+	}
+	getenvReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ListenAndServeStub        func(string, http.Handler) error
 	listenAndServeMutex       sync.RWMutex
 	listenAndServeArgsForCall []struct {
@@ -52,6 +63,67 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeImpl) Getenv(arg1 string) string {
+	fake.getenvMutex.Lock()
+	ret, specificReturn := fake.getenvReturnsOnCall[len(fake.getenvArgsForCall)]
+	fake.getenvArgsForCall = append(fake.getenvArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetenvStub
+	fakeReturns := fake.getenvReturns
+	fake.recordInvocation("Getenv", []interface{}{arg1})
+	fake.getenvMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) GetenvCallCount() int {
+	fake.getenvMutex.RLock()
+	defer fake.getenvMutex.RUnlock()
+	return len(fake.getenvArgsForCall)
+}
+
+func (fake *FakeImpl) GetenvCalls(stub func(string) string) {
+	fake.getenvMutex.Lock()
+	defer fake.getenvMutex.Unlock()
+	fake.GetenvStub = stub
+}
+
+func (fake *FakeImpl) GetenvArgsForCall(i int) string {
+	fake.getenvMutex.RLock()
+	defer fake.getenvMutex.RUnlock()
+	argsForCall := fake.getenvArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetenvReturns(result1 string) {
+	fake.getenvMutex.Lock()
+	defer fake.getenvMutex.Unlock()
+	fake.GetenvStub = nil
+	fake.getenvReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeImpl) GetenvReturnsOnCall(i int, result1 string) {
+	fake.getenvMutex.Lock()
+	defer fake.getenvMutex.Unlock()
+	fake.GetenvStub = nil
+	if fake.getenvReturnsOnCall == nil {
+		fake.getenvReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getenvReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeImpl) ListenAndServe(arg1 string, arg2 http.Handler) error {
 	fake.listenAndServeMutex.Lock()
 	ret, specificReturn := fake.listenAndServeReturnsOnCall[len(fake.listenAndServeArgsForCall)]
@@ -178,6 +250,8 @@ func (fake *FakeImpl) RegisterReturnsOnCall(i int, result1 error) {
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getenvMutex.RLock()
+	defer fake.getenvMutex.RUnlock()
 	fake.listenAndServeMutex.RLock()
 	defer fake.listenAndServeMutex.RUnlock()
 	fake.registerMutex.RLock()
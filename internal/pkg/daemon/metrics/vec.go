@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// legacyCollector is a metric which can be additionally exported with its
+// legacy name. Metrics introduced after the rename have no legacy name and
+// return a nil legacy collector.
+type legacyCollector interface {
+	collector() prometheus.Collector
+	legacyCollector() prometheus.Collector
+	enableLegacy()
+}
+
+// counterVec is a counter vector which can be additionally exported with its
+// legacy name.
+type counterVec struct {
+	*prometheus.CounterVec
+	legacy        *prometheus.CounterVec
+	legacyEnabled bool
+}
+
+func newCounterVec(name, legacyName, help string, labels []string) *counterVec {
+	c := &counterVec{
+		CounterVec: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      name,
+				Namespace: metricNamespace,
+				Help:      help,
+			},
+			labels,
+		),
+	}
+	if legacyName != "" {
+		c.legacy = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      legacyName,
+				Namespace: legacyMetricNamespace,
+				Help:      deprecatedHelp(name, help),
+			},
+			labels,
+		)
+	}
+	return c
+}
+
+func (c *counterVec) collector() prometheus.Collector { return c.CounterVec }
+
+func (c *counterVec) legacyCollector() prometheus.Collector {
+	if c.legacy == nil {
+		return nil
+	}
+	return c.legacy
+}
+
+func (c *counterVec) enableLegacy() { c.legacyEnabled = true }
+
+// inc increments the counter for the provided label values.
+func (c *counterVec) inc(lvs ...string) {
+	c.WithLabelValues(lvs...).Inc()
+
+	if c.legacyEnabled {
+		c.legacy.WithLabelValues(lvs...).Inc()
+	}
+}
+
+// add adds the value to the counter for the provided label values.
+func (c *counterVec) add(value float64, lvs ...string) {
+	c.WithLabelValues(lvs...).Add(value)
+
+	if c.legacyEnabled {
+		c.legacy.WithLabelValues(lvs...).Add(value)
+	}
+}
+
+// histogramVec is a histogram vector which can be additionally exported with
+// its legacy name.
+type histogramVec struct {
+	*prometheus.HistogramVec
+	legacy        *prometheus.HistogramVec
+	legacyEnabled bool
+}
+
+func newHistogramVec(name, legacyName, help string, buckets []float64, labels []string) *histogramVec {
+	h := &histogramVec{
+		HistogramVec: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:      name,
+				Namespace: metricNamespace,
+				Help:      help,
+				Buckets:   buckets,
+			},
+			labels,
+		),
+	}
+	if legacyName != "" {
+		h.legacy = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:      legacyName,
+				Namespace: legacyMetricNamespace,
+				Help:      deprecatedHelp(name, help),
+				Buckets:   buckets,
+			},
+			labels,
+		)
+	}
+	return h
+}
+
+func (h *histogramVec) collector() prometheus.Collector { return h.HistogramVec }
+
+func (h *histogramVec) legacyCollector() prometheus.Collector {
+	if h.legacy == nil {
+		return nil
+	}
+	return h.legacy
+}
+
+func (h *histogramVec) enableLegacy() { h.legacyEnabled = true }
+
+// observe records the value for the provided label values.
+func (h *histogramVec) observe(value float64, lvs ...string) {
+	h.WithLabelValues(lvs...).Observe(value)
+
+	if h.legacyEnabled {
+		h.legacy.WithLabelValues(lvs...).Observe(value)
+	}
+}
+
+// gaugeVec is a gauge vector which can be additionally exported with its
+// legacy name.
+type gaugeVec struct {
+	*prometheus.GaugeVec
+	legacy        *prometheus.GaugeVec
+	legacyEnabled bool
+}
+
+func newGaugeVec(name, legacyName, help string, labels []string) *gaugeVec {
+	g := &gaugeVec{
+		GaugeVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:      name,
+				Namespace: metricNamespace,
+				Help:      help,
+			},
+			labels,
+		),
+	}
+	if legacyName != "" {
+		g.legacy = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:      legacyName,
+				Namespace: legacyMetricNamespace,
+				Help:      deprecatedHelp(name, help),
+			},
+			labels,
+		)
+	}
+	return g
+}
+
+func (g *gaugeVec) collector() prometheus.Collector { return g.GaugeVec }
+
+func (g *gaugeVec) legacyCollector() prometheus.Collector {
+	if g.legacy == nil {
+		return nil
+	}
+	return g.legacy
+}
+
+func (g *gaugeVec) enableLegacy() { g.legacyEnabled = true }
+
+// set sets the gauge to the value for the provided label values.
+func (g *gaugeVec) set(value float64, lvs ...string) {
+	g.WithLabelValues(lvs...).Set(value)
+
+	if g.legacyEnabled {
+		g.legacy.WithLabelValues(lvs...).Set(value)
+	}
+}
+
+// deletePartialMatch removes all gauges matching the provided labels.
+func (g *gaugeVec) deletePartialMatch(labels prometheus.Labels) {
+	g.DeletePartialMatch(labels)
+	if g.legacy != nil {
+		g.legacy.DeletePartialMatch(labels)
+	}
+}
+
+func deprecatedHelp(name, help string) string {
+	return help + " Deprecated, use " + metricNamespace + "_" + name + " instead."
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+// languageRuntime is a language runtime for which a baseline of syscalls is
+// known.
+type languageRuntime string
+
+const (
+	runtimeNone   languageRuntime = ""
+	runtimeJVM    languageRuntime = "jvm"
+	runtimeGo     languageRuntime = "go"
+	runtimeNodeJS languageRuntime = "nodejs"
+	runtimePython languageRuntime = "python"
+)
+
+// commonBaselineSyscalls are required by nearly every dynamically or
+// statically linked program during startup and shutdown. Only syscalls which
+// exist on all supported architectures are part of the baselines, the
+// architecture specific ones are expected to be recorded.
+var commonBaselineSyscalls = []string{
+	"brk", "clock_gettime", "clock_nanosleep", "clone", "close", "execve",
+	"exit", "exit_group", "fcntl", "fstat", "futex", "getpid", "getrandom",
+	"gettid", "lseek", "madvise", "mmap", "mprotect", "munmap", "nanosleep",
+	"newfstatat", "openat", "prlimit64", "read", "readlinkat",
+	"rt_sigaction", "rt_sigprocmask", "rt_sigreturn", "sched_getaffinity",
+	"sched_yield", "set_robust_list", "set_tid_address", "sigaltstack",
+	"tgkill", "uname", "write",
+}
+
+// runtimeBaselineSyscalls are the syscalls on top of the common ones which
+// are known to be used by the language runtimes.
+var runtimeBaselineSyscalls = map[languageRuntime][]string{
+	runtimeJVM: {
+		"clone3", "epoll_create1", "epoll_ctl", "epoll_pwait", "faccessat",
+		"fchdir", "fstatfs", "fsync", "ftruncate", "getcwd", "getdents64",
+		"geteuid", "getuid", "ioctl", "mkdirat", "pread64", "prctl",
+		"rseq", "sched_getparam", "sched_getscheduler", "sched_setaffinity",
+		"sysinfo", "unlinkat",
+	},
+	runtimeGo: {
+		"epoll_create1", "epoll_ctl", "epoll_pwait", "getppid", "pipe2",
+	},
+	runtimeNodeJS: {
+		"capget", "clone3", "dup3", "epoll_create1", "epoll_ctl",
+		"epoll_pwait", "eventfd2", "getcwd", "getegid", "geteuid",
+		"getgid", "getuid", "ioctl", "io_uring_setup", "pipe2", "prctl",
+		"pread64", "rseq", "statx",
+	},
+	runtimePython: {
+		"dup", "faccessat", "getcwd", "getdents64", "getegid", "geteuid",
+		"getgid", "getuid", "ioctl", "pread64", "rseq", "sysinfo",
+	},
+}
+
+// runtimeImageHints map the path components of image repositories to the
+// runtimes. A hint only matches whole consecutive components of the
+// repository path, like library/node or distroless/static, so that unrelated
+// images like node-exporter do not get misdetected.
+var runtimeImageHints = []struct {
+	hint    string
+	runtime languageRuntime
+}{
+	{"openjdk", runtimeJVM},
+	{"eclipse-temurin", runtimeJVM},
+	{"amazoncorretto", runtimeJVM},
+	{"ibm-semeru-runtimes", runtimeJVM},
+	{"sapmachine", runtimeJVM},
+	{"java", runtimeJVM},
+	{"node", runtimeNodeJS},
+	{"nodejs", runtimeNodeJS},
+	{"python", runtimePython},
+	{"pypy", runtimePython},
+	{"golang", runtimeGo},
+	{"distroless/static", runtimeGo},
+	{"distroless/static-debian11", runtimeGo},
+	{"distroless/static-debian12", runtimeGo},
+}
+
+// runtimeEnvHints map environment variables to the runtimes.
+var runtimeEnvHints = map[string]languageRuntime{
+	"JAVA_HOME":      runtimeJVM,
+	"JAVA_VERSION":   runtimeJVM,
+	"NODE_VERSION":   runtimeNodeJS,
+	"PYTHON_VERSION": runtimePython,
+	"PYTHONPATH":     runtimePython,
+}
+
+// detectRuntime tries to identify the language runtime of a container. The
+// runtime annotation, which can be set by SBOM tooling, takes precedence
+// over the command, the environment and the image repository of the
+// container.
+func detectRuntime(pod *corev1.Pod, ctr *corev1.Container) languageRuntime {
+	if value, ok := pod.Annotations[config.RuntimeAnnotationKey+ctr.Name]; ok {
+		r := languageRuntime(strings.ToLower(value))
+		if _, ok := runtimeBaselineSyscalls[r]; ok {
+			return r
+		}
+		return runtimeNone
+	}
+
+	if len(ctr.Command) > 0 {
+		switch cmd := path.Base(ctr.Command[0]); {
+		case cmd == "java":
+			return runtimeJVM
+		case cmd == "node" || cmd == "nodejs":
+			return runtimeNodeJS
+		case strings.HasPrefix(cmd, "python"):
+			return runtimePython
+		}
+	}
+
+	for _, env := range ctr.Env {
+		if r, ok := runtimeEnvHints[env.Name]; ok {
+			return r
+		}
+	}
+
+	components := imageRepositoryComponents(ctr.Image)
+	for _, h := range runtimeImageHints {
+		if containsComponents(components, strings.Split(h.hint, "/")) {
+			return h.runtime
+		}
+	}
+
+	return runtimeNone
+}
+
+// imageRepositoryComponents returns the path components of the repository
+// of an image reference, without the registry host, the tag and the digest.
+func imageRepositoryComponents(image string) []string {
+	image = strings.ToLower(image)
+	if i := strings.IndexByte(image, '@'); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+
+	components := strings.Split(image, "/")
+	if len(components) > 1 &&
+		(strings.ContainsAny(components[0], ".:") || components[0] == "localhost") {
+		components = components[1:]
+	}
+	return components
+}
+
+// containsComponents returns true if the components contain the expected
+// ones in consecutive order.
+func containsComponents(components, expected []string) bool {
+	for i := 0; i+len(expected) <= len(components); i++ {
+		if slices.Equal(components[i:i+len(expected)], expected) {
+			return true
+		}
+	}
+	return false
+}
+
+// detectRuntimes returns the detected language runtimes of all containers
+// in the pod by their name.
+func detectRuntimes(pod *corev1.Pod) map[string]languageRuntime {
+	res := make(map[string]languageRuntime)
+	//nolint:gocritic // This is what we expect and want
+	containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+	for i := range containers {
+		if r := detectRuntime(pod, &containers[i]); r != runtimeNone {
+			res[containers[i].Name] = r
+		}
+	}
+	return res
+}
+
+// withBaseline merges the recorded syscalls with the baseline of the
+// runtime and returns them sorted.
+func withBaseline(syscalls []string, r languageRuntime) []string {
+	extra, ok := runtimeBaselineSyscalls[r]
+	if !ok {
+		return syscalls
+	}
+
+	res := sets.New(syscalls...)
+	res.Insert(commonBaselineSyscalls...)
+	res.Insert(extra...)
+	return sets.List(res)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestDetectRuntime(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		container   corev1.Container
+		want        languageRuntime
+	}{
+		{
+			name: "annotation",
+			annotations: map[string]string{
+				config.RuntimeAnnotationKey + "ctr": "Python",
+			},
+			container: corev1.Container{Name: "ctr", Image: "openjdk:17"},
+			want:      runtimePython,
+		},
+		{
+			name: "unknown annotation",
+			annotations: map[string]string{
+				config.RuntimeAnnotationKey + "ctr": "rust",
+			},
+			container: corev1.Container{Name: "ctr", Image: "openjdk:17"},
+			want:      runtimeNone,
+		},
+		{
+			name:      "command",
+			container: corev1.Container{Name: "ctr", Image: "busybox", Command: []string{"/usr/bin/python3"}},
+			want:      runtimePython,
+		},
+		{
+			name: "environment",
+			container: corev1.Container{Name: "ctr", Image: "busybox", Env: []corev1.EnvVar{
+				{Name: "JAVA_HOME", Value: "/opt/java"},
+			}},
+			want: runtimeJVM,
+		},
+		{
+			name:      "image",
+			container: corev1.Container{Name: "ctr", Image: "docker.io/library/node:20-alpine"},
+			want:      runtimeNodeJS,
+		},
+		{
+			name:      "distroless static image",
+			container: corev1.Container{Name: "ctr", Image: "gcr.io/distroless/static@sha256:abc"},
+			want:      runtimeGo,
+		},
+		{
+			name:      "image tag is ignored",
+			container: corev1.Container{Name: "ctr", Image: "registry:5000/nginx:python"},
+			want:      runtimeNone,
+		},
+		{
+			name:      "image with organization",
+			container: corev1.Container{Name: "ctr", Image: "quay.io/bitnami/python:3.12"},
+			want:      runtimePython,
+		},
+		{
+			name:      "distroless static debian image",
+			container: corev1.Container{Name: "ctr", Image: "gcr.io/distroless/static-debian12:nonroot"},
+			want:      runtimeGo,
+		},
+		{
+			name:      "image name containing a hint",
+			container: corev1.Container{Name: "ctr", Image: "quay.io/prometheus/node-exporter:v1.7.0"},
+			want:      runtimeNone,
+		},
+		{
+			name:      "image name with a hint as substring",
+			container: corev1.Container{Name: "ctr", Image: "docker.io/example/jdk-tools-scanner"},
+			want:      runtimeNone,
+		},
+		{
+			name:      "registry host is ignored",
+			container: corev1.Container{Name: "ctr", Image: "node.example.com/nginx"},
+			want:      runtimeNone,
+		},
+		{
+			name:      "partial component path",
+			container: corev1.Container{Name: "ctr", Image: "example.com/static/distroless"},
+			want:      runtimeNone,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			require.Equal(t, tc.want, detectRuntime(pod, &tc.container))
+		})
+	}
+}
+
+func TestWithBaseline(t *testing.T) {
+	t.Parallel()
+
+	recorded := []string{"mkdir", "write"}
+	require.Equal(t, recorded, withBaseline(recorded, runtimeNone))
+
+	res := withBaseline(recorded, runtimeGo)
+	require.IsIncreasing(t, res)
+	require.Contains(t, res, "mkdir")
+	require.Contains(t, res, "futex")
+	require.Contains(t, res, "epoll_pwait")
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// heartbeatInterval is the interval for reporting the node as alive to the
+// recordings of the pods recorded on the node.
+const heartbeatInterval = time.Minute
+
+// activeRecording is a recording of the pods recorded on the node together
+// with the recorder used on the node.
+type activeRecording struct {
+	types.NamespacedName
+	recorder profilerecording1alpha1.ProfileRecorder
+}
+
+// heartbeats reports the heartbeats periodically until the context is done.
+func (r *RecorderReconciler) heartbeats(ctx context.Context) error {
+	wait.UntilWithContext(ctx, r.heartbeat, heartbeatInterval)
+	return nil
+}
+
+// heartbeat reports the node as alive to all recordings of the currently
+// recorded pods. Recordings using the log enricher are skipped while it is
+// disabled, because the node does not contribute to them in that case. The
+// pods whose recording annotations got ignored are reported as well.
+func (r *RecorderReconciler) heartbeat(ctx context.Context) {
+	recordings, err := r.activeRecordings(ctx)
+	if err != nil {
+		r.log.Error(err, "cannot determine active recordings")
+		return
+	}
+
+	for _, recording := range recordings {
+		if err := r.reportHeartbeat(ctx, recording); err != nil {
+			r.log.Error(err, "cannot report recording heartbeat", "recording", recording.NamespacedName)
+		}
+	}
+
+	r.reportIgnoredPods(ctx)
+}
+
+// reportRecordings reports the heartbeat to the recordings of a pod which
+// just started to be recorded, without waiting for the next interval.
+func (r *RecorderReconciler) reportRecordings(ctx context.Context, podName types.NamespacedName) {
+	p, ok := r.watchedPod(podName)
+	if !ok || (p.recorder == profilerecording1alpha1.ProfileRecorderLogs && p.enricherDisabled) {
+		return
+	}
+
+	for _, name := range recordingsOfPod(&p) {
+		recording := activeRecording{NamespacedName: name, recorder: p.recorder}
+		if err := r.reportHeartbeat(ctx, recording); err != nil {
+			r.log.Error(err, "cannot report recording heartbeat", "recording", name)
+		}
+	}
+}
+
+// recordingsOfPod returns the unique names of the recordings which the
+// profiles of the pod belong to.
+func recordingsOfPod(p *podToWatch) []types.NamespacedName {
+	res := []types.NamespacedName{}
+	for _, prf := range p.profiles {
+		parsed, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			continue
+		}
+		name := types.NamespacedName{
+			Namespace: p.baseName.Namespace,
+			Name:      parsed.profileName,
+		}
+		if !slices.Contains(res, name) {
+			res = append(res, name)
+		}
+	}
+	return res
+}
+
+// activeRecordings returns the recordings which the currently recorded pods
+// belong to, sorted by their names.
+func (r *RecorderReconciler) activeRecordings(ctx context.Context) ([]activeRecording, error) {
+	var (
+		rangeErr        error
+		enricherEnabled *bool
+	)
+	recordings := map[types.NamespacedName]profilerecording1alpha1.ProfileRecorder{}
+
+	r.podsToWatch.Range(func(_, value any) bool {
+		p, ok := value.(podToWatch)
+		if !ok {
+			return true
+		}
+
+		if p.recorder == profilerecording1alpha1.ProfileRecorderLogs {
+			if enricherEnabled == nil {
+				enabled, err := r.logEnricherEnabled(ctx)
+				if err != nil {
+					rangeErr = err
+					return false
+				}
+				enricherEnabled = &enabled
+			}
+			if !*enricherEnabled {
+				return true
+			}
+		}
+
+		for _, name := range recordingsOfPod(&p) {
+			recordings[name] = p.recorder
+		}
+		return true
+	})
+	if rangeErr != nil {
+		return nil, rangeErr
+	}
+
+	res := make([]activeRecording, 0, len(recordings))
+	for name, recorder := range recordings {
+		res = append(res, activeRecording{NamespacedName: name, recorder: recorder})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].String() < res[j].String()
+	})
+	return res, nil
+}
+
+// reportHeartbeat patches the recording status with the current time and the
+// used recorder for this node.
+func (r *RecorderReconciler) reportHeartbeat(ctx context.Context, recording activeRecording) error {
+	return r.patchRecordingStatus(ctx, recording.NamespacedName, map[string]any{
+		"lastSeen":  map[string]metav1.Time{r.nodeName: metav1.Now()},
+		"recorders": map[string]profilerecording1alpha1.ProfileRecorder{r.nodeName: recording.recorder},
+	})
+}
+
+// patchRecordingStatus patches the provided fields of the recording status. A
+// merge patch is used to not conflict with the daemons running on other nodes.
+func (r *RecorderReconciler) patchRecordingStatus(
+	ctx context.Context, name types.NamespacedName, status map[string]any,
+) error {
+	patch, err := json.Marshal(map[string]any{"status": status})
+	if err != nil {
+		return fmt.Errorf("marshal status patch: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	obj := &profilerecording1alpha1.ProfileRecording{}
+	obj.SetName(name.Name)
+	obj.SetNamespace(name.Namespace)
+	if err := r.PatchRecordingStatus(ctx, r.client, obj, patch); err != nil {
+		// The recording got removed while the pods are still running
+		if util.IgnoreNotFound(err) == nil {
+			return nil
+		}
+		return fmt.Errorf("patching recording status: %w", err)
+	}
+	return nil
+}
+
+// reportPhase reports the recording phase of a pod to all recordings of its
+// profiles. Failures are only logged, because the phase is informational.
+func (r *RecorderReconciler) reportPhase(
+	ctx context.Context, podName string, p *podToWatch, phase profilerecording1alpha1.ProfileRecordingPhase,
+) {
+	for _, name := range recordingsOfPod(p) {
+		if err := r.patchRecordingStatus(ctx, name, map[string]any{
+			"pods": map[string]profilerecording1alpha1.ProfileRecordingPhase{podName: phase},
+		}); err != nil {
+			r.log.Error(err, "cannot report recording phase", "recording", name, "pod", podName, "phase", phase)
+		}
+	}
+}
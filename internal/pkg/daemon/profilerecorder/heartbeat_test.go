@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	recordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder/profilerecorderfakes"
+)
+
+func TestHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	trackPods := func(sut *RecorderReconciler) {
+		sut.trackPod(types.NamespacedName{Namespace: "ns", Name: "bpf-1"}, "1", podToWatch{
+			baseName: types.NamespacedName{Namespace: "ns", Name: "bpf"},
+			recorder: recordingapi.ProfileRecorderBpf,
+			profiles: []profileToCollect{
+				{name: "recording-b_ctr1_12345_1"},
+				{name: "recording-b_ctr2_12345_1"},
+			},
+		})
+		sut.trackPod(types.NamespacedName{Namespace: "ns", Name: "bpf-2"}, "2", podToWatch{
+			baseName: types.NamespacedName{Namespace: "ns", Name: "bpf"},
+			recorder: recordingapi.ProfileRecorderBpf,
+			profiles: []profileToCollect{{name: "recording-b_ctr1_67890_1"}},
+		})
+		sut.trackPod(types.NamespacedName{Namespace: "ns", Name: "logs"}, "3", podToWatch{
+			baseName: types.NamespacedName{Namespace: "ns", Name: "logs"},
+			recorder: recordingapi.ProfileRecorderLogs,
+			profiles: []profileToCollect{{name: "recording-a_ctr_12345_1"}},
+		})
+	}
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*RecorderReconciler, *profilerecorderfakes.FakeImpl)
+		assert  func(*profilerecorderfakes.FakeImpl)
+	}{
+		{
+			name: "report all recordings",
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				trackPods(sut)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl) {
+				assert.Equal(t, 2, mock.PatchRecordingStatusCallCount())
+
+				_, _, recording, patch := mock.PatchRecordingStatusArgsForCall(0)
+				assert.Equal(t, "recording-a", recording.GetName())
+				assert.Equal(t, "ns", recording.GetNamespace())
+
+				status := struct {
+					Status recordingapi.ProfileRecordingStatus `json:"status"`
+				}{}
+				assert.NoError(t, json.Unmarshal(patch, &status))
+				assert.Contains(t, status.Status.LastSeen, "node")
+				assert.Equal(t, recordingapi.ProfileRecorderLogs, status.Status.Recorders["node"])
+				assert.Empty(t, status.Status.ActiveWorkloads)
+
+				_, _, recording, patch = mock.PatchRecordingStatusArgsForCall(1)
+				assert.Equal(t, "recording-b", recording.GetName())
+				assert.NoError(t, json.Unmarshal(patch, &status))
+				assert.Equal(t, recordingapi.ProfileRecorderBpf, status.Status.Recorders["node"])
+			},
+		},
+		{
+			name: "skip log recordings if the enricher is disabled",
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				trackPods(sut)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{}, nil)
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl) {
+				assert.Equal(t, 1, mock.PatchRecordingStatusCallCount())
+				_, _, recording, _ := mock.PatchRecordingStatusArgsForCall(0)
+				assert.Equal(t, "recording-b", recording.GetName())
+			},
+		},
+		{
+			name:    "no recorded pods",
+			prepare: func(*RecorderReconciler, *profilerecorderfakes.FakeImpl) {},
+			assert: func(mock *profilerecorderfakes.FakeImpl) {
+				assert.Zero(t, mock.GetSPODCallCount())
+				assert.Zero(t, mock.PatchRecordingStatusCallCount())
+			},
+		},
+		{
+			name: "failure on GetSPOD",
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				trackPods(sut)
+				mock.GetSPODReturns(nil, errTest)
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl) {
+				assert.Zero(t, mock.PatchRecordingStatusCallCount())
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			sut := &RecorderReconciler{impl: mock, log: logr.Discard(), nodeName: "node"}
+			tc.prepare(sut, mock)
+
+			sut.heartbeat(context.Background())
+			tc.assert(mock)
+		})
+	}
+}
+
+func TestReportHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	recording := activeRecording{
+		NamespacedName: types.NamespacedName{Namespace: "ns", Name: "recording"},
+		recorder:       recordingapi.ProfileRecorderLogs,
+	}
+
+	for _, tc := range []struct {
+		name      string
+		patchErr  error
+		shouldErr bool
+	}{
+		{name: "success"},
+		{
+			name:     "recording not found",
+			patchErr: kerrors.NewNotFound(schema.GroupResource{}, recording.Name),
+		},
+		{name: "failure on PatchRecordingStatus", patchErr: errTest, shouldErr: true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			mock.PatchRecordingStatusReturns(tc.patchErr)
+			sut := &RecorderReconciler{impl: mock, log: logr.Discard(), nodeName: "node"}
+
+			before := metav1.Now().Rfc3339Copy()
+			err := sut.reportHeartbeat(context.Background(), recording)
+			if tc.shouldErr {
+				assert.ErrorIs(t, err, errTest)
+				return
+			}
+			assert.NoError(t, err)
+
+			_, _, _, patch := mock.PatchRecordingStatusArgsForCall(0)
+			status := struct {
+				Status recordingapi.ProfileRecordingStatus `json:"status"`
+			}{}
+			assert.NoError(t, json.Unmarshal(patch, &status))
+			lastSeen := status.Status.LastSeen["node"]
+			assert.False(t, lastSeen.Before(&before))
+			assert.Equal(t, recordingapi.ProfileRecorderLogs, status.Status.Recorders["node"])
+		})
+	}
+}
+
+func TestReportPhase(t *testing.T) {
+	t.Parallel()
+
+	p := &podToWatch{
+		baseName: types.NamespacedName{Namespace: "ns", Name: "pod"},
+		recorder: recordingapi.ProfileRecorderBpf,
+		profiles: []profileToCollect{
+			{name: "recording-a_ctr1_12345_1"},
+			{name: "recording-a_ctr2_12345_1"},
+			{name: "recording-b_ctr1_12345_1"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		patchErr error
+	}{
+		{name: "success"},
+		{name: "failure on PatchRecordingStatus", patchErr: errTest},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			mock.PatchRecordingStatusReturns(tc.patchErr)
+			sut := &RecorderReconciler{impl: mock, log: logr.Discard(), nodeName: "node"}
+
+			sut.reportPhase(context.Background(), "pod-1", p, recordingapi.ProfileRecordingPhaseCollected)
+			assert.Equal(t, 2, mock.PatchRecordingStatusCallCount())
+
+			for i, name := range []string{"recording-a", "recording-b"} {
+				_, _, recording, patch := mock.PatchRecordingStatusArgsForCall(i)
+				assert.Equal(t, name, recording.GetName())
+				assert.Equal(t, "ns", recording.GetNamespace())
+
+				status := struct {
+					Status recordingapi.ProfileRecordingStatus `json:"status"`
+				}{}
+				assert.NoError(t, json.Unmarshal(patch, &status))
+				assert.Equal(t, map[string]recordingapi.ProfileRecordingPhase{
+					"pod-1": recordingapi.ProfileRecordingPhaseCollected,
+				}, status.Status.Pods)
+				assert.Nil(t, status.Status.LastSeen)
+			}
+		})
+	}
+}
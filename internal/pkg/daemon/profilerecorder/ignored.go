@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// ignoredPod is a pod whose recording annotations got ignored.
+type ignoredPod struct {
+	name       types.NamespacedName
+	reason     string
+	recordings []types.NamespacedName
+}
+
+// ignorePod reports that the recording annotations of a pod got ignored by
+// emitting an event, counting it in the metrics and reporting it to the
+// status of its recordings. Every pod incarnation is only reported once,
+// because the pod gets reconciled again on every update.
+func (r *RecorderReconciler) ignorePod(ctx context.Context, pod *corev1.Pod, reason, message string) {
+	ignored := ignoredPod{
+		name:       types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name},
+		reason:     reason,
+		recordings: recordingsOfAnnotations(pod),
+	}
+	if _, loaded := r.ignoredPods.LoadOrStore(pod.UID, ignored); loaded {
+		return
+	}
+
+	r.record.Event(pod, util.EventTypeWarning, reason, message)
+	if r.metrics != nil {
+		r.metrics.IncRecordingIgnored(pod.Namespace, reason)
+	}
+	r.reportIgnoredPod(ctx, &ignored, reason)
+}
+
+// forgetIgnoredPod removes a pod which got deleted or is recorded now from
+// the ignored pods and the status of its recordings.
+func (r *RecorderReconciler) forgetIgnoredPod(ctx context.Context, podName types.NamespacedName) {
+	r.ignoredPods.Range(func(key, value any) bool {
+		ignored, ok := value.(ignoredPod)
+		if !ok || ignored.name != podName {
+			return true
+		}
+		r.ignoredPods.Delete(key)
+		r.reportIgnoredPod(ctx, &ignored, nil)
+		return true
+	})
+}
+
+// reportIgnoredPods reports all ignored pods to the status of their
+// recordings again, for example if the recordings got recreated or a
+// previous report failed.
+func (r *RecorderReconciler) reportIgnoredPods(ctx context.Context) {
+	r.ignoredPods.Range(func(_, value any) bool {
+		if ignored, ok := value.(ignoredPod); ok {
+			r.reportIgnoredPod(ctx, &ignored, ignored.reason)
+		}
+		return true
+	})
+}
+
+// reportIgnoredPod sets the reason for ignoring the pod in the status of its
+// recordings, a nil reason removes the pod from the status. Failures are
+// only logged, because the status is informational.
+func (r *RecorderReconciler) reportIgnoredPod(ctx context.Context, ignored *ignoredPod, reason any) {
+	for _, name := range ignored.recordings {
+		if err := r.patchRecordingStatus(ctx, name, map[string]any{
+			"ignoredPods": map[string]any{ignored.name.Name: reason},
+		}); err != nil {
+			r.log.Error(err, "cannot report ignored pod", "recording", name, "pod", ignored.name)
+		}
+	}
+}
+
+// recordingsOfAnnotations returns the unique names of the recordings which
+// the recording annotations of the pod refer to.
+func recordingsOfAnnotations(pod *corev1.Pod) []types.NamespacedName {
+	keys := []string{}
+	for key := range pod.Annotations {
+		if isRecordingAnnotation(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	p := podToWatch{baseName: types.NamespacedName{Namespace: pod.Namespace}}
+	for _, key := range keys {
+		p.profiles = append(p.profiles, profileToCollect{name: pod.Annotations[key]})
+	}
+	return recordingsOfPod(&p)
+}
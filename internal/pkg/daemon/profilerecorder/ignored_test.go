@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder/profilerecorderfakes"
+)
+
+func TestIgnorePod(t *testing.T) {
+	t.Parallel()
+
+	mock := &profilerecorderfakes.FakeImpl{}
+	recorder := record.NewFakeRecorder(10)
+	sut := &RecorderReconciler{impl: mock, log: logr.Discard(), record: recorder}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "pod",
+		Namespace: "ns",
+		UID:       "1",
+		Annotations: map[string]string{
+			config.SeccompProfileRecordLogsAnnotationKey + "ctr1": "recording-b_ctr1_12345_1",
+			config.SeccompProfileRecordLogsAnnotationKey + "ctr2": "recording-a_ctr2_12345_1",
+			config.SelinuxProfileRecordLogsAnnotationKey + "ctr1": "recording-b_ctr1_12345_1",
+			"other": "value",
+		},
+	}}
+
+	// Every pod incarnation is only reported once
+	sut.ignorePod(context.Background(), pod, reasonRecorderNotAllowed, "not allowed")
+	sut.ignorePod(context.Background(), pod, reasonRecorderNotAllowed, "not allowed")
+	assert.Len(t, recorder.Events, 1)
+	assert.Equal(t, 2, mock.PatchRecordingStatusCallCount())
+	for i, name := range []string{"recording-b", "recording-a"} {
+		_, _, recording, patch := mock.PatchRecordingStatusArgsForCall(i)
+		assert.Equal(t, types.NamespacedName{Namespace: "ns", Name: name}, client.ObjectKeyFromObject(recording))
+		assert.JSONEq(t, `{"status":{"ignoredPods":{"pod":"RecorderNotAllowed"}}}`, string(patch))
+	}
+
+	// The ignored pods are reported periodically
+	sut.reportIgnoredPods(context.Background())
+	assert.Equal(t, 4, mock.PatchRecordingStatusCallCount())
+
+	// Other pods are not forgotten
+	sut.forgetIgnoredPod(context.Background(), types.NamespacedName{Namespace: "ns", Name: "other"})
+	assert.Equal(t, 4, mock.PatchRecordingStatusCallCount())
+
+	sut.forgetIgnoredPod(context.Background(), types.NamespacedName{Namespace: "ns", Name: "pod"})
+	assert.Equal(t, 6, mock.PatchRecordingStatusCallCount())
+	_, _, _, patch := mock.PatchRecordingStatusArgsForCall(5)
+	assert.JSONEq(t, `{"status":{"ignoredPods":{"pod":null}}}`, string(patch))
+	_, ok := sut.ignoredPods.Load(pod.UID)
+	assert.False(t, ok)
+
+	// A new incarnation gets reported again
+	pod.UID = "2"
+	sut.ignorePod(context.Background(), pod, reasonPodAlreadyRunning, "already running")
+	assert.Len(t, recorder.Events, 2)
+}
@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,6 +49,7 @@ type defaultImpl struct{}
 type impl interface {
 	NewClient(ctrl.Manager) (client.Client, error)
 	ClientGet(context.Context, client.Client, client.ObjectKey, client.Object) error
+	ClientPatch(context.Context, client.Client, client.Object, client.Patch) error
 	NewControllerManagedBy(
 		manager.Manager, string, func(obj runtime.Object) bool,
 		func(obj runtime.Object) bool, reconcile.Reconciler) error
@@ -73,14 +75,22 @@ type impl interface {
 	ResetSyscalls(
 		context.Context, enricherapi.EnricherClient, *enricherapi.SyscallsRequest,
 	) error
+	CollectSyscalls(
+		context.Context, enricherapi.EnricherClient, *enricherapi.SyscallsRequest,
+	) (*enricherapi.SyscallsResponse, error)
 	Avcs(
 		context.Context, enricherapi.EnricherClient, *enricherapi.AvcRequest,
 	) (*enricherapi.AvcResponse, error)
 	ResetAvcs(
 		context.Context, enricherapi.EnricherClient, *enricherapi.AvcRequest,
 	) error
+	CollectAvcs(
+		context.Context, enricherapi.EnricherClient, *enricherapi.AvcRequest,
+	) (*enricherapi.AvcResponse, error)
 	DialEnricher() (*grpc.ClientConn, context.CancelFunc, error)
 	GetRecording(context.Context, client.Client, client.ObjectKey) (*profilerecording1alpha1.ProfileRecording, error)
+	ManagerAdd(manager.Manager, manager.Runnable) error
+	PatchRecordingStatus(context.Context, client.Client, *profilerecording1alpha1.ProfileRecording, []byte) error
 }
 
 func (*defaultImpl) NewClient(mgr ctrl.Manager) (client.Client, error) {
@@ -93,6 +103,12 @@ func (*defaultImpl) ClientGet(
 	return c.Get(ctx, key, obj)
 }
 
+func (*defaultImpl) ClientPatch(
+	ctx context.Context, c client.Client, obj client.Object, patch client.Patch,
+) error {
+	return c.Patch(ctx, obj, patch)
+}
+
 func (*defaultImpl) NewControllerManagedBy(
 	m manager.Manager,
 	name string,
@@ -188,6 +204,12 @@ func (*defaultImpl) ResetSyscalls(
 	return err
 }
 
+func (*defaultImpl) CollectSyscalls(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.SyscallsRequest,
+) (*enricherapi.SyscallsResponse, error) {
+	return c.CollectSyscalls(ctx, in)
+}
+
 func (*defaultImpl) Avcs(
 	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.AvcRequest,
 ) (*enricherapi.AvcResponse, error) {
@@ -201,6 +223,12 @@ func (*defaultImpl) ResetAvcs(
 	return err
 }
 
+func (*defaultImpl) CollectAvcs(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.AvcRequest,
+) (*enricherapi.AvcResponse, error) {
+	return c.CollectAvcs(ctx, in)
+}
+
 func (*defaultImpl) DialEnricher() (*grpc.ClientConn, context.CancelFunc, error) {
 	return enricher.Dial()
 }
@@ -215,3 +243,16 @@ func (*defaultImpl) GetRecording(
 	err := cli.Get(ctx, key, &recording)
 	return &recording, err
 }
+
+func (*defaultImpl) ManagerAdd(m manager.Manager, r manager.Runnable) error {
+	return m.Add(r)
+}
+
+func (*defaultImpl) PatchRecordingStatus(
+	ctx context.Context,
+	cli client.Client,
+	recording *profilerecording1alpha1.ProfileRecording,
+	patch []byte,
+) error {
+	return cli.Status().Patch(ctx, recording, client.RawPatch(types.MergePatchType, patch))
+}
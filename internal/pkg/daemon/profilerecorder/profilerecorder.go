@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"net/http"
 	"os"
-	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -32,6 +31,7 @@ import (
 	"github.com/go-logr/logr"
 	grpccodes "google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,6 +43,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
@@ -58,6 +59,8 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/features"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
@@ -66,18 +69,65 @@ const (
 	// default reconcile timeout.
 	reconcileTimeout = 1 * time.Minute
 
+	// minSnapshotInterval is the minimum interval for collecting the
+	// profiles recorded so far from running pods.
+	minSnapshotInterval = time.Minute
+
 	errInvalidAnnotation = "invalid Annotation"
 
 	reasonProfileRecording      string = "ProfileRecording"
 	reasonProfileCreated        string = "ProfileCreated"
 	reasonProfileCreationFailed string = "CannotCreateProfile"
 	reasonAnnotationParsing     string = "AnnotationParsing"
+	reasonLogEnricherDisabled   string = "LogEnricherDisabled"
+	reasonRecorderNotAllowed    string = "RecorderNotAllowed"
+	reasonRecorderFallback      string = "RecorderFallback"
+	reasonProfileNameInvalid    string = "ProfileNameInvalid"
+	reasonPodAlreadyRunning     string = "PodAlreadyRunning"
 
 	seContextRequiredParts = 3
+
+	// podUIDSuffixLength is the amount of pod UID characters appended to
+	// partial profile names.
+	podUIDSuffixLength = 8
+
+	// initContainerPrefix is prepended to the container name in the names
+	// of the profiles recorded for init containers.
+	initContainerPrefix = "init-"
 )
 
 var errNameNotValid = errors.New("recording name is not valid DNS1123 subdomain, check profileRecording events")
 
+// recordableTclasses are the target classes of AVCs which are added to
+// recorded SELinux profiles. Denials of other classes are usually not caused
+// by the workload itself, for example when the container runtime sets up the
+// container.
+var recordableTclasses = sets.New(
+	"blk_file",
+	"capability",
+	"capability2",
+	"chr_file",
+	"dir",
+	"fifo_file",
+	"file",
+	"icmp_socket",
+	"key",
+	"lnk_file",
+	"msgq",
+	"netlink_route_socket",
+	"netlink_socket",
+	"packet_socket",
+	"process",
+	"rawip_socket",
+	"sem",
+	"shm",
+	"sock_file",
+	"tcp_socket",
+	"udp_socket",
+	"unix_dgram_socket",
+	"unix_stream_socket",
+)
+
 // NewController returns a new empty controller instance.
 func NewController() controller.Controller {
 	return &RecorderReconciler{
@@ -88,10 +138,21 @@ func NewController() controller.Controller {
 type RecorderReconciler struct {
 	impl
 	client        client.Client
+	apiReader     client.Client
 	log           logr.Logger
 	record        record.EventRecorder
+	nodeName      string
 	nodeAddresses []string
-	podsToWatch   sync.Map
+	// podsToWatch are the recorded pods keyed by their UID, because a pod
+	// can be recreated with the same name, for example by a StatefulSet.
+	podsToWatch sync.Map
+	// podUIDs maps the namespaced name of a recorded pod to the UID of the
+	// currently tracked pod incarnation.
+	podUIDs sync.Map
+	// ignoredPods are the pods whose recording annotations got ignored keyed
+	// by their UID.
+	ignoredPods sync.Map
+	metrics     *metrics.Metrics
 }
 
 type profileToCollect struct {
@@ -103,6 +164,52 @@ type podToWatch struct {
 	baseName types.NamespacedName
 	recorder profilerecording1alpha1.ProfileRecorder
 	profiles []profileToCollect
+	runtimes map[string]languageRuntime
+	// ephemeralContainers are the names of the ephemeral containers added
+	// to the pod while it is running.
+	ephemeralContainers []string
+	// enricherDisabled indicates that the log enricher was not enabled when
+	// the recording of the pod started.
+	enricherDisabled bool
+	// fallbackProfiles are the profiles of the logs recorder which the pod
+	// got prepared for, but which are not used because the bpf recorder is
+	// available on the node.
+	fallbackProfiles []profileToCollect
+	// snapshotInterval is the interval at which the profiles recorded so far
+	// get collected while the pod is running, zero if disabled.
+	snapshotInterval time.Duration
+	// nextSnapshot is the time when the next snapshot should be taken.
+	nextSnapshot time.Time
+	// owner is the controller of the pod, which gets annotated with the
+	// recorded profiles if the pod does not exist anymore.
+	owner *metav1.OwnerReference
+	// ownerName is the name of the workload controlling the pod, which is
+	// available to the profile name template of the recording.
+	ownerName string
+	// images are the images of the pod per container name.
+	images map[string]string
+	// initContainers are the names of the init containers of the pod.
+	initContainers sets.Set[string]
+}
+
+// profileNaming contains the details of a recorded pod which are used to
+// name its profiles.
+type profileNaming struct {
+	replicaSuffix  string
+	owner          string
+	images         map[string]string
+	initContainers sets.Set[string]
+}
+
+// naming returns the details of the watched pod which are used to name its
+// profiles.
+func (p *podToWatch) naming(podName types.NamespacedName) profileNaming {
+	return profileNaming{
+		replicaSuffix:  replicaSuffix(podName, p.baseName),
+		owner:          p.ownerName,
+		images:         p.images,
+		initContainers: p.initContainers,
+	}
 }
 
 // Name returns the name of the controller.
@@ -117,12 +224,13 @@ func (r *RecorderReconciler) SchemeBuilder() *scheme.Builder {
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/status,verbs=patch
 
 // Setup is the initialization of the controller.
 func (r *RecorderReconciler) Setup(
 	ctx context.Context,
 	mgr ctrl.Manager,
-	_ *metrics.Metrics,
+	met *metrics.Metrics,
 ) error {
 	const name = "profilerecorder"
 	c, err := r.NewClient(mgr)
@@ -130,9 +238,10 @@ func (r *RecorderReconciler) Setup(
 		return fmt.Errorf("cannot get client connection: %w", err)
 	}
 
+	r.nodeName = os.Getenv(config.NodeNameEnvKey)
 	node := &corev1.Node{}
 	if err := r.ClientGet(
-		ctx, c, client.ObjectKey{Name: os.Getenv(config.NodeNameEnvKey)}, node,
+		ctx, c, client.ObjectKey{Name: r.nodeName}, node,
 	); err != nil {
 		return fmt.Errorf("cannot get node object: %w", err)
 	}
@@ -152,12 +261,21 @@ func (r *RecorderReconciler) Setup(
 	}
 
 	r.client = r.ManagerGetClient(mgr)
+	r.apiReader = c
 	r.nodeAddresses = nodeAddresses
 	r.record = r.ManagerGetEventRecorderFor(mgr, name)
+	r.metrics = met
 
-	return r.NewControllerManagedBy(
+	if err := r.NewControllerManagedBy(
 		mgr, name, r.isPodWithTraceAnnotation, r.isPodOnLocalNode, r,
-	)
+	); err != nil {
+		return fmt.Errorf("create controller: %w", err)
+	}
+
+	if err := r.ManagerAdd(mgr, manager.RunnableFunc(r.heartbeats)); err != nil {
+		return fmt.Errorf("add recording heartbeats: %w", err)
+	}
+	return nil
 }
 
 func (r *RecorderReconciler) getSPOD(ctx context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
@@ -166,6 +284,33 @@ func (r *RecorderReconciler) getSPOD(ctx context.Context) (*spodv1alpha1.Securit
 	return r.GetSPOD(ctx, r.client)
 }
 
+// logEnricherEnabled returns true if the log enricher is enabled either by the
+// SPOD configuration or the environment.
+func (r *RecorderReconciler) logEnricherEnabled(ctx context.Context) (bool, error) {
+	spod, err := r.getSPOD(ctx)
+	if err != nil {
+		return false, fmt.Errorf("getting SPOD config: %w", err)
+	}
+
+	enableLogEnricherEnv, err := strconv.ParseBool(os.Getenv(config.EnableLogEnricherEnvKey))
+	if err != nil {
+		enableLogEnricherEnv = false
+	}
+	return spod.Spec.EnableLogEnricher || enableLogEnricherEnv, nil
+}
+
+// recorderAllowed returns true if the SPOD configuration allows recording
+// profiles with the provided recorder.
+func (r *RecorderReconciler) recorderAllowed(
+	ctx context.Context, recorder profilerecording1alpha1.ProfileRecorder,
+) (bool, error) {
+	spod, err := r.getSPOD(ctx)
+	if err != nil {
+		return false, fmt.Errorf("getting SPOD config: %w", err)
+	}
+	return spod.Spec.RecorderAllowed(recorder), nil
+}
+
 // Healthz is the liveness probe endpoint of the controller.
 func (r *RecorderReconciler) Healthz(*http.Request) error {
 	return nil
@@ -195,9 +340,7 @@ func (r *RecorderReconciler) isPodWithTraceAnnotation(obj runtime.Object) bool {
 	}
 
 	for key := range p.Annotations {
-		if strings.HasPrefix(key, config.SelinuxProfileRecordLogsAnnotationKey) ||
-			strings.HasPrefix(key, config.SeccompProfileRecordLogsAnnotationKey) ||
-			strings.HasPrefix(key, config.SeccompProfileRecordBpfAnnotationKey) {
+		if isRecordingAnnotation(key) {
 			return true
 		}
 	}
@@ -205,6 +348,14 @@ func (r *RecorderReconciler) isPodWithTraceAnnotation(obj runtime.Object) bool {
 	return false
 }
 
+// isRecordingAnnotation returns true if the annotation key requests the
+// recording of a container.
+func isRecordingAnnotation(key string) bool {
+	return strings.HasPrefix(key, config.SelinuxProfileRecordLogsAnnotationKey) ||
+		strings.HasPrefix(key, config.SeccompProfileRecordLogsAnnotationKey) ||
+		strings.HasPrefix(key, config.SeccompProfileRecordBpfAnnotationKey)
+}
+
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
 func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger := r.log.WithValues("pod", req.Name, "namespace", req.Namespace)
@@ -215,6 +366,7 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 	pod, err := r.GetPod(ctx, r.client, req.NamespacedName)
 	if err != nil {
 		if kerrors.IsNotFound(err) {
+			r.forgetIgnoredPod(ctx, req.NamespacedName)
 			collErr := r.collectProfile(ctx, req.NamespacedName)
 			if errors.Is(collErr, errNameNotValid) {
 				logger.Error(collErr, "cannot collect profile")
@@ -231,8 +383,21 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 		return reconcile.Result{}, fmt.Errorf("cannot get pod: %w", err)
 	}
 
+	if uid, ok := r.podUIDs.Load(req.NamespacedName.String()); ok && uid != pod.UID {
+		// The pod got recreated with the same name before we were able to
+		// observe its removal, so collect the previous incarnation first.
+		logger.Info("Pod got recreated, collecting profiles of previous pod", "uid", uid)
+		collErr := r.collectProfile(ctx, req.NamespacedName)
+		if errors.Is(collErr, errNameNotValid) {
+			logger.Error(collErr, "cannot collect profile")
+			r.untrackPod(req.NamespacedName)
+		} else if collErr != nil {
+			return reconcile.Result{}, fmt.Errorf("collect profile for recreated pod: %w", collErr)
+		}
+	}
+
 	if pod.Status.Phase == corev1.PodPending {
-		if _, ok := r.podsToWatch.Load(req.NamespacedName.String()); ok {
+		if _, ok := r.podsToWatch.Load(pod.UID); ok {
 			// We're tracking this pod already
 			return reconcile.Result{}, nil
 		}
@@ -242,7 +407,7 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 			// Malformed annotations could be set by users directly, which is
 			// why we are ignoring them.
 			logger.Info("Ignoring because unable to parse log annotation", "error", err)
-			r.record.Event(pod, util.EventTypeWarning, reasonAnnotationParsing, err.Error())
+			r.ignorePod(ctx, pod, reasonAnnotationParsing, err.Error())
 			return reconcile.Result{}, nil
 		}
 
@@ -251,31 +416,81 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 			// Malformed annotations could be set by users directly, which is
 			// why we are ignoring them.
 			logger.Info("Ignoring because unable to parse bpf annotation", "error", err)
-			r.record.Event(pod, util.EventTypeWarning, reasonAnnotationParsing, err.Error())
+			r.ignorePod(ctx, pod, reasonAnnotationParsing, err.Error())
 			return reconcile.Result{}, nil
 		}
 
 		var (
-			profiles []profileToCollect
-			recorder profilerecording1alpha1.ProfileRecorder
+			profiles         []profileToCollect
+			fallbackProfiles []profileToCollect
+			recorder         profilerecording1alpha1.ProfileRecorder
+			enricherDisabled bool
+			bpfStarted       bool
+			snapshotInterval time.Duration
 		)
 
 		//nolint:gocritic // should be intentionally no switch
-		if len(logProfiles) > 0 {
-			profiles = logProfiles
+		if len(logProfiles) > 0 && len(bpfProfiles) > 0 {
+			// The pod got prepared for falling back to the logs recorder
+			recorder, err = r.availableRecorder(ctx, pod)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("determine available recorder: %w", err)
+			}
+			if recorder == profilerecording1alpha1.ProfileRecorderBpf {
+				bpfStarted = true
+				fallbackProfiles = logProfiles
+			}
+		} else if len(logProfiles) > 0 {
 			recorder = profilerecording1alpha1.ProfileRecorderLogs
 		} else if len(bpfProfiles) > 0 {
-			if err := r.startBpfRecorder(ctx); err != nil {
-				logger.Error(err, "unable to start bpf recorder")
-				return reconcile.Result{}, err
-			}
-			profiles = bpfProfiles
 			recorder = profilerecording1alpha1.ProfileRecorderBpf
 		} else {
 			logger.Info("No log or bpf annotations found on pod")
 			return reconcile.Result{}, nil
 		}
 
+		allowed, err := r.recorderAllowed(ctx, recorder)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("check if recorder is allowed: %w", err)
+		}
+		if !allowed {
+			// The pod got annotated before the recorder was disallowed.
+			logger.Info("Ignoring because the recorder is not allowed", "recorder", recorder)
+			r.ignorePod(ctx, pod, reasonRecorderNotAllowed, fmt.Sprintf(
+				"Not recording, because the %s recorder is not allowed by the SPOD configuration", recorder,
+			))
+			return reconcile.Result{}, nil
+		}
+
+		if recorder == profilerecording1alpha1.ProfileRecorderLogs {
+			enabled, err := r.logEnricherEnabled(ctx)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("check if log enricher is enabled: %w", err)
+			}
+			if !enabled {
+				// The enricher may still get enabled during the recording, so
+				// keep tracking the pod but warn the user about it.
+				logger.Info("Log enricher is not enabled, nothing gets recorded until it is enabled")
+				r.record.Event(pod, util.EventTypeWarning, reasonLogEnricherDisabled,
+					"Log enricher is not enabled, nothing gets recorded until it is enabled")
+				enricherDisabled = true
+			}
+			profiles = logProfiles
+
+			snapshotInterval, err = r.snapshotInterval(ctx, req.Namespace, profiles)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("get snapshot interval: %w", err)
+			}
+		} else {
+			if !bpfStarted {
+				if err := r.startBpfRecorder(ctx); err != nil {
+					logger.Error(err, "unable to start bpf recorder")
+					return reconcile.Result{}, err
+				}
+			}
+			profiles = bpfProfiles
+		}
+
 		for _, prf := range profiles {
 			logger.Info("Recording profile", "kind", prf.kind, "name", prf.name, "pod", req.NamespacedName.String())
 		}
@@ -283,15 +498,43 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 		// for pods managed by a replicated controller, let's store the replicated
 		// name so that we can later strip the suffix from the fully-generated pod name
 		baseName := req.NamespacedName
-		if pod.GenerateName != "" {
-			baseName.Name = pod.GenerateName
-		}
-
-		r.podsToWatch.Store(
-			req.NamespacedName.String(),
-			podToWatch{baseName, recorder, profiles},
+		baseName.Name = podBaseName(pod)
+
+		r.trackPod(
+			req.NamespacedName, pod.UID,
+			podToWatch{
+				baseName:         baseName,
+				recorder:         recorder,
+				profiles:         profiles,
+				runtimes:         detectRuntimes(pod),
+				enricherDisabled: enricherDisabled,
+				fallbackProfiles: fallbackProfiles,
+				snapshotInterval: snapshotInterval,
+				nextSnapshot:     time.Now().Add(snapshotInterval),
+				owner:            metav1.GetControllerOf(pod),
+				ownerName:        podOwnerName(pod),
+				images:           containerImages(pod),
+				initContainers:   initContainerNames(pod),
+			},
 		)
+		r.forgetIgnoredPod(ctx, req.NamespacedName)
 		r.record.Event(pod, util.EventTypeNormal, reasonProfileRecording, "Recording profiles")
+		r.reportRecordings(ctx, req.NamespacedName)
+		if p, ok := r.watchedPod(req.NamespacedName); ok {
+			r.reportPhase(ctx, req.Name, &p, profilerecording1alpha1.ProfileRecordingPhaseRecording)
+		}
+	}
+
+	if pod.Status.Phase == corev1.PodRunning {
+		if _, ok := r.watchedPod(req.NamespacedName); !ok {
+			// The recording would be incomplete, because the pod was not
+			// observed while its containers were created.
+			r.ignorePod(ctx, pod, reasonPodAlreadyRunning,
+				"Not recording, because the pod was already running when the profile recorder observed it")
+			return reconcile.Result{}, nil
+		}
+		r.trackEphemeralContainers(pod, req.NamespacedName)
+		return r.snapshotProfiles(ctx, pod, req.NamespacedName)
 	}
 
 	if pod.Status.Phase == corev1.PodSucceeded {
@@ -308,6 +551,94 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 	return reconcile.Result{}, nil
 }
 
+// snapshotInterval returns the shortest snapshot interval of the recordings
+// which the profiles belong to, or zero if none of them requests snapshots.
+func (r *RecorderReconciler) snapshotInterval(
+	ctx context.Context, namespace string, profiles []profileToCollect,
+) (time.Duration, error) {
+	var interval time.Duration
+	for _, prf := range profiles {
+		parsed, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			// Fails later on collection of the profile
+			continue
+		}
+
+		recording, err := r.GetRecording(
+			ctx, r.client, types.NamespacedName{Name: parsed.profileName, Namespace: namespace},
+		)
+		if err != nil {
+			if util.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return 0, fmt.Errorf("get recording: %w", err)
+		}
+
+		if recording.Spec.SnapshotInterval == nil || recording.Spec.SnapshotInterval.Duration <= 0 {
+			continue
+		}
+		recordingInterval := max(recording.Spec.SnapshotInterval.Duration, minSnapshotInterval)
+		if interval == 0 || recordingInterval < interval {
+			interval = recordingInterval
+		}
+	}
+	return interval, nil
+}
+
+// snapshotProfiles collects the profiles recorded so far from a running pod
+// if the snapshot interval elapsed, and requeues the pod for the next
+// snapshot. The recorded data is kept in the enricher, which means every
+// snapshot contains all syscalls recorded since the start of the pod.
+func (r *RecorderReconciler) snapshotProfiles(
+	ctx context.Context, pod *corev1.Pod, podName types.NamespacedName,
+) (reconcile.Result, error) {
+	p, ok := r.watchedPod(podName)
+	if !ok || p.snapshotInterval == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	if wait := time.Until(p.nextSnapshot); wait > 0 {
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	r.log.Info("Taking snapshot of recorded profiles", "pod", podName)
+	if _, err := r.collectLogProfiles(
+		ctx, p.naming(podName), podName, pod.UID,
+		expandEphemeralProfiles(p.profiles, p.ephemeralContainers), p.runtimes, p.enricherDisabled, true,
+	); err != nil {
+		return reconcile.Result{}, fmt.Errorf("snapshot log profiles: %w", err)
+	}
+
+	p.nextSnapshot = time.Now().Add(p.snapshotInterval)
+	r.trackPod(podName, pod.UID, p)
+	return reconcile.Result{RequeueAfter: p.snapshotInterval}, nil
+}
+
+// availableRecorder returns the bpf recorder if it is allowed and could be
+// started on this node, and the logs recorder otherwise.
+func (r *RecorderReconciler) availableRecorder(
+	ctx context.Context, pod *corev1.Pod,
+) (profilerecording1alpha1.ProfileRecorder, error) {
+	allowed, err := r.recorderAllowed(ctx, profilerecording1alpha1.ProfileRecorderBpf)
+	if err != nil {
+		return "", fmt.Errorf("check if recorder is allowed: %w", err)
+	}
+
+	reason := "the bpf recorder is not allowed by the SPOD configuration"
+	if allowed {
+		err := r.startBpfRecorder(ctx)
+		if err == nil {
+			return profilerecording1alpha1.ProfileRecorderBpf, nil
+		}
+		reason = fmt.Sprintf("the bpf recorder is not available: %v", err)
+	}
+
+	r.log.Info("Falling back to the logs recorder", "pod", pod.Name, "reason", reason)
+	r.record.Eventf(pod, util.EventTypeWarning, reasonRecorderFallback,
+		"Falling back to the logs recorder, because %s", reason)
+	return profilerecording1alpha1.ProfileRecorderLogs, nil
+}
+
 func (r *RecorderReconciler) getBpfRecorderClient(
 	ctx context.Context,
 ) (bpfrecorderapi.BpfRecorderClient, context.CancelFunc, error) {
@@ -325,6 +656,10 @@ func (r *RecorderReconciler) getBpfRecorderClient(
 	if !spod.Spec.EnableBpfRecorder && !enableBpfRecorderEnv {
 		return nil, nil, errors.New("bpf recorder is not enabled")
 	}
+	// The operator reports the effective feature gates in the SPOD status
+	if !features.Gates(spod.Status.FeatureGates).Enabled(features.BpfRecorder) {
+		return nil, nil, errors.New("bpf recorder is disabled by feature gate")
+	}
 
 	r.log.Info("Connecting to local GRPC bpf recorder server")
 	conn, cancel, err := r.DialBpfRecorder()
@@ -365,69 +700,246 @@ func (r *RecorderReconciler) stopBpfRecorder(ctx context.Context) error {
 func (r *RecorderReconciler) collectProfile(
 	ctx context.Context, podName types.NamespacedName,
 ) error {
-	n := podName.String()
-
-	value, ok := r.podsToWatch.Load(n)
+	value, ok := r.podUIDs.Load(podName.String())
 	if !ok {
 		return nil
 	}
 
-	podToWatch, ok := value.(podToWatch)
+	podUID, ok := value.(types.UID)
 	if !ok {
-		return errors.New("type assert pod to watch")
+		return errors.New("type assert pod UID")
 	}
 
-	replicaSuffix := ""
-	if podToWatch.baseName.Name != podName.Name && strings.HasPrefix(podName.Name, podToWatch.baseName.Name) {
-		// this is a replica, we need to strip the suffix from the pod name
-		replicaSuffix = strings.TrimPrefix(podName.Name, podToWatch.baseName.Name)
+	podToWatch, ok := r.watchedPod(podName)
+	if !ok {
+		return errors.New("type assert pod to watch")
 	}
 
+	naming := podToWatch.naming(podName)
+	profiles := expandEphemeralProfiles(podToWatch.profiles, podToWatch.ephemeralContainers)
+
+	var (
+		collected []string
+		err       error
+	)
 	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderLogs {
-		if err := r.collectLogProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
+		if collected, err = r.collectLogProfiles(
+			ctx, naming, podName, podUID, profiles, podToWatch.runtimes, podToWatch.enricherDisabled, false,
 		); err != nil {
+			r.reportPhase(ctx, podName.Name, &podToWatch, profilerecording1alpha1.ProfileRecordingPhaseFailed)
 			return fmt.Errorf("collect log profile: %w", err)
 		}
 	}
 
 	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderBpf {
-		if err := r.collectBpfProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
+		if collected, err = r.collectBpfProfiles(
+			ctx, naming, podName, podUID, profiles, podToWatch.runtimes,
 		); err != nil {
+			r.reportPhase(ctx, podName.Name, &podToWatch, profilerecording1alpha1.ProfileRecordingPhaseFailed)
 			return fmt.Errorf("collect bpf profile: %w", err)
 		}
+		r.resetFallbackProfiles(
+			ctx, expandEphemeralProfiles(podToWatch.fallbackProfiles, podToWatch.ephemeralContainers),
+		)
+	}
+
+	if err := r.annotateRecordedProfiles(ctx, podName, podUID, podToWatch.owner, collected); err != nil {
+		// The profiles got collected, so the pod should not be tracked anymore.
+		r.log.Error(err, "Cannot annotate the recorded profiles", "pod", podName)
 	}
 
-	r.podsToWatch.Delete(n)
+	r.reportPhase(ctx, podName.Name, &podToWatch, profilerecording1alpha1.ProfileRecordingPhaseCollected)
+	r.untrackPod(podName)
 	return nil
 }
 
+// podBaseName returns the name shared by all pods of the workload controlling
+// the pod. Custom workloads like Argo Workflows or Tekton TaskRuns often set
+// the pod name explicitly instead of using a generated one, so the name of the
+// controller is used if it prefixes the pod name.
+func podBaseName(pod *corev1.Pod) string {
+	if pod.GenerateName != "" {
+		return pod.GenerateName
+	}
+	if owner := metav1.GetControllerOf(pod); owner != nil &&
+		strings.HasPrefix(pod.Name, owner.Name+"-") {
+		return owner.Name + "-"
+	}
+	return pod.Name
+}
+
+// podOwnerName returns the name of the workload controlling the pod. Pods
+// of a deployment are controlled by a replica set, whose name is the name of
+// the deployment suffixed by the pod template hash.
+func podOwnerName(pod *corev1.Pod) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return pod.Name
+	}
+	if hash := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; owner.Kind == "ReplicaSet" && hash != "" {
+		return strings.TrimSuffix(owner.Name, "-"+hash)
+	}
+	return owner.Name
+}
+
+// containerImages returns the images of all containers of the pod per
+// container name.
+func containerImages(pod *corev1.Pod) map[string]string {
+	images := map[string]string{}
+	for i := range pod.Spec.InitContainers {
+		images[pod.Spec.InitContainers[i].Name] = pod.Spec.InitContainers[i].Image
+	}
+	for i := range pod.Spec.Containers {
+		images[pod.Spec.Containers[i].Name] = pod.Spec.Containers[i].Image
+	}
+	for i := range pod.Spec.EphemeralContainers {
+		images[pod.Spec.EphemeralContainers[i].Name] = pod.Spec.EphemeralContainers[i].Image
+	}
+	return images
+}
+
+// initContainerNames returns the names of the init containers of the pod.
+func initContainerNames(pod *corev1.Pod) sets.Set[string] {
+	names := sets.New[string]()
+	for i := range pod.Spec.InitContainers {
+		names.Insert(pod.Spec.InitContainers[i].Name)
+	}
+	return names
+}
+
+// replicaSuffix returns the suffix of the pod name of a replica, which has to
+// be stripped from the generated pod name.
+func replicaSuffix(podName, baseName types.NamespacedName) string {
+	if baseName.Name != podName.Name && strings.HasPrefix(podName.Name, baseName.Name) {
+		return strings.TrimPrefix(podName.Name, baseName.Name)
+	}
+	return ""
+}
+
+// trackPod starts watching the pod incarnation with the provided UID.
+func (r *RecorderReconciler) trackPod(podName types.NamespacedName, podUID types.UID, pod podToWatch) {
+	r.podsToWatch.Store(podUID, pod)
+	r.podUIDs.Store(podName.String(), podUID)
+}
+
+// untrackPod stops watching the currently tracked incarnation of a pod.
+func (r *RecorderReconciler) untrackPod(podName types.NamespacedName) {
+	if uid, ok := r.podUIDs.LoadAndDelete(podName.String()); ok {
+		r.podsToWatch.Delete(uid)
+	}
+}
+
+// watchedPod returns the currently tracked incarnation of a pod.
+func (r *RecorderReconciler) watchedPod(podName types.NamespacedName) (podToWatch, bool) {
+	uid, ok := r.podUIDs.Load(podName.String())
+	if !ok {
+		return podToWatch{}, false
+	}
+
+	value, ok := r.podsToWatch.Load(uid)
+	if !ok {
+		return podToWatch{}, false
+	}
+
+	pod, ok := value.(podToWatch)
+	return pod, ok
+}
+
+// trackEphemeralContainers stores the names of the ephemeral containers of a
+// watched pod, which can be added at any time during the lifetime of the pod.
+func (r *RecorderReconciler) trackEphemeralContainers(pod *corev1.Pod, podName types.NamespacedName) {
+	podToWatch, ok := r.watchedPod(podName)
+	if !ok || len(podToWatch.ephemeralContainers) == len(pod.Spec.EphemeralContainers) {
+		return
+	}
+
+	podToWatch.ephemeralContainers = make([]string, 0, len(pod.Spec.EphemeralContainers))
+	for i := range pod.Spec.EphemeralContainers {
+		podToWatch.ephemeralContainers = append(
+			podToWatch.ephemeralContainers, pod.Spec.EphemeralContainers[i].Name,
+		)
+	}
+	podToWatch.images = containerImages(pod)
+	r.trackPod(podName, pod.UID, podToWatch)
+}
+
+// expandEphemeralProfiles replaces the profiles recorded for all ephemeral
+// containers by a profile for every ephemeral container of the pod.
+func expandEphemeralProfiles(profiles []profileToCollect, ephemeralContainers []string) []profileToCollect {
+	res := make([]profileToCollect, 0, len(profiles))
+	for _, prf := range profiles {
+		parsed, err := parseProfileAnnotation(prf.name)
+		if err != nil || parsed.cntName != config.EphemeralContainersRecordName {
+			res = append(res, prf)
+			continue
+		}
+
+		for _, ctr := range ephemeralContainers {
+			res = append(res, profileToCollect{
+				kind: prf.kind,
+				name: util.EphemeralContainerProfile(prf.name, ctr),
+			})
+		}
+	}
+	return res
+}
+
+// resetFallbackProfiles removes the syscalls recorded by the log enricher for
+// profiles which got recorded by the bpf recorder instead. Failures are only
+// logged, because the profiles got collected already.
+func (r *RecorderReconciler) resetFallbackProfiles(ctx context.Context, profiles []profileToCollect) {
+	if len(profiles) == 0 {
+		return
+	}
+
+	enabled, err := r.logEnricherEnabled(ctx)
+	if err != nil || !enabled {
+		return
+	}
+
+	conn, cancel, err := r.DialEnricher()
+	if err != nil {
+		r.log.Error(err, "Cannot connect to local GRPC enricher server")
+		return
+	}
+	defer cancel()
+	enricherClient := enricherapi.NewEnricherClient(conn)
+
+	for _, prf := range profiles {
+		request := &enricherapi.SyscallsRequest{Profile: prf.name}
+		if err := r.ResetSyscalls(ctx, enricherClient, request); err != nil {
+			r.log.Error(err, "Cannot reset syscalls of fallback profile", "profile", prf.name)
+		}
+	}
+}
+
 func (r *RecorderReconciler) collectLogProfiles(
 	ctx context.Context,
-	replicaSuffix string,
+	naming profileNaming,
 	podName types.NamespacedName,
+	podUID types.UID,
 	profiles []profileToCollect,
-) error {
+	runtimes map[string]languageRuntime,
+	enricherDisabledAtStart bool,
+	snapshot bool,
+) (collected []string, err error) {
 	r.log.Info("Checking if enricher is enabled")
 
-	spod, err := r.getSPOD(ctx)
-	if err != nil {
-		return fmt.Errorf("getting SPOD config: %w", err)
-	}
-
-	enableLogEnricherEnv, err := strconv.ParseBool(os.Getenv(config.EnableLogEnricherEnvKey))
+	enabled, err := r.logEnricherEnabled(ctx)
 	if err != nil {
-		enableLogEnricherEnv = false
+		return nil, fmt.Errorf("check if log enricher is enabled: %w", err)
 	}
-	if !spod.Spec.EnableLogEnricher && !enableLogEnricherEnv {
-		return errors.New("log enricher not enabled")
+	if !enabled {
+		// Nothing can be retrieved without the enricher, retrying would
+		// only keep the pod tracked forever.
+		r.log.Info("Log enricher not enabled, skipping profile collection", "pod", podName)
+		return nil, nil
 	}
 
 	r.log.Info("Connecting to local GRPC enricher server")
 	conn, cancel, err := r.DialEnricher()
 	if err != nil {
-		return fmt.Errorf("connecting to local GRPC server: %w", err)
+		return nil, fmt.Errorf("connecting to local GRPC server: %w", err)
 	}
 	defer cancel()
 	enricherClient := enricherapi.NewEnricherClient(conn)
@@ -435,30 +947,53 @@ func (r *RecorderReconciler) collectLogProfiles(
 	for _, prf := range profiles {
 		parsedProfileAnnotation, err := parseProfileAnnotation(prf.name)
 		if err != nil {
-			return fmt.Errorf("parse profile raw annotation: %w", err)
+			return nil, fmt.Errorf("parse profile raw annotation: %w", err)
 		}
 
-		profileNamespacedName := createProfileName(
-			parsedProfileAnnotation.cntName, replicaSuffix,
-			podName.Namespace, parsedProfileAnnotation.profileName)
+		profileNamespacedName, renderedName, err := r.profileName(
+			ctx, parsedProfileAnnotation, naming, podName.Namespace,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-		r.log.Info("Collecting profile", "name", profileNamespacedName, "kind", prf.kind)
+		source := r.recordingSource(parsedProfileAnnotation, podName.Name)
+		r.log.Info("Collecting profile",
+			"name", profileNamespacedName, "kind", prf.kind, "recordingSession", source.Session)
 
+		var name string
 		switch prf.kind {
 		case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
-			err = r.collectLogSeccompProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
+			name, err = r.collectLogSeccompProfile(
+				ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, renderedName, podUID, prf.name,
+				runtimes[parsedProfileAnnotation.cntName], source, snapshot,
+			)
 		case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
-			err = r.collectLogSelinuxProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
+			name, err = r.collectLogSelinuxProfile(
+				ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, renderedName, podUID, prf.name,
+				source, snapshot,
+			)
 		default:
 			err = fmt.Errorf("unrecognized kind %s", prf.kind)
 		}
 
+		if err != nil && enricherDisabledAtStart && !errors.Is(err, errNameNotValid) {
+			// The enricher got enabled during the recording, so it may have
+			// missed the start of some containers. Collect whatever got
+			// recorded instead of failing the whole collection.
+			r.log.Error(err, "Cannot collect partially recorded profile", "name", profileNamespacedName)
+			continue
+		}
+
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if name != "" {
+			collected = append(collected, recordedProfileRef(prf.kind, name))
 		}
 	}
 
-	return nil
+	return collected, nil
 }
 
 func (r *RecorderReconciler) collectLogSeccompProfile(
@@ -466,44 +1001,55 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 	enricherClient enricherapi.EnricherClient,
 	parsedProfileName *parsedAnnotation,
 	profileNamespacedName types.NamespacedName,
+	renderedName string,
+	podUID types.UID,
 	profileID string,
-) error {
-	labels, err := profileLabels(
-		ctx,
-		r,
-		parsedProfileName.profileName,
-		parsedProfileName.cntName,
-		profileNamespacedName.Namespace)
+	langRuntime languageRuntime,
+	source util.RecordingSource,
+	snapshot bool,
+) (string, error) {
+	labels, err := profileLabels(ctx, r, parsedProfileName, profileNamespacedName.Namespace)
 	if err != nil {
-		return fmt.Errorf("creating profile labels: %w", err)
+		return "", fmt.Errorf("creating profile labels: %w", err)
 	}
+	profileNamespacedName = partialProfileName(profileNamespacedName, labels, podUID)
 
 	// Do this BEFORE reading the syscalls to hopefully minimize the
 	// race window in case reading the syscalls failed. In that case we just reconcile
 	// back here and loop through again
 	err = r.setRecordingFinalizers(ctx, labels, parsedProfileName.profileName, profileNamespacedName.Namespace)
 	if err != nil {
-		return fmt.Errorf("setting finalizer on profilerecording: %w", err)
+		return "", fmt.Errorf("setting finalizer on profilerecording: %w", err)
 	}
 
-	// Retrieve the syscalls for the recording
+	// Retrieve the syscalls for the recording. Snapshots keep them in the
+	// enricher, while the final collection takes them atomically and
+	// acknowledges them after the profile got created.
 	request := &enricherapi.SyscallsRequest{Profile: profileID}
-	response, err := r.Syscalls(ctx, enricherClient, request)
+	var response *enricherapi.SyscallsResponse
+	if snapshot {
+		response, err = r.Syscalls(ctx, enricherClient, request)
+	} else {
+		response, err = r.CollectSyscalls(ctx, enricherClient, request)
+	}
 	if err != nil {
 		if grpcstatus.Convert(err).Code() == grpccodes.NotFound &&
 			grpcstatus.Convert(err).Message() == enricher.ErrorNoSyscalls {
+			if snapshot {
+				return "", nil
+			}
 			if err := r.ResetSyscalls(ctx, enricherClient, request); err != nil {
-				return fmt.Errorf("reset syscalls for profile %s: %w", profileNamespacedName, err)
+				return "", fmt.Errorf("reset syscalls for profile %s: %w", profileNamespacedName, err)
 			}
 			r.log.Info("No syscalls found, resetting profile", "profileID", profileID)
-			return nil
+			return "", nil
 		}
-		return fmt.Errorf("retrieve syscalls for profile %s: %w", profileID, err)
+		return "", fmt.Errorf("retrieve syscalls for profile %s: %w", profileID, err)
 	}
 
 	arch, err := r.goArchToSeccompArch(response.GoArch)
 	if err != nil {
-		return fmt.Errorf("get seccomp arch: %w", err)
+		return "", fmt.Errorf("get seccomp arch: %w", err)
 	}
 
 	profileSpec := seccompprofileapi.SeccompProfileSpec{
@@ -517,9 +1063,10 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 
 	profile := &seccompprofileapi.SeccompProfile{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      profileNamespacedName.Name,
-			Namespace: profileNamespacedName.Namespace,
-			Labels:    labels,
+			Name:        profileNamespacedName.Name,
+			Namespace:   profileNamespacedName.Namespace,
+			Labels:      labels,
+			Annotations: partialProfileAnnotations(labels, renderedName),
 		},
 		Spec: profileSpec,
 	}
@@ -529,30 +1076,79 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 		&profileSpec.SpecBase); err != nil {
 		r.log.Error(err, "Cannot set the enabled flag")
 		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
-		return fmt.Errorf("format selinuxprofile resource: %w", err)
+		return "", fmt.Errorf("format selinuxprofile resource: %w", err)
+	}
+
+	if err := r.setBaseline(ctx, r.client,
+		parsedProfileName, profileNamespacedName.Namespace,
+		langRuntime, &profileSpec); err != nil {
+		r.log.Error(err, "Cannot seed the runtime baseline")
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+		return "", fmt.Errorf("seed runtime baseline: %w", err)
+	}
+
+	if err := r.setActions(ctx, r.client,
+		parsedProfileName.profileName, profileNamespacedName.Namespace,
+		&profileSpec); err != nil {
+		r.log.Error(err, "Cannot set the profile actions")
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+		return "", fmt.Errorf("set profile actions: %w", err)
+	}
+
+	if err := r.setBaseProfile(ctx, r.client,
+		parsedProfileName.profileName, profileNamespacedName.Namespace,
+		&profileSpec); err != nil {
+		r.log.Error(err, "Cannot subtract the base profile")
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+		return "", fmt.Errorf("subtract base profile: %w", err)
 	}
 
+	if err := r.setSyscallGrouping(ctx, r.client,
+		parsedProfileName.profileName, profileNamespacedName.Namespace,
+		&profileSpec); err != nil {
+		r.log.Error(err, "Cannot group the recorded syscalls")
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+		return "", fmt.Errorf("group syscalls: %w", err)
+	}
+
+	executables := syscallExecutables(response.GetExecutables())
+
 	res, err := r.CreateOrUpdate(ctx, r.client, profile,
 		func() error {
 			profile.Spec = profileSpec
-			return nil
+			if len(executables) > 0 {
+				metav1.SetMetaDataAnnotation(
+					&profile.ObjectMeta,
+					profilerecording1alpha1.ProfileSyscallExecutablesAnnotation,
+					executables.String(),
+				)
+			}
+			return addRecordingSource(&profile.ObjectMeta, source)
 		},
 	)
 	if err != nil {
 		r.log.Error(err, "Cannot create seccompprofile resource")
 		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
-		return fmt.Errorf("create seccompProfile resource: %w", err)
+		return "", fmt.Errorf("create seccompProfile resource: %w", err)
 	}
 
 	r.log.Info("Created/updated profile", "action", res, "name", profileNamespacedName.Name)
-	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "seccomp profile created")
+	r.record.Eventf(profile, util.EventTypeNormal, reasonProfileCreated,
+		"seccomp profile created with %d recorded syscalls", len(response.GetSyscalls()))
 
-	// Reset the syscalls for further recordings
+	if snapshot {
+		// Keep the syscalls for the next snapshot
+		return profileNamespacedName.Name, nil
+	}
+
+	// Acknowledge the collected syscalls. If this fails, then the next
+	// attempt collects them again together with the ones recorded since.
+	request.Generation = response.GetGeneration()
 	if err := r.ResetSyscalls(ctx, enricherClient, request); err != nil {
-		return fmt.Errorf("reset syscalls for profile %s: %w", profileID, err)
+		return "", fmt.Errorf("reset syscalls for profile %s: %w", profileID, err)
 	}
 
-	return nil
+	return profileNamespacedName.Name, nil
 }
 
 func (r *RecorderReconciler) collectLogSelinuxProfile(
@@ -560,39 +1156,47 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 	enricherClient enricherapi.EnricherClient,
 	parsedProfileName *parsedAnnotation,
 	profileNamespacedName types.NamespacedName,
+	renderedName string,
+	podUID types.UID,
 	profileID string,
-) error {
-	labels, err := profileLabels(
-		ctx,
-		r,
-		parsedProfileName.profileName,
-		parsedProfileName.cntName,
-		profileNamespacedName.Namespace)
+	source util.RecordingSource,
+	snapshot bool,
+) (string, error) {
+	labels, err := profileLabels(ctx, r, parsedProfileName, profileNamespacedName.Namespace)
 	if err != nil {
-		return fmt.Errorf("creating profile labels: %w", err)
+		return "", fmt.Errorf("creating profile labels: %w", err)
 	}
+	profileNamespacedName = partialProfileName(profileNamespacedName, labels, podUID)
 
 	// Do this BEFORE reading the syscalls to hopefully minimize the
 	// race window in case reading the syscalls failed. In that case we just reconcile
 	// back here and loop through again
 	err = r.setRecordingFinalizers(ctx, labels, parsedProfileName.profileName, profileNamespacedName.Namespace)
 	if err != nil {
-		return fmt.Errorf("setting finalizer on profilerecording: %w", err)
+		return "", fmt.Errorf("setting finalizer on profilerecording: %w", err)
 	}
 
-	// Retrieve the syscalls for the recording
+	// Retrieve the AVCs for the recording, see collectLogSeccompProfile
 	request := &enricherapi.AvcRequest{Profile: profileID}
-	response, err := r.Avcs(ctx, enricherClient, request)
+	var response *enricherapi.AvcResponse
+	if snapshot {
+		response, err = r.Avcs(ctx, enricherClient, request)
+	} else {
+		response, err = r.CollectAvcs(ctx, enricherClient, request)
+	}
 	if err != nil {
 		if grpcstatus.Convert(err).Code() == grpccodes.NotFound &&
 			grpcstatus.Convert(err).Message() == enricher.ErrorNoAvcs {
+			if snapshot {
+				return "", nil
+			}
 			if err := r.ResetAvcs(ctx, enricherClient, request); err != nil {
-				return fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
+				return "", fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
 			}
 			r.log.Info("No AVCs found, resetting profile", "profileID", profileID)
-			return nil
+			return "", nil
 		}
-		return fmt.Errorf("retrieve avcs for profile %s: %w", profileID, err)
+		return "", fmt.Errorf("retrieve avcs for profile %s: %w", profileID, err)
 	}
 
 	selinuxProfileSpec := selxv1alpha2.SelinuxProfileSpec{
@@ -606,9 +1210,10 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 
 	profile := &selxv1alpha2.SelinuxProfile{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      profileNamespacedName.Name,
-			Namespace: profileNamespacedName.Namespace,
-			Labels:    labels,
+			Name:        profileNamespacedName.Name,
+			Namespace:   profileNamespacedName.Namespace,
+			Labels:      labels,
+			Annotations: partialProfileAnnotations(labels, renderedName),
 		},
 		Spec: selinuxProfileSpec,
 	}
@@ -617,7 +1222,7 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 	if err != nil {
 		r.log.Error(err, "Cannot format selinuxprofile")
 		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
-		return fmt.Errorf("format selinuxprofile resource: %w", err)
+		return "", fmt.Errorf("format selinuxprofile resource: %w", err)
 	}
 	r.log.Info("Created", "profile", profile)
 
@@ -626,36 +1231,51 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 		&selinuxProfileSpec.SpecBase); err != nil {
 		r.log.Error(err, "Cannot set the enabled flag")
 		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
-		return fmt.Errorf("format selinuxprofile resource: %w", err)
+		return "", fmt.Errorf("format selinuxprofile resource: %w", err)
+	}
+
+	if err := r.setPermissive(ctx, r.client,
+		parsedProfileName.profileName, profileNamespacedName.Namespace,
+		&selinuxProfileSpec); err != nil {
+		r.log.Error(err, "Cannot set the permissive flag")
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+		return "", fmt.Errorf("format selinuxprofile resource: %w", err)
 	}
 
 	res, err := r.CreateOrUpdate(ctx, r.client, profile,
 		func() error {
 			profile.Spec = selinuxProfileSpec
-			return nil
+			return addRecordingSource(&profile.ObjectMeta, source)
 		},
 	)
 	if err != nil {
 		r.log.Error(err, "Cannot create selinuxprofile resource")
 		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
-		return fmt.Errorf("create selinuxprofile resource: %w", err)
+		return "", fmt.Errorf("create selinuxprofile resource: %w", err)
 	}
 	r.log.Info("Created/updated selinux profile", "action", res, "name", profileNamespacedName)
-	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "selinuxprofile profile created")
+	r.record.Eventf(profile, util.EventTypeNormal, reasonProfileCreated,
+		"selinuxprofile profile created with %d recorded AVCs", len(response.GetAvc()))
 
-	// Reset the selinuxprofile for further recordings
+	if snapshot {
+		// Keep the AVCs for the next snapshot
+		return profileNamespacedName.Name, nil
+	}
+
+	// Acknowledge the collected AVCs for further recordings
+	request.Generation = response.GetGeneration()
 	if err := r.ResetAvcs(ctx, enricherClient, request); err != nil {
-		return fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
+		return "", fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
 	}
 
-	return nil
+	return profileNamespacedName.Name, nil
 }
 
 func (r *RecorderReconciler) formatSelinuxProfile(
 	selinuxprofile *selxv1alpha2.SelinuxProfile,
 	avcResponse *enricherapi.AvcResponse,
 ) (selxv1alpha2.Allow, error) {
-	seBuilder := newSeProfileBuilder(selinuxprofile.GetPolicyUsage(), r.log)
+	seBuilder := newSeProfileBuilder(selinuxprofile.GetPolicyUsage(), avcResponse.GetScontext(), r.log)
 
 	if err := seBuilder.AddAvcList(avcResponse.GetAvc()); err != nil {
 		return nil, fmt.Errorf("consuming AVCs: %w", err)
@@ -666,50 +1286,60 @@ func (r *RecorderReconciler) formatSelinuxProfile(
 		return nil, fmt.Errorf("building policy: %w", err)
 	}
 
+	// Verify the resulting policy before it gets installed on the nodes
+	validated := selinuxprofile.DeepCopy()
+	validated.Spec.Allow = sePol
+	if err := translator.ValidateCIL(translator.Object2CIL(nil, nil, validated)); err != nil {
+		return nil, fmt.Errorf("validating CIL: %w", err)
+	}
+
 	return sePol, nil
 }
 
 func (r *RecorderReconciler) collectBpfProfiles(
 	ctx context.Context,
-	replicaSuffix string,
+	naming profileNaming,
 	podName types.NamespacedName,
+	podUID types.UID,
 	profiles []profileToCollect,
-) error {
+	runtimes map[string]languageRuntime,
+) (collected []string, err error) {
 	recorderClient, cancel, err := r.getBpfRecorderClient(ctx)
 	if err != nil {
-		return fmt.Errorf("get bpf recorder client: %w", err)
+		return nil, fmt.Errorf("get bpf recorder client: %w", err)
 	}
 	defer cancel()
 
 	for _, profile := range profiles {
 		parsedProfileName, err := parseProfileAnnotation(profile.name)
 		if err != nil {
-			return fmt.Errorf("parse profile raw annotation: %w", err)
+			return nil, fmt.Errorf("parse profile raw annotation: %w", err)
 		}
 
-		profileNamespacedName := createProfileName(
-			parsedProfileName.cntName, replicaSuffix,
-			podName.Namespace, parsedProfileName.profileName)
+		profileNamespacedName, renderedName, err := r.profileName(
+			ctx, parsedProfileName, naming, podName.Namespace,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-		labels, err := profileLabels(
-			ctx,
-			r,
-			parsedProfileName.profileName,
-			parsedProfileName.cntName,
-			profileNamespacedName.Namespace)
+		labels, err := profileLabels(ctx, r, parsedProfileName, profileNamespacedName.Namespace)
 		if err != nil {
-			return fmt.Errorf("creating profile labels: %w", err)
+			return nil, fmt.Errorf("creating profile labels: %w", err)
 		}
+		profileNamespacedName = partialProfileName(profileNamespacedName, labels, podUID)
 
 		// Do this BEFORE reading the syscalls to hopefully minimize the
 		// race window in case reading the syscalls failed. In that case we just reconcile
 		// back here and loop through again
 		err = r.setRecordingFinalizers(ctx, labels, parsedProfileName.profileName, profileNamespacedName.Namespace)
 		if err != nil {
-			return fmt.Errorf("setting finalizer on profilerecording: %w", err)
+			return nil, fmt.Errorf("setting finalizer on profilerecording: %w", err)
 		}
 
-		r.log.Info("Collecting BPF profile", "name", profile.name, "kind", profile.kind)
+		source := r.recordingSource(parsedProfileName, podName.Name)
+		r.log.Info("Collecting BPF profile",
+			"name", profile.name, "kind", profile.kind, "recordingSession", source.Session)
 		response, err := r.SyscallsForProfile(
 			ctx, recorderClient, &bpfrecorderapi.ProfileRequest{Name: profile.name},
 		)
@@ -721,12 +1351,12 @@ func (r *RecorderReconciler) collectBpfProfiles(
 				r.log.Error(err, "Recorded profile not found", "name", profile.name)
 				continue
 			}
-			return fmt.Errorf("get syscalls for profile: %w", err)
+			return nil, fmt.Errorf("get syscalls for profile: %w", err)
 		}
 
 		arch, err := r.goArchToSeccompArch(response.GoArch)
 		if err != nil {
-			return fmt.Errorf("get seccomp arch: %w", err)
+			return nil, fmt.Errorf("get seccomp arch: %w", err)
 		}
 
 		profileSpec := seccompprofileapi.SeccompProfileSpec{
@@ -740,9 +1370,10 @@ func (r *RecorderReconciler) collectBpfProfiles(
 
 		profile := &seccompprofileapi.SeccompProfile{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      profileNamespacedName.Name,
-				Namespace: profileNamespacedName.Namespace,
-				Labels:    labels,
+				Name:        profileNamespacedName.Name,
+				Namespace:   profileNamespacedName.Namespace,
+				Labels:      labels,
+				Annotations: partialProfileAnnotations(labels, renderedName),
 			},
 			Spec: profileSpec,
 		}
@@ -752,58 +1383,164 @@ func (r *RecorderReconciler) collectBpfProfiles(
 			&profileSpec.SpecBase); err != nil {
 			r.log.Error(err, "Cannot set the enabled flag")
 			r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
-			return fmt.Errorf("format selinuxprofile resource: %w", err)
+			return nil, fmt.Errorf("format selinuxprofile resource: %w", err)
+		}
+
+		if err := r.setBaseline(ctx, r.client,
+			parsedProfileName, profileNamespacedName.Namespace,
+			runtimes[parsedProfileName.cntName], &profileSpec); err != nil {
+			r.log.Error(err, "Cannot seed the runtime baseline")
+			r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+			return nil, fmt.Errorf("seed runtime baseline: %w", err)
+		}
+
+		if err := r.setActions(ctx, r.client,
+			parsedProfileName.profileName, profileNamespacedName.Namespace,
+			&profileSpec); err != nil {
+			r.log.Error(err, "Cannot set the profile actions")
+			r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+			return nil, fmt.Errorf("set profile actions: %w", err)
+		}
+
+		if err := r.setBaseProfile(ctx, r.client,
+			parsedProfileName.profileName, profileNamespacedName.Namespace,
+			&profileSpec); err != nil {
+			r.log.Error(err, "Cannot subtract the base profile")
+			r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+			return nil, fmt.Errorf("subtract base profile: %w", err)
+		}
+
+		if err := r.setSyscallGrouping(ctx, r.client,
+			parsedProfileName.profileName, profileNamespacedName.Namespace,
+			&profileSpec); err != nil {
+			r.log.Error(err, "Cannot group the recorded syscalls")
+			r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
+			return nil, fmt.Errorf("group syscalls: %w", err)
 		}
 
 		res, err := r.CreateOrUpdate(ctx, r.client, profile,
 			func() error {
 				profile.Spec = profileSpec
-				return nil
+				return addRecordingSource(&profile.ObjectMeta, source)
 			},
 		)
 		if err != nil {
 			r.log.Error(err, "Cannot create seccompprofile resource")
 			r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
-			return fmt.Errorf("create seccompProfile resource: %w", err)
+			return nil, fmt.Errorf("create seccompProfile resource: %w", err)
 		}
 
 		r.log.Info("Created/updated profile", "action", res, "name", profileNamespacedName)
-		r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "seccomp profile created")
+		r.record.Eventf(profile, util.EventTypeNormal, reasonProfileCreated,
+			"seccomp profile created with %d recorded syscalls", len(response.GetSyscalls()))
+		collected = append(collected, recordedProfileRef(
+			profilerecording1alpha1.ProfileRecordingKindSeccompProfile, profileNamespacedName.Name,
+		))
 	}
 
 	if err := r.stopBpfRecorder(ctx); err != nil {
 		r.log.Error(err, "Unable to stop bpf recorder")
-		return fmt.Errorf("stop bpf recorder: %w", err)
+		return nil, fmt.Errorf("stop bpf recorder: %w", err)
 	}
-	return nil
+	return collected, nil
 }
 
 type parsedAnnotation struct {
 	profileName string
 	cntName     string
-	nonce       string
-	timestamp   string
+	session     string
+	timestamp   int64
+	// mergeStrategy and runtimeBaseline are only carried by version 2
+	// annotations, the recording gets looked up otherwise.
+	mergeStrategy   profilerecording1alpha1.ProfileMergeStrategy
+	runtimeBaseline *bool
 }
 
 func parseProfileAnnotation(annotation string) (*parsedAnnotation, error) {
-	const expectedParts = 4
-
-	parts := strings.Split(annotation, "_")
-	if len(parts) != expectedParts {
-		return nil,
-			fmt.Errorf("invalid annotation: %s, expected %d parts got %d", annotation, expectedParts, len(parts))
+	payload, err := profilerecording1alpha1.ParseCtrAnnotation(annotation)
+	if err != nil {
+		return nil, fmt.Errorf("parse recording annotation: %w", err)
 	}
 
 	return &parsedAnnotation{
-		profileName: parts[0],
-		cntName:     parts[1],
-		nonce:       parts[2], // unused for now, but we might need it in the future
-		timestamp:   parts[3], // unused for now, but let's keep it for future use
+		profileName:     payload.Recording,
+		cntName:         payload.Container,
+		session:         payload.Session,
+		timestamp:       payload.Timestamp,
+		mergeStrategy:   payload.MergeStrategy,
+		runtimeBaseline: payload.RuntimeBaseline,
 	}, nil
 }
 
-func createProfileName(cntName, replicaSuffix, namespace, profileName string) types.NamespacedName {
-	name := fmt.Sprintf("%s-%s", profileName, cntName)
+// recordingSource returns the source of a profile recorded for the pod, whose
+// time window starts when the pod got annotated for recording and ends now.
+func (r *RecorderReconciler) recordingSource(parsed *parsedAnnotation, podName string) util.RecordingSource {
+	source := util.RecordingSource{
+		Session: parsed.session,
+		Node:    r.nodeName,
+		Pod:     podName,
+		End:     time.Now().UTC().Truncate(time.Second),
+	}
+	if parsed.timestamp > 0 {
+		source.Start = time.Unix(parsed.timestamp, 0).UTC()
+	}
+	return source
+}
+
+// addRecordingSource adds the source to the sources of the recorded profile.
+func addRecordingSource(obj *metav1.ObjectMeta, source util.RecordingSource) error {
+	sources, err := util.RecordingSourcesOf(obj)
+	if err != nil {
+		return fmt.Errorf("get recording sources: %w", err)
+	}
+	sources.Insert(source)
+	util.SetRecordingSources(obj, sources)
+	return nil
+}
+
+// profileName returns the name of the profile recorded for a container. The
+// base name of the profile is returned as well if it cannot be derived from
+// the recording and container name, which is the case for names rendered
+// from the profile name template of the recording and for init containers.
+func (r *RecorderReconciler) profileName(
+	ctx context.Context, parsed *parsedAnnotation, naming profileNaming, namespace string,
+) (name types.NamespacedName, rendered string, err error) {
+	container := parsed.cntName
+	if naming.initContainers.Has(container) {
+		// Init containers usually require more syscalls than the other
+		// containers, which is why they are recorded separately.
+		container = initContainerPrefix + container
+	}
+
+	recording, err := r.GetRecording(
+		ctx, r.client, types.NamespacedName{Name: parsed.profileName, Namespace: namespace},
+	)
+	if util.IgnoreNotFound(err) != nil {
+		return name, "", fmt.Errorf("get recording: %w", err)
+	}
+	if err != nil || recording == nil || recording.Spec.ProfileNameTemplate == "" {
+		baseName := fmt.Sprintf("%s-%s", parsed.profileName, container)
+		if container != parsed.cntName {
+			rendered = baseName
+		}
+		return createProfileName(baseName, naming.replicaSuffix, namespace), rendered, nil
+	}
+
+	rendered, err = recording.ProfileName(&profilerecording1alpha1.ProfileNameData{
+		Container: container,
+		Owner:     naming.owner,
+		Image:     naming.images[parsed.cntName],
+		Time:      time.Now(),
+	})
+	if err != nil {
+		r.record.Event(recording, util.EventTypeWarning, reasonProfileNameInvalid, err.Error())
+		return name, "", fmt.Errorf("%w: %w", errNameNotValid, err)
+	}
+	return createProfileName(rendered, naming.replicaSuffix, namespace), rendered, nil
+}
+
+func createProfileName(baseName, replicaSuffix, namespace string) types.NamespacedName {
+	name := baseName
 	if replicaSuffix != "" {
 		name = fmt.Sprintf("%s-%s", name, replicaSuffix)
 	}
@@ -814,6 +1551,35 @@ func createProfileName(cntName, replicaSuffix, namespace, profileName string) ty
 	}
 }
 
+// partialProfileName makes the name of a partial profile unique for every
+// pod incarnation. Pods recreated with the same name, for example by a
+// StatefulSet, would otherwise overwrite the partial profiles of their
+// predecessors instead of contributing to the merged profile.
+func partialProfileName(
+	name types.NamespacedName, labels map[string]string, podUID types.UID,
+) types.NamespacedName {
+	if labels[profilebase.ProfilePartialLabel] != "true" || podUID == "" {
+		return name
+	}
+
+	suffix := string(podUID)
+	if len(suffix) > podUIDSuffixLength {
+		suffix = suffix[:podUIDSuffixLength]
+	}
+	name.Name = fmt.Sprintf("%s-%s", name.Name, suffix)
+	return name
+}
+
+// partialProfileAnnotations returns the annotations of a recorded profile.
+// Partial profiles keep the base name returned by profileName, which is used
+// as name of the merged profile.
+func partialProfileAnnotations(labels map[string]string, renderedName string) map[string]string {
+	if labels[profilebase.ProfilePartialLabel] != "true" || renderedName == "" {
+		return nil
+	}
+	return map[string]string{profilerecording1alpha1.ProfileNameAnnotation: renderedName}
+}
+
 // parseLogAnnotations parses the provided annotations and extracts the
 // mandatory output profiles for the log recorder.
 func parseLogAnnotations(annotations map[string]string) (res []profileToCollect, err error) {
@@ -870,21 +1636,22 @@ func parseBpfAnnotations(annotations map[string]string) (res []profileToCollect,
 }
 
 type seProfileBuilder struct {
-	permMap       map[string]sets.Set[string]
-	usageCtx      string
+	permMap  map[string]sets.Set[string]
+	usageCtx string
+	// scontext is the source context of the recorded container, AVCs of
+	// other source types are ignored if it is set.
+	scontext      string
 	policyBuilder selxv1alpha2.Allow
 	log           logr.Logger
-	// used to optimize sorting
-	keys []string
 }
 
-func newSeProfileBuilder(usageCtx string, log logr.Logger) *seProfileBuilder {
+func newSeProfileBuilder(usageCtx, scontext string, log logr.Logger) *seProfileBuilder {
 	return &seProfileBuilder{
 		permMap:       make(map[string]sets.Set[string]),
 		usageCtx:      usageCtx,
+		scontext:      scontext,
 		policyBuilder: make(selxv1alpha2.Allow),
 		log:           log,
-		keys:          make([]string, 0),
 	}
 }
 
@@ -909,9 +1676,30 @@ func (sb *seProfileBuilder) addAvc(avc *enricherapi.AvcResponse_SelinuxAvc) erro
 		return fmt.Errorf("converting context to type: %w", err)
 	}
 
-	key := avc.Tclass + " " + ctxType
-	sb.keys = append(sb.keys, key)
+	srcType, srcErr := ctxt2type(avc.Scontext)
+	if sb.scontext != "" && (srcErr != nil || srcType != scontextType(sb.scontext)) {
+		sb.log.V(config.VerboseLevel).Info("Ignoring AVC of another source context",
+			"scontext", avc.Scontext, "expected", sb.scontext)
+		return nil
+	}
+
+	if !recordableTclasses.Has(avc.Tclass) {
+		sb.log.V(config.VerboseLevel).Info("Ignoring AVC of a not recordable class", "tclass", avc.Tclass)
+		return nil
+	}
+
+	// Accesses of the recorded process to its own domain reference itself,
+	// independently of the type the container runs with.
+	if srcErr == nil && srcType == ctxType {
+		ctxType = selxv1alpha2.AllowSelf
+	}
+
+	if avc.Perm == "" {
+		return nil
+	}
 
+	// Repeated keys only extend the permissions of the existing one
+	key := avc.Tclass + " " + ctxType
 	perms, ok := sb.permMap[key]
 	if ok {
 		perms.Insert(avc.Perm)
@@ -922,8 +1710,7 @@ func (sb *seProfileBuilder) addAvc(avc *enricherapi.AvcResponse_SelinuxAvc) erro
 }
 
 func (sb *seProfileBuilder) Format() (selxv1alpha2.Allow, error) {
-	sort.Strings(sb.keys)
-	for _, key := range sb.keys {
+	for _, key := range sets.List(sets.KeySet(sb.permMap)) {
 		val := sb.permMap[key]
 		if err := sb.writeLineFromKeyVal(key, val); err != nil {
 			return nil, fmt.Errorf("writing policy line from key-value pair: %w", err)
@@ -945,10 +1732,10 @@ func (sb *seProfileBuilder) writeLineFromKeyVal(key string, val sets.Set[string]
 		sb.policyBuilder[selxv1alpha2.LabelKey(setype)] = make(map[selxv1alpha2.ObjectClassKey]selxv1alpha2.PermissionSet)
 	}
 
+	// Different keys can be rewritten to the same type, for example @self
 	typePerms := sb.policyBuilder[selxv1alpha2.LabelKey(setype)]
-	l := val.UnsortedList()
-	sort.Strings(l)
-	typePerms[selxv1alpha2.ObjectClassKey(tclass)] = selxv1alpha2.PermissionSet(l)
+	perms := sets.New(typePerms[selxv1alpha2.ObjectClassKey(tclass)]...).Union(val)
+	typePerms[selxv1alpha2.ObjectClassKey(tclass)] = selxv1alpha2.PermissionSet(sets.List(perms))
 	return nil
 }
 
@@ -964,6 +1751,15 @@ func (sb *seProfileBuilder) targetClassCtx(key string) (tclass, tcontext string)
 	return
 }
 
+// scontextType returns the type of the provided context, which can also be a
+// plain type.
+func scontextType(ctx string) string {
+	if ctxType, err := ctxt2type(ctx); err == nil {
+		return ctxType
+	}
+	return ctx
+}
+
 func ctxt2type(ctx string) (string, error) {
 	elems := strings.Split(ctx, ":")
 	if len(elems) < seContextRequiredParts {
@@ -980,6 +1776,20 @@ func (r *RecorderReconciler) goArchToSeccompArch(goarch string) (seccompprofilea
 	return seccompprofileapi.Arch(seccompArch), nil
 }
 
+// syscallExecutables converts the executables per syscall reported by the
+// enricher into their annotation representation.
+func syscallExecutables(
+	executables map[string]*enricherapi.SyscallsResponse_Executables,
+) util.SyscallExecutables {
+	res := util.SyscallExecutables{}
+	for syscall, e := range executables {
+		for _, executable := range e.GetNames() {
+			res.Insert(syscall, executable)
+		}
+	}
+	return res
+}
+
 func profilePartial(
 	ctx context.Context, r *RecorderReconciler, profileName, namespace string,
 ) (bool, error) {
@@ -1005,21 +1815,25 @@ func profilePartial(
 }
 
 func profileLabels(
-	ctx context.Context, r *RecorderReconciler, recordingName, cntName, namespace string,
+	ctx context.Context, r *RecorderReconciler, parsed *parsedAnnotation, namespace string,
 ) (map[string]string, error) {
-	errs := validation.IsDNS1123Label(recordingName)
+	errs := validation.IsDNS1123Label(parsed.profileName)
 	if len(errs) > 0 {
 		return nil, errNameNotValid
 	}
 
 	labels := map[string]string{
-		profilerecording1alpha1.ProfileToRecordingLabel: recordingName,
-		profilerecording1alpha1.ProfileToContainerLabel: cntName,
+		profilerecording1alpha1.ProfileToRecordingLabel: parsed.profileName,
+		profilerecording1alpha1.ProfileToContainerLabel: parsed.cntName,
 	}
 
-	partial, err := profilePartial(ctx, r, recordingName, namespace)
-	if err != nil {
-		return nil, err
+	partial := parsed.mergeStrategy == profilerecording1alpha1.ProfileMergeContainers
+	if parsed.mergeStrategy == "" {
+		var err error
+		partial, err = profilePartial(ctx, r, parsed.profileName, namespace)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if partial {
@@ -1044,6 +1858,160 @@ func (r *RecorderReconciler) setDisabled(
 	return nil
 }
 
+func (r *RecorderReconciler) setPermissive(
+	ctx context.Context,
+	cli client.Client,
+	profileRecordingName, namespace string,
+	selinuxProfileSpec *selxv1alpha2.SelinuxProfileSpec,
+) error {
+	recording, err := r.GetRecording(ctx, cli, types.NamespacedName{Name: profileRecordingName, Namespace: namespace})
+	if err != nil {
+		return fmt.Errorf("get recording: %w", err)
+	}
+
+	selinuxProfileSpec.Permissive = recording.Spec.SelinuxPermissive
+	return nil
+}
+
+func (r *RecorderReconciler) setBaseline(
+	ctx context.Context,
+	cli client.Client,
+	parsed *parsedAnnotation,
+	namespace string,
+	langRuntime languageRuntime,
+	seccompProfileSpec *seccompprofileapi.SeccompProfileSpec,
+) error {
+	if langRuntime == runtimeNone {
+		return nil
+	}
+
+	runtimeBaseline := parsed.runtimeBaseline
+	if runtimeBaseline == nil {
+		recording, err := r.GetRecording(
+			ctx, cli, types.NamespacedName{Name: parsed.profileName, Namespace: namespace},
+		)
+		if err != nil {
+			return fmt.Errorf("get recording: %w", err)
+		}
+		runtimeBaseline = &recording.Spec.RuntimeBaseline
+	}
+
+	if !*runtimeBaseline {
+		return nil
+	}
+
+	r.log.Info("Seeding profile with runtime baseline", "runtime", langRuntime)
+	for _, syscall := range seccompProfileSpec.Syscalls {
+		if syscall.Action == seccomp.ActAllow {
+			syscall.Names = withBaseline(syscall.Names, langRuntime)
+		}
+	}
+	return nil
+}
+
+// setActions sets the default action and the action of the recorded syscalls
+// as configured by the recording. This has to happen after seeding the
+// runtime baseline, which only applies to the allowed syscalls.
+func (r *RecorderReconciler) setActions(
+	ctx context.Context,
+	cli client.Client,
+	profileRecordingName, namespace string,
+	seccompProfileSpec *seccompprofileapi.SeccompProfileSpec,
+) error {
+	recording, err := r.GetRecording(ctx, cli, types.NamespacedName{Name: profileRecordingName, Namespace: namespace})
+	if err != nil {
+		return fmt.Errorf("get recording: %w", err)
+	}
+
+	if recording.Spec.SeccompDefaultAction != "" {
+		seccompProfileSpec.DefaultAction = recording.Spec.SeccompDefaultAction
+	}
+	if seccompProfileSpec.DefaultAction == seccomp.ActErrno {
+		seccompProfileSpec.DefaultErrnoRet = recording.Spec.SeccompDefaultErrnoRet
+	}
+
+	if recording.Spec.SeccompSyscallAction != "" {
+		for _, syscall := range seccompProfileSpec.Syscalls {
+			syscall.Action = recording.Spec.SeccompSyscallAction
+		}
+	}
+	return nil
+}
+
+// setBaseProfile references the base profile configured by the recording and
+// removes all syscalls which are already allowed by the base profile chain.
+// This has to happen after setting the actions, because only syscalls with
+// the same action are subtracted.
+func (r *RecorderReconciler) setBaseProfile(
+	ctx context.Context,
+	cli client.Client,
+	profileRecordingName, namespace string,
+	seccompProfileSpec *seccompprofileapi.SeccompProfileSpec,
+) error {
+	recording, err := r.GetRecording(ctx, cli, types.NamespacedName{Name: profileRecordingName, Namespace: namespace})
+	if err != nil {
+		return fmt.Errorf("get recording: %w", err)
+	}
+
+	baseProfileName := recording.Spec.SeccompBaseProfileName
+	if baseProfileName == "" {
+		return nil
+	}
+
+	baseSyscalls, err := r.baseProfileSyscalls(ctx, baseProfileName, namespace)
+	if err != nil {
+		return fmt.Errorf("resolve base profile %s: %w", baseProfileName, err)
+	}
+
+	seccompProfileSpec.BaseProfileName = baseProfileName
+	seccompProfileSpec.Syscalls = util.SubtractSyscalls(seccompProfileSpec.Syscalls, baseSyscalls)
+	return nil
+}
+
+// baseProfileSyscalls returns the syscalls of a base profile including the
+// ones of its own base profiles. Base profiles from OCI artifacts are only
+// resolved by the daemon and therefore not taken into account.
+func (r *RecorderReconciler) baseProfileSyscalls(
+	ctx context.Context, name, namespace string,
+) ([]*seccompprofileapi.Syscall, error) {
+	const maxLevel = 15
+
+	syscalls := []*seccompprofileapi.Syscall{}
+	for level := 0; name != "" && !strings.HasPrefix(name, config.OCIProfilePrefix); level++ {
+		if level >= maxLevel {
+			return nil, fmt.Errorf("max recursion level of %d is reached for resolving base profiles", maxLevel)
+		}
+
+		baseProfile := &seccompprofileapi.SeccompProfile{}
+		if err := r.ClientGet(ctx, r.client, util.NamespacedName(name, namespace), baseProfile); err != nil {
+			return nil, fmt.Errorf("get base profile %s: %w", name, err)
+		}
+		syscalls = append(syscalls, baseProfile.Spec.Syscalls...)
+		name = baseProfile.Spec.BaseProfileName
+	}
+	return syscalls, nil
+}
+
+// setSyscallGrouping groups the recorded syscalls into multiple entries as
+// configured by the recording. This has to happen after setting the actions,
+// because the entries are grouped per action.
+func (r *RecorderReconciler) setSyscallGrouping(
+	ctx context.Context,
+	cli client.Client,
+	profileRecordingName, namespace string,
+	seccompProfileSpec *seccompprofileapi.SeccompProfileSpec,
+) error {
+	recording, err := r.GetRecording(ctx, cli, types.NamespacedName{Name: profileRecordingName, Namespace: namespace})
+	if err != nil {
+		return fmt.Errorf("get recording: %w", err)
+	}
+
+	if recording.Spec.SeccompSyscallGrouping == profilerecording1alpha1.ProfileSyscallGroupingCategory {
+		seccompProfileSpec.Syscalls = util.GroupSyscallsByCategory(seccompProfileSpec.Syscalls)
+	}
+	return nil
+}
+
 func (r *RecorderReconciler) setRecordingFinalizers(
 	ctx context.Context,
 	labels map[string]string,
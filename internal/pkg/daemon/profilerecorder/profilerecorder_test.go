@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/containers/common/pkg/seccomp"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -32,18 +33,24 @@ import (
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	bpfrecorderapi "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	recordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder/profilerecorderfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
 var errTest = errors.New("error")
@@ -162,6 +169,27 @@ func TestReconcile(t *testing.T) {
 				assert.Nil(t, err)
 			},
 		},
+		{ // ignore pod already running
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodRunning},
+					ObjectMeta: metav1.ObjectMeta{
+						UID: "uid",
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: "profile",
+						},
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				value, ok := sut.ignoredPods.Load(types.UID("uid"))
+				assert.True(t, ok)
+				ignored, ok := value.(ignoredPod)
+				assert.True(t, ok)
+				assert.Equal(t, reasonPodAlreadyRunning, ignored.reason)
+			},
+		},
 		{ // BPF success record
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				mock.GetPodReturns(&corev1.Pod{
@@ -179,9 +207,7 @@ func TestReconcile(t *testing.T) {
 			},
 			assert: func(sut *RecorderReconciler, err error) {
 				assert.Nil(t, err)
-				v, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
-				assert.True(t, ok)
-				pod, ok := v.(podToWatch)
+				pod, ok := sut.watchedPod(testRequest.NamespacedName)
 				assert.True(t, ok)
 				assert.Equal(t, recordingapi.ProfileRecorderBpf, pod.recorder)
 				assert.Len(t, pod.profiles, 1)
@@ -192,6 +218,59 @@ func TestReconcile(t *testing.T) {
 				assert.Nil(t, retryErr)
 			},
 		},
+		{ // BPF success record with logs fallback prepared
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey:  "profile",
+							config.SeccompProfileRecordLogsAnnotationKey: "profile",
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				pod, ok := sut.watchedPod(testRequest.NamespacedName)
+				assert.True(t, ok)
+				assert.Equal(t, recordingapi.ProfileRecorderBpf, pod.recorder)
+				assert.Len(t, pod.profiles, 1)
+				assert.Len(t, pod.fallbackProfiles, 1)
+				assert.Equal(t, "profile", pod.fallbackProfiles[0].name)
+			},
+		},
+		{ // Logs success record after falling back from BPF
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey:  "profile",
+							config.SeccompProfileRecordLogsAnnotationKey: "profile",
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				pod, ok := sut.watchedPod(testRequest.NamespacedName)
+				assert.True(t, ok)
+				assert.Equal(t, recordingapi.ProfileRecorderLogs, pod.recorder)
+				assert.Len(t, pod.profiles, 1)
+				assert.Empty(t, pod.fallbackProfiles)
+				recorder, ok := sut.record.(*record.FakeRecorder)
+				assert.True(t, ok)
+				assert.Contains(t, <-recorder.Events, reasonRecorderFallback)
+			},
+		},
 		{ // BPF success collect
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
@@ -204,7 +283,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -232,6 +311,12 @@ func TestReconcile(t *testing.T) {
 				) (controllerutil.OperationResult, error) {
 					err := f()
 					assert.Nil(t, err)
+					assert.Equal(t, "4bbwm", obj.GetLabels()[recordingapi.ProfileToRecordingSessionLabel])
+					sources, err := util.RecordingSourcesOf(obj)
+					assert.Nil(t, err)
+					assert.Len(t, sources, 1)
+					assert.Equal(t, testRequest.Name, sources[0].Pod)
+					assert.False(t, sources[0].Start.After(sources[0].End))
 					return "", nil
 				})
 				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
@@ -244,6 +329,302 @@ func TestReconcile(t *testing.T) {
 				assert.Nil(t, err)
 			},
 		},
+		{ // BPF success collect with runtime baseline
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+					runtimes: map[string]languageRuntime{"replica-123": runtimeGo},
+				}
+				sut.trackPod(testRequest.NamespacedName, "", value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(
+					&bpfrecorderapi.SyscallsResponse{
+						Syscalls: []string{"prctl", "mkdir"},
+						GoArch:   runtime.GOARCH,
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.Nil(t, err)
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					assert.True(t, ok)
+					names := profile.Spec.Syscalls[0].Names
+					assert.Contains(t, names, "mkdir")
+					assert.Contains(t, names, "futex")
+					assert.Contains(t, names, "epoll_pwait")
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						RuntimeBaseline: true,
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+			},
+		},
+		{ // BPF success collect with custom actions
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+					runtimes: map[string]languageRuntime{"replica-123": runtimeGo},
+				}
+				sut.trackPod(testRequest.NamespacedName, "", value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(
+					&bpfrecorderapi.SyscallsResponse{
+						Syscalls: []string{"prctl", "mkdir"},
+						GoArch:   runtime.GOARCH,
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.Nil(t, err)
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					assert.True(t, ok)
+					assert.Equal(t, seccomp.ActErrno, profile.Spec.DefaultAction)
+					assert.NotNil(t, profile.Spec.DefaultErrnoRet)
+					assert.EqualValues(t, 38, *profile.Spec.DefaultErrnoRet)
+					assert.Len(t, profile.Spec.Syscalls, 1)
+					assert.Equal(t, seccomp.ActLog, profile.Spec.Syscalls[0].Action)
+					assert.Contains(t, profile.Spec.Syscalls[0].Names, "futex")
+					return "", nil
+				})
+				errnoRet := uint(38)
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						RuntimeBaseline:        true,
+						SeccompDefaultAction:   seccomp.ActErrno,
+						SeccompDefaultErrnoRet: &errnoRet,
+						SeccompSyscallAction:   seccomp.ActLog,
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+			},
+		},
+		{ // BPF success collect with kill default action
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.trackPod(testRequest.NamespacedName, "", value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(
+					&bpfrecorderapi.SyscallsResponse{
+						Syscalls: []string{"prctl", "mkdir"},
+						GoArch:   runtime.GOARCH,
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.Nil(t, err)
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					assert.True(t, ok)
+					assert.Equal(t, seccomp.ActKillProcess, profile.Spec.DefaultAction)
+					assert.Nil(t, profile.Spec.DefaultErrnoRet)
+					assert.Equal(t, seccomp.ActAllow, profile.Spec.Syscalls[0].Action)
+					return "", nil
+				})
+				errnoRet := uint(38)
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						SeccompDefaultAction:   seccomp.ActKillProcess,
+						SeccompDefaultErrnoRet: &errnoRet,
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+			},
+		},
+		{ // BPF success collect with category grouping
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.trackPod(testRequest.NamespacedName, "", value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(
+					&bpfrecorderapi.SyscallsResponse{
+						Syscalls: []string{"socket", "prctl", "mkdir", "connect"},
+						GoArch:   runtime.GOARCH,
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.Nil(t, err)
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					assert.True(t, ok)
+					assert.Equal(t, []*seccompprofileapi.Syscall{
+						{Names: []string{"mkdir"}, Action: seccomp.ActAllow},
+						{Names: []string{"connect", "socket"}, Action: seccomp.ActAllow},
+						{Names: []string{"prctl"}, Action: seccomp.ActAllow},
+					}, profile.Spec.Syscalls)
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						SeccompSyscallGrouping: recordingapi.ProfileSyscallGroupingCategory,
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+			},
+		},
+		{ // BPF success collect with base profile
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.trackPod(testRequest.NamespacedName, "", value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(
+					&bpfrecorderapi.SyscallsResponse{
+						Syscalls: []string{"socket", "prctl", "mkdir", "connect"},
+						GoArch:   runtime.GOARCH,
+					}, nil,
+				)
+				mock.ClientGetCalls(func(
+					ctx context.Context, c client.Client, key client.ObjectKey, obj client.Object,
+				) error {
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					if !ok {
+						return nil
+					}
+					switch key.Name {
+					case "base":
+						profile.Spec.BaseProfileName = "runtime"
+						profile.Spec.Syscalls = []*seccompprofileapi.Syscall{
+							{Names: []string{"mkdir"}, Action: seccomp.ActAllow},
+						}
+					case "runtime":
+						profile.Spec.Syscalls = []*seccompprofileapi.Syscall{
+							{Names: []string{"socket"}, Action: seccomp.ActAllow},
+							{Names: []string{"prctl"}, Action: seccomp.ActLog},
+						}
+					}
+					return nil
+				})
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.Nil(t, err)
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					assert.True(t, ok)
+					assert.Equal(t, "base", profile.Spec.BaseProfileName)
+					assert.Equal(t, []*seccompprofileapi.Syscall{
+						{Names: []string{"prctl", "connect"}, Action: seccomp.ActAllow},
+					}, profile.Spec.Syscalls)
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						SeccompBaseProfileName: "base",
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+			},
+		},
 		{ //nolint:dupl // test duplicates are fine
 			// BPF GoArchToSeccompArch fails
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
@@ -257,7 +638,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -296,7 +677,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -335,7 +716,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -366,7 +747,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -405,7 +786,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -442,7 +823,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -476,7 +857,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -621,9 +1002,7 @@ func TestReconcile(t *testing.T) {
 			},
 			assert: func(sut *RecorderReconciler, err error) {
 				assert.Nil(t, err)
-				v, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
-				assert.True(t, ok)
-				pod, ok := v.(podToWatch)
+				pod, ok := sut.watchedPod(testRequest.NamespacedName)
 				assert.True(t, ok)
 				assert.Equal(t, recordingapi.ProfileRecorderLogs, pod.recorder)
 				assert.Len(t, pod.profiles, 1)
@@ -648,9 +1027,7 @@ func TestReconcile(t *testing.T) {
 			},
 			assert: func(sut *RecorderReconciler, err error) {
 				assert.Nil(t, err)
-				v, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
-				assert.True(t, ok)
-				pod, ok := v.(podToWatch)
+				pod, ok := sut.watchedPod(testRequest.NamespacedName)
 				assert.True(t, ok)
 				assert.Equal(t, recordingapi.ProfileRecorderLogs, pod.recorder)
 				assert.Len(t, pod.profiles, 1)
@@ -673,7 +1050,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -687,7 +1064,7 @@ func TestReconcile(t *testing.T) {
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
 				mock.DialEnricherReturns(nil, func() {}, nil)
-				mock.SyscallsReturns(
+				mock.CollectSyscallsReturns(
 					&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH}, nil,
 				)
 				mock.CreateOrUpdateCalls(func(
@@ -710,6 +1087,62 @@ func TestReconcile(t *testing.T) {
 				assert.Nil(t, err)
 			},
 		},
+		{ // logs seccomp success collect with executables
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.trackPod(testRequest.NamespacedName, "", value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.CollectSyscallsReturns(
+					&enricherapi.SyscallsResponse{
+						GoArch:   runtime.GOARCH,
+						Syscalls: []string{"ptrace", "read"},
+						Executables: map[string]*enricherapi.SyscallsResponse_Executables{
+							"ptrace": {Names: []string{"/usr/bin/strace"}},
+							"read":   {Names: []string{"/bin/sh", "/bin/cat"}},
+						},
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.Nil(t, err)
+					assert.Equal(t,
+						`{"ptrace":["/usr/bin/strace"],"read":["/bin/cat","/bin/sh"]}`,
+						obj.GetAnnotations()[recordingapi.ProfileSyscallExecutablesAnnotation],
+					)
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+			},
+		},
 		{ // logs seccomp failed ResetSyscalls
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
@@ -722,7 +1155,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -736,7 +1169,7 @@ func TestReconcile(t *testing.T) {
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
 				mock.DialEnricherReturns(nil, func() {}, nil)
-				mock.SyscallsReturns(
+				mock.CollectSyscallsReturns(
 					&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH}, nil,
 				)
 				mock.ResetSyscallsReturns(errTest)
@@ -758,7 +1191,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -772,7 +1205,7 @@ func TestReconcile(t *testing.T) {
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
 				mock.DialEnricherReturns(nil, func() {}, nil)
-				mock.SyscallsReturns(
+				mock.CollectSyscallsReturns(
 					&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH}, nil,
 				)
 				mock.CreateOrUpdateReturns("", errTest)
@@ -794,7 +1227,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -808,7 +1241,7 @@ func TestReconcile(t *testing.T) {
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
 				mock.DialEnricherReturns(nil, func() {}, nil)
-				mock.SyscallsReturns(
+				mock.CollectSyscallsReturns(
 					&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH}, nil,
 				)
 				mock.GoArchToSeccompArchReturns("", errTest)
@@ -818,7 +1251,7 @@ func TestReconcile(t *testing.T) {
 			},
 		},
 		{ //nolint:dupl // test duplicates are fine
-			// logs seccomp failed Syscalls
+			// logs seccomp failed CollectSyscalls
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
 				value := podToWatch{
@@ -830,7 +1263,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -844,7 +1277,7 @@ func TestReconcile(t *testing.T) {
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
 				mock.DialEnricherReturns(nil, func() {}, nil)
-				mock.SyscallsReturns(nil, errTest)
+				mock.CollectSyscallsReturns(nil, errTest)
 			},
 			assert: func(sut *RecorderReconciler, err error) {
 				assert.NotNil(t, err)
@@ -862,7 +1295,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.DialEnricherReturns(nil, func() {}, nil)
 				mock.GetPodReturns(&corev1.Pod{
@@ -894,7 +1327,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -926,7 +1359,71 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, errTest)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.NotNil(t, err)
+			},
+		},
+		{ // logs seccomp EnableLogEnricher false
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.trackPod(testRequest.NamespacedName, "", value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: false},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				_, ok := sut.watchedPod(testRequest.NamespacedName)
+				assert.False(t, ok)
+			},
+		},
+		{ // logs seccomp enricher enabled during recording
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+					enricherDisabled: true,
+				}
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -939,13 +1436,63 @@ func TestReconcile(t *testing.T) {
 				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
-				mock.DialEnricherReturns(nil, func() {}, errTest)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.CollectSyscallsReturns(nil, errTest)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				_, ok := sut.watchedPod(testRequest.NamespacedName)
+				assert.False(t, ok)
+			},
+		},
+		{ // BPF record with disallowed recorder
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey: "profile",
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{
+						EnableBpfRecorder: true,
+						AllowedRecorders:  []recordingapi.ProfileRecorder{recordingapi.ProfileRecorderLogs},
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				_, ok := sut.watchedPod(testRequest.NamespacedName)
+				assert.False(t, ok)
+				recorder, ok := sut.record.(*record.FakeRecorder)
+				assert.True(t, ok)
+				assert.Contains(t, <-recorder.Events, reasonRecorderNotAllowed)
+			},
+		},
+		{ // logs seccomp record with enricher disabled
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: "profile",
+						},
+					},
+				}, nil)
 			},
 			assert: func(sut *RecorderReconciler, err error) {
-				assert.NotNil(t, err)
+				assert.Nil(t, err)
+				pod, ok := sut.watchedPod(testRequest.NamespacedName)
+				assert.True(t, ok)
+				assert.True(t, pod.enricherDisabled)
+				recorder, ok := sut.record.(*record.FakeRecorder)
+				assert.True(t, ok)
+				assert.Contains(t, <-recorder.Events, reasonLogEnricherDisabled)
 			},
 		},
-		{ // logs seccomp EnableLogEnricher false
+		{ // logs seccomp failed GetSPOD
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
 				value := podToWatch{
@@ -957,7 +1504,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -967,43 +1514,67 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}, nil)
-				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
-					Spec: spodapi.SPODSpec{EnableLogEnricher: false},
-				}, nil)
+				mock.GetSPODReturns(nil, errTest)
 			},
 			assert: func(sut *RecorderReconciler, err error) {
 				assert.NotNil(t, err)
 			},
 		},
-		{ // logs seccomp failed GetSPOD
+		{ // logs selinux success collect
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
-				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
 				value := podToWatch{
 					recorder: recordingapi.ProfileRecorderLogs,
 					profiles: []profileToCollect{
 						{
-							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							kind: recordingapi.ProfileRecordingKindSelinuxProfile,
 							name: profileName,
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
 					ObjectMeta: metav1.ObjectMeta{
 						Annotations: map[string]string{
-							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+							config.SelinuxProfileRecordLogsAnnotationKey: profileName,
 						},
 					},
 				}, nil)
-				mock.GetSPODReturns(nil, errTest)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.CollectAvcsReturns(&enricherapi.AvcResponse{
+					Avc: []*enricherapi.AvcResponse_SelinuxAvc{
+						{
+							Tclass:   "file",
+							Tcontext: "0:1:2",
+						},
+					},
+				}, nil)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.Nil(t, err)
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						DisableProfileAfterRecording: false,
+					},
+				}, nil)
 			},
 			assert: func(sut *RecorderReconciler, err error) {
-				assert.NotNil(t, err)
+				assert.Nil(t, err)
 			},
 		},
-		{ // logs selinux success collect
+		{ // logs selinux success collect permissive
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
 				value := podToWatch{
@@ -1015,7 +1586,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -1029,10 +1600,10 @@ func TestReconcile(t *testing.T) {
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
 				mock.DialEnricherReturns(nil, func() {}, nil)
-				mock.AvcsReturns(&enricherapi.AvcResponse{
+				mock.CollectAvcsReturns(&enricherapi.AvcResponse{
 					Avc: []*enricherapi.AvcResponse_SelinuxAvc{
 						{
-							Tclass:   "class",
+							Tclass:   "file",
 							Tcontext: "0:1:2",
 						},
 					},
@@ -1045,11 +1616,14 @@ func TestReconcile(t *testing.T) {
 				) (controllerutil.OperationResult, error) {
 					err := f()
 					assert.Nil(t, err)
+					profile, ok := obj.(*selxv1alpha2.SelinuxProfile)
+					assert.True(t, ok)
+					assert.True(t, profile.Spec.Permissive)
 					return "", nil
 				})
 				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
 					Spec: recordingapi.ProfileRecordingSpec{
-						DisableProfileAfterRecording: false,
+						SelinuxPermissive: true,
 					},
 				}, nil)
 			},
@@ -1069,7 +1643,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -1101,7 +1675,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -1133,7 +1707,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -1147,7 +1721,7 @@ func TestReconcile(t *testing.T) {
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
 				mock.DialEnricherReturns(nil, func() {}, nil)
-				mock.AvcsReturns(&enricherapi.AvcResponse{
+				mock.CollectAvcsReturns(&enricherapi.AvcResponse{
 					Avc: []*enricherapi.AvcResponse_SelinuxAvc{
 						{Tcontext: "wrong"},
 					},
@@ -1158,7 +1732,7 @@ func TestReconcile(t *testing.T) {
 			},
 		},
 		{ //nolint:dupl // test duplicates are fine
-			// logs selinux failed Avcs
+			// logs selinux failed CollectAvcs
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
 				value := podToWatch{
@@ -1170,7 +1744,7 @@ func TestReconcile(t *testing.T) {
 						},
 					},
 				}
-				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+				sut.trackPod(testRequest.NamespacedName, "", value)
 
 				mock.GetPodReturns(&corev1.Pod{
 					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
@@ -1184,12 +1758,85 @@ func TestReconcile(t *testing.T) {
 					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
 				}, nil)
 				mock.DialEnricherReturns(nil, func() {}, nil)
-				mock.AvcsReturns(nil, errTest)
+				mock.CollectAvcsReturns(nil, errTest)
 			},
 			assert: func(sut *RecorderReconciler, err error) {
 				assert.NotNil(t, err)
 			},
 		},
+		{ // BPF success collect recreated pod
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.trackPod(testRequest.NamespacedName, "1b4e28ba-2fa1-11d2-883f-0016d3cca427", value)
+
+				scheme := apiruntime.NewScheme()
+				assert.Nil(t, recordingapi.AddToScheme(scheme))
+				sut.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&recordingapi.ProfileRecording{
+						ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "namespace"},
+					},
+				).Build()
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+					ObjectMeta: metav1.ObjectMeta{
+						UID: "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey: "profile",
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(
+					&bpfrecorderapi.SyscallsResponse{
+						Syscalls: []string{"prctl", "mkdir"},
+						GoArch:   runtime.GOARCH,
+					}, nil,
+				)
+				mock.ClientGetCalls(func(
+					ctx context.Context,
+					c client.Client,
+					key types.NamespacedName,
+					obj client.Object,
+				) error {
+					if recording, ok := obj.(*recordingapi.ProfileRecording); ok {
+						recording.Spec.MergeStrategy = recordingapi.ProfileMergeContainers
+					}
+					return nil
+				})
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					assert.Equal(t, "profile-replica-123-name-1b4e28ba", obj.GetName())
+					return "", f()
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.Nil(t, err)
+				pod, ok := sut.watchedPod(testRequest.NamespacedName)
+				assert.True(t, ok)
+				assert.Len(t, pod.profiles, 1)
+				assert.Equal(t, "profile", pod.profiles[0].name)
+				_, ok = sut.podsToWatch.Load(types.UID("1b4e28ba-2fa1-11d2-883f-0016d3cca427"))
+				assert.False(t, ok)
+			},
+		},
 	} {
 		mock := &profilerecorderfakes.FakeImpl{}
 		sut := &RecorderReconciler{
@@ -1197,6 +1844,7 @@ func TestReconcile(t *testing.T) {
 			log:    logr.Discard(),
 			record: record.NewFakeRecorder(10),
 		}
+		mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{}, nil)
 		tc.prepare(sut, mock)
 
 		_, err := sut.Reconcile(context.Background(), testRequest)
@@ -1204,6 +1852,117 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestSnapshotProfiles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "name"}}
+	profileName := fmt.Sprintf("profile_ctr_12345_%d", time.Now().Unix())
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: req.Namespace,
+			UID:       "1",
+			Annotations: map[string]string{
+				config.SeccompProfileRecordLogsAnnotationKey + "ctr": profileName,
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+	}
+
+	mock := &profilerecorderfakes.FakeImpl{}
+	mock.GetPodReturns(pod, nil)
+	mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+	}, nil)
+	mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+		Spec: recordingapi.ProfileRecordingSpec{
+			SnapshotInterval: &metav1.Duration{Duration: time.Second},
+		},
+	}, nil)
+	mock.DialEnricherReturns(nil, func() {}, nil)
+	mock.SyscallsReturns(&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH}, nil)
+	mock.CollectSyscallsReturns(&enricherapi.SyscallsResponse{GoArch: runtime.GOARCH, Generation: 1}, nil)
+	sut := &RecorderReconciler{impl: mock, log: logr.Discard(), record: record.NewFakeRecorder(10)}
+
+	// The interval is raised to the minimum and nothing gets collected yet
+	_, err := sut.Reconcile(ctx, req)
+	assert.NoError(t, err)
+	p, ok := sut.watchedPod(req.NamespacedName)
+	assert.True(t, ok)
+	assert.Equal(t, minSnapshotInterval, p.snapshotInterval)
+
+	pod.Status.Phase = corev1.PodRunning
+	res, err := sut.Reconcile(ctx, req)
+	assert.NoError(t, err)
+	assert.Positive(t, res.RequeueAfter)
+	assert.LessOrEqual(t, res.RequeueAfter, minSnapshotInterval)
+	assert.Zero(t, mock.SyscallsCallCount())
+
+	// A due snapshot collects the profile but keeps the recorded syscalls
+	p.nextSnapshot = time.Now()
+	sut.trackPod(req.NamespacedName, pod.UID, p)
+	res, err = sut.Reconcile(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, minSnapshotInterval, res.RequeueAfter)
+	assert.Equal(t, 1, mock.SyscallsCallCount())
+	assert.Equal(t, 1, mock.CreateOrUpdateCallCount())
+	assert.Zero(t, mock.ResetSyscallsCallCount())
+	p, ok = sut.watchedPod(req.NamespacedName)
+	assert.True(t, ok)
+	assert.True(t, p.nextSnapshot.After(time.Now()))
+
+	// The profile is collected a last time on termination
+	pod.Status.Phase = corev1.PodSucceeded
+	_, err = sut.Reconcile(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, mock.CreateOrUpdateCallCount())
+	assert.Equal(t, 1, mock.SyscallsCallCount())
+	assert.Equal(t, 1, mock.CollectSyscallsCallCount())
+	assert.Equal(t, 1, mock.ResetSyscallsCallCount())
+	_, _, request := mock.ResetSyscallsArgsForCall(0)
+	assert.EqualValues(t, 1, request.GetGeneration())
+	_, ok = sut.watchedPod(req.NamespacedName)
+	assert.False(t, ok)
+}
+
+func TestResetFallbackProfiles(t *testing.T) {
+	t.Parallel()
+
+	profiles := []profileToCollect{{name: "profile-a"}, {name: "profile-b"}}
+
+	for _, tc := range []struct {
+		name           string
+		enricher       bool
+		resetErr       error
+		expectedResets int
+	}{
+		{name: "enricher enabled", enricher: true, expectedResets: 2},
+		{name: "enricher disabled"},
+		{name: "failure on ResetSyscalls", enricher: true, resetErr: errTest, expectedResets: 2},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+				Spec: spodapi.SPODSpec{EnableLogEnricher: tc.enricher},
+			}, nil)
+			mock.DialEnricherReturns(nil, func() {}, nil)
+			mock.ResetSyscallsReturns(tc.resetErr)
+			sut := &RecorderReconciler{impl: mock, log: logr.Discard()}
+
+			sut.resetFallbackProfiles(context.Background(), profiles)
+			assert.Equal(t, tc.expectedResets, mock.ResetSyscallsCallCount())
+			if tc.expectedResets > 0 {
+				_, _, request := mock.ResetSyscallsArgsForCall(1)
+				assert.Equal(t, "profile-b", request.GetProfile())
+			}
+		})
+	}
+}
+
 func TestIsPodOnLocalNode(t *testing.T) {
 	t.Parallel()
 
@@ -1315,3 +2074,432 @@ func TestIsPodWithTraceAnnotation(t *testing.T) {
 		tc.assert(res)
 	}
 }
+
+func TestSeProfileBuilderSelf(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		avc      *enricherapi.AvcResponse_SelinuxAvc
+		expected selxv1alpha2.LabelKey
+	}{
+		{
+			name: "permissive recording type",
+			avc: &enricherapi.AvcResponse_SelinuxAvc{
+				Perm:     "read",
+				Tclass:   "fifo_file",
+				Scontext: "system_u:system_r:selinuxrecording.process:s0:c1,c2",
+				Tcontext: "system_u:system_r:selinuxrecording.process:s0:c1,c2",
+			},
+			expected: selxv1alpha2.AllowSelf,
+		},
+		{
+			name: "custom scontext",
+			avc: &enricherapi.AvcResponse_SelinuxAvc{
+				Perm:     "read",
+				Tclass:   "fifo_file",
+				Scontext: "system_u:system_r:my_app_t:s0:c1,c2",
+				Tcontext: "system_u:system_r:my_app_t:s0:c1,c2",
+			},
+			expected: selxv1alpha2.AllowSelf,
+		},
+		{
+			name: "other target type",
+			avc: &enricherapi.AvcResponse_SelinuxAvc{
+				Perm:     "read",
+				Tclass:   "file",
+				Scontext: "system_u:system_r:my_app_t:s0:c1,c2",
+				Tcontext: "system_u:object_r:var_lib_t:s0",
+			},
+			expected: "var_lib_t",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sb := newSeProfileBuilder("", "", logr.Discard())
+			assert.NoError(t, sb.AddAvcList([]*enricherapi.AvcResponse_SelinuxAvc{tc.avc}))
+			allow, err := sb.Format()
+			assert.NoError(t, err)
+			assert.Len(t, allow, 1)
+			assert.Contains(t, allow, tc.expected)
+			assert.Equal(t,
+				selxv1alpha2.PermissionSet{tc.avc.Perm},
+				allow[tc.expected][selxv1alpha2.ObjectClassKey(tc.avc.Tclass)],
+			)
+		})
+	}
+}
+
+func TestSeProfileBuilderDedup(t *testing.T) {
+	t.Parallel()
+
+	const (
+		recording = "system_u:system_r:selinuxrecording.process:s0:c1,c2"
+		varLib    = "system_u:object_r:var_lib_t:s0"
+	)
+
+	sb := newSeProfileBuilder("", "", logr.Discard())
+	assert.NoError(t, sb.AddAvcList([]*enricherapi.AvcResponse_SelinuxAvc{
+		{Perm: "write", Tclass: "file", Scontext: recording, Tcontext: varLib},
+		{Perm: "read", Tclass: "file", Scontext: recording, Tcontext: varLib},
+		{Perm: "read", Tclass: "file", Scontext: recording, Tcontext: varLib},
+		{Perm: "", Tclass: "dir", Scontext: recording, Tcontext: varLib},
+		{Perm: "read", Tclass: "fifo_file", Scontext: recording, Tcontext: recording},
+		{Perm: "write", Tclass: "fifo_file", Scontext: "", Tcontext: recording},
+	}))
+
+	allow, err := sb.Format()
+	assert.NoError(t, err)
+	assert.Equal(t, selxv1alpha2.Allow{
+		"var_lib_t":            {"file": {"read", "write"}},
+		selxv1alpha2.AllowSelf: {"fifo_file": {"read", "write"}},
+	}, allow)
+}
+
+func TestSeProfileBuilderFilter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		recording = "system_u:system_r:selinuxrecording.process:s0:c1,c2"
+		runtime   = "system_u:system_r:container_runtime_t:s0"
+		varLib    = "system_u:object_r:var_lib_t:s0"
+	)
+
+	for _, tc := range []struct {
+		name     string
+		scontext string
+		expected selxv1alpha2.Allow
+	}{
+		{
+			name:     "no source context",
+			scontext: "",
+			expected: selxv1alpha2.Allow{
+				"var_lib_t": {"dir": {"search"}, "file": {"read"}},
+			},
+		},
+		{
+			name:     "source type",
+			scontext: "selinuxrecording.process",
+			expected: selxv1alpha2.Allow{
+				"var_lib_t": {"file": {"read"}},
+			},
+		},
+		{
+			name:     "source context",
+			scontext: recording,
+			expected: selxv1alpha2.Allow{
+				"var_lib_t": {"file": {"read"}},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sb := newSeProfileBuilder("", tc.scontext, logr.Discard())
+			assert.NoError(t, sb.AddAvcList([]*enricherapi.AvcResponse_SelinuxAvc{
+				{Perm: "read", Tclass: "file", Scontext: recording, Tcontext: varLib},
+				{Perm: "search", Tclass: "dir", Scontext: runtime, Tcontext: varLib},
+				{Perm: "load_policy", Tclass: "security", Scontext: recording, Tcontext: varLib},
+				{Perm: "module_request", Tclass: "system", Scontext: recording, Tcontext: varLib},
+			}))
+
+			allow, err := sb.Format()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, allow)
+		})
+	}
+}
+
+func TestFormatSelinuxProfileInvalidCIL(t *testing.T) {
+	t.Parallel()
+
+	const recording = "system_u:system_r:selinuxrecording.process:s0:c1,c2"
+
+	sut := &RecorderReconciler{log: logr.Discard()}
+	profile := &selxv1alpha2.SelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "ns"},
+	}
+
+	_, err := sut.formatSelinuxProfile(profile, &enricherapi.AvcResponse{
+		Avc: []*enricherapi.AvcResponse_SelinuxAvc{
+			{Perm: "read", Tclass: "file", Scontext: recording, Tcontext: "system_u:object_r:var_lib_t:s0"},
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = sut.formatSelinuxProfile(profile, &enricherapi.AvcResponse{
+		Avc: []*enricherapi.AvcResponse_SelinuxAvc{
+			{Perm: "read)", Tclass: "file", Scontext: recording, Tcontext: "system_u:object_r:var_lib_t:s0"},
+		},
+	})
+	assert.ErrorContains(t, err, "validating CIL")
+}
+
+func TestExpandEphemeralProfiles(t *testing.T) {
+	t.Parallel()
+
+	profiles := []profileToCollect{
+		{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: "rec_container_abcde_1"},
+		{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: "rec_ephemeral.containers_fghij_2"},
+	}
+
+	assert.Equal(t, profiles[:1], expandEphemeralProfiles(profiles, nil))
+	assert.Equal(t, []profileToCollect{
+		{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: "rec_container_abcde_1"},
+		{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: "rec_debugger-1_fghij_2"},
+		{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: "rec_debugger-2_fghij_2"},
+	}, expandEphemeralProfiles(profiles, []string{"debugger-1", "debugger-2"}))
+}
+
+func TestPartialProfileName(t *testing.T) {
+	t.Parallel()
+
+	name := types.NamespacedName{Namespace: "ns", Name: "rec-web-0"}
+	partial := map[string]string{profilebase.ProfilePartialLabel: "true"}
+	const uid = types.UID("1b4e28ba-2fa1-11d2-883f-0016d3cca427")
+
+	assert.Equal(t, name, partialProfileName(name, nil, uid))
+	assert.Equal(t, name, partialProfileName(name, partial, ""))
+	assert.Equal(t,
+		types.NamespacedName{Namespace: "ns", Name: "rec-web-0-1b4e28ba"},
+		partialProfileName(name, partial, uid),
+	)
+}
+
+func TestParseProfileAnnotation(t *testing.T) {
+	t.Parallel()
+
+	runtimeBaseline := true
+	for _, tc := range []struct {
+		name       string
+		annotation string
+		expected   *parsedAnnotation
+		shouldFail bool
+	}{
+		{
+			name:       "version 1",
+			annotation: "rec_web_abcde_1661693966",
+			expected: &parsedAnnotation{
+				profileName: "rec", cntName: "web", session: "abcde", timestamp: 1661693966,
+			},
+		},
+		{
+			name: "version 2",
+			annotation: `{"version":2,"recording":"rec","kinds":["SeccompProfile"],"container":"web",` +
+				`"session":"abcde","timestamp":1661693966,"mergeStrategy":"containers","runtimeBaseline":true}`,
+			expected: &parsedAnnotation{
+				profileName:     "rec",
+				cntName:         "web",
+				session:         "abcde",
+				timestamp:       1661693966,
+				mergeStrategy:   recordingapi.ProfileMergeContainers,
+				runtimeBaseline: &runtimeBaseline,
+			},
+		},
+		{
+			name:       "version 1 with missing parts",
+			annotation: "rec_web_1661693966",
+			shouldFail: true,
+		},
+		{
+			name:       "unsupported version",
+			annotation: `{"version":3,"recording":"rec","container":"web"}`,
+			shouldFail: true,
+		},
+		{
+			name:       "version 2 without container",
+			annotation: `{"version":2,"recording":"rec"}`,
+			shouldFail: true,
+		},
+		{
+			name:       "invalid JSON",
+			annotation: `{"version":2,`,
+			shouldFail: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := parseProfileAnnotation(tc.annotation)
+			if tc.shouldFail {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, parsed)
+		})
+	}
+}
+
+func TestProfileName(t *testing.T) {
+	t.Parallel()
+
+	naming := profileNaming{
+		replicaSuffix:  "xzv7h",
+		owner:          "nginx",
+		images:         map[string]string{"web": "registry.io/nginx:1.25_Alpine", "setup": "busybox"},
+		initContainers: sets.New("setup"),
+	}
+	recording := func(template string) *recordingapi.ProfileRecording {
+		return &recordingapi.ProfileRecording{
+			ObjectMeta: metav1.ObjectMeta{Name: "rec", Namespace: "ns"},
+			Spec:       recordingapi.ProfileRecordingSpec{ProfileNameTemplate: template},
+		}
+	}
+
+	for _, tc := range []struct {
+		name             string
+		container        string
+		prepare          func(*profilerecorderfakes.FakeImpl)
+		expected         string
+		expectedRendered string
+		expectedErr      error
+	}{
+		{
+			name: "default name",
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(recording(""), nil)
+			},
+			expected: "rec-web-xzv7h",
+		},
+		{
+			name:      "init container",
+			container: "setup",
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(recording(""), nil)
+			},
+			expected:         "rec-init-setup-xzv7h",
+			expectedRendered: "rec-init-setup",
+		},
+		{
+			name:      "init container template",
+			container: "setup",
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(recording("{{ owner }}-{{ container }}-{{ imageTag }}"), nil)
+			},
+			expected:         "nginx-init-setup-latest-xzv7h",
+			expectedRendered: "nginx-init-setup-latest",
+		},
+		{
+			name: "recording not found",
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(nil, kerrors.NewNotFound(schema.GroupResource{}, "rec"))
+			},
+			expected: "rec-web-xzv7h",
+		},
+		{
+			name: "template",
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(recording("{{ owner }}-{{ container }}-{{ imageTag }}"), nil)
+			},
+			expected:         "nginx-web-1.25-alpine-xzv7h",
+			expectedRendered: "nginx-web-1.25-alpine",
+		},
+		{
+			name: "invalid rendered name",
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(recording("{{ recording }}_{{ container }}"), nil)
+			},
+			expectedErr: errNameNotValid,
+		},
+		{
+			name: "error get recording",
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(nil, errTest)
+			},
+			expectedErr: errTest,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			tc.prepare(mock)
+			sut := &RecorderReconciler{impl: mock, record: record.NewFakeRecorder(1)}
+
+			parsed := &parsedAnnotation{profileName: "rec", cntName: "web"}
+			if tc.container != "" {
+				parsed.cntName = tc.container
+			}
+			name, rendered, err := sut.profileName(context.Background(), parsed, naming, "ns")
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, types.NamespacedName{Namespace: "ns", Name: tc.expected}, name)
+			assert.Equal(t, tc.expectedRendered, rendered)
+		})
+	}
+}
+
+func TestPodOwnerName(t *testing.T) {
+	t.Parallel()
+
+	isController := true
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+	assert.Equal(t, "pod", podOwnerName(pod))
+
+	pod.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "nginx-6d4cf56db6", Controller: &isController,
+	}}
+	assert.Equal(t, "nginx-6d4cf56db6", podOwnerName(pod))
+
+	pod.Labels = map[string]string{"pod-template-hash": "6d4cf56db6"}
+	assert.Equal(t, "nginx", podOwnerName(pod))
+}
+
+func TestPodBaseName(t *testing.T) {
+	t.Parallel()
+
+	isController := true
+	for _, tc := range []struct {
+		name     string
+		pod      *corev1.Pod
+		expected string
+	}{
+		{
+			name: "generated name",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name: "nginx-6d4cf56db6-xzv7h", GenerateName: "nginx-6d4cf56db6-",
+			}},
+			expected: "nginx-6d4cf56db6-",
+		},
+		{
+			name: "custom workload",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name: "build-pod",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "tekton.dev/v1", Kind: "TaskRun", Name: "build", Controller: &isController,
+				}},
+			}},
+			expected: "build-",
+		},
+		{
+			name: "owner not prefixing the pod name",
+			pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+				Name: "pod",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow", Name: "wf", Controller: &isController,
+				}},
+			}},
+			expected: "pod",
+		},
+		{
+			name:     "standalone pod",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}},
+			expected: "pod",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, podBaseName(tc.pod))
+		})
+	}
+}
@@ -25,7 +25,6 @@ import (
 	"google.golang.org/grpc"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -53,12 +52,12 @@ type FakeImpl struct {
 		result1 *api_enricher.AvcResponse
 		result2 error
 	}
-	ClientGetStub        func(context.Context, client.Client, types.NamespacedName, client.Object) error
+	ClientGetStub        func(context.Context, client.Client, client.ObjectKey, client.Object) error
 	clientGetMutex       sync.RWMutex
 	clientGetArgsForCall []struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 		arg4 client.Object
 	}
 	clientGetReturns struct {
@@ -67,6 +66,50 @@ type FakeImpl struct {
 	clientGetReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ClientPatchStub        func(context.Context, client.Client, client.Object, client.Patch) error
+	clientPatchMutex       sync.RWMutex
+	clientPatchArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 client.Object
+		arg4 client.Patch
+	}
+	clientPatchReturns struct {
+		result1 error
+	}
+	clientPatchReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CollectAvcsStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.AvcRequest) (*api_enricher.AvcResponse, error)
+	collectAvcsMutex       sync.RWMutex
+	collectAvcsArgsForCall []struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.AvcRequest
+	}
+	collectAvcsReturns struct {
+		result1 *api_enricher.AvcResponse
+		result2 error
+	}
+	collectAvcsReturnsOnCall map[int]struct {
+		result1 *api_enricher.AvcResponse
+		result2 error
+	}
+	CollectSyscallsStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.SyscallsRequest) (*api_enricher.SyscallsResponse, error)
+	collectSyscallsMutex       sync.RWMutex
+	collectSyscallsArgsForCall []struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.SyscallsRequest
+	}
+	collectSyscallsReturns struct {
+		result1 *api_enricher.SyscallsResponse
+		result2 error
+	}
+	collectSyscallsReturnsOnCall map[int]struct {
+		result1 *api_enricher.SyscallsResponse
+		result2 error
+	}
 	CreateOrUpdateStub        func(context.Context, client.Client, client.Object, controllerutil.MutateFn) (controllerutil.OperationResult, error)
 	createOrUpdateMutex       sync.RWMutex
 	createOrUpdateArgsForCall []struct {
@@ -111,12 +154,12 @@ type FakeImpl struct {
 		result2 context.CancelFunc
 		result3 error
 	}
-	GetPodStub        func(context.Context, client.Client, types.NamespacedName) (*v1.Pod, error)
+	GetPodStub        func(context.Context, client.Client, client.ObjectKey) (*v1.Pod, error)
 	getPodMutex       sync.RWMutex
 	getPodArgsForCall []struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 	}
 	getPodReturns struct {
 		result1 *v1.Pod
@@ -126,12 +169,12 @@ type FakeImpl struct {
 		result1 *v1.Pod
 		result2 error
 	}
-	GetRecordingStub        func(context.Context, client.Client, types.NamespacedName) (*v1alpha1.ProfileRecording, error)
+	GetRecordingStub        func(context.Context, client.Client, client.ObjectKey) (*v1alpha1.ProfileRecording, error)
 	getRecordingMutex       sync.RWMutex
 	getRecordingArgsForCall []struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 	}
 	getRecordingReturns struct {
 		result1 *v1alpha1.ProfileRecording
@@ -168,6 +211,18 @@ type FakeImpl struct {
 		result1 seccomp.Arch
 		result2 error
 	}
+	ManagerAddStub        func(manager.Manager, manager.Runnable) error
+	managerAddMutex       sync.RWMutex
+	managerAddArgsForCall []struct {
+		arg1 manager.Manager
+		arg2 manager.Runnable
+	}
+	managerAddReturns struct {
+		result1 error
+	}
+	managerAddReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ManagerGetClientStub        func(manager.Manager) client.Client
 	managerGetClientMutex       sync.RWMutex
 	managerGetClientArgsForCall []struct {
@@ -219,6 +274,20 @@ type FakeImpl struct {
 	newControllerManagedByReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PatchRecordingStatusStub        func(context.Context, client.Client, *v1alpha1.ProfileRecording, []byte) error
+	patchRecordingStatusMutex       sync.RWMutex
+	patchRecordingStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1alpha1.ProfileRecording
+		arg4 []byte
+	}
+	patchRecordingStatusReturns struct {
+		result1 error
+	}
+	patchRecordingStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResetAvcsStub        func(context.Context, api_enricher.EnricherClient, *api_enricher.AvcRequest) error
 	resetAvcsMutex       sync.RWMutex
 	resetAvcsArgsForCall []struct {
@@ -369,13 +438,13 @@ func (fake *FakeImpl) AvcsReturnsOnCall(i int, result1 *api_enricher.AvcResponse
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) ClientGet(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName, arg4 client.Object) error {
+func (fake *FakeImpl) ClientGet(arg1 context.Context, arg2 client.Client, arg3 client.ObjectKey, arg4 client.Object) error {
 	fake.clientGetMutex.Lock()
 	ret, specificReturn := fake.clientGetReturnsOnCall[len(fake.clientGetArgsForCall)]
 	fake.clientGetArgsForCall = append(fake.clientGetArgsForCall, struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 		arg4 client.Object
 	}{arg1, arg2, arg3, arg4})
 	stub := fake.ClientGetStub
@@ -397,13 +466,13 @@ func (fake *FakeImpl) ClientGetCallCount() int {
 	return len(fake.clientGetArgsForCall)
 }
 
-func (fake *FakeImpl) ClientGetCalls(stub func(context.Context, client.Client, types.NamespacedName, client.Object) error) {
+func (fake *FakeImpl) ClientGetCalls(stub func(context.Context, client.Client, client.ObjectKey, client.Object) error) {
 	fake.clientGetMutex.Lock()
 	defer fake.clientGetMutex.Unlock()
 	fake.ClientGetStub = stub
 }
 
-func (fake *FakeImpl) ClientGetArgsForCall(i int) (context.Context, client.Client, types.NamespacedName, client.Object) {
+func (fake *FakeImpl) ClientGetArgsForCall(i int) (context.Context, client.Client, client.ObjectKey, client.Object) {
 	fake.clientGetMutex.RLock()
 	defer fake.clientGetMutex.RUnlock()
 	argsForCall := fake.clientGetArgsForCall[i]
@@ -433,6 +502,202 @@ func (fake *FakeImpl) ClientGetReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) ClientPatch(arg1 context.Context, arg2 client.Client, arg3 client.Object, arg4 client.Patch) error {
+	fake.clientPatchMutex.Lock()
+	ret, specificReturn := fake.clientPatchReturnsOnCall[len(fake.clientPatchArgsForCall)]
+	fake.clientPatchArgsForCall = append(fake.clientPatchArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 client.Object
+		arg4 client.Patch
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClientPatchStub
+	fakeReturns := fake.clientPatchReturns
+	fake.recordInvocation("ClientPatch", []interface{}{arg1, arg2, arg3, arg4})
+	fake.clientPatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) ClientPatchCallCount() int {
+	fake.clientPatchMutex.RLock()
+	defer fake.clientPatchMutex.RUnlock()
+	return len(fake.clientPatchArgsForCall)
+}
+
+func (fake *FakeImpl) ClientPatchCalls(stub func(context.Context, client.Client, client.Object, client.Patch) error) {
+	fake.clientPatchMutex.Lock()
+	defer fake.clientPatchMutex.Unlock()
+	fake.ClientPatchStub = stub
+}
+
+func (fake *FakeImpl) ClientPatchArgsForCall(i int) (context.Context, client.Client, client.Object, client.Patch) {
+	fake.clientPatchMutex.RLock()
+	defer fake.clientPatchMutex.RUnlock()
+	argsForCall := fake.clientPatchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeImpl) ClientPatchReturns(result1 error) {
+	fake.clientPatchMutex.Lock()
+	defer fake.clientPatchMutex.Unlock()
+	fake.ClientPatchStub = nil
+	fake.clientPatchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ClientPatchReturnsOnCall(i int, result1 error) {
+	fake.clientPatchMutex.Lock()
+	defer fake.clientPatchMutex.Unlock()
+	fake.ClientPatchStub = nil
+	if fake.clientPatchReturnsOnCall == nil {
+		fake.clientPatchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clientPatchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) CollectAvcs(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.AvcRequest) (*api_enricher.AvcResponse, error) {
+	fake.collectAvcsMutex.Lock()
+	ret, specificReturn := fake.collectAvcsReturnsOnCall[len(fake.collectAvcsArgsForCall)]
+	fake.collectAvcsArgsForCall = append(fake.collectAvcsArgsForCall, struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.AvcRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CollectAvcsStub
+	fakeReturns := fake.collectAvcsReturns
+	fake.recordInvocation("CollectAvcs", []interface{}{arg1, arg2, arg3})
+	fake.collectAvcsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) CollectAvcsCallCount() int {
+	fake.collectAvcsMutex.RLock()
+	defer fake.collectAvcsMutex.RUnlock()
+	return len(fake.collectAvcsArgsForCall)
+}
+
+func (fake *FakeImpl) CollectAvcsCalls(stub func(context.Context, api_enricher.EnricherClient, *api_enricher.AvcRequest) (*api_enricher.AvcResponse, error)) {
+	fake.collectAvcsMutex.Lock()
+	defer fake.collectAvcsMutex.Unlock()
+	fake.CollectAvcsStub = stub
+}
+
+func (fake *FakeImpl) CollectAvcsArgsForCall(i int) (context.Context, api_enricher.EnricherClient, *api_enricher.AvcRequest) {
+	fake.collectAvcsMutex.RLock()
+	defer fake.collectAvcsMutex.RUnlock()
+	argsForCall := fake.collectAvcsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) CollectAvcsReturns(result1 *api_enricher.AvcResponse, result2 error) {
+	fake.collectAvcsMutex.Lock()
+	defer fake.collectAvcsMutex.Unlock()
+	fake.CollectAvcsStub = nil
+	fake.collectAvcsReturns = struct {
+		result1 *api_enricher.AvcResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) CollectAvcsReturnsOnCall(i int, result1 *api_enricher.AvcResponse, result2 error) {
+	fake.collectAvcsMutex.Lock()
+	defer fake.collectAvcsMutex.Unlock()
+	fake.CollectAvcsStub = nil
+	if fake.collectAvcsReturnsOnCall == nil {
+		fake.collectAvcsReturnsOnCall = make(map[int]struct {
+			result1 *api_enricher.AvcResponse
+			result2 error
+		})
+	}
+	fake.collectAvcsReturnsOnCall[i] = struct {
+		result1 *api_enricher.AvcResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) CollectSyscalls(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.SyscallsRequest) (*api_enricher.SyscallsResponse, error) {
+	fake.collectSyscallsMutex.Lock()
+	ret, specificReturn := fake.collectSyscallsReturnsOnCall[len(fake.collectSyscallsArgsForCall)]
+	fake.collectSyscallsArgsForCall = append(fake.collectSyscallsArgsForCall, struct {
+		arg1 context.Context
+		arg2 api_enricher.EnricherClient
+		arg3 *api_enricher.SyscallsRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CollectSyscallsStub
+	fakeReturns := fake.collectSyscallsReturns
+	fake.recordInvocation("CollectSyscalls", []interface{}{arg1, arg2, arg3})
+	fake.collectSyscallsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) CollectSyscallsCallCount() int {
+	fake.collectSyscallsMutex.RLock()
+	defer fake.collectSyscallsMutex.RUnlock()
+	return len(fake.collectSyscallsArgsForCall)
+}
+
+func (fake *FakeImpl) CollectSyscallsCalls(stub func(context.Context, api_enricher.EnricherClient, *api_enricher.SyscallsRequest) (*api_enricher.SyscallsResponse, error)) {
+	fake.collectSyscallsMutex.Lock()
+	defer fake.collectSyscallsMutex.Unlock()
+	fake.CollectSyscallsStub = stub
+}
+
+func (fake *FakeImpl) CollectSyscallsArgsForCall(i int) (context.Context, api_enricher.EnricherClient, *api_enricher.SyscallsRequest) {
+	fake.collectSyscallsMutex.RLock()
+	defer fake.collectSyscallsMutex.RUnlock()
+	argsForCall := fake.collectSyscallsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) CollectSyscallsReturns(result1 *api_enricher.SyscallsResponse, result2 error) {
+	fake.collectSyscallsMutex.Lock()
+	defer fake.collectSyscallsMutex.Unlock()
+	fake.CollectSyscallsStub = nil
+	fake.collectSyscallsReturns = struct {
+		result1 *api_enricher.SyscallsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) CollectSyscallsReturnsOnCall(i int, result1 *api_enricher.SyscallsResponse, result2 error) {
+	fake.collectSyscallsMutex.Lock()
+	defer fake.collectSyscallsMutex.Unlock()
+	fake.CollectSyscallsStub = nil
+	if fake.collectSyscallsReturnsOnCall == nil {
+		fake.collectSyscallsReturnsOnCall = make(map[int]struct {
+			result1 *api_enricher.SyscallsResponse
+			result2 error
+		})
+	}
+	fake.collectSyscallsReturnsOnCall[i] = struct {
+		result1 *api_enricher.SyscallsResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) CreateOrUpdate(arg1 context.Context, arg2 client.Client, arg3 client.Object, arg4 controllerutil.MutateFn) (controllerutil.OperationResult, error) {
 	fake.createOrUpdateMutex.Lock()
 	ret, specificReturn := fake.createOrUpdateReturnsOnCall[len(fake.createOrUpdateArgsForCall)]
@@ -618,13 +883,13 @@ func (fake *FakeImpl) DialEnricherReturnsOnCall(i int, result1 *grpc.ClientConn,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeImpl) GetPod(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName) (*v1.Pod, error) {
+func (fake *FakeImpl) GetPod(arg1 context.Context, arg2 client.Client, arg3 client.ObjectKey) (*v1.Pod, error) {
 	fake.getPodMutex.Lock()
 	ret, specificReturn := fake.getPodReturnsOnCall[len(fake.getPodArgsForCall)]
 	fake.getPodArgsForCall = append(fake.getPodArgsForCall, struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 	}{arg1, arg2, arg3})
 	stub := fake.GetPodStub
 	fakeReturns := fake.getPodReturns
@@ -645,13 +910,13 @@ func (fake *FakeImpl) GetPodCallCount() int {
 	return len(fake.getPodArgsForCall)
 }
 
-func (fake *FakeImpl) GetPodCalls(stub func(context.Context, client.Client, types.NamespacedName) (*v1.Pod, error)) {
+func (fake *FakeImpl) GetPodCalls(stub func(context.Context, client.Client, client.ObjectKey) (*v1.Pod, error)) {
 	fake.getPodMutex.Lock()
 	defer fake.getPodMutex.Unlock()
 	fake.GetPodStub = stub
 }
 
-func (fake *FakeImpl) GetPodArgsForCall(i int) (context.Context, client.Client, types.NamespacedName) {
+func (fake *FakeImpl) GetPodArgsForCall(i int) (context.Context, client.Client, client.ObjectKey) {
 	fake.getPodMutex.RLock()
 	defer fake.getPodMutex.RUnlock()
 	argsForCall := fake.getPodArgsForCall[i]
@@ -684,13 +949,13 @@ func (fake *FakeImpl) GetPodReturnsOnCall(i int, result1 *v1.Pod, result2 error)
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) GetRecording(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName) (*v1alpha1.ProfileRecording, error) {
+func (fake *FakeImpl) GetRecording(arg1 context.Context, arg2 client.Client, arg3 client.ObjectKey) (*v1alpha1.ProfileRecording, error) {
 	fake.getRecordingMutex.Lock()
 	ret, specificReturn := fake.getRecordingReturnsOnCall[len(fake.getRecordingArgsForCall)]
 	fake.getRecordingArgsForCall = append(fake.getRecordingArgsForCall, struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 	}{arg1, arg2, arg3})
 	stub := fake.GetRecordingStub
 	fakeReturns := fake.getRecordingReturns
@@ -711,13 +976,13 @@ func (fake *FakeImpl) GetRecordingCallCount() int {
 	return len(fake.getRecordingArgsForCall)
 }
 
-func (fake *FakeImpl) GetRecordingCalls(stub func(context.Context, client.Client, types.NamespacedName) (*v1alpha1.ProfileRecording, error)) {
+func (fake *FakeImpl) GetRecordingCalls(stub func(context.Context, client.Client, client.ObjectKey) (*v1alpha1.ProfileRecording, error)) {
 	fake.getRecordingMutex.Lock()
 	defer fake.getRecordingMutex.Unlock()
 	fake.GetRecordingStub = stub
 }
 
-func (fake *FakeImpl) GetRecordingArgsForCall(i int) (context.Context, client.Client, types.NamespacedName) {
+func (fake *FakeImpl) GetRecordingArgsForCall(i int) (context.Context, client.Client, client.ObjectKey) {
 	fake.getRecordingMutex.RLock()
 	defer fake.getRecordingMutex.RUnlock()
 	argsForCall := fake.getRecordingArgsForCall[i]
@@ -879,6 +1144,68 @@ func (fake *FakeImpl) GoArchToSeccompArchReturnsOnCall(i int, result1 seccomp.Ar
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ManagerAdd(arg1 manager.Manager, arg2 manager.Runnable) error {
+	fake.managerAddMutex.Lock()
+	ret, specificReturn := fake.managerAddReturnsOnCall[len(fake.managerAddArgsForCall)]
+	fake.managerAddArgsForCall = append(fake.managerAddArgsForCall, struct {
+		arg1 manager.Manager
+		arg2 manager.Runnable
+	}{arg1, arg2})
+	stub := fake.ManagerAddStub
+	fakeReturns := fake.managerAddReturns
+	fake.recordInvocation("ManagerAdd", []interface{}{arg1, arg2})
+	fake.managerAddMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) ManagerAddCallCount() int {
+	fake.managerAddMutex.RLock()
+	defer fake.managerAddMutex.RUnlock()
+	return len(fake.managerAddArgsForCall)
+}
+
+func (fake *FakeImpl) ManagerAddCalls(stub func(manager.Manager, manager.Runnable) error) {
+	fake.managerAddMutex.Lock()
+	defer fake.managerAddMutex.Unlock()
+	fake.ManagerAddStub = stub
+}
+
+func (fake *FakeImpl) ManagerAddArgsForCall(i int) (manager.Manager, manager.Runnable) {
+	fake.managerAddMutex.RLock()
+	defer fake.managerAddMutex.RUnlock()
+	argsForCall := fake.managerAddArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) ManagerAddReturns(result1 error) {
+	fake.managerAddMutex.Lock()
+	defer fake.managerAddMutex.Unlock()
+	fake.ManagerAddStub = nil
+	fake.managerAddReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ManagerAddReturnsOnCall(i int, result1 error) {
+	fake.managerAddMutex.Lock()
+	defer fake.managerAddMutex.Unlock()
+	fake.ManagerAddStub = nil
+	if fake.managerAddReturnsOnCall == nil {
+		fake.managerAddReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.managerAddReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) ManagerGetClient(arg1 manager.Manager) client.Client {
 	fake.managerGetClientMutex.Lock()
 	ret, specificReturn := fake.managerGetClientReturnsOnCall[len(fake.managerGetClientArgsForCall)]
@@ -1131,6 +1458,75 @@ func (fake *FakeImpl) NewControllerManagedByReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeImpl) PatchRecordingStatus(arg1 context.Context, arg2 client.Client, arg3 *v1alpha1.ProfileRecording, arg4 []byte) error {
+	var arg4Copy []byte
+	if arg4 != nil {
+		arg4Copy = make([]byte, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.patchRecordingStatusMutex.Lock()
+	ret, specificReturn := fake.patchRecordingStatusReturnsOnCall[len(fake.patchRecordingStatusArgsForCall)]
+	fake.patchRecordingStatusArgsForCall = append(fake.patchRecordingStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1alpha1.ProfileRecording
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.PatchRecordingStatusStub
+	fakeReturns := fake.patchRecordingStatusReturns
+	fake.recordInvocation("PatchRecordingStatus", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.patchRecordingStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) PatchRecordingStatusCallCount() int {
+	fake.patchRecordingStatusMutex.RLock()
+	defer fake.patchRecordingStatusMutex.RUnlock()
+	return len(fake.patchRecordingStatusArgsForCall)
+}
+
+func (fake *FakeImpl) PatchRecordingStatusCalls(stub func(context.Context, client.Client, *v1alpha1.ProfileRecording, []byte) error) {
+	fake.patchRecordingStatusMutex.Lock()
+	defer fake.patchRecordingStatusMutex.Unlock()
+	fake.PatchRecordingStatusStub = stub
+}
+
+func (fake *FakeImpl) PatchRecordingStatusArgsForCall(i int) (context.Context, client.Client, *v1alpha1.ProfileRecording, []byte) {
+	fake.patchRecordingStatusMutex.RLock()
+	defer fake.patchRecordingStatusMutex.RUnlock()
+	argsForCall := fake.patchRecordingStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeImpl) PatchRecordingStatusReturns(result1 error) {
+	fake.patchRecordingStatusMutex.Lock()
+	defer fake.patchRecordingStatusMutex.Unlock()
+	fake.PatchRecordingStatusStub = nil
+	fake.patchRecordingStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) PatchRecordingStatusReturnsOnCall(i int, result1 error) {
+	fake.patchRecordingStatusMutex.Lock()
+	defer fake.patchRecordingStatusMutex.Unlock()
+	fake.PatchRecordingStatusStub = nil
+	if fake.patchRecordingStatusReturnsOnCall == nil {
+		fake.patchRecordingStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.patchRecordingStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) ResetAvcs(arg1 context.Context, arg2 api_enricher.EnricherClient, arg3 *api_enricher.AvcRequest) error {
 	fake.resetAvcsMutex.Lock()
 	ret, specificReturn := fake.resetAvcsReturnsOnCall[len(fake.resetAvcsArgsForCall)]
@@ -1520,6 +1916,12 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.avcsMutex.RUnlock()
 	fake.clientGetMutex.RLock()
 	defer fake.clientGetMutex.RUnlock()
+	fake.clientPatchMutex.RLock()
+	defer fake.clientPatchMutex.RUnlock()
+	fake.collectAvcsMutex.RLock()
+	defer fake.collectAvcsMutex.RUnlock()
+	fake.collectSyscallsMutex.RLock()
+	defer fake.collectSyscallsMutex.RUnlock()
 	fake.createOrUpdateMutex.RLock()
 	defer fake.createOrUpdateMutex.RUnlock()
 	fake.dialBpfRecorderMutex.RLock()
@@ -1534,6 +1936,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.getSPODMutex.RUnlock()
 	fake.goArchToSeccompArchMutex.RLock()
 	defer fake.goArchToSeccompArchMutex.RUnlock()
+	fake.managerAddMutex.RLock()
+	defer fake.managerAddMutex.RUnlock()
 	fake.managerGetClientMutex.RLock()
 	defer fake.managerGetClientMutex.RUnlock()
 	fake.managerGetEventRecorderForMutex.RLock()
@@ -1542,6 +1946,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.newClientMutex.RUnlock()
 	fake.newControllerManagedByMutex.RLock()
 	defer fake.newControllerManagedByMutex.RUnlock()
+	fake.patchRecordingStatusMutex.RLock()
+	defer fake.patchRecordingStatusMutex.RUnlock()
 	fake.resetAvcsMutex.RLock()
 	defer fake.resetAvcsMutex.RUnlock()
 	fake.resetSyscallsMutex.RLock()
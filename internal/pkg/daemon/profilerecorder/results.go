@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=patch
+// +kubebuilder:rbac:groups=apps,resources=replicasets;statefulsets;daemonsets,verbs=get;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;patch
+
+// recordedProfileRef returns the reference of a recorded profile in the
+// "kind/name" format understood by kubectl.
+func recordedProfileRef(kind profilerecording1alpha1.ProfileRecordingKind, name string) string {
+	return strings.ToLower(string(kind)) + "/" + name
+}
+
+// annotateRecordedProfiles exposes the collected profiles on the recorded pod
+// if it still exists, otherwise on its controller, for example a ReplicaSet or
+// a Job. Existing entries of the annotation are kept, because the replicas of
+// a controller contribute their profiles to the same annotation.
+func (r *RecorderReconciler) annotateRecordedProfiles(
+	ctx context.Context,
+	podName types.NamespacedName,
+	podUID types.UID,
+	owner *metav1.OwnerReference,
+	profiles []string,
+) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	return util.Retry(func() error {
+		target, err := r.recordedProfilesTarget(ctx, podName, podUID, owner)
+		if err != nil || target == nil {
+			return err
+		}
+
+		patched := target.DeepCopy()
+		metav1.SetMetaDataAnnotation(
+			&patched.ObjectMeta,
+			profilerecording1alpha1.RecordedProfilesAnnotation,
+			mergeRecordedProfiles(target.GetAnnotations()[profilerecording1alpha1.RecordedProfilesAnnotation], profiles),
+		)
+		if err := r.ClientPatch(
+			ctx, r.client, patched, client.MergeFromWithOptions(target, client.MergeFromWithOptimisticLock{}),
+		); err != nil {
+			return fmt.Errorf("patch %s %s: %w", target.Kind, target.Name, err)
+		}
+
+		r.log.Info("Annotated recorded profiles", "kind", target.Kind, "name", target.Name, "profiles", profiles)
+		return nil
+	}, kerrors.IsConflict)
+}
+
+// recordedProfilesTarget returns the metadata of the object to be annotated
+// with the recorded profiles, or nil if neither the pod nor its controller
+// exist anymore.
+func (r *RecorderReconciler) recordedProfilesTarget(
+	ctx context.Context,
+	podName types.NamespacedName,
+	podUID types.UID,
+	owner *metav1.OwnerReference,
+) (*metav1.PartialObjectMetadata, error) {
+	pod := &metav1.PartialObjectMetadata{}
+	pod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+	err := r.ClientGet(ctx, r.apiReader, podName, pod)
+	if err == nil && pod.GetUID() == podUID {
+		return pod, nil
+	}
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("get pod: %w", err)
+	}
+
+	// The pod is gone or got recreated with the same name.
+	if owner == nil {
+		return nil, nil
+	}
+	controller := &metav1.PartialObjectMetadata{}
+	controller.SetGroupVersionKind(schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind))
+	err = r.ClientGet(ctx, r.apiReader, types.NamespacedName{Namespace: podName.Namespace, Name: owner.Name}, controller)
+	if kerrors.IsNotFound(err) || (err == nil && controller.GetUID() != owner.UID) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get %s %s: %w", owner.Kind, owner.Name, err)
+	}
+	return controller, nil
+}
+
+// mergeRecordedProfiles adds the profiles to the comma separated list of
+// already recorded ones.
+func mergeRecordedProfiles(existing string, profiles []string) string {
+	merged := slices.Clone(profiles)
+	if existing != "" {
+		merged = append(merged, strings.Split(existing, ",")...)
+	}
+	slices.Sort(merged)
+	return strings.Join(slices.Compact(merged), ",")
+}
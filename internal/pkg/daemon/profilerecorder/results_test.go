@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	recordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder/profilerecorderfakes"
+)
+
+func TestAnnotateRecordedProfiles(t *testing.T) {
+	t.Parallel()
+
+	podName := types.NamespacedName{Namespace: "ns", Name: "pod"}
+	owner := &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-12345", UID: "2"}
+	profiles := []string{"seccompprofile/recording-ctr"}
+	notFound := kerrors.NewNotFound(schema.GroupResource{}, "")
+	getObjects := func(objects map[string]metav1.ObjectMeta) func(
+		context.Context, client.Client, client.ObjectKey, client.Object,
+	) error {
+		return func(_ context.Context, _ client.Client, key client.ObjectKey, obj client.Object) error {
+			meta, ok := objects[key.Name]
+			if !ok {
+				return notFound
+			}
+			partial, ok := obj.(*metav1.PartialObjectMetadata)
+			if !ok {
+				return errTest
+			}
+			partial.ObjectMeta = meta
+			return nil
+		}
+	}
+	patchedAnnotation := func(mock *profilerecorderfakes.FakeImpl, i int) (kind, name, annotation string) {
+		_, _, obj, _ := mock.ClientPatchArgsForCall(i)
+		partial, ok := obj.(*metav1.PartialObjectMetadata)
+		assert.True(t, ok)
+		return partial.Kind, partial.Name, partial.Annotations[recordingapi.RecordedProfilesAnnotation]
+	}
+
+	for _, tc := range []struct {
+		name     string
+		owner    *metav1.OwnerReference
+		profiles []string
+		prepare  func(*profilerecorderfakes.FakeImpl)
+		assert   func(*profilerecorderfakes.FakeImpl, error)
+	}{
+		{
+			name:     "annotate existing pod",
+			owner:    owner,
+			profiles: profiles,
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetCalls(getObjects(map[string]metav1.ObjectMeta{
+					"pod": {Name: "pod", UID: "1"},
+				}))
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, mock.ClientPatchCallCount())
+				kind, name, annotation := patchedAnnotation(mock, 0)
+				assert.Equal(t, "Pod", kind)
+				assert.Equal(t, "pod", name)
+				assert.Equal(t, "seccompprofile/recording-ctr", annotation)
+			},
+		},
+		{
+			name:     "annotate controller of removed pod",
+			owner:    owner,
+			profiles: profiles,
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetCalls(getObjects(map[string]metav1.ObjectMeta{
+					"app-12345": {
+						Name:        "app-12345",
+						UID:         "2",
+						Annotations: map[string]string{recordingapi.RecordedProfilesAnnotation: "seccompprofile/other"},
+					},
+				}))
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, mock.ClientPatchCallCount())
+				kind, name, annotation := patchedAnnotation(mock, 0)
+				assert.Equal(t, "ReplicaSet", kind)
+				assert.Equal(t, "app-12345", name)
+				assert.Equal(t, "seccompprofile/other,seccompprofile/recording-ctr", annotation)
+			},
+		},
+		{
+			name:     "annotate controller of recreated pod",
+			owner:    owner,
+			profiles: profiles,
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetCalls(getObjects(map[string]metav1.ObjectMeta{
+					"pod":       {Name: "pod", UID: "3"},
+					"app-12345": {Name: "app-12345", UID: "2"},
+				}))
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, mock.ClientPatchCallCount())
+				kind, _, _ := patchedAnnotation(mock, 0)
+				assert.Equal(t, "ReplicaSet", kind)
+			},
+		},
+		{
+			name:     "skip removed pod without controller",
+			profiles: profiles,
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetReturns(notFound)
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl, err error) {
+				assert.NoError(t, err)
+				assert.Zero(t, mock.ClientPatchCallCount())
+			},
+		},
+		{
+			name:     "skip removed controller",
+			owner:    owner,
+			profiles: profiles,
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetReturns(notFound)
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, mock.ClientGetCallCount())
+				assert.Zero(t, mock.ClientPatchCallCount())
+			},
+		},
+		{
+			name:    "skip without profiles",
+			owner:   owner,
+			prepare: func(*profilerecorderfakes.FakeImpl) {},
+			assert: func(mock *profilerecorderfakes.FakeImpl, err error) {
+				assert.NoError(t, err)
+				assert.Zero(t, mock.ClientGetCallCount())
+			},
+		},
+		{
+			name:     "retry on conflict",
+			owner:    owner,
+			profiles: profiles,
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetCalls(getObjects(map[string]metav1.ObjectMeta{
+					"pod": {Name: "pod", UID: "1"},
+				}))
+				mock.ClientPatchReturnsOnCall(0, kerrors.NewConflict(schema.GroupResource{}, "pod", errTest))
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, mock.ClientPatchCallCount())
+			},
+		},
+		{
+			name:     "failure on ClientGet",
+			owner:    owner,
+			profiles: profiles,
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.ClientGetReturns(errTest)
+			},
+			assert: func(mock *profilerecorderfakes.FakeImpl, err error) {
+				assert.ErrorIs(t, err, errTest)
+				assert.Zero(t, mock.ClientPatchCallCount())
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			tc.prepare(mock)
+			sut := &RecorderReconciler{impl: mock, log: logr.Discard()}
+
+			err := sut.annotateRecordedProfiles(context.Background(), podName, "1", tc.owner, tc.profiles)
+			tc.assert(mock, err)
+		})
+	}
+}
+
+func TestMergeRecordedProfiles(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"seccompprofile/a,seccompprofile/b,selinuxprofile/a",
+		mergeRecordedProfiles("seccompprofile/b,seccompprofile/a", []string{"selinuxprofile/a", "seccompprofile/b"}),
+	)
+	assert.Equal(t, "seccompprofile/a", mergeRecordedProfiles("", []string{"seccompprofile/a"}))
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profileserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+const (
+	// HandlerPath is the path for serving the installed profiles.
+	HandlerPath = "/profiles-spod"
+
+	// KindSeccomp is the kind of the installed seccomp profiles.
+	KindSeccomp = "seccomp"
+
+	// KindSelinux is the kind of the installed SELinux policies.
+	KindSelinux = "selinux"
+
+	// ChecksumHeader is the response header containing the SHA256 checksum
+	// of a downloaded profile.
+	ChecksumHeader = "X-Checksum-Sha256"
+)
+
+var errNotRegularFile = errors.New("not a regular file")
+
+// Index is the response for listing all installed profiles on a node.
+type Index struct {
+	// Node is the name of the node serving the profiles.
+	Node string `json:"node,omitempty"`
+
+	// Profiles are the installed profile files.
+	Profiles []File `json:"profiles"`
+}
+
+// File is a single installed profile file.
+type File struct {
+	// Kind is the kind of the profile, for example seccomp or selinux.
+	Kind string `json:"kind"`
+
+	// Path is the path of the file relative to the profile directory of its
+	// kind. It can be used to download the file from HandlerPath/Kind/Path.
+	Path string `json:"path"`
+
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+
+	// SHA256 is the hex encoded SHA256 checksum of the file content.
+	SHA256 string `json:"sha256"`
+
+	// ModTime is the last modification time of the file.
+	ModTime time.Time `json:"modTime"`
+}
+
+// Server serves the profile files installed on the node.
+type Server struct {
+	log   logr.Logger
+	node  string
+	roots map[string]string
+}
+
+// New returns a new Server for the seccomp profiles and SELinux policies
+// installed by the daemon.
+func New(logger logr.Logger) *Server {
+	return &Server{
+		log:  logger,
+		node: os.Getenv(config.NodeNameEnvKey),
+		roots: map[string]string{
+			KindSeccomp: config.ProfilesRootPath(),
+			KindSelinux: bindata.SelinuxDropDirectory,
+		},
+	}
+}
+
+// Handler returns the HTTP handler for listing and downloading the installed
+// profiles.
+func (s *Server) Handler() http.Handler {
+	handler := &http.ServeMux{}
+	handler.HandleFunc(HandlerPath, s.serveIndex)
+	handler.HandleFunc(HandlerPath+"/", s.serveFile)
+	return handler
+}
+
+func (s *Server) serveIndex(w http.ResponseWriter, r *http.Request) {
+	if !allowedMethod(w, r) {
+		return
+	}
+
+	index, err := s.index()
+	if err != nil {
+		s.log.Error(err, "Unable to list installed profiles")
+		http.Error(w, "unable to list installed profiles", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(index); err != nil {
+		s.log.Error(err, "Unable to write profile index")
+	}
+}
+
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request) {
+	if !allowedMethod(w, r) {
+		return
+	}
+
+	kind, rel, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, HandlerPath+"/"), "/")
+	root, ok := s.roots[kind]
+	if !ok || rel == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Cleaning the path as an absolute one removes all parent directory
+	// references, which ensures that the file is within the root.
+	filePath := filepath.Join(root, filepath.FromSlash(path.Clean("/"+rel)))
+
+	content, info, err := readRegularFile(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, errNotRegularFile) {
+			http.NotFound(w, r)
+			return
+		}
+		s.log.Error(err, "Unable to read installed profile", "path", filePath)
+		http.Error(w, "unable to read installed profile", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set(ChecksumHeader, checksum(content))
+	http.ServeContent(w, r, info.Name(), info.ModTime(), bytes.NewReader(content))
+}
+
+func (s *Server) index() (*Index, error) {
+	index := &Index{Node: s.node, Profiles: []File{}}
+
+	for kind, root := range s.roots {
+		err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if p == root && errors.Is(err, fs.ErrNotExist) {
+					return filepath.SkipDir
+				}
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+
+			content, info, err := readRegularFile(p)
+			if err != nil {
+				// The file may have been removed in the meantime
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return fmt.Errorf("get relative path: %w", err)
+			}
+
+			index.Profiles = append(index.Profiles, File{
+				Kind:    kind,
+				Path:    filepath.ToSlash(rel),
+				Size:    info.Size(),
+				SHA256:  checksum(content),
+				ModTime: info.ModTime().UTC(),
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("walk %s profiles in %s: %w", kind, root, err)
+		}
+	}
+
+	sort.Slice(index.Profiles, func(i, j int) bool {
+		if index.Profiles[i].Kind != index.Profiles[j].Kind {
+			return index.Profiles[i].Kind < index.Profiles[j].Kind
+		}
+		return index.Profiles[i].Path < index.Profiles[j].Path
+	})
+
+	return index, nil
+}
+
+func readRegularFile(filePath string) ([]byte, fs.FileInfo, error) {
+	info, err := os.Lstat(filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("stat file: %w", err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, nil, fmt.Errorf("%s: %w", filePath, errNotRegularFile)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read file: %w", err)
+	}
+
+	return content, info, nil
+}
+
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func allowedMethod(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profileserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testProfile = `{"defaultAction":"SCMP_ACT_ERRNO"}`
+	testPolicy  = "(block test)"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	seccompDir := t.TempDir()
+	selinuxDir := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(seccompDir, "default"), 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(seccompDir, "default", "profile.json"), []byte(testProfile), 0o644,
+	))
+	require.NoError(t, os.WriteFile(filepath.Join(selinuxDir, "policy.cil"), []byte(testPolicy), 0o644))
+	require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(selinuxDir, "link.cil")))
+
+	return &Server{
+		log:  logr.Discard(),
+		node: "node",
+		roots: map[string]string{
+			KindSeccomp: seccompDir,
+			KindSelinux: selinuxDir,
+		},
+	}
+}
+
+func TestIndex(t *testing.T) {
+	t.Parallel()
+	sut := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	sut.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HandlerPath, http.NoBody))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	index := &Index{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), index))
+	require.Equal(t, "node", index.Node)
+	require.Len(t, index.Profiles, 2)
+
+	require.Equal(t, KindSeccomp, index.Profiles[0].Kind)
+	require.Equal(t, "default/profile.json", index.Profiles[0].Path)
+	require.Equal(t, int64(len(testProfile)), index.Profiles[0].Size)
+	require.Equal(t, checksum([]byte(testProfile)), index.Profiles[0].SHA256)
+
+	require.Equal(t, KindSelinux, index.Profiles[1].Kind)
+	require.Equal(t, "policy.cil", index.Profiles[1].Path)
+	require.Equal(t, checksum([]byte(testPolicy)), index.Profiles[1].SHA256)
+}
+
+func TestIndexMissingRoot(t *testing.T) {
+	t.Parallel()
+	sut := newTestServer(t)
+	sut.roots[KindSelinux] = filepath.Join(t.TempDir(), "missing")
+
+	index, err := sut.index()
+	require.NoError(t, err)
+	require.Len(t, index.Profiles, 1)
+}
+
+func TestServeFile(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		method       string
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "seccomp profile",
+			method:       http.MethodGet,
+			path:         HandlerPath + "/seccomp/default/profile.json",
+			expectedCode: http.StatusOK,
+			expectedBody: testProfile,
+		},
+		{
+			name:         "selinux policy",
+			method:       http.MethodGet,
+			path:         HandlerPath + "/selinux/policy.cil",
+			expectedCode: http.StatusOK,
+			expectedBody: testPolicy,
+		},
+		{
+			name:         "unknown kind",
+			method:       http.MethodGet,
+			path:         HandlerPath + "/apparmor/profile",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "missing file",
+			method:       http.MethodGet,
+			path:         HandlerPath + "/seccomp/missing.json",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "directory",
+			method:       http.MethodGet,
+			path:         HandlerPath + "/seccomp/default",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "symlink",
+			method:       http.MethodGet,
+			path:         HandlerPath + "/selinux/link.cil",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "path traversal",
+			method:       http.MethodGet,
+			path:         HandlerPath + "/seccomp/%2e%2e/%2e%2e/etc/passwd",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "method not allowed",
+			method:       http.MethodPost,
+			path:         HandlerPath + "/seccomp/default/profile.json",
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			sut := newTestServer(t)
+
+			rec := httptest.NewRecorder()
+			sut.serveFile(rec, httptest.NewRequest(tc.method, tc.path, http.NoBody))
+			require.Equal(t, tc.expectedCode, rec.Code)
+
+			if tc.expectedCode == http.StatusOK {
+				require.Equal(t, tc.expectedBody, rec.Body.String())
+				require.Equal(t, checksum([]byte(tc.expectedBody)), rec.Header().Get(ChecksumHeader))
+			}
+		})
+	}
+}
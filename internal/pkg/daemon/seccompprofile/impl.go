@@ -37,7 +37,10 @@ type defaultImpl struct{}
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
 //counterfeiter:generate . impl
 type impl interface {
-	Pull(context.Context, logr.Logger, string, string, string, *v1.Platform, bool) (*artifact.PullResult, error)
+	Pull(
+		context.Context, logr.Logger, string, string, string, *v1.Platform, bool,
+		*spodv1alpha1.OCIArtifactSignaturePolicy,
+	) (*artifact.PullResult, error)
 	PullResultType(*artifact.PullResult) artifact.PullResultType
 	PullResultSeccompProfile(*artifact.PullResult) *seccompprofileapi.SeccompProfile
 	ClientGetProfile(
@@ -54,8 +57,11 @@ func (*defaultImpl) Pull(
 	from, username, password string,
 	platform *v1.Platform,
 	disableSignatureVerification bool,
+	signaturePolicy *spodv1alpha1.OCIArtifactSignaturePolicy,
 ) (*artifact.PullResult, error) {
-	return artifact.New(l).Pull(ctx, from, username, password, platform, disableSignatureVerification)
+	return artifact.New(l).Pull(
+		ctx, from, username, password, platform, disableSignatureVerification, signaturePolicy,
+	)
 }
 
 func (*defaultImpl) PullResultType(res *artifact.PullResult) artifact.PullResultType {
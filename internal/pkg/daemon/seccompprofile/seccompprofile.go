@@ -51,6 +51,7 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
@@ -72,6 +73,10 @@ const (
 
 	filePermissionMode os.FileMode = 0o644
 
+	// metadataFileSuffix is the suffix of the companion file containing the
+	// metadata of a profile next to the profile on disk.
+	metadataFileSuffix = ".metadata"
+
 	// MkdirAll won't create a directory if it does not have the execute bit.
 	// https://github.com/golang/go/issues/22323#issuecomment-340568811
 	dirPermissionMode os.FileMode = 0o744
@@ -112,6 +117,7 @@ type Reconciler struct {
 	save         saver
 	metrics      *metrics.Metrics
 	baseProfiles *ttlcache.Cache[string, *seccompprofileapi.SeccompProfile]
+	dryRun       bool
 }
 
 // Name returns the name of the controller.
@@ -172,10 +178,14 @@ func (r *Reconciler) Setup(
 	r.record = mgr.GetEventRecorderFor("profile")
 	r.save = saveProfileOnDisk
 	r.metrics = met
+	r.dryRun = common.DryRun()
 
 	// Register the regular reconciler to manage SeccompProfiles
-	return ctrl.NewControllerManagedBy(mgr).
-		Named("profile").
+	b := common.WatchNodeResync(
+		ctrl.NewControllerManagedBy(mgr), r.client, r.record, r.log, "SeccompProfile",
+		func() client.ObjectList { return &seccompprofileapi.SeccompProfileList{} },
+	)
+	return b.Named("profile").
 		For(&seccompprofileapi.SeccompProfile{}).
 		Watches(
 			&spodapi.SecurityProfilesOperatorDaemon{},
@@ -308,7 +318,7 @@ func (r *Reconciler) mergeBaseProfile(
 	}
 	jsonSyscalls := string(scBytes)
 
-	const key = "syscalls"
+	const key = seccompprofileapi.SyscallsAnnotation
 	if sp.Annotations[key] != jsonSyscalls {
 		l.Info("Updating syscall annotations", "profile", sp.Name)
 
@@ -376,7 +386,7 @@ func (r *Reconciler) resolveSyscallsForProfile(
 			res, err := r.Pull(ctx, l, from, "", "", &v1.Platform{
 				Architecture: runtime.GOARCH,
 				OS:           runtime.GOOS,
-			}, spod.Spec.DisableOCIArtifactSignatureVerification)
+			}, spod.Spec.DisableOCIArtifactSignatureVerification, spod.Spec.OCIArtifactSignaturePolicy)
 			if err != nil {
 				l.Error(err, "cannot pull base profile "+baseProfileName)
 				r.IncSeccompProfileError(r.metrics, reasonCannotPullProfile)
@@ -489,6 +499,20 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return reconcile.Result{}, nil
 	}
 
+	if sp.IsExpired(time.Now()) {
+		return r.reconcileExpiry(ctx, sp, nodeStatus, l)
+	}
+
+	if r.dryRun {
+		if err := common.ReportDryRun(ctx, nodeStatus, r.record, l, sp, profilePath, profileContent); err != nil {
+			l.Error(err, "cannot report dry run")
+			r.metrics.IncSeccompProfileError(reasonCannotUpdateStatus)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+			return reconcile.Result{}, fmt.Errorf("reporting dry run of SeccompProfile: %w", err)
+		}
+		return expiryResult(sp, time.Now()), nil
+	}
+
 	l.Info("Saving profile to disk")
 	updated, err := r.save(profilePath, profileContent)
 	if err != nil {
@@ -504,6 +528,13 @@ func (r *Reconciler) reconcileSeccompProfile(
 		r.record.Event(sp, util.EventTypeNormal, reasonSavedProfile, evstr)
 	}
 
+	if err := r.saveMetadata(sp, profilePath); err != nil {
+		l.Error(err, "cannot save profile metadata into disk")
+		r.metrics.IncSeccompProfileError(reasonCannotSaveProfile)
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotSaveProfile, err.Error())
+		return reconcile.Result{}, fmt.Errorf("cannot save profile metadata into disk: %w", err)
+	}
+
 	l.Info("Checking node status")
 	isAlreadyInstalled, getErr := nodeStatus.Matches(ctx, statusv1alpha1.ProfileStateInstalled)
 	if getErr != nil {
@@ -513,7 +544,7 @@ func (r *Reconciler) reconcileSeccompProfile(
 
 	if isAlreadyInstalled {
 		l.Info("Already in the expected Installed state")
-		return reconcile.Result{}, nil
+		return expiryResult(sp, time.Now()), nil
 	}
 
 	l.Info("Set node status to installed")
@@ -529,9 +560,54 @@ func (r *Reconciler) reconcileSeccompProfile(
 		"resource version", sp.GetResourceVersion(),
 		"name", sp.GetName(),
 	)
+	return expiryResult(sp, time.Now()), nil
+}
+
+// reconcileExpiry disables an expired profile on the node and optionally
+// removes it from disk.
+func (r *Reconciler) reconcileExpiry(
+	ctx context.Context,
+	sp *seccompprofileapi.SeccompProfile,
+	nsc *nodestatus.StatusClient,
+	l logr.Logger,
+) (reconcile.Result, error) {
+	if sp.Spec.RemoveOnExpiry && !r.dryRun {
+		l.Info("Removing expired profile from disk")
+		if err := r.handleDeletion(sp); err != nil {
+			l.Error(err, "cannot remove expired profile from disk")
+			r.metrics.IncSeccompProfileError(reasonCannotRemoveProfile)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotRemoveProfile, err.Error())
+			return reconcile.Result{}, fmt.Errorf("removing expired profile from disk: %w", err)
+		}
+	}
+
+	isExpired, err := nsc.Matches(ctx, statusv1alpha1.ProfileStateExpired)
+	if err != nil {
+		l.Error(err, "couldn't get current status")
+		return reconcile.Result{}, fmt.Errorf("getting status for expired SeccompProfile: %w", err)
+	}
+
+	if !isExpired {
+		l.Info("Set node status to expired")
+		if err := nsc.SetNodeStatus(ctx, statusv1alpha1.ProfileStateExpired); err != nil {
+			l.Error(err, "cannot update node status")
+			r.metrics.IncSeccompProfileError(reasonCannotUpdateStatus)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
+			return reconcile.Result{}, fmt.Errorf("updating status for expired SeccompProfile: %w", err)
+		}
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// expiryResult requeues the profile once it expires.
+func expiryResult(sp *seccompprofileapi.SeccompProfile, now time.Time) reconcile.Result {
+	if sp.Spec.ExpiresAt == nil {
+		return reconcile.Result{}
+	}
+	return reconcile.Result{RequeueAfter: max(sp.Spec.ExpiresAt.Sub(now), time.Second)}
+}
+
 func (r *Reconciler) reconcileDeletion(
 	ctx context.Context,
 	sp *seccompprofileapi.SeccompProfile,
@@ -567,7 +643,9 @@ func (r *Reconciler) reconcileDeletion(
 		return reconcile.Result{RequeueAfter: wait}, nil
 	}
 
-	if err := r.handleDeletion(sp); err != nil {
+	if r.dryRun {
+		r.log.Info("Dry run, not removing profile from disk")
+	} else if err := r.handleDeletion(sp); err != nil {
 		r.log.Error(err, "cannot delete profile")
 		r.metrics.IncSeccompProfileError(reasonCannotRemoveProfile)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotRemoveProfile, err.Error())
@@ -584,8 +662,48 @@ func (r *Reconciler) reconcileDeletion(
 	return ctrl.Result{}, nil
 }
 
+// saveMetadata saves the metadata of the profile into a companion file next
+// to the profile and exposes it as metrics. The companion file gets removed if
+// the profile has no metadata (anymore).
+func (r *Reconciler) saveMetadata(sp *seccompprofileapi.SeccompProfile, profilePath string) error {
+	metadataPath := profilePath + metadataFileSuffix
+	metadata := util.ProfileMetadata(sp.GetAnnotations())
+	if len(metadata) == 0 {
+		r.metrics.DeleteSeccompProfileInfo(sp.GetNamespace(), sp.GetName())
+		return removeMetadata(metadataPath)
+	}
+
+	content, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("marshal profile metadata: %w", err)
+	}
+	if _, err := r.save(metadataPath, content); err != nil {
+		return fmt.Errorf("save profile metadata: %w", err)
+	}
+
+	r.metrics.SetSeccompProfileInfo(
+		sp.GetNamespace(), sp.GetName(),
+		metadata[util.ProfileMetadataOwner],
+		metadata[util.ProfileMetadataTicket],
+		metadata[util.ProfileMetadataExpiry],
+	)
+	return nil
+}
+
+func removeMetadata(metadataPath string) error {
+	if err := os.Remove(metadataPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing profile metadata from host: %w", err)
+	}
+	return nil
+}
+
 func (r *Reconciler) handleDeletion(sp *seccompprofileapi.SeccompProfile) error {
 	profilePath := sp.GetProfilePath()
+	r.metrics.DeleteSeccompProfileInfo(sp.GetNamespace(), sp.GetName())
+	if err := removeMetadata(profilePath + metadataFileSuffix); err != nil {
+		return err
+	}
+
 	err := os.Remove(profilePath)
 	if os.IsNotExist(err) {
 		return nil
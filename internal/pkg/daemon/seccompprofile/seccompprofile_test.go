@@ -23,9 +23,11 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/containers/common/pkg/seccomp"
 	"github.com/go-logr/logr"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/require"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -203,6 +205,73 @@ func TestSaveProfileOnDisk(t *testing.T) {
 	}
 }
 
+func TestSaveMetadata(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	profilePath := path.Join(dir, "profile.json")
+	metadataPath := profilePath + metadataFileSuffix
+	sut := &Reconciler{save: saveProfileOnDisk, metrics: metrics.New()}
+
+	sp := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "profile",
+			Namespace: "namespace",
+			Annotations: map[string]string{
+				config.ProfileMetadataAnnotationPrefix + util.ProfileMetadataOwner: "team-a",
+				config.ProfileMetadataAnnotationPrefix + "ticket":                  "SEC-123",
+				"other": "value",
+			},
+		},
+	}
+	require.NoError(t, sut.saveMetadata(sp, profilePath))
+	content, err := os.ReadFile(metadataPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"owner":"team-a","ticket":"SEC-123"}`, string(content))
+
+	sp.Annotations = nil
+	require.NoError(t, sut.saveMetadata(sp, profilePath))
+	_, err = os.Stat(metadataPath)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestExpiryResult(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	for _, tc := range []struct {
+		name      string
+		expiresAt *metav1.Time
+		want      reconcile.Result
+	}{
+		{
+			name: "NoExpiry",
+			want: reconcile.Result{},
+		},
+		{
+			name:      "FutureExpiry",
+			expiresAt: &metav1.Time{Time: now.Add(time.Hour)},
+			want:      reconcile.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name:      "PastExpiry",
+			expiresAt: &metav1.Time{Time: now.Add(-time.Hour)},
+			want:      reconcile.Result{RequeueAfter: time.Second},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := &seccompprofileapi.SeccompProfile{
+				Spec: seccompprofileapi.SeccompProfileSpec{ExpiresAt: tc.expiresAt},
+			}
+			require.Equal(t, tc.want, expiryResult(sp, now))
+			require.Equal(t, tc.expiresAt != nil && tc.expiresAt.Before(&metav1.Time{Time: now}), sp.IsExpired(now))
+		})
+	}
+}
+
 func TestGetProfilePath(t *testing.T) {
 	t.Parallel()
 
@@ -683,6 +752,46 @@ func TestResolveSyscallsForProfile(t *testing.T) {
 				require.Equal(t, "first", syscalls[2].Names[0])
 			},
 		},
+		{
+			name: "success remote base profile with signature policy",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{
+						OCIArtifactSignaturePolicy: &spodapi.OCIArtifactSignaturePolicy{PublicKey: "key"},
+					},
+				}, nil)
+				mock.PullCalls(func(
+					_ context.Context, _ logr.Logger, _, _, _ string, _ *v1.Platform, _ bool,
+					policy *spodapi.OCIArtifactSignaturePolicy,
+				) (*artifact.PullResult, error) {
+					if policy == nil || policy.PublicKey != "key" {
+						return nil, errTest
+					}
+					return &artifact.PullResult{}, nil
+				})
+				mock.PullResultTypeReturns(artifact.PullResultTypeSeccompProfile)
+				mock.PullResultSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					Spec: seccompprofileapi.SeccompProfileSpec{
+						Syscalls: []*seccompprofileapi.Syscall{
+							{Names: []string{"second"}},
+						},
+					},
+				})
+
+				return &seccompprofileapi.SeccompProfile{
+					Spec: seccompprofileapi.SeccompProfileSpec{
+						BaseProfileName: config.OCIProfilePrefix + "test",
+						Syscalls: []*seccompprofileapi.Syscall{
+							{Names: []string{"first"}},
+						},
+					},
+				}
+			},
+			assert: func(syscalls []*seccompprofileapi.Syscall, err error) {
+				require.NoError(t, err)
+				require.Len(t, syscalls, 2)
+			},
+		},
 		{
 			name: "failure on wrong PullResultTypeSeccompProfile",
 			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
@@ -24,7 +24,6 @@ import (
 	"github.com/go-logr/logr"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
@@ -34,12 +33,12 @@ import (
 )
 
 type FakeImpl struct {
-	ClientGetProfileStub        func(context.Context, client.Client, types.NamespacedName, ...client.GetOption) (*v1beta1.SeccompProfile, error)
+	ClientGetProfileStub        func(context.Context, client.Client, client.ObjectKey, ...client.GetOption) (*v1beta1.SeccompProfile, error)
 	clientGetProfileMutex       sync.RWMutex
 	clientGetProfileArgsForCall []struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 		arg4 []client.GetOption
 	}
 	clientGetProfileReturns struct {
@@ -70,7 +69,7 @@ type FakeImpl struct {
 		arg1 *metrics.Metrics
 		arg2 string
 	}
-	PullStub        func(context.Context, logr.Logger, string, string, string, *v1.Platform, bool) (*artifact.PullResult, error)
+	PullStub        func(context.Context, logr.Logger, string, string, string, *v1.Platform, bool, *v1alpha1.OCIArtifactSignaturePolicy) (*artifact.PullResult, error)
 	pullMutex       sync.RWMutex
 	pullArgsForCall []struct {
 		arg1 context.Context
@@ -80,6 +79,7 @@ type FakeImpl struct {
 		arg5 string
 		arg6 *v1.Platform
 		arg7 bool
+		arg8 *v1alpha1.OCIArtifactSignaturePolicy
 	}
 	pullReturns struct {
 		result1 *artifact.PullResult
@@ -124,13 +124,13 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeImpl) ClientGetProfile(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName, arg4 ...client.GetOption) (*v1beta1.SeccompProfile, error) {
+func (fake *FakeImpl) ClientGetProfile(arg1 context.Context, arg2 client.Client, arg3 client.ObjectKey, arg4 ...client.GetOption) (*v1beta1.SeccompProfile, error) {
 	fake.clientGetProfileMutex.Lock()
 	ret, specificReturn := fake.clientGetProfileReturnsOnCall[len(fake.clientGetProfileArgsForCall)]
 	fake.clientGetProfileArgsForCall = append(fake.clientGetProfileArgsForCall, struct {
 		arg1 context.Context
 		arg2 client.Client
-		arg3 types.NamespacedName
+		arg3 client.ObjectKey
 		arg4 []client.GetOption
 	}{arg1, arg2, arg3, arg4})
 	stub := fake.ClientGetProfileStub
@@ -152,13 +152,13 @@ func (fake *FakeImpl) ClientGetProfileCallCount() int {
 	return len(fake.clientGetProfileArgsForCall)
 }
 
-func (fake *FakeImpl) ClientGetProfileCalls(stub func(context.Context, client.Client, types.NamespacedName, ...client.GetOption) (*v1beta1.SeccompProfile, error)) {
+func (fake *FakeImpl) ClientGetProfileCalls(stub func(context.Context, client.Client, client.ObjectKey, ...client.GetOption) (*v1beta1.SeccompProfile, error)) {
 	fake.clientGetProfileMutex.Lock()
 	defer fake.clientGetProfileMutex.Unlock()
 	fake.ClientGetProfileStub = stub
 }
 
-func (fake *FakeImpl) ClientGetProfileArgsForCall(i int) (context.Context, client.Client, types.NamespacedName, []client.GetOption) {
+func (fake *FakeImpl) ClientGetProfileArgsForCall(i int) (context.Context, client.Client, client.ObjectKey, []client.GetOption) {
 	fake.clientGetProfileMutex.RLock()
 	defer fake.clientGetProfileMutex.RUnlock()
 	argsForCall := fake.clientGetProfileArgsForCall[i]
@@ -289,7 +289,7 @@ func (fake *FakeImpl) IncSeccompProfileErrorArgsForCall(i int) (*metrics.Metrics
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeImpl) Pull(arg1 context.Context, arg2 logr.Logger, arg3 string, arg4 string, arg5 string, arg6 *v1.Platform, arg7 bool) (*artifact.PullResult, error) {
+func (fake *FakeImpl) Pull(arg1 context.Context, arg2 logr.Logger, arg3 string, arg4 string, arg5 string, arg6 *v1.Platform, arg7 bool, arg8 *v1alpha1.OCIArtifactSignaturePolicy) (*artifact.PullResult, error) {
 	fake.pullMutex.Lock()
 	ret, specificReturn := fake.pullReturnsOnCall[len(fake.pullArgsForCall)]
 	fake.pullArgsForCall = append(fake.pullArgsForCall, struct {
@@ -300,13 +300,14 @@ func (fake *FakeImpl) Pull(arg1 context.Context, arg2 logr.Logger, arg3 string,
 		arg5 string
 		arg6 *v1.Platform
 		arg7 bool
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+		arg8 *v1alpha1.OCIArtifactSignaturePolicy
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	stub := fake.PullStub
 	fakeReturns := fake.pullReturns
-	fake.recordInvocation("Pull", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordInvocation("Pull", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8})
 	fake.pullMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -320,17 +321,17 @@ func (fake *FakeImpl) PullCallCount() int {
 	return len(fake.pullArgsForCall)
 }
 
-func (fake *FakeImpl) PullCalls(stub func(context.Context, logr.Logger, string, string, string, *v1.Platform, bool) (*artifact.PullResult, error)) {
+func (fake *FakeImpl) PullCalls(stub func(context.Context, logr.Logger, string, string, string, *v1.Platform, bool, *v1alpha1.OCIArtifactSignaturePolicy) (*artifact.PullResult, error)) {
 	fake.pullMutex.Lock()
 	defer fake.pullMutex.Unlock()
 	fake.PullStub = stub
 }
 
-func (fake *FakeImpl) PullArgsForCall(i int) (context.Context, logr.Logger, string, string, string, *v1.Platform, bool) {
+func (fake *FakeImpl) PullArgsForCall(i int) (context.Context, logr.Logger, string, string, string, *v1.Platform, bool, *v1alpha1.OCIArtifactSignaturePolicy) {
 	fake.pullMutex.RLock()
 	defer fake.pullMutex.RUnlock()
 	argsForCall := fake.pullArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8
 }
 
 func (fake *FakeImpl) PullReturns(result1 *artifact.PullResult, result2 error) {
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxprofile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+)
+
+const (
+	booleansDir       = "booleans"
+	commitPendingBool = "commit_pending_bools"
+)
+
+var (
+	errInvalidBooleanValue = errors.New("invalid SELinux boolean value")
+	errConflictingBoolean  = errors.New("conflicting SELinux boolean value")
+)
+
+// getBoolean returns the current value of a SELinux boolean from selinuxfs,
+// which contains the current and the pending value, like "1 1".
+func getBoolean(selinuxFsPath, name string) (bool, error) {
+	content, err := os.ReadFile(filepath.Join(selinuxFsPath, booleansDir, name))
+	if err != nil {
+		return false, fmt.Errorf("reading SELinux boolean %s: %w", name, err)
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return false, fmt.Errorf("%s: %w", name, errInvalidBooleanValue)
+	}
+
+	switch fields[0] {
+	case "1":
+		return true, nil
+	case "0":
+		return false, nil
+	}
+
+	return false, fmt.Errorf("%s: %q: %w", name, fields[0], errInvalidBooleanValue)
+}
+
+// setBoolean sets the runtime value of a SELinux boolean in the same way as
+// setsebool does without making the value persistent.
+func setBoolean(selinuxFsPath, name string, value bool) error {
+	content := "0"
+	if value {
+		content = "1"
+	}
+
+	const filePermissions = 0o644
+	if err := os.WriteFile(
+		filepath.Join(selinuxFsPath, booleansDir, name), []byte(content), filePermissions,
+	); err != nil {
+		return fmt.Errorf("writing SELinux boolean %s: %w", name, err)
+	}
+
+	if err := os.WriteFile(
+		filepath.Join(selinuxFsPath, commitPendingBool), []byte("1"), filePermissions,
+	); err != nil {
+		return fmt.Errorf("committing SELinux boolean %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// applyBooleans sets the desired SELinux booleans and reverts the ones which
+// are not desired any more. The booleans are node wide, which is why others
+// contains the applied states of all other profiles on the node: a boolean is
+// only reverted if no other profile requires it, and its original value is
+// taken from the other profiles if they already changed it. The returned
+// states are sorted by name and keep the original values of the already
+// applied booleans, so that they can be restored later on.
+func applyBooleans(
+	selinuxFsPath string,
+	desired map[string]bool,
+	current, others []statusv1alpha1.SelinuxBooleanStatus,
+) ([]statusv1alpha1.SelinuxBooleanStatus, error) {
+	known := make(map[string]statusv1alpha1.SelinuxBooleanStatus, len(current))
+	for _, b := range current {
+		known[b.Name] = b
+	}
+	shared := make(map[string]statusv1alpha1.SelinuxBooleanStatus, len(others))
+	for _, b := range others {
+		shared[b.Name] = b
+	}
+
+	var errs []error
+	for _, b := range current {
+		if _, ok := desired[b.Name]; ok || !b.Applied {
+			continue
+		}
+		if _, ok := shared[b.Name]; ok {
+			// Still required by another profile on the node
+			continue
+		}
+		if err := setBoolean(selinuxFsPath, b.Name, b.Original); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	res := make([]statusv1alpha1.SelinuxBooleanStatus, 0, len(desired))
+	for name, value := range desired {
+		state := statusv1alpha1.SelinuxBooleanStatus{Name: name, Value: value}
+		other, isShared := shared[name]
+		if isShared && other.Value != value {
+			err := fmt.Errorf(
+				"%s: %w: %t is required by another profile on the node", name, errConflictingBoolean, other.Value,
+			)
+			state.Message = err.Error()
+			errs = append(errs, err)
+			res = append(res, state)
+			continue
+		}
+
+		if prev, ok := known[name]; ok && prev.Applied {
+			// Keep the original value and the applied flag, so that the
+			// boolean still gets reverted if setting it fails this time.
+			state.Original = prev.Original
+			state.Applied = true
+		} else if isShared {
+			// The current value has been set by another profile, which is
+			// why its original value has to be restored later on.
+			state.Original = other.Original
+		} else {
+			original, err := getBoolean(selinuxFsPath, name)
+			if err != nil {
+				state.Message = err.Error()
+				errs = append(errs, err)
+				res = append(res, state)
+				continue
+			}
+			state.Original = original
+		}
+
+		if err := setBoolean(selinuxFsPath, name, value); err != nil {
+			state.Message = err.Error()
+			errs = append(errs, err)
+		} else {
+			state.Applied = true
+		}
+		res = append(res, state)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res, errors.Join(errs...)
+}
+
+// revertBooleans restores the original values of all applied booleans which
+// are not required by any of the other profiles on the node.
+func revertBooleans(
+	selinuxFsPath string,
+	current, others []statusv1alpha1.SelinuxBooleanStatus,
+) error {
+	shared := make(map[string]bool, len(others))
+	for _, b := range others {
+		shared[b.Name] = true
+	}
+
+	var errs []error
+	for _, b := range current {
+		if !b.Applied || shared[b.Name] {
+			continue
+		}
+		if err := setBoolean(selinuxFsPath, b.Name, b.Original); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxprofile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+)
+
+func newTestSelinuxFs(t *testing.T, booleans map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, booleansDir), 0o755))
+	for name, value := range booleans {
+		require.NoError(t, os.WriteFile(filepath.Join(root, booleansDir, name), []byte(value), 0o644))
+	}
+	return root
+}
+
+func readTestBoolean(t *testing.T, root, name string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(root, booleansDir, name))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestApplyBooleans(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		booleans map[string]string
+		desired  map[string]bool
+		current  []statusv1alpha1.SelinuxBooleanStatus
+		others   []statusv1alpha1.SelinuxBooleanStatus
+		assert   func(string, []statusv1alpha1.SelinuxBooleanStatus, error)
+	}{
+		{
+			name:     "set new boolean",
+			booleans: map[string]string{"container_use_devices": "0 0"},
+			desired:  map[string]bool{"container_use_devices": true},
+			assert: func(root string, res []statusv1alpha1.SelinuxBooleanStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []statusv1alpha1.SelinuxBooleanStatus{{
+					Name: "container_use_devices", Value: true, Original: false, Applied: true,
+				}}, res)
+				require.Equal(t, "1", readTestBoolean(t, root, "container_use_devices"))
+				require.Equal(t, "1", readTestBoolean(t, root, "../"+commitPendingBool))
+			},
+		},
+		{
+			name:     "keep original of applied boolean",
+			booleans: map[string]string{"container_use_devices": "1 1"},
+			desired:  map[string]bool{"container_use_devices": true},
+			current: []statusv1alpha1.SelinuxBooleanStatus{{
+				Name: "container_use_devices", Value: true, Original: false, Applied: true,
+			}},
+			assert: func(root string, res []statusv1alpha1.SelinuxBooleanStatus, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				require.False(t, res[0].Original)
+				require.True(t, res[0].Applied)
+			},
+		},
+		{
+			name:     "revert removed boolean",
+			booleans: map[string]string{"container_use_devices": "1 1"},
+			current: []statusv1alpha1.SelinuxBooleanStatus{{
+				Name: "container_use_devices", Value: true, Original: false, Applied: true,
+			}},
+			assert: func(root string, res []statusv1alpha1.SelinuxBooleanStatus, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res)
+				require.Equal(t, "0", readTestBoolean(t, root, "container_use_devices"))
+			},
+		},
+		{
+			name:     "keep boolean required by other profile",
+			booleans: map[string]string{"container_use_devices": "1 1"},
+			current: []statusv1alpha1.SelinuxBooleanStatus{{
+				Name: "container_use_devices", Value: true, Original: false, Applied: true,
+			}},
+			others: []statusv1alpha1.SelinuxBooleanStatus{{
+				Name: "container_use_devices", Value: true, Original: false, Applied: true,
+			}},
+			assert: func(root string, res []statusv1alpha1.SelinuxBooleanStatus, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res)
+				require.Equal(t, "1 1", readTestBoolean(t, root, "container_use_devices"))
+			},
+		},
+		{
+			name:     "use original of other profile",
+			booleans: map[string]string{"container_use_devices": "1 1"},
+			desired:  map[string]bool{"container_use_devices": true},
+			others: []statusv1alpha1.SelinuxBooleanStatus{{
+				Name: "container_use_devices", Value: true, Original: false, Applied: true,
+			}},
+			assert: func(root string, res []statusv1alpha1.SelinuxBooleanStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []statusv1alpha1.SelinuxBooleanStatus{{
+					Name: "container_use_devices", Value: true, Original: false, Applied: true,
+				}}, res)
+			},
+		},
+		{
+			name:     "conflicting value of other profile",
+			booleans: map[string]string{"container_use_devices": "1 1"},
+			desired:  map[string]bool{"container_use_devices": false},
+			others: []statusv1alpha1.SelinuxBooleanStatus{{
+				Name: "container_use_devices", Value: true, Original: false, Applied: true,
+			}},
+			assert: func(root string, res []statusv1alpha1.SelinuxBooleanStatus, err error) {
+				require.ErrorIs(t, err, errConflictingBoolean)
+				require.Len(t, res, 1)
+				require.False(t, res[0].Applied)
+				require.NotEmpty(t, res[0].Message)
+				require.Equal(t, "1 1", readTestBoolean(t, root, "container_use_devices"))
+			},
+		},
+		{
+			name:    "unknown boolean",
+			desired: map[string]bool{"unknown": true},
+			assert: func(root string, res []statusv1alpha1.SelinuxBooleanStatus, err error) {
+				require.Error(t, err)
+				require.Len(t, res, 1)
+				require.False(t, res[0].Applied)
+				require.NotEmpty(t, res[0].Message)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			root := newTestSelinuxFs(t, tc.booleans)
+			res, err := applyBooleans(root, tc.desired, tc.current, tc.others)
+			tc.assert(root, res, err)
+		})
+	}
+}
+
+func TestRevertBooleans(t *testing.T) {
+	t.Parallel()
+
+	root := newTestSelinuxFs(t, map[string]string{
+		"container_use_devices":   "1 1",
+		"container_manage_cgroup": "1 1",
+		"virt_use_nfs":            "1 1",
+	})
+	err := revertBooleans(root, []statusv1alpha1.SelinuxBooleanStatus{
+		{Name: "container_use_devices", Value: true, Original: false, Applied: true},
+		{Name: "container_manage_cgroup", Value: true, Original: false, Applied: false},
+		{Name: "virt_use_nfs", Value: true, Original: false, Applied: true},
+	}, []statusv1alpha1.SelinuxBooleanStatus{
+		{Name: "virt_use_nfs", Value: true, Original: false, Applied: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0", readTestBoolean(t, root, "container_use_devices"))
+	require.Equal(t, "1 1", readTestBoolean(t, root, "container_manage_cgroup"))
+	require.Equal(t, "1 1", readTestBoolean(t, root, "virt_use_nfs"))
+}
@@ -42,9 +42,11 @@ import (
 	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
@@ -55,6 +57,10 @@ const (
 	selinuxdSocketTimeout   = 5 * time.Second
 
 	selinuxdReadyKey = "ready"
+
+	// inheritRequeueInterval is the interval for checking again if the
+	// inherited policies of a profile got installed.
+	inheritRequeueInterval = 10 * time.Second
 )
 
 type sePolStatusType string
@@ -77,6 +83,9 @@ const (
 	reasonCannotGetPolicyStatus    string = "CannotGetPolicyStatus"
 	reasonCannotUpdatePolicyStatus string = "CannotUpdatePolicyStatus"
 	reasonInstalledPolicy          string = "SavedSelinuxPolicy"
+	reasonCannotSetBooleans        string = "CannotSetSelinuxBooleans"
+	reasonCannotRevertBooleans     string = "CannotRevertSelinuxBooleans"
+	reasonWaitingForInherit        string = "WaitingForInheritedPolicy"
 )
 
 // blank assignment to verify that ReconcileSelinux implements `reconcile.Reconciler`.
@@ -97,7 +106,11 @@ type ReconcileSelinux struct {
 	controllerName    string
 	objectHandlerInit SelinuxObjectHandlerInit
 	ctrlBuilder       controllerBuilder
+	profileKind       string
+	newProfileList    func() client.ObjectList
 	httpc             *http.Client
+	selinuxFsPath     string
+	dryRun            bool
 }
 
 // Setup adds a controller that reconciles selinux profiles.
@@ -111,6 +124,8 @@ func (r *ReconcileSelinux) Setup(
 	r.scheme = mgr.GetScheme()
 	r.record = mgr.GetEventRecorderFor(r.controllerName)
 	r.metrics = met
+	r.selinuxFsPath = bindata.SelinuxFsPath
+	r.dryRun = common.DryRun()
 	r.httpc = &http.Client{
 		Transport: &http.Transport{
 			DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
@@ -119,7 +134,10 @@ func (r *ReconcileSelinux) Setup(
 		},
 	}
 
-	return r.ctrlBuilder(ctrl.NewControllerManagedBy(mgr), r)
+	b := common.WatchNodeResync(
+		ctrl.NewControllerManagedBy(mgr), r.client, r.record, r.log, r.profileKind, r.newProfileList,
+	)
+	return r.ctrlBuilder(b, r)
 }
 
 // Name returns the name of the controller.
@@ -219,6 +237,13 @@ func (r *ReconcileSelinux) Reconcile(ctx context.Context, request reconcile.Requ
 		return res, err
 	}
 
+	if err := r.reconcileDeleteBooleans(ctx, nodeStatus); err != nil {
+		reqLogger.Error(err, "cannot revert SELinux booleans")
+		r.metrics.IncSelinuxProfileError(reasonCannotRevertBooleans)
+		r.record.Event(instance, util.EventTypeWarning, reasonCannotRevertBooleans, err.Error())
+		return reconcile.Result{}, fmt.Errorf("reverting SELinux booleans: %w", err)
+	}
+
 	if err := nodeStatus.Remove(ctx, r.client); err != nil {
 		reqLogger.Error(err, "cannot remove finalizer from SELinux profile")
 		r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
@@ -248,7 +273,11 @@ func (r *ReconcileSelinux) reconcilePolicy(
 		return reconcile.Result{Requeue: true}, nil
 	}
 
-	if valErr := oh.Validate(); valErr != nil {
+	valErr := oh.Validate()
+	if valErr == nil {
+		valErr = validateCILPolicy(oh)
+	}
+	if valErr != nil {
 		if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateError); err != nil {
 			r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
 			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdatePolicyStatus, err.Error())
@@ -265,6 +294,28 @@ func (r *ReconcileSelinux) reconcilePolicy(
 		return reconcile.Result{}, nil
 	}
 
+	if r.dryRun {
+		return r.reportDryRun(ctx, sp, oh, nodeStatus, l)
+	}
+
+	pendingInherit, err := uninstalledInherit(ctx, oh, r.httpc)
+	if err != nil {
+		r.metrics.IncSelinuxProfileError(reasonCannotGetPolicyStatus)
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotGetPolicyStatus, err.Error())
+		return reconcile.Result{}, fmt.Errorf("looking up inherited policy status: %w", err)
+	}
+	if pendingInherit != "" {
+		l.Info("Inherited policy not yet installed, requeue", "inherit", pendingInherit)
+		if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateInProgress); err != nil {
+			r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdatePolicyStatus, err.Error())
+			return reconcile.Result{}, fmt.Errorf("setting node status to in progress: %w", err)
+		}
+		evstr := fmt.Sprintf("Waiting for inherited policy %s on %s", pendingInherit, os.Getenv(config.NodeNameEnvKey))
+		r.record.Event(sp, util.EventTypeNormal, reasonWaitingForInherit, evstr)
+		return reconcile.Result{RequeueAfter: inheritRequeueInterval}, nil
+	}
+
 	err = r.reconcilePolicyFile(sp, oh, l)
 	if err != nil {
 		r.metrics.IncSelinuxProfileError(reasonCannotWritePolicyFile)
@@ -298,6 +349,13 @@ func (r *ReconcileSelinux) reconcilePolicy(
 		evstr := fmt.Sprintf("Successfully saved profile to disk on %s", os.Getenv(config.NodeNameEnvKey))
 		r.metrics.IncSelinuxProfileUpdate()
 		r.record.Event(sp, util.EventTypeNormal, reasonInstalledPolicy, evstr)
+
+		if err := r.reconcileBooleans(ctx, oh, nodeStatus, l); err != nil {
+			polState = statusv1alpha1.ProfileStateError
+			evstr := fmt.Sprintf("Failed to set SELinux booleans on %s: %s", os.Getenv(config.NodeNameEnvKey), err)
+			r.metrics.IncSelinuxProfileError(reasonCannotSetBooleans)
+			r.record.Event(sp, util.EventTypeWarning, reasonCannotSetBooleans, evstr)
+		}
 	case failedStatus:
 		polState = statusv1alpha1.ProfileStateError
 		evstr := fmt.Sprintf("Failed to save profile to disk on %s: %s", os.Getenv(config.NodeNameEnvKey), polStatus.Msg)
@@ -316,6 +374,19 @@ func (r *ReconcileSelinux) reconcilePolicy(
 	return reconcile.Result{}, nil
 }
 
+// validateCILPolicy checks the syntax of the CIL policy of the profile, to
+// avoid that selinuxd fails to install it later on.
+func validateCILPolicy(oh SelinuxObjectHandler) error {
+	cil, err := oh.GetCILPolicy()
+	if err != nil {
+		return fmt.Errorf("generating CIL: %w", err)
+	}
+	if err := translator.ValidateCIL(cil); err != nil {
+		return fmt.Errorf("invalid CIL: %w", err)
+	}
+	return nil
+}
+
 func (r *ReconcileSelinux) reconcilePolicyFile(
 	sp selxv1alpha2.SelinuxProfileObject,
 	oh SelinuxObjectHandler,
@@ -335,12 +406,100 @@ func (r *ReconcileSelinux) reconcilePolicyFile(
 	return nil
 }
 
+// reportDryRun reports the CIL policy of the profile instead of handing it
+// over to selinuxd.
+func (r *ReconcileSelinux) reportDryRun(
+	ctx context.Context,
+	sp selxv1alpha2.SelinuxProfileObject,
+	oh SelinuxObjectHandler,
+	nodeStatus *nodestatus.StatusClient,
+	l logr.Logger,
+) (reconcile.Result, error) {
+	cil, err := oh.GetCILPolicy()
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("generating CIL: %w", err)
+	}
+
+	policyPath := path.Join(bindata.SelinuxDropDirectory, sp.GetPolicyName()+".cil")
+	if err := common.ReportDryRun(ctx, nodeStatus, r.record, l, sp, policyPath, []byte(cil)); err != nil {
+		r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdatePolicyStatus, err.Error())
+		return reconcile.Result{}, fmt.Errorf("reporting dry run: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// reconcileBooleans sets the SELinux booleans required by the profile and
+// stores their state in the node status.
+func (r *ReconcileSelinux) reconcileBooleans(
+	ctx context.Context,
+	oh SelinuxObjectHandler,
+	nodeStatus *nodestatus.StatusClient,
+	l logr.Logger,
+) error {
+	current, err := nodeStatus.SelinuxBooleans(ctx)
+	if err != nil {
+		return fmt.Errorf("getting SELinux boolean status: %w", err)
+	}
+	if len(current) == 0 && len(oh.GetBooleans()) == 0 {
+		return nil
+	}
+	others, err := nodeStatus.OtherSelinuxBooleans(ctx)
+	if err != nil {
+		return fmt.Errorf("getting SELinux boolean status of other profiles: %w", err)
+	}
+
+	booleans, applyErr := applyBooleans(r.selinuxFsPath, oh.GetBooleans(), current, others)
+	if err := nodeStatus.SetSelinuxBooleans(ctx, booleans); err != nil {
+		return fmt.Errorf("setting SELinux boolean status: %w", err)
+	}
+	if applyErr != nil {
+		return applyErr
+	}
+
+	l.Info("SELinux booleans set", "booleans", oh.GetBooleans())
+	return nil
+}
+
+// reconcileDeleteBooleans restores the SELinux booleans which have been set
+// for the profile and are not required by any other profile on the node.
+func (r *ReconcileSelinux) reconcileDeleteBooleans(
+	ctx context.Context,
+	nodeStatus *nodestatus.StatusClient,
+) error {
+	if r.dryRun {
+		return nil
+	}
+
+	current, err := nodeStatus.SelinuxBooleans(ctx)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting SELinux boolean status: %w", err)
+	}
+	if len(current) == 0 {
+		return nil
+	}
+	others, err := nodeStatus.OtherSelinuxBooleans(ctx)
+	if err != nil {
+		return fmt.Errorf("getting SELinux boolean status of other profiles: %w", err)
+	}
+
+	return revertBooleans(r.selinuxFsPath, current, others)
+}
+
 func (r *ReconcileSelinux) reconcileDeletePolicy(
 	ctx context.Context,
 	sp selxv1alpha2.SelinuxProfileObject,
 	nodeStatus *nodestatus.StatusClient,
 	l logr.Logger,
 ) (reconcile.Result, error) {
+	if r.dryRun {
+		l.Info("Dry run, not removing policy from selinuxd")
+		return reconcile.Result{}, nil
+	}
+
 	selinuxdReady, err := isSelinuxdReady(ctx, r.httpc)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("contacting selinuxd: %w", err)
@@ -443,6 +602,29 @@ func getPolicyStatus(
 	return nil, errors.New("invalid sePolStatus value")
 }
 
+// uninstalledInherit returns the name of the first inherited policy of the
+// profile which is not yet installed on the node, or an empty string if all
+// of them are installed.
+func uninstalledInherit(
+	ctx context.Context,
+	oh SelinuxObjectHandler,
+	httpc *http.Client,
+) (string, error) {
+	for _, inherit := range oh.GetInherits() {
+		polStatus, err := getPolicyStatus(ctx, inherit, httpc)
+		if errors.Is(err, errPolicyNotFound) {
+			return inherit.GetPolicyName(), nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("looking up status of %s: %w", inherit.GetPolicyName(), err)
+		}
+		if polStatus.Status != installedStatus {
+			return inherit.GetPolicyName(), nil
+		}
+	}
+	return "", nil
+}
+
 func isSelinuxdReady(ctx context.Context, httpc *http.Client) (bool, error) {
 	response, err := selinuxdGetRequest(ctx, httpc, selinuxdReadyURL)
 	if err != nil {
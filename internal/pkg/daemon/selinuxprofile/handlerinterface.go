@@ -34,6 +34,8 @@ type SelinuxObjectHandler interface {
 	GetProfileObject() selxv1alpha2.SelinuxProfileObject
 	Validate() error
 	GetCILPolicy() (string, error)
+	GetBooleans() map[string]bool
+	GetInherits() []selxv1alpha2.SelinuxProfileObject
 }
 
 type SelinuxObjectHandlerInit func(context.Context, client.Client, types.NamespacedName) (SelinuxObjectHandler, error)
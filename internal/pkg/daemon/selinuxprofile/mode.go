@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxprofile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+// modeReportInterval is the interval for checking the SELinux mode of the
+// node, which can change at runtime by using setenforce.
+const modeReportInterval = time.Minute
+
+// ModeReporter reports the SELinux mode of the node to the SPOD status.
+type ModeReporter struct {
+	client   client.Client
+	log      logr.Logger
+	readFile func(string) ([]byte, error)
+	nodeName string
+	reported spodv1alpha1.SelinuxMode
+}
+
+// NewModeController returns a new empty controller instance.
+func NewModeController() controller.Controller {
+	return &ModeReporter{
+		readFile: os.ReadFile,
+	}
+}
+
+// Name returns the name of the controller.
+func (r *ModeReporter) Name() string {
+	return "selinuxmode-spod"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *ModeReporter) SchemeBuilder() *scheme.Builder {
+	return spodv1alpha1.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *ModeReporter) Healthz(*http.Request) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons/status,verbs=get;patch
+
+// Setup adds a runnable which periodically reports the SELinux mode.
+func (r *ModeReporter) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.log = logf.Log.WithName("selinuxmode")
+	r.client = mgr.GetClient()
+	r.nodeName = os.Getenv(config.NodeNameEnvKey)
+
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			if err := r.report(ctx); err != nil {
+				r.log.Error(err, "cannot report SELinux mode")
+			}
+		}, modeReportInterval)
+		return nil
+	}))
+}
+
+// mode returns the current SELinux mode of the node.
+func (r *ModeReporter) mode() (spodv1alpha1.SelinuxMode, error) {
+	content, err := r.readFile(filepath.Join(bindata.SelinuxFsPath, "enforce"))
+	if errors.Is(err, os.ErrNotExist) {
+		return spodv1alpha1.SelinuxModeDisabled, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading SELinux enforce file: %w", err)
+	}
+
+	switch strings.TrimSpace(string(content)) {
+	case "1":
+		return spodv1alpha1.SelinuxModeEnforcing, nil
+	case "0":
+		return spodv1alpha1.SelinuxModePermissive, nil
+	}
+
+	return "", fmt.Errorf("unknown SELinux enforce value: %q", content)
+}
+
+// report patches the SPOD status with the current SELinux mode if it changed
+// since the last report. A merge patch is used to not conflict with the
+// daemons running on other nodes.
+func (r *ModeReporter) report(ctx context.Context) error {
+	mode, err := r.mode()
+	if err != nil {
+		return err
+	}
+	if mode == r.reported {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"selinuxModes": map[string]spodv1alpha1.SelinuxMode{r.nodeName: mode},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal SELinux mode patch: %w", err)
+	}
+
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	if err := r.client.Get(ctx, types.NamespacedName{
+		Name:      config.SPOdName,
+		Namespace: config.GetOperatorNamespace(),
+	}, spod); err != nil {
+		return fmt.Errorf("getting SPOD: %w", err)
+	}
+
+	if err := r.client.Status().Patch(ctx, spod, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return fmt.Errorf("patching SPOD status: %w", err)
+	}
+
+	r.log.Info("Reported SELinux mode", "node", r.nodeName, "mode", mode)
+	r.reported = mode
+	return nil
+}
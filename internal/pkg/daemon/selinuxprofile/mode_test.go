@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxprofile
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+func TestModeReporter(t *testing.T) {
+	ns := "security-profiles-operator"
+	t.Setenv(config.OperatorNamespaceEnvKey, ns)
+	require.Nil(t, spodv1alpha1.AddToScheme(scheme.Scheme))
+
+	errRead := errors.New("read error")
+
+	for _, tc := range []struct {
+		name      string
+		content   string
+		readErr   error
+		wantMode  spodv1alpha1.SelinuxMode
+		wantError bool
+	}{
+		{name: "enforcing", content: "1", wantMode: spodv1alpha1.SelinuxModeEnforcing},
+		{name: "permissive", content: "0\n", wantMode: spodv1alpha1.SelinuxModePermissive},
+		{name: "disabled", readErr: os.ErrNotExist, wantMode: spodv1alpha1.SelinuxModeDisabled},
+		{name: "invalid content", content: "2", wantError: true},
+		{name: "read error", readErr: errRead, wantError: true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spod := bindata.DefaultSPOD.DeepCopy()
+			spod.Namespace = ns
+			spod.Status.SelinuxModes = map[string]spodv1alpha1.SelinuxMode{
+				"other-node": spodv1alpha1.SelinuxModePermissive,
+			}
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(spod).
+				WithStatusSubresource(spod).
+				Build()
+
+			sut := &ModeReporter{
+				client:   cli,
+				log:      logr.Discard(),
+				nodeName: "node",
+				readFile: func(name string) ([]byte, error) {
+					require.Equal(t, bindata.SelinuxFsPath+"/enforce", name)
+					return []byte(tc.content), tc.readErr
+				},
+			}
+
+			err := sut.report(context.Background())
+			if tc.wantError {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+
+			res := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+			require.Nil(t, cli.Get(context.Background(), types.NamespacedName{
+				Name: config.SPOdName, Namespace: ns,
+			}, res))
+			require.Equal(t, tc.wantMode, res.Status.SelinuxModes["node"])
+			require.Equal(t, spodv1alpha1.SelinuxModePermissive, res.Status.SelinuxModes["other-node"])
+			require.Equal(t, tc.wantMode, sut.reported)
+		})
+	}
+}
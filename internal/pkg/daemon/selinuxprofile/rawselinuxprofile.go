@@ -44,6 +44,8 @@ func NewRawController() controller.Controller {
 		controllerName:    "rawselinuxprofile",
 		objectHandlerInit: newRawSelinuxProfileHandler,
 		ctrlBuilder:       rawSelinuxProfileControllerBuild,
+		profileKind:       "RawSelinuxProfile",
+		newProfileList:    func() client.ObjectList { return &selxv1alpha2.RawSelinuxProfileList{} },
 	}
 }
 
@@ -81,6 +83,14 @@ func (sph *rawSelinuxProfileHandler) GetCILPolicy() (string, error) {
 	return sph.wrapPolicy()
 }
 
+func (sph *rawSelinuxProfileHandler) GetBooleans() map[string]bool {
+	return nil
+}
+
+func (sph *rawSelinuxProfileHandler) GetInherits() []selxv1alpha2.SelinuxProfileObject {
+	return nil
+}
+
 func (sph *rawSelinuxProfileHandler) wrapPolicy() (string, error) {
 	parsedpolicy := strings.TrimSpace(sph.rsp.Spec.Policy)
 	// ident
@@ -29,9 +29,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
 var (
@@ -40,6 +42,7 @@ var (
 	ErrInvalidPermission       = errors.New("invalid permission")
 	ErrSystemInheritNotAllowed = errors.New("system profile not allowed")
 	ErrUnknownKindForEntry     = errors.New("unknown inherit kind for entry")
+	ErrBooleanNotAllowed       = errors.New("boolean not allowed")
 )
 
 // NewController returns a new empty controller instance.
@@ -48,6 +51,8 @@ func NewController() controller.Controller {
 		controllerName:    "selinuxprofile",
 		objectHandlerInit: newSelinuxProfileHandler,
 		ctrlBuilder:       selinuxProfileControllerBuild,
+		profileKind:       "SelinuxProfile",
+		newProfileList:    func() client.ObjectList { return &selxv1alpha2.SelinuxProfileList{} },
 	}
 }
 
@@ -123,6 +128,28 @@ func (sph *selinuxProfileHandler) Validate() error {
 			}
 		}
 	}
+
+	return sph.validateBooleans()
+}
+
+func (sph *selinuxProfileHandler) validateBooleans() error {
+	if len(sph.sp.Spec.Booleans) == 0 {
+		return nil
+	}
+
+	spod, err := common.GetSPOD(context.Background(), sph.cli)
+	if err != nil {
+		return fmt.Errorf("couldn't get spod to verify booleans: %w", err)
+	}
+
+	for name := range sph.sp.Spec.Booleans {
+		if !util.Contains(spod.Spec.SelinuxOpts.AllowedBooleans, name) {
+			return fmt.Errorf(
+				"boolean %s not in SecurityProfilesOperatorDaemon's allow list: %w",
+				name, ErrBooleanNotAllowed,
+			)
+		}
+	}
 	return nil
 }
 
@@ -136,6 +163,8 @@ func (sph *selinuxProfileHandler) validateAndTrackInherit(
 		return sph.handleInheritSystemPolicy(ancestorRef)
 	case "SelinuxProfile":
 		return sph.handleInheritSPOPolicy(ancestorRef, namespace)
+	case selxv1alpha2.TemplatePolicyKind:
+		return sph.handleInheritTemplate(ancestorRef)
 	}
 	return fmt.Errorf("%s/%s: %w", ancestorRef.Kind, ancestorRef.Name, ErrUnknownKindForEntry)
 }
@@ -172,16 +201,42 @@ func (sph *selinuxProfileHandler) handleInheritSPOPolicy(
 	namespace string,
 ) error {
 	ancestor := &selxv1alpha2.SelinuxProfile{}
+	if err := sph.getInherit(ancestorRef, namespace, ancestor); err != nil {
+		return err
+	}
+
+	// The reconciler waits until the ancestor policy is installed
+	sph.objInherits = append(sph.objInherits, ancestor)
+	return nil
+}
+
+func (sph *selinuxProfileHandler) handleInheritTemplate(
+	ancestorRef selxv1alpha2.PolicyRef,
+) error {
+	// Templates are managed by the operator in its own namespace
+	template := &selxv1alpha2.RawSelinuxProfile{}
+	if err := sph.getInherit(ancestorRef, config.GetOperatorNamespace(), template); err != nil {
+		return err
+	}
+
+	sph.objInherits = append(sph.objInherits, template)
+	return nil
+}
+
+func (sph *selinuxProfileHandler) getInherit(
+	ancestorRef selxv1alpha2.PolicyRef,
+	namespace string,
+	ancestor client.Object,
+) error {
 	key := types.NamespacedName{Name: ancestorRef.Name, Namespace: namespace}
 	err := sph.cli.Get(context.Background(), key, ancestor)
-	if err != nil && kerrors.IsNotFound(err) {
+	if kerrors.IsNotFound(err) {
 		return fmt.Errorf("couldn't find inherit reference %s/%s: %w",
 			ancestorRef.Kind, ancestorRef.Name, err)
+	} else if err != nil {
+		return fmt.Errorf("getting inherit reference %s/%s: %w",
+			ancestorRef.Kind, ancestorRef.Name, err)
 	}
-
-	// TODO(jaosorior): Handle dependencies... we could force a waiting period
-	// until the ancestor policy would be installed
-	sph.objInherits = append(sph.objInherits, ancestor)
 	return nil
 }
 
@@ -214,6 +269,14 @@ func (sph *selinuxProfileHandler) GetCILPolicy() (string, error) {
 	return translator.Object2CIL(sph.systemInherits, sph.objInherits, sph.sp), nil
 }
 
+func (sph *selinuxProfileHandler) GetBooleans() map[string]bool {
+	return sph.sp.Spec.Booleans
+}
+
+func (sph *selinuxProfileHandler) GetInherits() []selxv1alpha2.SelinuxProfileObject {
+	return sph.objInherits
+}
+
 func newSelinuxProfileHandler(
 	ctx context.Context,
 	cli client.Client,
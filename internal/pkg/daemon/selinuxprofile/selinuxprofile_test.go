@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -147,6 +148,62 @@ func Test_selinuxProfileHandler(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Test successful template reference",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-selinux-recording-nginx",
+					Namespace: "default",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Inherit: []selxv1alpha2.PolicyRef{
+						{
+							Kind: selxv1alpha2.TemplatePolicyKind,
+							Name: "log-writer",
+						},
+					},
+				},
+			},
+			existingObjs: []client.Object{
+				spodinstance.DeepCopy(),
+				&selxv1alpha2.RawSelinuxProfile{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "log-writer",
+						Namespace: ns,
+					},
+				},
+			},
+		},
+		{
+			name: "Test template reference outside of the operator namespace",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-selinux-recording-nginx",
+					Namespace: "default",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Inherit: []selxv1alpha2.PolicyRef{
+						{
+							Kind: selxv1alpha2.TemplatePolicyKind,
+							Name: "log-writer",
+						},
+					},
+				},
+			},
+			wantValidateErr: true,
+			wantErrMatches: []string{
+				"couldn't find inherit reference Template/log-writer",
+			},
+			existingObjs: []client.Object{
+				spodinstance.DeepCopy(),
+				&selxv1alpha2.RawSelinuxProfile{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "log-writer",
+						Namespace: "default",
+					},
+				},
+			},
+		},
 		{
 			name: "Test unexistent system reference",
 			profile: &selxv1alpha2.SelinuxProfile{
@@ -359,6 +416,44 @@ func Test_selinuxProfileHandler(t *testing.T) {
 				"didn't match expected characters: invalid permission",
 			},
 		},
+		{
+			name: "Test validate allowed boolean",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Booleans: map[string]bool{"container_use_devices": true},
+				},
+			},
+			existingObjs: []client.Object{
+				func() client.Object {
+					spod := spodinstance.DeepCopy()
+					spod.Spec.SelinuxOpts.AllowedBooleans = []string{"container_use_devices"}
+					return spod
+				}(),
+			},
+		},
+		{
+			name: "Test validate boolean not allowed",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Booleans: map[string]bool{"container_manage_cgroup": true},
+				},
+			},
+			existingObjs: []client.Object{
+				spodinstance.DeepCopy(),
+			},
+			wantValidateErr: true,
+			wantErrMatches: []string{
+				"boolean container_manage_cgroup not in SecurityProfilesOperatorDaemon's allow list",
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -405,3 +500,47 @@ func Test_selinuxProfileHandler(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateCILPolicy(t *testing.T) {
+	t.Parallel()
+	schemeInstance := runtime.NewScheme()
+	if err := selxv1alpha2.AddToScheme(schemeInstance); err != nil {
+		t.Fatalf("couldn't add SELinux API to scheme")
+	}
+
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{
+			name:   "valid raw policy",
+			policy: "(blockinherit container)\n(allow process var_log_t ( dir ( open read )))",
+		},
+		{
+			name:    "unbalanced raw policy",
+			policy:  "(blockinherit container)\n(allow process var_log_t ( dir ( open read ))",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			profile := &selxv1alpha2.RawSelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec:       selxv1alpha2.RawSelinuxProfileSpec{Policy: tt.policy},
+			}
+			cli := fake.NewClientBuilder().WithScheme(schemeInstance).WithObjects(profile).Build()
+			key := types.NamespacedName{Name: profile.GetName(), Namespace: profile.GetNamespace()}
+			oh, err := newRawSelinuxProfileHandler(context.TODO(), cli, key)
+			if err != nil {
+				t.Fatalf("newRawSelinuxProfileHandler() error = %v", err)
+			}
+
+			if err := validateCILPolicy(oh); (err != nil) != tt.wantErr {
+				t.Errorf("validateCILPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syscallstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+)
+
+// HandlerPath is the path for streaming the syscalls of a recorded profile
+// as Server-Sent Events.
+const HandlerPath = "/syscalls-stream"
+
+// Event is the data of a single Server-Sent Event.
+type Event struct {
+	// Syscall is the name of the observed syscall.
+	Syscall string `json:"syscall"`
+
+	// Executable is the executable which invoked the syscall. It is empty
+	// for syscalls which have been recorded before the stream started.
+	Executable string `json:"executable,omitempty"`
+
+	// EventTime is the time when the syscall was observed. It is empty for
+	// syscalls which have been recorded before the stream started.
+	EventTime *time.Time `json:"eventTime,omitempty"`
+}
+
+// Server bridges the syscalls stream of the log enricher to Server-Sent
+// Events, which allows consuming it without a GRPC client.
+type Server struct {
+	log  logr.Logger
+	dial func() (api.EnricherClient, context.CancelFunc, error)
+}
+
+// New returns a new Server for the log enricher on the node.
+func New(logger logr.Logger) *Server {
+	return &Server{
+		log:  logger,
+		dial: dialEnricher,
+	}
+}
+
+func dialEnricher() (api.EnricherClient, context.CancelFunc, error) {
+	conn, cancel, err := enricher.Dial()
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to enricher: %w", err)
+	}
+	return api.NewEnricherClient(conn), func() {
+		cancel()
+		conn.Close()
+	}, nil
+}
+
+// Handler returns the HTTP handler for streaming the syscalls of the profile
+// provided by the `profile` query parameter.
+func (s *Server) Handler() http.Handler {
+	return http.HandlerFunc(s.serve)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	profile := r.URL.Query().Get("profile")
+	if profile == "" {
+		http.Error(w, "missing profile query parameter", http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	client, cancel, err := s.dial()
+	if err != nil {
+		s.log.Error(err, "Unable to connect to the log enricher")
+		http.Error(w, "log enricher is not available", http.StatusServiceUnavailable)
+		return
+	}
+	defer cancel()
+
+	stream, err := client.SyscallsStream(r.Context(), &api.SyscallsRequest{Profile: profile})
+	if err != nil {
+		s.log.Error(err, "Unable to open syscalls stream", "profile", profile)
+		http.Error(w, "unable to open syscalls stream", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) && status.Code(err) != codes.Canceled && r.Context().Err() == nil {
+				s.log.Error(err, "Unable to receive syscall event", "profile", profile)
+			}
+			return
+		}
+
+		if err := writeEvent(w, event); err != nil {
+			s.log.V(config.VerboseLevel).Info("Stopping syscalls stream", "profile", profile, "reason", err.Error())
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func writeEvent(w io.Writer, event *api.SyscallEvent) error {
+	data := Event{
+		Syscall:    event.GetSyscall(),
+		Executable: event.GetExecutable(),
+	}
+	if event.GetEventTime() != nil {
+		eventTime := event.GetEventTime().AsTime()
+		data.EventTime = &eventTime
+	}
+
+	content, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(w, "data: %s\n\n", content); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+	return nil
+}
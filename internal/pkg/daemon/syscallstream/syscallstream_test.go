@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syscallstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+)
+
+var errTest = errors.New("test")
+
+type fakeEnricher struct {
+	api.EnricherClient
+	profile string
+	events  []*api.SyscallEvent
+	err     error
+}
+
+func (f *fakeEnricher) SyscallsStream(
+	_ context.Context, in *api.SyscallsRequest, _ ...grpc.CallOption,
+) (api.Enricher_SyscallsStreamClient, error) {
+	f.profile = in.GetProfile()
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &fakeStream{events: f.events}, nil
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+	events []*api.SyscallEvent
+}
+
+func (f *fakeStream) Recv() (*api.SyscallEvent, error) {
+	if len(f.events) == 0 {
+		return nil, io.EOF
+	}
+	event := f.events[0]
+	f.events = f.events[1:]
+	return event, nil
+}
+
+func TestServe(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Date(2023, 10, 19, 19, 34, 15, 0, time.UTC)
+	for _, tc := range []struct {
+		name       string
+		method     string
+		target     string
+		enricher   *fakeEnricher
+		dialErr    error
+		wantCode   int
+		wantBody   string
+		wantStream bool
+	}{
+		{
+			name:   "stream events",
+			method: http.MethodGet,
+			target: HandlerPath + "?profile=test",
+			enricher: &fakeEnricher{events: []*api.SyscallEvent{
+				{Syscall: "read"},
+				{Syscall: "write", Executable: "/bin/sh", EventTime: timestamppb.New(eventTime)},
+			}},
+			wantCode: http.StatusOK,
+			wantBody: "data: {\"syscall\":\"read\"}\n\n" +
+				"data: {\"syscall\":\"write\",\"executable\":\"/bin/sh\",\"eventTime\":\"2023-10-19T19:34:15Z\"}\n\n",
+			wantStream: true,
+		},
+		{
+			name:     "missing profile",
+			method:   http.MethodGet,
+			target:   HandlerPath,
+			enricher: &fakeEnricher{},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "wrong method",
+			method:   http.MethodPost,
+			target:   HandlerPath + "?profile=test",
+			enricher: &fakeEnricher{},
+			wantCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:     "enricher not available",
+			method:   http.MethodGet,
+			target:   HandlerPath + "?profile=test",
+			dialErr:  errTest,
+			wantCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "stream not available",
+			method:   http.MethodGet,
+			target:   HandlerPath + "?profile=test",
+			enricher: &fakeEnricher{err: errTest},
+			wantCode: http.StatusServiceUnavailable,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			sut := &Server{
+				log: logr.Discard(),
+				dial: func() (api.EnricherClient, context.CancelFunc, error) {
+					if tc.dialErr != nil {
+						return nil, nil, tc.dialErr
+					}
+					return tc.enricher, func() {}, nil
+				},
+			}
+
+			rec := httptest.NewRecorder()
+			sut.Handler().ServeHTTP(rec, httptest.NewRequest(tc.method, tc.target, http.NoBody))
+			require.Equal(t, tc.wantCode, rec.Code)
+			if tc.wantStream {
+				require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+				require.Equal(t, tc.wantBody, rec.Body.String())
+				require.Equal(t, "test", tc.enricher.profile)
+			}
+		})
+	}
+}
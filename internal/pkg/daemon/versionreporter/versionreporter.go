@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versionreporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/version"
+)
+
+// reportInterval is the interval for retrying to report the version if the
+// previous report failed.
+const reportInterval = time.Minute
+
+// VersionReporter reports the build version of the daemon to the SPOD status.
+type VersionReporter struct {
+	client   client.Client
+	log      logr.Logger
+	nodeName string
+	version  string
+	reported bool
+}
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &VersionReporter{}
+}
+
+// Name returns the name of the controller.
+func (r *VersionReporter) Name() string {
+	return "version-spod"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *VersionReporter) SchemeBuilder() *scheme.Builder {
+	return spodv1alpha1.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *VersionReporter) Healthz(*http.Request) error {
+	return nil
+}
+
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons/status,verbs=get;patch
+
+// Setup adds a runnable which reports the daemon version once.
+func (r *VersionReporter) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.log = logf.Log.WithName("version")
+	r.client = mgr.GetClient()
+	r.nodeName = os.Getenv(config.NodeNameEnvKey)
+
+	info, err := version.Get()
+	if err != nil {
+		return fmt.Errorf("get version info: %w", err)
+	}
+	r.version = info.Version
+
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			if err := r.report(ctx); err != nil {
+				r.log.Error(err, "cannot report daemon version")
+			}
+		}, reportInterval)
+		return nil
+	}))
+}
+
+// report patches the SPOD status with the version of the daemon if it has not
+// been reported yet. A merge patch is used to not conflict with the daemons
+// running on other nodes.
+func (r *VersionReporter) report(ctx context.Context) error {
+	if r.reported {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"daemonVersions": map[string]string{r.nodeName: r.version},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal daemon version patch: %w", err)
+	}
+
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	if err := r.client.Get(ctx, types.NamespacedName{
+		Name:      config.SPOdName,
+		Namespace: config.GetOperatorNamespace(),
+	}, spod); err != nil {
+		return fmt.Errorf("getting SPOD: %w", err)
+	}
+
+	if err := r.client.Status().Patch(ctx, spod, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return fmt.Errorf("patching SPOD status: %w", err)
+	}
+
+	r.log.Info("Reported daemon version", "node", r.nodeName, "version", r.version)
+	r.reported = true
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versionreporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+func TestVersionReporter(t *testing.T) {
+	ns := "security-profiles-operator"
+	t.Setenv(config.OperatorNamespaceEnvKey, ns)
+	require.Nil(t, spodv1alpha1.AddToScheme(scheme.Scheme))
+
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Namespace = ns
+	spod.Status.DaemonVersions = map[string]string{
+		"node":       "0.8.0",
+		"other-node": "0.8.0",
+	}
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(spod).
+		WithStatusSubresource(spod).
+		Build()
+
+	sut := &VersionReporter{
+		client:   cli,
+		log:      logr.Discard(),
+		nodeName: "node",
+		version:  "0.9.0",
+	}
+	require.Nil(t, sut.report(context.Background()))
+	require.True(t, sut.reported)
+
+	res := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Name: config.SPOdName, Namespace: ns}
+	require.Nil(t, cli.Get(context.Background(), key, res))
+	require.Equal(t, "0.9.0", res.Status.DaemonVersions["node"])
+	require.Equal(t, "0.8.0", res.Status.DaemonVersions["other-node"])
+
+	// The version is only reported once
+	sut.version = "1.0.0"
+	require.Nil(t, sut.report(context.Background()))
+	require.Nil(t, cli.Get(context.Background(), key, res))
+	require.Equal(t, "0.9.0", res.Status.DaemonVersions["node"])
+}
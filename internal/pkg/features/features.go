@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+// Feature is the name of a feature gate.
+type Feature string
+
+const (
+	// BpfRecorder gates the eBPF based profile recorder.
+	BpfRecorder Feature = "BpfRecorder"
+
+	// AppArmor gates the support for AppArmor profiles.
+	AppArmor Feature = "AppArmor"
+
+	// Notifications gates the notifications of external systems about
+	// profile lifecycle events.
+	Notifications Feature = "Notifications"
+
+	// ConfigMapMirror gates mirroring the content of seccomp profiles into
+	// ConfigMaps for consumers which cannot read the operator APIs.
+	ConfigMapMirror Feature = "ConfigMapMirror"
+
+	// SelinuxUsageConfigMap gates publishing the SELinux type and level of
+	// SELinux profiles in a ConfigMap per namespace.
+	SelinuxUsageConfigMap Feature = "SelinuxUsageConfigMap"
+
+	// Landlock gates the support for Landlock profiles.
+	Landlock Feature = "Landlock"
+)
+
+// defaults are the states of all known feature gates if they are not
+// configured. Experimental functionality should be disabled per default to
+// ship it dark.
+var defaults = map[Feature]bool{
+	BpfRecorder:           true,
+	AppArmor:              true,
+	Notifications:         false,
+	ConfigMapMirror:       false,
+	SelinuxUsageConfigMap: false,
+	Landlock:              false,
+}
+
+// Gates are the states of the feature gates by their name.
+type Gates map[string]bool
+
+// New returns the effective state of all known feature gates. The configured
+// gates, for example of the SecurityProfilesOperatorDaemon, are applied on
+// top of the defaults. The gates of the FeatureGatesEnvKey environment
+// variable in the format "Feature1=true,Feature2=false" take precedence. The
+// returned unknown entries are ignored.
+func New(configured map[string]bool) (gates Gates, unknown []string) {
+	gates = Gates{}
+	for feature, enabled := range defaults {
+		gates[string(feature)] = enabled
+	}
+
+	for name, enabled := range configured {
+		if _, ok := gates[name]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		gates[name] = enabled
+	}
+
+	for _, entry := range strings.Split(os.Getenv(config.FeatureGatesEnvKey), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(entry, "=")
+		enabled, err := strconv.ParseBool(value)
+		if _, ok := gates[name]; !ok || !found || err != nil {
+			unknown = append(unknown, entry)
+			continue
+		}
+		gates[name] = enabled
+	}
+
+	sort.Strings(unknown)
+	return gates, unknown
+}
+
+// Enabled returns true if the feature is enabled. The default of the feature
+// is used if the gates do not contain it, for example because they got
+// reported by an older version of the operator.
+func (g Gates) Enabled(feature Feature) bool {
+	if enabled, ok := g[string(feature)]; ok {
+		return enabled
+	}
+	return defaults[feature]
+}
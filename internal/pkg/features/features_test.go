@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestNew(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		configured  map[string]bool
+		env         string
+		wantGates   Gates
+		wantUnknown []string
+	}{
+		{
+			name: "defaults",
+			wantGates: Gates{
+				"BpfRecorder": true, "AppArmor": true, "Notifications": false,
+				"ConfigMapMirror": false, "SelinuxUsageConfigMap": false, "Landlock": false,
+			},
+		},
+		{
+			name:       "configured",
+			configured: map[string]bool{"BpfRecorder": false},
+			wantGates: Gates{
+				"BpfRecorder": false, "AppArmor": true, "Notifications": false,
+				"ConfigMapMirror": false, "SelinuxUsageConfigMap": false, "Landlock": false,
+			},
+		},
+		{
+			name:       "environment takes precedence",
+			configured: map[string]bool{"BpfRecorder": false, "AppArmor": true},
+			env:        "BpfRecorder=true, AppArmor=false",
+			wantGates: Gates{
+				"BpfRecorder": true, "AppArmor": false, "Notifications": false,
+				"ConfigMapMirror": false, "SelinuxUsageConfigMap": false, "Landlock": false,
+			},
+		},
+		{
+			name:       "unknown and invalid gates",
+			configured: map[string]bool{"Unknown": true},
+			env:        "Notifications=false,AppArmor,BpfRecorder=maybe,Other=true",
+			wantGates: Gates{
+				"BpfRecorder": true, "AppArmor": true, "Notifications": false,
+				"ConfigMapMirror": false, "SelinuxUsageConfigMap": false, "Landlock": false,
+			},
+			wantUnknown: []string{"AppArmor", "BpfRecorder=maybe", "Other=true", "Unknown"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.FeatureGatesEnvKey, tc.env)
+
+			gates, unknown := New(tc.configured)
+			require.Equal(t, tc.wantGates, gates)
+			require.Equal(t, tc.wantUnknown, unknown)
+		})
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	t.Parallel()
+
+	gates := Gates{"BpfRecorder": false}
+	require.False(t, gates.Enabled(BpfRecorder))
+	require.True(t, gates.Enabled(AppArmor))
+	require.False(t, gates.Enabled(Feature("Unknown")))
+	require.False(t, Gates(nil).Enabled(Notifications))
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	compliancev1alpha1 "sigs.k8s.io/security-profiles-operator/api/compliancereport/v1alpha1"
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	// ReportName is the name of the ComplianceReport created per namespace.
+	ReportName = "compliance-report"
+
+	reconcileTimeout = 1 * time.Minute
+	checkInterval    = 5 * time.Minute
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &Reconciler{}
+}
+
+// A Reconciler periodically checks the profiles, bindings and workloads of
+// every selected namespace against benchmark rules and reports the result
+// as ComplianceReport.
+type Reconciler struct {
+	client client.Client
+	log    logr.Logger
+}
+
+// Name returns the name of the controller.
+func (r *Reconciler) Name() string {
+	return "compliance"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *Reconciler) SchemeBuilder() *scheme.Builder {
+	return compliancev1alpha1.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *Reconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+// Cluster scoped
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+// Namespace scoped
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=compliancereports,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+
+// Reconcile checks a namespace against the configured compliance rules.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("namespace", req.Name)
+
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	ns := &corev1.Namespace{}
+	if err := r.client.Get(ctx, req.NamespacedName, ns); err != nil {
+		return reconcile.Result{}, util.IgnoreNotFound(err)
+	}
+	if !ns.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	if err := r.client.Get(ctx, spodName(), spod); util.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("get SPOD instance: %w", err)
+	}
+
+	opts := spod.Spec.Compliance
+	selected, err := namespaceSelected(opts, ns)
+	if err != nil {
+		logger.Error(err, "invalid compliance namespace selector")
+		return reconcile.Result{}, nil
+	}
+	if !selected {
+		if err := r.deleteReport(ctx, ns.Name); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	state, err := r.namespaceState(ctx, ns.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	rules := enabledRules(opts.Rules)
+	findings := evaluate(rules, state)
+
+	report := &compliancev1alpha1.ComplianceReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ReportName,
+			Namespace: ns.Name,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.client, report, func() error {
+		report.Rules = rules
+		report.Compliant = len(findings) == 0
+		report.FindingsCount = len(findings)
+		report.Findings = findings
+		report.LastChecked = metav1.Now()
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("create or update compliance report: %w", err)
+	}
+
+	logger.V(config.VerboseLevel).Info("Updated compliance report", "findings", len(findings))
+	return reconcile.Result{RequeueAfter: checkInterval}, nil
+}
+
+func spodName() types.NamespacedName {
+	return util.NamespacedName(config.SPOdName, config.GetOperatorNamespace())
+}
+
+// enqueueNamespaces reconciles all namespaces if the SPOD instance changes,
+// because the compliance options may have been changed.
+func (r *Reconciler) enqueueNamespaces(ctx context.Context, obj client.Object) []reconcile.Request {
+	if obj.GetName() != config.SPOdName {
+		return []reconcile.Request{}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	namespaces := &corev1.NamespaceList{}
+	if err := r.client.List(ctx, namespaces); err != nil {
+		r.log.Error(err, "cannot list namespaces")
+		return []reconcile.Request{}
+	}
+
+	reconcileRequests := make([]reconcile.Request, 0, len(namespaces.Items))
+	for i := range namespaces.Items {
+		reconcileRequests = append(reconcileRequests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: namespaces.Items[i].Name},
+		})
+	}
+	return reconcileRequests
+}
+
+func namespaceSelected(opts *spodv1alpha1.ComplianceOptions, ns *corev1.Namespace) (bool, error) {
+	if opts == nil {
+		return false, nil
+	}
+	if opts.NamespaceSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(opts.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("parse namespace selector: %w", err)
+	}
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
+
+func (r *Reconciler) namespaceState(ctx context.Context, namespace string) (*namespaceState, error) {
+	inNamespace := client.InNamespace(namespace)
+
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, inNamespace); err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+
+	seccompProfiles := &seccompprofileapi.SeccompProfileList{}
+	if err := r.client.List(ctx, seccompProfiles, inNamespace); err != nil {
+		return nil, fmt.Errorf("list seccomp profiles: %w", err)
+	}
+
+	selinuxProfiles := &selxv1alpha2.SelinuxProfileList{}
+	if err := r.client.List(ctx, selinuxProfiles, inNamespace); err != nil {
+		return nil, fmt.Errorf("list selinux profiles: %w", err)
+	}
+
+	bindings := &profilebindingv1alpha1.ProfileBindingList{}
+	if err := r.client.List(ctx, bindings, inNamespace); err != nil {
+		return nil, fmt.Errorf("list profile bindings: %w", err)
+	}
+
+	return &namespaceState{
+		pods:            pods.Items,
+		seccompProfiles: seccompProfiles.Items,
+		selinuxProfiles: selinuxProfiles.Items,
+		bindings:        bindings.Items,
+	}, nil
+}
+
+func (r *Reconciler) deleteReport(ctx context.Context, namespace string) error {
+	report := &compliancev1alpha1.ComplianceReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ReportName,
+			Namespace: namespace,
+		},
+	}
+	if err := r.client.Delete(ctx, report); util.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("delete compliance report: %w", err)
+	}
+	return nil
+}
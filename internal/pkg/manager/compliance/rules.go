@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compliance
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/containers/common/pkg/seccomp"
+	corev1 "k8s.io/api/core/v1"
+
+	compliancev1alpha1 "sigs.k8s.io/security-profiles-operator/api/compliancereport/v1alpha1"
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+const (
+	kindPod            = "Pod"
+	kindProfileBinding = "ProfileBinding"
+)
+
+// namespaceState contains all objects of a namespace which are relevant
+// for the compliance rules.
+type namespaceState struct {
+	pods            []corev1.Pod
+	seccompProfiles []seccompprofileapi.SeccompProfile
+	selinuxProfiles []selxv1alpha2.SelinuxProfile
+	bindings        []profilebindingv1alpha1.ProfileBinding
+}
+
+// enabledRules returns the rules to be checked, which are all known rules if
+// none are configured.
+func enabledRules(rules []compliancev1alpha1.ComplianceRule) []compliancev1alpha1.ComplianceRule {
+	if len(rules) == 0 {
+		return compliancev1alpha1.AllComplianceRules
+	}
+	return rules
+}
+
+// evaluate checks the namespace state against the provided rules and
+// returns the sorted findings.
+func evaluate(
+	rules []compliancev1alpha1.ComplianceRule, state *namespaceState,
+) []compliancev1alpha1.ComplianceFinding {
+	findings := []compliancev1alpha1.ComplianceFinding{}
+
+	if ruleEnabled(rules, compliancev1alpha1.ComplianceRuleNoUnconfinedPods) {
+		findings = append(findings, unconfinedPods(state)...)
+	}
+	if ruleEnabled(rules, compliancev1alpha1.ComplianceRuleNoAllowAllProfiles) {
+		findings = append(findings, allowAllProfiles(state)...)
+	}
+	if ruleEnabled(rules, compliancev1alpha1.ComplianceRuleNoDanglingBindings) {
+		findings = append(findings, danglingBindings(state)...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Rule != findings[j].Rule {
+			return findings[i].Rule < findings[j].Rule
+		}
+		if findings[i].Kind != findings[j].Kind {
+			return findings[i].Kind < findings[j].Kind
+		}
+		return findings[i].Name < findings[j].Name
+	})
+
+	return findings
+}
+
+func ruleEnabled(rules []compliancev1alpha1.ComplianceRule, rule compliancev1alpha1.ComplianceRule) bool {
+	for _, r := range rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+func unconfinedPods(state *namespaceState) []compliancev1alpha1.ComplianceFinding {
+	findings := []compliancev1alpha1.ComplianceFinding{}
+
+	for i := range state.pods {
+		pod := &state.pods[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		var podProfile *corev1.SeccompProfile
+		if pod.Spec.SecurityContext != nil {
+			podProfile = pod.Spec.SecurityContext.SeccompProfile
+		}
+
+		//nolint:gocritic // we want a new slice
+		containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+		for j := range containers {
+			ctr := &containers[j]
+			profile := podProfile
+			if ctr.SecurityContext != nil && ctr.SecurityContext.SeccompProfile != nil {
+				profile = ctr.SecurityContext.SeccompProfile
+			}
+
+			var msg string
+			switch {
+			case profile == nil:
+				msg = fmt.Sprintf("container %q runs without a seccomp profile", ctr.Name)
+			case profile.Type == corev1.SeccompProfileTypeUnconfined:
+				msg = fmt.Sprintf("container %q runs with the Unconfined seccomp profile", ctr.Name)
+			default:
+				continue
+			}
+
+			findings = append(findings, compliancev1alpha1.ComplianceFinding{
+				Rule:    compliancev1alpha1.ComplianceRuleNoUnconfinedPods,
+				Kind:    kindPod,
+				Name:    pod.Name,
+				Message: msg,
+			})
+		}
+	}
+
+	return findings
+}
+
+func allowAllProfiles(state *namespaceState) []compliancev1alpha1.ComplianceFinding {
+	findings := []compliancev1alpha1.ComplianceFinding{}
+
+	for i := range state.seccompProfiles {
+		sp := &state.seccompProfiles[i]
+		if isAllowAllSeccompProfile(sp) {
+			findings = append(findings, compliancev1alpha1.ComplianceFinding{
+				Rule:    compliancev1alpha1.ComplianceRuleNoAllowAllProfiles,
+				Kind:    string(profilebindingv1alpha1.ProfileBindingKindSeccompProfile),
+				Name:    sp.Name,
+				Message: fmt.Sprintf("default action %s does not restrict any syscall", sp.Spec.DefaultAction),
+			})
+		}
+	}
+
+	for i := range state.selinuxProfiles {
+		sp := &state.selinuxProfiles[i]
+		if sp.Spec.Permissive {
+			findings = append(findings, compliancev1alpha1.ComplianceFinding{
+				Rule:    compliancev1alpha1.ComplianceRuleNoAllowAllProfiles,
+				Kind:    string(profilebindingv1alpha1.ProfileBindingKindSelinuxProfile),
+				Name:    sp.Name,
+				Message: "profile is permissive and does not deny any access",
+			})
+		}
+	}
+
+	for i := range state.bindings {
+		binding := &state.bindings[i]
+		ref := binding.Spec.ProfileRef
+
+		allowAll := false
+		switch ref.Kind {
+		case profilebindingv1alpha1.ProfileBindingKindSeccompProfile:
+			if sp := findSeccompProfile(state, ref.Name); sp != nil {
+				allowAll = isAllowAllSeccompProfile(sp)
+			}
+		case profilebindingv1alpha1.ProfileBindingKindSelinuxProfile:
+			if sp := findSelinuxProfile(state, ref.Name); sp != nil {
+				allowAll = sp.Spec.Permissive
+			}
+		}
+
+		if allowAll {
+			findings = append(findings, compliancev1alpha1.ComplianceFinding{
+				Rule:    compliancev1alpha1.ComplianceRuleNoAllowAllProfiles,
+				Kind:    kindProfileBinding,
+				Name:    binding.Name,
+				Message: fmt.Sprintf("binds the unrestricted %s %q", ref.Kind, ref.Name),
+			})
+		}
+	}
+
+	return findings
+}
+
+func danglingBindings(state *namespaceState) []compliancev1alpha1.ComplianceFinding {
+	findings := []compliancev1alpha1.ComplianceFinding{}
+
+	for i := range state.bindings {
+		binding := &state.bindings[i]
+		ref := binding.Spec.ProfileRef
+
+		found := false
+		switch ref.Kind {
+		case profilebindingv1alpha1.ProfileBindingKindSeccompProfile:
+			found = findSeccompProfile(state, ref.Name) != nil
+		case profilebindingv1alpha1.ProfileBindingKindSelinuxProfile:
+			found = findSelinuxProfile(state, ref.Name) != nil
+		}
+
+		if !found {
+			findings = append(findings, compliancev1alpha1.ComplianceFinding{
+				Rule:    compliancev1alpha1.ComplianceRuleNoDanglingBindings,
+				Kind:    kindProfileBinding,
+				Name:    binding.Name,
+				Message: fmt.Sprintf("references the missing %s %q", ref.Kind, ref.Name),
+			})
+		}
+	}
+
+	return findings
+}
+
+// isAllowAllSeccompProfile returns true if the profile allows or only logs
+// every syscall.
+func isAllowAllSeccompProfile(sp *seccompprofileapi.SeccompProfile) bool {
+	if !isAllowAction(sp.Spec.DefaultAction) {
+		return false
+	}
+
+	for _, syscall := range sp.Spec.Syscalls {
+		if syscall != nil && !isAllowAction(syscall.Action) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isAllowAction(action seccomp.Action) bool {
+	return action == seccomp.ActAllow || action == seccomp.ActLog
+}
+
+func findSeccompProfile(state *namespaceState, name string) *seccompprofileapi.SeccompProfile {
+	for i := range state.seccompProfiles {
+		if state.seccompProfiles[i].Name == name {
+			return &state.seccompProfiles[i]
+		}
+	}
+	return nil
+}
+
+func findSelinuxProfile(state *namespaceState, name string) *selxv1alpha2.SelinuxProfile {
+	for i := range state.selinuxProfiles {
+		if state.selinuxProfiles[i].Name == name {
+			return &state.selinuxProfiles[i]
+		}
+	}
+	return nil
+}
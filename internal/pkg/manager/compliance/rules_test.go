@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compliance
+
+import (
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	compliancev1alpha1 "sigs.k8s.io/security-profiles-operator/api/compliancereport/v1alpha1"
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+func pod(name string, podProfile, ctrProfile *corev1.SeccompProfile) corev1.Pod {
+	p := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "ctr"}},
+		},
+	}
+	if podProfile != nil {
+		p.Spec.SecurityContext = &corev1.PodSecurityContext{SeccompProfile: podProfile}
+	}
+	if ctrProfile != nil {
+		p.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{SeccompProfile: ctrProfile}
+	}
+	return p
+}
+
+func seccompProfile(
+	name string, defaultAction seccomp.Action, syscalls ...*seccompprofileapi.Syscall,
+) seccompprofileapi.SeccompProfile {
+	return seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			DefaultAction: defaultAction,
+			Syscalls:      syscalls,
+		},
+	}
+}
+
+func binding(
+	name string, kind profilebindingv1alpha1.ProfileBindingKind, profile string,
+) profilebindingv1alpha1.ProfileBinding {
+	return profilebindingv1alpha1.ProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: profilebindingv1alpha1.ProfileBindingSpec{
+			ProfileRef: profilebindingv1alpha1.ProfileRef{Kind: kind, Name: profile},
+			Image:      "nginx",
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	runtimeDefault := &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	unconfined := &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined}
+	denyWrite := &seccompprofileapi.Syscall{Names: []string{"write"}, Action: seccomp.ActErrno}
+
+	for _, tc := range []struct {
+		name     string
+		rules    []compliancev1alpha1.ComplianceRule
+		state    *namespaceState
+		expected []compliancev1alpha1.ComplianceFinding
+	}{
+		{
+			name:  "compliant",
+			rules: compliancev1alpha1.AllComplianceRules,
+			state: &namespaceState{
+				pods:            []corev1.Pod{pod("pod", runtimeDefault, nil)},
+				seccompProfiles: []seccompprofileapi.SeccompProfile{seccompProfile("profile", seccomp.ActErrno)},
+				bindings: []profilebindingv1alpha1.ProfileBinding{
+					binding("binding", profilebindingv1alpha1.ProfileBindingKindSeccompProfile, "profile"),
+				},
+			},
+			expected: []compliancev1alpha1.ComplianceFinding{},
+		},
+		{
+			name:  "unconfined pods",
+			rules: compliancev1alpha1.AllComplianceRules,
+			state: &namespaceState{
+				pods: []corev1.Pod{
+					pod("no-profile", nil, nil),
+					pod("unconfined", unconfined, nil),
+					pod("container-overrides-unconfined", unconfined, runtimeDefault),
+					pod("container-unconfined", runtimeDefault, unconfined),
+				},
+			},
+			expected: []compliancev1alpha1.ComplianceFinding{
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoUnconfinedPods,
+					Kind:    kindPod,
+					Name:    "container-unconfined",
+					Message: `container "ctr" runs with the Unconfined seccomp profile`,
+				},
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoUnconfinedPods,
+					Kind:    kindPod,
+					Name:    "no-profile",
+					Message: `container "ctr" runs without a seccomp profile`,
+				},
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoUnconfinedPods,
+					Kind:    kindPod,
+					Name:    "unconfined",
+					Message: `container "ctr" runs with the Unconfined seccomp profile`,
+				},
+			},
+		},
+		{
+			name:  "completed pods are ignored",
+			rules: compliancev1alpha1.AllComplianceRules,
+			state: &namespaceState{
+				pods: []corev1.Pod{func() corev1.Pod {
+					p := pod("completed", nil, nil)
+					p.Status.Phase = corev1.PodSucceeded
+					return p
+				}()},
+			},
+			expected: []compliancev1alpha1.ComplianceFinding{},
+		},
+		{
+			name:  "allow all profiles and bindings",
+			rules: compliancev1alpha1.AllComplianceRules,
+			state: &namespaceState{
+				seccompProfiles: []seccompprofileapi.SeccompProfile{
+					seccompProfile("allow", seccomp.ActAllow),
+					seccompProfile("log", seccomp.ActLog),
+					seccompProfile("allow-deny-write", seccomp.ActAllow, denyWrite),
+				},
+				selinuxProfiles: []selxv1alpha2.SelinuxProfile{{
+					ObjectMeta: metav1.ObjectMeta{Name: "permissive"},
+					Spec:       selxv1alpha2.SelinuxProfileSpec{Permissive: true},
+				}},
+				bindings: []profilebindingv1alpha1.ProfileBinding{
+					binding("seccomp", profilebindingv1alpha1.ProfileBindingKindSeccompProfile, "allow"),
+					binding("selinux", profilebindingv1alpha1.ProfileBindingKindSelinuxProfile, "permissive"),
+				},
+			},
+			expected: []compliancev1alpha1.ComplianceFinding{
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoAllowAllProfiles,
+					Kind:    kindProfileBinding,
+					Name:    "seccomp",
+					Message: `binds the unrestricted SeccompProfile "allow"`,
+				},
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoAllowAllProfiles,
+					Kind:    kindProfileBinding,
+					Name:    "selinux",
+					Message: `binds the unrestricted SelinuxProfile "permissive"`,
+				},
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoAllowAllProfiles,
+					Kind:    "SeccompProfile",
+					Name:    "allow",
+					Message: "default action SCMP_ACT_ALLOW does not restrict any syscall",
+				},
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoAllowAllProfiles,
+					Kind:    "SeccompProfile",
+					Name:    "log",
+					Message: "default action SCMP_ACT_LOG does not restrict any syscall",
+				},
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoAllowAllProfiles,
+					Kind:    "SelinuxProfile",
+					Name:    "permissive",
+					Message: "profile is permissive and does not deny any access",
+				},
+			},
+		},
+		{
+			name:  "dangling bindings",
+			rules: compliancev1alpha1.AllComplianceRules,
+			state: &namespaceState{
+				bindings: []profilebindingv1alpha1.ProfileBinding{
+					binding("seccomp", profilebindingv1alpha1.ProfileBindingKindSeccompProfile, "missing"),
+					binding("selinux", profilebindingv1alpha1.ProfileBindingKindSelinuxProfile, "missing"),
+				},
+			},
+			expected: []compliancev1alpha1.ComplianceFinding{
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoDanglingBindings,
+					Kind:    kindProfileBinding,
+					Name:    "seccomp",
+					Message: `references the missing SeccompProfile "missing"`,
+				},
+				{
+					Rule:    compliancev1alpha1.ComplianceRuleNoDanglingBindings,
+					Kind:    kindProfileBinding,
+					Name:    "selinux",
+					Message: `references the missing SelinuxProfile "missing"`,
+				},
+			},
+		},
+		{
+			name:  "only enabled rules are checked",
+			rules: []compliancev1alpha1.ComplianceRule{compliancev1alpha1.ComplianceRuleNoDanglingBindings},
+			state: &namespaceState{
+				pods:            []corev1.Pod{pod("no-profile", nil, nil)},
+				seccompProfiles: []seccompprofileapi.SeccompProfile{seccompProfile("allow", seccomp.ActAllow)},
+			},
+			expected: []compliancev1alpha1.ComplianceFinding{},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, evaluate(tc.rules, tc.state))
+		})
+	}
+}
+
+func TestEnabledRules(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, compliancev1alpha1.AllComplianceRules, enabledRules(nil))
+
+	rules := []compliancev1alpha1.ComplianceRule{compliancev1alpha1.ComplianceRuleNoUnconfinedPods}
+	require.Equal(t, rules, enabledRules(rules))
+}
@@ -21,6 +21,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -35,6 +38,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	landlockprofileapi "sigs.k8s.io/security-profiles-operator/api/landlockprofile/v1alpha1"
 	pbv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
@@ -48,6 +53,12 @@ import (
 const (
 	reconcileTimeout = 1 * time.Minute
 	dsWait           = 30 * time.Second
+
+	reasonProfileExpired = "ProfileExpired"
+
+	// maxFailedNodesInCondition limits the amount of node names listed in
+	// the ready condition, the full list is available in status.failedNodes.
+	maxFailedNodesInCondition = 10
 )
 
 var (
@@ -97,10 +108,24 @@ func (r *StatusReconciler) Healthz(*http.Request) error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/finalizers,verbs=delete;get;update;patch
 
+// Security Profiles Operator RBAC permissions to manage AppArmorProfile
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/finalizers,verbs=delete;get;update;patch
+
+// Security Profiles Operator RBAC permissions to manage LandlockProfile
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=landlockprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=landlockprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=landlockprofiles/finalizers,verbs=delete;get;update;patch
+
 // Security Profiles Operator RBAC permissions to manage Node Statuses
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create
 
 // Reconcile reconciles a NodeStatus.
 func (r *StatusReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
@@ -136,7 +161,7 @@ func (r *StatusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 		if instance.Status != "" {
 			targetStatus = instance.Status
 		}
-		return r.reconcileStatus(ctx, prof, targetStatus, lprof)
+		return r.reconcileStatus(ctx, prof, &installation{state: targetStatus}, lprof)
 	}
 
 	// get all the other statuses
@@ -155,7 +180,7 @@ func (r *StatusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 	}
 
 	// get the DS
-	spodDS, err := r.getDS(ctx, config.GetOperatorNamespace(), lprof)
+	spodDS, err := r.getDS(ctx, config.GetOperatorNamespace(), metav1.GetControllerOf(instance).Kind, lprof)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("cannot get the DS: %w", err)
 	}
@@ -191,13 +216,41 @@ func (r *StatusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 		return reconcile.Result{Requeue: true}, nil
 	}
 
-	lowestCommonState := statusv1alpha1.LowestState
-	for i := range nodeStatusList.Items {
-		lowestCommonState = statusv1alpha1.LowerOfTwoStates(lowestCommonState, nodeStatusList.Items[i].Status)
+	inst := summarizeNodeStatuses(nodeStatusList.Items)
+	logger.V(config.VerboseLevel).Info("Setting the status to", "Status", inst.state)
+
+	return r.reconcileStatus(ctx, prof, inst, lprof)
+}
+
+// installation summarizes the node statuses of a profile.
+type installation struct {
+	state          statusv1alpha1.ProfileState
+	installedNodes int32
+	failedNodes    []string
+}
+
+// summarizeNodeStatuses returns the lowest common state of the node statuses.
+// A profile which is installed on some nodes and failed on all the others is
+// PartiallyInstalled instead of failed, which allows to find the failed nodes.
+func summarizeNodeStatuses(statuses []statusv1alpha1.SecurityProfileNodeStatus) *installation {
+	inst := &installation{state: statusv1alpha1.LowestState}
+	for i := range statuses {
+		inst.state = statusv1alpha1.LowerOfTwoStates(inst.state, statuses[i].Status)
+		switch statuses[i].Status {
+		case statusv1alpha1.ProfileStateInstalled:
+			inst.installedNodes++
+		case statusv1alpha1.ProfileStateError:
+			inst.failedNodes = append(inst.failedNodes, statuses[i].NodeName)
+		default:
+		}
 	}
-	logger.V(config.VerboseLevel).Info("Setting the status to", "Status", lowestCommonState)
+	sort.Strings(inst.failedNodes)
 
-	return r.reconcileStatus(ctx, prof, lowestCommonState, lprof)
+	if inst.state == statusv1alpha1.ProfileStateError && inst.installedNodes > 0 &&
+		int(inst.installedNodes)+len(inst.failedNodes) == len(statuses) {
+		inst.state = statusv1alpha1.ProfileStatePartiallyInstalled
+	}
+	return inst
 }
 
 // removeStatusForDeletedNode removes the status for a node that has been deleted.
@@ -221,30 +274,55 @@ func (r *StatusReconciler) removeStatusForDeletedNode(ctx context.Context,
 	return "", nil
 }
 
-func (r *StatusReconciler) getDS(ctx context.Context, namespace string, l logr.Logger) (*appsv1.DaemonSet, error) {
-	dsSelect := labels.NewSelector()
-	spodDSFilter, err := labels.NewRequirement("spod", selection.Exists, []string{})
-	if err != nil {
-		return nil, fmt.Errorf("cannot create DS list label: %w", err)
-	}
-	dsSelect.Add(*spodDSFilter)
-	dsListOpts := client.ListOptions{
-		LabelSelector: dsSelect,
-		Namespace:     namespace,
-	}
+// profileKindFeatures maps the profile kinds to the SPOd feature which is
+// required to install them.
+var profileKindFeatures = map[string]string{
+	"SelinuxProfile":    config.SPOdFeatureSelinux,
+	"RawSelinuxProfile": config.SPOdFeatureSelinux,
+	"AppArmorProfile":   config.SPOdFeatureAppArmor,
+}
 
+// getDS returns the SPOd DaemonSet with the summarized status of all SPOd
+// DaemonSet variants which install profiles of the provided kind.
+func (r *StatusReconciler) getDS(
+	ctx context.Context, namespace, profileKind string, l logr.Logger,
+) (*appsv1.DaemonSet, error) {
 	spodDSList := appsv1.DaemonSetList{}
-	if err := r.client.List(ctx, &spodDSList, &dsListOpts); err != nil {
+	if err := r.client.List(ctx, &spodDSList, client.InNamespace(namespace)); err != nil {
 		return nil, fmt.Errorf("cannot list DS: %w", err)
 	}
 
-	if len(spodDSList.Items) != 1 {
-		retErr := errors.New("did not find exactly one DS")
-		l.Error(retErr, "Expected to find 1 DS", "len(dsList.Items)", len(spodDSList.Items))
+	spodDS := &appsv1.DaemonSet{}
+	found := 0
+	for i := range spodDSList.Items {
+		ds := &spodDSList.Items[i]
+		if ctrl := metav1.GetControllerOf(ds); ctrl == nil || ctrl.Kind != "SecurityProfilesOperatorDaemon" {
+			continue
+		}
+		found++
+
+		// Variants without the feature do not report node statuses
+		feature, ok := profileKindFeatures[profileKind]
+		if ok && slices.Contains(strings.Split(ds.Annotations[config.SPOdDisabledFeaturesAnnotation], ","), feature) {
+			continue
+		}
+
+		if spodDS.Name == "" {
+			spodDS.ObjectMeta = *ds.ObjectMeta.DeepCopy()
+		}
+		spodDS.Status.DesiredNumberScheduled += ds.Status.DesiredNumberScheduled
+		spodDS.Status.NumberAvailable += ds.Status.NumberAvailable
+		spodDS.Status.NumberUnavailable += ds.Status.NumberUnavailable
+		spodDS.Status.UpdatedNumberScheduled += ds.Status.UpdatedNumberScheduled
+	}
+
+	if found == 0 {
+		retErr := errors.New("did not find any DS")
+		l.Error(retErr, "Expected to find at least one SPOd DS", "len(dsList.Items)", len(spodDSList.Items))
 		return nil, fmt.Errorf("listing DS: %w", retErr)
 	}
 
-	return &spodDSList.Items[0], nil
+	return spodDS, nil
 }
 
 func (r *StatusReconciler) getProfileFromStatus(
@@ -268,6 +346,10 @@ func (r *StatusReconciler) getProfileFromStatus(
 		prof = &selxv1alpha2.SelinuxProfile{}
 	case "RawSelinuxProfile":
 		prof = &selxv1alpha2.RawSelinuxProfile{}
+	case "AppArmorProfile":
+		prof = &apparmorprofileapi.AppArmorProfile{}
+	case "LandlockProfile":
+		prof = &landlockprofileapi.LandlockProfile{}
 	default:
 		return nil, fmt.Errorf("getting owner profile: %w", ErrUnknownOwnerKind)
 	}
@@ -280,7 +362,7 @@ func (r *StatusReconciler) getProfileFromStatus(
 func (r *StatusReconciler) reconcileStatus(
 	ctx context.Context,
 	prof pbv1alpha1.StatusBaseUser,
-	state statusv1alpha1.ProfileState,
+	inst *installation,
 	l logr.Logger,
 ) (reconcile.Result, error) {
 	pCopy := prof.DeepCopyToStatusBaseIf()
@@ -288,7 +370,14 @@ func (r *StatusReconciler) reconcileStatus(
 	// We always set this status
 	pCopy.SetImplementationStatus()
 
+	state := inst.state
 	outStatus := pCopy.GetStatusBase()
+	outStatus.InstalledNodes = inst.installedNodes
+	outStatus.FailedNodes = inst.failedNodes
+	if state == statusv1alpha1.ProfileStateExpired && outStatus.Status != statusv1alpha1.ProfileStateExpired {
+		r.recordExpiry(ctx, prof, l)
+	}
+
 	switch state {
 	case statusv1alpha1.ProfileStatePending, "":
 		outStatus.Status = statusv1alpha1.ProfileStatePending
@@ -304,13 +393,28 @@ func (r *StatusReconciler) reconcileStatus(
 		outStatus.SetConditions(spodv1alpha1.Deleting())
 	case statusv1alpha1.ProfileStateError:
 		outStatus.Status = statusv1alpha1.ProfileStateError
-		outStatus.SetConditions(spodv1alpha1.Unavailable())
+		outStatus.SetConditions(unavailable(inst.failedNodes))
+	case statusv1alpha1.ProfileStatePartiallyInstalled:
+		outStatus.Status = statusv1alpha1.ProfileStatePartiallyInstalled
+		outStatus.SetConditions(unavailable(inst.failedNodes))
 	case statusv1alpha1.ProfileStatePartial:
 		outStatus.Status = statusv1alpha1.ProfileStatePartial
-		outStatus.SetConditions(spodv1alpha1.Unavailable())
+		outStatus.SetConditions(unavailable(inst.failedNodes))
 	case statusv1alpha1.ProfileStateDisabled:
 		outStatus.Status = statusv1alpha1.ProfileStateDisabled
 		outStatus.SetConditions(spodv1alpha1.Unavailable())
+	case statusv1alpha1.ProfileStateDryRun:
+		outStatus.Status = statusv1alpha1.ProfileStateDryRun
+		outStatus.SetConditions(spodv1alpha1.Unavailable())
+	case statusv1alpha1.ProfileStateExpired:
+		outStatus.Status = statusv1alpha1.ProfileStateExpired
+		outStatus.SetConditions(spodv1alpha1.Expired())
+	}
+
+	if util.ContentEqual(prof, pCopy) {
+		// Nothing changed, so there is no need to write to the API server
+		l.V(config.VerboseLevel).Info("Status is up to date")
+		return reconcile.Result{}, nil
 	}
 
 	l.V(config.VerboseLevel).Info("Updating status")
@@ -321,6 +425,51 @@ func (r *StatusReconciler) reconcileStatus(
 	return reconcile.Result{}, nil
 }
 
+// unavailable returns the unavailable condition including the names of the
+// nodes which failed to install the profile.
+func unavailable(failedNodes []string) metav1.Condition {
+	cond := spodv1alpha1.Unavailable()
+	if len(failedNodes) == 0 {
+		return cond
+	}
+
+	nodes := failedNodes
+	if len(nodes) > maxFailedNodesInCondition {
+		nodes = nodes[:maxFailedNodesInCondition]
+	}
+	cond.Message = "Failed to install on nodes: " + strings.Join(nodes, ", ")
+	if more := len(failedNodes) - len(nodes); more > 0 {
+		cond.Message += fmt.Sprintf(" and %d more", more)
+	}
+	return cond
+}
+
+// recordExpiry emits a warning event for an expired profile as well as for
+// all pods which are still using it.
+func (r *StatusReconciler) recordExpiry(ctx context.Context, prof pbv1alpha1.StatusBaseUser, l logr.Logger) {
+	r.record.Event(prof, util.EventTypeWarning, reasonProfileExpired, "Profile expired")
+
+	sp, ok := prof.(*seccompprofileapi.SeccompProfile)
+	if !ok {
+		return
+	}
+
+	for _, workload := range sp.Status.ActiveWorkloads {
+		namespace, name, found := strings.Cut(workload, "/")
+		if !found {
+			continue
+		}
+
+		pod := &v1.Pod{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod); err != nil {
+			l.Error(err, "Cannot get pod using expired profile", "pod", workload)
+			continue
+		}
+		r.record.Eventf(pod, util.EventTypeWarning, reasonProfileExpired,
+			"Pod is using the expired seccomp profile %s", sp.GetName())
+	}
+}
+
 func daemonSetIsReady(ds *appsv1.DaemonSet) bool {
 	return ds.Status.DesiredNumberScheduled > 0 && ds.Status.DesiredNumberScheduled == ds.Status.NumberAvailable
 }
@@ -0,0 +1,304 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodestatus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	landlockprofileapi "sigs.k8s.io/security-profiles-operator/api/landlockprofile/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestSummarizeNodeStatuses(t *testing.T) {
+	t.Parallel()
+
+	nodeStatuses := func(states ...statusv1alpha1.ProfileState) []statusv1alpha1.SecurityProfileNodeStatus {
+		res := make([]statusv1alpha1.SecurityProfileNodeStatus, 0, len(states))
+		for i, state := range states {
+			res = append(res, statusv1alpha1.SecurityProfileNodeStatus{
+				NodeName: "node-" + string(rune('a'+i)),
+				Status:   state,
+			})
+		}
+		return res
+	}
+
+	for _, tc := range []struct {
+		name     string
+		statuses []statusv1alpha1.SecurityProfileNodeStatus
+		expected *installation
+	}{
+		{
+			name: "installed",
+			statuses: nodeStatuses(
+				statusv1alpha1.ProfileStateInstalled, statusv1alpha1.ProfileStateInstalled,
+			),
+			expected: &installation{state: statusv1alpha1.ProfileStateInstalled, installedNodes: 2},
+		},
+		{
+			name: "pending",
+			statuses: nodeStatuses(
+				statusv1alpha1.ProfileStateInstalled, statusv1alpha1.ProfileStatePending,
+			),
+			expected: &installation{state: statusv1alpha1.ProfileStatePending, installedNodes: 1},
+		},
+		{
+			name: "partially installed",
+			statuses: nodeStatuses(
+				statusv1alpha1.ProfileStateError, statusv1alpha1.ProfileStateInstalled, statusv1alpha1.ProfileStateError,
+			),
+			expected: &installation{
+				state:          statusv1alpha1.ProfileStatePartiallyInstalled,
+				installedNodes: 1,
+				failedNodes:    []string{"node-a", "node-c"},
+			},
+		},
+		{
+			name: "failed on all nodes",
+			statuses: nodeStatuses(
+				statusv1alpha1.ProfileStateError, statusv1alpha1.ProfileStateError,
+			),
+			expected: &installation{
+				state:       statusv1alpha1.ProfileStateError,
+				failedNodes: []string{"node-a", "node-b"},
+			},
+		},
+		{
+			name: "failed while still in progress",
+			statuses: nodeStatuses(
+				statusv1alpha1.ProfileStateError, statusv1alpha1.ProfileStateInstalled, statusv1alpha1.ProfileStateInProgress,
+			),
+			expected: &installation{
+				state:          statusv1alpha1.ProfileStateError,
+				installedNodes: 1,
+				failedNodes:    []string{"node-a"},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, summarizeNodeStatuses(tc.statuses))
+		})
+	}
+}
+
+func TestReconcileStatusSkipsUnchanged(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+
+	profile := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "default"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(profile).
+		WithStatusSubresource(profile).
+		Build()
+	sut := &StatusReconciler{client: c, record: record.NewFakeRecorder(10)}
+
+	ctx := context.Background()
+	reconcileAndGet := func(inst *installation) *seccompprofileapi.SeccompProfile {
+		current := &seccompprofileapi.SeccompProfile{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(profile), current))
+		_, err := sut.reconcileStatus(ctx, current, inst, logr.Discard())
+		require.NoError(t, err)
+
+		res := &seccompprofileapi.SeccompProfile{}
+		require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(profile), res))
+		return res
+	}
+
+	installed := &installation{state: statusv1alpha1.ProfileStateInstalled, installedNodes: 2}
+	first := reconcileAndGet(installed)
+	require.Equal(t, statusv1alpha1.ProfileStateInstalled, first.Status.Status)
+
+	// Unchanged status is not written again
+	second := reconcileAndGet(installed)
+	require.Equal(t, first.ResourceVersion, second.ResourceVersion)
+
+	// Changed status gets written
+	third := reconcileAndGet(&installation{state: statusv1alpha1.ProfileStateInstalled, installedNodes: 3})
+	require.NotEqual(t, second.ResourceVersion, third.ResourceVersion)
+	require.EqualValues(t, 3, third.Status.InstalledNodes)
+}
+
+func TestUnavailable(t *testing.T) {
+	t.Parallel()
+
+	manyNodes := make([]string, 0, maxFailedNodesInCondition+2)
+	for i := 0; i < maxFailedNodesInCondition+2; i++ {
+		manyNodes = append(manyNodes, fmt.Sprintf("node-%d", i))
+	}
+
+	for _, tc := range []struct {
+		name        string
+		failedNodes []string
+		expected    string
+	}{
+		{
+			name:     "no failed nodes",
+			expected: "",
+		},
+		{
+			name:        "failed nodes",
+			failedNodes: []string{"node-a", "node-c"},
+			expected:    "Failed to install on nodes: node-a, node-c",
+		},
+		{
+			name:        "too many failed nodes",
+			failedNodes: manyNodes,
+			expected: "Failed to install on nodes: node-0, node-1, node-2, node-3, node-4, " +
+				"node-5, node-6, node-7, node-8, node-9 and 2 more",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cond := unavailable(tc.failedNodes)
+			require.Equal(t, spodv1alpha1.ReasonUnavailable, cond.Reason)
+			require.Equal(t, metav1.ConditionFalse, cond.Status)
+			require.Equal(t, tc.expected, cond.Message)
+		})
+	}
+}
+
+func TestReconcileStatusFailedNodes(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, landlockprofileapi.AddToScheme(scheme))
+
+	profile := &landlockprofileapi.LandlockProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "default"},
+	}
+	isController := true
+	nodeStatus := &statusv1alpha1.SecurityProfileNodeStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "profile-node-a",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: landlockprofileapi.GroupVersion.String(),
+				Kind:       "LandlockProfile",
+				Name:       "profile",
+				Controller: &isController,
+			}},
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(profile).
+		WithStatusSubresource(profile).
+		Build()
+	sut := &StatusReconciler{client: c, record: record.NewFakeRecorder(10)}
+
+	ctx := context.Background()
+	prof, err := sut.getProfileFromStatus(ctx, nodeStatus)
+	require.NoError(t, err)
+
+	_, err = sut.reconcileStatus(ctx, prof, &installation{
+		state:          statusv1alpha1.ProfileStatePartiallyInstalled,
+		installedNodes: 1,
+		failedNodes:    []string{"node-a"},
+	}, logr.Discard())
+	require.NoError(t, err)
+
+	res := &landlockprofileapi.LandlockProfile{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(profile), res))
+	require.Equal(t, statusv1alpha1.ProfileStatePartiallyInstalled, res.Status.Status)
+	require.Equal(t, []string{"node-a"}, res.Status.FailedNodes)
+	cond := res.Status.GetReadyCondition()
+	require.Equal(t, metav1.ConditionFalse, cond.Status)
+	require.Equal(t, "Failed to install on nodes: node-a", cond.Message)
+}
+
+func TestGetDS(t *testing.T) {
+	t.Parallel()
+
+	isController := true
+	spodDS := func(name, disabled string, desired int32) *appsv1.DaemonSet {
+		ds := &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "security-profiles-operator",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: spodv1alpha1.GroupVersion.String(),
+					Kind:       "SecurityProfilesOperatorDaemon",
+					Name:       "spod",
+					Controller: &isController,
+				}},
+			},
+			Status: appsv1.DaemonSetStatus{
+				DesiredNumberScheduled: desired,
+				NumberAvailable:        desired,
+				UpdatedNumberScheduled: desired,
+			},
+		}
+		if disabled != "" {
+			ds.Annotations = map[string]string{config.SPOdDisabledFeaturesAnnotation: disabled}
+		}
+		return ds
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			spodDS("spod", "", 1),
+			spodDS("spod-no-selinux", "selinux", 2),
+			spodDS("spod-no-selinux-apparmor", "selinux,apparmor", 4),
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "security-profiles-operator"}},
+		).
+		Build()
+	sut := &StatusReconciler{client: c}
+
+	for _, tc := range []struct {
+		kind     string
+		expected int32
+	}{
+		{kind: "SeccompProfile", expected: 7},
+		{kind: "SelinuxProfile", expected: 1},
+		{kind: "RawSelinuxProfile", expected: 1},
+		{kind: "AppArmorProfile", expected: 3},
+	} {
+		ds, err := sut.getDS(context.Background(), "security-profiles-operator", tc.kind, logr.Discard())
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, ds.Status.DesiredNumberScheduled, tc.kind)
+		require.True(t, daemonSetIsReady(ds), tc.kind)
+		require.False(t, daemonSetIsUpdating(ds), tc.kind)
+	}
+
+	_, err := sut.getDS(context.Background(), "other", "SeccompProfile", logr.Discard())
+	require.Error(t, err)
+}
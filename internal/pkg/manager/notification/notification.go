@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	pbv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/notifier"
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &Notifier{}
+}
+
+// A Notifier watches security profiles and sends their lifecycle events to
+// an external HTTP endpoint.
+type Notifier struct {
+	log       logr.Logger
+	scheme    *runtime.Scheme
+	notifier  *notifier.Notifier
+	informers cache.Informers
+	started   time.Time
+}
+
+// Name returns the name of the controller.
+func (r *Notifier) Name() string {
+	return "notification"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *Notifier) SchemeBuilder() *scheme.Builder {
+	return nil
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *Notifier) Healthz(*http.Request) error {
+	return nil
+}
+
+// NeedLeaderElection returns true to only send notifications from the leading
+// operator instance.
+func (r *Notifier) NeedLeaderElection() bool {
+	return true
+}
+
+// Start registers the profile event handlers and removes them again if the
+// context is done.
+func (r *Notifier) Start(ctx context.Context) error {
+	r.started = time.Now()
+
+	type registration struct {
+		informer cache.Informer
+		handle   toolscache.ResourceEventHandlerRegistration
+	}
+	registrations := []registration{}
+
+	for _, obj := range []client.Object{
+		&seccompprofileapi.SeccompProfile{},
+		&selxv1alpha2.SelinuxProfile{},
+		&selxv1alpha2.RawSelinuxProfile{},
+	} {
+		informer, err := r.informers.GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("get informer for %T: %w", obj, err)
+		}
+
+		handle, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc:    r.onAdd,
+			UpdateFunc: r.onUpdate,
+			DeleteFunc: r.onDelete,
+		})
+		if err != nil {
+			return fmt.Errorf("add event handler for %T: %w", obj, err)
+		}
+		registrations = append(registrations, registration{informer, handle})
+	}
+
+	<-ctx.Done()
+
+	for _, reg := range registrations {
+		if err := reg.informer.RemoveEventHandler(reg.handle); err != nil {
+			r.log.Error(err, "Unable to remove event handler")
+		}
+	}
+
+	return nil
+}
+
+func (r *Notifier) onAdd(obj interface{}) {
+	o, ok := obj.(client.Object)
+	if !ok {
+		return
+	}
+
+	// Objects existing before the operator started are reported as added
+	// by the informer, but they have not been created recently.
+	if o.GetCreationTimestamp().Time.Before(r.started) {
+		return
+	}
+
+	r.notify(notifier.EventProfileCreated, o, "")
+
+	// Recorded profiles are created once the recording of their container
+	// finished, either directly by the daemon or by merging the partial
+	// profiles of the recording.
+	labels := o.GetLabels()
+	if recording := labels[profilerecording1alpha1.ProfileToRecordingLabel]; recording != "" &&
+		labels[pbv1alpha1.ProfilePartialLabel] != "true" {
+		r.sendEvent(&notifier.Event{
+			Type:      notifier.EventRecordingCompleted,
+			Kind:      "ProfileRecording",
+			Name:      recording,
+			Namespace: o.GetNamespace(),
+			Message:   fmt.Sprintf("recorded %s %s is available", r.kindOf(o), o.GetName()),
+		})
+	}
+}
+
+func (r *Notifier) onUpdate(oldObj, newObj interface{}) {
+	o, ok := oldObj.(client.Object)
+	if !ok {
+		return
+	}
+	n, ok := newObj.(client.Object)
+	if !ok {
+		return
+	}
+
+	if o.GetGeneration() != n.GetGeneration() {
+		r.notify(notifier.EventProfileUpdated, n, "")
+	}
+
+	oldStatus, ok := o.(pbv1alpha1.StatusBaseUser)
+	if !ok {
+		return
+	}
+	newStatus, ok := n.(pbv1alpha1.StatusBaseUser)
+	if !ok {
+		return
+	}
+
+	state := newStatus.GetStatusBase().Status
+	if oldStatus.GetStatusBase().Status == state {
+		return
+	}
+	switch state {
+	case statusv1alpha1.ProfileStateError:
+		r.notify(
+			notifier.EventProfileInstallFailed, n,
+			"profile could not be installed on at least one node",
+		)
+	case statusv1alpha1.ProfileStatePartiallyInstalled:
+		r.notify(
+			notifier.EventProfileInstallFailed, n,
+			"profile could not be installed on nodes: "+strings.Join(newStatus.GetStatusBase().FailedNodes, ", "),
+		)
+	default:
+	}
+}
+
+func (r *Notifier) onDelete(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	o, ok := obj.(client.Object)
+	if !ok {
+		return
+	}
+
+	r.notify(notifier.EventProfileDeleted, o, "")
+}
+
+func (r *Notifier) notify(eventType notifier.EventType, obj client.Object, msg string) {
+	r.sendEvent(&notifier.Event{
+		Type:      eventType,
+		Kind:      r.kindOf(obj),
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Message:   msg,
+	})
+}
+
+func (r *Notifier) sendEvent(event *notifier.Event) {
+	r.log.V(config.VerboseLevel).Info(
+		"Sending notification", "type", event.Type, "kind", event.Kind, "name", event.Name,
+	)
+	r.notifier.NotifyAsync(event)
+}
+
+func (r *Notifier) kindOf(obj client.Object) string {
+	if gvk, err := apiutil.GVKForObject(obj, r.scheme); err == nil {
+		return gvk.Kind
+	}
+	return obj.GetObjectKind().GroupVersionKind().Kind
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	pbv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/notifier"
+)
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestOnAdd(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		labels     map[string]string
+		wantEvents []string
+	}{
+		{
+			name:       "profile",
+			wantEvents: []string{"ProfileCreated/SeccompProfile/profile"},
+		},
+		{
+			name: "recorded profile",
+			labels: map[string]string{
+				profilerecording1alpha1.ProfileToRecordingLabel: "rec",
+			},
+			wantEvents: []string{
+				"ProfileCreated/SeccompProfile/profile",
+				"RecordingCompleted/ProfileRecording/rec",
+			},
+		},
+		{
+			name: "partial recorded profile",
+			labels: map[string]string{
+				profilerecording1alpha1.ProfileToRecordingLabel: "rec",
+				pbv1alpha1.ProfilePartialLabel:                  "true",
+			},
+			wantEvents: []string{"ProfileCreated/SeccompProfile/profile"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			events := make(chan string, 10)
+			srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				event := &notifier.Event{}
+				if err := json.NewDecoder(r.Body).Decode(event); err == nil {
+					events <- string(event.Type) + "/" + event.Kind + "/" + event.Name
+				}
+			}))
+			defer srv.Close()
+			t.Setenv(notifier.URLEnvKey, srv.URL)
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+			sut := &Notifier{
+				log:      logr.Discard(),
+				scheme:   scheme,
+				notifier: notifier.New(logr.Discard()),
+			}
+
+			sut.onAdd(&seccompprofileapi.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "profile",
+					Namespace:         "ns",
+					Labels:            tc.labels,
+					CreationTimestamp: metav1.NewTime(time.Now().Add(time.Minute)),
+				},
+			})
+
+			got := []string{}
+			for range tc.wantEvents {
+				select {
+				case event := <-events:
+					got = append(got, event)
+				case <-time.After(5 * time.Second):
+					t.Fatal("timed out waiting for notification")
+				}
+			}
+			sort.Strings(got)
+			require.Equal(t, tc.wantEvents, got)
+		})
+	}
+}
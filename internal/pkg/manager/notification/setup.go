@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/features"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/notifier"
+)
+
+// Setup adds the notifier to the manager if notifications are enabled.
+func (r *Notifier) Setup(
+	ctx context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.log = ctrl.Log.WithName(r.Name())
+	r.scheme = mgr.GetScheme()
+	r.notifier = notifier.New(r.log)
+
+	if !r.notifier.Enabled() {
+		r.log.Info("Notifications are disabled", "envKey", notifier.URLEnvKey)
+		return nil
+	}
+
+	// The cache is not started yet, which means that the SPOD has to be read
+	// from the API server. It may not exist on the first start of the
+	// operator, where the default feature gates apply.
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	if err := mgr.GetAPIReader().Get(ctx, types.NamespacedName{
+		Name:      config.SPOdName,
+		Namespace: config.GetOperatorNamespace(),
+	}, spod); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("get SPOD for feature gates: %w", err)
+	}
+	if gates, _ := features.New(spod.Spec.FeatureGates); !gates.Enabled(features.Notifications) {
+		r.log.Info("Notifications are disabled by feature gate", "feature", features.Notifications)
+		return nil
+	}
+
+	// The handlers are registered once the operator instance became the
+	// leader to not send every notification once per replica.
+	r.informers = mgr.GetCache()
+	if err := mgr.Add(r); err != nil {
+		return fmt.Errorf("add notifier to manager: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/features"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/notifier"
+)
+
+var errTest = errors.New("test")
+
+type fakeInformer struct {
+	cache.Informer
+	added   int
+	removed int
+}
+
+func (f *fakeInformer) AddEventHandler(
+	toolscache.ResourceEventHandler,
+) (toolscache.ResourceEventHandlerRegistration, error) {
+	f.added++
+	return nil, nil
+}
+
+func (f *fakeInformer) RemoveEventHandler(toolscache.ResourceEventHandlerRegistration) error {
+	f.removed++
+	return nil
+}
+
+type fakeCache struct {
+	cache.Cache
+	informer *fakeInformer
+	err      error
+}
+
+func (f *fakeCache) GetInformer(context.Context, client.Object, ...cache.InformerGetOption) (cache.Informer, error) {
+	return f.informer, f.err
+}
+
+type fakeManager struct {
+	manager.Manager
+	scheme    *runtime.Scheme
+	reader    client.Reader
+	runnables []manager.Runnable
+}
+
+func (f *fakeManager) GetScheme() *runtime.Scheme {
+	return f.scheme
+}
+
+func (f *fakeManager) GetAPIReader() client.Reader {
+	return f.reader
+}
+
+func (f *fakeManager) GetCache() cache.Cache {
+	return &fakeCache{}
+}
+
+func (f *fakeManager) Add(r manager.Runnable) error {
+	f.runnables = append(f.runnables, r)
+	return nil
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestSetup(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		url       string
+		gates     map[string]bool
+		noSPOD    bool
+		wantAdded bool
+	}{
+		{
+			name: "disabled by default",
+			url:  "http://localhost",
+		},
+		{
+			name:      "enabled by feature gate",
+			url:       "http://localhost",
+			gates:     map[string]bool{string(features.Notifications): true},
+			wantAdded: true,
+		},
+		{
+			name:   "disabled without SPOD",
+			url:    "http://localhost",
+			noSPOD: true,
+		},
+		{
+			name:  "disabled without URL",
+			gates: map[string]bool{string(features.Notifications): true},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.OperatorNamespaceEnvKey, "security-profiles-operator")
+			t.Setenv(config.FeatureGatesEnvKey, "")
+			t.Setenv(notifier.URLEnvKey, tc.url)
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, spodv1alpha1.AddToScheme(scheme))
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if !tc.noSPOD {
+				builder = builder.WithObjects(&spodv1alpha1.SecurityProfilesOperatorDaemon{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      config.SPOdName,
+						Namespace: "security-profiles-operator",
+					},
+					Spec: spodv1alpha1.SPODSpec{FeatureGates: tc.gates},
+				})
+			}
+			mgr := &fakeManager{scheme: scheme, reader: builder.Build()}
+
+			sut := &Notifier{}
+			require.NoError(t, sut.Setup(context.Background(), mgr, nil))
+
+			if !tc.wantAdded {
+				require.Empty(t, mgr.runnables)
+				return
+			}
+			require.Len(t, mgr.runnables, 1)
+			runnable, ok := mgr.runnables[0].(manager.LeaderElectionRunnable)
+			require.True(t, ok)
+			require.True(t, runnable.NeedLeaderElection())
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		err         error
+		wantErr     bool
+		wantAdded   int
+		wantRemoved int
+	}{
+		{
+			name:        "registers and removes handlers",
+			wantAdded:   3,
+			wantRemoved: 3,
+		},
+		{
+			name:    "failure on get informer",
+			err:     errTest,
+			wantErr: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			informer := &fakeInformer{}
+			sut := &Notifier{informers: &fakeCache{informer: informer, err: tc.err}}
+
+			// The context is done already to make Start return after the
+			// registration of the handlers.
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := sut.Start(ctx)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantAdded, informer.added)
+			require.Equal(t, tc.wantRemoved, informer.removed)
+		})
+	}
+}
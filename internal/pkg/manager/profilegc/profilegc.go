@@ -0,0 +1,260 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilegc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+	checkInterval    = 1 * time.Hour
+
+	reasonUnusedProfileDeleted = "UnusedProfileDeleted"
+	reasonUnusedProfileMarked  = "UnusedProfileMarked"
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &Collector{}
+}
+
+// A Collector periodically deletes or marks the recorded profiles of every
+// namespace which have not been used by any pod for the retention period
+// configured in the SPOD instance.
+type Collector struct {
+	client client.Client
+	log    logr.Logger
+	record record.EventRecorder
+}
+
+// Name returns the name of the controller.
+func (r *Collector) Name() string {
+	return "recorded-profile-gc"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *Collector) SchemeBuilder() *scheme.Builder {
+	return nil
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *Collector) Healthz(*http.Request) error {
+	return nil
+}
+
+// recordedProfile is a recorded profile together with its usage.
+type recordedProfile struct {
+	client.Object
+	kind            string
+	activeWorkloads int
+	unusedSince     *metav1.Time
+}
+
+// Cluster scoped
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+// Namespace scoped
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create
+
+// Reconcile collects the unused recorded profiles of a namespace.
+func (r *Collector) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	if err := r.client.Get(ctx, spodName(), spod); util.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("get SPOD instance: %w", err)
+	}
+	opts := spod.Spec.RecordedProfileGC
+	if opts == nil {
+		return reconcile.Result{}, nil
+	}
+
+	profiles, err := r.recordedProfiles(ctx, req.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	requeueAfter := checkInterval
+	now := time.Now()
+	for i := range profiles {
+		next, err := r.collect(ctx, &profiles[i], opts, now)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if next > 0 {
+			requeueAfter = min(requeueAfter, next)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// recordedProfiles returns the recorded SeccompProfiles and SelinuxProfiles of
+// the namespace. Partial profiles are excluded, because they get merged once
+// their recording is deleted.
+func (r *Collector) recordedProfiles(ctx context.Context, namespace string) ([]recordedProfile, error) {
+	opts := []client.ListOption{
+		client.InNamespace(namespace),
+		client.HasLabels{profilerecordingv1alpha1.ProfileToRecordingLabel},
+	}
+	profiles := []recordedProfile{}
+	add := func(prf recordedProfile) {
+		if prf.GetLabels()[profilebase.ProfilePartialLabel] == "true" || !prf.GetDeletionTimestamp().IsZero() {
+			return
+		}
+		profiles = append(profiles, prf)
+	}
+
+	seccompProfiles := &seccompprofileapi.SeccompProfileList{}
+	if err := r.client.List(ctx, seccompProfiles, opts...); err != nil {
+		return nil, fmt.Errorf("list seccomp profiles: %w", err)
+	}
+	for i := range seccompProfiles.Items {
+		sp := &seccompProfiles.Items[i]
+		add(recordedProfile{sp, "SeccompProfile", len(sp.Status.ActiveWorkloads), sp.Status.UnusedSince})
+	}
+
+	selinuxProfiles := &selxv1alpha2.SelinuxProfileList{}
+	if err := r.client.List(ctx, selinuxProfiles, opts...); err != nil {
+		return nil, fmt.Errorf("list SELinux profiles: %w", err)
+	}
+	for i := range selinuxProfiles.Items {
+		sp := &selinuxProfiles.Items[i]
+		add(recordedProfile{sp, "SelinuxProfile", len(sp.Status.ActiveWorkloads), sp.Status.UnusedSince})
+	}
+
+	return profiles, nil
+}
+
+// collect deletes or marks the profile if it has been unused for the
+// retention period. Otherwise, it returns the duration until the retention
+// period of an unused profile ends.
+func (r *Collector) collect(
+	ctx context.Context, prf *recordedProfile, opts *spodv1alpha1.RecordedProfileGCOptions, now time.Time,
+) (time.Duration, error) {
+	_, marked := prf.GetLabels()[config.UnusedProfileLabelKey]
+	if prf.activeWorkloads > 0 {
+		if marked {
+			return 0, r.mark(ctx, prf, false)
+		}
+		return 0, nil
+	}
+
+	since := prf.GetCreationTimestamp()
+	if prf.unusedSince != nil {
+		since = *prf.unusedSince
+	}
+	if remaining := since.Add(opts.Retention.Duration).Sub(now); remaining > 0 {
+		return remaining, nil
+	}
+
+	if opts.Action == spodv1alpha1.RecordedProfileGCActionMark {
+		if marked {
+			return 0, nil
+		}
+		if err := r.mark(ctx, prf, true); err != nil {
+			return 0, err
+		}
+		r.log.Info("Marked unused recorded profile", "kind", prf.kind, "profile", client.ObjectKeyFromObject(prf))
+		r.record.Eventf(prf, util.EventTypeNormal, reasonUnusedProfileMarked,
+			"Profile has not been used since %s", since.UTC().Format(time.RFC3339))
+		return 0, nil
+	}
+
+	if err := r.client.Delete(ctx, prf.Object); util.IgnoreNotFound(err) != nil {
+		return 0, fmt.Errorf("delete unused profile: %w", err)
+	}
+	r.log.Info("Deleted unused recorded profile", "kind", prf.kind, "profile", client.ObjectKeyFromObject(prf))
+	r.record.Eventf(prf, util.EventTypeNormal, reasonUnusedProfileDeleted,
+		"Profile has not been used since %s", since.UTC().Format(time.RFC3339))
+	return 0, nil
+}
+
+// mark adds or removes the label marking a profile as unused.
+func (r *Collector) mark(ctx context.Context, prf *recordedProfile, unused bool) error {
+	patched, ok := prf.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("copy profile %s", prf.GetName())
+	}
+	labels := patched.GetLabels()
+	if unused {
+		labels[config.UnusedProfileLabelKey] = "true"
+	} else {
+		delete(labels, config.UnusedProfileLabelKey)
+	}
+	patched.SetLabels(labels)
+
+	if err := r.client.Patch(ctx, patched, client.MergeFrom(prf.Object)); err != nil {
+		return fmt.Errorf("patch unused profile label: %w", err)
+	}
+	return nil
+}
+
+func spodName() types.NamespacedName {
+	return util.NamespacedName(config.SPOdName, config.GetOperatorNamespace())
+}
+
+// enqueueNamespaces collects the profiles of all namespaces if the SPOD
+// instance changes, because the garbage collection may have been enabled.
+func (r *Collector) enqueueNamespaces(ctx context.Context, obj client.Object) []reconcile.Request {
+	if obj.GetName() != config.SPOdName {
+		return []reconcile.Request{}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	namespaces := &corev1.NamespaceList{}
+	if err := r.client.List(ctx, namespaces); err != nil {
+		r.log.Error(err, "cannot list namespaces")
+		return []reconcile.Request{}
+	}
+
+	reconcileRequests := make([]reconcile.Request, 0, len(namespaces.Items))
+	for i := range namespaces.Items {
+		reconcileRequests = append(reconcileRequests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: namespaces.Items[i].Name},
+		})
+	}
+	return reconcileRequests
+}
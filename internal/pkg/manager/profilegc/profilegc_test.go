@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilegc
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	const retention = 24 * time.Hour
+	longAgo := metav1.NewTime(time.Now().Add(-2 * retention))
+	recently := metav1.NewTime(time.Now().Add(-retention + 10*time.Minute))
+
+	seccompProfile := func(name string, labels map[string]string, active int, unusedSince *metav1.Time) client.Object {
+		sp := &seccompprofileapi.SeccompProfile{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "ns",
+			Labels:            labels,
+			CreationTimestamp: longAgo,
+		}}
+		for i := 0; i < active; i++ {
+			sp.Status.ActiveWorkloads = append(sp.Status.ActiveWorkloads, "ns/pod")
+		}
+		sp.Status.UnusedSince = unusedSince
+		return sp
+	}
+	recorded := map[string]string{profilerecordingv1alpha1.ProfileToRecordingLabel: "recording"}
+	marked := map[string]string{
+		profilerecordingv1alpha1.ProfileToRecordingLabel: "recording",
+		config.UnusedProfileLabelKey:                     "true",
+	}
+	partial := map[string]string{
+		profilerecordingv1alpha1.ProfileToRecordingLabel: "recording",
+		profilebase.ProfilePartialLabel:                  "true",
+	}
+
+	for _, tc := range []struct {
+		name         string
+		gc           *spodv1alpha1.RecordedProfileGCOptions
+		profiles     []client.Object
+		wantExisting map[string]bool
+		wantMarked   map[string]bool
+		wantRequeue  time.Duration
+	}{
+		{
+			name: "disabled",
+			profiles: []client.Object{
+				seccompProfile("unused", recorded, 0, &longAgo),
+			},
+			wantExisting: map[string]bool{"unused": true},
+		},
+		{
+			name: "delete",
+			gc:   &spodv1alpha1.RecordedProfileGCOptions{Retention: metav1.Duration{Duration: retention}},
+			profiles: []client.Object{
+				seccompProfile("unused", recorded, 0, &longAgo),
+				seccompProfile("never-used", recorded, 0, nil),
+				seccompProfile("used", marked, 1, nil),
+				seccompProfile("recently-unused", recorded, 0, &recently),
+				seccompProfile("not-recorded", nil, 0, &longAgo),
+				seccompProfile("partial", partial, 0, &longAgo),
+				&selxv1alpha2.SelinuxProfile{ObjectMeta: metav1.ObjectMeta{
+					Name: "selinux", Namespace: "ns", Labels: recorded, CreationTimestamp: longAgo,
+				}},
+			},
+			wantExisting: map[string]bool{
+				"unused":          false,
+				"never-used":      false,
+				"used":            true,
+				"recently-unused": true,
+				"not-recorded":    true,
+				"partial":         true,
+			},
+			// The label of the used profile is removed
+			wantMarked: map[string]bool{"used": false},
+			// The recently unused profile expires before the next check
+			wantRequeue: 10 * time.Minute,
+		},
+		{
+			name: "mark",
+			gc: &spodv1alpha1.RecordedProfileGCOptions{
+				Retention: metav1.Duration{Duration: retention},
+				Action:    spodv1alpha1.RecordedProfileGCActionMark,
+			},
+			profiles: []client.Object{
+				seccompProfile("unused", recorded, 0, &longAgo),
+				seccompProfile("used", recorded, 1, nil),
+			},
+			wantExisting: map[string]bool{"unused": true, "used": true},
+			wantMarked:   map[string]bool{"unused": true, "used": false},
+			wantRequeue:  checkInterval,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			//nolint:tenv // cannot use tenv after t.Parallel()
+			os.Setenv(config.OperatorNamespaceEnvKey, "security-profiles-operator")
+			scheme := runtime.NewScheme()
+			require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+			require.NoError(t, selxv1alpha2.AddToScheme(scheme))
+			require.NoError(t, spodv1alpha1.AddToScheme(scheme))
+
+			spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{
+				ObjectMeta: metav1.ObjectMeta{Name: config.SPOdName, Namespace: config.GetOperatorNamespace()},
+				Spec:       spodv1alpha1.SPODSpec{RecordedProfileGC: tc.gc},
+			}
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tc.profiles, spod)...).
+				Build()
+
+			sut := &Collector{client: cli, log: logr.Discard(), record: record.NewFakeRecorder(10)}
+			ctx := context.Background()
+			res, err := sut.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "ns"}})
+			require.NoError(t, err)
+			if tc.wantRequeue == 0 {
+				require.Zero(t, res.RequeueAfter)
+			} else {
+				require.InDelta(t, tc.wantRequeue, res.RequeueAfter, float64(time.Minute))
+			}
+
+			for name, exists := range tc.wantExisting {
+				sp := &seccompprofileapi.SeccompProfile{}
+				err := cli.Get(ctx, types.NamespacedName{Name: name, Namespace: "ns"}, sp)
+				if exists {
+					require.NoError(t, err, name)
+				} else {
+					require.Error(t, err, name)
+				}
+			}
+			for name, isMarked := range tc.wantMarked {
+				sp := &seccompprofileapi.SeccompProfile{}
+				require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: name, Namespace: "ns"}, sp))
+				_, ok := sp.Labels[config.UnusedProfileLabelKey]
+				require.Equal(t, isMarked, ok, name)
+			}
+		})
+	}
+}
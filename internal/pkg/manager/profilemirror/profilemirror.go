@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilemirror
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+
+	reasonMirrorConflict string = "ConfigMapMirrorConflict"
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &ProfileMirror{}
+}
+
+// A ProfileMirror mirrors the content of every SeccompProfile into a ConfigMap
+// with the same name, which can be read by tools not knowing the operator
+// APIs.
+type ProfileMirror struct {
+	client       client.Client
+	clientReader client.Reader
+	scheme       *runtime.Scheme
+	log          logr.Logger
+	record       record.EventRecorder
+}
+
+// Name returns the name of the controller.
+func (r *ProfileMirror) Name() string {
+	return "profile-mirror"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *ProfileMirror) SchemeBuilder() *scheme.Builder {
+	return seccompprofileapi.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *ProfileMirror) Healthz(*http.Request) error {
+	return nil
+}
+
+// Security Profiles Operator RBAC permissions to mirror SeccompProfiles
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create
+
+// Reconcile mirrors a SeccompProfile into a ConfigMap.
+func (r *ProfileMirror) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	logger := r.log.WithValues("profile", req.Name, "namespace", req.Namespace)
+
+	sp := &seccompprofileapi.SeccompProfile{}
+	if err := r.client.Get(ctx, req.NamespacedName, sp); err != nil {
+		// The mirror gets garbage collected together with the profile
+		if util.IgnoreNotFound(err) == nil {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("get seccomp profile: %w", err)
+	}
+
+	// ConfigMaps are read without the cache to not watch all of them
+	mirror := &corev1.ConfigMap{}
+	exists := true
+	if err := r.clientReader.Get(ctx, req.NamespacedName, mirror); err != nil {
+		if util.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, fmt.Errorf("get config map mirror: %w", err)
+		}
+		exists = false
+	}
+
+	if exists && !metav1.IsControlledBy(mirror, sp) {
+		logger.Info("Not mirroring profile, because a config map with the same name exists")
+		r.record.Event(sp, util.EventTypeWarning, reasonMirrorConflict,
+			"Not mirroring profile, because a config map with the same name exists")
+		return reconcile.Result{}, nil
+	}
+
+	if !sp.GetDeletionTimestamp().IsZero() || !sp.IsReconcilable() {
+		if !exists {
+			return reconcile.Result{}, nil
+		}
+		logger.Info("Removing config map mirror of profile which is not installed")
+		if err := r.client.Delete(ctx, mirror); util.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, fmt.Errorf("delete config map mirror: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	content, err := renderProfile(sp)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("render seccomp profile: %w", err)
+	}
+	data := map[string]string{sp.GetProfileFile(): string(content)}
+
+	if !exists {
+		mirror = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sp.GetName(),
+				Namespace: sp.GetNamespace(),
+				Labels:    map[string]string{config.ProfileMirrorLabelKey: sp.GetName()},
+			},
+			Data: data,
+		}
+		if err := controllerutil.SetControllerReference(sp, mirror, r.scheme); err != nil {
+			return reconcile.Result{}, fmt.Errorf("set owner of config map mirror: %w", err)
+		}
+
+		logger.Info("Creating config map mirror")
+		if err := r.client.Create(ctx, mirror); err != nil {
+			return reconcile.Result{}, fmt.Errorf("create config map mirror: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if equality.Semantic.DeepEqual(mirror.Data, data) {
+		return reconcile.Result{}, nil
+	}
+
+	logger.Info("Updating config map mirror")
+	mirror.Data = data
+	if err := r.client.Update(ctx, mirror); err != nil {
+		return reconcile.Result{}, fmt.Errorf("update config map mirror: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// renderProfile returns the JSON content of the profile like it gets
+// installed on the nodes, including the syscalls of its base profiles if the
+// daemon resolved them already.
+func renderProfile(sp *seccompprofileapi.SeccompProfile) ([]byte, error) {
+	spec := sp.Spec.DeepCopy()
+	if syscalls, ok := sp.GetAnnotations()[seccompprofileapi.SyscallsAnnotation]; ok {
+		if err := json.Unmarshal([]byte(syscalls), &spec.Syscalls); err != nil {
+			return nil, fmt.Errorf("unmarshal resolved syscalls: %w", err)
+		}
+	}
+
+	content, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal profile: %w", err)
+	}
+	return content, nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilemirror
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	name := types.NamespacedName{Namespace: "ns", Name: "profile"}
+	profile := func(mutate func(*seccompprofileapi.SeccompProfile)) *seccompprofileapi.SeccompProfile {
+		sp := &seccompprofileapi.SeccompProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace, UID: "uid"},
+			Spec: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction: "SCMP_ACT_ERRNO",
+				Syscalls: []*seccompprofileapi.Syscall{{
+					Action: "SCMP_ACT_ALLOW",
+					Names:  []string{"read"},
+				}},
+			},
+		}
+		if mutate != nil {
+			mutate(sp)
+		}
+		return sp
+	}
+
+	for _, tc := range []struct {
+		name    string
+		objects func(*runtime.Scheme) []client.Object
+		assert  func(*corev1.ConfigMap, error)
+	}{
+		{
+			name: "create mirror",
+			objects: func(*runtime.Scheme) []client.Object {
+				return []client.Object{profile(nil)}
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.NoError(t, err)
+				require.Equal(t, name.Name, cm.Labels[config.ProfileMirrorLabelKey])
+				require.JSONEq(t,
+					`{"defaultAction":"SCMP_ACT_ERRNO","disabled":false,"syscalls":[{"action":"SCMP_ACT_ALLOW","names":["read"]}]}`,
+					cm.Data["profile.json"],
+				)
+				require.Len(t, cm.OwnerReferences, 1)
+			},
+		},
+		{
+			name: "create mirror with resolved base profile syscalls",
+			objects: func(*runtime.Scheme) []client.Object {
+				return []client.Object{profile(func(sp *seccompprofileapi.SeccompProfile) {
+					sp.Spec.BaseProfileName = "base"
+					sp.Annotations = map[string]string{
+						seccompprofileapi.SyscallsAnnotation: `[{"action":"SCMP_ACT_ALLOW","names":["read","write"]}]`,
+					}
+				})}
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.NoError(t, err)
+				require.JSONEq(t,
+					`{"baseProfileName":"base","defaultAction":"SCMP_ACT_ERRNO","disabled":false,`+
+						`"syscalls":[{"action":"SCMP_ACT_ALLOW","names":["read","write"]}]}`,
+					cm.Data["profile.json"],
+				)
+			},
+		},
+		{
+			name: "update mirror",
+			objects: func(s *runtime.Scheme) []client.Object {
+				sp := profile(nil)
+				cm := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace},
+					Data:       map[string]string{"profile.json": "{}"},
+				}
+				require.NoError(t, controllerutil.SetControllerReference(sp, cm, s))
+				return []client.Object{sp, cm}
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.NoError(t, err)
+				require.Contains(t, cm.Data["profile.json"], "SCMP_ACT_ALLOW")
+			},
+		},
+		{
+			name: "do not overwrite foreign config map",
+			objects: func(*runtime.Scheme) []client.Object {
+				return []client.Object{profile(nil), &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace},
+					Data:       map[string]string{"key": "value"},
+				}}
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{"key": "value"}, cm.Data)
+			},
+		},
+		{
+			name: "remove mirror of partial profile",
+			objects: func(s *runtime.Scheme) []client.Object {
+				sp := profile(func(sp *seccompprofileapi.SeccompProfile) {
+					sp.Labels = map[string]string{profilebase.ProfilePartialLabel: "true"}
+				})
+				cm := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace},
+				}
+				require.NoError(t, controllerutil.SetControllerReference(sp, cm, s))
+				return []client.Object{sp, cm}
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.True(t, kerrors.IsNotFound(err))
+			},
+		},
+		{
+			name: "profile not found",
+			objects: func(*runtime.Scheme) []client.Object {
+				return nil
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.True(t, kerrors.IsNotFound(err))
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(s))
+			require.NoError(t, seccompprofileapi.AddToScheme(s))
+			cli := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.objects(s)...).Build()
+
+			sut := &ProfileMirror{
+				client:       cli,
+				clientReader: cli,
+				scheme:       s,
+				log:          logr.Discard(),
+				record:       record.NewFakeRecorder(10),
+			}
+			_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: name})
+			require.NoError(t, err)
+
+			cm := &corev1.ConfigMap{}
+			tc.assert(cm, cli.Get(context.Background(), name, cm))
+		})
+	}
+}
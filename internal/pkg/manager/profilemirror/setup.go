@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilemirror
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/features"
+)
+
+// Setup adds a controller that mirrors SeccompProfiles if it is enabled.
+func (r *ProfileMirror) Setup(
+	ctx context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.client = mgr.GetClient()
+	r.clientReader = mgr.GetAPIReader()
+	r.scheme = mgr.GetScheme()
+	r.log = ctrl.Log.WithName(r.Name())
+	r.record = mgr.GetEventRecorderFor(r.Name())
+
+	// The cache is not started yet, which means that the SPOD has to be read
+	// from the API server. It may not exist on the first start of the
+	// operator, where the default feature gates apply.
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	if err := r.clientReader.Get(ctx, types.NamespacedName{
+		Name:      config.SPOdName,
+		Namespace: config.GetOperatorNamespace(),
+	}, spod); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("get SPOD for feature gates: %w", err)
+	}
+	if gates, _ := features.New(spod.Spec.FeatureGates); !gates.Enabled(features.ConfigMapMirror) {
+		r.log.Info("Config map mirror is disabled by feature gate", "feature", features.ConfigMapMirror)
+		return nil
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(r.Name()).
+		For(&seccompprofileapi.SeccompProfile{}).
+		Complete(r)
+}
@@ -18,7 +18,9 @@ package recordingmerger
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +34,31 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
+// ErrConflictingDefaultAction is returned when merging seccomp profiles which
+// use different default actions.
+var ErrConflictingDefaultAction = errors.New("conflicting default actions")
+
+// MergeSeccompProfiles merges the provided seccomp profiles the same way the
+// partial profiles of a recording get merged. The first profile is used as
+// base and gets modified in place.
+func MergeSeccompProfiles(profiles []*seccompprofile.SeccompProfile) (*seccompprofile.SeccompProfile, error) {
+	mergeable := make([]mergeableProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		mergeable = append(mergeable, &mergeableSeccompProfile{SeccompProfile: *profile})
+	}
+
+	merged, err := mergeProfiles(mergeable)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, ok := merged.getProfile().(*seccompprofile.SeccompProfile)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %T to SeccompProfile", merged.getProfile())
+	}
+	return profile, nil
+}
+
 func mergedObjectMeta(profileName, recordingName, namespace string) *metav1.ObjectMeta {
 	return &metav1.ObjectMeta{
 		Name:      profileName,
@@ -43,6 +70,9 @@ func mergedObjectMeta(profileName, recordingName, namespace string) *metav1.Obje
 }
 
 func mergedProfileName(recordingName string, prf metav1.Object) string {
+	if name := prf.GetAnnotations()[profilerecording1alpha1.ProfileNameAnnotation]; name != "" {
+		return name
+	}
 	suffix := prf.GetLabels()[profilerecording1alpha1.ProfileToContainerLabel]
 	if suffix == "" {
 		suffix = prf.GetName()
@@ -167,12 +197,102 @@ func (sp *mergeableSeccompProfile) merge(other mergeableProfile) error {
 	if !ok {
 		return fmt.Errorf("cannot merge SeccompProfile with %T", other)
 	}
+	if sp.Spec.DefaultAction != otherSP.Spec.DefaultAction {
+		return fmt.Errorf(
+			"%w: %s and %s", ErrConflictingDefaultAction, sp.Spec.DefaultAction, otherSP.Spec.DefaultAction,
+		)
+	}
+	sp.Spec.Architectures = unionArchitectures(sp.Spec.Architectures, otherSP.Spec.Architectures)
+
 	syscalls, err := util.UnionSyscalls(sp.Spec.Syscalls, otherSP.Spec.Syscalls)
 	if err != nil {
 		return fmt.Errorf("union syscalls: %w", err)
 	}
 	sp.Spec.Syscalls = syscalls
 
+	if err := mergeSyscallExecutables(&sp.ObjectMeta, otherSP.GetAnnotations()); err != nil {
+		return fmt.Errorf("merge syscall executables: %w", err)
+	}
+
+	return mergeRecordingSources(&sp.ObjectMeta, otherSP)
+}
+
+// unionArchitectures returns the sorted architectures of both profiles, since
+// partial profiles may be recorded on nodes with different architectures.
+func unionArchitectures(arches, otherArches []seccompprofile.Arch) []seccompprofile.Arch {
+	if len(otherArches) == 0 {
+		return arches
+	}
+
+	union := append([]seccompprofile.Arch{}, arches...)
+	for _, arch := range otherArches {
+		if !slices.Contains(union, arch) {
+			union = append(union, arch)
+		}
+	}
+
+	slices.Sort(union)
+	return union
+}
+
+func copySyscallExecutables(dst *metav1.ObjectMeta, srcAnnotations map[string]string) {
+	if executables, ok := srcAnnotations[profilerecording1alpha1.ProfileSyscallExecutablesAnnotation]; ok {
+		metav1.SetMetaDataAnnotation(dst, profilerecording1alpha1.ProfileSyscallExecutablesAnnotation, executables)
+	}
+}
+
+func mergeSyscallExecutables(base *metav1.ObjectMeta, otherAnnotations map[string]string) error {
+	other, ok := otherAnnotations[profilerecording1alpha1.ProfileSyscallExecutablesAnnotation]
+	if !ok {
+		return nil
+	}
+
+	executables, err := util.ParseSyscallExecutables(
+		base.GetAnnotations()[profilerecording1alpha1.ProfileSyscallExecutablesAnnotation],
+	)
+	if err != nil {
+		return fmt.Errorf("parse base profile executables: %w", err)
+	}
+	otherExecutables, err := util.ParseSyscallExecutables(other)
+	if err != nil {
+		return fmt.Errorf("parse merged profile executables: %w", err)
+	}
+
+	executables.Merge(otherExecutables)
+	metav1.SetMetaDataAnnotation(
+		base, profilerecording1alpha1.ProfileSyscallExecutablesAnnotation, executables.String(),
+	)
+	return nil
+}
+
+// copyRecordingSources sets the recording sources of the merged profile. The
+// profile keeps the label of the recording session only if all sources belong
+// to the same one.
+func copyRecordingSources(dst *metav1.ObjectMeta, src metav1.Object) error {
+	sources, err := util.RecordingSourcesOf(src)
+	if err != nil {
+		return fmt.Errorf("parse recording sources: %w", err)
+	}
+	if len(sources) > 0 {
+		util.SetRecordingSources(dst, sources)
+	}
+	return nil
+}
+
+func mergeRecordingSources(base *metav1.ObjectMeta, other metav1.Object) error {
+	sources, err := util.RecordingSourcesOf(base)
+	if err != nil {
+		return fmt.Errorf("parse base profile recording sources: %w", err)
+	}
+	otherSources, err := util.RecordingSourcesOf(other)
+	if err != nil {
+		return fmt.Errorf("parse merged profile recording sources: %w", err)
+	}
+
+	sources.Merge(otherSources)
+	if len(sources) > 0 {
+		util.SetRecordingSources(base, sources)
+	}
 	return nil
 }
 
@@ -196,7 +316,7 @@ func (sp *MergeableSelinuxProfile) merge(other mergeableProfile) error {
 	}
 	sp.Spec.Allow = addAllow(sp.Spec.Allow, otherSP.Spec.Allow)
 
-	return nil
+	return mergeRecordingSources(&sp.ObjectMeta, otherSP)
 }
 
 func addAllow(union, additional selinuxprofileapi.Allow) selinuxprofileapi.Allow {
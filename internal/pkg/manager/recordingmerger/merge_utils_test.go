@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 )
@@ -121,6 +122,121 @@ func TestMergeProfiles(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "Two seccomp profiles with executables",
+			prepare: func(t *testing.T) []mergeableProfile {
+				t.Helper()
+
+				parts := []seccompprofile.SeccompProfile{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-abc",
+							Annotations: map[string]string{
+								profilerecording1alpha1.ProfileSyscallExecutablesAnnotation: `{"read":["/bin/cat"]}`,
+							},
+						},
+						Spec: seccompprofile.SeccompProfileSpec{
+							Syscalls: []*seccompprofile.Syscall{
+								{Names: []string{"read"}, Action: seccomp.ActAllow},
+							},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-def",
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-ghi",
+							Annotations: map[string]string{
+								profilerecording1alpha1.ProfileSyscallExecutablesAnnotation: `{"ptrace":["/usr/bin/strace"],` +
+									`"read":["/bin/sh"]}`,
+							},
+						},
+						Spec: seccompprofile.SeccompProfileSpec{
+							Syscalls: []*seccompprofile.Syscall{
+								{Names: []string{"ptrace", "read"}, Action: seccomp.ActAllow},
+							},
+						},
+					},
+				}
+
+				partialSpecs := make([]mergeableProfile, len(parts))
+				for i := range parts {
+					var err error
+					partialSpecs[i], err = newMergeableProfile(&parts[i])
+					require.NoError(t, err)
+				}
+				return partialSpecs
+			},
+			assert: func(mergedProfIface mergeableProfile) error {
+				t.Helper()
+
+				mergedProf := ifaceAsSortedSeccompProfile(mergedProfIface)
+				require.Equal(t,
+					`{"ptrace":["/usr/bin/strace"],"read":["/bin/cat","/bin/sh"]}`,
+					mergedProf.Annotations[profilerecording1alpha1.ProfileSyscallExecutablesAnnotation],
+				)
+				return nil
+			},
+		},
+		{
+			name: "Two seccomp profiles with recording sources",
+			prepare: func(t *testing.T) []mergeableProfile {
+				t.Helper()
+
+				parts := []seccompprofile.SeccompProfile{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-node1",
+							Labels: map[string]string{
+								profilerecording1alpha1.ProfileToRecordingSessionLabel: "abc",
+							},
+							Annotations: map[string]string{
+								profilerecording1alpha1.ProfileRecordingSourcesAnnotation: `[{"session":"abc",` +
+									`"node":"node1","pod":"pod1",` +
+									`"start":"2023-01-02T03:00:00Z","end":"2023-01-02T04:00:00Z"}]`,
+							},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-node2",
+							Labels: map[string]string{
+								profilerecording1alpha1.ProfileToRecordingSessionLabel: "def",
+							},
+							Annotations: map[string]string{
+								profilerecording1alpha1.ProfileRecordingSourcesAnnotation: `[{"session":"def",` +
+									`"node":"node2","pod":"pod2",` +
+									`"start":"2023-01-02T02:00:00Z","end":"2023-01-02T05:00:00Z"}]`,
+							},
+						},
+					},
+				}
+
+				partialSpecs := make([]mergeableProfile, len(parts))
+				for i := range parts {
+					var err error
+					partialSpecs[i], err = newMergeableProfile(&parts[i])
+					require.NoError(t, err)
+				}
+				return partialSpecs
+			},
+			assert: func(mergedProfIface mergeableProfile) error {
+				t.Helper()
+
+				mergedProf := ifaceAsSortedSeccompProfile(mergedProfIface)
+				require.NotContains(t, mergedProf.Labels, profilerecording1alpha1.ProfileToRecordingSessionLabel)
+				require.JSONEq(t, `[
+					{"session":"def","node":"node2","pod":"pod2",
+					 "start":"2023-01-02T02:00:00Z","end":"2023-01-02T05:00:00Z"},
+					{"session":"abc","node":"node1","pod":"pod1",
+					 "start":"2023-01-02T03:00:00Z","end":"2023-01-02T04:00:00Z"}
+				]`, mergedProf.Annotations[profilerecording1alpha1.ProfileRecordingSourcesAnnotation])
+				return nil
+			},
+		},
 		{
 			name: "Two selinux profiles",
 			prepare: func(t *testing.T) []mergeableProfile {
@@ -194,3 +310,46 @@ func TestMergeProfiles(t *testing.T) {
 		})
 	}
 }
+
+func TestMergedProfileName(t *testing.T) {
+	t.Parallel()
+
+	prf := &metav1.ObjectMeta{
+		Name:   "rec-web-1b4e28ba",
+		Labels: map[string]string{profilerecording1alpha1.ProfileToContainerLabel: "web"},
+	}
+	require.Equal(t, "rec-web", mergedProfileName("rec", prf))
+
+	prf.Annotations = map[string]string{profilerecording1alpha1.ProfileNameAnnotation: "nginx-web-1.25"}
+	require.Equal(t, "nginx-web-1.25", mergedProfileName("rec", prf))
+}
+
+func TestMergeSeccompProfilesConflicts(t *testing.T) {
+	t.Parallel()
+
+	newProfile := func(action seccomp.Action, arches ...seccompprofile.Arch) *seccompprofile.SeccompProfile {
+		return &seccompprofile.SeccompProfile{
+			Spec: seccompprofile.SeccompProfileSpec{
+				DefaultAction: action,
+				Architectures: arches,
+				Syscalls: []*seccompprofile.Syscall{
+					{Names: []string{"read"}, Action: seccomp.ActAllow},
+				},
+			},
+		}
+	}
+
+	merged, err := MergeSeccompProfiles([]*seccompprofile.SeccompProfile{
+		newProfile(seccomp.ActErrno, "SCMP_ARCH_X86_64"),
+		newProfile(seccomp.ActErrno, "SCMP_ARCH_AARCH64", "SCMP_ARCH_X86_64"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []seccompprofile.Arch{"SCMP_ARCH_AARCH64", "SCMP_ARCH_X86_64"}, merged.Spec.Architectures)
+	require.Len(t, merged.Spec.Syscalls, 2)
+
+	_, err = MergeSeccompProfiles([]*seccompprofile.SeccompProfile{
+		newProfile(seccomp.ActErrno),
+		newProfile(seccomp.ActLog),
+	})
+	require.ErrorIs(t, err, ErrConflictingDefaultAction)
+}
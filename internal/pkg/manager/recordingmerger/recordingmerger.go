@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -118,67 +119,104 @@ func (r *PolicyMergeReconciler) mergeProfiles(
 	ctx context.Context,
 	profileRecording *profilerecording1alpha1.ProfileRecording,
 ) error {
-	var err error
-
-	switch profileRecording.Spec.Kind {
-	case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
-		err = r.mergeSeccompProfiles(ctx, profileRecording)
-	case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
-		err = r.mergeSelinuxProfiles(ctx, profileRecording)
-	default:
-		err = fmt.Errorf("%s: %s", errCannotMergeKind, profileRecording.Spec.Kind)
-		r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotMergeKind, err.Error())
+	mergedKinds := []profilerecording1alpha1.ProfileRecordingKind{}
+	for _, kind := range profileRecording.Kinds() {
+		var (
+			merged bool
+			err    error
+		)
+
+		switch kind {
+		case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
+			merged, err = r.mergeSeccompProfiles(ctx, profileRecording)
+		case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
+			merged, err = r.mergeSelinuxProfiles(ctx, profileRecording)
+		default:
+			err = fmt.Errorf("%s: %s", errCannotMergeKind, kind)
+			r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotMergeKind, err.Error())
+		}
+
+		if err != nil {
+			return fmt.Errorf("cannot merge profiles: %w", err)
+		}
+		if merged {
+			mergedKinds = append(mergedKinds, kind)
+		}
 	}
 
-	if err != nil {
-		return fmt.Errorf("cannot merge profiles: %w", err)
+	// The partial profiles are deleted after merging them, which means that
+	// subsequent reconciles of the deleted recording do not merge anything.
+	// The notification controller reports the merged profiles as completed
+	// recording once they got created.
+	if len(mergedKinds) > 0 {
+		r.log.Info("Merged recorded profiles", "kinds", joinKinds(mergedKinds))
 	}
 
-	return err
+	return nil
+}
+
+// joinKinds returns the comma separated list of kinds.
+func joinKinds(kinds []profilerecording1alpha1.ProfileRecordingKind) string {
+	names := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		names = append(names, string(kind))
+	}
+	return strings.Join(names, ", ")
 }
 
+// mergeTypedProfiles merges the partial profiles of the recording and returns
+// true if at least one merged profile has been created or updated.
 func (r *PolicyMergeReconciler) mergeTypedProfiles(
 	ctx context.Context,
 	profileRecording *profilerecording1alpha1.ProfileRecording,
 	createUpdateMergedProfile createUpdateFn,
 	profileItem client.Object,
 	listItem client.ObjectList,
-) error {
+) (bool, error) {
 	partialProfiles, err := listPartialProfiles(ctx, r.client, listItem, profileRecording)
 	if err != nil {
-		return fmt.Errorf("cannot list partial profiles: %w", err)
+		return false, fmt.Errorf("cannot list partial profiles: %w", err)
 	}
 
 	if len(partialProfiles) == 0 {
 		r.record.Event(profileRecording, util.EventTypeWarning, reasonNoPartialProfiles, errNoPartialProfiles)
 		r.log.Info(errNoPartialProfiles)
-		return nil
+		return false, nil
 	}
 
+	merged := false
+
 	for cntName, cntPartialProfiles := range partialProfiles {
 		r.log.Info("Merging profiles for container", "container", cntName)
 
 		mergedProfile, err := mergeProfiles(cntPartialProfiles)
 		if err != nil {
-			return fmt.Errorf("cannot merge partial profiles: %w", err)
+			return false, fmt.Errorf("cannot merge partial profiles: %w", err)
 		}
 
 		if mergedProfile == nil {
 			r.record.Event(profileRecording, util.EventTypeWarning, reasonMergedEmptyProfile, errEmptyMergedProfile)
 			r.log.Info(errEmptyMergedProfile)
-			return nil
+			return merged, nil
 		}
 
 		mergedRecordingName := mergedProfileName(profileRecording.Name, cntPartialProfiles[0])
 		res, err := createUpdateMergedProfile(ctx, r.client, profileRecording, mergedRecordingName, mergedProfile)
 		if err != nil {
 			r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotCreateUpdate, err.Error())
-			return fmt.Errorf("cannot create or update merged profile: action:  %w", err)
+			return false, fmt.Errorf("cannot create or update merged profile: action:  %w", err)
 		}
 		r.log.Info("Created/updated profile", "action", res, "name", mergedRecordingName)
+		if res != controllerutil.OperationResultNone {
+			merged = true
+		}
 	}
 
-	return deletePartialProfiles(ctx, r.client, profileItem, profileRecording)
+	if err := deletePartialProfiles(ctx, r.client, profileItem, profileRecording); err != nil {
+		return false, fmt.Errorf("cannot delete partial profiles: %w", err)
+	}
+
+	return merged, nil
 }
 
 type createUpdateFn func(
@@ -192,7 +230,7 @@ type createUpdateFn func(
 func (r *PolicyMergeReconciler) mergeSeccompProfiles(
 	ctx context.Context,
 	profileRecording *profilerecording1alpha1.ProfileRecording,
-) error {
+) (bool, error) {
 	return r.mergeTypedProfiles(
 		ctx,
 		profileRecording,
@@ -217,11 +255,17 @@ func createUpdateSeccompProfile(
 		return controllerutil.OperationResultNone, fmt.Errorf("cannot convert merged profile to SeccompProfile")
 	}
 	mergedSpec := mergedProf.Spec.DeepCopy()
+	if profileRecording.Spec.SeccompSyscallGrouping == profilerecording1alpha1.ProfileSyscallGroupingCategory {
+		// The union of the grouped partial profiles contains every category
+		// once per partial profile.
+		mergedSpec.Syscalls = util.GroupSyscallsByCategory(mergedSpec.Syscalls)
+	}
 	mergedSp.Spec = *mergedSpec
 	return controllerutil.CreateOrUpdate(ctx, cl, mergedSp,
 		func() error {
 			mergedSp.Spec = *mergedSpec
-			return nil
+			copySyscallExecutables(&mergedSp.ObjectMeta, mergedProf.GetAnnotations())
+			return copyRecordingSources(&mergedSp.ObjectMeta, mergedProf)
 		},
 	)
 }
@@ -229,7 +273,7 @@ func createUpdateSeccompProfile(
 func (r *PolicyMergeReconciler) mergeSelinuxProfiles(
 	ctx context.Context,
 	profileRecording *profilerecording1alpha1.ProfileRecording,
-) error {
+) (bool, error) {
 	return r.mergeTypedProfiles(
 		ctx,
 		profileRecording,
@@ -259,7 +303,7 @@ func createUpdateSelinuxProfile(
 	return controllerutil.CreateOrUpdate(ctx, cl, mergedSp,
 		func() error {
 			mergedSp.Spec = *mergedSpec
-			return nil
+			return copyRecordingSources(&mergedSp.ObjectMeta, mergedProf)
 		},
 	)
 }
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestMergeSeccompProfiles(t *testing.T) {
+	t.Parallel()
+
+	recording := &profilerecording1alpha1.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{Name: "rec", Namespace: "ns"},
+	}
+	partialProfile := func(name string, syscalls ...string) client.Object {
+		return &seccompprofile.SeccompProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels: map[string]string{
+					profilerecording1alpha1.ProfileToRecordingLabel: "rec",
+					profilerecording1alpha1.ProfileToContainerLabel: "ctr",
+					profilebase.ProfilePartialLabel:                 "true",
+				},
+			},
+			Spec: seccompprofile.SeccompProfileSpec{
+				DefaultAction: seccomp.ActErrno,
+				Syscalls: []*seccompprofile.Syscall{
+					{Names: syscalls, Action: seccomp.ActAllow},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name       string
+		partials   []client.Object
+		mergeTwice bool
+		want       bool
+	}{
+		{
+			name: "no partial profiles",
+		},
+		{
+			name: "merged profile created",
+			partials: []client.Object{
+				partialProfile("rec-ctr-1", "read"),
+				partialProfile("rec-ctr-2", "write"),
+			},
+			want: true,
+		},
+		{
+			name: "merged profile unchanged",
+			partials: []client.Object{
+				partialProfile("rec-ctr-1", "read"),
+			},
+			mergeTwice: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, seccompprofile.AddToScheme(scheme))
+			cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.partials...).Build()
+
+			sut := &PolicyMergeReconciler{
+				client: cl,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+			}
+
+			ctx := context.Background()
+			if tc.mergeTwice {
+				_, err := sut.mergeSeccompProfiles(ctx, recording)
+				require.NoError(t, err)
+				for _, partial := range tc.partials {
+					partial.SetResourceVersion("")
+					require.NoError(t, cl.Create(ctx, partial))
+				}
+			}
+
+			merged, err := sut.mergeSeccompProfiles(ctx, recording)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, merged)
+
+			partials := &seccompprofile.SeccompProfileList{}
+			require.NoError(t, cl.List(ctx, partials, client.MatchingLabels{
+				profilebase.ProfilePartialLabel: "true",
+			}))
+			require.Empty(t, partials.Items)
+
+			if len(tc.partials) > 0 {
+				require.NoError(t, cl.Get(
+					ctx, types.NamespacedName{Name: "rec-ctr", Namespace: "ns"}, &seccompprofile.SeccompProfile{},
+				))
+			}
+		})
+	}
+}
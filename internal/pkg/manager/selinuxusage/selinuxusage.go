@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxusage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+
+	// typeKeySuffix and levelKeySuffix are appended to the profile name to
+	// build the ConfigMap keys.
+	typeKeySuffix  = ".type"
+	levelKeySuffix = ".level"
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &Publisher{}
+}
+
+// A Publisher publishes the SELinux type and level of the SELinux profiles of
+// a namespace in a ConfigMap, which can be referenced by templating tools
+// like Helm charts.
+type Publisher struct {
+	client       client.Client
+	clientReader client.Reader
+	log          logr.Logger
+}
+
+// Name returns the name of the controller.
+func (r *Publisher) Name() string {
+	return "selinux-usage"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *Publisher) SchemeBuilder() *scheme.Builder {
+	return selxv1alpha2.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *Publisher) Healthz(*http.Request) error {
+	return nil
+}
+
+// Security Profiles Operator RBAC permissions to publish the SELinux usage
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;create;update;delete
+
+// Reconcile publishes the SELinux usage of all profiles in the namespace of
+// the request.
+func (r *Publisher) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	logger := r.log.WithValues("namespace", req.Namespace)
+
+	data, err := r.usage(ctx, req.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// ConfigMaps are read without the cache to not watch all of them
+	name := types.NamespacedName{Namespace: req.Namespace, Name: config.SelinuxUsageConfigMapName}
+	cm := &corev1.ConfigMap{}
+	exists := true
+	if err := r.clientReader.Get(ctx, name, cm); err != nil {
+		if util.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, fmt.Errorf("get SELinux usage config map: %w", err)
+		}
+		exists = false
+	}
+
+	if exists && cm.Labels[config.SelinuxUsageLabelKey] != "true" {
+		logger.Info("Not publishing SELinux usage, because a foreign config map with the same name exists")
+		return reconcile.Result{}, nil
+	}
+
+	if len(data) == 0 {
+		if !exists {
+			return reconcile.Result{}, nil
+		}
+		logger.Info("Removing SELinux usage config map without profiles")
+		if err := r.client.Delete(ctx, cm); util.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, fmt.Errorf("delete SELinux usage config map: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if !exists {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.Name,
+				Namespace: name.Namespace,
+				Labels:    map[string]string{config.SelinuxUsageLabelKey: "true"},
+			},
+			Data: data,
+		}
+		logger.Info("Creating SELinux usage config map")
+		if err := r.client.Create(ctx, cm); err != nil {
+			return reconcile.Result{}, fmt.Errorf("create SELinux usage config map: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if equality.Semantic.DeepEqual(cm.Data, data) {
+		return reconcile.Result{}, nil
+	}
+
+	logger.Info("Updating SELinux usage config map")
+	cm.Data = data
+	if err := r.client.Update(ctx, cm); err != nil {
+		return reconcile.Result{}, fmt.Errorf("update SELinux usage config map: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// usage returns the ConfigMap data containing the SELinux type and level of
+// every installable profile in the namespace.
+func (r *Publisher) usage(ctx context.Context, namespace string) (map[string]string, error) {
+	data := map[string]string{}
+
+	profiles := &selxv1alpha2.SelinuxProfileList{}
+	if err := r.client.List(ctx, profiles, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("list SELinux profiles: %w", err)
+	}
+	for i := range profiles.Items {
+		sp := &profiles.Items[i]
+		if !sp.GetDeletionTimestamp().IsZero() || !sp.IsReconcilable() {
+			continue
+		}
+		data[sp.GetName()+typeKeySuffix] = sp.GetPolicyUsage()
+		if level := sp.GetPolicyLevel(); level != "" {
+			data[sp.GetName()+levelKeySuffix] = level
+		}
+	}
+
+	rawProfiles := &selxv1alpha2.RawSelinuxProfileList{}
+	if err := r.client.List(ctx, rawProfiles, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("list raw SELinux profiles: %w", err)
+	}
+	for i := range rawProfiles.Items {
+		sp := &rawProfiles.Items[i]
+		if !sp.GetDeletionTimestamp().IsZero() || !sp.IsReconcilable() {
+			continue
+		}
+		data[sp.GetName()+typeKeySuffix] = sp.GetPolicyUsage()
+	}
+
+	return data, nil
+}
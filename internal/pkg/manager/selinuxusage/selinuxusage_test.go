@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxusage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	name := types.NamespacedName{Namespace: "ns", Name: config.SelinuxUsageConfigMapName}
+	profile := func(
+		profileName string, mutate func(*selxv1alpha2.SelinuxProfile),
+	) *selxv1alpha2.SelinuxProfile {
+		sp := &selxv1alpha2.SelinuxProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: profileName, Namespace: name.Namespace},
+		}
+		if mutate != nil {
+			mutate(sp)
+		}
+		return sp
+	}
+	managed := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.Name,
+				Namespace: name.Namespace,
+				Labels:    map[string]string{config.SelinuxUsageLabelKey: "true"},
+			},
+			Data: data,
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		objects []client.Object
+		assert  func(*corev1.ConfigMap, error)
+	}{
+		{
+			name: "create config map",
+			objects: []client.Object{
+				profile("app", nil),
+				profile("mcs", func(sp *selxv1alpha2.SelinuxProfile) {
+					sp.Spec.Categories = []selxv1alpha2.MCSCategory{"c1", "c2"}
+				}),
+				&selxv1alpha2.RawSelinuxProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "raw", Namespace: name.Namespace},
+				},
+				profile("other", func(sp *selxv1alpha2.SelinuxProfile) {
+					sp.Namespace = "other"
+				}),
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "true", cm.Labels[config.SelinuxUsageLabelKey])
+				require.Equal(t, map[string]string{
+					"app.type":  "app_ns.process",
+					"mcs.type":  "mcs_ns.process",
+					"mcs.level": "s0:c1,c2",
+					"raw.type":  "raw_ns.process",
+				}, cm.Data)
+			},
+		},
+		{
+			name: "update config map",
+			objects: []client.Object{
+				profile("app", nil),
+				managed(map[string]string{"old.type": "old_ns.process"}),
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{"app.type": "app_ns.process"}, cm.Data)
+			},
+		},
+		{
+			name: "skip partial profile",
+			objects: []client.Object{
+				profile("app", nil),
+				profile("partial", func(sp *selxv1alpha2.SelinuxProfile) {
+					sp.Labels = map[string]string{profilebase.ProfilePartialLabel: "true"}
+				}),
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{"app.type": "app_ns.process"}, cm.Data)
+			},
+		},
+		{
+			name: "do not overwrite foreign config map",
+			objects: []client.Object{
+				profile("app", nil),
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace},
+					Data:       map[string]string{"key": "value"},
+				},
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{"key": "value"}, cm.Data)
+			},
+		},
+		{
+			name: "remove config map without profiles",
+			objects: []client.Object{
+				managed(map[string]string{"app.type": "app_ns.process"}),
+			},
+			assert: func(cm *corev1.ConfigMap, err error) {
+				require.True(t, kerrors.IsNotFound(err))
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(s))
+			require.NoError(t, selxv1alpha2.AddToScheme(s))
+			cli := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.objects...).Build()
+
+			sut := &Publisher{
+				client:       cli,
+				clientReader: cli,
+				log:          logr.Discard(),
+			}
+			_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: name})
+			require.NoError(t, err)
+
+			cm := &corev1.ConfigMap{}
+			tc.assert(cm, cli.Get(context.Background(), name, cm))
+		})
+	}
+}
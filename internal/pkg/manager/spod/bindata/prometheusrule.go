@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindata
+
+import (
+	"fmt"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	severityWarning  = "warning"
+	severityCritical = "critical"
+
+	// webhookCertExpiryThreshold is the remaining validity of the webhook
+	// certificate in seconds which causes an alert (7 days).
+	webhookCertExpiryThreshold = 7 * 24 * 60 * 60
+)
+
+// PrometheusRule returns the default alerting rules for conditions known by
+// the operator, to be evaluated by the prometheus operator.
+func PrometheusRule(caInjectType CAInjectType) *v1.PrometheusRule {
+	rules := []v1.Rule{
+		alertFor(
+			"SecurityProfileInstallFailed",
+			`sum by (reason) (increase(spo_seccomp_profile_errors_total[10m])) > 0 or `+
+				`sum by (reason) (increase(spo_selinux_profile_errors_total[10m])) > 0 or `+
+				`sum by (reason) (increase(spo_apparmor_profile_errors_total[10m])) > 0`,
+			"",
+			severityWarning,
+			"Security profiles cannot be installed",
+			"Security profiles failed to install on at least one node with reason {{ $labels.reason }}.",
+		),
+		alertFor(
+			"SecurityProfilesEnricherLagging",
+			`histogram_quantile(0.9, sum by (node, le) `+
+				`(rate(spo_enricher_lag_seconds_bucket[10m]))) > 60`,
+			"15m",
+			severityWarning,
+			"Log enricher is lagging behind",
+			"The log enricher on node {{ $labels.node }} processes audit events with more than 60 seconds delay.",
+		),
+		alertFor(
+			"SecurityProfilesRecordingStalled",
+			`sum by (controller) (increase(controller_runtime_reconcile_errors_total`+
+				`{controller=~"profilerecorder|policymerger"}[30m])) > 0`,
+			"15m",
+			severityWarning,
+			"Profile recording is stalled",
+			"The {{ $labels.controller }} controller keeps failing to reconcile profile recordings.",
+		),
+	}
+
+	if caInjectType == CAInjectTypeCertManager {
+		rules = append(rules, alertFor(
+			"SecurityProfilesWebhookCertExpiring",
+			fmt.Sprintf(
+				`certmanager_certificate_expiration_timestamp_seconds{namespace=%q, name=%q} - time() < %d`,
+				config.GetOperatorNamespace(), webhookCert.Name, webhookCertExpiryThreshold,
+			),
+			"1h",
+			severityCritical,
+			"Webhook certificate is about to expire",
+			"The certificate of the operator webhook expires in less than 7 days.",
+		))
+	}
+
+	return &v1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "security-profiles-operator-alerts",
+			Namespace: config.GetOperatorNamespace(),
+		},
+		Spec: v1.PrometheusRuleSpec{
+			Groups: []v1.RuleGroup{{
+				Name:  config.OperatorName,
+				Rules: rules,
+			}},
+		},
+	}
+}
+
+// alertFor provides an alerting rule with the common labels and annotations.
+func alertFor(name, expr string, forDuration v1.Duration, severity, summary, description string) v1.Rule {
+	rule := v1.Rule{
+		Alert: name,
+		Expr:  intstr.FromString(expr),
+		Labels: map[string]string{
+			"severity": severity,
+		},
+		Annotations: map[string]string{
+			"summary":     summary,
+			"description": description,
+		},
+	}
+	if forDuration != "" {
+		rule.For = &forDuration
+	}
+	return rule
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestPrometheusRule(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		caInjectType CAInjectType
+		expected     []string
+	}{
+		{
+			name:         "cert-manager",
+			caInjectType: CAInjectTypeCertManager,
+			expected: []string{
+				"SecurityProfileInstallFailed",
+				"SecurityProfilesEnricherLagging",
+				"SecurityProfilesRecordingStalled",
+				"SecurityProfilesWebhookCertExpiring",
+			},
+		},
+		{
+			name:         "openshift",
+			caInjectType: CAInjectTypeOpenShift,
+			expected: []string{
+				"SecurityProfileInstallFailed",
+				"SecurityProfilesEnricherLagging",
+				"SecurityProfilesRecordingStalled",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.OperatorNamespaceEnvKey, "test-ns")
+
+			rule := PrometheusRule(tc.caInjectType)
+			require.Equal(t, "test-ns", rule.Namespace)
+			require.Len(t, rule.Spec.Groups, 1)
+
+			alerts := []string{}
+			for _, r := range rule.Spec.Groups[0].Rules {
+				require.NotEmpty(t, r.Expr.String())
+				require.NotEmpty(t, r.Labels["severity"])
+				alerts = append(alerts, r.Alert)
+			}
+			require.Equal(t, tc.expected, alerts)
+		})
+	}
+}
@@ -38,11 +38,14 @@ var (
 	hostPathDirectory               = corev1.HostPathDirectory
 	hostPathDirectoryOrCreate       = corev1.HostPathDirectoryOrCreate
 	hostPathFile                    = corev1.HostPathFile
+	hostPathSocket                  = corev1.HostPathSocket
 	servicePort               int32 = 443
 	healthzPath                     = "/healthz"
+	readyzPath                      = "/readyz"
 	etcOSReleasePath                = "/etc/os-release"
 	metricsPort               int32 = 9443
 	metricsCertPath                 = "/var/run/secrets/metrics"
+	logEnricherTLSPath              = "/var/run/secrets/log-enricher-tls"
 	metricsServerCert               = "metrics-server-cert"
 	openshiftCertAnnotation         = "service.beta.openshift.io/serving-cert-secret-name"
 	localSeccompProfilePath         = LocalSeccompProfilePath
@@ -55,6 +58,7 @@ const (
 	SelinuxdPrivateDir                         = "/var/run/selinuxd"
 	SelinuxdSocketPath                         = SelinuxdPrivateDir + "/selinuxd.sock"
 	SelinuxdDBPath                             = SelinuxdPrivateDir + "/selinuxd.db"
+	SelinuxFsPath                              = "/sys/fs/selinux"
 	MetricsImage                               = "gcr.io/kubebuilder/kube-rbac-proxy:v0.15.0"
 	sysKernelDebugPath                         = "/sys/kernel/debug"
 	InitContainerIDNonRootenabler              = 0
@@ -420,6 +424,19 @@ semodule -i /opt/spo-profiles/selinuxrecording.cil
 							TimeoutSeconds:   1,
 							SuccessThreshold: 1,
 						},
+						// The daemon is ready once all profiles got installed
+						// on the node.
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{
+								Path:   readyzPath,
+								Port:   intstr.FromString("liveness-port"),
+								Scheme: corev1.URISchemeHTTP,
+							}},
+							FailureThreshold: 1,
+							PeriodSeconds:    5, //nolint:gomnd // test number
+							TimeoutSeconds:   1,
+							SuccessThreshold: 1,
+						},
 					},
 					{
 						Name:  SelinuxContainerName,
@@ -605,7 +622,7 @@ semodule -i /opt/spo-profiles/selinuxrecording.cil
 						ImagePullPolicy: corev1.PullIfNotPresent,
 						Args: []string{
 							fmt.Sprintf("--secure-listen-address=0.0.0.0:%d", metricsPort),
-							"--upstream=http://127.0.0.1:8080",
+							"--upstream=http://" + config.DaemonMetricsBindAddress,
 							"--v=10",
 							fmt.Sprintf("--tls-cert-file=%s", filepath.Join(metricsCertPath, "tls.crt")),
 							fmt.Sprintf("--tls-private-key-file=%s", filepath.Join(metricsCertPath, "tls.key")),
@@ -859,18 +876,73 @@ var metricsService = &corev1.Service{
 func CustomHostProcVolume(path string) (corev1.Volume, corev1.VolumeMount) {
 	const volumeName = "host-proc-volume"
 	return corev1.Volume{
-			Name: volumeName,
-			VolumeSource: corev1.VolumeSource{
-				HostPath: &corev1.HostPathVolumeSource{
-					Path: path,
-					Type: &hostPathDirectory,
-				},
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: path,
+				Type: &hostPathDirectory,
 			},
-		}, corev1.VolumeMount{
-			Name:      volumeName,
-			MountPath: DefaultHostProcPath,
-			ReadOnly:  true,
-		}
+		},
+	}, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: DefaultHostProcPath,
+		ReadOnly:  true,
+	}
+}
+
+// LogEnricherSinkVolume returns a new host path volume for the directory of
+// the log enricher file sink as well as the corresponding mount.
+func LogEnricherSinkVolume(path string) (corev1.Volume, corev1.VolumeMount) {
+	const volumeName = "log-enricher-sink-volume"
+	return corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: path,
+				Type: &hostPathDirectoryOrCreate,
+			},
+		},
+	}, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: path,
+	}
+}
+
+// LogEnricherCRISocketVolume returns a new host path volume for the CRI
+// runtime socket used by the log enricher as well as the corresponding mount.
+func LogEnricherCRISocketVolume(path string) (corev1.Volume, corev1.VolumeMount) {
+	const volumeName = "log-enricher-cri-socket"
+	return corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: path,
+				Type: &hostPathSocket,
+			},
+		},
+	}, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: path,
+	}
+}
+
+// LogEnricherTLSVolume returns a new secret volume for the certificates used
+// for mutual TLS between the log enricher and its clients as well as the
+// corresponding mount.
+func LogEnricherTLSVolume(secretName string) (corev1.Volume, corev1.VolumeMount) {
+	const volumeName = "log-enricher-tls"
+	return corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	}, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: logEnricherTLSPath,
+		ReadOnly:  true,
+	}
 }
 
 // CustomHostKubeletVolume returns a new host path volume for custom kubelet path
@@ -878,16 +950,16 @@ func CustomHostProcVolume(path string) (corev1.Volume, corev1.VolumeMount) {
 func CustomHostKubeletVolume(path string) (corev1.Volume, corev1.VolumeMount) {
 	const volumeName = "host-kubelet-volume"
 	return corev1.Volume{
-			Name: volumeName,
-			VolumeSource: corev1.VolumeSource{
-				HostPath: &corev1.HostPathVolumeSource{
-					Path: path,
-					Type: &hostPathDirectory,
-				},
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: path,
+				Type: &hostPathDirectory,
 			},
-		}, corev1.VolumeMount{
-			Name:      volumeName,
-			MountPath: path,
-			ReadOnly:  false,
-		}
+		},
+	}, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: path,
+		ReadOnly:  false,
+	}
 }
@@ -40,9 +40,13 @@ var (
 	replicas                int32 = 3
 	defaultMode             int32 = 420
 	failurePolicy                 = admissionregv1.Fail
+	ignoreFailurePolicy           = admissionregv1.Ignore
 	caBundle                      = []byte("Cg==")
 	bindingPath                   = "/mutate-v1-pod-binding"
 	recordingPath                 = "/mutate-v1-pod-recording"
+	bindingPolicyPath             = "/validate-v1alpha1-profilebinding"
+	recordingApprovalPath         = "/validate-v1alpha1-profilerecording"
+	seccompProfilePath            = "/validate-v1beta1-seccompprofile"
 	sideEffects                   = admissionregv1.SideEffectClassNone
 	admissionReviewVersions       = []string{"v1beta1"}
 	rules                         = []admissionregv1.RuleWithOperations{
@@ -53,7 +57,43 @@ var (
 			Rule: admissionregv1.Rule{
 				APIGroups:   []string{""},
 				APIVersions: []string{"v1"},
-				Resources:   []string{"pods"},
+				Resources:   []string{"pods", "pods/ephemeralcontainers"},
+			},
+		},
+	}
+	bindingPolicyRules = []admissionregv1.RuleWithOperations{
+		{
+			Operations: []admissionregv1.OperationType{
+				"CREATE", "UPDATE",
+			},
+			Rule: admissionregv1.Rule{
+				APIGroups:   []string{"security-profiles-operator.x-k8s.io"},
+				APIVersions: []string{"v1alpha1"},
+				Resources:   []string{"profilebindings"},
+			},
+		},
+	}
+	recordingApprovalRules = []admissionregv1.RuleWithOperations{
+		{
+			Operations: []admissionregv1.OperationType{
+				"CREATE", "UPDATE",
+			},
+			Rule: admissionregv1.Rule{
+				APIGroups:   []string{"security-profiles-operator.x-k8s.io"},
+				APIVersions: []string{"v1alpha1"},
+				Resources:   []string{"profilerecordings"},
+			},
+		},
+	}
+	seccompProfileRules = []admissionregv1.RuleWithOperations{
+		{
+			Operations: []admissionregv1.OperationType{
+				"CREATE", "UPDATE",
+			},
+			Rule: admissionregv1.Rule{
+				APIGroups:   []string{"security-profiles-operator.x-k8s.io"},
+				APIVersions: []string{"v1beta1"},
+				Resources:   []string{"seccompprofiles"},
 			},
 		},
 	}
@@ -78,20 +118,22 @@ const (
 )
 
 const (
-	webhookName        = config.OperatorName + "-webhook"
-	webhookConfigName  = "spo-mutating-webhook-configuration"
-	serviceAccountName = "spo-webhook"
-	certsMountPath     = "/tmp/k8s-webhook-server/serving-certs"
-	containerPort      = 9443
-	serviceName        = "webhook-service"
-	webhookServerCert  = "webhook-server-cert"
+	webhookName                 = config.OperatorName + "-webhook"
+	webhookConfigName           = "spo-mutating-webhook-configuration"
+	validatingWebhookConfigName = "spo-validating-webhook-configuration"
+	serviceAccountName          = "spo-webhook"
+	certsMountPath              = "/tmp/k8s-webhook-server/serving-certs"
+	containerPort               = 9443
+	serviceName                 = "webhook-service"
+	webhookServerCert           = "webhook-server-cert"
 )
 
 type Webhook struct {
-	log        logr.Logger
-	deployment *appsv1.Deployment
-	config     *admissionregv1.MutatingWebhookConfiguration
-	service    *corev1.Service
+	log              logr.Logger
+	deployment       *appsv1.Deployment
+	config           *admissionregv1.MutatingWebhookConfiguration
+	validatingConfig *admissionregv1.ValidatingWebhookConfiguration
+	service          *corev1.Service
 }
 
 func GetWebhook(
@@ -120,8 +162,15 @@ func GetWebhook(
 
 	cfg := webhookConfig.DeepCopy()
 	cfg.Namespace = namespace
-	cfg.Webhooks[0].ClientConfig.Service.Namespace = namespace
-	cfg.Webhooks[1].ClientConfig.Service.Namespace = namespace
+	for i := range cfg.Webhooks {
+		cfg.Webhooks[i].ClientConfig.Service.Namespace = namespace
+	}
+
+	validatingCfg := validatingWebhookConfig.DeepCopy()
+	validatingCfg.Namespace = namespace
+	for i := range validatingCfg.Webhooks {
+		validatingCfg.Webhooks[i].ClientConfig.Service.Namespace = namespace
+	}
 
 	service := webhookService.DeepCopy()
 	service.Namespace = namespace
@@ -131,10 +180,12 @@ func GetWebhook(
 		cfg.Annotations = map[string]string{
 			"cert-manager.io/inject-ca-from": config.OperatorName + "/webhook-cert",
 		}
+		validatingCfg.Annotations = cfg.Annotations
 	case CAInjectTypeOpenShift:
 		cfg.Annotations = map[string]string{
 			"service.beta.openshift.io/inject-cabundle": "true",
 		}
+		validatingCfg.Annotations = cfg.Annotations
 		service.Annotations = map[string]string{
 			openshiftCertAnnotation: webhookServerCert,
 		}
@@ -143,13 +194,15 @@ func GetWebhook(
 	}
 
 	// then apply the user-specified opts
-	applyWebhookOptions(cfg, webhookOpts)
+	applyWebhookOptions(mutatingTunables(cfg), webhookOpts)
+	applyWebhookOptions(validatingTunables(validatingCfg), webhookOpts)
 
 	return &Webhook{
-		log:        log,
-		deployment: deployment,
-		config:     cfg,
-		service:    service,
+		log:              log,
+		deployment:       deployment,
+		config:           cfg,
+		validatingConfig: validatingCfg,
+		service:          service,
 	}
 }
 
@@ -157,7 +210,7 @@ func (w *Webhook) Create(ctx context.Context, c client.Client) error {
 	for k, o := range w.objectMap() {
 		if err := c.Create(ctx, o); err != nil {
 			if errors.IsAlreadyExists(err) {
-				if k == "config" {
+				if k == "config" || k == "validatingConfig" {
 					// The config already exists because it's a global resource we have to remove later on
 					if err := c.Patch(ctx, o, client.Merge); err != nil {
 						return fmt.Errorf("updating %s: %w", k, err)
@@ -172,28 +225,62 @@ func (w *Webhook) Create(ctx context.Context, c client.Client) error {
 	return nil
 }
 
-func applyWebhookOptions(cfg *admissionregv1.MutatingWebhookConfiguration, opts []spodv1alpha1.WebhookOptions) {
+// webhookTunables references the settings of a mutating or validating webhook
+// which are tunable in spod.
+type webhookTunables struct {
+	name              string
+	failurePolicy     **admissionregv1.FailurePolicyType
+	namespaceSelector **metav1.LabelSelector
+	objectSelector    **metav1.LabelSelector
+}
+
+func mutatingTunables(cfg *admissionregv1.MutatingWebhookConfiguration) []webhookTunables {
+	res := make([]webhookTunables, 0, len(cfg.Webhooks))
 	for i := range cfg.Webhooks {
-		var userOpt *spodv1alpha1.WebhookOptions
+		hook := &cfg.Webhooks[i]
+		res = append(res, webhookTunables{
+			name:              hook.Name,
+			failurePolicy:     &hook.FailurePolicy,
+			namespaceSelector: &hook.NamespaceSelector,
+			objectSelector:    &hook.ObjectSelector,
+		})
+	}
+	return res
+}
 
+func validatingTunables(cfg *admissionregv1.ValidatingWebhookConfiguration) []webhookTunables {
+	res := make([]webhookTunables, 0, len(cfg.Webhooks))
+	for i := range cfg.Webhooks {
 		hook := &cfg.Webhooks[i]
+		res = append(res, webhookTunables{
+			name:              hook.Name,
+			failurePolicy:     &hook.FailurePolicy,
+			namespaceSelector: &hook.NamespaceSelector,
+			objectSelector:    &hook.ObjectSelector,
+		})
+	}
+	return res
+}
+
+func applyWebhookOptions(hooks []webhookTunables, opts []spodv1alpha1.WebhookOptions) {
+	for _, hook := range hooks {
 		for j := range opts {
-			userOpt = &opts[j]
+			userOpt := &opts[j]
 
-			if userOpt == nil || userOpt.Name != hook.Name {
+			if userOpt.Name != hook.name {
 				continue
 			}
 
 			if userOpt.FailurePolicy != nil {
-				hook.FailurePolicy = userOpt.FailurePolicy
+				*hook.failurePolicy = userOpt.FailurePolicy
 			}
 
 			if userOpt.NamespaceSelector != nil {
-				hook.NamespaceSelector = userOpt.NamespaceSelector
+				*hook.namespaceSelector = userOpt.NamespaceSelector
 			}
 
 			if userOpt.ObjectSelector != nil {
-				hook.ObjectSelector = userOpt.ObjectSelector
+				*hook.objectSelector = userOpt.ObjectSelector
 			}
 		}
 	}
@@ -208,67 +295,89 @@ func (w *Webhook) NeedsUpdate(ctx context.Context, c client.Client) (bool, error
 		return false, err
 	}
 
-	if len(existingWebHook.Webhooks) != len(w.config.Webhooks) {
+	if webhooksNeedUpdate(mutatingTunables(&existingWebHook), mutatingTunables(w.config)) {
 		return true, nil
 	}
 
-	for i := range existingWebHook.Webhooks {
-		ew := existingWebHook.Webhooks[i]
-		for j := range w.config.Webhooks {
-			cw := w.config.Webhooks[j]
+	existingValidatingWebHook := admissionregv1.ValidatingWebhookConfiguration{}
+	if err := c.Get(ctx,
+		types.NamespacedName{Namespace: w.validatingConfig.Namespace, Name: w.validatingConfig.Name},
+		&existingValidatingWebHook); err != nil {
+		if errors.IsNotFound(err) {
+			// The validating webhooks have been part of the mutating
+			// configuration in previous versions.
+			return true, nil
+		}
+		return false, err
+	}
 
-			if ew.Name != cw.Name {
+	return webhooksNeedUpdate(
+		validatingTunables(&existingValidatingWebHook), validatingTunables(w.validatingConfig),
+	), nil
+}
+
+func webhooksNeedUpdate(existing, configured []webhookTunables) bool {
+	if len(existing) != len(configured) {
+		return true
+	}
+
+	for i := range existing {
+		for j := range configured {
+			if existing[i].name != configured[j].name {
 				continue
 			}
 
-			if webhookNeedsUpdate(&ew, &cw) {
-				return true, nil
+			if webhookNeedsUpdate(&existing[i], &configured[j]) {
+				return true
 			}
 		}
 	}
 
-	return false, nil
+	return false
 }
 
 // only compare the settings that are tunable in spod now.
-func webhookNeedsUpdate(existing, configured *admissionregv1.MutatingWebhook) bool {
-	if existing.FailurePolicy == nil && configured.FailurePolicy != nil ||
-		existing.FailurePolicy != nil && configured.FailurePolicy == nil {
+func webhookNeedsUpdate(existing, configured *webhookTunables) bool {
+	existingFailurePolicy, configuredFailurePolicy := *existing.failurePolicy, *configured.failurePolicy
+	if existingFailurePolicy == nil && configuredFailurePolicy != nil ||
+		existingFailurePolicy != nil && configuredFailurePolicy == nil {
 		// comparing pointers, not values
 		return true
 	}
 
-	if existing.FailurePolicy != nil &&
-		configured.FailurePolicy != nil &&
-		*existing.FailurePolicy != *configured.FailurePolicy {
+	if existingFailurePolicy != nil &&
+		configuredFailurePolicy != nil &&
+		*existingFailurePolicy != *configuredFailurePolicy {
 		// comparing values this time
 		return true
 	}
 
-	if existing.NamespaceSelector == nil && configured.NamespaceSelector != nil ||
-		existing.NamespaceSelector != nil && configured.NamespaceSelector == nil {
+	existingNamespaceSelector, configuredNamespaceSelector := *existing.namespaceSelector, *configured.namespaceSelector
+	if existingNamespaceSelector == nil && configuredNamespaceSelector != nil ||
+		existingNamespaceSelector != nil && configuredNamespaceSelector == nil {
 		// comparing pointers, not values
 		return true
 	}
 
-	if existing.NamespaceSelector != nil && configured.NamespaceSelector != nil {
+	if existingNamespaceSelector != nil && configuredNamespaceSelector != nil {
 		// Only compare managed labels, all others are out of scope
 		for _, label := range []string{EnableBindingLabel, EnableRecordingLabel} {
-			if namespaceSelectorUnequalForLabel(label, existing.NamespaceSelector, configured.NamespaceSelector) {
+			if namespaceSelectorUnequalForLabel(label, existingNamespaceSelector, configuredNamespaceSelector) {
 				return true
 			}
 		}
 	}
 
-	if existing.ObjectSelector == nil && configured.ObjectSelector != nil ||
-		existing.ObjectSelector != nil && configured.ObjectSelector == nil {
+	existingObjectSelector, configuredObjectSelector := *existing.objectSelector, *configured.objectSelector
+	if existingObjectSelector == nil && configuredObjectSelector != nil ||
+		existingObjectSelector != nil && configuredObjectSelector == nil {
 		// comparing pointers, not values
 		return true
 	}
 
-	if existing.ObjectSelector != nil &&
-		configured.ObjectSelector != nil &&
-		!reflect.DeepEqual(*existing.ObjectSelector, *configured.ObjectSelector) {
+	if existingObjectSelector != nil &&
+		configuredObjectSelector != nil &&
+		!reflect.DeepEqual(*existingObjectSelector, *configuredObjectSelector) {
 		return true
 	}
 
@@ -313,6 +422,14 @@ func namespaceSelectorUnequalForLabel(label string, existing, configured *metav1
 func (w *Webhook) Update(ctx context.Context, c client.Client) error {
 	for k, o := range w.objectMap() {
 		if err := c.Patch(ctx, o, client.Merge); err != nil {
+			if k == "validatingConfig" && errors.IsNotFound(err) {
+				// The config does not exist when upgrading from a version
+				// without validating webhooks.
+				if err := c.Create(ctx, o); err != nil {
+					return fmt.Errorf("creating %s: %w", k, err)
+				}
+				continue
+			}
 			return fmt.Errorf("updating %s: %w", k, err)
 		}
 	}
@@ -321,9 +438,10 @@ func (w *Webhook) Update(ctx context.Context, c client.Client) error {
 
 func (w *Webhook) objectMap() map[string]client.Object {
 	return map[string]client.Object{
-		"deployment": w.deployment,
-		"config":     w.config,
-		"service":    w.service,
+		"deployment":       w.deployment,
+		"config":           w.config,
+		"validatingConfig": w.validatingConfig,
+		"service":          w.service,
 	}
 }
 
@@ -481,6 +599,59 @@ var webhookConfig = &admissionregv1.MutatingWebhookConfiguration{
 	},
 }
 
+var validatingWebhookConfig = &admissionregv1.ValidatingWebhookConfiguration{
+	ObjectMeta: metav1.ObjectMeta{
+		Name: validatingWebhookConfigName,
+	},
+	Webhooks: []admissionregv1.ValidatingWebhook{
+		{
+			Name:           "binding-policy.spo.io",
+			FailurePolicy:  &failurePolicy,
+			SideEffects:    &sideEffects,
+			Rules:          bindingPolicyRules,
+			ObjectSelector: &objectSelector,
+			ClientConfig: admissionregv1.WebhookClientConfig{
+				CABundle: caBundle,
+				Service: &admissionregv1.ServiceReference{
+					Name: serviceName,
+					Path: &bindingPolicyPath,
+				},
+			},
+			AdmissionReviewVersions: admissionReviewVersions,
+		},
+		{
+			Name:           "recording-approval.spo.io",
+			FailurePolicy:  &failurePolicy,
+			SideEffects:    &sideEffects,
+			Rules:          recordingApprovalRules,
+			ObjectSelector: &objectSelector,
+			ClientConfig: admissionregv1.WebhookClientConfig{
+				CABundle: caBundle,
+				Service: &admissionregv1.ServiceReference{
+					Name: serviceName,
+					Path: &recordingApprovalPath,
+				},
+			},
+			AdmissionReviewVersions: admissionReviewVersions,
+		},
+		{
+			Name:           "seccompprofile-validation.spo.io",
+			FailurePolicy:  &ignoreFailurePolicy,
+			SideEffects:    &sideEffects,
+			Rules:          seccompProfileRules,
+			ObjectSelector: &objectSelector,
+			ClientConfig: admissionregv1.WebhookClientConfig{
+				CABundle: caBundle,
+				Service: &admissionregv1.ServiceReference{
+					Name: serviceName,
+					Path: &seccompProfilePath,
+				},
+			},
+			AdmissionReviewVersions: admissionReviewVersions,
+		},
+	},
+}
+
 var webhookService = &corev1.Service{
 	ObjectMeta: metav1.ObjectMeta{
 		Name:   serviceName,
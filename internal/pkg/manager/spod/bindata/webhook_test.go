@@ -17,10 +17,19 @@ limitations under the License.
 package bindata
 
 import (
+	"context"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 )
 
 const testLabel = "test"
@@ -110,3 +119,97 @@ func TestNamespaceSelectorUnequalForLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestGetWebhook(t *testing.T) {
+	t.Parallel()
+
+	ignore := admissionregv1.Ignore
+	webhook := GetWebhook(logr.Discard(), "test-ns", []spodv1alpha1.WebhookOptions{
+		{Name: "binding.spo.io", FailurePolicy: &ignore},
+		{Name: "binding-policy.spo.io", FailurePolicy: &ignore},
+	}, "image", corev1.PullAlways, CAInjectTypeCertManager, nil, nil)
+
+	mutating := map[string]*admissionregv1.MutatingWebhook{}
+	for i := range webhook.config.Webhooks {
+		hook := &webhook.config.Webhooks[i]
+		assert.Equal(t, "test-ns", hook.ClientConfig.Service.Namespace)
+		mutating[hook.Name] = hook
+	}
+	validating := map[string]*admissionregv1.ValidatingWebhook{}
+	for i := range webhook.validatingConfig.Webhooks {
+		hook := &webhook.validatingConfig.Webhooks[i]
+		assert.Equal(t, "test-ns", hook.ClientConfig.Service.Namespace)
+		validating[hook.Name] = hook
+	}
+
+	require.Contains(t, mutating, "binding.spo.io")
+	assert.Equal(t, admissionregv1.Ignore, *mutating["binding.spo.io"].FailurePolicy)
+	assert.NotContains(t, mutating, "binding-policy.spo.io")
+	require.Contains(t, validating, "binding-policy.spo.io")
+	assert.Equal(t, admissionregv1.Ignore, *validating["binding-policy.spo.io"].FailurePolicy)
+	assert.NotContains(t, mutating, "recording-approval.spo.io")
+	require.Contains(t, validating, "recording-approval.spo.io")
+	assert.Equal(t, admissionregv1.Fail, *validating["recording-approval.spo.io"].FailurePolicy)
+	assert.NotContains(t, mutating, "seccompprofile-validation.spo.io")
+	require.Contains(t, validating, "seccompprofile-validation.spo.io")
+	assert.Equal(t, admissionregv1.Ignore, *validating["seccompprofile-validation.spo.io"].FailurePolicy)
+	assert.Equal(t, webhook.config.Annotations, webhook.validatingConfig.Annotations)
+}
+
+func TestWebhookNeedsUpdate(t *testing.T) {
+	t.Parallel()
+
+	ignore := admissionregv1.Ignore
+	for _, tc := range []struct {
+		name     string
+		prepare  func(*Webhook) []runtime.Object
+		expected bool
+	}{
+		{
+			name: "configurations up to date",
+			prepare: func(w *Webhook) []runtime.Object {
+				return []runtime.Object{w.config.DeepCopy(), w.validatingConfig.DeepCopy()}
+			},
+			expected: false,
+		},
+		{
+			name: "validating configuration missing",
+			prepare: func(w *Webhook) []runtime.Object {
+				return []runtime.Object{w.config.DeepCopy()}
+			},
+			expected: true,
+		},
+		{
+			name: "validating webhook changed",
+			prepare: func(w *Webhook) []runtime.Object {
+				validatingCfg := w.validatingConfig.DeepCopy()
+				validatingCfg.Webhooks[0].FailurePolicy = &ignore
+				return []runtime.Object{w.config.DeepCopy(), validatingCfg}
+			},
+			expected: true,
+		},
+		{
+			name: "mutating configuration contains validating webhooks",
+			prepare: func(w *Webhook) []runtime.Object {
+				cfg := w.config.DeepCopy()
+				cfg.Webhooks = append(cfg.Webhooks, admissionregv1.MutatingWebhook{
+					Name: "binding-policy.spo.io",
+				})
+				return []runtime.Object{cfg, w.validatingConfig.DeepCopy()}
+			},
+			expected: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := GetWebhook(logr.Discard(), "test-ns", nil, "image", corev1.PullAlways, CAInjectTypeCertManager, nil, nil)
+			cl := fake.NewClientBuilder().WithRuntimeObjects(tc.prepare(webhook)...).Build()
+
+			res, err := webhook.NeedsUpdate(context.Background(), cl)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spod
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+// selinuxTemplates are the reusable SELinux building blocks which can be
+// inherited by SelinuxProfiles using the "Template" kind. Every template is
+// an abstract CIL block, which means that it does not declare a process type
+// on its own but grants its permissions to the process of the inheriting
+// profile.
+var selinuxTemplates = map[string]string{
+	"log-writer": `(allow process var_log_t (dir (add_name getattr ioctl lock open read remove_name search write)))
+(allow process var_log_t (file (append create getattr ioctl lock map open read write)))
+(allow process var_log_t (lnk_file (getattr read)))`,
+	"net-client": `(allow process self (tcp_socket (connect create getattr getopt read setopt shutdown write)))
+(allow process self (udp_socket (connect create getattr getopt read setopt write)))
+(allow process dns_port_t (tcp_socket (name_connect)))
+(allow process http_port_t (tcp_socket (name_connect)))
+(allow process net_conf_t (file (getattr open read)))`,
+	"home-reader": `(allow process user_home_dir_t (dir (getattr open read search)))
+(allow process user_home_t (dir (getattr open read search)))
+(allow process user_home_t (file (getattr open read)))
+(allow process user_home_t (lnk_file (getattr read)))`,
+}
+
+// defaultSelinuxTemplates returns the SELinux templates managed by the
+// operator.
+func defaultSelinuxTemplates() []*selxv1alpha2.RawSelinuxProfile {
+	namespace := config.GetOperatorNamespace()
+	labels := map[string]string{"app": config.OperatorName}
+
+	templates := make([]*selxv1alpha2.RawSelinuxProfile, 0, len(selinuxTemplates))
+	for _, name := range sets.List(sets.KeySet(selinuxTemplates)) {
+		template := &selxv1alpha2.RawSelinuxProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    labels,
+			},
+		}
+		template.Spec.Policy = fmt.Sprintf("(blockabstract %s)\n%s\n", template.GetPolicyName(), selinuxTemplates[name])
+		templates = append(templates, template)
+	}
+	return templates
+}
@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/version"
 )
 
 // CtxKey type for spod context keys.
@@ -86,6 +87,12 @@ func (r *ReconcileSPOd) Setup(
 	r.watchNamespace = dt.watchNamespace
 	r.namespace = config.GetOperatorNamespace()
 
+	info, err := version.Get()
+	if err != nil {
+		return fmt.Errorf("get version info: %w", err)
+	}
+	r.version = info.Version
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(r.Name()).
 		For(&spodv1alpha1.SecurityProfilesOperatorDaemon{}).
@@ -18,10 +18,16 @@ package spod
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"net/http"
 	"os"
+	"path/filepath"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -40,9 +46,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/features"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
@@ -53,6 +61,8 @@ const (
 
 	reasonCannotCreateSPOD string = "CannotCreateSPOD"
 	reasonCannotUpdateSPOD string = "CannotUpdateSPOD"
+	reasonVersionSkew      string = "DaemonVersionSkew"
+	reasonUnknownFeature   string = "UnknownFeatureGates"
 
 	appArmorAnnotation = "container.seccomp.security.alpha.kubernetes.io/security-profiles-operator"
 )
@@ -79,6 +89,9 @@ type ReconcileSPOd struct {
 	log            logr.Logger
 	watchNamespace string
 	namespace      string
+	// version is the build version of the operator, which is expected to be
+	// the version of all daemons.
+	version string
 }
 
 // Name returns the name of the controller.
@@ -104,9 +117,10 @@ func (r *ReconcileSPOd) Healthz(*http.Request) error {
 //
 // Operand:
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch
-// +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets/finalizers,verbs=delete;get;update;patch
 // +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=issuers;certificates,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons/status,verbs=get;update;patch
@@ -118,9 +132,15 @@ func (r *ReconcileSPOd) Healthz(*http.Request) error {
 // Needed for default profiles:
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch
 //
+// Needed for the SELinux templates:
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles,verbs=get;list;watch;create;update;patch
+//
 // Needed for the ServiceMonitor
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch
 //
+// Needed for the alerting rules
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+//
 // OpenShift (This is ignored in other distros):
 // +kubebuilder:rbac:groups=security.openshift.io,namespace="security-profiles-operator",resources=securitycontextconstraints,verbs=use
 // +kubebuilder:rbac:groups=config.openshift.io,resources=clusteroperators,verbs=get;list;watch
@@ -151,6 +171,31 @@ func (r *ReconcileSPOd) Reconcile(ctx context.Context, req reconcile.Request) (r
 		return r.handleInitialStatus(ctx, spod, logger)
 	}
 
+	updated, err := r.reconcileFeatureGates(ctx, spod)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		// The status update triggers another reconciliation
+		return reconcile.Result{}, nil
+	}
+
+	updated, err = r.reconcileVersionSkew(ctx, spod)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	updated, err = r.reconcileSelinuxModes(ctx, spod)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
 	deploymentKey := types.NamespacedName{
 		Name:      config.OperatorName,
 		Namespace: r.namespace,
@@ -176,18 +221,33 @@ func (r *ReconcileSPOd) Reconcile(ctx context.Context, req reconcile.Request) (r
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("get ca inject type: %w", err)
 	}
-	configuredSPOd := r.getConfiguredSPOd(spod, image, pullPolicy, caInjectType)
+	configuredSPOds := r.getConfiguredSPOds(spod, image, pullPolicy, caInjectType)
+	configuredSPOd := configuredSPOds[0]
 
 	webhook := bindata.GetWebhook(r.log, r.namespace, spod.Spec.WebhookOpts, image,
 		pullPolicy, caInjectType, spod.Spec.Tolerations, spod.Spec.ImagePullSecrets)
 	metricsService := bindata.GetMetricsService(r.namespace, caInjectType)
 	serviceMonitor := bindata.ServiceMonitor(caInjectType)
 
+	if err := r.reconcilePrometheusRule(ctx, spod, caInjectType); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.reconcileSelinuxTemplates(ctx, spod, caInjectType); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	var certManagerResources *bindata.CertManagerResources
 	if caInjectType == bindata.CAInjectTypeCertManager {
 		certManagerResources = bindata.GetCertManagerResources(r.namespace)
 	}
 
+	variantsReady, err := r.reconcileSPOdVariants(ctx, spod, configuredSPOds[1:])
+	if err != nil {
+		r.record.Event(spod, util.EventTypeWarning, reasonCannotUpdateSPOD, err.Error())
+		return reconcile.Result{}, err
+	}
+
 	foundSPOd := &appsv1.DaemonSet{}
 	if err := r.client.Get(ctx, spodKey, foundSPOd); err != nil {
 		if errors.IsNotFound(err) {
@@ -227,7 +287,7 @@ func (r *ReconcileSPOd) Reconcile(ctx context.Context, req reconcile.Request) (r
 		return r.handleUpdatingStatus(ctx, spod, logger)
 	}
 
-	if foundSPOd.Status.NumberReady == foundSPOd.Status.DesiredNumberScheduled {
+	if foundSPOd.Status.NumberReady == foundSPOd.Status.DesiredNumberScheduled && variantsReady {
 		condready := spod.Status.GetReadyCondition()
 		// Don't pollute the logs. Let's only update when needed.
 		if condready.Status != metav1.ConditionTrue {
@@ -237,6 +297,183 @@ func (r *ReconcileSPOd) Reconcile(ctx context.Context, req reconcile.Request) (r
 	return reconcile.Result{}, nil
 }
 
+// reconcilePrometheusRule deploys the default alerting rules if enabled and
+// removes them otherwise.
+func (r *ReconcileSPOd) reconcilePrometheusRule(
+	ctx context.Context,
+	cfg *spodv1alpha1.SecurityProfilesOperatorDaemon,
+	caInjectType bindata.CAInjectType,
+) error {
+	configuredRule := bindata.PrometheusRule(caInjectType)
+
+	if !cfg.Spec.EnableAlertingRules {
+		if err := r.client.Delete(ctx, configuredRule); err != nil && !bindata.IsNotFound(err) {
+			return fmt.Errorf("deleting prometheus rule: %w", err)
+		}
+		return nil
+	}
+
+	rule := &monitoringv1.PrometheusRule{ObjectMeta: configuredRule.ObjectMeta}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.client, rule, func() error {
+		rule.Spec = configuredRule.Spec
+		return nil
+	}); err != nil {
+		if bindata.IsNotFound(err) {
+			r.log.Info("Prometheus rule resource does not seem to exist, ignoring")
+			return nil
+		}
+		return fmt.Errorf("creating or updating prometheus rule: %w", err)
+	}
+
+	return nil
+}
+
+// reconcileFeatureGates reports the effective state of the feature gates in
+// the SPOD status. It returns true if the status got updated.
+func (r *ReconcileSPOd) reconcileFeatureGates(
+	ctx context.Context,
+	spod *spodv1alpha1.SecurityProfilesOperatorDaemon,
+) (bool, error) {
+	gates, unknown := features.New(spod.Spec.FeatureGates)
+	if len(unknown) > 0 {
+		r.record.Event(spod, util.EventTypeWarning, reasonUnknownFeature,
+			"Ignoring unknown feature gates: "+strings.Join(unknown, ", "))
+	}
+
+	if maps.Equal(spod.Status.FeatureGates, gates) {
+		return false, nil
+	}
+
+	sCopy := spod.DeepCopy()
+	sCopy.Status.FeatureGates = gates
+	if err := r.client.Status().Update(ctx, sCopy); err != nil {
+		return false, fmt.Errorf("updating spod feature gates: %w", err)
+	}
+	return true, nil
+}
+
+// reconcileVersionSkew sets the version skew condition depending on whether
+// the daemons on the existing nodes report the version of the operator. It
+// returns true if the status got updated.
+func (r *ReconcileSPOd) reconcileVersionSkew(
+	ctx context.Context,
+	spod *spodv1alpha1.SecurityProfilesOperatorDaemon,
+) (bool, error) {
+	if r.version == "" || len(spod.Status.DaemonVersions) == 0 {
+		return false, nil
+	}
+
+	// Versions of deleted nodes are not relevant any more
+	nodes := &corev1.NodeList{}
+	if err := r.clientReader.List(ctx, nodes); err != nil {
+		return false, fmt.Errorf("listing nodes: %w", err)
+	}
+
+	skewed := []string{}
+	for i := range nodes.Items {
+		name := nodes.Items[i].Name
+		if daemonVersion, ok := spod.Status.DaemonVersions[name]; ok && daemonVersion != r.version {
+			skewed = append(skewed, fmt.Sprintf("%s (%s)", name, daemonVersion))
+		}
+	}
+
+	condition := spodv1alpha1.NoVersionSkew()
+	if len(skewed) > 0 {
+		sort.Strings(skewed)
+		condition = spodv1alpha1.VersionSkew(fmt.Sprintf(
+			"Daemons not running operator version %s: %s", r.version, strings.Join(skewed, ", "),
+		))
+	}
+
+	sCopy := spod.DeepCopy()
+	sCopy.Status.SetConditions(condition)
+	if sCopy.Status.ConditionedStatus.Equal(&spod.Status.ConditionedStatus) {
+		return false, nil
+	}
+
+	if err := r.client.Status().Update(ctx, sCopy); err != nil {
+		return false, fmt.Errorf("updating spod version skew condition: %w", err)
+	}
+	if len(skewed) > 0 {
+		r.record.Event(spod, util.EventTypeWarning, reasonVersionSkew, condition.Message)
+	}
+	return true, nil
+}
+
+// reconcileSelinuxModes removes the SELinux modes reported by daemons on
+// nodes which do not exist any more. A merge patch is used to not conflict
+// with the daemons reporting their modes concurrently. It returns true if the
+// status got updated.
+func (r *ReconcileSPOd) reconcileSelinuxModes(
+	ctx context.Context,
+	spod *spodv1alpha1.SecurityProfilesOperatorDaemon,
+) (bool, error) {
+	if len(spod.Status.SelinuxModes) == 0 {
+		return false, nil
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.clientReader.List(ctx, nodes); err != nil {
+		return false, fmt.Errorf("listing nodes: %w", err)
+	}
+
+	existing := make(map[string]bool, len(nodes.Items))
+	for i := range nodes.Items {
+		existing[nodes.Items[i].Name] = true
+	}
+
+	stale := []string{}
+	removals := map[string]any{}
+	for name := range spod.Status.SelinuxModes {
+		if !existing[name] {
+			stale = append(stale, name)
+			removals[name] = nil
+		}
+	}
+	if len(stale) == 0 {
+		return false, nil
+	}
+	sort.Strings(stale)
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{"selinuxModes": removals},
+	})
+	if err != nil {
+		return false, fmt.Errorf("marshal SELinux modes patch: %w", err)
+	}
+
+	if err := r.client.Status().Patch(ctx, spod, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return false, fmt.Errorf("removing SELinux modes of deleted nodes: %w", err)
+	}
+	r.log.Info("Removed SELinux modes of deleted nodes", "nodes", stale)
+	return true, nil
+}
+
+// reconcileSelinuxTemplates deploys the SELinux templates if SELinux support
+// is enabled. The templates are kept on disabling SELinux support, because
+// existing profiles may still inherit from them.
+func (r *ReconcileSPOd) reconcileSelinuxTemplates(
+	ctx context.Context,
+	cfg *spodv1alpha1.SecurityProfilesOperatorDaemon,
+	caInjectType bindata.CAInjectType,
+) error {
+	if !isSelinuxEnabled(cfg, caInjectType) {
+		return nil
+	}
+
+	for _, configuredTemplate := range defaultSelinuxTemplates() {
+		template := &selxv1alpha2.RawSelinuxProfile{ObjectMeta: configuredTemplate.ObjectMeta}
+		if _, err := controllerutil.CreateOrUpdate(ctx, r.client, template, func() error {
+			template.Spec = configuredTemplate.Spec
+			return nil
+		}); err != nil {
+			return fmt.Errorf("creating or updating SELinux template %s: %w", template.Name, err)
+		}
+	}
+
+	return nil
+}
+
 func (r *ReconcileSPOd) handleInitialStatus(
 	ctx context.Context,
 	spod *spodv1alpha1.SecurityProfilesOperatorDaemon,
@@ -501,27 +738,72 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 		}
 	}
 
+	if cfg.Spec.EnableLegacyMetricNames {
+		templateSpec.Containers[bindata.ContainerIDDaemon].Env = append(
+			templateSpec.Containers[bindata.ContainerIDDaemon].Env,
+			corev1.EnvVar{
+				Name:  config.LegacyMetricNamesEnvKey,
+				Value: "true",
+			},
+		)
+	}
+
+	if cfg.Spec.EnableStartupTaint {
+		templateSpec.Containers[bindata.ContainerIDDaemon].Env = append(
+			templateSpec.Containers[bindata.ContainerIDDaemon].Env,
+			corev1.EnvVar{
+				Name:  config.StartupTaintEnvKey,
+				Value: "true",
+			},
+		)
+	}
+
+	if cfg.Spec.DryRun {
+		templateSpec.Containers[bindata.ContainerIDDaemon].Env = append(
+			templateSpec.Containers[bindata.ContainerIDDaemon].Env,
+			corev1.EnvVar{
+				Name:  config.DryRunEnvKey,
+				Value: "true",
+			},
+		)
+	}
+
 	// Overwrite the SPOD's default resource requirements
 	if cfg.Spec.DaemonResourceRequirements != nil {
 		templateSpec.Containers[bindata.ContainerIDDaemon].Resources = *cfg.Spec.DaemonResourceRequirements
 	}
 
 	// SELinux parameters
-	enableSelinux := (cfg.Spec.EnableSelinux != nil && *cfg.Spec.EnableSelinux) ||
-		// enable SELinux support per default in OpenShift
-		(cfg.Spec.EnableSelinux == nil && caInjectType == bindata.CAInjectTypeOpenShift)
-
-	if enableSelinux {
+	if isSelinuxEnabled(cfg, caInjectType) {
 		templateSpec.InitContainers = append(
 			templateSpec.InitContainers,
 			r.baseSPOd.Spec.Template.Spec.InitContainers[bindata.InitContainerIDSelinuxSharedPoliciesCopier])
-		templateSpec.Containers = append(
-			templateSpec.Containers,
-			r.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDSelinuxd])
+		selinuxd := r.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDSelinuxd]
+		if cfg.Spec.SelinuxdResourceRequirements != nil {
+			selinuxd.Resources = *cfg.Spec.SelinuxdResourceRequirements
+		}
+		templateSpec.Containers = append(templateSpec.Containers, selinuxd)
 
 		templateSpec.Containers[bindata.ContainerIDDaemon].Args = append(
 			templateSpec.Containers[bindata.ContainerIDDaemon].Args,
 			"--with-selinux=true")
+
+		// The daemon reports the SELinux mode of the node and sets the
+		// allowed SELinux booleans, which requires write access as root.
+		manageBooleans := len(cfg.Spec.SelinuxOpts.AllowedBooleans) > 0
+		templateSpec.Containers[bindata.ContainerIDDaemon].VolumeMounts = append(
+			templateSpec.Containers[bindata.ContainerIDDaemon].VolumeMounts,
+			corev1.VolumeMount{
+				Name:      "host-fsselinux-volume",
+				MountPath: bindata.SelinuxFsPath,
+				ReadOnly:  !manageBooleans,
+			})
+		if manageBooleans {
+			var userRoot int64
+			sc := templateSpec.Containers[bindata.ContainerIDDaemon].SecurityContext
+			sc.RunAsUser = &userRoot
+			sc.RunAsGroup = &userRoot
+		}
 	}
 
 	// Custom host proc volume
@@ -550,10 +832,105 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 		ctr := r.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDLogEnricher]
 		ctr.Image = image
 
+		if cfg.Spec.LogEnricherResourceRequirements != nil {
+			ctr.Resources = *cfg.Spec.LogEnricherResourceRequirements
+		}
+
 		if useCustomHostProc {
 			ctr.VolumeMounts = append(ctr.VolumeMounts, mount)
 		}
 
+		if cfg.Spec.EnableDenialEvents {
+			ctr.Env = append(ctr.Env, corev1.EnvVar{
+				Name:  config.EnableDenialEventsEnvKey,
+				Value: "true",
+			})
+		}
+
+		if cfg.Spec.EnableAuditNetlink {
+			ctr.Env = append(ctr.Env, corev1.EnvVar{
+				Name:  config.EnableAuditNetlinkEnvKey,
+				Value: "true",
+			})
+		}
+
+		if sinks := cfg.Spec.LogEnricherSinks; sinks != nil {
+			ctr.Env = append(ctr.Env,
+				corev1.EnvVar{Name: config.EnricherSinkStdoutFormatEnvKey, Value: string(sinks.StdoutFormat)},
+				corev1.EnvVar{Name: config.EnricherSinkFileEnvKey, Value: sinks.File},
+				corev1.EnvVar{Name: config.EnricherSinkWebhookURLEnvKey, Value: sinks.WebhookURL},
+			)
+
+			if sinks.File != "" {
+				sinkVolume, sinkMount := bindata.LogEnricherSinkVolume(filepath.Dir(sinks.File))
+				templateSpec.Volumes = append(templateSpec.Volumes, sinkVolume)
+				ctr.VolumeMounts = append(ctr.VolumeMounts, sinkMount)
+			}
+		}
+
+		if retention := cfg.Spec.LogEnricherRetention; retention != nil {
+			ctr.Env = append(ctr.Env, corev1.EnvVar{
+				Name:  config.EnricherRetentionEnvKey,
+				Value: retention.Duration.String(),
+			})
+		}
+
+		if cache := cfg.Spec.LogEnricherCache; cache != nil {
+			if cache.TTL != nil {
+				ctr.Env = append(ctr.Env, corev1.EnvVar{
+					Name:  config.EnricherCacheTTLEnvKey,
+					Value: cache.TTL.Duration.String(),
+				})
+			}
+			if cache.MaxEntries > 0 {
+				ctr.Env = append(ctr.Env, corev1.EnvVar{
+					Name:  config.EnricherCacheMaxEntriesEnvKey,
+					Value: strconv.FormatInt(cache.MaxEntries, 10),
+				})
+			}
+		}
+
+		if socket := cfg.Spec.LogEnricherCRISocket; socket != "" {
+			ctr.Env = append(ctr.Env, corev1.EnvVar{
+				Name:  config.EnricherCRIEndpointEnvKey,
+				Value: "unix://" + socket,
+			})
+			socketVolume, socketMount := bindata.LogEnricherCRISocketVolume(socket)
+			templateSpec.Volumes = append(templateSpec.Volumes, socketVolume)
+			ctr.VolumeMounts = append(ctr.VolumeMounts, socketMount)
+		}
+
+		if resolvers := cfg.Spec.LogEnricherContainerIDResolvers; len(resolvers) > 0 {
+			names := make([]string, 0, len(resolvers))
+			for _, resolver := range resolvers {
+				names = append(names, string(resolver))
+			}
+			ctr.Env = append(ctr.Env, corev1.EnvVar{
+				Name:  config.EnricherContainerIDResolversEnvKey,
+				Value: strings.Join(names, ","),
+			})
+		}
+
+		if cfg.Spec.LogEnricherBackfill {
+			ctr.Env = append(ctr.Env, corev1.EnvVar{
+				Name:  config.EnricherBackfillEnvKey,
+				Value: "true",
+			})
+		}
+
+		if secret := cfg.Spec.LogEnricherTLSSecret; secret != "" {
+			tlsVolume, tlsMount := bindata.LogEnricherTLSVolume(secret)
+			tlsEnv := corev1.EnvVar{Name: config.EnricherTLSDirEnvKey, Value: tlsMount.MountPath}
+			templateSpec.Volumes = append(templateSpec.Volumes, tlsVolume)
+			ctr.VolumeMounts = append(ctr.VolumeMounts, tlsMount)
+			ctr.Env = append(ctr.Env, tlsEnv)
+
+			// the daemon connects to the log enricher for recording profiles
+			daemon := &templateSpec.Containers[bindata.ContainerIDDaemon]
+			daemon.VolumeMounts = append(daemon.VolumeMounts, tlsMount)
+			daemon.Env = append(daemon.Env, tlsEnv)
+		}
+
 		templateSpec.Containers = append(templateSpec.Containers, ctr)
 		// pass the log enricher env var to the daemon as the profile recorder is otherwise disabled
 		addEnvVar(templateSpec, config.EnableLogEnricherEnvKey)
@@ -574,7 +951,7 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 	}
 
 	// AppArmor parameters
-	if cfg.Spec.EnableAppArmor {
+	if isAppArmorEnabled(cfg) {
 		falsely, truly := false, true
 		var userRoot int64
 		// a more privileged mode is required when apparmor is enabled
@@ -601,6 +978,13 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 		templateSpec.HostPID = true
 	}
 
+	// Landlock parameters
+	if isLandlockEnabled(cfg) {
+		templateSpec.Containers[bindata.ContainerIDDaemon].Args = append(
+			templateSpec.Containers[bindata.ContainerIDDaemon].Args,
+			"--with-landlock=true")
+	}
+
 	// Enable memory optimization for spod controller
 	if cfg.Spec.EnableMemoryOptimization {
 		templateSpec.Containers[bindata.ContainerIDDaemon].Args = append(
@@ -626,7 +1010,7 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 		// SELinux can be active on the node regardless if the SELinux feature is enabled or not in the operator.
 		// For instance, on Flatcar Linux SELinux type tag needs to be set to 'unconfined_t' instead of 'spc_t'
 		// even though SELinux is disabled in order to get the containers to start.
-		if !cfg.Spec.EnableAppArmor {
+		if !isAppArmorEnabled(cfg) {
 			configureSeLinuxTag(templateSpec.InitContainers[i].SecurityContext, cfg.Spec.SelinuxTypeTag)
 		}
 	}
@@ -651,12 +1035,20 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 		// SELinux can be active on the node regardless if the SELinux feature is enabled or not in the operator.
 		// For instance, on Flatcar Linux SELinux type tag needs to be set to 'unconfined_t' instead of 'spc_t'
 		// even though SELinux is disabled in order to get the containers to start.
-		if !cfg.Spec.EnableAppArmor {
+		if !isAppArmorEnabled(cfg) {
 			configureSeLinuxTag(templateSpec.Containers[i].SecurityContext, cfg.Spec.SelinuxTypeTag)
 		}
 	}
 
 	templateSpec.Tolerations = cfg.Spec.Tolerations
+	if cfg.Spec.EnableStartupTaint {
+		// The daemon has to run on the node to be able to remove the taint
+		templateSpec.Tolerations = append(slices.Clone(cfg.Spec.Tolerations), corev1.Toleration{
+			Key:      config.ProfilesNotSyncedTaintKey,
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		})
+	}
 	templateSpec.Affinity = cfg.Spec.Affinity
 	templateSpec.ImagePullSecrets = cfg.Spec.ImagePullSecrets
 	templateSpec.PriorityClassName = cfg.Spec.PriorityClassName
@@ -664,6 +1056,12 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 	return newSPOd
 }
 
+func isSelinuxEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon, caInjectType bindata.CAInjectType) bool {
+	return (cfg.Spec.EnableSelinux != nil && *cfg.Spec.EnableSelinux) ||
+		// enable SELinux support per default in OpenShift
+		(cfg.Spec.EnableSelinux == nil && caInjectType == bindata.CAInjectTypeOpenShift)
+}
+
 func isLogEnricherEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon) bool {
 	enableLogEnricherEnv, err := strconv.ParseBool(os.Getenv(config.EnableLogEnricherEnvKey))
 	if err != nil {
@@ -679,7 +1077,20 @@ func isBpfRecorderEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon) bool
 		enableBpfRecorderEnv = false
 	}
 
-	return cfg.Spec.EnableBpfRecorder || enableBpfRecorderEnv
+	return (cfg.Spec.EnableBpfRecorder || enableBpfRecorderEnv) && featureEnabled(cfg, features.BpfRecorder)
+}
+
+func isAppArmorEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon) bool {
+	return cfg.Spec.EnableAppArmor && featureEnabled(cfg, features.AppArmor)
+}
+
+func isLandlockEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon) bool {
+	return cfg.Spec.EnableLandlock && featureEnabled(cfg, features.Landlock)
+}
+
+func featureEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon, feature features.Feature) bool {
+	gates, _ := features.New(cfg.Spec.FeatureGates)
+	return gates.Enabled(feature)
 }
 
 func addEnvVar(templateSpec *corev1.PodSpec, envVarKey string) {
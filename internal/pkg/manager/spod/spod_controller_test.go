@@ -0,0 +1,481 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spod
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+func TestReconcileVersionSkew(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		versions      map[string]string
+		conditions    []metav1.Condition
+		wantUpdated   bool
+		wantCondition *metav1.Condition
+	}{
+		{
+			name:     "no versions reported",
+			versions: nil,
+		},
+		{
+			name:          "all nodes run the operator version",
+			versions:      map[string]string{"node-1": "0.9.0", "node-2": "0.9.0"},
+			wantUpdated:   true,
+			wantCondition: &metav1.Condition{Status: metav1.ConditionFalse, Reason: spodv1alpha1.ReasonVersionMatch},
+		},
+		{
+			name:        "skewed node",
+			versions:    map[string]string{"node-1": "0.9.0", "node-2": "0.8.0"},
+			wantUpdated: true,
+			wantCondition: &metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  spodv1alpha1.ReasonVersionMismatch,
+				Message: "Daemons not running operator version 0.9.0: node-2 (0.8.0)",
+			},
+		},
+		{
+			name:     "skewed deleted node",
+			versions: map[string]string{"node-1": "0.9.0", "deleted-node": "0.8.0"},
+			conditions: []metav1.Condition{
+				spodv1alpha1.NoVersionSkew(),
+			},
+			wantCondition: &metav1.Condition{Status: metav1.ConditionFalse, Reason: spodv1alpha1.ReasonVersionMatch},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			require.NoError(t, spodv1alpha1.AddToScheme(scheme))
+
+			spod := bindata.DefaultSPOD.DeepCopy()
+			spod.Namespace = "security-profiles-operator"
+			spod.Status.DaemonVersions = tc.versions
+			spod.Status.Conditions = tc.conditions
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					spod,
+					&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+					&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+				).
+				WithStatusSubresource(spod).
+				Build()
+
+			sut := &ReconcileSPOd{
+				client:       cli,
+				clientReader: cli,
+				record:       record.NewFakeRecorder(10),
+				log:          logr.Discard(),
+				version:      "0.9.0",
+			}
+
+			updated, err := sut.reconcileVersionSkew(context.Background(), spod)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantUpdated, updated)
+
+			res := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+			require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(spod), res))
+
+			var got *metav1.Condition
+			for i := range res.Status.Conditions {
+				if res.Status.Conditions[i].Type == spodv1alpha1.TypeVersionSkew {
+					got = &res.Status.Conditions[i]
+				}
+			}
+			if tc.wantCondition == nil {
+				require.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			require.Equal(t, tc.wantCondition.Status, got.Status)
+			require.Equal(t, tc.wantCondition.Reason, got.Reason)
+			require.Equal(t, tc.wantCondition.Message, got.Message)
+		})
+	}
+}
+
+func TestReconcileSelinuxModes(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		modes       map[string]spodv1alpha1.SelinuxMode
+		wantUpdated bool
+		wantModes   map[string]spodv1alpha1.SelinuxMode
+	}{
+		{
+			name: "no modes reported",
+		},
+		{
+			name: "all nodes exist",
+			modes: map[string]spodv1alpha1.SelinuxMode{
+				"node-1": spodv1alpha1.SelinuxModeEnforcing,
+				"node-2": spodv1alpha1.SelinuxModePermissive,
+			},
+			wantModes: map[string]spodv1alpha1.SelinuxMode{
+				"node-1": spodv1alpha1.SelinuxModeEnforcing,
+				"node-2": spodv1alpha1.SelinuxModePermissive,
+			},
+		},
+		{
+			name: "deleted node",
+			modes: map[string]spodv1alpha1.SelinuxMode{
+				"node-1":       spodv1alpha1.SelinuxModeEnforcing,
+				"deleted-node": spodv1alpha1.SelinuxModePermissive,
+			},
+			wantUpdated: true,
+			wantModes: map[string]spodv1alpha1.SelinuxMode{
+				"node-1": spodv1alpha1.SelinuxModeEnforcing,
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			require.NoError(t, spodv1alpha1.AddToScheme(scheme))
+
+			spod := bindata.DefaultSPOD.DeepCopy()
+			spod.Namespace = "security-profiles-operator"
+			spod.Status.SelinuxModes = tc.modes
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					spod,
+					&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+					&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+				).
+				WithStatusSubresource(spod).
+				Build()
+
+			sut := &ReconcileSPOd{
+				client:       cli,
+				clientReader: cli,
+				log:          logr.Discard(),
+			}
+
+			updated, err := sut.reconcileSelinuxModes(context.Background(), spod)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantUpdated, updated)
+
+			res := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+			require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(spod), res))
+			require.Equal(t, tc.wantModes, res.Status.SelinuxModes)
+		})
+	}
+}
+
+func TestReconcileFeatureGates(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, spodv1alpha1.AddToScheme(scheme))
+
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Namespace = "security-profiles-operator"
+	spod.Spec.FeatureGates = map[string]bool{"AppArmor": false, "Unknown": true}
+	cli := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(spod).
+		WithStatusSubresource(spod).
+		Build()
+
+	recorder := record.NewFakeRecorder(10)
+	sut := &ReconcileSPOd{
+		client: cli,
+		record: recorder,
+		log:    logr.Discard(),
+	}
+
+	updated, err := sut.reconcileFeatureGates(context.Background(), spod)
+	require.NoError(t, err)
+	require.True(t, updated)
+	require.Contains(t, <-recorder.Events, "Ignoring unknown feature gates: Unknown")
+
+	res := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(spod), res))
+	require.Equal(t,
+		map[string]bool{
+			"AppArmor": false, "BpfRecorder": true, "Notifications": false,
+			"ConfigMapMirror": false, "SelinuxUsageConfigMap": false, "Landlock": false,
+		},
+		res.Status.FeatureGates,
+	)
+
+	// The status is only updated on changes
+	updated, err = sut.reconcileFeatureGates(context.Background(), res)
+	require.NoError(t, err)
+	require.False(t, updated)
+}
+
+func TestGetConfiguredSPOdStartupTaint(t *testing.T) {
+	t.Parallel()
+
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Spec.Tolerations = []corev1.Toleration{{Key: "custom", Operator: corev1.TolerationOpExists}}
+	spod.Spec.EnableStartupTaint = true
+
+	sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+	got := sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+
+	podSpec := got.Spec.Template.Spec
+	require.Equal(t, []corev1.Toleration{
+		{Key: "custom", Operator: corev1.TolerationOpExists},
+		{
+			Key:      config.ProfilesNotSyncedTaintKey,
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+	}, podSpec.Tolerations)
+	require.Contains(t, podSpec.Containers[bindata.ContainerIDDaemon].Env,
+		corev1.EnvVar{Name: config.StartupTaintEnvKey, Value: "true"})
+
+	// The tolerations of the SPOD are not modified
+	require.Len(t, spod.Spec.Tolerations, 1)
+}
+
+func TestGetConfiguredSPOdResourceRequirements(t *testing.T) {
+	t.Parallel()
+
+	resources := func(memory string) *corev1.ResourceRequirements {
+		return &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memory)},
+		}
+	}
+
+	enableSelinux := true
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Spec.EnableSelinux = &enableSelinux
+	spod.Spec.EnableLogEnricher = true
+	spod.Spec.DaemonResourceRequirements = resources("256Mi")
+	spod.Spec.SelinuxdResourceRequirements = resources("512Mi")
+	spod.Spec.LogEnricherResourceRequirements = resources("1Gi")
+
+	sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+	got := sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+
+	containers := got.Spec.Template.Spec.Containers
+	actual := map[string]corev1.ResourceRequirements{}
+	for _, ctr := range containers {
+		actual[ctr.Name] = ctr.Resources
+	}
+	require.Equal(t, *resources("256Mi"), containers[bindata.ContainerIDDaemon].Resources)
+	require.Equal(t, *resources("512Mi"), actual[bindata.SelinuxContainerName])
+	require.Equal(t, *resources("1Gi"), actual[bindata.LogEnricherContainerName])
+
+	// The defaults of the base manifest are not modified
+	base := bindata.Manifest.Spec.Template.Spec.Containers
+	require.Equal(t, base[bindata.ContainerIDSelinuxd].Resources,
+		sut.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDSelinuxd].Resources)
+	require.Equal(t, base[bindata.ContainerIDLogEnricher].Resources,
+		sut.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDLogEnricher].Resources)
+}
+
+func TestGetConfiguredSPOdDryRun(t *testing.T) {
+	t.Parallel()
+
+	sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+
+	spod := bindata.DefaultSPOD.DeepCopy()
+	got := sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+	require.NotContains(t, got.Spec.Template.Spec.Containers[bindata.ContainerIDDaemon].Env,
+		corev1.EnvVar{Name: config.DryRunEnvKey, Value: "true"})
+
+	spod.Spec.DryRun = true
+	got = sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+	require.Contains(t, got.Spec.Template.Spec.Containers[bindata.ContainerIDDaemon].Env,
+		corev1.EnvVar{Name: config.DryRunEnvKey, Value: "true"})
+}
+
+func TestGetConfiguredSPOdLogEnricherSinks(t *testing.T) {
+	t.Parallel()
+
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Spec.EnableLogEnricher = true
+	spod.Spec.LogEnricherSinks = &spodv1alpha1.LogEnricherSinkOptions{
+		StdoutFormat: spodv1alpha1.LogEnricherStdoutFormatJSON,
+		File:         "/var/log/spo/audit.jsonl",
+		WebhookURL:   "https://siem.example.com/ingest",
+	}
+
+	sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+	got := sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+
+	var enricher *corev1.Container
+	for i := range got.Spec.Template.Spec.Containers {
+		if got.Spec.Template.Spec.Containers[i].Name == bindata.LogEnricherContainerName {
+			enricher = &got.Spec.Template.Spec.Containers[i]
+		}
+	}
+	require.NotNil(t, enricher)
+
+	require.Subset(t, enricher.Env, []corev1.EnvVar{
+		{Name: config.EnricherSinkStdoutFormatEnvKey, Value: "JSON"},
+		{Name: config.EnricherSinkFileEnvKey, Value: "/var/log/spo/audit.jsonl"},
+		{Name: config.EnricherSinkWebhookURLEnvKey, Value: "https://siem.example.com/ingest"},
+	})
+
+	sinkVolume, sinkMount := bindata.LogEnricherSinkVolume("/var/log/spo")
+	require.Contains(t, got.Spec.Template.Spec.Volumes, sinkVolume)
+	require.Contains(t, enricher.VolumeMounts, sinkMount)
+}
+
+func TestGetConfiguredSPOdLandlock(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		featureGates map[string]bool
+		expected     bool
+	}{
+		{name: "feature gate disabled"},
+		{name: "feature gate enabled", featureGates: map[string]bool{"Landlock": true}, expected: true},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			spod := bindata.DefaultSPOD.DeepCopy()
+			spod.Spec.EnableLandlock = true
+			spod.Spec.FeatureGates = tc.featureGates
+
+			sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+			got := sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+
+			args := got.Spec.Template.Spec.Containers[bindata.ContainerIDDaemon].Args
+			if tc.expected {
+				require.Contains(t, args, "--with-landlock=true")
+			} else {
+				require.NotContains(t, args, "--with-landlock=true")
+			}
+		})
+	}
+}
+
+func TestGetConfiguredSPOdLogEnricherCRISocket(t *testing.T) {
+	t.Parallel()
+
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Spec.EnableLogEnricher = true
+	spod.Spec.LogEnricherCRISocket = "/run/containerd/containerd.sock"
+
+	sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+	got := sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+
+	var enricher *corev1.Container
+	for i := range got.Spec.Template.Spec.Containers {
+		if got.Spec.Template.Spec.Containers[i].Name == bindata.LogEnricherContainerName {
+			enricher = &got.Spec.Template.Spec.Containers[i]
+		}
+	}
+	require.NotNil(t, enricher)
+
+	require.Contains(t, enricher.Env, corev1.EnvVar{
+		Name:  config.EnricherCRIEndpointEnvKey,
+		Value: "unix:///run/containerd/containerd.sock",
+	})
+
+	socketVolume, socketMount := bindata.LogEnricherCRISocketVolume("/run/containerd/containerd.sock")
+	require.Contains(t, got.Spec.Template.Spec.Volumes, socketVolume)
+	require.Contains(t, enricher.VolumeMounts, socketMount)
+}
+
+func TestGetConfiguredSPOdLogEnricherTLS(t *testing.T) {
+	t.Parallel()
+
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Spec.EnableLogEnricher = true
+	spod.Spec.LogEnricherTLSSecret = "log-enricher-tls"
+
+	sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+	got := sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+
+	tlsVolume, tlsMount := bindata.LogEnricherTLSVolume("log-enricher-tls")
+	require.Contains(t, got.Spec.Template.Spec.Volumes, tlsVolume)
+
+	containers := []*corev1.Container{&got.Spec.Template.Spec.Containers[bindata.ContainerIDDaemon]}
+	for i := range got.Spec.Template.Spec.Containers {
+		if got.Spec.Template.Spec.Containers[i].Name == bindata.LogEnricherContainerName {
+			containers = append(containers, &got.Spec.Template.Spec.Containers[i])
+		}
+	}
+	require.Len(t, containers, 2)
+
+	for _, ctr := range containers {
+		require.Contains(t, ctr.VolumeMounts, tlsMount, ctr.Name)
+		require.Contains(t, ctr.Env, corev1.EnvVar{
+			Name:  config.EnricherTLSDirEnvKey,
+			Value: tlsMount.MountPath,
+		}, ctr.Name)
+	}
+}
+
+func TestGetConfiguredSPOdLogEnricherOptions(t *testing.T) {
+	t.Parallel()
+
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Spec.EnableLogEnricher = true
+	spod.Spec.LogEnricherRetention = &metav1.Duration{Duration: 24 * time.Hour}
+	spod.Spec.LogEnricherCache = &spodv1alpha1.LogEnricherCacheOptions{
+		TTL:        &metav1.Duration{Duration: 30 * time.Minute},
+		MaxEntries: 5000,
+	}
+	spod.Spec.LogEnricherBackfill = true
+	spod.Spec.LogEnricherContainerIDResolvers = []spodv1alpha1.ContainerIDResolver{"containerd", "cgroupfs"}
+
+	sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+	got := sut.getConfiguredSPOd(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+
+	var env []corev1.EnvVar
+	for i := range got.Spec.Template.Spec.Containers {
+		if got.Spec.Template.Spec.Containers[i].Name == bindata.LogEnricherContainerName {
+			env = got.Spec.Template.Spec.Containers[i].Env
+		}
+	}
+	require.Contains(t, env, corev1.EnvVar{Name: config.EnricherRetentionEnvKey, Value: "24h0m0s"})
+	require.Contains(t, env, corev1.EnvVar{Name: config.EnricherCacheTTLEnvKey, Value: "30m0s"})
+	require.Contains(t, env, corev1.EnvVar{Name: config.EnricherCacheMaxEntriesEnvKey, Value: "5000"})
+	require.Contains(t, env, corev1.EnvVar{Name: config.EnricherBackfillEnvKey, Value: "true"})
+	require.Contains(t, env, corev1.EnvVar{
+		Name:  config.EnricherContainerIDResolversEnvKey,
+		Value: "containerd,cgroupfs",
+	})
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spod
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+// nodeGatedFeature is a SPOd feature which is only enabled on the nodes
+// matching its node selector.
+type nodeGatedFeature struct {
+	name         string
+	nodeSelector map[string]string
+	disable      func(*spodv1alpha1.SecurityProfilesOperatorDaemon)
+}
+
+// nodeGatedFeatures returns the enabled features of the SPOd configuration
+// which are restricted to a set of nodes.
+func nodeGatedFeatures(
+	cfg *spodv1alpha1.SecurityProfilesOperatorDaemon, caInjectType bindata.CAInjectType,
+) []nodeGatedFeature {
+	res := []nodeGatedFeature{}
+	if isSelinuxEnabled(cfg, caInjectType) && len(cfg.Spec.SelinuxNodeSelector) > 0 {
+		res = append(res, nodeGatedFeature{
+			name:         config.SPOdFeatureSelinux,
+			nodeSelector: cfg.Spec.SelinuxNodeSelector,
+			disable: func(c *spodv1alpha1.SecurityProfilesOperatorDaemon) {
+				disabled := false
+				c.Spec.EnableSelinux = &disabled
+			},
+		})
+	}
+	if isAppArmorEnabled(cfg) && len(cfg.Spec.AppArmorNodeSelector) > 0 {
+		res = append(res, nodeGatedFeature{
+			name:         config.SPOdFeatureAppArmor,
+			nodeSelector: cfg.Spec.AppArmorNodeSelector,
+			disable: func(c *spodv1alpha1.SecurityProfilesOperatorDaemon) {
+				c.Spec.EnableAppArmor = false
+			},
+		})
+	}
+	return res
+}
+
+// getConfiguredSPOds returns the configured SPOd DaemonSets. The first one
+// runs all enabled features and is named after the SPOd configuration. If
+// features are restricted to a set of nodes, then a variant DaemonSet is
+// added for every combination of disabled features, each of them scheduled
+// only on the nodes matching exactly this combination.
+func (r *ReconcileSPOd) getConfiguredSPOds(
+	cfg *spodv1alpha1.SecurityProfilesOperatorDaemon,
+	image string,
+	pullPolicy corev1.PullPolicy,
+	caInjectType bindata.CAInjectType,
+) []*appsv1.DaemonSet {
+	gated := nodeGatedFeatures(cfg, caInjectType)
+	spods := make([]*appsv1.DaemonSet, 0, 1<<len(gated))
+
+	for variant := 0; variant < 1<<len(gated); variant++ {
+		variantCfg := cfg.DeepCopy()
+		disabled := []string{}
+		terms := []corev1.NodeSelectorTerm{{}}
+		for i := range gated {
+			if variant&(1<<i) == 0 {
+				terms = matchingNodeSelector(terms, gated[i].nodeSelector)
+				continue
+			}
+			gated[i].disable(variantCfg)
+			disabled = append(disabled, gated[i].name)
+			terms = notMatchingNodeSelector(terms, gated[i].nodeSelector)
+		}
+
+		spod := r.getConfiguredSPOd(variantCfg, image, pullPolicy, caInjectType)
+		if len(gated) > 0 {
+			restrictToNodes(&spod.Spec.Template.Spec, terms)
+		}
+		if len(disabled) > 0 {
+			setVariant(spod, disabled)
+		}
+		spods = append(spods, spod)
+	}
+
+	return spods
+}
+
+// setVariant makes the DaemonSet a variant of the SPOd which runs without
+// the disabled features. The pods of the variant keep the labels of the
+// SPOd pods, which are used by the metrics service.
+func setVariant(spod *appsv1.DaemonSet, disabled []string) {
+	variant := "no-" + strings.Join(disabled, "-")
+	spod.SetName(fmt.Sprintf("%s-%s", spod.GetName(), variant))
+
+	if spod.Labels == nil {
+		spod.Labels = map[string]string{}
+	}
+	spod.Labels[config.SPOdVariantLabel] = variant
+	if spod.Annotations == nil {
+		spod.Annotations = map[string]string{}
+	}
+	spod.Annotations[config.SPOdDisabledFeaturesAnnotation] = strings.Join(disabled, ",")
+
+	// Variants select only their own pods
+	spod.Spec.Selector.MatchLabels[config.SPOdVariantLabel] = variant
+	spod.Spec.Template.Labels[config.SPOdVariantLabel] = variant
+}
+
+// matchingNodeSelector restricts the node selector terms to the nodes having
+// all the labels of the node selector.
+func matchingNodeSelector(
+	terms []corev1.NodeSelectorTerm, nodeSelector map[string]string,
+) []corev1.NodeSelectorTerm {
+	res := make([]corev1.NodeSelectorTerm, 0, len(terms))
+	for i := range terms {
+		term := *terms[i].DeepCopy()
+		for _, key := range sets.List(sets.KeySet(nodeSelector)) {
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{nodeSelector[key]},
+			})
+		}
+		res = append(res, term)
+	}
+	return res
+}
+
+// notMatchingNodeSelector restricts the node selector terms to the nodes
+// missing at least one of the labels of the node selector.
+func notMatchingNodeSelector(
+	terms []corev1.NodeSelectorTerm, nodeSelector map[string]string,
+) []corev1.NodeSelectorTerm {
+	res := make([]corev1.NodeSelectorTerm, 0, len(terms)*len(nodeSelector))
+	for i := range terms {
+		for _, key := range sets.List(sets.KeySet(nodeSelector)) {
+			term := *terms[i].DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpNotIn,
+				Values:   []string{nodeSelector[key]},
+			})
+			res = append(res, term)
+		}
+	}
+	return res
+}
+
+// restrictToNodes schedules the pods only on the nodes matching the node
+// selector terms in addition to the required node affinity of the SPOd
+// configuration.
+func restrictToNodes(templateSpec *corev1.PodSpec, terms []corev1.NodeSelectorTerm) {
+	affinity := &corev1.Affinity{}
+	if templateSpec.Affinity != nil {
+		affinity = templateSpec.Affinity.DeepCopy()
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+
+	if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil &&
+		len(required.NodeSelectorTerms) > 0 {
+		// Terms are ORed while their expressions are ANDed, which means
+		// that we have to combine every term with each other.
+		combined := make([]corev1.NodeSelectorTerm, 0, len(terms)*len(required.NodeSelectorTerms))
+		for i := range required.NodeSelectorTerms {
+			for j := range terms {
+				term := *required.NodeSelectorTerms[i].DeepCopy()
+				term.MatchExpressions = append(term.MatchExpressions, terms[j].MatchExpressions...)
+				combined = append(combined, term)
+			}
+		}
+		terms = combined
+	}
+
+	affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{
+		NodeSelectorTerms: terms,
+	}
+	templateSpec.Affinity = affinity
+}
+
+// reconcileSPOdVariants creates and updates the SPOd DaemonSet variants and
+// removes the ones which are not required any more. It returns true if all
+// variants are up to date and ready.
+func (r *ReconcileSPOd) reconcileSPOdVariants(
+	ctx context.Context,
+	cfg *spodv1alpha1.SecurityProfilesOperatorDaemon,
+	variants []*appsv1.DaemonSet,
+) (bool, error) {
+	found := &appsv1.DaemonSetList{}
+	if err := r.client.List(
+		ctx, found, client.InNamespace(r.namespace), client.HasLabels{config.SPOdVariantLabel},
+	); err != nil {
+		return false, fmt.Errorf("listing spod DaemonSet variants: %w", err)
+	}
+
+	ready := true
+	for i := range found.Items {
+		ds := &found.Items[i]
+		if !metav1.IsControlledBy(ds, cfg) {
+			continue
+		}
+
+		idx := slices.IndexFunc(variants, func(v *appsv1.DaemonSet) bool {
+			return v.GetName() == ds.GetName()
+		})
+		if idx == -1 {
+			r.log.Info("Removing spod DaemonSet variant", "name", ds.GetName())
+			if err := r.client.Delete(ctx, ds); client.IgnoreNotFound(err) != nil {
+				return false, fmt.Errorf("deleting spod DaemonSet variant %s: %w", ds.GetName(), err)
+			}
+			continue
+		}
+
+		variant := variants[idx]
+		variants = slices.Delete(slices.Clone(variants), idx, idx+1)
+		if spodNeedsUpdate(variant, ds) {
+			r.log.Info("Updating spod DaemonSet variant", "name", ds.GetName())
+			updated := ds.DeepCopy()
+			updated.Spec.Template = variant.Spec.Template
+			if err := r.client.Patch(ctx, updated, client.Merge); err != nil {
+				return false, fmt.Errorf("updating spod DaemonSet variant %s: %w", ds.GetName(), err)
+			}
+			ready = false
+			continue
+		}
+
+		if ds.Status.NumberReady != ds.Status.DesiredNumberScheduled {
+			ready = false
+		}
+	}
+
+	for _, variant := range variants {
+		r.log.Info("Creating spod DaemonSet variant", "name", variant.GetName())
+		if err := controllerutil.SetControllerReference(cfg, variant, r.scheme); err != nil {
+			return false, fmt.Errorf("setting spod DaemonSet variant controller reference: %w", err)
+		}
+		if err := r.client.Create(ctx, variant); err != nil {
+			return false, fmt.Errorf("creating spod DaemonSet variant %s: %w", variant.GetName(), err)
+		}
+		ready = false
+	}
+
+	return ready, nil
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spod
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+func TestGetConfiguredSPOds(t *testing.T) {
+	t.Parallel()
+
+	requirement := func(key string, op corev1.NodeSelectorOperator, value string) corev1.NodeSelectorRequirement {
+		return corev1.NodeSelectorRequirement{Key: key, Operator: op, Values: []string{value}}
+	}
+	rhel := requirement("os", corev1.NodeSelectorOpIn, "rhel")
+	notRhel := requirement("os", corev1.NodeSelectorOpNotIn, "rhel")
+	ubuntu := requirement("distro", corev1.NodeSelectorOpIn, "ubuntu")
+	notUbuntu := requirement("distro", corev1.NodeSelectorOpNotIn, "ubuntu")
+	amd64 := requirement("arch", corev1.NodeSelectorOpIn, "amd64")
+
+	for _, tc := range []struct {
+		name     string
+		prepare  func(*spodv1alpha1.SecurityProfilesOperatorDaemon)
+		expected map[string][]corev1.NodeSelectorTerm
+	}{
+		{
+			name:     "no node selectors",
+			prepare:  func(*spodv1alpha1.SecurityProfilesOperatorDaemon) {},
+			expected: map[string][]corev1.NodeSelectorTerm{"spod": nil},
+		},
+		{
+			name: "node selector of disabled feature",
+			prepare: func(spod *spodv1alpha1.SecurityProfilesOperatorDaemon) {
+				spod.Spec.SelinuxNodeSelector = map[string]string{"os": "rhel"}
+			},
+			expected: map[string][]corev1.NodeSelectorTerm{"spod": nil},
+		},
+		{
+			name: "SELinux node selector",
+			prepare: func(spod *spodv1alpha1.SecurityProfilesOperatorDaemon) {
+				enableSelinux := true
+				spod.Spec.EnableSelinux = &enableSelinux
+				spod.Spec.SelinuxNodeSelector = map[string]string{"os": "rhel"}
+			},
+			expected: map[string][]corev1.NodeSelectorTerm{
+				"spod":            {{MatchExpressions: []corev1.NodeSelectorRequirement{rhel}}},
+				"spod-no-selinux": {{MatchExpressions: []corev1.NodeSelectorRequirement{notRhel}}},
+			},
+		},
+		{
+			name: "SELinux and AppArmor node selectors with affinity",
+			prepare: func(spod *spodv1alpha1.SecurityProfilesOperatorDaemon) {
+				enableSelinux := true
+				spod.Spec.EnableSelinux = &enableSelinux
+				spod.Spec.SelinuxNodeSelector = map[string]string{"os": "rhel"}
+				spod.Spec.EnableAppArmor = true
+				spod.Spec.AppArmorNodeSelector = map[string]string{"distro": "ubuntu"}
+				spod.Spec.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{MatchExpressions: []corev1.NodeSelectorRequirement{amd64}},
+						},
+					},
+				}}
+			},
+			expected: map[string][]corev1.NodeSelectorTerm{
+				"spod": {{MatchExpressions: []corev1.NodeSelectorRequirement{amd64, rhel, ubuntu}}},
+				"spod-no-selinux": {
+					{MatchExpressions: []corev1.NodeSelectorRequirement{amd64, notRhel, ubuntu}},
+				},
+				"spod-no-apparmor": {
+					{MatchExpressions: []corev1.NodeSelectorRequirement{amd64, rhel, notUbuntu}},
+				},
+				"spod-no-selinux-apparmor": {
+					{MatchExpressions: []corev1.NodeSelectorRequirement{amd64, notRhel, notUbuntu}},
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			spod := bindata.DefaultSPOD.DeepCopy()
+			tc.prepare(spod)
+
+			sut := &ReconcileSPOd{baseSPOd: bindata.Manifest.DeepCopy()}
+			got := sut.getConfiguredSPOds(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+			require.Len(t, got, len(tc.expected))
+			require.Equal(t, "spod", got[0].GetName())
+
+			for _, ds := range got {
+				terms, ok := tc.expected[ds.GetName()]
+				require.True(t, ok, ds.GetName())
+
+				affinity := ds.Spec.Template.Spec.Affinity
+				if terms == nil {
+					require.Equal(t, spod.Spec.Affinity, affinity)
+					continue
+				}
+				required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+				require.Equal(t, terms, required.NodeSelectorTerms)
+
+				args := ds.Spec.Template.Spec.Containers[bindata.ContainerIDDaemon].Args
+				disabled := strings.Split(ds.Annotations[config.SPOdDisabledFeaturesAnnotation], ",")
+				require.Equal(t, !slices.Contains(disabled, "selinux"), slices.Contains(args, "--with-selinux=true"))
+				require.Equal(t, spod.Spec.EnableAppArmor && !slices.Contains(disabled, "apparmor"),
+					slices.Contains(args, "--with-apparmor=true"))
+
+				// Variants select only their own pods
+				if ds.GetName() != "spod" {
+					variant := ds.Labels[config.SPOdVariantLabel]
+					require.Equal(t, "spod-"+variant, ds.GetName())
+					require.Equal(t, variant, ds.Spec.Selector.MatchLabels[config.SPOdVariantLabel])
+					require.Equal(t, variant, ds.Spec.Template.Labels[config.SPOdVariantLabel])
+				}
+			}
+
+			// The base manifest is not modified
+			require.Len(t, sut.baseSPOd.Spec.Selector.MatchLabels, 2)
+			require.Len(t, sut.baseSPOd.Spec.Template.Labels, 2)
+		})
+	}
+}
+
+func TestReconcileSPOdVariants(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, spodv1alpha1.AddToScheme(scheme))
+
+	enableSelinux := true
+	spod := bindata.DefaultSPOD.DeepCopy()
+	spod.Namespace = "security-profiles-operator"
+	spod.UID = "uid"
+	spod.Spec.EnableSelinux = &enableSelinux
+	spod.Spec.EnableAppArmor = true
+	spod.Spec.SelinuxNodeSelector = map[string]string{"os": "rhel"}
+
+	isController := true
+	stale := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      "spod-no-apparmor",
+		Namespace: "security-profiles-operator",
+		Labels:    map[string]string{config.SPOdVariantLabel: "no-apparmor"},
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: spodv1alpha1.GroupVersion.String(),
+			Kind:       "SecurityProfilesOperatorDaemon",
+			Name:       spod.Name,
+			UID:        spod.UID,
+			Controller: &isController,
+		}},
+	}}
+	foreign := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      "other",
+		Namespace: "security-profiles-operator",
+		Labels:    map[string]string{config.SPOdVariantLabel: "no-apparmor"},
+	}}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(spod, stale, foreign).Build()
+
+	sut := &ReconcileSPOd{
+		baseSPOd:  bindata.Manifest.DeepCopy(),
+		client:    cli,
+		scheme:    scheme,
+		record:    record.NewFakeRecorder(10),
+		log:       logr.Discard(),
+		namespace: "security-profiles-operator",
+	}
+	spods := sut.getConfiguredSPOds(spod, "image", corev1.PullAlways, bindata.CAInjectTypeCertManager)
+	require.Len(t, spods, 2)
+
+	ctx := context.Background()
+	ready, err := sut.reconcileSPOdVariants(ctx, spod, spods[1:])
+	require.NoError(t, err)
+	require.False(t, ready)
+
+	list := &appsv1.DaemonSetList{}
+	require.NoError(t, cli.List(ctx, list, client.InNamespace("security-profiles-operator")))
+	names := []string{}
+	for i := range list.Items {
+		names = append(names, list.Items[i].Name)
+	}
+	require.ElementsMatch(t, []string{"other", "spod-no-selinux"}, names)
+
+	// Up to date variants without scheduled pods are ready
+	ready, err = sut.reconcileSPOdVariants(ctx, spod, spods[1:])
+	require.NoError(t, err)
+	require.True(t, ready)
+}
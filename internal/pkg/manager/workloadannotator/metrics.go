@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadannotator
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+const collectTimeout = 10 * time.Second
+
+var (
+	metricProfileActivePods = prometheus.NewDesc(
+		"spo_profile_active_pods",
+		"Number of pods currently using a profile.",
+		[]string{"kind", "namespace", "profile"},
+		nil,
+	)
+	metricProfileActiveWorkloads = prometheus.NewDesc(
+		"spo_profile_active_workloads",
+		"Number of workloads whose pods currently use a profile.",
+		[]string{"kind", "namespace", "profile"},
+		nil,
+	)
+)
+
+// usageCollector exposes the pods and workloads using each SeccompProfile and
+// SelinuxProfile. The values are read from the profile status on every scrape,
+// which means that profiles without any user are reported with zero.
+type usageCollector struct {
+	client client.Reader
+	log    logr.Logger
+}
+
+// Describe implements prometheus.Collector.
+func (c *usageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- metricProfileActivePods
+	ch <- metricProfileActiveWorkloads
+}
+
+// Collect implements prometheus.Collector.
+func (c *usageCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	seccompProfiles := &seccompprofileapi.SeccompProfileList{}
+	if err := c.client.List(ctx, seccompProfiles); err != nil {
+		c.log.Error(err, "cannot list seccomp profiles for usage metrics")
+	}
+	for i := range seccompProfiles.Items {
+		sp := &seccompProfiles.Items[i]
+		c.collect(ch, "SeccompProfile", sp, sp.Status.ActiveWorkloads, sp.Status.ActiveWorkloadOwners)
+	}
+
+	selinuxProfiles := &selinuxprofileapi.SelinuxProfileList{}
+	if err := c.client.List(ctx, selinuxProfiles); err != nil {
+		c.log.Error(err, "cannot list selinux profiles for usage metrics")
+	}
+	for i := range selinuxProfiles.Items {
+		sp := &selinuxProfiles.Items[i]
+		c.collect(ch, "SelinuxProfile", sp, sp.Status.ActiveWorkloads, sp.Status.ActiveWorkloadOwners)
+	}
+}
+
+func (c *usageCollector) collect(
+	ch chan<- prometheus.Metric, kind string, profile client.Object, pods, owners []string,
+) {
+	ch <- prometheus.MustNewConstMetric(
+		metricProfileActivePods, prometheus.GaugeValue, float64(len(pods)),
+		kind, profile.GetNamespace(), profile.GetName(),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		metricProfileActiveWorkloads, prometheus.GaugeValue, float64(len(owners)),
+		kind, profile.GetNamespace(), profile.GetName(),
+	)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadannotator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+func TestUsageCollector(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+	require.NoError(t, selinuxprofileapi.AddToScheme(scheme))
+
+	used := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "used", Namespace: "default"},
+	}
+	used.Status.ActiveWorkloads = []string{"default/web-1", "default/web-2"}
+	used.Status.ActiveWorkloadOwners = []string{"Deployment/default/web"}
+	unused := &selinuxprofileapi.SelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "unused", Namespace: "default"},
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(used, unused).Build()
+	collector := &usageCollector{client: cli, log: logr.Discard()}
+
+	const expected = `
+# HELP spo_profile_active_pods Number of pods currently using a profile.
+# TYPE spo_profile_active_pods gauge
+spo_profile_active_pods{kind="SeccompProfile",namespace="default",profile="used"} 2
+spo_profile_active_pods{kind="SelinuxProfile",namespace="default",profile="unused"} 0
+# HELP spo_profile_active_workloads Number of workloads whose pods currently use a profile.
+# TYPE spo_profile_active_workloads gauge
+spo_profile_active_workloads{kind="SeccompProfile",namespace="default",profile="used"} 1
+spo_profile_active_workloads{kind="SelinuxProfile",namespace="default",profile="unused"} 0
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
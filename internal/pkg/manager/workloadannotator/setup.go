@@ -25,6 +25,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
@@ -90,6 +91,11 @@ func (r *PodReconciler) Setup(
 		return fmt.Errorf("creating selinux profile index: %w", err)
 	}
 
+	// Expose the profile usage as metrics
+	if err := ctrlmetrics.Registry.Register(&usageCollector{client: r.client, log: r.log}); err != nil {
+		return fmt.Errorf("registering profile usage metrics: %w", err)
+	}
+
 	// Register a special reconciler for pod events
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -20,12 +20,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -169,8 +172,11 @@ func (r *PodReconciler) updatePodReferencesForSeccomp(ctx context.Context, sp *s
 		pod := linkedPods.Items[i]
 		podList[i] = pod.ObjectMeta.Namespace + "/" + pod.ObjectMeta.Name
 	}
+	ownerList := workloadOwners(linkedPods.Items)
 	if err := util.Retry(func() error {
 		sp.Status.ActiveWorkloads = podList
+		sp.Status.ActiveWorkloadOwners = ownerList
+		sp.Status.UnusedSince = unusedSince(sp.Status.UnusedSince, len(podList))
 
 		updateErr := r.client.Status().Update(ctx, sp)
 		if updateErr != nil {
@@ -214,8 +220,11 @@ func (r *PodReconciler) updatePodReferencesForSelinux(ctx context.Context, se *s
 		pod := linkedPods.Items[i]
 		podList[i] = pod.ObjectMeta.Namespace + "/" + pod.ObjectMeta.Name
 	}
+	ownerList := workloadOwners(linkedPods.Items)
 	if err := util.Retry(func() error {
 		se.Status.ActiveWorkloads = podList
+		se.Status.ActiveWorkloadOwners = ownerList
+		se.Status.UnusedSince = unusedSince(se.Status.UnusedSince, len(podList))
 		updateErr := r.client.Status().Update(ctx, se)
 		if updateErr != nil {
 			if err := r.client.Get(ctx, util.NamespacedName(se.GetName(), se.GetNamespace()), se); err != nil {
@@ -243,6 +252,48 @@ func (r *PodReconciler) updatePodReferencesForSelinux(ctx context.Context, se *s
 	return nil
 }
 
+// unusedSince returns the time since when a profile is not used by any pod,
+// which is kept while the profile stays unused.
+func unusedSince(current *metav1.Time, pods int) *metav1.Time {
+	if pods > 0 {
+		return nil
+	}
+	if current != nil {
+		return current
+	}
+	now := metav1.Now()
+	return &now
+}
+
+// workloadOwners returns the sorted and deduplicated workloads owning the
+// provided pods in the form kind/namespace/name.
+func workloadOwners(pods []corev1.Pod) []string {
+	owners := []string{}
+	for i := range pods {
+		owner := workloadOwner(&pods[i])
+		if !util.Contains(owners, owner) {
+			owners = append(owners, owner)
+		}
+	}
+	sort.Strings(owners)
+	return owners
+}
+
+// workloadOwner returns the workload owning the pod in the form
+// kind/namespace/name. ReplicaSets created by a Deployment are resolved to
+// the Deployment by stripping the pod template hash from their name.
+func workloadOwner(pod *corev1.Pod) string {
+	kind, name := "Pod", pod.GetName()
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		kind, name = owner.Kind, owner.Name
+		hash, hasHash := pod.GetLabels()[appsv1.DefaultDeploymentUniqueLabelKey]
+		if kind == "ReplicaSet" && hasHash && strings.HasSuffix(name, "-"+hash) {
+			kind, name = "Deployment", strings.TrimSuffix(name, "-"+hash)
+		}
+	}
+	return kind + "/" + pod.GetNamespace() + "/" + name
+}
+
 // getSeccompProfilesFromPod returns a slice of strings representing seccomp profiles required by the pod.
 // It looks first at the pod spec level, then in each container and init container, then in the annotations.
 func getSeccompProfilesFromPod(pod *corev1.Pod) []string {
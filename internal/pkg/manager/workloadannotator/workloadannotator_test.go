@@ -18,6 +18,7 @@ package workloadannotator
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -238,3 +239,45 @@ func TestGetSeccompProfilesFromPod(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadOwners(t *testing.T) {
+	t.Parallel()
+
+	isController := true
+	pod := func(name string, labels map[string]string, owner *metav1.OwnerReference) corev1.Pod {
+		p := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+		if owner != nil {
+			owner.Controller = &isController
+			p.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return p
+	}
+
+	got := workloadOwners([]corev1.Pod{
+		pod("web-7d4b9c-abcde", map[string]string{"pod-template-hash": "7d4b9c"},
+			&metav1.OwnerReference{Kind: "ReplicaSet", Name: "web-7d4b9c"}),
+		pod("web-7d4b9c-fghij", map[string]string{"pod-template-hash": "7d4b9c"},
+			&metav1.OwnerReference{Kind: "ReplicaSet", Name: "web-7d4b9c"}),
+		pod("standalone-xyz", nil, &metav1.OwnerReference{Kind: "ReplicaSet", Name: "standalone"}),
+		pod("db-0", nil, &metav1.OwnerReference{Kind: "StatefulSet", Name: "db"}),
+		pod("debug", nil, nil),
+	})
+	require.Equal(t, []string{
+		"Deployment/default/web",
+		"Pod/default/debug",
+		"ReplicaSet/default/standalone",
+		"StatefulSet/default/db",
+	}, got)
+}
+
+func TestUnusedSince(t *testing.T) {
+	t.Parallel()
+
+	before := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	require.Nil(t, unusedSince(&before, 1))
+	require.Equal(t, &before, unusedSince(&before, 0))
+	since := unusedSince(nil, 0)
+	require.NotNil(t, since)
+	require.WithinDuration(t, time.Now(), since.Time, time.Minute)
+}
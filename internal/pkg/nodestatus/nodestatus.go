@@ -37,6 +37,14 @@ import (
 
 const (
 	partialProfileFinalizer = "spo.x-k8s.io/partial-profile-finalizer"
+
+	// stateFieldOwner is the field manager of the node daemon when applying
+	// the state of a node status.
+	stateFieldOwner = client.FieldOwner("spod-node-status")
+
+	// selinuxBooleansFieldOwner is the field manager of the node daemon when
+	// applying the SELinux booleans of a node status.
+	selinuxBooleansFieldOwner = client.FieldOwner("spod-selinux-booleans")
 )
 
 type StatusClient struct {
@@ -224,12 +232,114 @@ func (nsf *StatusClient) SetNodeStatus(
 		return fmt.Errorf("retrieving the current status: %w", err)
 	}
 
-	status.Status = polState
-	status.Labels[secprofnodestatusv1alpha1.StatusStateLabel] = string(polState)
-	if err := nsf.client.Update(ctx, &status); err != nil {
-		return fmt.Errorf("updating node status: %w", err)
+	if status.Status == polState && status.Labels[secprofnodestatusv1alpha1.StatusStateLabel] == string(polState) {
+		// Nothing changed, so there is no need to write to the API server
+		return nil
+	}
+
+	apply, err := nsf.applyObj()
+	if err != nil {
+		return err
+	}
+	apply.Labels = map[string]string{secprofnodestatusv1alpha1.StatusStateLabel: string(polState)}
+	apply.Status = polState
+	if err := nsf.client.Patch(ctx, apply, client.Apply, stateFieldOwner, client.ForceOwnership); err != nil {
+		return fmt.Errorf("applying node status: %w", err)
+	}
+
+	return nil
+}
+
+// applyObj returns the node status for server-side applying the fields owned
+// by the node daemon. It contains the owner reference to the profile, so that
+// the node status still gets garbage collected if the apply recreates it
+// after the profile got deleted concurrently.
+func (nsf *StatusClient) applyObj() (*secprofnodestatusv1alpha1.SecurityProfileNodeStatus, error) {
+	s := &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: secprofnodestatusv1alpha1.GroupVersion.String(),
+			Kind:       "SecurityProfileNodeStatus",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nsf.perNodeStatusName(),
+			Namespace: nsf.pol.GetNamespace(),
+		},
+		NodeName: nsf.nodeName,
+	}
+
+	if err := controllerutil.SetControllerReference(nsf.pol, s, nsf.client.Scheme()); err != nil {
+		return nil, fmt.Errorf("cannot set node status owner reference: %s: %w", nsf.pol.GetName(), err)
+	}
+	return s, nil
+}
+
+// SelinuxBooleans returns the SELinux boolean states of the node status.
+func (nsf *StatusClient) SelinuxBooleans(
+	ctx context.Context,
+) ([]secprofnodestatusv1alpha1.SelinuxBooleanStatus, error) {
+	status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	if err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), &status); err != nil {
+		return nil, fmt.Errorf("retrieving the current status: %w", err)
+	}
+	return status.SelinuxBooleans, nil
+}
+
+// OtherSelinuxBooleans returns the applied SELinux boolean states of all
+// other profiles on the same node which are not being deleted, because the
+// booleans are node wide and may be required by more than one profile.
+func (nsf *StatusClient) OtherSelinuxBooleans(
+	ctx context.Context,
+) ([]secprofnodestatusv1alpha1.SelinuxBooleanStatus, error) {
+	nodeStatuses := &secprofnodestatusv1alpha1.SecurityProfileNodeStatusList{}
+	if err := nsf.client.List(
+		ctx, nodeStatuses, client.MatchingLabels{secprofnodestatusv1alpha1.StatusToNodeLabel: nsf.nodeName},
+	); err != nil {
+		return nil, fmt.Errorf("listing node statuses: %w", err)
+	}
+
+	own := nsf.perNodeStatusNamespacedName()
+	res := []secprofnodestatusv1alpha1.SelinuxBooleanStatus{}
+	for i := range nodeStatuses.Items {
+		status := &nodeStatuses.Items[i]
+		if (status.Name == own.Name && status.Namespace == own.Namespace) ||
+			status.Status == secprofnodestatusv1alpha1.ProfileStateTerminating {
+			continue
+		}
+		for _, b := range status.SelinuxBooleans {
+			if b.Applied {
+				res = append(res, b)
+			}
+		}
+	}
+	return res, nil
+}
+
+// SetSelinuxBooleans updates the SELinux boolean states of the node status.
+func (nsf *StatusClient) SetSelinuxBooleans(
+	ctx context.Context,
+	booleans []secprofnodestatusv1alpha1.SelinuxBooleanStatus,
+) error {
+	status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	if err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), &status); err != nil {
+		return fmt.Errorf("retrieving the current status: %w", err)
+	}
+
+	if util.ContentEqual(
+		&secprofnodestatusv1alpha1.SecurityProfileNodeStatus{SelinuxBooleans: status.SelinuxBooleans},
+		&secprofnodestatusv1alpha1.SecurityProfileNodeStatus{SelinuxBooleans: booleans},
+	) {
+		// Nothing changed, so there is no need to write to the API server
+		return nil
 	}
 
+	apply, err := nsf.applyObj()
+	if err != nil {
+		return err
+	}
+	apply.SelinuxBooleans = booleans
+	if err := nsf.client.Patch(ctx, apply, client.Apply, selinuxBooleansFieldOwner, client.ForceOwnership); err != nil {
+		return fmt.Errorf("applying node status: %w", err)
+	}
 	return nil
 }
 
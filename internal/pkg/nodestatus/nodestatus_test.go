@@ -17,13 +17,17 @@ limitations under the License.
 package nodestatus
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
@@ -89,3 +93,56 @@ func partialSeccompProfile() *seccompprofile.SeccompProfile {
 		},
 	}
 }
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestSetNodeStatusSkipsUnchanged(t *testing.T) {
+	t.Setenv(config.NodeNameEnvKey, "node")
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, seccompprofile.AddToScheme(scheme))
+	require.NoError(t, secprofnodestatusv1alpha1.AddToScheme(scheme))
+
+	profile := regularSeccompProfile()
+	profile.UID = "uid"
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(profile).Build()
+
+	ctx := context.Background()
+	sc, err := NewForProfile(profile, c)
+	require.NoError(t, err)
+	require.NoError(t, sc.createNodeStatus(ctx))
+
+	resourceVersion := func() string {
+		status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+		require.NoError(t, c.Get(ctx, sc.perNodeStatusNamespacedName(), &status))
+		return status.ResourceVersion
+	}
+	initial := resourceVersion()
+
+	// Unchanged state
+	require.NoError(t, sc.SetNodeStatus(ctx, secprofnodestatusv1alpha1.ProfileStatePending))
+	require.Equal(t, initial, resourceVersion())
+
+	// Changed state
+	require.NoError(t, sc.SetNodeStatus(ctx, secprofnodestatusv1alpha1.ProfileStateInstalled))
+	installed := resourceVersion()
+	require.NotEqual(t, initial, installed)
+	matches, err := sc.Matches(ctx, secprofnodestatusv1alpha1.ProfileStateInstalled)
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	require.NoError(t, sc.SetNodeStatus(ctx, secprofnodestatusv1alpha1.ProfileStateInstalled))
+	require.Equal(t, installed, resourceVersion())
+
+	// Changed and unchanged SELinux booleans
+	booleans := []secprofnodestatusv1alpha1.SelinuxBooleanStatus{{Name: "container_manage_cgroup", Value: true}}
+	require.NoError(t, sc.SetSelinuxBooleans(ctx, booleans))
+	applied := resourceVersion()
+	require.NotEqual(t, installed, applied)
+
+	got, err := sc.SelinuxBooleans(ctx)
+	require.NoError(t, err)
+	require.Equal(t, booleans, got)
+
+	require.NoError(t, sc.SetSelinuxBooleans(ctx, booleans))
+	require.Equal(t, applied, resourceVersion())
+}
@@ -18,9 +18,12 @@ package nonrootenabler
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"strconv"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/release-utils/util"
@@ -28,6 +31,13 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
+const (
+	kubeletBinary = "kubelet"
+	rootDirFlag   = "--root-dir"
+)
+
+var errKubeletNotFound = errors.New("kubelet process not found")
+
 // NonRootEnabler is the main type of this package.
 type NonRootEnabler struct {
 	impl
@@ -44,6 +54,91 @@ func (n *NonRootEnabler) SetImpl(i impl) {
 	n.impl = i
 }
 
+// DiscoverKubeletDir tries to auto-detect the kubelet root directory on the
+// host. The root directory is taken from the command line of the running
+// kubelet if possible. Otherwise, the configured directory as well as
+// well-known locations used by different Kubernetes distributions are probed,
+// where a directory is considered to be the kubelet root if it contains the
+// kubelet pods directory. The configured directory is returned if none of the
+// candidates match.
+func (n *NonRootEnabler) DiscoverKubeletDir(logger logr.Logger) string {
+	rootDir, err := n.kubeletRootDir()
+	if err == nil {
+		logger.Info("Discovered kubelet directory from kubelet command line: " + rootDir)
+		return rootDir
+	}
+	logger.V(config.VerboseLevel).Info(
+		"Unable to read kubelet directory from kubelet command line", "reason", err.Error(),
+	)
+
+	configured := config.KubeletDir()
+	candidates := append([]string{configured}, config.KnownKubeletPaths...)
+
+	for _, candidate := range candidates {
+		podsDir := path.Join(config.HostRoot, candidate, config.KubeletPodsFolder)
+		if _, err := n.impl.Stat(podsDir); err != nil {
+			logger.V(config.VerboseLevel).Info(
+				"Skipping kubelet directory candidate",
+				"candidate", candidate, "reason", err.Error(),
+			)
+			continue
+		}
+
+		logger.Info("Discovered kubelet directory: " + candidate)
+		return candidate
+	}
+
+	logger.Info("Unable to discover kubelet directory, using: " + configured)
+	return configured
+}
+
+// kubeletRootDir returns the root directory of the kubelet process running on
+// the host. The root directory can only be set via the --root-dir flag, which
+// is why it is neither part of the kubelet config file nor of the configz
+// endpoint of the kubelet.
+func (n *NonRootEnabler) kubeletRootDir() (string, error) {
+	procDir := path.Join(config.HostRoot, "proc")
+	entries, err := n.impl.ReadDir(procDir)
+	if err != nil {
+		return "", fmt.Errorf("read host processes: %w", err)
+	}
+
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+
+		cmdline, err := n.impl.ReadFile(path.Join(procDir, entry.Name(), "cmdline"))
+		if err != nil {
+			// The process may have exited in the meantime
+			continue
+		}
+
+		args := strings.Split(strings.TrimRight(string(cmdline), "\x00"), "\x00")
+		if path.Base(args[0]) != kubeletBinary {
+			continue
+		}
+
+		return rootDirFromArgs(args[1:]), nil
+	}
+
+	return "", errKubeletNotFound
+}
+
+// rootDirFromArgs returns the value of the --root-dir flag or the default
+// kubelet directory if the flag is not set.
+func rootDirFromArgs(args []string) string {
+	rootDir := config.DefaultKubeletPath
+	for i, arg := range args {
+		if value, ok := strings.CutPrefix(arg, rootDirFlag+"="); ok {
+			rootDir = value
+		} else if arg == rootDirFlag && i+1 < len(args) {
+			rootDir = args[i+1]
+		}
+	}
+	return rootDir
+}
+
 // Run executes the NonRootEnabler and returns an error if anything fails.
 func (n *NonRootEnabler) Run(logger logr.Logger, runtime, kubeletDir string) error {
 	const dirPermissions os.FileMode = 0o744
@@ -62,6 +157,14 @@ func (n *NonRootEnabler) Run(logger logr.Logger, runtime, kubeletDir string) err
 		)
 	}
 
+	logger.Info("Verifying that seccomp root path is writable")
+	if err := n.impl.CheckWritable(kubeleteSeccompDir); err != nil {
+		return fmt.Errorf(
+			"seccomp root path %s is not writable on node %q: %w",
+			kubeleteSeccompDir, os.Getenv(config.NodeNameEnvKey), err,
+		)
+	}
+
 	logger.Info("Ensuring operator root path: " + config.OperatorRoot)
 	if err := n.impl.MkdirAll(
 		config.OperatorRoot, dirPermissions,
@@ -131,10 +234,13 @@ type impl interface {
 	MkdirAll(dirpath string, perm os.FileMode) error
 	Chmod(name string, mode os.FileMode) error
 	Stat(name string) (os.FileInfo, error)
+	ReadDir(name string) ([]os.DirEntry, error)
+	ReadFile(name string) ([]byte, error)
 	Symlink(oldname, newname string) error
 	Chown(name string, uid, gid int) error
 	CopyDirContentsLocal(src, dst string) error
 	SaveKubeletConfig(filename string, kubeletConfig []byte, perm os.FileMode) error
+	CheckWritable(dirpath string) error
 }
 
 type defaultImpl struct{}
@@ -151,6 +257,14 @@ func (*defaultImpl) Stat(name string) (os.FileInfo, error) {
 	return os.Stat(name)
 }
 
+func (*defaultImpl) ReadDir(name string) ([]os.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
+func (*defaultImpl) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
 func (*defaultImpl) Symlink(oldname, newname string) error {
 	return os.Symlink(oldname, newname)
 }
@@ -166,3 +280,18 @@ func (*defaultImpl) CopyDirContentsLocal(src, dst string) error {
 func (*defaultImpl) SaveKubeletConfig(filename string, kubeletConfig []byte, perm os.FileMode) error {
 	return os.WriteFile(filename, kubeletConfig, perm)
 }
+
+func (*defaultImpl) CheckWritable(dirpath string) error {
+	f, err := os.CreateTemp(dirpath, ".spo-write-check-")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	name := f.Name()
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close temp file: %w", err)
+	}
+	if err := os.Remove(name); err != nil {
+		return fmt.Errorf("remove temp file: %w", err)
+	}
+	return nil
+}
@@ -18,8 +18,13 @@ package nonrootenabler_test
 
 import (
 	"errors"
+	"io/fs"
 	"os"
+	"path"
+	"strconv"
+	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
@@ -90,6 +95,12 @@ func TestRun(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{ // failure on CheckWritable
+			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
+				mock.CheckWritableReturns(errTest)
+			},
+			shouldError: true,
+		},
 		{ // success on SaveKubeletDir success
 			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
 				mock.SaveKubeletConfigReturns(nil)
@@ -110,3 +121,102 @@ func TestRun(t *testing.T) {
 		}
 	}
 }
+
+// mockProcesses lets the mock return the provided command lines as host
+// processes.
+func mockProcesses(t *testing.T, mock *nonrootenablerfakes.FakeImpl, cmdlines ...string) {
+	t.Helper()
+	procs := fstest.MapFS{"self": &fstest.MapFile{Mode: fs.ModeDir}}
+	for i, cmdline := range cmdlines {
+		procs[path.Join(strconv.Itoa(i+1), "cmdline")] = &fstest.MapFile{Data: []byte(cmdline)}
+	}
+
+	entries, err := fs.ReadDir(procs, ".")
+	require.NoError(t, err)
+	mock.ReadDirReturns(entries, nil)
+	mock.ReadFileCalls(func(name string) ([]byte, error) {
+		return fs.ReadFile(procs, strings.TrimPrefix(name, path.Join(config.HostRoot, "proc")+"/"))
+	})
+}
+
+func TestDiscoverKubeletDir(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		prepare  func(*nonrootenablerfakes.FakeImpl)
+		expected string
+	}{
+		{ // configured directory exists
+			prepare:  func(*nonrootenablerfakes.FakeImpl) {},
+			expected: config.KubeletDir(),
+		},
+		{ // root directory from kubelet command line
+			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
+				mockProcesses(t, mock,
+					"/usr/lib/systemd/systemd\x00--system\x00",
+					"/usr/bin/kubelet\x00--config=/var/lib/kubelet/config.yaml\x00--root-dir=/mnt/kubelet\x00",
+				)
+			},
+			expected: "/mnt/kubelet",
+		},
+		{ // root directory as separate kubelet argument
+			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
+				mockProcesses(t, mock, "kubelet\x00--root-dir\x00/data/kubelet\x00")
+			},
+			expected: "/data/kubelet",
+		},
+		{ // kubelet running with default root directory
+			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
+				mockProcesses(t, mock, "/usr/bin/kubelet\x00--v=2\x00")
+				mock.StatCalls(func(name string) (os.FileInfo, error) {
+					if strings.HasPrefix(name, path.Join(config.HostRoot, "/var/lib/k0s")) {
+						return nil, nil
+					}
+					return nil, os.ErrNotExist
+				})
+			},
+			expected: config.DefaultKubeletPath,
+		},
+		{ // no kubelet process, falling back to well-known directories
+			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
+				mockProcesses(t, mock, "/usr/bin/kubelet-wrapper\x00--root-dir=/mnt/kubelet\x00")
+				mock.StatCalls(func(name string) (os.FileInfo, error) {
+					if strings.HasPrefix(name, path.Join(config.HostRoot, "/var/snap/microk8s")) {
+						return nil, nil
+					}
+					return nil, os.ErrNotExist
+				})
+			},
+			expected: "/var/snap/microk8s/common/var/lib/kubelet",
+		},
+		{ // host processes not readable
+			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
+				mock.ReadDirReturns(nil, errTest)
+			},
+			expected: config.KubeletDir(),
+		},
+		{ // well-known directory exists
+			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
+				mock.StatCalls(func(name string) (os.FileInfo, error) {
+					if strings.HasPrefix(name, path.Join(config.HostRoot, "/var/lib/k0s")) {
+						return nil, nil
+					}
+					return nil, os.ErrNotExist
+				})
+			},
+			expected: "/var/lib/k0s/kubelet",
+		},
+		{ // nothing found
+			prepare: func(mock *nonrootenablerfakes.FakeImpl) {
+				mock.StatReturns(nil, os.ErrNotExist)
+			},
+			expected: config.KubeletDir(),
+		},
+	} {
+		sut := nonrootenabler.New()
+		mock := &nonrootenablerfakes.FakeImpl{}
+		tc.prepare(mock)
+		sut.SetImpl(mock)
+
+		require.Equal(t, tc.expected, sut.DiscoverKubeletDir(logr.Discard()))
+	}
+}
@@ -23,6 +23,17 @@ import (
 )
 
 type FakeImpl struct {
+	CheckWritableStub        func(string) error
+	checkWritableMutex       sync.RWMutex
+	checkWritableArgsForCall []struct {
+		arg1 string
+	}
+	checkWritableReturns struct {
+		result1 error
+	}
+	checkWritableReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ChmodStub        func(string, fs.FileMode) error
 	chmodMutex       sync.RWMutex
 	chmodArgsForCall []struct {
@@ -72,6 +83,32 @@ type FakeImpl struct {
 	mkdirAllReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReadDirStub        func(string) ([]fs.DirEntry, error)
+	readDirMutex       sync.RWMutex
+	readDirArgsForCall []struct {
+		arg1 string
+	}
+	readDirReturns struct {
+		result1 []fs.DirEntry
+		result2 error
+	}
+	readDirReturnsOnCall map[int]struct {
+		result1 []fs.DirEntry
+		result2 error
+	}
+	ReadFileStub        func(string) ([]byte, error)
+	readFileMutex       sync.RWMutex
+	readFileArgsForCall []struct {
+		arg1 string
+	}
+	readFileReturns struct {
+		result1 []byte
+		result2 error
+	}
+	readFileReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	SaveKubeletConfigStub        func(string, []byte, fs.FileMode) error
 	saveKubeletConfigMutex       sync.RWMutex
 	saveKubeletConfigArgsForCall []struct {
@@ -114,6 +151,67 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeImpl) CheckWritable(arg1 string) error {
+	fake.checkWritableMutex.Lock()
+	ret, specificReturn := fake.checkWritableReturnsOnCall[len(fake.checkWritableArgsForCall)]
+	fake.checkWritableArgsForCall = append(fake.checkWritableArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CheckWritableStub
+	fakeReturns := fake.checkWritableReturns
+	fake.recordInvocation("CheckWritable", []interface{}{arg1})
+	fake.checkWritableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) CheckWritableCallCount() int {
+	fake.checkWritableMutex.RLock()
+	defer fake.checkWritableMutex.RUnlock()
+	return len(fake.checkWritableArgsForCall)
+}
+
+func (fake *FakeImpl) CheckWritableCalls(stub func(string) error) {
+	fake.checkWritableMutex.Lock()
+	defer fake.checkWritableMutex.Unlock()
+	fake.CheckWritableStub = stub
+}
+
+func (fake *FakeImpl) CheckWritableArgsForCall(i int) string {
+	fake.checkWritableMutex.RLock()
+	defer fake.checkWritableMutex.RUnlock()
+	argsForCall := fake.checkWritableArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) CheckWritableReturns(result1 error) {
+	fake.checkWritableMutex.Lock()
+	defer fake.checkWritableMutex.Unlock()
+	fake.CheckWritableStub = nil
+	fake.checkWritableReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) CheckWritableReturnsOnCall(i int, result1 error) {
+	fake.checkWritableMutex.Lock()
+	defer fake.checkWritableMutex.Unlock()
+	fake.CheckWritableStub = nil
+	if fake.checkWritableReturnsOnCall == nil {
+		fake.checkWritableReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkWritableReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Chmod(arg1 string, arg2 fs.FileMode) error {
 	fake.chmodMutex.Lock()
 	ret, specificReturn := fake.chmodReturnsOnCall[len(fake.chmodArgsForCall)]
@@ -363,6 +461,134 @@ func (fake *FakeImpl) MkdirAllReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) ReadDir(arg1 string) ([]fs.DirEntry, error) {
+	fake.readDirMutex.Lock()
+	ret, specificReturn := fake.readDirReturnsOnCall[len(fake.readDirArgsForCall)]
+	fake.readDirArgsForCall = append(fake.readDirArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadDirStub
+	fakeReturns := fake.readDirReturns
+	fake.recordInvocation("ReadDir", []interface{}{arg1})
+	fake.readDirMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ReadDirCallCount() int {
+	fake.readDirMutex.RLock()
+	defer fake.readDirMutex.RUnlock()
+	return len(fake.readDirArgsForCall)
+}
+
+func (fake *FakeImpl) ReadDirCalls(stub func(string) ([]fs.DirEntry, error)) {
+	fake.readDirMutex.Lock()
+	defer fake.readDirMutex.Unlock()
+	fake.ReadDirStub = stub
+}
+
+func (fake *FakeImpl) ReadDirArgsForCall(i int) string {
+	fake.readDirMutex.RLock()
+	defer fake.readDirMutex.RUnlock()
+	argsForCall := fake.readDirArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ReadDirReturns(result1 []fs.DirEntry, result2 error) {
+	fake.readDirMutex.Lock()
+	defer fake.readDirMutex.Unlock()
+	fake.ReadDirStub = nil
+	fake.readDirReturns = struct {
+		result1 []fs.DirEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ReadDirReturnsOnCall(i int, result1 []fs.DirEntry, result2 error) {
+	fake.readDirMutex.Lock()
+	defer fake.readDirMutex.Unlock()
+	fake.ReadDirStub = nil
+	if fake.readDirReturnsOnCall == nil {
+		fake.readDirReturnsOnCall = make(map[int]struct {
+			result1 []fs.DirEntry
+			result2 error
+		})
+	}
+	fake.readDirReturnsOnCall[i] = struct {
+		result1 []fs.DirEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ReadFile(arg1 string) ([]byte, error) {
+	fake.readFileMutex.Lock()
+	ret, specificReturn := fake.readFileReturnsOnCall[len(fake.readFileArgsForCall)]
+	fake.readFileArgsForCall = append(fake.readFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadFileStub
+	fakeReturns := fake.readFileReturns
+	fake.recordInvocation("ReadFile", []interface{}{arg1})
+	fake.readFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ReadFileCallCount() int {
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
+	return len(fake.readFileArgsForCall)
+}
+
+func (fake *FakeImpl) ReadFileCalls(stub func(string) ([]byte, error)) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = stub
+}
+
+func (fake *FakeImpl) ReadFileArgsForCall(i int) string {
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
+	argsForCall := fake.readFileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ReadFileReturns(result1 []byte, result2 error) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = nil
+	fake.readFileReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ReadFileReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.readFileMutex.Lock()
+	defer fake.readFileMutex.Unlock()
+	fake.ReadFileStub = nil
+	if fake.readFileReturnsOnCall == nil {
+		fake.readFileReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.readFileReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) SaveKubeletConfig(arg1 string, arg2 []byte, arg3 fs.FileMode) error {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -560,6 +786,8 @@ func (fake *FakeImpl) SymlinkReturnsOnCall(i int, result1 error) {
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkWritableMutex.RLock()
+	defer fake.checkWritableMutex.RUnlock()
 	fake.chmodMutex.RLock()
 	defer fake.chmodMutex.RUnlock()
 	fake.chownMutex.RLock()
@@ -568,6 +796,10 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.copyDirContentsLocalMutex.RUnlock()
 	fake.mkdirAllMutex.RLock()
 	defer fake.mkdirAllMutex.RUnlock()
+	fake.readDirMutex.RLock()
+	defer fake.readDirMutex.RUnlock()
+	fake.readFileMutex.RLock()
+	defer fake.readFileMutex.RUnlock()
 	fake.saveKubeletConfigMutex.RLock()
 	defer fake.saveKubeletConfigMutex.RUnlock()
 	fake.statMutex.RLock()
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// URLEnvKey is the environment variable key for the HTTP endpoint which
+	// receives the lifecycle notifications. Notifications are disabled if
+	// the variable is not set.
+	URLEnvKey = "NOTIFICATION_WEBHOOK_URL"
+
+	// SecretEnvKey is the environment variable key for the shared secret
+	// used to sign the notification payload.
+	SecretEnvKey = "NOTIFICATION_WEBHOOK_SECRET"
+
+	// SignatureHeader is the HTTP header containing the hex encoded
+	// HMAC-SHA256 signature of the request body, prefixed by "sha256=".
+	SignatureHeader = "X-SPO-Signature"
+
+	// EventHeader is the HTTP header containing the event type.
+	EventHeader = "X-SPO-Event"
+
+	signaturePrefix = "sha256="
+	requestTimeout  = 10 * time.Second
+)
+
+// EventType is the type of a lifecycle notification.
+type EventType string
+
+const (
+	// EventProfileCreated is sent when a profile has been created.
+	EventProfileCreated EventType = "ProfileCreated"
+
+	// EventProfileUpdated is sent when the spec of a profile has changed.
+	EventProfileUpdated EventType = "ProfileUpdated"
+
+	// EventProfileDeleted is sent when a profile has been removed.
+	EventProfileDeleted EventType = "ProfileDeleted"
+
+	// EventProfileInstallFailed is sent when a profile could not be installed
+	// on at least one node.
+	EventProfileInstallFailed EventType = "ProfileInstallFailed"
+
+	// EventRecordingCompleted is sent when a profile recording has been
+	// finished and its resulting profiles are available.
+	EventRecordingCompleted EventType = "RecordingCompleted"
+)
+
+// Event is the payload sent to the notification endpoint.
+type Event struct {
+	Type      EventType `json:"type"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Namespace string    `json:"namespace,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Notifier sends lifecycle events to an external HTTP endpoint.
+type Notifier struct {
+	url    string
+	secret []byte
+	client *http.Client
+	log    logr.Logger
+}
+
+// New creates a new Notifier configured from the environment.
+func New(log logr.Logger) *Notifier {
+	return &Notifier{
+		url:    os.Getenv(URLEnvKey),
+		secret: []byte(os.Getenv(SecretEnvKey)),
+		client: &http.Client{Timeout: requestTimeout},
+		log:    log,
+	}
+}
+
+// Enabled returns true if a notification endpoint has been configured.
+func (n *Notifier) Enabled() bool {
+	return n != nil && n.url != ""
+}
+
+// Notify sends the event to the configured endpoint. It is a no-op if the
+// notifier is not enabled.
+func (n *Notifier) Notify(ctx context.Context, event *Event) error {
+	if !n.Enabled() {
+		return nil
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(event.Type))
+	if len(n.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(n.secret, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("notification endpoint returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// NotifyAsync sends the event in the background and logs possible errors.
+func (n *Notifier) NotifyAsync(event *Event) {
+	if !n.Enabled() {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+
+		if err := n.Notify(ctx, event); err != nil {
+			n.log.Error(err, "Unable to send notification",
+				"type", event.Type, "kind", event.Kind,
+				"name", event.Name, "namespace", event.Namespace,
+			)
+		}
+	}()
+}
+
+// Sign returns the signature of the body by using the provided secret.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks if the signature matches the body for the provided secret.
+func Verify(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret")
+	for _, tc := range []struct {
+		name        string
+		secret      []byte
+		status      int
+		shouldError bool
+	}{
+		{
+			name:   "success with signature",
+			secret: secret,
+			status: http.StatusOK,
+		},
+		{
+			name:   "success without signature",
+			status: http.StatusNoContent,
+		},
+		{
+			name:        "failure on bad status code",
+			secret:      secret,
+			status:      http.StatusInternalServerError,
+			shouldError: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.Nil(t, err)
+
+				signature := r.Header.Get(SignatureHeader)
+				if len(tc.secret) > 0 {
+					require.True(t, Verify(tc.secret, body, signature))
+				} else {
+					require.Empty(t, signature)
+				}
+
+				event := &Event{}
+				require.Nil(t, json.Unmarshal(body, event))
+				require.Equal(t, EventProfileCreated, event.Type)
+				require.Equal(t, string(EventProfileCreated), r.Header.Get(EventHeader))
+				require.Equal(t, "profile", event.Name)
+				require.False(t, event.Timestamp.IsZero())
+
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			sut := &Notifier{
+				url:    srv.URL,
+				secret: tc.secret,
+				client: srv.Client(),
+				log:    logr.Discard(),
+			}
+			err := sut.Notify(context.Background(), &Event{
+				Type:      EventProfileCreated,
+				Kind:      "SeccompProfile",
+				Name:      "profile",
+				Namespace: "default",
+			})
+			if tc.shouldError {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestNotifyDisabled(t *testing.T) {
+	t.Parallel()
+
+	var sut *Notifier
+	require.False(t, sut.Enabled())
+	require.Nil(t, sut.Notify(context.Background(), &Event{}))
+
+	sut = &Notifier{}
+	require.False(t, sut.Enabled())
+	require.Nil(t, sut.Notify(context.Background(), &Event{}))
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"type":"ProfileDeleted"}`)
+	signature := Sign([]byte("secret"), body)
+
+	require.True(t, Verify([]byte("secret"), body, signature))
+	require.False(t, Verify([]byte("other"), body, signature))
+	require.False(t, Verify([]byte("secret"), []byte("{}"), signature))
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccomplint
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/containers/common/pkg/seccomp"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+// Rule is the name of a seccomp profile lint rule.
+type Rule string
+
+const (
+	// RuleDefaultAllow flags profiles which allow all syscalls which are
+	// not explicitly restricted by their default action.
+	RuleDefaultAllow Rule = "default-allow"
+
+	// RuleDangerousSyscalls flags rules allowing syscalls which can be used
+	// to escape the container or to attack the kernel.
+	RuleDangerousSyscalls Rule = "dangerous-syscalls"
+
+	// RuleArchitectures flags profiles without architectures, which only
+	// apply to the native architecture of the nodes.
+	RuleArchitectures Rule = "architectures"
+)
+
+// Rules are all known lint rules.
+var Rules = []Rule{RuleArchitectures, RuleDangerousSyscalls, RuleDefaultAllow}
+
+// DangerousSyscalls are the syscalls flagged by the RuleDangerousSyscalls.
+var DangerousSyscalls = []string{
+	"acct",
+	"add_key",
+	"bpf",
+	"delete_module",
+	"finit_module",
+	"init_module",
+	"ioperm",
+	"iopl",
+	"kexec_file_load",
+	"kexec_load",
+	"keyctl",
+	"mount",
+	"move_mount",
+	"open_by_handle_at",
+	"perf_event_open",
+	"pivot_root",
+	"process_vm_writev",
+	"ptrace",
+	"reboot",
+	"request_key",
+	"setns",
+	"swapoff",
+	"swapon",
+	"umount2",
+	"unshare",
+	"userfaultfd",
+}
+
+// Finding is a violation of a lint rule.
+type Finding struct {
+	Rule     Rule
+	Severity spodv1alpha1.SeccompLintSeverity
+	Message  string
+}
+
+func (f *Finding) String() string {
+	return fmt.Sprintf("%s: %s", f.Rule, f.Message)
+}
+
+// Severities are the severities of the lint rules.
+type Severities map[Rule]spodv1alpha1.SeccompLintSeverity
+
+// NewSeverities returns the severities of all known rules, which warn if
+// they are not configured. The returned unknown rules are ignored.
+func NewSeverities(
+	configured map[string]spodv1alpha1.SeccompLintSeverity,
+) (severities Severities, unknown []string) {
+	severities = Severities{}
+	for _, rule := range Rules {
+		severities[rule] = spodv1alpha1.SeccompLintSeverityWarn
+	}
+
+	for name, severity := range configured {
+		if _, ok := severities[Rule(name)]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		severities[Rule(name)] = severity
+	}
+
+	sort.Strings(unknown)
+	return severities, unknown
+}
+
+// Lint checks the profile against all enabled rules and returns the findings
+// in the order of the rules.
+func Lint(spec *seccompprofileapi.SeccompProfileSpec, severities Severities) []Finding {
+	findings := []Finding{}
+	add := func(rule Rule, format string, args ...any) {
+		findings = append(findings, Finding{
+			Rule:     rule,
+			Severity: severities[rule],
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	if enabled(severities, RuleArchitectures) && len(spec.Architectures) == 0 {
+		add(RuleArchitectures, "no architectures set, the profile only applies to the native architecture")
+	}
+
+	if enabled(severities, RuleDangerousSyscalls) {
+		for i, rule := range spec.Syscalls {
+			if rule == nil || !allows(rule.Action) {
+				continue
+			}
+			dangerous := []string{}
+			for _, name := range rule.Names {
+				if slices.Contains(DangerousSyscalls, name) {
+					dangerous = append(dangerous, name)
+				}
+			}
+			if len(dangerous) > 0 {
+				add(RuleDangerousSyscalls, "syscalls[%d]: %s allows dangerous syscalls %v", i, rule.Action, dangerous)
+			}
+		}
+	}
+
+	if enabled(severities, RuleDefaultAllow) && allows(spec.DefaultAction) {
+		add(RuleDefaultAllow, "default action %s does not restrict any syscall", spec.DefaultAction)
+	}
+
+	return findings
+}
+
+// Denied returns true if any of the findings has the deny severity.
+func Denied(findings []Finding) bool {
+	return slices.ContainsFunc(findings, func(f Finding) bool {
+		return f.Severity == spodv1alpha1.SeccompLintSeverityDeny
+	})
+}
+
+func enabled(severities Severities, rule Rule) bool {
+	severity, ok := severities[rule]
+	return ok && severity != spodv1alpha1.SeccompLintSeverityOff
+}
+
+func allows(action seccomp.Action) bool {
+	return action == seccomp.ActAllow || action == seccomp.ActLog
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccomplint
+
+import (
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/stretchr/testify/require"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+func TestNewSeverities(t *testing.T) {
+	t.Parallel()
+
+	severities, unknown := NewSeverities(map[string]spodv1alpha1.SeccompLintSeverity{
+		"dangerous-syscalls": spodv1alpha1.SeccompLintSeverityDeny,
+		"default-allow":      spodv1alpha1.SeccompLintSeverityOff,
+		"unknown":            spodv1alpha1.SeccompLintSeverityDeny,
+	})
+	require.Equal(t, Severities{
+		RuleArchitectures:     spodv1alpha1.SeccompLintSeverityWarn,
+		RuleDangerousSyscalls: spodv1alpha1.SeccompLintSeverityDeny,
+		RuleDefaultAllow:      spodv1alpha1.SeccompLintSeverityOff,
+	}, severities)
+	require.Equal(t, []string{"unknown"}, unknown)
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		spec       seccompprofileapi.SeccompProfileSpec
+		configured map[string]spodv1alpha1.SeccompLintSeverity
+		expected   []Finding
+		denied     bool
+	}{
+		{
+			name: "no findings",
+			spec: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction: seccomp.ActErrno,
+				Architectures: []seccompprofileapi.Arch{"SCMP_ARCH_X86_64"},
+				Syscalls: []*seccompprofileapi.Syscall{
+					{Action: seccomp.ActAllow, Names: []string{"read", "write"}},
+					{Action: seccomp.ActErrno, Names: []string{"ptrace"}},
+				},
+			},
+			expected: []Finding{},
+		},
+		{
+			name: "all rules warn",
+			spec: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction: seccomp.ActLog,
+				Syscalls: []*seccompprofileapi.Syscall{
+					{Action: seccomp.ActAllow, Names: []string{"read", "ptrace", "bpf"}},
+				},
+			},
+			expected: []Finding{
+				{
+					Rule:     RuleArchitectures,
+					Severity: spodv1alpha1.SeccompLintSeverityWarn,
+					Message:  "no architectures set, the profile only applies to the native architecture",
+				},
+				{
+					Rule:     RuleDangerousSyscalls,
+					Severity: spodv1alpha1.SeccompLintSeverityWarn,
+					Message:  "syscalls[0]: SCMP_ACT_ALLOW allows dangerous syscalls [ptrace bpf]",
+				},
+				{
+					Rule:     RuleDefaultAllow,
+					Severity: spodv1alpha1.SeccompLintSeverityWarn,
+					Message:  "default action SCMP_ACT_LOG does not restrict any syscall",
+				},
+			},
+		},
+		{
+			name: "configured severities",
+			spec: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction: seccomp.ActAllow,
+				Syscalls: []*seccompprofileapi.Syscall{
+					{Action: seccomp.ActLog, Names: []string{"mount"}},
+				},
+			},
+			configured: map[string]spodv1alpha1.SeccompLintSeverity{
+				"architectures":      spodv1alpha1.SeccompLintSeverityOff,
+				"dangerous-syscalls": spodv1alpha1.SeccompLintSeverityDeny,
+			},
+			expected: []Finding{
+				{
+					Rule:     RuleDangerousSyscalls,
+					Severity: spodv1alpha1.SeccompLintSeverityDeny,
+					Message:  "syscalls[0]: SCMP_ACT_LOG allows dangerous syscalls [mount]",
+				},
+				{
+					Rule:     RuleDefaultAllow,
+					Severity: spodv1alpha1.SeccompLintSeverityWarn,
+					Message:  "default action SCMP_ACT_ALLOW does not restrict any syscall",
+				},
+			},
+			denied: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			severities, _ := NewSeverities(tc.configured)
+			findings := Lint(&tc.spec, severities)
+			require.Equal(t, tc.expected, findings)
+			require.Equal(t, tc.denied, Denied(findings))
+		})
+	}
+}
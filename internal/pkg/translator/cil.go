@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	errCILUnbalanced      = errors.New("unbalanced parentheses")
+	errCILUnterminated    = errors.New("unterminated string")
+	errCILEmptyStatement  = errors.New("empty statement")
+	errCILNoStatement     = errors.New("expected a statement")
+	errCILNoKeyword       = errors.New("statement does not start with a keyword")
+	errCILArguments       = errors.New("wrong amount of arguments")
+	errCILInvalidArgument = errors.New("invalid argument")
+	errCILInvalidChar     = errors.New("invalid character")
+)
+
+// cilArity is the minimum and maximum amount of arguments of a CIL
+// statement, whereas a negative maximum means that there is no limit.
+type cilArity struct {
+	minArgs, maxArgs int
+}
+
+// cilStatements are the CIL statements with a known amount of arguments.
+// Statements containing other statements are validated recursively.
+var cilStatements = map[string]cilArity{
+	"allow":            {3, 3},
+	"auditallow":       {3, 3},
+	"block":            {1, -1},
+	"blockabstract":    {1, 1},
+	"blockinherit":     {1, 1},
+	"dontaudit":        {3, 3},
+	"neverallow":       {3, 3},
+	"optional":         {1, -1},
+	"type":             {1, 1},
+	"typeattribute":    {1, 1},
+	"typeattributeset": {2, 2},
+	"typepermissive":   {1, 1},
+}
+
+// cilContainers are the statements whose arguments after the name are
+// statements themselves.
+var cilContainers = map[string]bool{
+	"block":    true,
+	"optional": true,
+}
+
+// cilAccessVectorRules are the statements using a class permission as their
+// last argument.
+var cilAccessVectorRules = map[string]bool{
+	"allow":      true,
+	"auditallow": true,
+	"dontaudit":  true,
+	"neverallow": true,
+}
+
+type cilNode struct {
+	line int
+	atom string
+	list []*cilNode
+	// isList distinguishes empty lists from atoms.
+	isList bool
+}
+
+// ValidateCIL checks the syntax of a CIL policy before it gets installed by
+// selinuxd, which would otherwise only fail on the nodes. It does not resolve
+// any types, classes or permissions.
+func ValidateCIL(cil string) error {
+	nodes, err := parseCIL(cil)
+	if err != nil {
+		return err
+	}
+	return validateCILStatements(nodes)
+}
+
+func parseCIL(cil string) ([]*cilNode, error) {
+	var (
+		stack = [][]*cilNode{{}}
+		lines = []int{}
+		line  = 1
+	)
+
+	input := []rune(cil)
+	for i := 0; i < len(input); i++ {
+		r := input[i]
+		switch {
+		case r == '\n':
+			line++
+		case unicode.IsSpace(r):
+		case r == ';':
+			for i < len(input)-1 && input[i+1] != '\n' {
+				i++
+			}
+		case r == '(':
+			stack = append(stack, []*cilNode{})
+			lines = append(lines, line)
+		case r == ')':
+			if len(lines) == 0 {
+				return nil, fmt.Errorf("line %d: %w", line, errCILUnbalanced)
+			}
+			list := &cilNode{line: lines[len(lines)-1], list: stack[len(stack)-1], isList: true}
+			stack = stack[:len(stack)-1]
+			lines = lines[:len(lines)-1]
+			stack[len(stack)-1] = append(stack[len(stack)-1], list)
+		case r == '"':
+			end := i + 1
+			for end < len(input) && input[end] != '"' && input[end] != '\n' {
+				end++
+			}
+			if end == len(input) || input[end] != '"' {
+				return nil, fmt.Errorf("line %d: %w", line, errCILUnterminated)
+			}
+			stack[len(stack)-1] = append(stack[len(stack)-1], &cilNode{line: line, atom: string(input[i : end+1])})
+			i = end
+		case !unicode.IsPrint(r):
+			return nil, fmt.Errorf("line %d: %w %q", line, errCILInvalidChar, r)
+		default:
+			end := i
+			for end < len(input)-1 && !isCILDelimiter(input[end+1]) {
+				end++
+			}
+			stack[len(stack)-1] = append(stack[len(stack)-1], &cilNode{line: line, atom: string(input[i : end+1])})
+			i = end
+		}
+	}
+
+	if len(lines) != 0 {
+		return nil, fmt.Errorf("line %d: %w", lines[len(lines)-1], errCILUnbalanced)
+	}
+	return stack[0], nil
+}
+
+func isCILDelimiter(r rune) bool {
+	return unicode.IsSpace(r) || !unicode.IsPrint(r) || strings.ContainsRune(`();"`, r)
+}
+
+func validateCILStatements(nodes []*cilNode) error {
+	for _, node := range nodes {
+		if err := validateCILStatement(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCILStatement(node *cilNode) error {
+	if !node.isList {
+		return fmt.Errorf("line %d: %w, got %q", node.line, errCILNoStatement, node.atom)
+	}
+	if len(node.list) == 0 {
+		return fmt.Errorf("line %d: %w", node.line, errCILEmptyStatement)
+	}
+
+	keyword := node.list[0]
+	if keyword.isList || strings.HasPrefix(keyword.atom, `"`) {
+		return fmt.Errorf("line %d: %w", node.line, errCILNoKeyword)
+	}
+
+	args := node.list[1:]
+	arity, ok := cilStatements[keyword.atom]
+	if !ok {
+		return nil
+	}
+	if len(args) < arity.minArgs || (arity.maxArgs >= 0 && len(args) > arity.maxArgs) {
+		return fmt.Errorf("line %d: %w for %s: %d", node.line, errCILArguments, keyword.atom, len(args))
+	}
+
+	if cilContainers[keyword.atom] {
+		if args[0].isList {
+			return fmt.Errorf("line %d: %w: %s name must not be a list", node.line, errCILInvalidArgument, keyword.atom)
+		}
+		return validateCILStatements(args[1:])
+	}
+
+	if cilAccessVectorRules[keyword.atom] {
+		return validateCILClassPermission(keyword.atom, args[len(args)-1])
+	}
+	return nil
+}
+
+// validateCILClassPermission validates anonymous class permissions like
+// "(file (read write))", whereas named ones are not resolved.
+func validateCILClassPermission(keyword string, node *cilNode) error {
+	if !node.isList {
+		return nil
+	}
+	if len(node.list) != 2 || node.list[0].isList || !node.list[1].isList || len(node.list[1].list) == 0 {
+		return fmt.Errorf(
+			"line %d: %w: %s requires a class and a non-empty permission list", node.line, errCILInvalidArgument, keyword,
+		)
+	}
+	return nil
+}
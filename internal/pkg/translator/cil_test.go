@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateCIL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		cil     string
+		wantErr error
+	}{
+		{
+			name: "valid policy",
+			cil: `(block foo_bar
+; comment (with parentheses
+(blockinherit container)
+(typepermissive process)
+(typeattribute folded_1)
+(typeattributeset folded_1 (a_t b_t))
+(allow process var_log_t ( dir ( open read )))
+(allow process self named_classperms)
+(filecon "/var/log(/.*)?" any ())
+)
+`,
+		},
+		{
+			name:    "missing closing parenthesis",
+			cil:     "(block foo_bar\n(blockinherit container)\n",
+			wantErr: errCILUnbalanced,
+		},
+		{
+			name:    "additional closing parenthesis",
+			cil:     "(block foo_bar\n(blockinherit container)))\n",
+			wantErr: errCILUnbalanced,
+		},
+		{
+			name:    "unterminated string",
+			cil:     "(block foo_bar\n(filecon \"/var/log any ())\n)\n",
+			wantErr: errCILUnterminated,
+		},
+		{
+			name:    "empty statement",
+			cil:     "(block foo_bar\n()\n)\n",
+			wantErr: errCILEmptyStatement,
+		},
+		{
+			name:    "top level atom",
+			cil:     "block foo_bar",
+			wantErr: errCILNoStatement,
+		},
+		{
+			name:    "statement without keyword",
+			cil:     "(block foo_bar\n((allow) process)\n)\n",
+			wantErr: errCILNoKeyword,
+		},
+		{
+			name:    "wrong amount of arguments",
+			cil:     "(block foo_bar\n(blockinherit container net_container)\n)\n",
+			wantErr: errCILArguments,
+		},
+		{
+			name:    "block without name",
+			cil:     "(block (foo_bar))\n",
+			wantErr: errCILInvalidArgument,
+		},
+		{
+			name:    "empty permissions",
+			cil:     "(block foo_bar\n(allow process var_log_t ( dir ( )))\n)\n",
+			wantErr: errCILInvalidArgument,
+		},
+		{
+			name:    "invalid character",
+			cil:     "(block foo_bar\x00)\n",
+			wantErr: errCILInvalidChar,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateCIL(tt.cil)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("Unexpected error validating CIL: %s", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected error %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
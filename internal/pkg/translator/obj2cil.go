@@ -29,6 +29,12 @@ import (
 const (
 	typePermissive         = "(typepermissive process)"
 	systemContainerInherit = "container"
+
+	// minFoldTypes is the minimum amount of target types with identical
+	// permissions to be folded into a type attribute. Folding requires two
+	// additional statements, which means that it only results in a smaller
+	// policy from this amount of types on.
+	minFoldTypes = 4
 )
 
 func Object2CIL(
@@ -44,8 +50,9 @@ func Object2CIL(
 	// if it uses e.g. net_container or any other template because only the
 	// container template includes a "process" definition unless it is inhrienting
 	// another selinuxProfile object because the system container is already being
-	// inherited in that case.
-	if len(objInherits) == 0 {
+	// inherited in that case. Templates are abstract blocks which do not
+	// inherit the system container.
+	if !inheritsSelinuxProfile(objInherits) {
 		cilbuilder.WriteString(getCILInheritline(systemContainerInherit))
 	}
 	for _, inherit := range systemInherits {
@@ -63,16 +70,23 @@ func Object2CIL(
 		cilbuilder.WriteString("\n")
 	}
 
-	for _, ttype := range selxv1alpha2.SortLabelKeys(sp.Spec.Allow) {
-		for _, tclass := range selxv1alpha2.SortObjectClassKeys(sp.Spec.Allow[ttype]) {
-			cilbuilder.WriteString(getCILAllowLine(sp, ttype, tclass, sp.Spec.Allow[ttype][tclass]))
-		}
-	}
+	cilbuilder.WriteString(getCILAllowLines(sp))
 
 	cilbuilder.WriteString(getCILEnd())
 	return cilbuilder.String()
 }
 
+// inheritsSelinuxProfile returns true if any of the inherited objects is a
+// SelinuxProfile.
+func inheritsSelinuxProfile(objInherits []selxv1alpha2.SelinuxProfileObject) bool {
+	for _, inherit := range objInherits {
+		if _, ok := inherit.(*selxv1alpha2.SelinuxProfile); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func getCILStart(sp *selxv1alpha2.SelinuxProfile) string {
 	return fmt.Sprintf("(block %s_%s\n", sp.GetName(), sp.GetNamespace())
 }
@@ -81,19 +95,95 @@ func getCILInheritline(i string) string {
 	return fmt.Sprintf("(blockinherit %s)\n", i)
 }
 
-func getCILAllowLine(
-	sp *selxv1alpha2.SelinuxProfile,
-	ttype selxv1alpha2.LabelKey,
-	tclass selxv1alpha2.ObjectClassKey,
-	perms selxv1alpha2.PermissionSet,
-) string {
-	ttypeFinal := ttype.String()
-	if ttype == selxv1alpha2.AllowSelf {
-		ttypeFinal = sp.GetPolicyUsage()
+// getCILAllowLines returns the deduplicated allow rules of the profile in a
+// deterministic order. Target types with identical permissions are folded
+// into a type attribute if that results in less rules.
+func getCILAllowLines(sp *selxv1alpha2.SelinuxProfile) string {
+	rules := allowRules(sp)
+
+	types := make([]string, 0, len(rules))
+	typesByPerms := map[string][]string{}
+	for ttype, classes := range rules {
+		types = append(types, ttype)
+		key := classPermsKey(classes)
+		typesByPerms[key] = append(typesByPerms[key], ttype)
 	}
-	uniquePerms := sets.New(perms...).UnsortedList()
-	sort.Strings(uniquePerms)
-	return fmt.Sprintf("(allow process %s ( %s ( %s )))\n", ttypeFinal, tclass, strings.Join(uniquePerms, " "))
+	sort.Strings(types)
+
+	cilbuilder := strings.Builder{}
+	folded := sets.New[string]()
+	attributes := 0
+	for _, ttype := range types {
+		if folded.Has(ttype) {
+			continue
+		}
+
+		classes := rules[ttype]
+		target := ttype
+		if foldTypes := typesByPerms[classPermsKey(classes)]; len(foldTypes) >= minFoldTypes {
+			sort.Strings(foldTypes)
+			folded.Insert(foldTypes...)
+			attributes++
+			target = fmt.Sprintf("folded_%d", attributes)
+			cilbuilder.WriteString(fmt.Sprintf("(typeattribute %s)\n", target))
+			cilbuilder.WriteString(fmt.Sprintf("(typeattributeset %s (%s))\n", target, strings.Join(foldTypes, " ")))
+		}
+
+		for _, tclass := range sets.List(sets.KeySet(classes)) {
+			cilbuilder.WriteString(getCILAllowLine(target, tclass, classes[tclass]))
+		}
+	}
+
+	return cilbuilder.String()
+}
+
+// allowRules resolves the target types of the profile and returns their
+// sorted and deduplicated permissions per object class. Rules referencing
+// the profile itself are merged with the ones using its type explicitly.
+func allowRules(sp *selxv1alpha2.SelinuxProfile) map[string]map[string][]string {
+	perms := map[string]map[string]sets.Set[string]{}
+	for ttype, classes := range sp.Spec.Allow {
+		ttypeFinal := ttype.String()
+		if ttype == selxv1alpha2.AllowSelf {
+			ttypeFinal = sp.GetPolicyUsage()
+		}
+
+		for tclass, classPerms := range classes {
+			if len(classPerms) == 0 {
+				continue
+			}
+			if _, ok := perms[ttypeFinal]; !ok {
+				perms[ttypeFinal] = map[string]sets.Set[string]{}
+			}
+			if _, ok := perms[ttypeFinal][tclass.String()]; !ok {
+				perms[ttypeFinal][tclass.String()] = sets.New[string]()
+			}
+			perms[ttypeFinal][tclass.String()].Insert(classPerms...)
+		}
+	}
+
+	rules := make(map[string]map[string][]string, len(perms))
+	for ttype, classes := range perms {
+		rules[ttype] = make(map[string][]string, len(classes))
+		for tclass, classPerms := range classes {
+			rules[ttype][tclass] = sets.List(classPerms)
+		}
+	}
+	return rules
+}
+
+// classPermsKey returns a unique representation of the permissions per
+// object class.
+func classPermsKey(classes map[string][]string) string {
+	keyBuilder := strings.Builder{}
+	for _, tclass := range sets.List(sets.KeySet(classes)) {
+		keyBuilder.WriteString(tclass + "(" + strings.Join(classes[tclass], " ") + ")")
+	}
+	return keyBuilder.String()
+}
+
+func getCILAllowLine(ttype, tclass string, perms []string) string {
+	return fmt.Sprintf("(allow process %s ( %s ( %s )))\n", ttype, tclass, strings.Join(perms, " "))
 }
 
 func getCILEnd() string {
@@ -305,6 +305,36 @@ func TestObject2CIL(t *testing.T) {
 				"net_container",
 			},
 		},
+		{
+			name: "Test translation with an operator template",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Inherit: []selxv1alpha2.PolicyRef{
+						{
+							Kind: selxv1alpha2.TemplatePolicyKind,
+							Name: "log-writer",
+						},
+					},
+				},
+			},
+			wantMatches: []string{
+				"\\(block foo_bar",
+				"\\(blockinherit container\\)",
+				"\\(blockinherit log-writer_security-profiles-operator\\)",
+			},
+			inheritobjs: []selxv1alpha2.SelinuxProfileObject{
+				&selxv1alpha2.RawSelinuxProfile{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "log-writer",
+						Namespace: "security-profiles-operator",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -330,3 +360,48 @@ func TestObject2CIL(t *testing.T) {
 		})
 	}
 }
+
+func TestObject2CILMinimal(t *testing.T) {
+	t.Parallel()
+
+	profile := &selxv1alpha2.SelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "bar",
+		},
+		Spec: selxv1alpha2.SelinuxProfileSpec{
+			Allow: selxv1alpha2.Allow{
+				"@self": {
+					"fifo_file": []string{"write", "read"},
+				},
+				"foo_bar.process": {
+					"fifo_file": []string{"read", "ioctl"},
+				},
+				"a_t": {"file": []string{"read", "open", "read"}},
+				"b_t": {"file": []string{"open", "read"}},
+				"c_t": {"file": []string{"read", "open"}},
+				"d_t": {"file": []string{"open", "read"}},
+				"e_t": {
+					"file": []string{"open", "read"},
+					"dir":  []string{},
+				},
+				"f_t": {"dir": []string{"search"}},
+			},
+		},
+	}
+
+	const want = "(block foo_bar\n" +
+		"(blockinherit container)\n" +
+		"(typeattribute folded_1)\n" +
+		"(typeattributeset folded_1 (a_t b_t c_t d_t e_t))\n" +
+		"(allow process folded_1 ( file ( open read )))\n" +
+		"(allow process f_t ( dir ( search )))\n" +
+		"(allow process foo_bar.process ( fifo_file ( ioctl read write )))\n" +
+		")\n"
+
+	for i := 0; i < 10; i++ {
+		if got := Object2CIL(nil, nil, profile); got != want {
+			t.Fatalf("The generated CIL didn't match expectation.\nExpected: %s\nGenerated CIL: %s", want, got)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 
 	"github.com/jellydator/ttlcache/v3"
@@ -40,22 +41,126 @@ var (
 	// ErrContainerIDNotFound is the error returned by ContainerIDForPID if the
 	// cgroup does not contain any container ID.
 	ErrContainerIDNotFound = errors.New("unable to find container ID in cgroup path")
+
+	// ErrUnknownContainerIDResolver is the error returned by
+	// ContainerIDResolversByName for names which do not belong to any of the
+	// DefaultContainerIDResolvers.
+	ErrUnknownContainerIDResolver = errors.New("unknown container ID resolver")
+
+	// DefaultContainerIDResolvers are the container ID resolvers used by
+	// ContainerIDForPID in the order of their precedence. The runtime
+	// specific resolvers come first, while the generic cgroupfs resolver acts
+	// as fallback for any other runtime or cgroup driver.
+	DefaultContainerIDResolvers = []ContainerIDResolver{
+		NewRegexContainerIDResolver("crio", `crio-(?:conmon-)?([0-9a-f]{64})`),
+		NewRegexContainerIDResolver("containerd", `cri-containerd-([0-9a-f]{64})`),
+		NewRegexContainerIDResolver("docker", `docker-([0-9a-f]{64})`),
+		NewRegexContainerIDResolver("cgroupfs", `([0-9a-f]{64})`),
+	}
 )
 
+// ContainerIDResolver extracts a container ID from a single cgroup line.
+type ContainerIDResolver interface {
+	// Name returns the name of the resolver.
+	Name() string
+
+	// FindContainerID returns the last container ID of the cgroup line
+	// together with the index of its end, or -1 if the line contains no
+	// container ID known to the resolver.
+	FindContainerID(cgroupLine string) (containerID string, end int)
+}
+
+type regexContainerIDResolver struct {
+	name  string
+	regex *regexp.Regexp
+}
+
+// NewRegexContainerIDResolver creates a new ContainerIDResolver which uses
+// the first submatch of the provided regular expression as container ID.
+func NewRegexContainerIDResolver(name, expr string) ContainerIDResolver {
+	return &regexContainerIDResolver{
+		name:  name,
+		regex: regexp.MustCompile(expr),
+	}
+}
+
+func (r *regexContainerIDResolver) Name() string {
+	return r.name
+}
+
+func (r *regexContainerIDResolver) FindContainerID(cgroupLine string) (containerID string, end int) {
+	matches := r.regex.FindAllStringSubmatchIndex(cgroupLine, -1)
+	if len(matches) == 0 {
+		return "", -1
+	}
+
+	last := matches[len(matches)-1]
+	return cgroupLine[last[2]:last[3]], last[3]
+}
+
+// ContainerIDResolversByName returns the DefaultContainerIDResolvers with the
+// provided names in the provided order, which allows to change the precedence
+// of the resolvers or to disable some of them.
+func ContainerIDResolversByName(names []string) ([]ContainerIDResolver, error) {
+	resolvers := make([]ContainerIDResolver, 0, len(names))
+	for _, name := range names {
+		idx := slices.IndexFunc(DefaultContainerIDResolvers, func(r ContainerIDResolver) bool {
+			return r.Name() == name
+		})
+		if idx < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownContainerIDResolver, name)
+		}
+		if slices.Contains(resolvers, DefaultContainerIDResolvers[idx]) {
+			continue
+		}
+		resolvers = append(resolvers, DefaultContainerIDResolvers[idx])
+	}
+	return resolvers, nil
+}
+
+// FindContainerID returns the container ID of the cgroup line as well as the
+// name of the resolver which found it. The resolvers are tried in order and
+// the right-most container ID wins to support "docker in docker" use cases.
+// If multiple resolvers find the same container ID, then the first one is
+// used.
+func FindContainerID(resolvers []ContainerIDResolver, cgroupLine string) (containerID, resolver string) {
+	maxEnd := -1
+	for _, r := range resolvers {
+		id, end := r.FindContainerID(cgroupLine)
+		if end > maxEnd {
+			maxEnd = end
+			containerID = id
+			resolver = r.Name()
+		}
+	}
+	return containerID, resolver
+}
+
 // ContainerIDForPID tries to find the 64 digit container ID for the provided
 // PID by using its cgroup. It supports caching via the cache argument.
 func ContainerIDForPID(cache *ttlcache.Cache[string, string], pid int) (string, error) {
+	containerID, _, err := ResolveContainerIDForPID(cache, DefaultContainerIDResolvers, pid)
+	return containerID, err
+}
+
+// ResolveContainerIDForPID tries to find the 64 digit container ID for the
+// provided PID by using its cgroup and the provided resolvers. It returns the
+// name of the resolver which found the container ID, which is empty if the
+// result has been taken from the cache.
+func ResolveContainerIDForPID(
+	cache *ttlcache.Cache[string, string], resolvers []ContainerIDResolver, pid int,
+) (string, string, error) {
 	// Check the cache first
 	item := cache.Get(strconv.Itoa(pid))
 	if item != nil {
-		return item.Value(), nil
+		return item.Value(), "", nil
 	}
 
 	cgroupPath := fmt.Sprintf("/proc/%d/cgroup", pid)
 
 	file, err := os.Open(filepath.Clean(cgroupPath))
 	if err != nil {
-		return "", fmt.Errorf("%w: %w", ErrProcessNotFound, err)
+		return "", "", fmt.Errorf("%w: %w", ErrProcessNotFound, err)
 	}
 
 	defer func() {
@@ -67,16 +172,12 @@ func ContainerIDForPID(cache *ttlcache.Cache[string, string], pid int) (string,
 
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
-		text := scanner.Text()
-
-		if containerIDs := ContainerIDRegex.FindAllString(text, -1); 0 < len(containerIDs) {
-			// Using the last container ID in the cgroup path to support "docker in docker" use cases
-			containerID := containerIDs[len(containerIDs)-1]
+		if containerID, resolver := FindContainerID(resolvers, scanner.Text()); containerID != "" {
 			// Update the cache
 			cache.Set(strconv.Itoa(pid), containerID, ttlcache.DefaultTTL)
-			return containerID, nil
+			return containerID, resolver, nil
 		}
 	}
 
-	return "", ErrContainerIDNotFound
+	return "", "", ErrContainerIDNotFound
 }
@@ -61,3 +61,132 @@ func TestExtractContainerID(t *testing.T) {
 		})
 	}
 }
+
+func TestFindContainerID(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id      = "af208fd68bf39a07a439ed0c9b6609b9ae63ecd8a5f1a2af3e0db48b945b320a"
+		innerID = "b469ca5b54e01e7724b7a990f01d54f571dd7669b87851a87bd8b849c438c580"
+	)
+
+	tests := []struct {
+		name         string
+		cgroupLine   string
+		wantID       string
+		wantResolver string
+	}{
+		{
+			"Should resolve CRI-O with systemd driver",
+			"0::/kubepods.slice/kubepods-besteffort.slice/crio-" + id + ".scope",
+			id,
+			"crio",
+		},
+		{
+			"Should resolve CRI-O conmon",
+			"0::/system.slice/crio-conmon-" + id + ".scope",
+			id,
+			"crio",
+		},
+		{
+			"Should resolve containerd with systemd driver",
+			"0::/kubepods.slice/kubepods-burstable.slice/cri-containerd-" + id + ".scope",
+			id,
+			"containerd",
+		},
+		{
+			"Should resolve docker with systemd driver",
+			"0::/system.slice/docker-" + id + ".scope",
+			id,
+			"docker",
+		},
+		{
+			"Should fall back to cgroupfs",
+			"12:cpu,cpuacct:/kubepods/burstable/poda201f46d-151a-4701-8f24-314bea77df79/" + id,
+			id,
+			"cgroupfs",
+		},
+		{
+			"Should prefer the inner container for docker in docker",
+			"0::/kubepods.slice/crio-" + id + ".scope/docker/" + innerID,
+			innerID,
+			"cgroupfs",
+		},
+		{
+			"Should return empty when not found",
+			"0::/system.slice/crio.service",
+			"",
+			"",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			gotID, gotResolver := FindContainerID(DefaultContainerIDResolvers, tt.cgroupLine)
+			require.Equal(t, tt.wantID, gotID)
+			require.Equal(t, tt.wantResolver, gotResolver)
+		})
+	}
+}
+
+func TestContainerIDResolversByName(t *testing.T) {
+	t.Parallel()
+
+	const id = "af208fd68bf39a07a439ed0c9b6609b9ae63ecd8a5f1a2af3e0db48b945b320a"
+	const cgroupLine = "0::/kubepods.slice/crio-" + id + ".scope"
+
+	for _, tc := range []struct {
+		name         string
+		names        []string
+		wantNames    []string
+		wantResolver string
+		wantErr      error
+	}{
+		{
+			name:         "default order",
+			names:        []string{"crio", "containerd", "docker", "cgroupfs"},
+			wantNames:    []string{"crio", "containerd", "docker", "cgroupfs"},
+			wantResolver: "crio",
+		},
+		{
+			name:         "generic resolver first",
+			names:        []string{"cgroupfs", "crio"},
+			wantNames:    []string{"cgroupfs", "crio"},
+			wantResolver: "cgroupfs",
+		},
+		{
+			name:         "runtime specific resolver disabled",
+			names:        []string{"containerd", "cgroupfs", "containerd"},
+			wantNames:    []string{"containerd", "cgroupfs"},
+			wantResolver: "cgroupfs",
+		},
+		{
+			name:    "unknown resolver",
+			names:   []string{"crio", "podman"},
+			wantErr: ErrUnknownContainerIDResolver,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolvers, err := ContainerIDResolversByName(tc.names)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, r := range resolvers {
+				names = append(names, r.Name())
+			}
+			require.Equal(t, tc.wantNames, names)
+
+			gotID, gotResolver := FindContainerID(resolvers, cgroupLine)
+			require.Equal(t, id, gotID)
+			require.Equal(t, tc.wantResolver, gotResolver)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sort"
+
+	"github.com/containers/common/pkg/seccomp"
+
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+// SyscallCategoryOther is the category of all syscalls which are not part of
+// any other category.
+const SyscallCategoryOther = "other"
+
+// SyscallCategories are the categories used for grouping syscalls, in the
+// order of the resulting syscall entries.
+var SyscallCategories = []string{
+	"file",
+	"network",
+	"process",
+	"memory",
+	"signal",
+	"ipc",
+	"polling",
+	"time",
+	SyscallCategoryOther,
+}
+
+var syscallCategoryNames = map[string][]string{
+	"file": {
+		"access", "chdir", "chmod", "chown", "close", "close_range", "copy_file_range", "creat", "dup", "dup2",
+		"dup3", "faccessat", "faccessat2", "fadvise64", "fallocate", "fanotify_init", "fanotify_mark", "fchdir",
+		"fchmod", "fchmodat", "fchown", "fchownat", "fcntl", "fdatasync", "fgetxattr", "flistxattr", "flock",
+		"fremovexattr", "fsetxattr", "fstat", "fstatfs", "fsync", "ftruncate", "futimesat", "getcwd", "getdents",
+		"getdents64", "getxattr", "inotify_add_watch", "inotify_init", "inotify_init1", "inotify_rm_watch", "ioctl",
+		"lchown", "lgetxattr", "link", "linkat", "listxattr", "llistxattr", "lremovexattr", "lseek", "lsetxattr",
+		"lstat", "mkdir", "mkdirat", "mknod", "mknodat", "name_to_handle_at", "newfstatat", "open",
+		"open_by_handle_at", "openat", "openat2", "pipe", "pipe2", "pread64", "preadv", "preadv2", "pwrite64",
+		"pwritev", "pwritev2", "read", "readahead", "readlink", "readlinkat", "readv", "removexattr", "rename",
+		"renameat", "renameat2", "rmdir", "sendfile", "setxattr", "splice", "stat", "statfs", "statx", "symlink",
+		"symlinkat", "sync", "sync_file_range", "syncfs", "tee", "truncate", "umask", "unlink", "unlinkat",
+		"utime", "utimensat", "utimes", "write", "writev",
+	},
+	"network": {
+		"accept", "accept4", "bind", "connect", "getpeername", "getsockname", "getsockopt", "listen", "recvfrom",
+		"recvmmsg", "recvmsg", "sendmmsg", "sendmsg", "sendto", "setsockopt", "shutdown", "socket", "socketpair",
+	},
+	"process": {
+		"arch_prctl", "capget", "capset", "chroot", "clone", "clone3", "execve", "execveat", "exit", "exit_group",
+		"fork", "get_robust_list", "getegid", "geteuid", "getgid", "getgroups", "getpgid", "getpgrp", "getpid",
+		"getppid", "getpriority", "getresgid", "getresuid", "getrlimit", "getrusage", "getsid", "gettid", "getuid",
+		"kill", "mount", "personality", "pidfd_getfd", "pidfd_open", "pidfd_send_signal", "pivot_root", "prctl",
+		"prlimit64", "ptrace", "rseq", "sched_get_priority_max", "sched_get_priority_min", "sched_getaffinity",
+		"sched_getattr", "sched_getparam", "sched_getscheduler", "sched_rr_get_interval", "sched_setaffinity",
+		"sched_setattr", "sched_setparam", "sched_setscheduler", "sched_yield", "seccomp", "set_robust_list",
+		"set_tid_address", "setfsgid", "setfsuid", "setgid", "setgroups", "setns", "setpgid", "setpriority",
+		"setregid", "setresgid", "setresuid", "setreuid", "setrlimit", "setsid", "setuid", "sysinfo", "tgkill",
+		"tkill", "umount2", "uname", "unshare", "vfork", "wait4", "waitid",
+	},
+	"memory": {
+		"brk", "get_mempolicy", "madvise", "mbind", "membarrier", "memfd_create", "migrate_pages", "mincore",
+		"mlock", "mlock2", "mlockall", "mmap", "move_pages", "mprotect", "mremap", "msync", "munlock",
+		"munlockall", "munmap", "pkey_alloc", "pkey_free", "pkey_mprotect", "process_vm_readv",
+		"process_vm_writev", "set_mempolicy",
+	},
+	"signal": {
+		"alarm", "pause", "rt_sigaction", "rt_sigpending", "rt_sigprocmask", "rt_sigqueueinfo", "rt_sigreturn",
+		"rt_sigsuspend", "rt_sigtimedwait", "rt_tgsigqueueinfo", "sigaltstack", "signalfd", "signalfd4",
+	},
+	"ipc": {
+		"eventfd", "eventfd2", "futex", "futex_waitv", "mq_getsetattr", "mq_notify", "mq_open", "mq_timedreceive",
+		"mq_timedsend", "mq_unlink", "msgctl", "msgget", "msgrcv", "msgsnd", "semctl", "semget", "semop",
+		"semtimedop", "shmat", "shmctl", "shmdt", "shmget",
+	},
+	"polling": {
+		"epoll_create", "epoll_create1", "epoll_ctl", "epoll_pwait", "epoll_pwait2", "epoll_wait", "io_cancel",
+		"io_destroy", "io_getevents", "io_setup", "io_submit", "io_uring_enter", "io_uring_register",
+		"io_uring_setup", "poll", "ppoll", "pselect6", "select",
+	},
+	"time": {
+		"adjtimex", "clock_adjtime", "clock_getres", "clock_gettime", "clock_nanosleep", "clock_settime",
+		"getitimer", "gettimeofday", "nanosleep", "setitimer", "settimeofday", "time", "timer_create",
+		"timer_delete", "timer_getoverrun", "timer_gettime", "timer_settime", "timerfd_create",
+		"timerfd_gettime", "timerfd_settime", "times",
+	},
+}
+
+var syscallCategory = func() map[string]string {
+	res := map[string]string{}
+	for category, names := range syscallCategoryNames {
+		for _, name := range names {
+			res[name] = category
+		}
+	}
+	return res
+}()
+
+// SyscallCategory returns the category of the syscall, which is
+// SyscallCategoryOther for unknown syscalls.
+func SyscallCategory(name string) string {
+	if category, ok := syscallCategory[name]; ok {
+		return category
+	}
+	return SyscallCategoryOther
+}
+
+// GroupSyscallsByCategory regroups the syscalls into one entry per action and
+// category, ordered by action and SyscallCategories. Entries with an errno
+// return code or arguments are kept unchanged after the grouped entries.
+func GroupSyscallsByCategory(syscalls []*seccompprofile.Syscall) []*seccompprofile.Syscall {
+	grouped := map[seccomp.Action]map[string][]string{}
+	kept := []*seccompprofile.Syscall{}
+
+	for _, syscall := range syscalls {
+		if syscall.ErrnoRet != 0 || len(syscall.Args) > 0 {
+			kept = append(kept, syscall)
+			continue
+		}
+
+		categories, ok := grouped[syscall.Action]
+		if !ok {
+			categories = map[string][]string{}
+			grouped[syscall.Action] = categories
+		}
+		for _, name := range syscall.Names {
+			category := SyscallCategory(name)
+			if !Contains(categories[category], name) {
+				categories[category] = append(categories[category], name)
+			}
+		}
+	}
+
+	actions := make([]seccomp.Action, 0, len(grouped))
+	for action := range grouped {
+		actions = append(actions, action)
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i] < actions[j]
+	})
+
+	res := []*seccompprofile.Syscall{}
+	for _, action := range actions {
+		for _, category := range SyscallCategories {
+			names := grouped[action][category]
+			if len(names) == 0 {
+				continue
+			}
+			sort.Strings(names)
+			res = append(res, &seccompprofile.Syscall{Names: names, Action: action})
+		}
+	}
+
+	return append(res, kept...)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestGroupSyscallsByCategory(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		syscalls []*v1beta1.Syscall
+		want     []*v1beta1.Syscall
+	}{
+		{
+			name:     "Empty",
+			syscalls: []*v1beta1.Syscall{},
+			want:     []*v1beta1.Syscall{},
+		},
+		{
+			name: "SingleEntry",
+			syscalls: []*v1beta1.Syscall{
+				{Names: []string{"socket", "read", "getrandom", "mmap", "write", "connect"}, Action: seccomp.ActAllow},
+			},
+			want: []*v1beta1.Syscall{
+				{Names: []string{"read", "write"}, Action: seccomp.ActAllow},
+				{Names: []string{"connect", "socket"}, Action: seccomp.ActAllow},
+				{Names: []string{"mmap"}, Action: seccomp.ActAllow},
+				{Names: []string{"getrandom"}, Action: seccomp.ActAllow},
+			},
+		},
+		{
+			name: "MergesDuplicates",
+			syscalls: []*v1beta1.Syscall{
+				{Names: []string{"read", "futex"}, Action: seccomp.ActAllow},
+				{Names: []string{"futex", "close"}, Action: seccomp.ActAllow},
+			},
+			want: []*v1beta1.Syscall{
+				{Names: []string{"close", "read"}, Action: seccomp.ActAllow},
+				{Names: []string{"futex"}, Action: seccomp.ActAllow},
+			},
+		},
+		{
+			name: "SeparatesActions",
+			syscalls: []*v1beta1.Syscall{
+				{Names: []string{"read", "ptrace"}, Action: seccomp.ActLog},
+				{Names: []string{"write"}, Action: seccomp.ActAllow},
+			},
+			want: []*v1beta1.Syscall{
+				{Names: []string{"write"}, Action: seccomp.ActAllow},
+				{Names: []string{"read"}, Action: seccomp.ActLog},
+				{Names: []string{"ptrace"}, Action: seccomp.ActLog},
+			},
+		},
+		{
+			name: "KeepsErrnoAndArgs",
+			syscalls: []*v1beta1.Syscall{
+				{Names: []string{"personality"}, Action: seccomp.ActAllow, Args: []*v1beta1.Arg{{Value: 8}}},
+				{Names: []string{"mount"}, Action: seccomp.ActErrno, ErrnoRet: 1},
+				{Names: []string{"read"}, Action: seccomp.ActAllow},
+			},
+			want: []*v1beta1.Syscall{
+				{Names: []string{"read"}, Action: seccomp.ActAllow},
+				{Names: []string{"personality"}, Action: seccomp.ActAllow, Args: []*v1beta1.Arg{{Value: 8}}},
+				{Names: []string{"mount"}, Action: seccomp.ActErrno, ErrnoRet: 1},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, GroupSyscallsByCategory(tc.syscalls))
+		})
+	}
+}
+
+func TestSyscallCategory(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "file", SyscallCategory("openat"))
+	require.Equal(t, "network", SyscallCategory("accept4"))
+	require.Equal(t, SyscallCategoryOther, SyscallCategory("getrandom"))
+	require.Equal(t, SyscallCategoryOther, SyscallCategory(""))
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+)
+
+// ContentHash returns the SHA256 hash of the JSON representation of the
+// provided value. Comparing the hashes of the current and the desired content
+// of an object allows to skip API writes which would not change anything.
+func ContentHash(v interface{}) (string, error) {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal content: %w", err)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(content)), nil
+}
+
+// ContentEqual returns true if both values have the same content hash. Values
+// which cannot be hashed are never considered to be equal.
+func ContentEqual(a, b interface{}) bool {
+	hashA, err := ContentHash(a)
+	if err != nil {
+		return false
+	}
+	hashB, err := ContentHash(b)
+	if err != nil {
+		return false
+	}
+	return hashA == hashB
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentHash(t *testing.T) {
+	t.Parallel()
+
+	type content struct {
+		Name   string   `json:"name"`
+		Values []string `json:"values,omitempty"`
+	}
+
+	hash, err := ContentHash(content{Name: "test"})
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+
+	_, err = ContentHash(make(chan int))
+	require.Error(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		a, b     interface{}
+		expected bool
+	}{
+		{
+			name:     "equal",
+			a:        content{Name: "test", Values: []string{"a", "b"}},
+			b:        content{Name: "test", Values: []string{"a", "b"}},
+			expected: true,
+		},
+		{
+			name:     "omitted empty values",
+			a:        content{Name: "test"},
+			b:        content{Name: "test", Values: []string{}},
+			expected: true,
+		},
+		{
+			name: "different order",
+			a:    content{Name: "test", Values: []string{"a", "b"}},
+			b:    content{Name: "test", Values: []string{"b", "a"}},
+		},
+		{
+			name: "different name",
+			a:    content{Name: "test"},
+			b:    content{Name: "other"},
+		},
+		{
+			name: "not hashable",
+			a:    make(chan int),
+			b:    make(chan int),
+		},
+	} {
+		a, b, expected := tc.a, tc.b, tc.expected
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, expected, ContentEqual(a, b))
+		})
+	}
+}
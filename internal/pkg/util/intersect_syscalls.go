@@ -0,0 +1,29 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+// IntersectSyscalls returns the syscalls which are also part of the other
+// syscalls. A syscall is only part of both if the other syscalls contain it
+// with the same action, errno return code and arguments. Syscall entries
+// without any remaining names are omitted.
+func IntersectSyscalls(syscalls, otherSyscalls []*seccompprofile.Syscall) []*seccompprofile.Syscall {
+	return filterSyscalls(syscalls, otherSyscalls, true)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestIntersectSyscalls(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		syscalls      []*v1beta1.Syscall
+		otherSyscalls []*v1beta1.Syscall
+		want          []*v1beta1.Syscall
+	}{
+		{
+			name: "EmptyOther",
+			syscalls: []*v1beta1.Syscall{
+				{Names: []string{"read", "write"}, Action: seccomp.ActAllow},
+			},
+			otherSyscalls: []*v1beta1.Syscall{},
+			want:          []*v1beta1.Syscall{},
+		},
+		{
+			name: "PartialOverlap",
+			syscalls: []*v1beta1.Syscall{
+				{Names: []string{"ptrace", "read", "write"}, Action: seccomp.ActAllow},
+			},
+			otherSyscalls: []*v1beta1.Syscall{
+				{Names: []string{"read", "write", "close"}, Action: seccomp.ActAllow},
+			},
+			want: []*v1beta1.Syscall{
+				{Names: []string{"read", "write"}, Action: seccomp.ActAllow},
+			},
+		},
+		{
+			name: "DifferentAction",
+			syscalls: []*v1beta1.Syscall{
+				{Names: []string{"read"}, Action: seccomp.ActAllow},
+			},
+			otherSyscalls: []*v1beta1.Syscall{
+				{Names: []string{"read"}, Action: seccomp.ActLog},
+			},
+			want: []*v1beta1.Syscall{},
+		},
+		{
+			name: "SameArgs",
+			syscalls: []*v1beta1.Syscall{
+				{
+					Names:  []string{"personality"},
+					Action: seccomp.ActAllow,
+					Args:   []*v1beta1.Arg{{Index: 0, Value: 8, Op: seccomp.OpEqualTo}},
+				},
+			},
+			otherSyscalls: []*v1beta1.Syscall{
+				{
+					Names:  []string{"personality", "read"},
+					Action: seccomp.ActAllow,
+					Args:   []*v1beta1.Arg{{Index: 0, Value: 8, Op: seccomp.OpEqualTo}},
+				},
+			},
+			want: []*v1beta1.Syscall{
+				{
+					Names:  []string{"personality"},
+					Action: seccomp.ActAllow,
+					Args:   []*v1beta1.Arg{{Index: 0, Value: 8, Op: seccomp.OpEqualTo}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, IntersectSyscalls(tc.syscalls, tc.otherSyscalls))
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
 )
@@ -95,6 +96,41 @@ func GetKubeletDirFromNodeLabel(ctx context.Context, c client.Reader) (string, e
 	return "", fmt.Errorf("no %s label found on node %s", config.KubeletDirNodeLabelKey, nodeName)
 }
 
+// RecordProfileForContainer returns the profile to be recorded for the
+// container from the pod annotations with the provided key prefix. Ephemeral
+// containers are recorded if the pod is annotated for recording ephemeral
+// containers, where the name of the container gets substituted in the profile.
+func RecordProfileForContainer(
+	annotations map[string]string, prefix, containerName string, ephemeral bool,
+) string {
+	if !ephemeral {
+		return annotations[prefix+containerName]
+	}
+
+	profile, ok := annotations[prefix+config.EphemeralContainersRecordName]
+	if !ok {
+		return ""
+	}
+	return EphemeralContainerProfile(profile, containerName)
+}
+
+// EphemeralContainerProfile returns the profile to be recorded for an
+// ephemeral container from the profile annotated for all ephemeral containers.
+func EphemeralContainerProfile(profile, containerName string) string {
+	payload, err := profilerecordingv1alpha1.ParseCtrAnnotation(profile)
+	if err == nil && payload.Version >= profilerecordingv1alpha1.CtrAnnotationVersion {
+		payload.Container = containerName
+		return payload.String()
+	}
+
+	return strings.Replace(
+		profile,
+		"_"+config.EphemeralContainersRecordName+"_",
+		"_"+containerName+"_",
+		1,
+	)
+}
+
 type selinuxdImageMap struct {
 	Regex        string `json:"regex"`
 	ImageFromVar string `json:"imageFromVar"`
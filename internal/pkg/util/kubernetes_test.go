@@ -230,3 +230,58 @@ func TestMatchSelinuxdImageVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordProfileForContainer(t *testing.T) {
+	t.Parallel()
+	const prefix = "io.containers.trace-bpf/"
+	annotations := map[string]string{
+		prefix + "container":            "recording_container_abcde_1",
+		prefix + "ephemeral.containers": "recording_ephemeral.containers_fghij_2",
+	}
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		containerName string
+		ephemeral     bool
+		want          string
+	}{
+		{
+			name:          "Should return the profile of a regular container",
+			annotations:   annotations,
+			containerName: "container",
+			want:          "recording_container_abcde_1",
+		},
+		{
+			name:          "Should return the profile of an ephemeral container",
+			annotations:   annotations,
+			containerName: "debugger",
+			ephemeral:     true,
+			want:          "recording_debugger_fghij_2",
+		},
+		{
+			name: "Should return the profile of an ephemeral container for version 2 annotations",
+			annotations: map[string]string{
+				prefix + "ephemeral.containers": `{"version":2,"recording":"recording",` +
+					`"container":"ephemeral.containers","session":"fghij","timestamp":2}`,
+			},
+			containerName: "debugger",
+			ephemeral:     true,
+			want: `{"version":2,"recording":"recording",` +
+				`"container":"debugger","session":"fghij","timestamp":2}`,
+		},
+		{
+			name:          "Should not record ephemeral containers if not annotated",
+			annotations:   map[string]string{prefix + "container": "recording_container_abcde_1"},
+			containerName: "container",
+			ephemeral:     true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := RecordProfileForContainer(tt.annotations, prefix, tt.containerName, tt.ephemeral)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+// Well-known keys of the profile metadata, which are additionally exposed as
+// metrics labels.
+const (
+	ProfileMetadataOwner  = "owner"
+	ProfileMetadataTicket = "ticket"
+	ProfileMetadataExpiry = "expiry"
+)
+
+// ProfileMetadata returns the metadata of a profile from its annotations with
+// the config.ProfileMetadataAnnotationPrefix, keyed by the annotation names
+// without the prefix. It returns nil if the profile has no metadata.
+func ProfileMetadata(annotations map[string]string) map[string]string {
+	var res map[string]string
+	for key, value := range annotations {
+		name, ok := strings.CutPrefix(key, config.ProfileMetadataAnnotationPrefix)
+		if !ok || name == "" {
+			continue
+		}
+		if res == nil {
+			res = map[string]string{}
+		}
+		res[name] = value
+	}
+	return res
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileMetadata(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]string
+	}{
+		{name: "no annotations"},
+		{
+			name:        "no metadata annotations",
+			annotations: map[string]string{"spo.x-k8s.io/owner": "team-a"},
+		},
+		{
+			name: "metadata annotations",
+			annotations: map[string]string{
+				"metadata.spo.x-k8s.io/owner":  "team-a",
+				"metadata.spo.x-k8s.io/ticket": "SEC-123",
+				"metadata.spo.x-k8s.io/":       "empty",
+				"other":                        "value",
+			},
+			expected: map[string]string{
+				ProfileMetadataOwner:  "team-a",
+				ProfileMetadataTicket: "SEC-123",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, ProfileMetadata(tc.annotations))
+		})
+	}
+}
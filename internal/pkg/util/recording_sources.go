@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+)
+
+// MaxRecordingSources is the maximum number of recording sources which are
+// tracked for a single recorded profile. The oldest sources get dropped.
+const MaxRecordingSources = 20
+
+// RecordingSource identifies the recording session of a pod on a node and the
+// time window in which it contributed to a recorded profile.
+type RecordingSource struct {
+	Session string    `json:"session"`
+	Node    string    `json:"node,omitempty"`
+	Pod     string    `json:"pod,omitempty"`
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+}
+
+// RecordingSources are the sources of a recorded profile.
+type RecordingSources []RecordingSource
+
+// ParseRecordingSources parses the JSON representation of the sources, as
+// stored in an annotation. An empty string results in no sources.
+func ParseRecordingSources(data string) (RecordingSources, error) {
+	res := RecordingSources{}
+	if data == "" {
+		return res, nil
+	}
+	if err := json.Unmarshal([]byte(data), &res); err != nil {
+		return nil, fmt.Errorf("unmarshal recording sources: %w", err)
+	}
+	return res, nil
+}
+
+// RecordingSourcesOf returns the sources annotated on the recorded profile.
+func RecordingSourcesOf(obj metav1.Object) (RecordingSources, error) {
+	return ParseRecordingSources(
+		obj.GetAnnotations()[profilerecordingv1alpha1.ProfileRecordingSourcesAnnotation],
+	)
+}
+
+// SetRecordingSources annotates the sources on the recorded profile and labels
+// it with their recording session, if all sources belong to the same one.
+func SetRecordingSources(obj *metav1.ObjectMeta, sources RecordingSources) {
+	metav1.SetMetaDataAnnotation(obj, profilerecordingv1alpha1.ProfileRecordingSourcesAnnotation, sources.String())
+
+	session := sources.Session()
+	if session == "" || len(validation.IsValidLabelValue(session)) > 0 {
+		delete(obj.Labels, profilerecordingv1alpha1.ProfileToRecordingSessionLabel)
+		return
+	}
+	metav1.SetMetaDataLabel(obj, profilerecordingv1alpha1.ProfileToRecordingSessionLabel, session)
+}
+
+// Insert adds the source, or widens the time window of the source with the
+// same session, node and pod.
+func (s *RecordingSources) Insert(source RecordingSource) {
+	for i := range *s {
+		known := &(*s)[i]
+		if known.Session != source.Session || known.Node != source.Node || known.Pod != source.Pod {
+			continue
+		}
+		if !source.Start.IsZero() && (known.Start.IsZero() || source.Start.Before(known.Start)) {
+			known.Start = source.Start
+		}
+		if source.End.After(known.End) {
+			known.End = source.End
+		}
+		return
+	}
+
+	*s = append(*s, source)
+	s.sort()
+	if len(*s) > MaxRecordingSources {
+		*s = (*s)[len(*s)-MaxRecordingSources:]
+	}
+}
+
+// Merge adds all sources of other.
+func (s *RecordingSources) Merge(other RecordingSources) {
+	for _, source := range other {
+		s.Insert(source)
+	}
+}
+
+// Session returns the recording session if all sources belong to the same
+// one, otherwise an empty string.
+func (s RecordingSources) Session() string {
+	session := ""
+	for i := range s {
+		if session != "" && s[i].Session != session {
+			return ""
+		}
+		session = s[i].Session
+	}
+	return session
+}
+
+// String returns the JSON representation of the sources ordered by the start
+// of their time window.
+func (s RecordingSources) String() string {
+	if s == nil {
+		s = RecordingSources{}
+	}
+	// Marshaling plain structs cannot fail
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+func (s RecordingSources) sort() {
+	sort.SliceStable(s, func(i, j int) bool {
+		return s[i].Start.Before(s[j].Start)
+	})
+}
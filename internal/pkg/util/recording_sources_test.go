@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+)
+
+func TestRecordingSourcesInsert(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	sut := RecordingSources{}
+	sut.Insert(RecordingSource{Session: "b", Node: "node", Pod: "pod-b", Start: start, End: start.Add(time.Minute)})
+	sut.Insert(RecordingSource{Session: "a", Node: "node", Pod: "pod-a", Start: start.Add(-time.Hour), End: start})
+	sut.Insert(RecordingSource{Session: "b", Node: "node", Pod: "pod-b", Start: start, End: start.Add(time.Hour)})
+	require.Equal(t, RecordingSources{
+		{Session: "a", Node: "node", Pod: "pod-a", Start: start.Add(-time.Hour), End: start},
+		{Session: "b", Node: "node", Pod: "pod-b", Start: start, End: start.Add(time.Hour)},
+	}, sut)
+
+	for i := 0; i < 2*MaxRecordingSources; i++ {
+		sut.Insert(RecordingSource{Session: fmt.Sprint(i), Start: start.Add(time.Duration(i) * time.Second)})
+	}
+	require.Len(t, sut, MaxRecordingSources)
+	require.Equal(t, fmt.Sprint(2*MaxRecordingSources-1), sut[MaxRecordingSources-1].Session)
+}
+
+func TestRecordingSourcesSession(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		sources  RecordingSources
+		expected string
+	}{
+		{
+			name:     "no sources",
+			expected: "",
+		},
+		{
+			name:     "single session on multiple nodes",
+			sources:  RecordingSources{{Session: "a", Node: "1"}, {Session: "a", Node: "2"}},
+			expected: "a",
+		},
+		{
+			name:     "multiple sessions",
+			sources:  RecordingSources{{Session: "a"}, {Session: "b"}},
+			expected: "",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, tc.sources.Session())
+		})
+	}
+}
+
+func TestSetRecordingSources(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	obj := &metav1.ObjectMeta{}
+	SetRecordingSources(obj, RecordingSources{{Session: "a", Node: "node", Pod: "pod", Start: start, End: start}})
+	require.Equal(t, "a", obj.Labels[profilerecordingv1alpha1.ProfileToRecordingSessionLabel])
+	require.JSONEq(t,
+		`[{"session":"a","node":"node","pod":"pod","start":"2023-01-02T03:04:05Z","end":"2023-01-02T03:04:05Z"}]`,
+		obj.Annotations[profilerecordingv1alpha1.ProfileRecordingSourcesAnnotation],
+	)
+
+	sources, err := RecordingSourcesOf(obj)
+	require.NoError(t, err)
+	sources.Insert(RecordingSource{Session: "b", Start: start, End: start})
+	SetRecordingSources(obj, sources)
+	require.NotContains(t, obj.Labels, profilerecordingv1alpha1.ProfileToRecordingSessionLabel)
+
+	obj.Annotations[profilerecordingv1alpha1.ProfileRecordingSourcesAnnotation] = "invalid"
+	_, err = RecordingSourcesOf(obj)
+	require.Error(t, err)
+}
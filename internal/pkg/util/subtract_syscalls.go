@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+// SubtractSyscalls returns the syscalls which are not already covered by the
+// base syscalls. A syscall is covered if the base contains it with the same
+// action, errno return code and arguments. Syscall entries without any
+// remaining names are omitted.
+func SubtractSyscalls(syscalls, baseSyscalls []*seccompprofile.Syscall) []*seccompprofile.Syscall {
+	return filterSyscalls(syscalls, baseSyscalls, false)
+}
+
+// filterSyscalls returns the syscalls which are either covered or not
+// covered by the base syscalls, depending on the provided flag.
+func filterSyscalls(syscalls, baseSyscalls []*seccompprofile.Syscall, covered bool) []*seccompprofile.Syscall {
+	res := []*seccompprofile.Syscall{}
+
+	for _, syscall := range syscalls {
+		names := []string{}
+		for _, name := range syscall.Names {
+			if coveredBy(syscall, name, baseSyscalls) == covered {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+
+		diff := syscall.DeepCopy()
+		diff.Names = names
+		res = append(res, diff)
+	}
+
+	return res
+}
+
+func coveredBy(syscall *seccompprofile.Syscall, name string, baseSyscalls []*seccompprofile.Syscall) bool {
+	for _, base := range baseSyscalls {
+		if base.Action == syscall.Action &&
+			base.ErrnoRet == syscall.ErrnoRet &&
+			reflect.DeepEqual(base.Args, syscall.Args) &&
+			Contains(base.Names, name) {
+			return true
+		}
+	}
+	return false
+}
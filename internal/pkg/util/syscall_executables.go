@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// MaxExecutablesPerSyscall is the maximum number of executables which are
+// tracked for a single syscall.
+const MaxExecutablesPerSyscall = 5
+
+// SyscallExecutables maps syscall names to the executables which triggered
+// them.
+type SyscallExecutables map[string][]string
+
+// ParseSyscallExecutables parses the JSON representation of the mapping, as
+// stored in an annotation. An empty string results in an empty mapping.
+func ParseSyscallExecutables(data string) (SyscallExecutables, error) {
+	res := SyscallExecutables{}
+	if data == "" {
+		return res, nil
+	}
+	if err := json.Unmarshal([]byte(data), &res); err != nil {
+		return nil, fmt.Errorf("unmarshal syscall executables: %w", err)
+	}
+	return res, nil
+}
+
+// Insert adds the executable to the syscall, unless it is already known or
+// MaxExecutablesPerSyscall executables are tracked for the syscall.
+func (s SyscallExecutables) Insert(syscall, executable string) {
+	executables := s[syscall]
+	if executable == "" ||
+		len(executables) >= MaxExecutablesPerSyscall ||
+		Contains(executables, executable) {
+		return
+	}
+	s[syscall] = append(executables, executable)
+}
+
+// Merge adds all executables of other to the mapping.
+func (s SyscallExecutables) Merge(other SyscallExecutables) {
+	for syscall, executables := range other {
+		for _, executable := range executables {
+			s.Insert(syscall, executable)
+		}
+	}
+}
+
+// String returns the JSON representation of the mapping with sorted
+// executables.
+func (s SyscallExecutables) String() string {
+	sorted := make(SyscallExecutables, len(s))
+	for syscall, executables := range s {
+		sorted[syscall] = append([]string{}, executables...)
+		sort.Strings(sorted[syscall])
+	}
+	// Marshaling a map of string slices cannot fail
+	data, _ := json.Marshal(sorted)
+	return string(data)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyscallExecutablesInsert(t *testing.T) {
+	t.Parallel()
+
+	sut := SyscallExecutables{}
+	sut.Insert("ptrace", "/usr/bin/gdb")
+	sut.Insert("ptrace", "/usr/bin/gdb")
+	sut.Insert("ptrace", "")
+	require.Equal(t, []string{"/usr/bin/gdb"}, sut["ptrace"])
+
+	for i := 0; i < 2*MaxExecutablesPerSyscall; i++ {
+		sut.Insert("read", fmt.Sprintf("/bin/%d", i))
+	}
+	require.Len(t, sut["read"], MaxExecutablesPerSyscall)
+}
+
+func TestSyscallExecutablesMerge(t *testing.T) {
+	t.Parallel()
+
+	sut := SyscallExecutables{"read": {"/bin/cat"}}
+	sut.Merge(SyscallExecutables{
+		"read":   {"/bin/cat", "/bin/sh"},
+		"ptrace": {"/usr/bin/strace"},
+	})
+	require.Equal(t, SyscallExecutables{
+		"read":   {"/bin/cat", "/bin/sh"},
+		"ptrace": {"/usr/bin/strace"},
+	}, sut)
+}
+
+func TestSyscallExecutablesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	sut := SyscallExecutables{"read": {"/bin/sh", "/bin/cat"}}
+	data := sut.String()
+	require.Equal(t, `{"read":["/bin/cat","/bin/sh"]}`, data)
+
+	parsed, err := ParseSyscallExecutables(data)
+	require.NoError(t, err)
+	require.Equal(t, SyscallExecutables{"read": {"/bin/cat", "/bin/sh"}}, parsed)
+
+	parsed, err = ParseSyscallExecutables("")
+	require.NoError(t, err)
+	require.Empty(t, parsed)
+
+	_, err = ParseSyscallExecutables("invalid")
+	require.Error(t, err)
+}
@@ -22,22 +22,28 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	pbv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
@@ -52,18 +58,37 @@ type podBinder struct {
 }
 
 func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Client) {
+	ctrlmetrics.Registry.MustRegister(metricBindingAudit)
+
+	i := &defaultImpl{
+		client:  c,
+		decoder: admission.NewDecoder(scheme),
+	}
 	server.Register(
 		"/mutate-v1-pod-binding",
 		&webhook.Admission{
 			Handler: &podBinder{
-				impl: &defaultImpl{
-					client:  c,
-					decoder: admission.NewDecoder(scheme),
-				},
-				log: logf.Log.WithName("binding"),
+				impl: i,
+				log:  logf.Log.WithName("binding"),
+			},
+		},
+	)
+	server.Register(
+		"/validate-v1alpha1-profilebinding",
+		&webhook.Admission{
+			Handler: &profileBindingValidator{
+				impl: i,
+				log:  logf.Log.WithName("binding-policy"),
 			},
 		},
 	)
+	server.Register(
+		"/simulate-v1-pod",
+		&profileSimulator{
+			impl: i,
+			log:  logf.Log.WithName("binding-simulation"),
+		},
+	)
 }
 
 type containerList []*corev1.Container
@@ -91,6 +116,58 @@ func initContainerMap(m *sync.Map, spec *corev1.PodSpec) {
 	}
 }
 
+func initEphemeralContainerMap(m *sync.Map, ephemeralContainers containerList) {
+	for _, ctr := range ephemeralContainers {
+		value, _ := m.LoadOrStore(ctr.Image, containerList{})
+		cList, ok := value.(containerList)
+		if ok {
+			m.Store(ctr.Image, append(cList, ctr))
+		}
+	}
+}
+
+// boundContainers returns the containers to which the profile of the binding
+// has to be applied.
+func boundContainers(
+	containers *sync.Map, ephemeralContainers containerList, spec *profilebindingv1alpha1.ProfileBindingSpec,
+) (containerList, error) {
+	if spec.EphemeralContainers {
+		return ephemeralContainers, nil
+	}
+
+	matchImage, err := newImageMatcher(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var res containerList
+	containers.Range(func(key, value any) bool {
+		image, ok := key.(string)
+		if !ok || !matchImage(image) {
+			return true
+		}
+		if cList, ok := value.(containerList); ok {
+			res = append(res, cList...)
+		}
+		return true
+	})
+	return res, nil
+}
+
+// bindingPredicate returns the predicate selecting the pods to which the
+// binding applies.
+func bindingPredicate(spec *profilebindingv1alpha1.ProfileBindingSpec) (utils.PodPredicate, error) {
+	predicates := []utils.PodPredicate{utils.OwnerPredicate(spec.OwnerSelector)}
+	if spec.PodSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(spec.PodSelector)
+		if err != nil {
+			return nil, fmt.Errorf("convert pod selector: %w", err)
+		}
+		predicates = append(predicates, utils.LabelPredicate(selector))
+	}
+	return utils.AllOf(predicates...), nil
+}
+
 // Security Profiles Operator Webhook RBAC permissions
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch;create;update;patch
@@ -98,6 +175,9 @@ func initContainerMap(m *sync.Map, spec *corev1.PodSpec) {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/finalizers,verbs=delete;get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindingpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create
@@ -120,14 +200,30 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 	podID := req.Namespace + "/" + req.Name
 	pod := &corev1.Pod{}
 
-	var containers sync.Map
+	var (
+		containers          sync.Map
+		ephemeralContainers containerList
+		bindingConfig       = &spodv1alpha1.SPODSpec{}
+		warnings            []string
+	)
 	if req.Operation != "DELETE" {
 		pod, err = p.impl.DecodePod(req)
 		if err != nil {
 			p.log.Error(err, "failed to decode pod")
 			return admission.Errored(http.StatusBadRequest, err)
 		}
-		initContainerMap(&containers, &pod.Spec)
+		bindingConfig, err = p.bindingConfig(ctx)
+		if err != nil {
+			p.log.Error(err, "failed to get binding configuration")
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if req.SubResource == utils.EphemeralContainersSubResource {
+			// Only the added ephemeral containers can be changed
+			ephemeralContainers = utils.NewEphemeralContainers(pod)
+			initEphemeralContainerMap(&containers, ephemeralContainers)
+		} else {
+			initContainerMap(&containers, &pod.Spec)
+		}
 	}
 
 	for i := range profilebindings {
@@ -146,18 +242,25 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 			}
 			continue
 		}
-		value, ok := containers.Load(profilebindings[i].Spec.Image)
-		if !ok {
+		predicate, err := bindingPredicate(&profilebindings[i].Spec)
+		if err != nil {
+			p.log.Error(err, "invalid pod selector", "binding", profilebindings[i].Name)
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if !predicate.Matches(pod) {
 			continue
 		}
-		containers, ok := value.(containerList)
-		if !ok {
+		ctrs, err := boundContainers(&containers, ephemeralContainers, &profilebindings[i].Spec)
+		if err != nil {
+			p.log.Error(err, "invalid image", "binding", profilebindings[i].Name)
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if len(ctrs) == 0 {
 			continue
 		}
 
 		namespacedName := types.NamespacedName{Namespace: req.Namespace, Name: profileName}
-		var bindProfile interface{}
-		var err error
+		var bindProfile client.Object
 
 		if profileKind == profilebindingv1alpha1.ProfileBindingKindSeccompProfile {
 			bindProfile, err = p.getSeccompProfile(ctx, namespacedName)
@@ -172,17 +275,67 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 			return admission.Errored(http.StatusInternalServerError, err)
 		}
 
-		for j := range containers {
-			podChanged = p.addSecurityContext(containers[j], bindProfile)
+		if msg := dryRunMessage(profileKind, bindProfile); msg != "" {
+			p.log.Info("not binding pod "+podID, "reason", msg)
+			warnings = append(warnings, msg)
+			continue
 		}
-		if podChanged {
-			if err := p.addPodToBinding(ctx, podID, &profilebindings[i]); err != nil {
+
+		if enforcesPolicies(&req) {
+			allowed, err := profileAllowed(ctx, p.impl, req.Namespace, bindProfile)
+			if err != nil {
+				p.log.Error(err, "failed to check profile binding policies")
 				return admission.Errored(http.StatusInternalServerError, err)
 			}
+			var msg string
+			if !allowed {
+				msg = notAllowedMessage(profileKind, profileName, req.Namespace)
+			} else if partialMsg, tolerated := partialInstallMessage(
+				profileKind, bindProfile, bindingConfig.BindingPartialInstallTolerance,
+			); partialMsg != "" {
+				if tolerated {
+					warnings = append(warnings, partialMsg)
+				} else {
+					msg = partialMsg
+				}
+			}
+			if msg != "" {
+				if !bindingConfig.BindingAuditMode {
+					return admission.Denied(msg)
+				}
+				p.log.Info("audit mode: would deny pod "+podID, "reason", msg)
+				metricBindingAudit.WithLabelValues(
+					req.Namespace, string(profileKind), profileName, auditResultDenied,
+				).Inc()
+				continue
+			}
+		}
+
+		bound := false
+		for j := range ctrs {
+			if p.addSecurityContext(ctrs[j], pod.Spec.SecurityContext, bindProfile) {
+				bound = true
+			}
+		}
+		if !bound {
+			continue
+		}
+		if bindingConfig.BindingAuditMode {
+			p.log.Info(fmt.Sprintf(
+				"audit mode: would bind pod %s to %s %s", podID, profileKind, profileName,
+			), "binding", profilebindings[i].Name)
+			metricBindingAudit.WithLabelValues(
+				req.Namespace, string(profileKind), profileName, auditResultBound,
+			).Inc()
+			continue
+		}
+		podChanged = true
+		if err := p.addPodToBinding(ctx, podID, &profilebindings[i]); err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
 		}
 	}
 	if !podChanged {
-		return admission.Allowed("pod unchanged")
+		return admission.Allowed("pod unchanged").WithWarnings(warnings...)
 	}
 	marshaledPod, err := json.Marshal(pod)
 	if err != nil {
@@ -190,7 +343,62 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
-	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod).WithWarnings(warnings...)
+}
+
+// bindingConfig returns the cluster wide binding configuration of the SPOD,
+// for example whether the bindings should only be evaluated but not applied.
+func (p *podBinder) bindingConfig(ctx context.Context) (*spodv1alpha1.SPODSpec, error) {
+	spod, err := p.GetSPOd(ctx)
+	if kerrors.IsNotFound(err) {
+		return &spodv1alpha1.SPODSpec{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get spod configuration: %w", err)
+	}
+	return &spod.Spec, nil
+}
+
+// partialInstallMessage returns a message if the profile could not be
+// installed on all nodes, as well as whether the percentage of failed nodes
+// is within the tolerance.
+func partialInstallMessage(
+	kind profilebindingv1alpha1.ProfileBindingKind, profile client.Object, tolerance int32,
+) (msg string, tolerated bool) {
+	prof, ok := profile.(pbv1alpha1.StatusBaseUser)
+	if !ok {
+		return "", true
+	}
+	status := prof.GetStatusBase()
+	if status.Status != secprofnodestatusv1alpha1.ProfileStatePartiallyInstalled {
+		return "", true
+	}
+
+	ratio := status.FailedNodesRatio()
+	msg = fmt.Sprintf(
+		"%s %s is not installed on %d%% of the nodes: %s",
+		kind, profile.GetName(), ratio, strings.Join(status.FailedNodes, ", "),
+	)
+	return msg, ratio <= tolerance
+}
+
+// dryRunMessage returns a message if the profile has only been rendered by
+// daemons running in dry-run mode and therefore cannot be used by pods.
+func dryRunMessage(kind profilebindingv1alpha1.ProfileBindingKind, profile client.Object) string {
+	prof, ok := profile.(pbv1alpha1.StatusBaseUser)
+	if !ok || prof.GetStatusBase().Status != secprofnodestatusv1alpha1.ProfileStateDryRun {
+		return ""
+	}
+	return fmt.Sprintf("%s %s is not bound, because it is not installed in dry-run mode", kind, profile.GetName())
+}
+
+// enforcesPolicies returns true if the profile binding policies have to be
+// enforced for the request. Updates of existing pods are not rejected,
+// because their profiles cannot change anymore.
+//
+//nolint:gocritic
+func enforcesPolicies(req *admission.Request) bool {
+	return req.Operation == "CREATE" || req.SubResource == utils.EphemeralContainersSubResource
 }
 
 func (p *podBinder) getSeccompProfile(
@@ -236,7 +444,7 @@ func (p *podBinder) getSelinuxProfile(
 }
 
 func (p *podBinder) addSecurityContext(
-	c *corev1.Container, bindProfile interface{},
+	c *corev1.Container, podSC *corev1.PodSecurityContext, bindProfile interface{},
 ) bool {
 	var podChanged bool
 
@@ -244,7 +452,7 @@ func (p *podBinder) addSecurityContext(
 	case *seccompprofileapi.SeccompProfile:
 		podChanged = p.addSeccompContext(c, v)
 	case *selinuxprofileapi.SelinuxProfile:
-		podChanged = p.addSelinuxContext(c, v)
+		podChanged = p.addSelinuxContext(c, podSC, v)
 	default:
 		p.log.Info("Unexpected Profile Type")
 		return false
@@ -274,12 +482,13 @@ func (p *podBinder) addSeccompContext(
 }
 
 func (p *podBinder) addSelinuxContext(
-	c *corev1.Container, selinuxProfile *selinuxprofileapi.SelinuxProfile,
+	c *corev1.Container, podSC *corev1.PodSecurityContext, selinuxProfile *selinuxprofileapi.SelinuxProfile,
 ) bool {
 	podChanged := false
 	usage := selinuxProfile.Status.Usage
 	sl := corev1.SELinuxOptions{
-		Type: usage,
+		Type:  usage,
+		Level: selinuxProfileLevel(podSC, selinuxProfile),
 	}
 
 	if c.SecurityContext == nil {
@@ -294,6 +503,22 @@ func (p *podBinder) addSelinuxContext(
 	return podChanged
 }
 
+// selinuxProfileLevel returns the SELinux level for a container bound to the
+// profile. The container level options replace the pod level ones, which
+// means that the categories assigned to the pod have to be inherited
+// explicitly if the profile does not configure any.
+func selinuxProfileLevel(
+	podSC *corev1.PodSecurityContext, selinuxProfile *selinuxprofileapi.SelinuxProfile,
+) string {
+	if level := selinuxProfile.GetPolicyLevel(); level != "" {
+		return level
+	}
+	if podSC != nil && podSC.SELinuxOptions != nil {
+		return podSC.SELinuxOptions.Level
+	}
+	return ""
+}
+
 func (p *podBinder) addPodToBinding(
 	ctx context.Context,
 	podID string,
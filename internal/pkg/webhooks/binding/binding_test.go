@@ -34,9 +34,11 @@ import (
 
 	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding/bindingfakes"
 )
 
@@ -54,6 +56,44 @@ var (
 			},
 		},
 	}
+	testEphemeralPod = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "pod-",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "container",
+				},
+			},
+			EphemeralContainers: []corev1.EphemeralContainer{
+				{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-1"}},
+				{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-2"}},
+			},
+		},
+		Status: corev1.PodStatus{
+			EphemeralContainerStatuses: []corev1.ContainerStatus{{Name: "debugger-1"}},
+		},
+	}
+	testAuditSPOd = &spodv1alpha1.SecurityProfilesOperatorDaemon{
+		Spec: spodv1alpha1.SPODSpec{BindingAuditMode: true},
+	}
+	testPartialProfile = &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+		Status: seccompprofileapi.SeccompProfileStatus{
+			StatusBase: profilebasev1alpha1.StatusBase{
+				Status:         secprofnodestatusv1alpha1.ProfileStatePartiallyInstalled,
+				InstalledNodes: 3,
+				FailedNodes:    []string{"node-1"},
+			},
+		},
+	}
+	testPolicy = &v1alpha1.ProfileBindingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+		Spec: v1alpha1.ProfileBindingPolicySpec{
+			AllowedProfileNames: []string{"allowed"},
+		},
+	}
 )
 
 func TestHandle(t *testing.T) {
@@ -130,6 +170,116 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 1)
 			},
 		},
+		{ // success pod changed by image glob
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+								},
+								Image:     "nginx:*",
+								MatchType: v1alpha1.ImageMatchTypeGlob,
+							},
+						},
+					},
+				}, nil)
+				pod := testPod.DeepCopy()
+				pod.Spec.Containers[0].Image = "nginx:1.25"
+				mock.DecodePodReturns(pod, nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.NotEmpty(t, resp.Patches)
+			},
+		},
+		{ // error invalid image regex
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+								},
+								Image:     "nginx:(1",
+								MatchType: v1alpha1.ImageMatchTypeRegex,
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
+		{ // success pod unchanged because the owner does not match
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+								},
+								OwnerSelector: &profilerecordingv1alpha1.OwnerSelector{
+									APIGroup: "tekton.dev",
+									Kind:     "TaskRun",
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
+		{ // success pod unchanged because the labels do not match
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+								},
+								PodSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": "other"},
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
 		{ // selinux success pod changed
 			prepare: func(mock *bindingfakes.FakeImpl) {
 				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
@@ -168,6 +318,340 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 1)
 			},
 		},
+		{ // success ephemeral container changed
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+								},
+								EphemeralContainers: true,
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testEphemeralPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+				mock.ListProfileBindingPoliciesReturns(&v1alpha1.ProfileBindingPolicyList{}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation:   admissionv1.Update,
+					SubResource: "ephemeralcontainers",
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testEphemeralPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+				require.Equal(t, "/spec/ephemeralContainers/1/securityContext", resp.Patches[0].Path)
+			},
+		},
+		{ // pod creation denied by profile binding policy
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+									Name: "profile",
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+				mock.ListProfileBindingPoliciesReturns(&v1alpha1.ProfileBindingPolicyList{
+					Items: []v1alpha1.ProfileBindingPolicy{*testPolicy.DeepCopy()},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.False(t, resp.Allowed)
+				require.Equal(t, http.StatusForbidden, int(resp.Result.Code))
+				require.Empty(t, resp.Patches)
+			},
+		},
+		{ // success pod unchanged in audit mode
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+				mock.GetSPOdReturns(testAuditSPOd, nil)
+				mock.UpdateResourceStatusReturns(errTest)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+				require.Empty(t, resp.Patches)
+			},
+		},
+		{ // pod creation not denied by profile binding policy in audit mode
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+									Name: "profile",
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+				mock.ListProfileBindingPoliciesReturns(&v1alpha1.ProfileBindingPolicyList{
+					Items: []v1alpha1.ProfileBindingPolicy{*testPolicy.DeepCopy()},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.GetSPOdReturns(testAuditSPOd, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Empty(t, resp.Patches)
+			},
+		},
+		{ // error could not get binding configuration
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSPOdReturns(nil, errTest)
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
+		{ // pod creation denied for partially installed profile
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+									Name: "profile",
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(testPartialProfile.DeepCopy(), nil)
+				mock.ListProfileBindingPoliciesReturns(&v1alpha1.ProfileBindingPolicyList{}, nil)
+				mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.False(t, resp.Allowed)
+				require.Contains(t, resp.Result.Message, "not installed on 25% of the nodes: node-1")
+			},
+		},
+		{ // partially installed profile bound within tolerance
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+									Name: "profile",
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(testPartialProfile.DeepCopy(), nil)
+				mock.ListProfileBindingPoliciesReturns(&v1alpha1.ProfileBindingPolicyList{}, nil)
+				mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{
+					Spec: spodv1alpha1.SPODSpec{BindingPartialInstallTolerance: 25},
+				}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Len(t, resp.Patches, 1)
+				require.Len(t, resp.Warnings, 1)
+			},
+		},
+		{ // profile rendered in dry-run mode is not bound
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+									Name: "profile",
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateDryRun,
+						},
+					},
+				}, nil)
+				mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+				require.Equal(t, []string{
+					"SeccompProfile profile is not bound, because it is not installed in dry-run mode",
+				}, resp.Warnings)
+			},
+		},
+		{ // error could not list profile binding policies
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+				mock.ListProfileBindingPoliciesReturns(nil, errTest)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
+		{ // success ephemeral container binding ignores regular containers
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+								},
+								EphemeralContainers: true,
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
 		{ // success unsupported kind
 			prepare: func(mock *bindingfakes.FakeImpl) {
 				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
@@ -405,6 +889,7 @@ func TestHandle(t *testing.T) {
 		},
 	} {
 		mock := &bindingfakes.FakeImpl{}
+		mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{}, nil)
 		tc.prepare(mock)
 
 		binder := podBinder{impl: mock, log: logr.Discard()}
@@ -558,3 +1043,48 @@ func TestNewContainerMap(t *testing.T) {
 		})
 	}
 }
+
+func TestSelinuxProfileLevel(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		podSC   *corev1.PodSecurityContext
+		profile *selinuxprofileapi.SelinuxProfile
+		want    string
+	}{
+		{
+			name:    "no categories",
+			profile: &selinuxprofileapi.SelinuxProfile{},
+			want:    "",
+		},
+		{
+			name: "profile categories",
+			podSC: &corev1.PodSecurityContext{
+				SELinuxOptions: &corev1.SELinuxOptions{Level: "s0:c1,c2"},
+			},
+			profile: &selinuxprofileapi.SelinuxProfile{
+				Spec: selinuxprofileapi.SelinuxProfileSpec{
+					Categories: []selinuxprofileapi.MCSCategory{"c123", "c456"},
+				},
+			},
+			want: "s0:c123,c456",
+		},
+		{
+			name: "inherit pod categories",
+			podSC: &corev1.PodSecurityContext{
+				SELinuxOptions: &corev1.SELinuxOptions{Level: "s0:c1,c2"},
+			},
+			profile: &selinuxprofileapi.SelinuxProfile{},
+			want:    "s0:c1,c2",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, selinuxProfileLevel(tc.podSC, tc.profile))
+		})
+	}
+}
@@ -22,27 +22,84 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
-	v1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/authentication/v1"
+	v1a "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	"sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	v1alpha1a "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 )
 
 type FakeImpl struct {
-	DecodePodStub        func(admission.Request) (*v1.Pod, error)
+	CanGetSeccompProfilesStub        func(context.Context, *v1.UserInfo, string) (bool, error)
+	canGetSeccompProfilesMutex       sync.RWMutex
+	canGetSeccompProfilesArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1.UserInfo
+		arg3 string
+	}
+	canGetSeccompProfilesReturns struct {
+		result1 bool
+		result2 error
+	}
+	canGetSeccompProfilesReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DecodePodStub        func(admission.Request) (*v1a.Pod, error)
 	decodePodMutex       sync.RWMutex
 	decodePodArgsForCall []struct {
 		arg1 admission.Request
 	}
 	decodePodReturns struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
 		result2 error
 	}
 	decodePodReturnsOnCall map[int]struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
+		result2 error
+	}
+	DecodeProfileBindingStub        func(admission.Request) (*v1alpha1.ProfileBinding, error)
+	decodeProfileBindingMutex       sync.RWMutex
+	decodeProfileBindingArgsForCall []struct {
+		arg1 admission.Request
+	}
+	decodeProfileBindingReturns struct {
+		result1 *v1alpha1.ProfileBinding
+		result2 error
+	}
+	decodeProfileBindingReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ProfileBinding
+		result2 error
+	}
+	GetNamespaceStub        func(context.Context, string) (*v1a.Namespace, error)
+	getNamespaceMutex       sync.RWMutex
+	getNamespaceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNamespaceReturns struct {
+		result1 *v1a.Namespace
+		result2 error
+	}
+	getNamespaceReturnsOnCall map[int]struct {
+		result1 *v1a.Namespace
+		result2 error
+	}
+	GetSPOdStub        func(context.Context) (*v1alpha1a.SecurityProfilesOperatorDaemon, error)
+	getSPOdMutex       sync.RWMutex
+	getSPOdArgsForCall []struct {
+		arg1 context.Context
+	}
+	getSPOdReturns struct {
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	getSPOdReturnsOnCall map[int]struct {
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
 		result2 error
 	}
 	GetSeccompProfileStub        func(context.Context, types.NamespacedName) (*v1beta1.SeccompProfile, error)
@@ -73,6 +130,19 @@ type FakeImpl struct {
 		result1 *v1alpha2.SelinuxProfile
 		result2 error
 	}
+	ListProfileBindingPoliciesStub        func(context.Context) (*v1alpha1.ProfileBindingPolicyList, error)
+	listProfileBindingPoliciesMutex       sync.RWMutex
+	listProfileBindingPoliciesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listProfileBindingPoliciesReturns struct {
+		result1 *v1alpha1.ProfileBindingPolicyList
+		result2 error
+	}
+	listProfileBindingPoliciesReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ProfileBindingPolicyList
+		result2 error
+	}
 	ListProfileBindingsStub        func(context.Context, ...client.ListOption) (*v1alpha1.ProfileBindingList, error)
 	listProfileBindingsMutex       sync.RWMutex
 	listProfileBindingsArgsForCall []struct {
@@ -87,6 +157,22 @@ type FakeImpl struct {
 		result1 *v1alpha1.ProfileBindingList
 		result2 error
 	}
+	ReviewTokenStub        func(context.Context, string) (*v1.UserInfo, bool, error)
+	reviewTokenMutex       sync.RWMutex
+	reviewTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	reviewTokenReturns struct {
+		result1 *v1.UserInfo
+		result2 bool
+		result3 error
+	}
+	reviewTokenReturnsOnCall map[int]struct {
+		result1 *v1.UserInfo
+		result2 bool
+		result3 error
+	}
 	UpdateResourceStub        func(context.Context, logr.Logger, client.Object, string) error
 	updateResourceMutex       sync.RWMutex
 	updateResourceArgsForCall []struct {
@@ -119,7 +205,73 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeImpl) DecodePod(arg1 admission.Request) (*v1.Pod, error) {
+func (fake *FakeImpl) CanGetSeccompProfiles(arg1 context.Context, arg2 *v1.UserInfo, arg3 string) (bool, error) {
+	fake.canGetSeccompProfilesMutex.Lock()
+	ret, specificReturn := fake.canGetSeccompProfilesReturnsOnCall[len(fake.canGetSeccompProfilesArgsForCall)]
+	fake.canGetSeccompProfilesArgsForCall = append(fake.canGetSeccompProfilesArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1.UserInfo
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CanGetSeccompProfilesStub
+	fakeReturns := fake.canGetSeccompProfilesReturns
+	fake.recordInvocation("CanGetSeccompProfiles", []interface{}{arg1, arg2, arg3})
+	fake.canGetSeccompProfilesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) CanGetSeccompProfilesCallCount() int {
+	fake.canGetSeccompProfilesMutex.RLock()
+	defer fake.canGetSeccompProfilesMutex.RUnlock()
+	return len(fake.canGetSeccompProfilesArgsForCall)
+}
+
+func (fake *FakeImpl) CanGetSeccompProfilesCalls(stub func(context.Context, *v1.UserInfo, string) (bool, error)) {
+	fake.canGetSeccompProfilesMutex.Lock()
+	defer fake.canGetSeccompProfilesMutex.Unlock()
+	fake.CanGetSeccompProfilesStub = stub
+}
+
+func (fake *FakeImpl) CanGetSeccompProfilesArgsForCall(i int) (context.Context, *v1.UserInfo, string) {
+	fake.canGetSeccompProfilesMutex.RLock()
+	defer fake.canGetSeccompProfilesMutex.RUnlock()
+	argsForCall := fake.canGetSeccompProfilesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) CanGetSeccompProfilesReturns(result1 bool, result2 error) {
+	fake.canGetSeccompProfilesMutex.Lock()
+	defer fake.canGetSeccompProfilesMutex.Unlock()
+	fake.CanGetSeccompProfilesStub = nil
+	fake.canGetSeccompProfilesReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) CanGetSeccompProfilesReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.canGetSeccompProfilesMutex.Lock()
+	defer fake.canGetSeccompProfilesMutex.Unlock()
+	fake.CanGetSeccompProfilesStub = nil
+	if fake.canGetSeccompProfilesReturnsOnCall == nil {
+		fake.canGetSeccompProfilesReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.canGetSeccompProfilesReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodePod(arg1 admission.Request) (*v1a.Pod, error) {
 	fake.decodePodMutex.Lock()
 	ret, specificReturn := fake.decodePodReturnsOnCall[len(fake.decodePodArgsForCall)]
 	fake.decodePodArgsForCall = append(fake.decodePodArgsForCall, struct {
@@ -144,7 +296,7 @@ func (fake *FakeImpl) DecodePodCallCount() int {
 	return len(fake.decodePodArgsForCall)
 }
 
-func (fake *FakeImpl) DecodePodCalls(stub func(admission.Request) (*v1.Pod, error)) {
+func (fake *FakeImpl) DecodePodCalls(stub func(admission.Request) (*v1a.Pod, error)) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = stub
@@ -157,28 +309,221 @@ func (fake *FakeImpl) DecodePodArgsForCall(i int) admission.Request {
 	return argsForCall.arg1
 }
 
-func (fake *FakeImpl) DecodePodReturns(result1 *v1.Pod, result2 error) {
+func (fake *FakeImpl) DecodePodReturns(result1 *v1a.Pod, result2 error) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = nil
 	fake.decodePodReturns = struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) DecodePodReturnsOnCall(i int, result1 *v1.Pod, result2 error) {
+func (fake *FakeImpl) DecodePodReturnsOnCall(i int, result1 *v1a.Pod, result2 error) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = nil
 	if fake.decodePodReturnsOnCall == nil {
 		fake.decodePodReturnsOnCall = make(map[int]struct {
-			result1 *v1.Pod
+			result1 *v1a.Pod
 			result2 error
 		})
 	}
 	fake.decodePodReturnsOnCall[i] = struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeProfileBinding(arg1 admission.Request) (*v1alpha1.ProfileBinding, error) {
+	fake.decodeProfileBindingMutex.Lock()
+	ret, specificReturn := fake.decodeProfileBindingReturnsOnCall[len(fake.decodeProfileBindingArgsForCall)]
+	fake.decodeProfileBindingArgsForCall = append(fake.decodeProfileBindingArgsForCall, struct {
+		arg1 admission.Request
+	}{arg1})
+	stub := fake.DecodeProfileBindingStub
+	fakeReturns := fake.decodeProfileBindingReturns
+	fake.recordInvocation("DecodeProfileBinding", []interface{}{arg1})
+	fake.decodeProfileBindingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) DecodeProfileBindingCallCount() int {
+	fake.decodeProfileBindingMutex.RLock()
+	defer fake.decodeProfileBindingMutex.RUnlock()
+	return len(fake.decodeProfileBindingArgsForCall)
+}
+
+func (fake *FakeImpl) DecodeProfileBindingCalls(stub func(admission.Request) (*v1alpha1.ProfileBinding, error)) {
+	fake.decodeProfileBindingMutex.Lock()
+	defer fake.decodeProfileBindingMutex.Unlock()
+	fake.DecodeProfileBindingStub = stub
+}
+
+func (fake *FakeImpl) DecodeProfileBindingArgsForCall(i int) admission.Request {
+	fake.decodeProfileBindingMutex.RLock()
+	defer fake.decodeProfileBindingMutex.RUnlock()
+	argsForCall := fake.decodeProfileBindingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) DecodeProfileBindingReturns(result1 *v1alpha1.ProfileBinding, result2 error) {
+	fake.decodeProfileBindingMutex.Lock()
+	defer fake.decodeProfileBindingMutex.Unlock()
+	fake.DecodeProfileBindingStub = nil
+	fake.decodeProfileBindingReturns = struct {
+		result1 *v1alpha1.ProfileBinding
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeProfileBindingReturnsOnCall(i int, result1 *v1alpha1.ProfileBinding, result2 error) {
+	fake.decodeProfileBindingMutex.Lock()
+	defer fake.decodeProfileBindingMutex.Unlock()
+	fake.DecodeProfileBindingStub = nil
+	if fake.decodeProfileBindingReturnsOnCall == nil {
+		fake.decodeProfileBindingReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ProfileBinding
+			result2 error
+		})
+	}
+	fake.decodeProfileBindingReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ProfileBinding
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespace(arg1 context.Context, arg2 string) (*v1a.Namespace, error) {
+	fake.getNamespaceMutex.Lock()
+	ret, specificReturn := fake.getNamespaceReturnsOnCall[len(fake.getNamespaceArgsForCall)]
+	fake.getNamespaceArgsForCall = append(fake.getNamespaceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNamespaceStub
+	fakeReturns := fake.getNamespaceReturns
+	fake.recordInvocation("GetNamespace", []interface{}{arg1, arg2})
+	fake.getNamespaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetNamespaceCallCount() int {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	return len(fake.getNamespaceArgsForCall)
+}
+
+func (fake *FakeImpl) GetNamespaceCalls(stub func(context.Context, string) (*v1a.Namespace, error)) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = stub
+}
+
+func (fake *FakeImpl) GetNamespaceArgsForCall(i int) (context.Context, string) {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	argsForCall := fake.getNamespaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetNamespaceReturns(result1 *v1a.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	fake.getNamespaceReturns = struct {
+		result1 *v1a.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespaceReturnsOnCall(i int, result1 *v1a.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	if fake.getNamespaceReturnsOnCall == nil {
+		fake.getNamespaceReturnsOnCall = make(map[int]struct {
+			result1 *v1a.Namespace
+			result2 error
+		})
+	}
+	fake.getNamespaceReturnsOnCall[i] = struct {
+		result1 *v1a.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOd(arg1 context.Context) (*v1alpha1a.SecurityProfilesOperatorDaemon, error) {
+	fake.getSPOdMutex.Lock()
+	ret, specificReturn := fake.getSPOdReturnsOnCall[len(fake.getSPOdArgsForCall)]
+	fake.getSPOdArgsForCall = append(fake.getSPOdArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetSPOdStub
+	fakeReturns := fake.getSPOdReturns
+	fake.recordInvocation("GetSPOd", []interface{}{arg1})
+	fake.getSPOdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSPOdCallCount() int {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	return len(fake.getSPOdArgsForCall)
+}
+
+func (fake *FakeImpl) GetSPOdCalls(stub func(context.Context) (*v1alpha1a.SecurityProfilesOperatorDaemon, error)) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = stub
+}
+
+func (fake *FakeImpl) GetSPOdArgsForCall(i int) context.Context {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	argsForCall := fake.getSPOdArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetSPOdReturns(result1 *v1alpha1a.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	fake.getSPOdReturns = struct {
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOdReturnsOnCall(i int, result1 *v1alpha1a.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	if fake.getSPOdReturnsOnCall == nil {
+		fake.getSPOdReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1a.SecurityProfilesOperatorDaemon
+			result2 error
+		})
+	}
+	fake.getSPOdReturnsOnCall[i] = struct {
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
 		result2 error
 	}{result1, result2}
 }
@@ -313,6 +658,70 @@ func (fake *FakeImpl) GetSelinuxProfileReturnsOnCall(i int, result1 *v1alpha2.Se
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ListProfileBindingPolicies(arg1 context.Context) (*v1alpha1.ProfileBindingPolicyList, error) {
+	fake.listProfileBindingPoliciesMutex.Lock()
+	ret, specificReturn := fake.listProfileBindingPoliciesReturnsOnCall[len(fake.listProfileBindingPoliciesArgsForCall)]
+	fake.listProfileBindingPoliciesArgsForCall = append(fake.listProfileBindingPoliciesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListProfileBindingPoliciesStub
+	fakeReturns := fake.listProfileBindingPoliciesReturns
+	fake.recordInvocation("ListProfileBindingPolicies", []interface{}{arg1})
+	fake.listProfileBindingPoliciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListProfileBindingPoliciesCallCount() int {
+	fake.listProfileBindingPoliciesMutex.RLock()
+	defer fake.listProfileBindingPoliciesMutex.RUnlock()
+	return len(fake.listProfileBindingPoliciesArgsForCall)
+}
+
+func (fake *FakeImpl) ListProfileBindingPoliciesCalls(stub func(context.Context) (*v1alpha1.ProfileBindingPolicyList, error)) {
+	fake.listProfileBindingPoliciesMutex.Lock()
+	defer fake.listProfileBindingPoliciesMutex.Unlock()
+	fake.ListProfileBindingPoliciesStub = stub
+}
+
+func (fake *FakeImpl) ListProfileBindingPoliciesArgsForCall(i int) context.Context {
+	fake.listProfileBindingPoliciesMutex.RLock()
+	defer fake.listProfileBindingPoliciesMutex.RUnlock()
+	argsForCall := fake.listProfileBindingPoliciesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ListProfileBindingPoliciesReturns(result1 *v1alpha1.ProfileBindingPolicyList, result2 error) {
+	fake.listProfileBindingPoliciesMutex.Lock()
+	defer fake.listProfileBindingPoliciesMutex.Unlock()
+	fake.ListProfileBindingPoliciesStub = nil
+	fake.listProfileBindingPoliciesReturns = struct {
+		result1 *v1alpha1.ProfileBindingPolicyList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListProfileBindingPoliciesReturnsOnCall(i int, result1 *v1alpha1.ProfileBindingPolicyList, result2 error) {
+	fake.listProfileBindingPoliciesMutex.Lock()
+	defer fake.listProfileBindingPoliciesMutex.Unlock()
+	fake.ListProfileBindingPoliciesStub = nil
+	if fake.listProfileBindingPoliciesReturnsOnCall == nil {
+		fake.listProfileBindingPoliciesReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ProfileBindingPolicyList
+			result2 error
+		})
+	}
+	fake.listProfileBindingPoliciesReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ProfileBindingPolicyList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) ListProfileBindings(arg1 context.Context, arg2 ...client.ListOption) (*v1alpha1.ProfileBindingList, error) {
 	fake.listProfileBindingsMutex.Lock()
 	ret, specificReturn := fake.listProfileBindingsReturnsOnCall[len(fake.listProfileBindingsArgsForCall)]
@@ -378,6 +787,74 @@ func (fake *FakeImpl) ListProfileBindingsReturnsOnCall(i int, result1 *v1alpha1.
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ReviewToken(arg1 context.Context, arg2 string) (*v1.UserInfo, bool, error) {
+	fake.reviewTokenMutex.Lock()
+	ret, specificReturn := fake.reviewTokenReturnsOnCall[len(fake.reviewTokenArgsForCall)]
+	fake.reviewTokenArgsForCall = append(fake.reviewTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReviewTokenStub
+	fakeReturns := fake.reviewTokenReturns
+	fake.recordInvocation("ReviewToken", []interface{}{arg1, arg2})
+	fake.reviewTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeImpl) ReviewTokenCallCount() int {
+	fake.reviewTokenMutex.RLock()
+	defer fake.reviewTokenMutex.RUnlock()
+	return len(fake.reviewTokenArgsForCall)
+}
+
+func (fake *FakeImpl) ReviewTokenCalls(stub func(context.Context, string) (*v1.UserInfo, bool, error)) {
+	fake.reviewTokenMutex.Lock()
+	defer fake.reviewTokenMutex.Unlock()
+	fake.ReviewTokenStub = stub
+}
+
+func (fake *FakeImpl) ReviewTokenArgsForCall(i int) (context.Context, string) {
+	fake.reviewTokenMutex.RLock()
+	defer fake.reviewTokenMutex.RUnlock()
+	argsForCall := fake.reviewTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) ReviewTokenReturns(result1 *v1.UserInfo, result2 bool, result3 error) {
+	fake.reviewTokenMutex.Lock()
+	defer fake.reviewTokenMutex.Unlock()
+	fake.ReviewTokenStub = nil
+	fake.reviewTokenReturns = struct {
+		result1 *v1.UserInfo
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeImpl) ReviewTokenReturnsOnCall(i int, result1 *v1.UserInfo, result2 bool, result3 error) {
+	fake.reviewTokenMutex.Lock()
+	defer fake.reviewTokenMutex.Unlock()
+	fake.ReviewTokenStub = nil
+	if fake.reviewTokenReturnsOnCall == nil {
+		fake.reviewTokenReturnsOnCall = make(map[int]struct {
+			result1 *v1.UserInfo
+			result2 bool
+			result3 error
+		})
+	}
+	fake.reviewTokenReturnsOnCall[i] = struct {
+		result1 *v1.UserInfo
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeImpl) UpdateResource(arg1 context.Context, arg2 logr.Logger, arg3 client.Object, arg4 string) error {
 	fake.updateResourceMutex.Lock()
 	ret, specificReturn := fake.updateResourceReturnsOnCall[len(fake.updateResourceArgsForCall)]
@@ -509,14 +986,26 @@ func (fake *FakeImpl) UpdateResourceStatusReturnsOnCall(i int, result1 error) {
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.canGetSeccompProfilesMutex.RLock()
+	defer fake.canGetSeccompProfilesMutex.RUnlock()
 	fake.decodePodMutex.RLock()
 	defer fake.decodePodMutex.RUnlock()
+	fake.decodeProfileBindingMutex.RLock()
+	defer fake.decodeProfileBindingMutex.RUnlock()
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
 	fake.getSeccompProfileMutex.RLock()
 	defer fake.getSeccompProfileMutex.RUnlock()
 	fake.getSelinuxProfileMutex.RLock()
 	defer fake.getSelinuxProfileMutex.RUnlock()
+	fake.listProfileBindingPoliciesMutex.RLock()
+	defer fake.listProfileBindingPoliciesMutex.RUnlock()
 	fake.listProfileBindingsMutex.RLock()
 	defer fake.listProfileBindingsMutex.RUnlock()
+	fake.reviewTokenMutex.RLock()
+	defer fake.reviewTokenMutex.RUnlock()
 	fake.updateResourceMutex.RLock()
 	defer fake.updateResourceMutex.RUnlock()
 	fake.updateResourceStatusMutex.RLock()
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+)
+
+var errUnknownMatchType = errors.New("unknown image match type")
+
+// imageMatcher returns true if the image of a container is selected by a
+// binding.
+type imageMatcher func(image string) bool
+
+// newImageMatcher returns the image matcher for the binding, or an error if
+// the image pattern of the binding is invalid.
+func newImageMatcher(spec *profilebindingv1alpha1.ProfileBindingSpec) (imageMatcher, error) {
+	var matchName imageMatcher
+	switch spec.MatchType {
+	case profilebindingv1alpha1.ImageMatchTypeExact, "":
+		matchName = func(name string) bool {
+			return name == spec.Image
+		}
+	case profilebindingv1alpha1.ImageMatchTypeGlob:
+		if _, err := path.Match(spec.Image, ""); err != nil {
+			return nil, fmt.Errorf("invalid image glob %q: %w", spec.Image, err)
+		}
+		matchName = func(name string) bool {
+			matched, err := path.Match(spec.Image, name)
+			return err == nil && matched
+		}
+	case profilebindingv1alpha1.ImageMatchTypeRegex:
+		re, err := regexp.Compile("^(?:" + spec.Image + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid image regex %q: %w", spec.Image, err)
+		}
+		matchName = re.MatchString
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownMatchType, spec.MatchType)
+	}
+
+	if spec.Digest == "" {
+		return matchName, nil
+	}
+	return func(image string) bool {
+		name, digest, found := strings.Cut(image, "@")
+		return found && digest == spec.Digest && matchName(name)
+	}, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+)
+
+func TestNewImageMatcher(t *testing.T) {
+	t.Parallel()
+
+	const digest = "sha256:9a7e"
+
+	for _, tc := range []struct {
+		name       string
+		spec       v1alpha1.ProfileBindingSpec
+		matches    []string
+		notMatches []string
+		shouldErr  bool
+	}{
+		{
+			name:       "exact",
+			spec:       v1alpha1.ProfileBindingSpec{Image: "nginx:1.25"},
+			matches:    []string{"nginx:1.25"},
+			notMatches: []string{"nginx:1.24", "nginx", "nginx:1.25@" + digest},
+		},
+		{
+			name: "glob",
+			spec: v1alpha1.ProfileBindingSpec{
+				Image: "registry.example.com/app:*", MatchType: v1alpha1.ImageMatchTypeGlob,
+			},
+			matches:    []string{"registry.example.com/app:v1", "registry.example.com/app:latest"},
+			notMatches: []string{"registry.example.com/other:v1", "registry.example.com/app/sub:v1"},
+		},
+		{
+			name: "invalid glob",
+			spec: v1alpha1.ProfileBindingSpec{
+				Image: "nginx:[", MatchType: v1alpha1.ImageMatchTypeGlob,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "regex",
+			spec: v1alpha1.ProfileBindingSpec{
+				Image: `nginx:1\.2[45]`, MatchType: v1alpha1.ImageMatchTypeRegex,
+			},
+			matches:    []string{"nginx:1.24", "nginx:1.25"},
+			notMatches: []string{"nginx:1.26", "my-nginx:1.25", "nginx:1.25-alpine"},
+		},
+		{
+			name: "invalid regex",
+			spec: v1alpha1.ProfileBindingSpec{
+				Image: "nginx:(1", MatchType: v1alpha1.ImageMatchTypeRegex,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "unknown match type",
+			spec: v1alpha1.ProfileBindingSpec{
+				Image: "nginx", MatchType: "Fuzzy",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "glob with digest",
+			spec: v1alpha1.ProfileBindingSpec{
+				Image: "nginx:*", MatchType: v1alpha1.ImageMatchTypeGlob, Digest: digest,
+			},
+			matches:    []string{"nginx:1.25@" + digest, "nginx:latest@" + digest},
+			notMatches: []string{"nginx:1.25", "nginx:1.25@sha256:0000"},
+		},
+		{
+			name:       "exact with digest",
+			spec:       v1alpha1.ProfileBindingSpec{Image: "nginx:1.25", Digest: digest},
+			matches:    []string{"nginx:1.25@" + digest},
+			notMatches: []string{"nginx:1.25", "nginx:1.24@" + digest},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			matchImage, err := newImageMatcher(&tc.spec)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for _, image := range tc.matches {
+				require.True(t, matchImage(image), image)
+			}
+			for _, image := range tc.notMatches {
+				require.False(t, matchImage(image), image)
+			}
+		})
+	}
+}
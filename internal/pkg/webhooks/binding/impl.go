@@ -21,6 +21,8 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,6 +31,8 @@ import (
 	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
 
@@ -44,8 +48,14 @@ type impl interface {
 	UpdateResource(context.Context, logr.Logger, client.Object, string) error
 	UpdateResourceStatus(context.Context, logr.Logger, client.Object, string) error
 	DecodePod(admission.Request) (*corev1.Pod, error)
+	DecodeProfileBinding(admission.Request) (*v1alpha1.ProfileBinding, error)
+	GetNamespace(context.Context, string) (*corev1.Namespace, error)
+	ListProfileBindingPolicies(context.Context) (*v1alpha1.ProfileBindingPolicyList, error)
+	GetSPOd(context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error)
 	GetSeccompProfile(context.Context, types.NamespacedName) (*seccompprofileapi.SeccompProfile, error)
 	GetSelinuxProfile(context.Context, types.NamespacedName) (*selinuxprofileapi.SelinuxProfile, error)
+	ReviewToken(context.Context, string) (*authenticationv1.UserInfo, bool, error)
+	CanGetSeccompProfiles(context.Context, *authenticationv1.UserInfo, string) (bool, error)
 }
 
 func (d *defaultImpl) ListProfileBindings(
@@ -85,6 +95,42 @@ func (d *defaultImpl) DecodePod(req admission.Request) (*corev1.Pod, error) {
 	return pod, nil
 }
 
+//nolint:gocritic
+func (d *defaultImpl) DecodeProfileBinding(req admission.Request) (*v1alpha1.ProfileBinding, error) {
+	profileBinding := &v1alpha1.ProfileBinding{}
+	if err := d.decoder.Decode(req, profileBinding); err != nil {
+		return nil, fmt.Errorf("decode profile binding: %w", err)
+	}
+	return profileBinding, nil
+}
+
+func (d *defaultImpl) GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
+	namespace := &corev1.Namespace{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		return nil, fmt.Errorf("get namespace: %w", err)
+	}
+	return namespace, nil
+}
+
+func (d *defaultImpl) ListProfileBindingPolicies(
+	ctx context.Context,
+) (*v1alpha1.ProfileBindingPolicyList, error) {
+	policies := &v1alpha1.ProfileBindingPolicyList{}
+	if err := d.client.List(ctx, policies); err != nil {
+		return nil, fmt.Errorf("list profile binding policies: %w", err)
+	}
+	return policies, nil
+}
+
+func (d *defaultImpl) GetSPOd(ctx context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Namespace: config.GetOperatorNamespace(), Name: config.SPOdName}
+	if err := d.client.Get(ctx, key, spod); err != nil {
+		return nil, fmt.Errorf("get spod: %w", err)
+	}
+	return spod, nil
+}
+
 func (d *defaultImpl) GetSeccompProfile(
 	ctx context.Context, key types.NamespacedName,
 ) (*seccompprofileapi.SeccompProfile, error) {
@@ -105,3 +151,41 @@ func (d *defaultImpl) GetSelinuxProfile(
 	}
 	return selinuxProfile, nil
 }
+
+func (d *defaultImpl) ReviewToken(ctx context.Context, token string) (*authenticationv1.UserInfo, bool, error) {
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := d.client.Create(ctx, review); err != nil {
+		return nil, false, fmt.Errorf("create token review: %w", err)
+	}
+	return &review.Status.User, review.Status.Authenticated, nil
+}
+
+func (d *defaultImpl) CanGetSeccompProfiles(
+	ctx context.Context, user *authenticationv1.UserInfo, namespace string,
+) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "get",
+				Group:     seccompprofileapi.GroupVersion.Group,
+				Resource:  "seccompprofiles",
+			},
+		},
+	}
+	if err := d.client.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("create subject access review: %w", err)
+	}
+	return review.Status.Allowed, nil
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// auditResultBound indicates that a pod would have been bound to a
+	// profile if the audit mode was disabled.
+	auditResultBound = "bound"
+	// auditResultDenied indicates that a pod would have been rejected because
+	// its profile is not allowed by any ProfileBindingPolicy.
+	auditResultDenied = "denied"
+)
+
+// metricBindingAudit counts the bindings evaluated in audit mode.
+var metricBindingAudit = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "spo",
+		Name:      "binding_audit_total",
+		Help:      "Counter about profile bindings which were evaluated but not applied in audit mode.",
+	},
+	[]string{"namespace", "kind", "profile", "result"},
+)
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
+)
+
+type profileBindingValidator struct {
+	impl
+	log logr.Logger
+}
+
+//nolint:gocritic
+func (v *profileBindingValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation == "DELETE" {
+		return admission.Allowed("profile binding deleted")
+	}
+
+	profileBinding, err := v.DecodeProfileBinding(req)
+	if err != nil {
+		v.log.Error(err, "failed to decode profile binding")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if _, err := newImageMatcher(&profileBinding.Spec); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	profile, err := v.boundProfile(ctx, req.Namespace, &profileBinding.Spec.ProfileRef)
+	if err != nil {
+		v.log.Error(err, "failed to get bound profile")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	allowed, err := profileAllowed(ctx, v.impl, req.Namespace, profile)
+	if err != nil {
+		v.log.Error(err, "failed to check profile binding policies")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if !allowed {
+		return admission.Denied(notAllowedMessage(profileBinding.Spec.ProfileRef.Kind, profile.GetName(), req.Namespace))
+	}
+	return admission.Allowed("profile allowed")
+}
+
+// boundProfile returns the profile referenced by a binding. Profiles which do
+// not exist yet can only be checked by their name.
+func (v *profileBindingValidator) boundProfile(
+	ctx context.Context, namespace string, ref *profilebindingv1alpha1.ProfileRef,
+) (metav1.Object, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: ref.Name}
+
+	var (
+		profile metav1.Object
+		err     error
+	)
+	switch ref.Kind {
+	case profilebindingv1alpha1.ProfileBindingKindSeccompProfile:
+		profile, err = v.GetSeccompProfile(ctx, key)
+	case profilebindingv1alpha1.ProfileBindingKindSelinuxProfile:
+		profile, err = v.GetSelinuxProfile(ctx, key)
+	default:
+		return &metav1.ObjectMeta{Name: ref.Name}, nil
+	}
+
+	if kerrors.IsNotFound(err) {
+		return &metav1.ObjectMeta{Name: ref.Name}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get %s %s: %w", ref.Kind, key, err)
+	}
+	return profile, nil
+}
+
+// profileAllowed checks if the profile binding policies allow to bind the
+// profile in the namespace.
+func profileAllowed(ctx context.Context, i impl, namespace string, profile metav1.Object) (bool, error) {
+	policies, err := i.ListProfileBindingPolicies(ctx)
+	if err != nil {
+		return false, fmt.Errorf("list profile binding policies: %w", err)
+	}
+	if len(policies.Items) == 0 {
+		return true, nil
+	}
+
+	ns, err := i.GetNamespace(ctx, namespace)
+	if err != nil {
+		return false, fmt.Errorf("get namespace %s: %w", namespace, err)
+	}
+
+	allowed, err := utils.ProfileAllowed(ns, profile, policies.Items)
+	if err != nil {
+		return false, fmt.Errorf("check profile binding policies: %w", err)
+	}
+	return allowed, nil
+}
+
+func notAllowedMessage(kind profilebindingv1alpha1.ProfileBindingKind, name, namespace string) string {
+	return fmt.Sprintf(
+		"%s %s is not allowed to be bound in namespace %s by any ProfileBindingPolicy",
+		kind, name, namespace,
+	)
+}
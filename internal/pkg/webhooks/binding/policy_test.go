@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding/bindingfakes"
+)
+
+func TestValidateProfileBinding(t *testing.T) {
+	t.Parallel()
+
+	profileBinding := func(name string) *v1alpha1.ProfileBinding {
+		return &v1alpha1.ProfileBinding{
+			Spec: v1alpha1.ProfileBindingSpec{
+				ProfileRef: v1alpha1.ProfileRef{
+					Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+					Name: name,
+				},
+			},
+		}
+	}
+	policies := &v1alpha1.ProfileBindingPolicyList{
+		Items: []v1alpha1.ProfileBindingPolicy{*testPolicy.DeepCopy()},
+	}
+	notFound := kerrors.NewNotFound(schema.GroupResource{Resource: "seccompprofiles"}, "profile")
+
+	for _, tc := range []struct {
+		name      string
+		prepare   func(*bindingfakes.FakeImpl)
+		operation admissionv1.Operation
+		allowed   bool
+		code      int32
+	}{
+		{
+			name: "no policies",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.DecodeProfileBindingReturns(profileBinding("profile"), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				}, nil)
+				mock.ListProfileBindingPoliciesReturns(&v1alpha1.ProfileBindingPolicyList{}, nil)
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "profile allowed",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.DecodeProfileBindingReturns(profileBinding("allowed"), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "allowed"},
+				}, nil)
+				mock.ListProfileBindingPoliciesReturns(policies, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+			},
+			operation: admissionv1.Update,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "profile not allowed",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.DecodeProfileBindingReturns(profileBinding("profile"), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				}, nil)
+				mock.ListProfileBindingPoliciesReturns(policies, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "missing profile checked by name",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.DecodeProfileBindingReturns(profileBinding("profile"), nil)
+				mock.GetSeccompProfileReturns(nil, notFound)
+				mock.ListProfileBindingPoliciesReturns(policies, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "invalid image regex",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				binding := profileBinding("profile")
+				binding.Spec.Image = "nginx:(1"
+				binding.Spec.MatchType = v1alpha1.ImageMatchTypeRegex
+				mock.DecodeProfileBindingReturns(binding, nil)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name:      "deletion allowed",
+			prepare:   func(*bindingfakes.FakeImpl) {},
+			operation: admissionv1.Delete,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "error decode profile binding",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.DecodeProfileBindingReturns(nil, errTest)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusBadRequest,
+		},
+		{
+			name: "error get profile",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.DecodeProfileBindingReturns(profileBinding("profile"), nil)
+				mock.GetSeccompProfileReturns(nil, errTest)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusInternalServerError,
+		},
+		{
+			name: "error get namespace",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.DecodeProfileBindingReturns(profileBinding("profile"), nil)
+				mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{}, nil)
+				mock.ListProfileBindingPoliciesReturns(policies, nil)
+				mock.GetNamespaceReturns(nil, errTest)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusInternalServerError,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := &bindingfakes.FakeImpl{}
+			tc.prepare(mock)
+
+			validator := profileBindingValidator{impl: mock, log: logr.Discard()}
+			resp := validator.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					Namespace: "namespace",
+				},
+			})
+			require.Equal(t, tc.allowed, resp.Allowed)
+			require.Equal(t, tc.code, resp.Result.Code)
+		})
+	}
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	maxSimulationRequestSize = 10 << 20
+	maxBaseProfileLevel      = 15
+)
+
+var (
+	errSimulationForbidden = errors.New("not allowed to get seccomp profiles")
+
+	// straceLineRegex matches the syscall name of a line in the strace
+	// output, which may be prefixed by the PID and a timestamp.
+	straceLineRegex = regexp.MustCompile(`^(?:\[pid\s+\d+\]\s+|\d+\s+)?(?:[\d:.]+\s+)?([a-z_][a-z0-9_]*)\(`)
+)
+
+// SimulationRequest is the request of the profile simulation endpoint.
+type SimulationRequest struct {
+	// Pod is the pod to be simulated, which has to contain its namespace.
+	Pod corev1.Pod `json:"pod"`
+
+	// Syscalls are the syscalls used by the containers of the pod.
+	Syscalls []string `json:"syscalls,omitempty"`
+
+	// Strace is the output of strace, from which the syscalls used by the
+	// containers of the pod get extracted in addition.
+	Strace string `json:"strace,omitempty"`
+}
+
+// SimulationResponse is the response of the profile simulation endpoint.
+type SimulationResponse struct {
+	// Containers are the simulation results per container of the pod.
+	Containers []ContainerSimulation `json:"containers"`
+}
+
+// ContainerSimulation is the simulation result of a single container.
+type ContainerSimulation struct {
+	// Name is the name of the container.
+	Name string `json:"name"`
+
+	// Profile is the namespaced name of the SeccompProfile applied to the
+	// container, either directly or by a ProfileBinding.
+	Profile string `json:"profile,omitempty"`
+
+	// Denied are the syscalls which would be denied by the profile.
+	Denied []string `json:"denied"`
+
+	// Conditional are the syscalls which would be allowed or denied
+	// depending on their arguments.
+	Conditional []string `json:"conditional,omitempty"`
+
+	// Message explains why the container could not be simulated completely.
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+
+// profileSimulator evaluates which syscalls would be denied for the containers
+// of a pod by the seccomp profiles applied to them.
+type profileSimulator struct {
+	impl
+	log logr.Logger
+}
+
+func (s *profileSimulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		http.Error(w, "missing bearer token", http.StatusUnauthorized)
+		return
+	}
+	user, authenticated, err := s.ReviewToken(r.Context(), token)
+	if err != nil {
+		s.log.Error(err, "Failed to review token")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !authenticated {
+		http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	req := &SimulationRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxSimulationRequestSize)).Decode(req); err != nil {
+		http.Error(w, fmt.Sprintf("decode simulation request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if req.Pod.Namespace == "" {
+		http.Error(w, "the namespace of the pod is required", http.StatusBadRequest)
+		return
+	}
+
+	syscalls := append(req.Syscalls, syscallsFromStrace(req.Strace)...)
+	res, err := s.simulate(r.Context(), user, &req.Pod, syscalls)
+	if errors.Is(err, errSimulationForbidden) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		s.log.Error(err, "Failed to simulate pod", "pod", req.Pod.Namespace+"/"+req.Pod.Name)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		s.log.Error(err, "Failed to encode simulation response")
+	}
+}
+
+// simulate evaluates the syscalls for all containers of the pod.
+func (s *profileSimulator) simulate(
+	ctx context.Context, user *authenticationv1.UserInfo, pod *corev1.Pod, syscalls []string,
+) (*SimulationResponse, error) {
+	allowedNamespaces := map[string]bool{}
+	authorize := func(namespace string) error {
+		allowed, ok := allowedNamespaces[namespace]
+		if !ok {
+			var err error
+			allowed, err = s.CanGetSeccompProfiles(ctx, user, namespace)
+			if err != nil {
+				return fmt.Errorf("check access to seccomp profiles: %w", err)
+			}
+			allowedNamespaces[namespace] = allowed
+		}
+		if !allowed {
+			return fmt.Errorf("%w in namespace %s", errSimulationForbidden, namespace)
+		}
+		return nil
+	}
+
+	if err := authorize(pod.Namespace); err != nil {
+		return nil, err
+	}
+	profileBindings, err := s.ListProfileBindings(ctx, client.InNamespace(pod.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("list profile bindings: %w", err)
+	}
+
+	syscalls = slices.Clone(syscalls)
+	slices.Sort(syscalls)
+	syscalls = slices.Compact(syscalls)
+
+	//nolint:gocritic // This is what we expect and want
+	containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+	res := &SimulationResponse{Containers: []ContainerSimulation{}}
+	for i := range containers {
+		result := ContainerSimulation{Name: containers[i].Name, Denied: []string{}}
+
+		key, msg := containerProfile(pod, &containers[i], profileBindings.Items)
+		if msg != "" {
+			result.Message = msg
+			res.Containers = append(res.Containers, result)
+			continue
+		}
+		if err := authorize(key.Namespace); err != nil {
+			return nil, err
+		}
+		result.Profile = key.String()
+
+		profile, rules, msg, err := s.resolveProfile(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		result.Message = msg
+		if profile != nil {
+			result.Denied, result.Conditional = evaluateSyscalls(profile.Spec.DefaultAction, rules, syscalls)
+		}
+		res.Containers = append(res.Containers, result)
+	}
+
+	return res, nil
+}
+
+// containerProfile returns the SeccompProfile applied to the container or a
+// message why there is none. Bindings only apply to containers without an own
+// seccomp profile, but take precedence over the one of the pod.
+func containerProfile(
+	pod *corev1.Pod, ctr *corev1.Container, profileBindings []profilebindingv1alpha1.ProfileBinding,
+) (types.NamespacedName, string) {
+	if ctr.SecurityContext != nil && ctr.SecurityContext.SeccompProfile != nil {
+		return localhostProfile(ctr.SecurityContext.SeccompProfile)
+	}
+
+	for i := range profileBindings {
+		spec := &profileBindings[i].Spec
+		if spec.ProfileRef.Kind != profilebindingv1alpha1.ProfileBindingKindSeccompProfile ||
+			spec.EphemeralContainers {
+			continue
+		}
+		// Bindings with an invalid image are not applied by the webhook
+		if matchImage, err := newImageMatcher(spec); err == nil && matchImage(ctr.Image) {
+			return types.NamespacedName{Namespace: pod.Namespace, Name: spec.ProfileRef.Name}, ""
+		}
+	}
+
+	if pod.Spec.SecurityContext != nil && pod.Spec.SecurityContext.SeccompProfile != nil {
+		return localhostProfile(pod.Spec.SecurityContext.SeccompProfile)
+	}
+	return types.NamespacedName{}, "no seccomp profile applied"
+}
+
+// localhostProfile returns the SeccompProfile installed by the operator at
+// the path of the localhost profile, for example "operator/ns/name.json".
+func localhostProfile(sp *corev1.SeccompProfile) (types.NamespacedName, string) {
+	if sp.Type != corev1.SeccompProfileTypeLocalhost || sp.LocalhostProfile == nil {
+		return types.NamespacedName{}, fmt.Sprintf("seccomp profile type %s cannot be simulated", sp.Type)
+	}
+
+	parts := strings.Split(strings.TrimSuffix(*sp.LocalhostProfile, ".json"), "/")
+	if len(parts) != 3 || parts[0] != config.OperatorProfilesFolder {
+		return types.NamespacedName{}, fmt.Sprintf(
+			"localhost profile %s is not managed by the operator", *sp.LocalhostProfile,
+		)
+	}
+	return types.NamespacedName{Namespace: parts[1], Name: parts[2]}, ""
+}
+
+// resolveProfile returns the profile together with the syscall rules of its
+// base profiles. Base profiles from OCI artifacts cannot be resolved, which
+// is reported by the returned message.
+func (s *profileSimulator) resolveProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*seccompprofileapi.SeccompProfile, []*seccompprofileapi.Syscall, string, error) {
+	profile, err := s.GetSeccompProfile(ctx, key)
+	if kerrors.IsNotFound(err) {
+		return nil, nil, "seccomp profile not found", nil
+	}
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("get seccomp profile %s: %w", key, err)
+	}
+
+	rules := slices.Clone(profile.Spec.Syscalls)
+	baseProfileName := profile.Spec.BaseProfileName
+	for level := 0; baseProfileName != ""; level++ {
+		if strings.HasPrefix(baseProfileName, config.OCIProfilePrefix) {
+			return profile, rules, fmt.Sprintf("base profile %s is not simulated", baseProfileName), nil
+		}
+		if level >= maxBaseProfileLevel {
+			return nil, nil, "", fmt.Errorf(
+				"max recursion level of %d is reached for resolving base profiles", maxBaseProfileLevel,
+			)
+		}
+
+		baseKey := types.NamespacedName{Namespace: key.Namespace, Name: baseProfileName}
+		baseProfile, err := s.GetSeccompProfile(ctx, baseKey)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("get base profile %s: %w", baseKey, err)
+		}
+		rules = append(rules, baseProfile.Spec.Syscalls...)
+		baseProfileName = baseProfile.Spec.BaseProfileName
+	}
+
+	return profile, rules, "", nil
+}
+
+// evaluateSyscalls returns the syscalls which would be denied by the rules
+// and the ones which only match rules restricting their arguments.
+func evaluateSyscalls(
+	defaultAction seccomp.Action, rules []*seccompprofileapi.Syscall, syscalls []string,
+) (denied, conditional []string) {
+	denied = []string{}
+	for _, name := range syscalls {
+		action, hasArgs, matched := defaultAction, false, false
+		for _, rule := range rules {
+			if !slices.Contains(rule.Names, name) {
+				continue
+			}
+			if len(rule.Args) > 0 {
+				hasArgs = true
+				continue
+			}
+			action, matched = rule.Action, true
+			break
+		}
+
+		switch {
+		case !matched && hasArgs:
+			conditional = append(conditional, name)
+		case action != seccomp.ActAllow && action != seccomp.ActLog:
+			denied = append(denied, name)
+		}
+	}
+	return denied, conditional
+}
+
+// syscallsFromStrace returns the syscalls of the strace output.
+func syscallsFromStrace(output string) []string {
+	syscalls := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		if match := straceLineRegex.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			syscalls = append(syscalls, match[1])
+		}
+	}
+	return syscalls
+}
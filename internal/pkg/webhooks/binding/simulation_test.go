@@ -0,0 +1,324 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding/bindingfakes"
+)
+
+func TestSimulateProfile(t *testing.T) {
+	t.Parallel()
+
+	profile := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "namespace"},
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			DefaultAction:   seccomp.ActErrno,
+			BaseProfileName: "base",
+			Syscalls: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: []string{"read", "write"}},
+				{
+					Action: seccomp.ActAllow,
+					Names:  []string{"personality"},
+					Args:   []*seccompprofileapi.Arg{{Index: 0, Value: 8, Op: seccomp.OpEqualTo}},
+				},
+			},
+		},
+	}
+	baseProfile := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: "namespace"},
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			DefaultAction: seccomp.ActErrno,
+			Syscalls: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActLog, Names: []string{"exit_group"}},
+			},
+		},
+	}
+	profileBindings := &v1alpha1.ProfileBindingList{
+		Items: []v1alpha1.ProfileBinding{{
+			Spec: v1alpha1.ProfileBindingSpec{
+				ProfileRef: v1alpha1.ProfileRef{
+					Kind: v1alpha1.ProfileBindingKindSeccompProfile,
+					Name: "profile",
+				},
+				Image: "bound",
+			},
+		}},
+	}
+	getProfile := func(mock *bindingfakes.FakeImpl) {
+		mock.GetSeccompProfileCalls(func(_ context.Context, key types.NamespacedName) (
+			*seccompprofileapi.SeccompProfile, error,
+		) {
+			if key.Name == "base" {
+				return baseProfile, nil
+			}
+			return profile, nil
+		})
+	}
+	localhost := func(path string) *corev1.SecurityContext {
+		return &corev1.SecurityContext{SeccompProfile: &corev1.SeccompProfile{
+			Type:             corev1.SeccompProfileTypeLocalhost,
+			LocalhostProfile: &path,
+		}}
+	}
+	syscalls := []string{"write", "read", "mkdir", "personality", "exit_group", "read"}
+
+	for _, tc := range []struct {
+		name       string
+		prepare    func(*bindingfakes.FakeImpl)
+		containers []corev1.Container
+		expected   []ContainerSimulation
+		shouldErr  bool
+	}{
+		{
+			name:    "bound profile with base profile",
+			prepare: getProfile,
+			containers: []corev1.Container{
+				{Name: "container", Image: "bound"},
+			},
+			expected: []ContainerSimulation{{
+				Name:        "container",
+				Profile:     "namespace/profile",
+				Denied:      []string{"mkdir"},
+				Conditional: []string{"personality"},
+			}},
+		},
+		{
+			name:    "localhost profile",
+			prepare: getProfile,
+			containers: []corev1.Container{
+				{Name: "container", SecurityContext: localhost("operator/namespace/profile.json")},
+			},
+			expected: []ContainerSimulation{{
+				Name:        "container",
+				Profile:     "namespace/profile",
+				Denied:      []string{"mkdir"},
+				Conditional: []string{"personality"},
+			}},
+		},
+		{
+			name:    "unmanaged localhost profile",
+			prepare: getProfile,
+			containers: []corev1.Container{
+				{Name: "container", Image: "bound", SecurityContext: localhost("custom.json")},
+			},
+			expected: []ContainerSimulation{{
+				Name:    "container",
+				Denied:  []string{},
+				Message: "localhost profile custom.json is not managed by the operator",
+			}},
+		},
+		{
+			name:    "no profile",
+			prepare: getProfile,
+			containers: []corev1.Container{
+				{Name: "container", Image: "unbound"},
+			},
+			expected: []ContainerSimulation{{
+				Name:    "container",
+				Denied:  []string{},
+				Message: "no seccomp profile applied",
+			}},
+		},
+		{
+			name: "profile in other namespace not allowed",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				getProfile(mock)
+				mock.CanGetSeccompProfilesCalls(func(
+					_ context.Context, _ *authenticationv1.UserInfo, namespace string,
+				) (bool, error) {
+					return namespace == "namespace", nil
+				})
+			},
+			containers: []corev1.Container{
+				{Name: "container", SecurityContext: localhost("operator/other/profile.json")},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "error get profile",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.GetSeccompProfileReturns(nil, errTest)
+			},
+			containers: []corev1.Container{
+				{Name: "container", Image: "bound"},
+			},
+			shouldErr: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := &bindingfakes.FakeImpl{}
+			mock.CanGetSeccompProfilesReturns(true, nil)
+			mock.ListProfileBindingsReturns(profileBindings, nil)
+			tc.prepare(mock)
+
+			simulator := profileSimulator{impl: mock, log: logr.Discard()}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "namespace"},
+				Spec:       corev1.PodSpec{Containers: tc.containers},
+			}
+			res, err := simulator.simulate(context.Background(), &authenticationv1.UserInfo{}, pod, syscalls)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res.Containers)
+		})
+	}
+}
+
+func TestServeSimulation(t *testing.T) {
+	t.Parallel()
+
+	body := func() string {
+		req, err := json.Marshal(&SimulationRequest{
+			Pod: corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "namespace"},
+			},
+			Strace: "read(0, \"\", 1) = 0",
+		})
+		require.NoError(t, err)
+		return string(req)
+	}()
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*bindingfakes.FakeImpl)
+		method  string
+		token   string
+		body    string
+		code    int
+	}{
+		{
+			name: "success",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ReviewTokenReturns(&authenticationv1.UserInfo{}, true, nil)
+				mock.CanGetSeccompProfilesReturns(true, nil)
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{}, nil)
+			},
+			method: http.MethodPost,
+			token:  "Bearer token",
+			body:   body,
+			code:   http.StatusOK,
+		},
+		{
+			name:    "wrong method",
+			prepare: func(*bindingfakes.FakeImpl) {},
+			method:  http.MethodGet,
+			code:    http.StatusMethodNotAllowed,
+		},
+		{
+			name:    "missing token",
+			prepare: func(*bindingfakes.FakeImpl) {},
+			method:  http.MethodPost,
+			body:    body,
+			code:    http.StatusUnauthorized,
+		},
+		{
+			name: "invalid token",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ReviewTokenReturns(nil, false, nil)
+			},
+			method: http.MethodPost,
+			token:  "Bearer token",
+			body:   body,
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name: "invalid body",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ReviewTokenReturns(&authenticationv1.UserInfo{}, true, nil)
+			},
+			method: http.MethodPost,
+			token:  "Bearer token",
+			body:   "{",
+			code:   http.StatusBadRequest,
+		},
+		{
+			name: "forbidden",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ReviewTokenReturns(&authenticationv1.UserInfo{}, true, nil)
+				mock.CanGetSeccompProfilesReturns(false, nil)
+			},
+			method: http.MethodPost,
+			token:  "Bearer token",
+			body:   body,
+			code:   http.StatusForbidden,
+		},
+		{
+			name: "error review token",
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ReviewTokenReturns(nil, false, errTest)
+			},
+			method: http.MethodPost,
+			token:  "Bearer token",
+			body:   body,
+			code:   http.StatusInternalServerError,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := &bindingfakes.FakeImpl{}
+			tc.prepare(mock)
+
+			simulator := profileSimulator{impl: mock, log: logr.Discard()}
+			req := httptest.NewRequest(tc.method, "/simulate-v1-pod", strings.NewReader(tc.body))
+			if tc.token != "" {
+				req.Header.Set("Authorization", tc.token)
+			}
+			rec := httptest.NewRecorder()
+			simulator.ServeHTTP(rec, req)
+			require.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
+
+func TestSyscallsFromStrace(t *testing.T) {
+	t.Parallel()
+
+	output := `execve("/bin/true", ["true"], 0x7ffd8a8c3e40 /* 20 vars */) = 0
+[pid  1234] openat(AT_FDCWD, "/etc/ld.so.cache", O_RDONLY|O_CLOEXEC) = 3
+1235  12:00:01.123456 close(3)                = 0
+--- SIGCHLD {si_signo=SIGCHLD, si_code=CLD_EXITED} ---
++++ exited with 0 +++
+exit_group(0)                           = ?`
+
+	require.Equal(t,
+		[]string{"execve", "openat", "close", "exit_group"},
+		syscallsFromStrace(output),
+	)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recording
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+// approveVerb is the verb which allows users to approve profile recordings.
+const approveVerb = "approve"
+
+// recordingApprover ensures that profile recordings only use the recorders
+// allowed by the SPOD configuration and that they can only be approved by
+// users which are allowed to approve them.
+type recordingApprover struct {
+	impl
+	log logr.Logger
+}
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+//nolint:gocritic
+func (a *recordingApprover) Handle(ctx context.Context, req admission.Request) admission.Response {
+	profileRecording, err := a.DecodeProfileRecording(req)
+	if err != nil {
+		a.log.Error(err, "Failed to decode profile recording")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var oldProfileRecording *profilerecordingv1alpha1.ProfileRecording
+	if req.Operation == admissionv1.Update {
+		oldProfileRecording, err = a.DecodeOldProfileRecording(req)
+		if err != nil {
+			a.log.Error(err, "Failed to decode old profile recording")
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	if err := profileRecording.ValidateKinds(); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	if err := profileRecording.ValidateProfileNameTemplate(); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	// Existing recordings can still be updated, for example to remove their
+	// finalizers, after the recorder got disallowed.
+	if oldProfileRecording == nil || oldProfileRecording.Spec.Recorder != profileRecording.Spec.Recorder {
+		allowed, msg, err := recorderAllowed(ctx, a.impl, profileRecording.Spec.Recorder)
+		if err != nil {
+			a.log.Error(err, "Failed to check if the recorder is allowed")
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if !allowed {
+			return admission.Denied(msg)
+		}
+	}
+
+	if !profileRecording.Spec.Approved {
+		return admission.Allowed("profile recording not approved")
+	}
+
+	// Changing an approved recording requires another approval, because
+	// it could select other workloads or use another recorder.
+	if oldProfileRecording != nil && equality.Semantic.DeepEqual(oldProfileRecording.Spec, profileRecording.Spec) {
+		return admission.Allowed("profile recording approval unchanged")
+	}
+
+	allowed, err := a.CanApproveRecordings(ctx, &req.UserInfo, req.Namespace)
+	if err != nil {
+		a.log.Error(err, "Failed to check approval permission")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if !allowed {
+		return admission.Denied(fmt.Sprintf(
+			"user %s is not allowed to approve profile recordings in namespace %s",
+			req.UserInfo.Username, req.Namespace,
+		))
+	}
+
+	a.log.Info(fmt.Sprintf(
+		"profile recording %s/%s approved by %s",
+		req.Namespace, profileRecording.Name, req.UserInfo.Username,
+	))
+	return admission.Allowed("profile recording approved")
+}
+
+// namespaceRequiresApproval returns true if the namespace requires profile
+// recordings to be approved before activating them.
+func namespaceRequiresApproval(ctx context.Context, i impl, namespace string) (bool, error) {
+	ns, err := i.GetNamespace(ctx, namespace)
+	if err != nil {
+		return false, fmt.Errorf("get namespace %s: %w", namespace, err)
+	}
+	_, ok := ns.Labels[config.RequireRecordingApprovalLabelKey]
+	return ok, nil
+}
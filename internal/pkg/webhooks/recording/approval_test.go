@@ -0,0 +1,254 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recording
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/recording/recordingfakes"
+)
+
+func TestHandleApproval(t *testing.T) {
+	t.Parallel()
+
+	recording := func(approved bool, containers ...string) *v1alpha1.ProfileRecording {
+		return &v1alpha1.ProfileRecording{
+			Spec: v1alpha1.ProfileRecordingSpec{
+				Kind:       v1alpha1.ProfileRecordingKindSeccompProfile,
+				Recorder:   v1alpha1.ProfileRecorderLogs,
+				Containers: containers,
+				Approved:   approved,
+			},
+		}
+	}
+
+	bpfOnlySPOd := &spodv1alpha1.SecurityProfilesOperatorDaemon{
+		Spec: spodv1alpha1.SPODSpec{
+			AllowedRecorders: []v1alpha1.ProfileRecorder{v1alpha1.ProfileRecorderBpf},
+		},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		prepare     func(*recordingfakes.FakeImpl)
+		operation   admissionv1.Operation
+		allowed     bool
+		code        int32
+		checkedAuth bool
+	}{
+		{
+			name: "not approved",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(false), nil)
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "approved by approver",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(true), nil)
+				mock.CanApproveRecordingsReturns(true, nil)
+			},
+			operation:   admissionv1.Create,
+			allowed:     true,
+			code:        http.StatusOK,
+			checkedAuth: true,
+		},
+		{
+			name: "approved by other user",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(true), nil)
+				mock.CanApproveRecordingsReturns(false, nil)
+			},
+			operation:   admissionv1.Create,
+			code:        http.StatusForbidden,
+			checkedAuth: true,
+		},
+		{
+			name: "approval unchanged",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(true), nil)
+				mock.DecodeOldProfileRecordingReturns(recording(true), nil)
+			},
+			operation: admissionv1.Update,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "approved recording changed by other user",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(true, "other"), nil)
+				mock.DecodeOldProfileRecordingReturns(recording(true), nil)
+				mock.CanApproveRecordingsReturns(false, nil)
+			},
+			operation:   admissionv1.Update,
+			code:        http.StatusForbidden,
+			checkedAuth: true,
+		},
+		{
+			name: "additional kinds with logs recorder",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				rec := recording(false)
+				rec.Spec.AdditionalKinds = []v1alpha1.ProfileRecordingKind{v1alpha1.ProfileRecordingKindSelinuxProfile}
+				mock.DecodeProfileRecordingReturns(rec, nil)
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "additional kinds with bpf recorder",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				rec := recording(false)
+				rec.Spec.Recorder = v1alpha1.ProfileRecorderBpf
+				rec.Spec.AdditionalKinds = []v1alpha1.ProfileRecordingKind{v1alpha1.ProfileRecordingKindSelinuxProfile}
+				mock.DecodeProfileRecordingReturns(rec, nil)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "valid profile name template",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				rec := recording(false)
+				rec.Spec.ProfileNameTemplate = "{{ owner }}-{{ container }}-{{ imageTag }}"
+				mock.DecodeProfileRecordingReturns(rec, nil)
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "invalid profile name template",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				rec := recording(false)
+				rec.Spec.ProfileNameTemplate = "{{ owner }}_{{ container }}"
+				mock.DecodeProfileRecordingReturns(rec, nil)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "error decode profile recording",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(nil, errTest)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusBadRequest,
+		},
+		{
+			name: "error decode old profile recording",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(true), nil)
+				mock.DecodeOldProfileRecordingReturns(nil, errTest)
+			},
+			operation: admissionv1.Update,
+			code:      http.StatusBadRequest,
+		},
+		{
+			name: "error check permission",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(true), nil)
+				mock.CanApproveRecordingsReturns(false, errTest)
+			},
+			operation:   admissionv1.Create,
+			code:        http.StatusInternalServerError,
+			checkedAuth: true,
+		},
+		{
+			name: "recorder not allowed",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(false), nil)
+				mock.GetSPOdReturns(bpfOnlySPOd, nil)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "recorder not allowed but unchanged",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(false, "foo"), nil)
+				mock.DecodeOldProfileRecordingReturns(recording(false), nil)
+				mock.GetSPOdReturns(bpfOnlySPOd, nil)
+			},
+			operation: admissionv1.Update,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "recorder allowed without SPOD",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(false), nil)
+				mock.GetSPOdReturns(nil, kerrors.NewNotFound(schema.GroupResource{}, ""))
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "error get SPOD",
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.DecodeProfileRecordingReturns(recording(false), nil)
+				mock.GetSPOdReturns(nil, errTest)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusInternalServerError,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := &recordingfakes.FakeImpl{}
+			mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{}, nil)
+			tc.prepare(mock)
+
+			approver := recordingApprover{impl: mock, log: logr.Discard()}
+			resp := approver.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					Namespace: "namespace",
+					UserInfo:  authenticationv1.UserInfo{Username: "user"},
+				},
+			})
+			require.Equal(t, tc.allowed, resp.Allowed)
+			require.Equal(t, tc.code, resp.Result.Code)
+
+			if tc.checkedAuth {
+				require.Equal(t, 1, mock.CanApproveRecordingsCallCount())
+				_, user, namespace := mock.CanApproveRecordingsArgsForCall(0)
+				require.Equal(t, "user", user.Username)
+				require.Equal(t, "namespace", namespace)
+			} else {
+				require.Zero(t, mock.CanApproveRecordingsCallCount())
+			}
+		})
+	}
+}
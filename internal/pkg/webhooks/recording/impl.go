@@ -21,6 +21,8 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -29,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
@@ -47,8 +50,13 @@ type impl interface {
 	UpdateResource(context.Context, logr.Logger, client.Object, string) error
 	UpdateResourceStatus(context.Context, logr.Logger, client.Object, string) error
 	DecodePod(admission.Request) (*corev1.Pod, error)
+	DecodeProfileRecording(admission.Request) (*v1alpha1.ProfileRecording, error)
+	DecodeOldProfileRecording(admission.Request) (*v1alpha1.ProfileRecording, error)
 	LabelSelectorAsSelector(*metav1.LabelSelector) (labels.Selector, error)
 	GetOperatorNamespace() string
+	GetNamespace(context.Context, string) (*corev1.Namespace, error)
+	CanApproveRecordings(context.Context, *authenticationv1.UserInfo, string) (bool, error)
+	GetSPOd(context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error)
 }
 
 func (d *defaultImpl) GetProfileRecording(
@@ -127,6 +135,69 @@ func (d *defaultImpl) DecodePod(req admission.Request) (*corev1.Pod, error) {
 	return pod, nil
 }
 
+//nolint:gocritic
+func (d *defaultImpl) DecodeProfileRecording(req admission.Request) (*v1alpha1.ProfileRecording, error) {
+	profileRecording := &v1alpha1.ProfileRecording{}
+	if err := d.decoder.Decode(req, profileRecording); err != nil {
+		return nil, fmt.Errorf("decode profile recording: %w", err)
+	}
+	return profileRecording, nil
+}
+
+//nolint:gocritic
+func (d *defaultImpl) DecodeOldProfileRecording(req admission.Request) (*v1alpha1.ProfileRecording, error) {
+	profileRecording := &v1alpha1.ProfileRecording{}
+	if err := d.decoder.DecodeRaw(req.OldObject, profileRecording); err != nil {
+		return nil, fmt.Errorf("decode old profile recording: %w", err)
+	}
+	return profileRecording, nil
+}
+
+func (d *defaultImpl) GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
+	namespace := &corev1.Namespace{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		return nil, fmt.Errorf("get namespace: %w", err)
+	}
+	return namespace, nil
+}
+
+func (d *defaultImpl) CanApproveRecordings(
+	ctx context.Context, user *authenticationv1.UserInfo, namespace string,
+) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      approveVerb,
+				Group:     v1alpha1.GroupVersion.Group,
+				Resource:  "profilerecordings",
+			},
+		},
+	}
+	if err := d.client.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("create subject access review: %w", err)
+	}
+	return review.Status.Allowed, nil
+}
+
+func (d *defaultImpl) GetSPOd(ctx context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Namespace: config.GetOperatorNamespace(), Name: config.SPOdName}
+	if err := d.client.Get(ctx, key, spod); err != nil {
+		return nil, fmt.Errorf("get spod: %w", err)
+	}
+	return spod, nil
+}
+
 func (*defaultImpl) LabelSelectorAsSelector(
 	ps *metav1.LabelSelector,
 ) (labels.Selector, error) {
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recording
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+
+// recorderAllowed returns true if the SPOD configuration allows profile
+// recordings to use the provided recorder. Otherwise, it returns a message
+// which lists the allowed recorders.
+func recorderAllowed(
+	ctx context.Context, i impl, recorder profilerecordingv1alpha1.ProfileRecorder,
+) (allowed bool, msg string, err error) {
+	spod, err := i.GetSPOd(ctx)
+	if kerrors.IsNotFound(err) {
+		return true, "", nil
+	} else if err != nil {
+		return false, "", fmt.Errorf("get SPOD configuration: %w", err)
+	}
+
+	if spod.Spec.RecorderAllowed(recorder) {
+		return true, "", nil
+	}
+
+	allowedRecorders := make([]string, 0, len(spod.Spec.AllowedRecorders))
+	for _, r := range spod.Spec.AllowedRecorders {
+		allowedRecorders = append(allowedRecorders, string(r))
+	}
+	return false, fmt.Sprintf(
+		"the %s recorder is not allowed by the SPOD configuration, allowed recorders: %s",
+		recorder, strings.Join(allowedRecorders, ", "),
+	), nil
+}
@@ -28,7 +28,6 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/record"
@@ -53,19 +52,29 @@ type podSeccompRecorder struct {
 }
 
 func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, rec record.EventRecorder, c client.Client) {
+	i := &defaultImpl{
+		client:  c,
+		decoder: admission.NewDecoder(scheme),
+	}
 	server.Register(
 		"/mutate-v1-pod-recording",
 		&webhook.Admission{
 			Handler: &podSeccompRecorder{
-				impl: &defaultImpl{
-					client:  c,
-					decoder: admission.NewDecoder(scheme),
-				},
+				impl:   i,
 				log:    logf.Log.WithName("recording"),
 				record: utils.NewSafeRecorder(rec),
 			},
 		},
 	)
+	server.Register(
+		"/validate-v1alpha1-profilerecording",
+		&webhook.Admission{
+			Handler: &recordingApprover{
+				impl: i,
+				log:  logf.Log.WithName("recording-approval"),
+			},
+		},
+	)
 }
 
 //nolint:lll // required for kubebuilder
@@ -103,14 +112,13 @@ func (p *podSeccompRecorder) Handle(
 	}
 
 	podChanged := false
-	podLabels := labels.Set(pod.GetLabels())
 	items := profileRecordings.Items
 
 	for i := range items {
 		item := items[i]
 		if !item.IsKindSupported() {
 			p.log.Info(fmt.Sprintf(
-				"recording kind %s not supported", item.Spec.Kind,
+				"recording kinds %v not supported", item.Kinds(),
 			))
 			continue
 		}
@@ -124,10 +132,46 @@ func (p *podSeccompRecorder) Handle(
 			)
 			return admission.Errored(http.StatusInternalServerError, err)
 		}
+		matches := utils.AllOf(
+			utils.LabelPredicate(selector),
+			utils.OwnerPredicate(item.Spec.OwnerSelector),
+		).Matches(pod)
+
+		if req.Operation != admissionv1.Delete && item.NeedsApproval() && matches {
+			required, err := namespaceRequiresApproval(ctx, p.impl, req.Namespace)
+			if err != nil {
+				p.log.Error(err, "Could not check if the recording requires an approval")
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+			if required {
+				p.log.Info(fmt.Sprintf("recording %s is not approved yet, skipping pod %s", item.Name, podName))
+				p.record.Eventf(&item,
+					corev1.EventTypeWarning,
+					"RecordingNotApproved",
+					"Not recording pod %s, because the recording has not been approved yet", podName)
+				continue
+			}
+		}
+
+		if req.Operation != admissionv1.Delete && matches {
+			allowed, msg, err := recorderAllowed(ctx, p.impl, item.Spec.Recorder)
+			if err != nil {
+				p.log.Error(err, "Could not check if the recorder is allowed")
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+			if !allowed {
+				p.log.Info(fmt.Sprintf("recording %s uses a disallowed recorder, skipping pod %s", item.Name, podName))
+				p.record.Eventf(&item,
+					corev1.EventTypeWarning,
+					"RecorderNotAllowed",
+					"Not recording pod %s, because %s", podName, msg)
+				continue
+			}
+		}
 
 		if err := util.Retry(func() error {
 			if err := p.setRecordingReferences(ctx, req.Operation,
-				&item, selector, podName, podLabels); err != nil {
+				&item, matches, podName); err != nil {
 				return fmt.Errorf("adding pod tracking: %w", err)
 			}
 
@@ -136,7 +180,12 @@ func (p *podSeccompRecorder) Handle(
 			return admission.Errored(http.StatusInternalServerError, err)
 		}
 
-		if selector.Matches(podLabels) {
+		if matches {
+			if req.SubResource == utils.EphemeralContainersSubResource {
+				podChanged = p.updateEphemeralContainers(pod, &item)
+				continue
+			}
+
 			podChanged, err = p.updatePod(pod, podName, &item)
 			if err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
@@ -179,6 +228,9 @@ func (p *podSeccompRecorder) updatePod(
 	// Collect containers as references to not copy them during modification
 	ctrs := []*corev1.Container{}
 	for i := range pod.Spec.InitContainers {
+		if profileRecording.Spec.ExcludeInitContainers {
+			break
+		}
 		if p.shouldRecordContainer(pod.Spec.InitContainers[i].Name, profileRecording) {
 			ctrs = append(ctrs, &pod.Spec.InitContainers[i])
 		}
@@ -189,10 +241,20 @@ func (p *podSeccompRecorder) updatePod(
 		}
 	}
 
+	// All recordings of the pod share the same session
+	session, ok := pod.GetAnnotations()[config.RecordingSessionAnnotationKey]
+	if !ok {
+		session = profilerecordingv1alpha1.NewRecordingSession()
+	}
+
 	for i := range ctrs {
 		ctr := ctrs[i]
 
-		key, value, err := profileRecording.CtrAnnotation(ctr.Name)
+		key, value, err := profileRecording.CtrAnnotation(ctr.Name, session)
+		if err != nil {
+			return false, err
+		}
+		additionalKeys, err := profileRecording.AdditionalCtrAnnotationKeys(ctr.Name)
 		if err != nil {
 			return false, err
 		}
@@ -200,50 +262,138 @@ func (p *podSeccompRecorder) updatePod(
 		p.warnEventIfContainerPrivileged(profileRecording, ctr, pod)
 
 		p.updateSecurityContext(ctr, profileRecording)
-		existingValue, ok := pod.GetAnnotations()[key]
-		if !ok {
-			if pod.Annotations == nil {
-				pod.Annotations = make(map[string]string)
+		// The profiles of all kinds share the same name
+		for _, k := range append([]string{key}, additionalKeys...) {
+			if p.addAnnotation(pod, podName, k, value) {
+				podChanged = true
 			}
-			pod.Annotations[key] = value
-			p.log.Info(fmt.Sprintf(
-				"adding recording annotation %s=%s to pod %s",
-				key, value, pod.Name,
-			))
+		}
+		if p.addFallbackAnnotation(pod, podName, profileRecording, ctr.Name, value) {
 			podChanged = true
-			continue
 		}
+	}
 
-		if existingValue != value {
-			p.log.Error(
-				errors.New("existing annotation"),
-				fmt.Sprintf(
-					"workload %s already has annotation %s (not mutating to %s).",
-					podName,
-					existingValue,
-					value,
-				),
-			)
+	if profileRecording.Spec.EphemeralContainers {
+		// Ephemeral containers are added to running pods, where the
+		// annotations cannot be changed any more.
+		key, value, err := profileRecording.CtrAnnotation(config.EphemeralContainersRecordName, session)
+		if err != nil {
+			return false, err
 		}
+		additionalKeys, err := profileRecording.AdditionalCtrAnnotationKeys(config.EphemeralContainersRecordName)
+		if err != nil {
+			return false, err
+		}
+		for _, k := range append([]string{key}, additionalKeys...) {
+			if p.addAnnotation(pod, podName, k, value) {
+				podChanged = true
+			}
+		}
+		if p.addFallbackAnnotation(
+			pod, podName, profileRecording, config.EphemeralContainersRecordName, value,
+		) {
+			podChanged = true
+		}
+	}
+
+	if podChanged {
+		p.addAnnotation(pod, podName, config.RecordingSessionAnnotationKey, session)
 	}
 
 	return podChanged, nil
 }
 
+// addFallbackAnnotation adds the annotation of the logs recorder with the
+// same profile as recorded by the bpf recorder, if the recording falls back
+// to the logs recorder on nodes where the bpf recorder is not available.
+func (p *podSeccompRecorder) addFallbackAnnotation(
+	pod *corev1.Pod,
+	podName string,
+	profileRecording *profilerecordingv1alpha1.ProfileRecording,
+	ctrName, value string,
+) bool {
+	if !profileRecording.FallsBackToLogs() {
+		return false
+	}
+	return p.addAnnotation(pod, podName, config.SeccompProfileRecordLogsAnnotationKey+ctrName, value)
+}
+
+func (p *podSeccompRecorder) addAnnotation(pod *corev1.Pod, podName, key, value string) bool {
+	existingValue, ok := pod.GetAnnotations()[key]
+	if !ok {
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		pod.Annotations[key] = value
+		p.log.Info(fmt.Sprintf(
+			"adding recording annotation %s=%s to pod %s",
+			key, value, pod.Name,
+		))
+		return true
+	}
+
+	if existingValue != value {
+		p.log.Error(
+			errors.New("existing annotation"),
+			fmt.Sprintf(
+				"workload %s already has annotation %s (not mutating to %s).",
+				podName,
+				existingValue,
+				value,
+			),
+		)
+	}
+	return false
+}
+
+// updateEphemeralContainers prepares ephemeral containers which are about to
+// be added to a pod for being recorded. The pod has to be annotated for
+// recording ephemeral containers on creation.
+func (p *podSeccompRecorder) updateEphemeralContainers(
+	pod *corev1.Pod,
+	profileRecording *profilerecordingv1alpha1.ProfileRecording,
+) (podChanged bool) {
+	if !profileRecording.Spec.EphemeralContainers || !profileRecording.UsesLogsRecorder() {
+		// Only the log based recorder requires to change the containers
+		return false
+	}
+
+	key, _, err := profileRecording.CtrAnnotation(config.EphemeralContainersRecordName, "")
+	if err != nil {
+		return false
+	}
+	if _, ok := pod.GetAnnotations()[key]; !ok {
+		p.log.Info(fmt.Sprintf(
+			"pod %s is not annotated for recording ephemeral containers", pod.Name,
+		))
+		return false
+	}
+
+	for _, ctr := range utils.NewEphemeralContainers(pod) {
+		p.warnEventIfContainerPrivileged(profileRecording, ctr, pod)
+		p.updateSecurityContext(ctr, profileRecording)
+		podChanged = true
+	}
+
+	return podChanged
+}
+
 func (p *podSeccompRecorder) updateSecurityContext(
 	ctr *corev1.Container, pr *profilerecordingv1alpha1.ProfileRecording,
 ) {
-	if pr.Spec.Recorder != profilerecordingv1alpha1.ProfileRecorderLogs {
+	if !pr.UsesLogsRecorder() {
 		// we only need to ensure the special security context if we're tailing
 		// the logs
 		return
 	}
 
-	switch pr.Spec.Kind {
-	case profilerecordingv1alpha1.ProfileRecordingKindSeccompProfile:
-		p.updateSeccompSecurityContext(ctr, pr)
-	case profilerecordingv1alpha1.ProfileRecordingKindSelinuxProfile:
-		p.updateSelinuxSecurityContext(ctr, pr)
+	for _, kind := range pr.Kinds() {
+		switch kind {
+		case profilerecordingv1alpha1.ProfileRecordingKindSeccompProfile:
+			p.updateSeccompSecurityContext(ctr, pr)
+		case profilerecordingv1alpha1.ProfileRecordingKindSelinuxProfile:
+			p.updateSelinuxSecurityContext(ctr, pr)
+		}
 	}
 
 	p.log.Info(fmt.Sprintf(
@@ -282,6 +432,11 @@ func (p *podSeccompRecorder) updateSelinuxSecurityContext(
 	ctr *corev1.Container,
 	pr *profilerecordingv1alpha1.ProfileRecording,
 ) {
+	if pr.Spec.SelinuxKeepContext {
+		// The workload is recorded with the context it runs with
+		return
+	}
+
 	if ctr.SecurityContext == nil {
 		ctr.SecurityContext = &corev1.SecurityContext{}
 	}
@@ -302,9 +457,8 @@ func (p *podSeccompRecorder) setRecordingReferences(
 	ctx context.Context,
 	op admissionv1.Operation,
 	profileRecording *profilerecordingv1alpha1.ProfileRecording,
-	selector labels.Selector,
+	matches bool,
 	podName string,
-	podLabels labels.Set,
 ) error {
 	// we Get the recording again because remove is used in a retry loop
 	// to handle conflicts, we want to get the most recent one
@@ -317,25 +471,24 @@ func (p *podSeccompRecorder) setRecordingReferences(
 		return fmt.Errorf("cannot retrieve profilerecording: %w", err)
 	}
 
-	if err := p.setActiveWorkloads(ctx, op, profileRecording, selector, podName, podLabels); err != nil {
+	if err := p.setActiveWorkloads(ctx, op, profileRecording, matches, podName); err != nil {
 		return fmt.Errorf("cannot set active workloads: %w", err)
 	}
 
-	return p.setFinalizers(ctx, op, profileRecording, selector, podLabels)
+	return p.setFinalizers(ctx, op, profileRecording, matches)
 }
 
 func (p *podSeccompRecorder) setActiveWorkloads(
 	ctx context.Context,
 	op admissionv1.Operation,
 	profileRecording *profilerecordingv1alpha1.ProfileRecording,
-	selector labels.Selector,
+	matches bool,
 	podName string,
-	podLabels labels.Set,
 ) error {
 	newActiveWorkloads := profileRecording.Status.ActiveWorkloads
 	if op == admissionv1.Delete {
 		newActiveWorkloads = utils.RemoveIfExists(newActiveWorkloads, podName)
-	} else if selector.Matches(podLabels) {
+	} else if matches {
 		newActiveWorkloads = utils.AppendIfNotExists(newActiveWorkloads, podName)
 	}
 
@@ -348,14 +501,13 @@ func (p *podSeccompRecorder) setFinalizers(
 	ctx context.Context,
 	op admissionv1.Operation,
 	profileRecording *profilerecordingv1alpha1.ProfileRecording,
-	selector labels.Selector,
-	podLabels labels.Set,
+	matches bool,
 ) error {
 	if op == admissionv1.Delete {
 		if controllerutil.ContainsFinalizer(profileRecording, finalizer) {
 			controllerutil.RemoveFinalizer(profileRecording, finalizer)
 		}
-	} else if selector.Matches(podLabels) {
+	} else if matches {
 		if !controllerutil.ContainsFinalizer(profileRecording, finalizer) {
 			controllerutil.AddFinalizer(profileRecording, finalizer)
 		}
@@ -380,7 +532,8 @@ func (p *podSeccompRecorder) warnEventIfContainerPrivileged(
 	p.record.Eventf(profileRecording,
 		corev1.EventTypeWarning,
 		"PrivilegedContainer",
-		"Container %s in pod %s is privileged, cannot use log-based profile recording", ctr.Name, pod.Name)
+		"Container %s in pod %s is privileged, cannot use log-based profile recording, "+
+			"use the bpf recorder for seccomp profiles instead", ctr.Name, pod.Name)
 }
 
 // warnEventIfNameTooLong warns the user if the name of the profile recording is too long or otherwise does
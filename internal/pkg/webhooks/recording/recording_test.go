@@ -35,6 +35,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/recording/recordingfakes"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
@@ -53,6 +55,51 @@ var (
 			},
 		},
 	}
+	testIsController = true
+	testTaskRunPod   = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "build-pod",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "tekton.dev/v1",
+					Kind:       "TaskRun",
+					Name:       "build",
+					Controller: &testIsController,
+				},
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "step-build",
+				},
+			},
+		},
+	}
+	testApprovalNamespace = &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "namespace",
+			Labels: map[string]string{config.RequireRecordingApprovalLabelKey: ""},
+		},
+	}
+	testEphemeralPod = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "pod-",
+			Annotations: map[string]string{
+				"io.containers.trace-logs/ephemeral.containers": "_ephemeral.containers_abcde_1",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "container",
+				},
+			},
+			EphemeralContainers: []corev1.EphemeralContainer{
+				{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"}},
+			},
+		},
+	}
 )
 
 func TestHandle(t *testing.T) {
@@ -91,6 +138,349 @@ func TestHandle(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, int(resp.Result.Code))
 			},
 		},
+		{ // success pod annotated for recording ephemeral containers
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:                v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder:            v1alpha1.ProfileRecorderBpf,
+						EphemeralContainers: true,
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.GetProfileRecordingReturns(recording.DeepCopy(), nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+				annotations, ok := resp.Patches[0].Value.(map[string]interface{})
+				require.True(t, ok)
+				require.Contains(t, annotations, "io.containers.trace-bpf/container")
+				require.Contains(t, annotations, "io.containers.trace-bpf/ephemeral.containers")
+			},
+		},
+		{ // success init containers excluded
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:                  v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder:              v1alpha1.ProfileRecorderBpf,
+						ExcludeInitContainers: true,
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.GetProfileRecordingReturns(&recording, nil)
+				pod := testPod.DeepCopy()
+				pod.Spec.InitContainers = []corev1.Container{{Name: "setup"}}
+				mock.DecodePodReturns(pod, nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							pod := testPod.DeepCopy()
+							pod.Spec.InitContainers = []corev1.Container{{Name: "setup"}}
+							b, err := json.Marshal(pod)
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+				annotations, ok := resp.Patches[0].Value.(map[string]interface{})
+				require.True(t, ok)
+				require.Contains(t, annotations, "io.containers.trace-bpf/container")
+				require.NotContains(t, annotations, "io.containers.trace-bpf/setup")
+			},
+		},
+		{ // success recordings share the session of the pod
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recordings := []v1alpha1.ProfileRecording{}
+				for _, name := range []string{"first", "second"} {
+					recordings = append(recordings, v1alpha1.ProfileRecording{
+						ObjectMeta: metav1.ObjectMeta{Name: name},
+						Spec: v1alpha1.ProfileRecordingSpec{
+							Kind:       v1alpha1.ProfileRecordingKindSeccompProfile,
+							Recorder:   v1alpha1.ProfileRecorderBpf,
+							Containers: []string{name},
+						},
+					})
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{Items: recordings}, nil)
+				mock.GetProfileRecordingReturnsOnCall(0, recordings[0].DeepCopy(), nil)
+				mock.GetProfileRecordingReturnsOnCall(1, recordings[1].DeepCopy(), nil)
+				pod := testPod.DeepCopy()
+				pod.Spec.Containers = []corev1.Container{{Name: "first"}, {Name: "second"}}
+				mock.DecodePodReturns(pod, nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							pod := testPod.DeepCopy()
+							pod.Spec.Containers = []corev1.Container{{Name: "first"}, {Name: "second"}}
+							b, err := json.Marshal(pod)
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+				annotations, ok := resp.Patches[0].Value.(map[string]interface{})
+				require.True(t, ok)
+				session, ok := annotations[config.RecordingSessionAnnotationKey].(string)
+				require.True(t, ok)
+				require.NotEmpty(t, session)
+				for _, ctr := range []string{"first", "second"} {
+					value, ok := annotations["io.containers.trace-bpf/"+ctr].(string)
+					require.True(t, ok)
+					payload, err := v1alpha1.ParseCtrAnnotation(value)
+					require.NoError(t, err)
+					require.Equal(t, ctr, payload.Container)
+					require.Equal(t, session, payload.Session)
+				}
+			},
+		},
+		{ // success pod prepared for falling back to the logs recorder
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:             v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder:         v1alpha1.ProfileRecorderBpf,
+						RecorderFallback: true,
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.GetProfileRecordingReturns(recording.DeepCopy(), nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.GetOperatorNamespaceReturns("test-ns")
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 2) // 2 because security context and the annotations
+				var annotations map[string]interface{}
+				for _, patch := range resp.Patches {
+					if value, ok := patch.Value.(map[string]interface{}); ok && patch.Path == "/metadata/annotations" {
+						annotations = value
+					}
+				}
+				require.Contains(t, annotations, "io.containers.trace-bpf/container")
+				require.Equal(t,
+					annotations["io.containers.trace-bpf/container"],
+					annotations["io.containers.trace-logs/container"],
+				)
+			},
+		},
+		{ // success unapproved recording skipped
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder: v1alpha1.ProfileRecorderLogs,
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+				mock.GetNamespaceReturns(testApprovalNamespace.DeepCopy(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
+		{ // success recording with disallowed recorder skipped
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder: v1alpha1.ProfileRecorderBpf,
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+				mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{
+					Spec: spodv1alpha1.SPODSpec{
+						AllowedRecorders: []v1alpha1.ProfileRecorder{v1alpha1.ProfileRecorderLogs},
+					},
+				}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
+		{ // error could not get SPOD to check the recorder
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{{
+						Spec: v1alpha1.ProfileRecordingSpec{
+							Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+							Recorder: v1alpha1.ProfileRecorderBpf,
+						},
+					}},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+				mock.GetSPOdReturns(nil, errTest)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
+		{ // success approved recording
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder: v1alpha1.ProfileRecorderLogs,
+						Approved: true,
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.GetProfileRecordingReturns(recording.DeepCopy(), nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+				mock.GetNamespaceReturns(testApprovalNamespace.DeepCopy(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.NotEmpty(t, resp.Patches)
+			},
+		},
+		{ // error could not get namespace for approval
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{{
+						Spec: v1alpha1.ProfileRecordingSpec{
+							Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+							Recorder: v1alpha1.ProfileRecorderLogs,
+						},
+					}},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+				mock.GetNamespaceReturns(nil, errTest)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
+		{ // success ephemeral container changed - tailing logs
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:                v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder:            v1alpha1.ProfileRecorderLogs,
+						EphemeralContainers: true,
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.GetProfileRecordingReturns(recording.DeepCopy(), nil)
+				mock.GetOperatorNamespaceReturns("test-ns")
+				mock.DecodePodReturns(testEphemeralPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation:   admissionv1.Update,
+					SubResource: utils.EphemeralContainersSubResource,
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testEphemeralPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+				require.Equal(t, "/spec/ephemeralContainers/0/securityContext", resp.Patches[0].Path)
+			},
+		},
 		// todo: bad combination, selinux + hook
 		// todo: actually look at the content of the patches
 		{ // success pod changed - tailing logs
@@ -138,6 +528,111 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 2) // 2 because security context and the annotation
 			},
 		},
+		{ // success pod changed - tailing logs keeping the selinux context
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{
+						{
+							Spec: v1alpha1.ProfileRecordingSpec{
+								Kind:               v1alpha1.ProfileRecordingKindSelinuxProfile,
+								Recorder:           v1alpha1.ProfileRecorderLogs,
+								SelinuxKeepContext: true,
+							},
+						},
+					},
+				}, nil)
+				mock.GetProfileRecordingReturns(&v1alpha1.ProfileRecording{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-little-profile-recording",
+						Namespace: "test-ns",
+					},
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:               v1alpha1.ProfileRecordingKindSelinuxProfile,
+						Recorder:           v1alpha1.ProfileRecorderLogs,
+						SelinuxKeepContext: true,
+					},
+				}, nil)
+				mock.ListRecordedPodsReturns(&corev1.PodList{
+					Items: []corev1.Pod{},
+				}, nil)
+				mock.GetOperatorNamespaceReturns("test-ns")
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1) // only the annotation
+			},
+		},
+		{ // success pod changed - tailing logs for multiple kinds
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				spec := v1alpha1.ProfileRecordingSpec{
+					Kind:            v1alpha1.ProfileRecordingKindSeccompProfile,
+					AdditionalKinds: []v1alpha1.ProfileRecordingKind{v1alpha1.ProfileRecordingKindSelinuxProfile},
+					Recorder:        v1alpha1.ProfileRecorderLogs,
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{{Spec: spec}},
+				}, nil)
+				mock.GetProfileRecordingReturns(&v1alpha1.ProfileRecording{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-little-profile-recording",
+						Namespace: "test-ns",
+					},
+					Spec: spec,
+				}, nil)
+				mock.ListRecordedPodsReturns(&corev1.PodList{
+					Items: []corev1.Pod{},
+				}, nil)
+				mock.GetOperatorNamespaceReturns("test-ns")
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 2)
+				for _, patch := range resp.Patches {
+					switch patch.Path {
+					case "/metadata/annotations":
+						// Both kinds are recorded into profiles with the same name
+						annotations, ok := patch.Value.(map[string]any)
+						require.True(t, ok)
+						seccomp := annotations[config.SeccompProfileRecordLogsAnnotationKey+"container"]
+						require.NotEmpty(t, seccomp)
+						require.Equal(t, seccomp, annotations[config.SelinuxProfileRecordLogsAnnotationKey+"container"])
+					case "/spec/containers/0/securityContext":
+						sc, ok := patch.Value.(map[string]any)
+						require.True(t, ok)
+						require.Contains(t, sc, "seccompProfile")
+						require.Contains(t, sc, "seLinuxOptions")
+					default:
+						require.Fail(t, "unexpected patch", patch.Path)
+					}
+				}
+			},
+		},
 		{ // success pod changed
 			prepare: func(mock *recordingfakes.FakeImpl) {
 				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
@@ -182,6 +677,75 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 1)
 			},
 		},
+		{ // success pod of custom workload changed
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-little-profile-recording",
+						Namespace: "test-ns",
+					},
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder: v1alpha1.ProfileRecorderBpf,
+						OwnerSelector: &v1alpha1.OwnerSelector{
+							APIGroup: "tekton.dev",
+							Kind:     "TaskRun",
+						},
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.GetProfileRecordingReturns(recording.DeepCopy(), nil)
+				mock.ListRecordedPodsReturns(&corev1.PodList{
+					Items: []corev1.Pod{},
+				}, nil)
+				mock.DecodePodReturns(testTaskRunPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testTaskRunPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+			},
+		},
+		{ // success pod unchanged because the owner does not match
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				recording := v1alpha1.ProfileRecording{
+					Spec: v1alpha1.ProfileRecordingSpec{
+						Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+						Recorder: v1alpha1.ProfileRecorderBpf,
+						OwnerSelector: &v1alpha1.OwnerSelector{
+							APIGroup: "argoproj.io",
+							Kind:     "Workflow",
+						},
+					},
+				}
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{recording},
+				}, nil)
+				mock.GetProfileRecordingReturns(recording.DeepCopy(), nil)
+				mock.ListRecordedPodsReturns(&corev1.PodList{
+					Items: []corev1.Pod{},
+				}, nil)
+				mock.DecodePodReturns(testTaskRunPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
 		{ // success no seccomp profile
 			prepare: func(mock *recordingfakes.FakeImpl) {
 				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
@@ -498,6 +1062,8 @@ func TestHandle(t *testing.T) {
 		},
 	} {
 		mock := &recordingfakes.FakeImpl{}
+		mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+		mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{}, nil)
 		tc.prepare(mock)
 
 		recorder := podSeccompRecorder{impl: mock, log: logr.Discard(), record: utils.NewSafeRecorder(nil)}
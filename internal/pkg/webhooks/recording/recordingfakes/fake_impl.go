@@ -22,26 +22,83 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
-	v1 "k8s.io/api/core/v1"
-	v1a "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/api/authentication/v1"
+	v1a "k8s.io/api/core/v1"
+	v1b "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	"sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	v1alpha1a "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 )
 
 type FakeImpl struct {
-	DecodePodStub        func(admission.Request) (*v1.Pod, error)
+	CanApproveRecordingsStub        func(context.Context, *v1.UserInfo, string) (bool, error)
+	canApproveRecordingsMutex       sync.RWMutex
+	canApproveRecordingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1.UserInfo
+		arg3 string
+	}
+	canApproveRecordingsReturns struct {
+		result1 bool
+		result2 error
+	}
+	canApproveRecordingsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DecodeOldProfileRecordingStub        func(admission.Request) (*v1alpha1.ProfileRecording, error)
+	decodeOldProfileRecordingMutex       sync.RWMutex
+	decodeOldProfileRecordingArgsForCall []struct {
+		arg1 admission.Request
+	}
+	decodeOldProfileRecordingReturns struct {
+		result1 *v1alpha1.ProfileRecording
+		result2 error
+	}
+	decodeOldProfileRecordingReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ProfileRecording
+		result2 error
+	}
+	DecodePodStub        func(admission.Request) (*v1a.Pod, error)
 	decodePodMutex       sync.RWMutex
 	decodePodArgsForCall []struct {
 		arg1 admission.Request
 	}
 	decodePodReturns struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
 		result2 error
 	}
 	decodePodReturnsOnCall map[int]struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
+		result2 error
+	}
+	DecodeProfileRecordingStub        func(admission.Request) (*v1alpha1.ProfileRecording, error)
+	decodeProfileRecordingMutex       sync.RWMutex
+	decodeProfileRecordingArgsForCall []struct {
+		arg1 admission.Request
+	}
+	decodeProfileRecordingReturns struct {
+		result1 *v1alpha1.ProfileRecording
+		result2 error
+	}
+	decodeProfileRecordingReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ProfileRecording
+		result2 error
+	}
+	GetNamespaceStub        func(context.Context, string) (*v1a.Namespace, error)
+	getNamespaceMutex       sync.RWMutex
+	getNamespaceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNamespaceReturns struct {
+		result1 *v1a.Namespace
+		result2 error
+	}
+	getNamespaceReturnsOnCall map[int]struct {
+		result1 *v1a.Namespace
 		result2 error
 	}
 	GetOperatorNamespaceStub        func() string
@@ -69,10 +126,23 @@ type FakeImpl struct {
 		result1 *v1alpha1.ProfileRecording
 		result2 error
 	}
-	LabelSelectorAsSelectorStub        func(*v1a.LabelSelector) (labels.Selector, error)
+	GetSPOdStub        func(context.Context) (*v1alpha1a.SecurityProfilesOperatorDaemon, error)
+	getSPOdMutex       sync.RWMutex
+	getSPOdArgsForCall []struct {
+		arg1 context.Context
+	}
+	getSPOdReturns struct {
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	getSPOdReturnsOnCall map[int]struct {
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	LabelSelectorAsSelectorStub        func(*v1b.LabelSelector) (labels.Selector, error)
 	labelSelectorAsSelectorMutex       sync.RWMutex
 	labelSelectorAsSelectorArgsForCall []struct {
-		arg1 *v1a.LabelSelector
+		arg1 *v1b.LabelSelector
 	}
 	labelSelectorAsSelectorReturns struct {
 		result1 labels.Selector
@@ -96,19 +166,19 @@ type FakeImpl struct {
 		result1 *v1alpha1.ProfileRecordingList
 		result2 error
 	}
-	ListRecordedPodsStub        func(context.Context, string, *v1a.LabelSelector) (*v1.PodList, error)
+	ListRecordedPodsStub        func(context.Context, string, *v1b.LabelSelector) (*v1a.PodList, error)
 	listRecordedPodsMutex       sync.RWMutex
 	listRecordedPodsArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
-		arg3 *v1a.LabelSelector
+		arg3 *v1b.LabelSelector
 	}
 	listRecordedPodsReturns struct {
-		result1 *v1.PodList
+		result1 *v1a.PodList
 		result2 error
 	}
 	listRecordedPodsReturnsOnCall map[int]struct {
-		result1 *v1.PodList
+		result1 *v1a.PodList
 		result2 error
 	}
 	UpdateResourceStub        func(context.Context, logr.Logger, client.Object, string) error
@@ -143,7 +213,137 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeImpl) DecodePod(arg1 admission.Request) (*v1.Pod, error) {
+func (fake *FakeImpl) CanApproveRecordings(arg1 context.Context, arg2 *v1.UserInfo, arg3 string) (bool, error) {
+	fake.canApproveRecordingsMutex.Lock()
+	ret, specificReturn := fake.canApproveRecordingsReturnsOnCall[len(fake.canApproveRecordingsArgsForCall)]
+	fake.canApproveRecordingsArgsForCall = append(fake.canApproveRecordingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1.UserInfo
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CanApproveRecordingsStub
+	fakeReturns := fake.canApproveRecordingsReturns
+	fake.recordInvocation("CanApproveRecordings", []interface{}{arg1, arg2, arg3})
+	fake.canApproveRecordingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) CanApproveRecordingsCallCount() int {
+	fake.canApproveRecordingsMutex.RLock()
+	defer fake.canApproveRecordingsMutex.RUnlock()
+	return len(fake.canApproveRecordingsArgsForCall)
+}
+
+func (fake *FakeImpl) CanApproveRecordingsCalls(stub func(context.Context, *v1.UserInfo, string) (bool, error)) {
+	fake.canApproveRecordingsMutex.Lock()
+	defer fake.canApproveRecordingsMutex.Unlock()
+	fake.CanApproveRecordingsStub = stub
+}
+
+func (fake *FakeImpl) CanApproveRecordingsArgsForCall(i int) (context.Context, *v1.UserInfo, string) {
+	fake.canApproveRecordingsMutex.RLock()
+	defer fake.canApproveRecordingsMutex.RUnlock()
+	argsForCall := fake.canApproveRecordingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) CanApproveRecordingsReturns(result1 bool, result2 error) {
+	fake.canApproveRecordingsMutex.Lock()
+	defer fake.canApproveRecordingsMutex.Unlock()
+	fake.CanApproveRecordingsStub = nil
+	fake.canApproveRecordingsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) CanApproveRecordingsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.canApproveRecordingsMutex.Lock()
+	defer fake.canApproveRecordingsMutex.Unlock()
+	fake.CanApproveRecordingsStub = nil
+	if fake.canApproveRecordingsReturnsOnCall == nil {
+		fake.canApproveRecordingsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.canApproveRecordingsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeOldProfileRecording(arg1 admission.Request) (*v1alpha1.ProfileRecording, error) {
+	fake.decodeOldProfileRecordingMutex.Lock()
+	ret, specificReturn := fake.decodeOldProfileRecordingReturnsOnCall[len(fake.decodeOldProfileRecordingArgsForCall)]
+	fake.decodeOldProfileRecordingArgsForCall = append(fake.decodeOldProfileRecordingArgsForCall, struct {
+		arg1 admission.Request
+	}{arg1})
+	stub := fake.DecodeOldProfileRecordingStub
+	fakeReturns := fake.decodeOldProfileRecordingReturns
+	fake.recordInvocation("DecodeOldProfileRecording", []interface{}{arg1})
+	fake.decodeOldProfileRecordingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) DecodeOldProfileRecordingCallCount() int {
+	fake.decodeOldProfileRecordingMutex.RLock()
+	defer fake.decodeOldProfileRecordingMutex.RUnlock()
+	return len(fake.decodeOldProfileRecordingArgsForCall)
+}
+
+func (fake *FakeImpl) DecodeOldProfileRecordingCalls(stub func(admission.Request) (*v1alpha1.ProfileRecording, error)) {
+	fake.decodeOldProfileRecordingMutex.Lock()
+	defer fake.decodeOldProfileRecordingMutex.Unlock()
+	fake.DecodeOldProfileRecordingStub = stub
+}
+
+func (fake *FakeImpl) DecodeOldProfileRecordingArgsForCall(i int) admission.Request {
+	fake.decodeOldProfileRecordingMutex.RLock()
+	defer fake.decodeOldProfileRecordingMutex.RUnlock()
+	argsForCall := fake.decodeOldProfileRecordingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) DecodeOldProfileRecordingReturns(result1 *v1alpha1.ProfileRecording, result2 error) {
+	fake.decodeOldProfileRecordingMutex.Lock()
+	defer fake.decodeOldProfileRecordingMutex.Unlock()
+	fake.DecodeOldProfileRecordingStub = nil
+	fake.decodeOldProfileRecordingReturns = struct {
+		result1 *v1alpha1.ProfileRecording
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeOldProfileRecordingReturnsOnCall(i int, result1 *v1alpha1.ProfileRecording, result2 error) {
+	fake.decodeOldProfileRecordingMutex.Lock()
+	defer fake.decodeOldProfileRecordingMutex.Unlock()
+	fake.DecodeOldProfileRecordingStub = nil
+	if fake.decodeOldProfileRecordingReturnsOnCall == nil {
+		fake.decodeOldProfileRecordingReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ProfileRecording
+			result2 error
+		})
+	}
+	fake.decodeOldProfileRecordingReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ProfileRecording
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodePod(arg1 admission.Request) (*v1a.Pod, error) {
 	fake.decodePodMutex.Lock()
 	ret, specificReturn := fake.decodePodReturnsOnCall[len(fake.decodePodArgsForCall)]
 	fake.decodePodArgsForCall = append(fake.decodePodArgsForCall, struct {
@@ -168,7 +368,7 @@ func (fake *FakeImpl) DecodePodCallCount() int {
 	return len(fake.decodePodArgsForCall)
 }
 
-func (fake *FakeImpl) DecodePodCalls(stub func(admission.Request) (*v1.Pod, error)) {
+func (fake *FakeImpl) DecodePodCalls(stub func(admission.Request) (*v1a.Pod, error)) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = stub
@@ -181,28 +381,157 @@ func (fake *FakeImpl) DecodePodArgsForCall(i int) admission.Request {
 	return argsForCall.arg1
 }
 
-func (fake *FakeImpl) DecodePodReturns(result1 *v1.Pod, result2 error) {
+func (fake *FakeImpl) DecodePodReturns(result1 *v1a.Pod, result2 error) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = nil
 	fake.decodePodReturns = struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) DecodePodReturnsOnCall(i int, result1 *v1.Pod, result2 error) {
+func (fake *FakeImpl) DecodePodReturnsOnCall(i int, result1 *v1a.Pod, result2 error) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = nil
 	if fake.decodePodReturnsOnCall == nil {
 		fake.decodePodReturnsOnCall = make(map[int]struct {
-			result1 *v1.Pod
+			result1 *v1a.Pod
 			result2 error
 		})
 	}
 	fake.decodePodReturnsOnCall[i] = struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeProfileRecording(arg1 admission.Request) (*v1alpha1.ProfileRecording, error) {
+	fake.decodeProfileRecordingMutex.Lock()
+	ret, specificReturn := fake.decodeProfileRecordingReturnsOnCall[len(fake.decodeProfileRecordingArgsForCall)]
+	fake.decodeProfileRecordingArgsForCall = append(fake.decodeProfileRecordingArgsForCall, struct {
+		arg1 admission.Request
+	}{arg1})
+	stub := fake.DecodeProfileRecordingStub
+	fakeReturns := fake.decodeProfileRecordingReturns
+	fake.recordInvocation("DecodeProfileRecording", []interface{}{arg1})
+	fake.decodeProfileRecordingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) DecodeProfileRecordingCallCount() int {
+	fake.decodeProfileRecordingMutex.RLock()
+	defer fake.decodeProfileRecordingMutex.RUnlock()
+	return len(fake.decodeProfileRecordingArgsForCall)
+}
+
+func (fake *FakeImpl) DecodeProfileRecordingCalls(stub func(admission.Request) (*v1alpha1.ProfileRecording, error)) {
+	fake.decodeProfileRecordingMutex.Lock()
+	defer fake.decodeProfileRecordingMutex.Unlock()
+	fake.DecodeProfileRecordingStub = stub
+}
+
+func (fake *FakeImpl) DecodeProfileRecordingArgsForCall(i int) admission.Request {
+	fake.decodeProfileRecordingMutex.RLock()
+	defer fake.decodeProfileRecordingMutex.RUnlock()
+	argsForCall := fake.decodeProfileRecordingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) DecodeProfileRecordingReturns(result1 *v1alpha1.ProfileRecording, result2 error) {
+	fake.decodeProfileRecordingMutex.Lock()
+	defer fake.decodeProfileRecordingMutex.Unlock()
+	fake.DecodeProfileRecordingStub = nil
+	fake.decodeProfileRecordingReturns = struct {
+		result1 *v1alpha1.ProfileRecording
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeProfileRecordingReturnsOnCall(i int, result1 *v1alpha1.ProfileRecording, result2 error) {
+	fake.decodeProfileRecordingMutex.Lock()
+	defer fake.decodeProfileRecordingMutex.Unlock()
+	fake.DecodeProfileRecordingStub = nil
+	if fake.decodeProfileRecordingReturnsOnCall == nil {
+		fake.decodeProfileRecordingReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ProfileRecording
+			result2 error
+		})
+	}
+	fake.decodeProfileRecordingReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ProfileRecording
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespace(arg1 context.Context, arg2 string) (*v1a.Namespace, error) {
+	fake.getNamespaceMutex.Lock()
+	ret, specificReturn := fake.getNamespaceReturnsOnCall[len(fake.getNamespaceArgsForCall)]
+	fake.getNamespaceArgsForCall = append(fake.getNamespaceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNamespaceStub
+	fakeReturns := fake.getNamespaceReturns
+	fake.recordInvocation("GetNamespace", []interface{}{arg1, arg2})
+	fake.getNamespaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetNamespaceCallCount() int {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	return len(fake.getNamespaceArgsForCall)
+}
+
+func (fake *FakeImpl) GetNamespaceCalls(stub func(context.Context, string) (*v1a.Namespace, error)) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = stub
+}
+
+func (fake *FakeImpl) GetNamespaceArgsForCall(i int) (context.Context, string) {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	argsForCall := fake.getNamespaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetNamespaceReturns(result1 *v1a.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	fake.getNamespaceReturns = struct {
+		result1 *v1a.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespaceReturnsOnCall(i int, result1 *v1a.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	if fake.getNamespaceReturnsOnCall == nil {
+		fake.getNamespaceReturnsOnCall = make(map[int]struct {
+			result1 *v1a.Namespace
+			result2 error
+		})
+	}
+	fake.getNamespaceReturnsOnCall[i] = struct {
+		result1 *v1a.Namespace
 		result2 error
 	}{result1, result2}
 }
@@ -326,11 +655,75 @@ func (fake *FakeImpl) GetProfileRecordingReturnsOnCall(i int, result1 *v1alpha1.
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) LabelSelectorAsSelector(arg1 *v1a.LabelSelector) (labels.Selector, error) {
+func (fake *FakeImpl) GetSPOd(arg1 context.Context) (*v1alpha1a.SecurityProfilesOperatorDaemon, error) {
+	fake.getSPOdMutex.Lock()
+	ret, specificReturn := fake.getSPOdReturnsOnCall[len(fake.getSPOdArgsForCall)]
+	fake.getSPOdArgsForCall = append(fake.getSPOdArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetSPOdStub
+	fakeReturns := fake.getSPOdReturns
+	fake.recordInvocation("GetSPOd", []interface{}{arg1})
+	fake.getSPOdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSPOdCallCount() int {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	return len(fake.getSPOdArgsForCall)
+}
+
+func (fake *FakeImpl) GetSPOdCalls(stub func(context.Context) (*v1alpha1a.SecurityProfilesOperatorDaemon, error)) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = stub
+}
+
+func (fake *FakeImpl) GetSPOdArgsForCall(i int) context.Context {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	argsForCall := fake.getSPOdArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetSPOdReturns(result1 *v1alpha1a.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	fake.getSPOdReturns = struct {
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOdReturnsOnCall(i int, result1 *v1alpha1a.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	if fake.getSPOdReturnsOnCall == nil {
+		fake.getSPOdReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1a.SecurityProfilesOperatorDaemon
+			result2 error
+		})
+	}
+	fake.getSPOdReturnsOnCall[i] = struct {
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) LabelSelectorAsSelector(arg1 *v1b.LabelSelector) (labels.Selector, error) {
 	fake.labelSelectorAsSelectorMutex.Lock()
 	ret, specificReturn := fake.labelSelectorAsSelectorReturnsOnCall[len(fake.labelSelectorAsSelectorArgsForCall)]
 	fake.labelSelectorAsSelectorArgsForCall = append(fake.labelSelectorAsSelectorArgsForCall, struct {
-		arg1 *v1a.LabelSelector
+		arg1 *v1b.LabelSelector
 	}{arg1})
 	stub := fake.LabelSelectorAsSelectorStub
 	fakeReturns := fake.labelSelectorAsSelectorReturns
@@ -351,13 +744,13 @@ func (fake *FakeImpl) LabelSelectorAsSelectorCallCount() int {
 	return len(fake.labelSelectorAsSelectorArgsForCall)
 }
 
-func (fake *FakeImpl) LabelSelectorAsSelectorCalls(stub func(*v1a.LabelSelector) (labels.Selector, error)) {
+func (fake *FakeImpl) LabelSelectorAsSelectorCalls(stub func(*v1b.LabelSelector) (labels.Selector, error)) {
 	fake.labelSelectorAsSelectorMutex.Lock()
 	defer fake.labelSelectorAsSelectorMutex.Unlock()
 	fake.LabelSelectorAsSelectorStub = stub
 }
 
-func (fake *FakeImpl) LabelSelectorAsSelectorArgsForCall(i int) *v1a.LabelSelector {
+func (fake *FakeImpl) LabelSelectorAsSelectorArgsForCall(i int) *v1b.LabelSelector {
 	fake.labelSelectorAsSelectorMutex.RLock()
 	defer fake.labelSelectorAsSelectorMutex.RUnlock()
 	argsForCall := fake.labelSelectorAsSelectorArgsForCall[i]
@@ -455,13 +848,13 @@ func (fake *FakeImpl) ListProfileRecordingsReturnsOnCall(i int, result1 *v1alpha
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) ListRecordedPods(arg1 context.Context, arg2 string, arg3 *v1a.LabelSelector) (*v1.PodList, error) {
+func (fake *FakeImpl) ListRecordedPods(arg1 context.Context, arg2 string, arg3 *v1b.LabelSelector) (*v1a.PodList, error) {
 	fake.listRecordedPodsMutex.Lock()
 	ret, specificReturn := fake.listRecordedPodsReturnsOnCall[len(fake.listRecordedPodsArgsForCall)]
 	fake.listRecordedPodsArgsForCall = append(fake.listRecordedPodsArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-		arg3 *v1a.LabelSelector
+		arg3 *v1b.LabelSelector
 	}{arg1, arg2, arg3})
 	stub := fake.ListRecordedPodsStub
 	fakeReturns := fake.listRecordedPodsReturns
@@ -482,41 +875,41 @@ func (fake *FakeImpl) ListRecordedPodsCallCount() int {
 	return len(fake.listRecordedPodsArgsForCall)
 }
 
-func (fake *FakeImpl) ListRecordedPodsCalls(stub func(context.Context, string, *v1a.LabelSelector) (*v1.PodList, error)) {
+func (fake *FakeImpl) ListRecordedPodsCalls(stub func(context.Context, string, *v1b.LabelSelector) (*v1a.PodList, error)) {
 	fake.listRecordedPodsMutex.Lock()
 	defer fake.listRecordedPodsMutex.Unlock()
 	fake.ListRecordedPodsStub = stub
 }
 
-func (fake *FakeImpl) ListRecordedPodsArgsForCall(i int) (context.Context, string, *v1a.LabelSelector) {
+func (fake *FakeImpl) ListRecordedPodsArgsForCall(i int) (context.Context, string, *v1b.LabelSelector) {
 	fake.listRecordedPodsMutex.RLock()
 	defer fake.listRecordedPodsMutex.RUnlock()
 	argsForCall := fake.listRecordedPodsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeImpl) ListRecordedPodsReturns(result1 *v1.PodList, result2 error) {
+func (fake *FakeImpl) ListRecordedPodsReturns(result1 *v1a.PodList, result2 error) {
 	fake.listRecordedPodsMutex.Lock()
 	defer fake.listRecordedPodsMutex.Unlock()
 	fake.ListRecordedPodsStub = nil
 	fake.listRecordedPodsReturns = struct {
-		result1 *v1.PodList
+		result1 *v1a.PodList
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) ListRecordedPodsReturnsOnCall(i int, result1 *v1.PodList, result2 error) {
+func (fake *FakeImpl) ListRecordedPodsReturnsOnCall(i int, result1 *v1a.PodList, result2 error) {
 	fake.listRecordedPodsMutex.Lock()
 	defer fake.listRecordedPodsMutex.Unlock()
 	fake.ListRecordedPodsStub = nil
 	if fake.listRecordedPodsReturnsOnCall == nil {
 		fake.listRecordedPodsReturnsOnCall = make(map[int]struct {
-			result1 *v1.PodList
+			result1 *v1a.PodList
 			result2 error
 		})
 	}
 	fake.listRecordedPodsReturnsOnCall[i] = struct {
-		result1 *v1.PodList
+		result1 *v1a.PodList
 		result2 error
 	}{result1, result2}
 }
@@ -652,12 +1045,22 @@ func (fake *FakeImpl) UpdateResourceStatusReturnsOnCall(i int, result1 error) {
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.canApproveRecordingsMutex.RLock()
+	defer fake.canApproveRecordingsMutex.RUnlock()
+	fake.decodeOldProfileRecordingMutex.RLock()
+	defer fake.decodeOldProfileRecordingMutex.RUnlock()
 	fake.decodePodMutex.RLock()
 	defer fake.decodePodMutex.RUnlock()
+	fake.decodeProfileRecordingMutex.RLock()
+	defer fake.decodeProfileRecordingMutex.RUnlock()
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
 	fake.getOperatorNamespaceMutex.RLock()
 	defer fake.getOperatorNamespaceMutex.RUnlock()
 	fake.getProfileRecordingMutex.RLock()
 	defer fake.getProfileRecordingMutex.RUnlock()
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
 	fake.labelSelectorAsSelectorMutex.RLock()
 	defer fake.labelSelectorAsSelectorMutex.RUnlock()
 	fake.listProfileRecordingsMutex.RLock()
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompprofile
+
+import (
+	"context"
+	"fmt"
+
+	libseccomp "github.com/seccomp/libseccomp-golang"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+type defaultImpl struct {
+	client  client.Client
+	decoder *admission.Decoder
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	DecodeSeccompProfile(admission.Request) (*seccompprofileapi.SeccompProfile, error)
+	DecodeOldSeccompProfile(admission.Request) (*seccompprofileapi.SeccompProfile, error)
+	ListNodes(context.Context) (*corev1.NodeList, error)
+	GetSPOd(context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error)
+	GetArchFromString(string) (libseccomp.ScmpArch, error)
+	GetNativeArch() (libseccomp.ScmpArch, error)
+	GetSyscallFromNameByArch(string, libseccomp.ScmpArch) (libseccomp.ScmpSyscall, error)
+}
+
+//nolint:gocritic
+func (d *defaultImpl) DecodeSeccompProfile(req admission.Request) (*seccompprofileapi.SeccompProfile, error) {
+	seccompProfile := &seccompprofileapi.SeccompProfile{}
+	if err := d.decoder.Decode(req, seccompProfile); err != nil {
+		return nil, fmt.Errorf("decode seccomp profile: %w", err)
+	}
+	return seccompProfile, nil
+}
+
+//nolint:gocritic
+func (d *defaultImpl) DecodeOldSeccompProfile(req admission.Request) (*seccompprofileapi.SeccompProfile, error) {
+	seccompProfile := &seccompprofileapi.SeccompProfile{}
+	if err := d.decoder.DecodeRaw(req.OldObject, seccompProfile); err != nil {
+		return nil, fmt.Errorf("decode old seccomp profile: %w", err)
+	}
+	return seccompProfile, nil
+}
+
+func (d *defaultImpl) ListNodes(ctx context.Context) (*corev1.NodeList, error) {
+	nodes := &corev1.NodeList{}
+	if err := d.client.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+	return nodes, nil
+}
+
+func (d *defaultImpl) GetSPOd(ctx context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Namespace: config.GetOperatorNamespace(), Name: config.SPOdName}
+	if err := d.client.Get(ctx, key, spod); err != nil {
+		return nil, fmt.Errorf("get spod: %w", err)
+	}
+	return spod, nil
+}
+
+func (*defaultImpl) GetArchFromString(arch string) (libseccomp.ScmpArch, error) {
+	return libseccomp.GetArchFromString(arch)
+}
+
+func (*defaultImpl) GetNativeArch() (libseccomp.ScmpArch, error) {
+	return libseccomp.GetNativeArch()
+}
+
+func (*defaultImpl) GetSyscallFromNameByArch(
+	name string, arch libseccomp.ScmpArch,
+) (libseccomp.ScmpSyscall, error) {
+	return libseccomp.GetSyscallFromNameByArch(name, arch)
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompprofile
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	libseccomp "github.com/seccomp/libseccomp-golang"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/seccomplint"
+)
+
+const (
+	archNative = seccompprofileapi.Arch(seccomp.ArchNative)
+	archPrefix = "SCMP_ARCH_"
+)
+
+// nodeArches maps the architecture of a node to the seccomp architectures
+// supported by its kernel.
+var nodeArches = map[string][]seccompprofileapi.Arch{
+	"amd64":    {"SCMP_ARCH_X86_64", "SCMP_ARCH_X86", "SCMP_ARCH_X32"},
+	"arm64":    {"SCMP_ARCH_AARCH64", "SCMP_ARCH_ARM"},
+	"arm":      {"SCMP_ARCH_ARM"},
+	"386":      {"SCMP_ARCH_X86"},
+	"ppc64le":  {"SCMP_ARCH_PPC64LE"},
+	"s390x":    {"SCMP_ARCH_S390X", "SCMP_ARCH_S390"},
+	"riscv64":  {"SCMP_ARCH_RISCV64"},
+	"mips64le": {"SCMP_ARCH_MIPSEL64", "SCMP_ARCH_MIPSEL64N32", "SCMP_ARCH_MIPSEL"},
+}
+
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+
+func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Client) {
+	server.Register(
+		"/validate-v1beta1-seccompprofile",
+		&webhook.Admission{
+			Handler: &seccompProfileValidator{
+				impl: &defaultImpl{
+					client:  c,
+					decoder: admission.NewDecoder(scheme),
+				},
+				log: logf.Log.WithName("seccompprofile-validation"),
+			},
+		},
+	)
+}
+
+type seccompProfileValidator struct {
+	impl
+	log logr.Logger
+}
+
+//nolint:gocritic
+func (v *seccompProfileValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Delete {
+		return admission.Allowed("seccomp profile deleted")
+	}
+
+	profile, err := v.DecodeSeccompProfile(req)
+	if err != nil {
+		v.log.Error(err, "Failed to decode seccomp profile")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Existing profiles can still be updated, for example to remove their
+	// finalizers, without validating them again.
+	if req.Operation == admissionv1.Update {
+		oldProfile, err := v.DecodeOldSeccompProfile(req)
+		if err != nil {
+			v.log.Error(err, "Failed to decode old seccomp profile")
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if equality.Semantic.DeepEqual(oldProfile.Spec, profile.Spec) {
+			return admission.Allowed("seccomp profile spec unchanged")
+		}
+	}
+
+	nodes, err := v.ListNodes(ctx)
+	if err != nil {
+		v.log.Error(err, "Failed to list nodes")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	arches := supportedArches(nodes)
+	problems := v.validate(profile, arches)
+	if len(problems) > 0 {
+		return admission.Denied(fmt.Sprintf(
+			"invalid seccomp profile %s: %s", profile.GetName(), strings.Join(problems, "; "),
+		))
+	}
+
+	severities, err := v.lintSeverities(ctx)
+	if err != nil {
+		v.log.Error(err, "Failed to get lint rule severities")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	// The syscalls known by the libseccomp of the webhook may differ from
+	// the ones known on the nodes, which is why unknown syscalls are only
+	// reported as warnings.
+	warnings := append(redundantRules(profile), v.validateSyscallNames(profile, arches)...)
+	findings := seccomplint.Lint(&profile.Spec, severities)
+	for i := range findings {
+		if findings[i].Severity == spodv1alpha1.SeccompLintSeverityDeny {
+			problems = append(problems, findings[i].String())
+		} else {
+			warnings = append(warnings, findings[i].String())
+		}
+	}
+	if len(problems) > 0 {
+		return admission.Denied(fmt.Sprintf(
+			"seccomp profile %s violates lint rules: %s", profile.GetName(), strings.Join(problems, "; "),
+		))
+	}
+	return admission.Allowed("seccomp profile valid").WithWarnings(warnings...)
+}
+
+// lintSeverities returns the severities of the lint rules, which are
+// configured cluster wide in the SPOD configuration.
+func (v *seccompProfileValidator) lintSeverities(ctx context.Context) (seccomplint.Severities, error) {
+	var configured map[string]spodv1alpha1.SeccompLintSeverity
+	spod, err := v.GetSPOd(ctx)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("get spod configuration: %w", err)
+	}
+	if spod != nil {
+		configured = spod.Spec.SeccompLintRules
+	}
+
+	severities, unknown := seccomplint.NewSeverities(configured)
+	if len(unknown) > 0 {
+		v.log.Info("Ignoring unknown seccomp lint rules", "rules", unknown)
+	}
+	return severities, nil
+}
+
+// supportedArches returns the seccomp architectures supported by the kernels
+// of the nodes.
+func supportedArches(nodes *corev1.NodeList) []seccompprofileapi.Arch {
+	res := []seccompprofileapi.Arch{}
+	for i := range nodes.Items {
+		for _, arch := range nodeArches[nodes.Items[i].Labels[corev1.LabelArchStable]] {
+			if !slices.Contains(res, arch) {
+				res = append(res, arch)
+			}
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// validate returns all problems of the profile which would let it fail on the
+// nodes.
+func (v *seccompProfileValidator) validate(
+	profile *seccompprofileapi.SeccompProfile, supported []seccompprofileapi.Arch,
+) []string {
+	problems := validateActions(profile)
+
+	if len(supported) > 0 {
+		for _, arch := range profile.Spec.Architectures {
+			if arch != archNative && !slices.Contains(supported, arch) {
+				problems = append(problems, fmt.Sprintf(
+					"architecture %s is not supported by any node, supported architectures are %v",
+					arch, supported,
+				))
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateActions checks that the actions are supported and that the actions
+// of the rules do not conflict with each other.
+func validateActions(profile *seccompprofileapi.SeccompProfile) []string {
+	problems := []string{}
+
+	if profile.Spec.DefaultAction == seccomp.ActNotify {
+		problems = append(problems, fmt.Sprintf("%s cannot be used as default action", seccomp.ActNotify))
+	}
+
+	if profile.Spec.DefaultErrnoRet != nil {
+		if !supportsErrnoRet(profile.Spec.DefaultAction) {
+			problems = append(problems, fmt.Sprintf(
+				"defaultErrnoRet is only supported for %s and %s, not for %s",
+				seccomp.ActErrno, seccomp.ActTrace, profile.Spec.DefaultAction,
+			))
+		}
+	}
+
+	seen := map[string]int{}
+	for i, rule := range profile.Spec.Syscalls {
+		if rule == nil {
+			continue
+		}
+
+		if rule.ErrnoRet != 0 && !supportsErrnoRet(rule.Action) {
+			problems = append(problems, fmt.Sprintf(
+				"syscalls[%d]: errnoRet is only supported for %s and %s, not for %s",
+				i, seccomp.ActErrno, seccomp.ActTrace, rule.Action,
+			))
+		}
+
+		// Rules with arguments only match some invocations of the syscalls
+		if len(rule.Args) > 0 {
+			continue
+		}
+
+		for _, name := range rule.Names {
+			j, ok := seen[name]
+			if !ok {
+				seen[name] = i
+				continue
+			}
+			other := profile.Spec.Syscalls[j]
+			if other.Action != rule.Action || other.ErrnoRet != rule.ErrnoRet {
+				problems = append(problems, fmt.Sprintf(
+					"syscall %q has the conflicting actions %s in syscalls[%d] and %s in syscalls[%d]",
+					name, other.Action, j, rule.Action, i,
+				))
+			}
+		}
+	}
+
+	return problems
+}
+
+// redundantRules returns warnings for rules which have no effect because they
+// match the default action. Container runtimes skip those rules.
+func redundantRules(profile *seccompprofileapi.SeccompProfile) []string {
+	var defaultErrnoRet uint
+	if profile.Spec.DefaultErrnoRet != nil {
+		defaultErrnoRet = *profile.Spec.DefaultErrnoRet
+	}
+
+	warnings := []string{}
+	for i, rule := range profile.Spec.Syscalls {
+		if rule != nil && len(rule.Args) == 0 &&
+			rule.Action == profile.Spec.DefaultAction && rule.ErrnoRet == defaultErrnoRet {
+			warnings = append(warnings, fmt.Sprintf(
+				"syscalls[%d]: action %s equals the default action and has no effect", i, rule.Action,
+			))
+		}
+	}
+	return warnings
+}
+
+func supportsErrnoRet(action seccomp.Action) bool {
+	return action == seccomp.ActErrno || action == seccomp.ActTrace
+}
+
+// validateSyscallNames checks that all syscalls are known for at least one of
+// the architectures of the profile and returns the unknown ones. Profiles
+// without explicit architectures are checked against the ones of the nodes.
+func (v *seccompProfileValidator) validateSyscallNames(
+	profile *seccompprofileapi.SeccompProfile, supported []seccompprofileapi.Arch,
+) []string {
+	archNames := []seccompprofileapi.Arch{}
+	for _, arch := range profile.Spec.Architectures {
+		if arch != archNative {
+			archNames = append(archNames, arch)
+		}
+	}
+	if len(archNames) == 0 {
+		archNames = supported
+	}
+
+	arches := []libseccomp.ScmpArch{}
+	for _, name := range archNames {
+		arch, err := v.GetArchFromString(strings.TrimPrefix(string(name), archPrefix))
+		if err != nil {
+			v.log.Info("Skipping unknown architecture", "arch", name)
+			continue
+		}
+		arches = append(arches, arch)
+	}
+	if len(arches) == 0 {
+		arch, err := v.GetNativeArch()
+		if err != nil {
+			v.log.Error(err, "Failed to get native architecture, skipping syscall validation")
+			return nil
+		}
+		arches = append(arches, arch)
+	}
+
+	problems := []string{}
+	for i, rule := range profile.Spec.Syscalls {
+		if rule == nil {
+			continue
+		}
+		for _, name := range rule.Names {
+			if !v.syscallExists(name, arches) {
+				problems = append(problems, fmt.Sprintf("syscalls[%d]: unknown syscall %q", i, name))
+			}
+		}
+	}
+	return problems
+}
+
+func (v *seccompProfileValidator) syscallExists(name string, arches []libseccomp.ScmpArch) bool {
+	for _, arch := range arches {
+		if _, err := v.GetSyscallFromNameByArch(name, arch); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompprofile
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	libseccomp "github.com/seccomp/libseccomp-golang"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/seccompprofile/seccompprofilefakes"
+)
+
+var errTest = errors.New("test")
+
+func TestValidateSeccompProfile(t *testing.T) {
+	t.Parallel()
+
+	errnoRet := uint(38)
+	profile := func(modify func(*seccompprofileapi.SeccompProfileSpec)) *seccompprofileapi.SeccompProfile {
+		sp := &seccompprofileapi.SeccompProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction: "SCMP_ACT_ERRNO",
+				Architectures: []seccompprofileapi.Arch{"SCMP_ARCH_X86_64"},
+				Syscalls: []*seccompprofileapi.Syscall{
+					{Names: []string{"read", "write"}, Action: "SCMP_ACT_ALLOW"},
+				},
+			},
+		}
+		if modify != nil {
+			modify(&sp.Spec)
+		}
+		return sp
+	}
+	nodes := &corev1.NodeList{Items: []corev1.Node{{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{corev1.LabelArchStable: "amd64"}},
+	}}}
+	prepare := func(mock *seccompprofilefakes.FakeImpl, sp *seccompprofileapi.SeccompProfile) {
+		mock.DecodeSeccompProfileReturns(sp, nil)
+		mock.ListNodesReturns(nodes, nil)
+		mock.GetArchFromStringReturns(libseccomp.ArchAMD64, nil)
+		mock.GetSyscallFromNameByArchCalls(func(name string, _ libseccomp.ScmpArch) (libseccomp.ScmpSyscall, error) {
+			if name == "unknown" {
+				return 0, libseccomp.ErrSyscallDoesNotExist
+			}
+			return 1, nil
+		})
+	}
+
+	for _, tc := range []struct {
+		name      string
+		prepare   func(*seccompprofilefakes.FakeImpl)
+		operation admissionv1.Operation
+		allowed   bool
+		code      int32
+		warnings  int
+	}{
+		{
+			name: "valid profile",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(nil))
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "valid profile with errno rule",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.Architectures = []seccompprofileapi.Arch{"SCMP_ARCH_X86_64", "SCMP_ARCH_X86"}
+					spec.Syscalls = append(spec.Syscalls, &seccompprofileapi.Syscall{
+						Names: []string{"clone3"}, Action: "SCMP_ACT_ERRNO", ErrnoRet: errnoRet,
+					})
+				}))
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "unknown syscall",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.Syscalls[0].Names = append(spec.Syscalls[0].Names, "unknown")
+				}))
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+			warnings:  1,
+		},
+		{
+			name: "rule equals default action",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.Syscalls[0].Action = "SCMP_ACT_ERRNO"
+				}))
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+			warnings:  1,
+		},
+		{
+			name: "conflicting rules",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.Syscalls = append(spec.Syscalls, &seccompprofileapi.Syscall{
+						Names: []string{"read"}, Action: "SCMP_ACT_LOG",
+					})
+				}))
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "errno for allow action",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.Syscalls[0].ErrnoRet = errnoRet
+				}))
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "notify default action",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.DefaultAction = "SCMP_ACT_NOTIFY"
+				}))
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "unsupported architecture",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.Architectures = []seccompprofileapi.Arch{"SCMP_ARCH_AARCH64"}
+				}))
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "lint rule warning",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.Architectures = nil
+					spec.Syscalls[0].Names = append(spec.Syscalls[0].Names, "ptrace")
+				}))
+				mock.GetSPOdReturns(nil, kerrors.NewNotFound(schema.GroupResource{}, "spod"))
+			},
+			operation: admissionv1.Create,
+			allowed:   true,
+			code:      http.StatusOK,
+			warnings:  2,
+		},
+		{
+			name: "lint rule denied",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.Syscalls[0].Names = append(spec.Syscalls[0].Names, "ptrace")
+				}))
+				mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{
+					Spec: spodv1alpha1.SPODSpec{
+						SeccompLintRules: map[string]spodv1alpha1.SeccompLintSeverity{
+							"dangerous-syscalls": spodv1alpha1.SeccompLintSeverityDeny,
+						},
+					},
+				}, nil)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusForbidden,
+		},
+		{
+			name: "unchanged spec on update",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				sp := profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.DefaultAction = "SCMP_ACT_NOTIFY"
+				})
+				prepare(mock, sp)
+				mock.DecodeOldSeccompProfileReturns(sp.DeepCopy(), nil)
+			},
+			operation: admissionv1.Update,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "changed spec on update",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(func(spec *seccompprofileapi.SeccompProfileSpec) {
+					spec.DefaultAction = "SCMP_ACT_NOTIFY"
+				}))
+				mock.DecodeOldSeccompProfileReturns(profile(nil), nil)
+			},
+			operation: admissionv1.Update,
+			code:      http.StatusForbidden,
+		},
+		{
+			name:      "deletion allowed",
+			prepare:   func(*seccompprofilefakes.FakeImpl) {},
+			operation: admissionv1.Delete,
+			allowed:   true,
+			code:      http.StatusOK,
+		},
+		{
+			name: "error decode seccomp profile",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				mock.DecodeSeccompProfileReturns(nil, errTest)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusBadRequest,
+		},
+		{
+			name: "error list nodes",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(nil))
+				mock.ListNodesReturns(nil, errTest)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusInternalServerError,
+		},
+		{
+			name: "error get spod",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) {
+				prepare(mock, profile(nil))
+				mock.GetSPOdReturns(nil, errTest)
+			},
+			operation: admissionv1.Create,
+			code:      http.StatusInternalServerError,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mock := &seccompprofilefakes.FakeImpl{}
+			tc.prepare(mock)
+
+			validator := seccompProfileValidator{impl: mock, log: logr.Discard()}
+			resp := validator.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{Operation: tc.operation},
+			})
+			require.Equal(t, tc.allowed, resp.Allowed, resp.Result.Message)
+			require.Equal(t, tc.code, resp.Result.Code)
+			require.Len(t, resp.Warnings, tc.warnings)
+		})
+	}
+}
@@ -0,0 +1,602 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by counterfeiter. DO NOT EDIT.
+package seccompprofilefakes
+
+import (
+	"context"
+	"sync"
+
+	seccomp "github.com/seccomp/libseccomp-golang"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+type FakeImpl struct {
+	DecodeOldSeccompProfileStub        func(admission.Request) (*v1beta1.SeccompProfile, error)
+	decodeOldSeccompProfileMutex       sync.RWMutex
+	decodeOldSeccompProfileArgsForCall []struct {
+		arg1 admission.Request
+	}
+	decodeOldSeccompProfileReturns struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}
+	decodeOldSeccompProfileReturnsOnCall map[int]struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}
+	DecodeSeccompProfileStub        func(admission.Request) (*v1beta1.SeccompProfile, error)
+	decodeSeccompProfileMutex       sync.RWMutex
+	decodeSeccompProfileArgsForCall []struct {
+		arg1 admission.Request
+	}
+	decodeSeccompProfileReturns struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}
+	decodeSeccompProfileReturnsOnCall map[int]struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}
+	GetArchFromStringStub        func(string) (seccomp.ScmpArch, error)
+	getArchFromStringMutex       sync.RWMutex
+	getArchFromStringArgsForCall []struct {
+		arg1 string
+	}
+	getArchFromStringReturns struct {
+		result1 seccomp.ScmpArch
+		result2 error
+	}
+	getArchFromStringReturnsOnCall map[int]struct {
+		result1 seccomp.ScmpArch
+		result2 error
+	}
+	GetNativeArchStub        func() (seccomp.ScmpArch, error)
+	getNativeArchMutex       sync.RWMutex
+	getNativeArchArgsForCall []struct {
+	}
+	getNativeArchReturns struct {
+		result1 seccomp.ScmpArch
+		result2 error
+	}
+	getNativeArchReturnsOnCall map[int]struct {
+		result1 seccomp.ScmpArch
+		result2 error
+	}
+	GetSPOdStub        func(context.Context) (*v1alpha1.SecurityProfilesOperatorDaemon, error)
+	getSPOdMutex       sync.RWMutex
+	getSPOdArgsForCall []struct {
+		arg1 context.Context
+	}
+	getSPOdReturns struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	getSPOdReturnsOnCall map[int]struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	GetSyscallFromNameByArchStub        func(string, seccomp.ScmpArch) (seccomp.ScmpSyscall, error)
+	getSyscallFromNameByArchMutex       sync.RWMutex
+	getSyscallFromNameByArchArgsForCall []struct {
+		arg1 string
+		arg2 seccomp.ScmpArch
+	}
+	getSyscallFromNameByArchReturns struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}
+	getSyscallFromNameByArchReturnsOnCall map[int]struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}
+	ListNodesStub        func(context.Context) (*v1.NodeList, error)
+	listNodesMutex       sync.RWMutex
+	listNodesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listNodesReturns struct {
+		result1 *v1.NodeList
+		result2 error
+	}
+	listNodesReturnsOnCall map[int]struct {
+		result1 *v1.NodeList
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImpl) DecodeOldSeccompProfile(arg1 admission.Request) (*v1beta1.SeccompProfile, error) {
+	fake.decodeOldSeccompProfileMutex.Lock()
+	ret, specificReturn := fake.decodeOldSeccompProfileReturnsOnCall[len(fake.decodeOldSeccompProfileArgsForCall)]
+	fake.decodeOldSeccompProfileArgsForCall = append(fake.decodeOldSeccompProfileArgsForCall, struct {
+		arg1 admission.Request
+	}{arg1})
+	stub := fake.DecodeOldSeccompProfileStub
+	fakeReturns := fake.decodeOldSeccompProfileReturns
+	fake.recordInvocation("DecodeOldSeccompProfile", []interface{}{arg1})
+	fake.decodeOldSeccompProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) DecodeOldSeccompProfileCallCount() int {
+	fake.decodeOldSeccompProfileMutex.RLock()
+	defer fake.decodeOldSeccompProfileMutex.RUnlock()
+	return len(fake.decodeOldSeccompProfileArgsForCall)
+}
+
+func (fake *FakeImpl) DecodeOldSeccompProfileCalls(stub func(admission.Request) (*v1beta1.SeccompProfile, error)) {
+	fake.decodeOldSeccompProfileMutex.Lock()
+	defer fake.decodeOldSeccompProfileMutex.Unlock()
+	fake.DecodeOldSeccompProfileStub = stub
+}
+
+func (fake *FakeImpl) DecodeOldSeccompProfileArgsForCall(i int) admission.Request {
+	fake.decodeOldSeccompProfileMutex.RLock()
+	defer fake.decodeOldSeccompProfileMutex.RUnlock()
+	argsForCall := fake.decodeOldSeccompProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) DecodeOldSeccompProfileReturns(result1 *v1beta1.SeccompProfile, result2 error) {
+	fake.decodeOldSeccompProfileMutex.Lock()
+	defer fake.decodeOldSeccompProfileMutex.Unlock()
+	fake.DecodeOldSeccompProfileStub = nil
+	fake.decodeOldSeccompProfileReturns = struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeOldSeccompProfileReturnsOnCall(i int, result1 *v1beta1.SeccompProfile, result2 error) {
+	fake.decodeOldSeccompProfileMutex.Lock()
+	defer fake.decodeOldSeccompProfileMutex.Unlock()
+	fake.DecodeOldSeccompProfileStub = nil
+	if fake.decodeOldSeccompProfileReturnsOnCall == nil {
+		fake.decodeOldSeccompProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.SeccompProfile
+			result2 error
+		})
+	}
+	fake.decodeOldSeccompProfileReturnsOnCall[i] = struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeSeccompProfile(arg1 admission.Request) (*v1beta1.SeccompProfile, error) {
+	fake.decodeSeccompProfileMutex.Lock()
+	ret, specificReturn := fake.decodeSeccompProfileReturnsOnCall[len(fake.decodeSeccompProfileArgsForCall)]
+	fake.decodeSeccompProfileArgsForCall = append(fake.decodeSeccompProfileArgsForCall, struct {
+		arg1 admission.Request
+	}{arg1})
+	stub := fake.DecodeSeccompProfileStub
+	fakeReturns := fake.decodeSeccompProfileReturns
+	fake.recordInvocation("DecodeSeccompProfile", []interface{}{arg1})
+	fake.decodeSeccompProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) DecodeSeccompProfileCallCount() int {
+	fake.decodeSeccompProfileMutex.RLock()
+	defer fake.decodeSeccompProfileMutex.RUnlock()
+	return len(fake.decodeSeccompProfileArgsForCall)
+}
+
+func (fake *FakeImpl) DecodeSeccompProfileCalls(stub func(admission.Request) (*v1beta1.SeccompProfile, error)) {
+	fake.decodeSeccompProfileMutex.Lock()
+	defer fake.decodeSeccompProfileMutex.Unlock()
+	fake.DecodeSeccompProfileStub = stub
+}
+
+func (fake *FakeImpl) DecodeSeccompProfileArgsForCall(i int) admission.Request {
+	fake.decodeSeccompProfileMutex.RLock()
+	defer fake.decodeSeccompProfileMutex.RUnlock()
+	argsForCall := fake.decodeSeccompProfileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) DecodeSeccompProfileReturns(result1 *v1beta1.SeccompProfile, result2 error) {
+	fake.decodeSeccompProfileMutex.Lock()
+	defer fake.decodeSeccompProfileMutex.Unlock()
+	fake.DecodeSeccompProfileStub = nil
+	fake.decodeSeccompProfileReturns = struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DecodeSeccompProfileReturnsOnCall(i int, result1 *v1beta1.SeccompProfile, result2 error) {
+	fake.decodeSeccompProfileMutex.Lock()
+	defer fake.decodeSeccompProfileMutex.Unlock()
+	fake.DecodeSeccompProfileStub = nil
+	if fake.decodeSeccompProfileReturnsOnCall == nil {
+		fake.decodeSeccompProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.SeccompProfile
+			result2 error
+		})
+	}
+	fake.decodeSeccompProfileReturnsOnCall[i] = struct {
+		result1 *v1beta1.SeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetArchFromString(arg1 string) (seccomp.ScmpArch, error) {
+	fake.getArchFromStringMutex.Lock()
+	ret, specificReturn := fake.getArchFromStringReturnsOnCall[len(fake.getArchFromStringArgsForCall)]
+	fake.getArchFromStringArgsForCall = append(fake.getArchFromStringArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetArchFromStringStub
+	fakeReturns := fake.getArchFromStringReturns
+	fake.recordInvocation("GetArchFromString", []interface{}{arg1})
+	fake.getArchFromStringMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetArchFromStringCallCount() int {
+	fake.getArchFromStringMutex.RLock()
+	defer fake.getArchFromStringMutex.RUnlock()
+	return len(fake.getArchFromStringArgsForCall)
+}
+
+func (fake *FakeImpl) GetArchFromStringCalls(stub func(string) (seccomp.ScmpArch, error)) {
+	fake.getArchFromStringMutex.Lock()
+	defer fake.getArchFromStringMutex.Unlock()
+	fake.GetArchFromStringStub = stub
+}
+
+func (fake *FakeImpl) GetArchFromStringArgsForCall(i int) string {
+	fake.getArchFromStringMutex.RLock()
+	defer fake.getArchFromStringMutex.RUnlock()
+	argsForCall := fake.getArchFromStringArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetArchFromStringReturns(result1 seccomp.ScmpArch, result2 error) {
+	fake.getArchFromStringMutex.Lock()
+	defer fake.getArchFromStringMutex.Unlock()
+	fake.GetArchFromStringStub = nil
+	fake.getArchFromStringReturns = struct {
+		result1 seccomp.ScmpArch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetArchFromStringReturnsOnCall(i int, result1 seccomp.ScmpArch, result2 error) {
+	fake.getArchFromStringMutex.Lock()
+	defer fake.getArchFromStringMutex.Unlock()
+	fake.GetArchFromStringStub = nil
+	if fake.getArchFromStringReturnsOnCall == nil {
+		fake.getArchFromStringReturnsOnCall = make(map[int]struct {
+			result1 seccomp.ScmpArch
+			result2 error
+		})
+	}
+	fake.getArchFromStringReturnsOnCall[i] = struct {
+		result1 seccomp.ScmpArch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNativeArch() (seccomp.ScmpArch, error) {
+	fake.getNativeArchMutex.Lock()
+	ret, specificReturn := fake.getNativeArchReturnsOnCall[len(fake.getNativeArchArgsForCall)]
+	fake.getNativeArchArgsForCall = append(fake.getNativeArchArgsForCall, struct {
+	}{})
+	stub := fake.GetNativeArchStub
+	fakeReturns := fake.getNativeArchReturns
+	fake.recordInvocation("GetNativeArch", []interface{}{})
+	fake.getNativeArchMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetNativeArchCallCount() int {
+	fake.getNativeArchMutex.RLock()
+	defer fake.getNativeArchMutex.RUnlock()
+	return len(fake.getNativeArchArgsForCall)
+}
+
+func (fake *FakeImpl) GetNativeArchCalls(stub func() (seccomp.ScmpArch, error)) {
+	fake.getNativeArchMutex.Lock()
+	defer fake.getNativeArchMutex.Unlock()
+	fake.GetNativeArchStub = stub
+}
+
+func (fake *FakeImpl) GetNativeArchReturns(result1 seccomp.ScmpArch, result2 error) {
+	fake.getNativeArchMutex.Lock()
+	defer fake.getNativeArchMutex.Unlock()
+	fake.GetNativeArchStub = nil
+	fake.getNativeArchReturns = struct {
+		result1 seccomp.ScmpArch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNativeArchReturnsOnCall(i int, result1 seccomp.ScmpArch, result2 error) {
+	fake.getNativeArchMutex.Lock()
+	defer fake.getNativeArchMutex.Unlock()
+	fake.GetNativeArchStub = nil
+	if fake.getNativeArchReturnsOnCall == nil {
+		fake.getNativeArchReturnsOnCall = make(map[int]struct {
+			result1 seccomp.ScmpArch
+			result2 error
+		})
+	}
+	fake.getNativeArchReturnsOnCall[i] = struct {
+		result1 seccomp.ScmpArch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOd(arg1 context.Context) (*v1alpha1.SecurityProfilesOperatorDaemon, error) {
+	fake.getSPOdMutex.Lock()
+	ret, specificReturn := fake.getSPOdReturnsOnCall[len(fake.getSPOdArgsForCall)]
+	fake.getSPOdArgsForCall = append(fake.getSPOdArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetSPOdStub
+	fakeReturns := fake.getSPOdReturns
+	fake.recordInvocation("GetSPOd", []interface{}{arg1})
+	fake.getSPOdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSPOdCallCount() int {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	return len(fake.getSPOdArgsForCall)
+}
+
+func (fake *FakeImpl) GetSPOdCalls(stub func(context.Context) (*v1alpha1.SecurityProfilesOperatorDaemon, error)) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = stub
+}
+
+func (fake *FakeImpl) GetSPOdArgsForCall(i int) context.Context {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	argsForCall := fake.getSPOdArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetSPOdReturns(result1 *v1alpha1.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	fake.getSPOdReturns = struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOdReturnsOnCall(i int, result1 *v1alpha1.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	if fake.getSPOdReturnsOnCall == nil {
+		fake.getSPOdReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.SecurityProfilesOperatorDaemon
+			result2 error
+		})
+	}
+	fake.getSPOdReturnsOnCall[i] = struct {
+		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSyscallFromNameByArch(arg1 string, arg2 seccomp.ScmpArch) (seccomp.ScmpSyscall, error) {
+	fake.getSyscallFromNameByArchMutex.Lock()
+	ret, specificReturn := fake.getSyscallFromNameByArchReturnsOnCall[len(fake.getSyscallFromNameByArchArgsForCall)]
+	fake.getSyscallFromNameByArchArgsForCall = append(fake.getSyscallFromNameByArchArgsForCall, struct {
+		arg1 string
+		arg2 seccomp.ScmpArch
+	}{arg1, arg2})
+	stub := fake.GetSyscallFromNameByArchStub
+	fakeReturns := fake.getSyscallFromNameByArchReturns
+	fake.recordInvocation("GetSyscallFromNameByArch", []interface{}{arg1, arg2})
+	fake.getSyscallFromNameByArchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSyscallFromNameByArchCallCount() int {
+	fake.getSyscallFromNameByArchMutex.RLock()
+	defer fake.getSyscallFromNameByArchMutex.RUnlock()
+	return len(fake.getSyscallFromNameByArchArgsForCall)
+}
+
+func (fake *FakeImpl) GetSyscallFromNameByArchCalls(stub func(string, seccomp.ScmpArch) (seccomp.ScmpSyscall, error)) {
+	fake.getSyscallFromNameByArchMutex.Lock()
+	defer fake.getSyscallFromNameByArchMutex.Unlock()
+	fake.GetSyscallFromNameByArchStub = stub
+}
+
+func (fake *FakeImpl) GetSyscallFromNameByArchArgsForCall(i int) (string, seccomp.ScmpArch) {
+	fake.getSyscallFromNameByArchMutex.RLock()
+	defer fake.getSyscallFromNameByArchMutex.RUnlock()
+	argsForCall := fake.getSyscallFromNameByArchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetSyscallFromNameByArchReturns(result1 seccomp.ScmpSyscall, result2 error) {
+	fake.getSyscallFromNameByArchMutex.Lock()
+	defer fake.getSyscallFromNameByArchMutex.Unlock()
+	fake.GetSyscallFromNameByArchStub = nil
+	fake.getSyscallFromNameByArchReturns = struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSyscallFromNameByArchReturnsOnCall(i int, result1 seccomp.ScmpSyscall, result2 error) {
+	fake.getSyscallFromNameByArchMutex.Lock()
+	defer fake.getSyscallFromNameByArchMutex.Unlock()
+	fake.GetSyscallFromNameByArchStub = nil
+	if fake.getSyscallFromNameByArchReturnsOnCall == nil {
+		fake.getSyscallFromNameByArchReturnsOnCall = make(map[int]struct {
+			result1 seccomp.ScmpSyscall
+			result2 error
+		})
+	}
+	fake.getSyscallFromNameByArchReturnsOnCall[i] = struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListNodes(arg1 context.Context) (*v1.NodeList, error) {
+	fake.listNodesMutex.Lock()
+	ret, specificReturn := fake.listNodesReturnsOnCall[len(fake.listNodesArgsForCall)]
+	fake.listNodesArgsForCall = append(fake.listNodesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListNodesStub
+	fakeReturns := fake.listNodesReturns
+	fake.recordInvocation("ListNodes", []interface{}{arg1})
+	fake.listNodesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListNodesCallCount() int {
+	fake.listNodesMutex.RLock()
+	defer fake.listNodesMutex.RUnlock()
+	return len(fake.listNodesArgsForCall)
+}
+
+func (fake *FakeImpl) ListNodesCalls(stub func(context.Context) (*v1.NodeList, error)) {
+	fake.listNodesMutex.Lock()
+	defer fake.listNodesMutex.Unlock()
+	fake.ListNodesStub = stub
+}
+
+func (fake *FakeImpl) ListNodesArgsForCall(i int) context.Context {
+	fake.listNodesMutex.RLock()
+	defer fake.listNodesMutex.RUnlock()
+	argsForCall := fake.listNodesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ListNodesReturns(result1 *v1.NodeList, result2 error) {
+	fake.listNodesMutex.Lock()
+	defer fake.listNodesMutex.Unlock()
+	fake.ListNodesStub = nil
+	fake.listNodesReturns = struct {
+		result1 *v1.NodeList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListNodesReturnsOnCall(i int, result1 *v1.NodeList, result2 error) {
+	fake.listNodesMutex.Lock()
+	defer fake.listNodesMutex.Unlock()
+	fake.ListNodesStub = nil
+	if fake.listNodesReturnsOnCall == nil {
+		fake.listNodesReturnsOnCall = make(map[int]struct {
+			result1 *v1.NodeList
+			result2 error
+		})
+	}
+	fake.listNodesReturnsOnCall[i] = struct {
+		result1 *v1.NodeList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.decodeOldSeccompProfileMutex.RLock()
+	defer fake.decodeOldSeccompProfileMutex.RUnlock()
+	fake.decodeSeccompProfileMutex.RLock()
+	defer fake.decodeSeccompProfileMutex.RUnlock()
+	fake.getArchFromStringMutex.RLock()
+	defer fake.getArchFromStringMutex.RUnlock()
+	fake.getNativeArchMutex.RLock()
+	defer fake.getNativeArchMutex.RUnlock()
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	fake.getSyscallFromNameByArchMutex.RLock()
+	defer fake.getSyscallFromNameByArchMutex.RUnlock()
+	fake.listNodesMutex.RLock()
+	defer fake.listNodesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImpl) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
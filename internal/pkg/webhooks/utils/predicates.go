@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+)
+
+// A PodPredicate decides whether a ProfileRecording or ProfileBinding applies
+// to a pod. Predicates only inspect the admitted pod, which means that they
+// do not assume any specific workload controller. Pods created by custom
+// resources, like Argo Workflows or Tekton TaskRuns, are selected by their
+// labels or owner references like the pods of Deployments. Further ways of
+// selecting pods can be supported by implementing this interface and
+// combining the predicates via AllOf.
+type PodPredicate interface {
+	// Matches returns true if the pod is selected.
+	Matches(pod *corev1.Pod) bool
+}
+
+// PodPredicateFunc is a function implementing the PodPredicate interface.
+type PodPredicateFunc func(pod *corev1.Pod) bool
+
+// Matches returns true if the pod is selected.
+func (f PodPredicateFunc) Matches(pod *corev1.Pod) bool {
+	return f(pod)
+}
+
+// LabelPredicate selects the pods matching the label selector.
+func LabelPredicate(selector labels.Selector) PodPredicate {
+	return PodPredicateFunc(func(pod *corev1.Pod) bool {
+		return selector.Matches(labels.Set(pod.GetLabels()))
+	})
+}
+
+// OwnerPredicate selects the pods whose controller matches the owner
+// selector. All pods are selected if the owner selector is nil.
+func OwnerPredicate(owner *profilerecordingv1alpha1.OwnerSelector) PodPredicate {
+	return PodPredicateFunc(func(pod *corev1.Pod) bool {
+		if owner == nil {
+			return true
+		}
+
+		ref := metav1.GetControllerOf(pod)
+		if ref == nil {
+			return false
+		}
+
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return false
+		}
+		return gv.Group == owner.APIGroup &&
+			ref.Kind == owner.Kind &&
+			(owner.Name == "" || ref.Name == owner.Name)
+	})
+}
+
+// AllOf selects the pods selected by all of the predicates.
+func AllOf(predicates ...PodPredicate) PodPredicate {
+	return PodPredicateFunc(func(pod *corev1.Pod) bool {
+		for _, predicate := range predicates {
+			if !predicate.Matches(pod) {
+				return false
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	profilerecordingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
+)
+
+func TestPredicates(t *testing.T) {
+	t.Parallel()
+
+	isController := true
+	workflowPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "wf-1234",
+			Labels: map[string]string{"app": "ci"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "argoproj.io/v1alpha1",
+				Kind:       "Workflow",
+				Name:       "wf",
+				Controller: &isController,
+			}},
+		},
+	}
+	standalonePod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "pod",
+			Labels: map[string]string{"app": "ci"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		predicate utils.PodPredicate
+		pod       *corev1.Pod
+		expected  bool
+	}{
+		{
+			name:      "labels match",
+			predicate: utils.LabelPredicate(labels.SelectorFromSet(labels.Set{"app": "ci"})),
+			pod:       standalonePod,
+			expected:  true,
+		},
+		{
+			name:      "labels do not match",
+			predicate: utils.LabelPredicate(labels.SelectorFromSet(labels.Set{"app": "web"})),
+			pod:       standalonePod,
+			expected:  false,
+		},
+		{
+			name:      "no owner selector",
+			predicate: utils.OwnerPredicate(nil),
+			pod:       standalonePod,
+			expected:  true,
+		},
+		{
+			name: "owner kind matches",
+			predicate: utils.OwnerPredicate(&profilerecordingv1alpha1.OwnerSelector{
+				APIGroup: "argoproj.io", Kind: "Workflow",
+			}),
+			pod:      workflowPod,
+			expected: true,
+		},
+		{
+			name: "owner name matches",
+			predicate: utils.OwnerPredicate(&profilerecordingv1alpha1.OwnerSelector{
+				APIGroup: "argoproj.io", Kind: "Workflow", Name: "wf",
+			}),
+			pod:      workflowPod,
+			expected: true,
+		},
+		{
+			name: "owner name does not match",
+			predicate: utils.OwnerPredicate(&profilerecordingv1alpha1.OwnerSelector{
+				APIGroup: "argoproj.io", Kind: "Workflow", Name: "other",
+			}),
+			pod:      workflowPod,
+			expected: false,
+		},
+		{
+			name: "owner group does not match",
+			predicate: utils.OwnerPredicate(&profilerecordingv1alpha1.OwnerSelector{
+				APIGroup: "tekton.dev", Kind: "Workflow",
+			}),
+			pod:      workflowPod,
+			expected: false,
+		},
+		{
+			name: "pod without owner",
+			predicate: utils.OwnerPredicate(&profilerecordingv1alpha1.OwnerSelector{
+				APIGroup: "argoproj.io", Kind: "Workflow",
+			}),
+			pod:      standalonePod,
+			expected: false,
+		},
+		{
+			name: "all of labels and owner",
+			predicate: utils.AllOf(
+				utils.LabelPredicate(labels.SelectorFromSet(labels.Set{"app": "ci"})),
+				utils.OwnerPredicate(&profilerecordingv1alpha1.OwnerSelector{
+					APIGroup: "argoproj.io", Kind: "Workflow",
+				}),
+			),
+			pod:      workflowPod,
+			expected: true,
+		},
+		{
+			name: "all of with one mismatch",
+			predicate: utils.AllOf(
+				utils.LabelPredicate(labels.SelectorFromSet(labels.Set{"app": "web"})),
+				utils.OwnerPredicate(nil),
+			),
+			pod:      workflowPod,
+			expected: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, tc.predicate.Matches(tc.pod))
+		})
+	}
+}
@@ -21,9 +21,17 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 )
 
+// EphemeralContainersSubResource is the pod sub resource used for adding
+// ephemeral containers, for example by "kubectl debug".
+const EphemeralContainersSubResource = "ephemeralcontainers"
+
 // AppendIfNotExists adds an item to the provided list if it not exists.
 func AppendIfNotExists(list []string, item string) []string {
 	for _, s := range list {
@@ -44,6 +52,36 @@ func RemoveIfExists(list []string, item string) []string {
 	return list
 }
 
+// ProfileAllowed returns true if the provided profile binding policies allow
+// to bind the profile in the namespace. Namespaces which are not selected by
+// any policy are allowed to bind all profiles.
+func ProfileAllowed(
+	namespace *corev1.Namespace,
+	profile metav1.Object,
+	policies []profilebindingv1alpha1.ProfileBindingPolicy,
+) (bool, error) {
+	restricted := false
+	for i := range policies {
+		selected, err := policies[i].SelectsNamespace(namespace.Labels)
+		if err != nil {
+			return false, fmt.Errorf("check namespace %s: %w", namespace.Name, err)
+		}
+		if !selected {
+			continue
+		}
+		restricted = true
+
+		allowed, err := policies[i].AllowsProfile(profile)
+		if err != nil {
+			return false, fmt.Errorf("check profile %s: %w", profile.GetName(), err)
+		}
+		if allowed {
+			return true, nil
+		}
+	}
+	return !restricted, nil
+}
+
 // UpdateResource tries to update the provided object by using the
 // client.Writer. If the update fails, it automatically logs to the
 // provided logger.
@@ -79,3 +117,23 @@ func UpdateResourceStatus(
 	}
 	return nil
 }
+
+// NewEphemeralContainers returns the ephemeral containers of the pod which do
+// not have a status yet, which means that they are about to be added. They
+// are returned as regular containers, because both share the same fields.
+func NewEphemeralContainers(pod *corev1.Pod) []*corev1.Container {
+	existing := make(map[string]bool, len(pod.Status.EphemeralContainerStatuses))
+	for i := range pod.Status.EphemeralContainerStatuses {
+		existing[pod.Status.EphemeralContainerStatuses[i].Name] = true
+	}
+
+	res := []*corev1.Container{}
+	for i := range pod.Spec.EphemeralContainers {
+		ctr := &pod.Spec.EphemeralContainers[i]
+		if existing[ctr.Name] {
+			continue
+		}
+		res = append(res, (*corev1.Container)(&ctr.EphemeralContainerCommon))
+	}
+	return res
+}
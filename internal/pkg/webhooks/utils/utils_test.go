@@ -23,8 +23,11 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
 
@@ -87,6 +90,115 @@ func TestRemoveIfExists(t *testing.T) {
 	}
 }
 
+func TestProfileAllowed(t *testing.T) {
+	t.Parallel()
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "tenant-a",
+			Labels: map[string]string{"tenant": "a"},
+		},
+	}
+	profile := &metav1.ObjectMeta{
+		Name:   "profile",
+		Labels: map[string]string{"tier": "web"},
+	}
+	policy := func(
+		namespaceLabels map[string]string, names []string, profileLabels map[string]string,
+	) profilebindingv1alpha1.ProfileBindingPolicy {
+		p := profilebindingv1alpha1.ProfileBindingPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+			Spec: profilebindingv1alpha1.ProfileBindingPolicySpec{
+				NamespaceSelector:   metav1.LabelSelector{MatchLabels: namespaceLabels},
+				AllowedProfileNames: names,
+			},
+		}
+		if profileLabels != nil {
+			p.Spec.AllowedProfileSelector = &metav1.LabelSelector{MatchLabels: profileLabels}
+		}
+		return p
+	}
+
+	for _, tc := range []struct {
+		name      string
+		policies  []profilebindingv1alpha1.ProfileBindingPolicy
+		allowed   bool
+		shouldErr bool
+	}{
+		{
+			name:    "no policies",
+			allowed: true,
+		},
+		{
+			name: "namespace not selected",
+			policies: []profilebindingv1alpha1.ProfileBindingPolicy{
+				policy(map[string]string{"tenant": "b"}, nil, nil),
+			},
+			allowed: true,
+		},
+		{
+			name: "allowed by name",
+			policies: []profilebindingv1alpha1.ProfileBindingPolicy{
+				policy(map[string]string{"tenant": "a"}, []string{"other", "profile"}, nil),
+			},
+			allowed: true,
+		},
+		{
+			name: "allowed by labels",
+			policies: []profilebindingv1alpha1.ProfileBindingPolicy{
+				policy(nil, nil, map[string]string{"tier": "web"}),
+			},
+			allowed: true,
+		},
+		{
+			name: "allowed by any policy",
+			policies: []profilebindingv1alpha1.ProfileBindingPolicy{
+				policy(nil, []string{"other"}, nil),
+				policy(map[string]string{"tenant": "a"}, []string{"profile"}, nil),
+			},
+			allowed: true,
+		},
+		{
+			name: "not allowed",
+			policies: []profilebindingv1alpha1.ProfileBindingPolicy{
+				policy(map[string]string{"tenant": "a"}, []string{"other"}, map[string]string{"tier": "db"}),
+			},
+		},
+		{
+			name: "nothing allowed",
+			policies: []profilebindingv1alpha1.ProfileBindingPolicy{
+				policy(nil, nil, nil),
+			},
+		},
+		{
+			name: "invalid selector",
+			policies: []profilebindingv1alpha1.ProfileBindingPolicy{
+				{
+					Spec: profilebindingv1alpha1.ProfileBindingPolicySpec{
+						NamespaceSelector: metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "tenant", Operator: "invalid"},
+							},
+						},
+					},
+				},
+			},
+			shouldErr: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			allowed, err := utils.ProfileAllowed(namespace, profile, tc.policies)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.allowed, allowed)
+		})
+	}
+}
+
 type fakeClient struct {
 	updateFails bool
 }
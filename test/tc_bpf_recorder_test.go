@@ -100,7 +100,7 @@ func (e *e2e) testCaseBpfRecorderStaticPod() {
 	// we don't use resource name here, because the metrics are tracked by the annotation name which contains
 	// underscores instead of dashes
 	metricName := recordingName + "_nginx"
-	e.Regexp(fmt.Sprintf(`(?m)security_profiles_operator_seccomp_profile_bpf_total{`+
+	e.Regexp(fmt.Sprintf(`(?m)spo_seccomp_bpf_events_total{`+
 		`mount_namespace=".*",`+
 		`node=".*",`+
 		`profile="%s_.*"} \d+`,
@@ -336,7 +336,7 @@ func (e *e2e) testCaseBpfRecorderWithMemoryOptimization() {
 	// we don't use resource name here, because the metrics are tracked by the annotation name which contains
 	// underscores instead of dashes
 	metricName := recordingName + "_nginx"
-	e.Regexp(fmt.Sprintf(`(?m)security_profiles_operator_seccomp_profile_bpf_total{`+
+	e.Regexp(fmt.Sprintf(`(?m)spo_seccomp_bpf_events_total{`+
 		`mount_namespace=".*",`+
 		`node=".*",`+
 		`profile="%s_.*"} \d+`,
@@ -114,7 +114,7 @@ spec:
 		// which spod instance do we hit and the test is not stable
 
 		metrics := e.runAndRetryPodCMD(curlSpodCMD)
-		e.Regexp(fmt.Sprintf(`(?m)security_profiles_operator_seccomp_profile_audit_total{`+
+		e.Regexp(fmt.Sprintf(`(?m)spo_seccomp_denials_total{`+
 			`container="%s",`+
 			`executable="/usr/sbin/nginx",`+
 			`namespace="%s",`+
@@ -30,8 +30,8 @@ func (e *e2e) testCaseSeccompMetrics([]string) {
 	e.singleNodeTestCase()
 
 	const (
-		operationDelete = `security_profiles_operator_seccomp_profile_total{operation="delete"}`
-		operationUpdate = `security_profiles_operator_seccomp_profile_total{operation="update"}`
+		operationDelete = `spo_seccomp_profile_operations_total{operation="delete"}`
+		operationUpdate = `spo_seccomp_profile_operations_total{operation="update"}`
 	)
 
 	e.logf("Retrieving spo metrics for getting assertions")
@@ -76,8 +76,8 @@ func (e *e2e) testCaseSelinuxMetrics(nodes []string) {
 	e.singleNodeTestCase()
 
 	const (
-		operationDelete = `security_profiles_operator_selinux_profile_total{operation="delete"}`
-		operationUpdate = `security_profiles_operator_selinux_profile_total{operation="update"}`
+		operationDelete = `spo_selinux_profile_operations_total{operation="delete"}`
+		operationUpdate = `spo_selinux_profile_operations_total{operation="update"}`
 	)
 
 	e.logf("Retrieving spo metrics for getting assertions")